@@ -2,10 +2,14 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
 	"net/url"
+	"os"
 	"path/filepath"
 
 	"connectrpc.com/connect"
@@ -20,6 +24,10 @@ type Client struct {
 	agent         v1connect.AgentServiceClient
 	task          v1connect.TaskServiceClient
 	message       v1connect.MessageServiceClient
+	schedule      v1connect.ScheduleServiceClient
+	webhook       v1connect.WebhookServiceClient
+	tool          v1connect.ToolServiceClient
+	event         v1connect.EventServiceClient
 }
 
 type ClientOptions struct {
@@ -58,6 +66,20 @@ func NewClient(endpointContext EndpointContext, options ...ClientOption) (*Clien
 		}
 		baseURL = "http://unix"
 	}
+
+	if endpointContext.TLS != nil {
+		tlsConfig, err := endpointContext.TLS.ClientConfig()
+		if err != nil {
+			return nil, err
+		}
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = tlsConfig
+		opts.HTTPClient.Transport = transport
+	}
+
+	if endpointContext.Token != "" {
+		opts.ConnectOptions = append(opts.ConnectOptions, connect.WithInterceptors(&bearerTokenInterceptor{token: endpointContext.Token}))
+	}
 	baseURL, err := url.JoinPath(baseURL, "api")
 	if err != nil {
 		return nil, fmt.Errorf("invalid base URL %s: %w", baseURL, err)
@@ -69,6 +91,10 @@ func NewClient(endpointContext EndpointContext, options ...ClientOption) (*Clien
 		agent:         v1connect.NewAgentServiceClient(opts.HTTPClient, baseURL, opts.ConnectOptions...),
 		task:          v1connect.NewTaskServiceClient(opts.HTTPClient, baseURL, opts.ConnectOptions...),
 		message:       v1connect.NewMessageServiceClient(opts.HTTPClient, baseURL, opts.ConnectOptions...),
+		schedule:      v1connect.NewScheduleServiceClient(opts.HTTPClient, baseURL, opts.ConnectOptions...),
+		webhook:       v1connect.NewWebhookServiceClient(opts.HTTPClient, baseURL, opts.ConnectOptions...),
+		tool:          v1connect.NewToolServiceClient(opts.HTTPClient, baseURL, opts.ConnectOptions...),
+		event:         v1connect.NewEventServiceClient(opts.HTTPClient, baseURL, opts.ConnectOptions...),
 	}, nil
 }
 
@@ -92,12 +118,32 @@ func (c *Client) Message() v1connect.MessageServiceClient {
 	return c.message
 }
 
+func (c *Client) Schedule() v1connect.ScheduleServiceClient {
+	return c.schedule
+}
+
+func (c *Client) Webhook() v1connect.WebhookServiceClient {
+	return c.webhook
+}
+
+func (c *Client) Tool() v1connect.ToolServiceClient {
+	return c.tool
+}
+
+func (c *Client) Event() v1connect.EventServiceClient {
+	return c.event
+}
+
 type MockClient struct {
 	ModelProvider *mocks.MockModelProviderServiceClient
 	Model         *mocks.MockModelServiceClient
 	Agent         *mocks.MockAgentServiceClient
 	Task          *mocks.MockTaskServiceClient
 	Message       *mocks.MockMessageServiceClient
+	Schedule      *mocks.MockScheduleServiceClient
+	Webhook       *mocks.MockWebhookServiceClient
+	Tool          *mocks.MockToolServiceClient
+	Event         *mocks.MockEventServiceClient
 }
 
 func NewMockClient(ctrl *gomock.Controller) *MockClient {
@@ -107,6 +153,10 @@ func NewMockClient(ctrl *gomock.Controller) *MockClient {
 		Agent:         mocks.NewMockAgentServiceClient(ctrl),
 		Task:          mocks.NewMockTaskServiceClient(ctrl),
 		Message:       mocks.NewMockMessageServiceClient(ctrl),
+		Schedule:      mocks.NewMockScheduleServiceClient(ctrl),
+		Webhook:       mocks.NewMockWebhookServiceClient(ctrl),
+		Tool:          mocks.NewMockToolServiceClient(ctrl),
+		Event:         mocks.NewMockEventServiceClient(ctrl),
 	}
 }
 
@@ -117,6 +167,10 @@ func (c *MockClient) Client() *Client {
 		agent:         c.Agent,
 		task:          c.Task,
 		message:       c.Message,
+		schedule:      c.Schedule,
+		webhook:       c.Webhook,
+		tool:          c.Tool,
+		event:         c.Event,
 	}
 }
 
@@ -160,8 +214,10 @@ func (c *EndpointContexts) SetCurrent(contextName string) error {
 }
 
 type EndpointContext struct {
-	Address string `yaml:"address"`
-	Kind    string `yaml:"kind"`
+	Address string     `yaml:"address"`
+	Kind    string     `yaml:"kind"`
+	Token   string     `yaml:"token,omitempty"`
+	TLS     *TLSConfig `yaml:"tls,omitempty"`
 }
 
 func (c *EndpointContext) Validate() error {
@@ -173,17 +229,106 @@ func (c *EndpointContext) Validate() error {
 		if !filepath.IsAbs(c.Address) {
 			return fmt.Errorf("unix address must be an absolute path: %s", c.Address)
 		}
+
+		if c.TLS != nil {
+			return fmt.Errorf("tls is not supported for unix sockets")
+		}
 	}
 
 	if c.Kind == "http" {
-		if _, err := url.Parse(c.Address); err != nil {
+		address, err := url.Parse(c.Address)
+		if err != nil {
 			return fmt.Errorf("invalid http address: %s", c.Address)
 		}
+
+		if c.TLS != nil && address.Scheme != "https" {
+			return fmt.Errorf("tls requires an https address: %s", c.Address)
+		}
+	}
+
+	if c.TLS != nil {
+		if err := c.TLS.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// TLSConfig holds the files used to connect to a daemon that serves TLS. CACert
+// is only needed when the daemon certificate is not signed by a system root;
+// ClientCert and ClientKey enable mTLS authentication.
+type TLSConfig struct {
+	CACert     string `yaml:"ca_cert,omitempty"`
+	ClientCert string `yaml:"client_cert,omitempty"`
+	ClientKey  string `yaml:"client_key,omitempty"`
+	ServerName string `yaml:"server_name,omitempty"`
+}
+
+func (c *TLSConfig) Validate() error {
+	if (c.ClientCert == "") != (c.ClientKey == "") {
+		return fmt.Errorf("client certificate and client key must be set together")
 	}
 
 	return nil
 }
 
+func (c *TLSConfig) ClientConfig() (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: c.ServerName,
+	}
+
+	if c.CACert != "" {
+		caCert, err := os.ReadFile(c.CACert)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ca certificate: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, errors.New("no certificates found in ca certificate file")
+		}
+		config.RootCAs = pool
+	}
+
+	if c.ClientCert != "" {
+		certificate, err := tls.LoadX509KeyPair(c.ClientCert, c.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		config.Certificates = []tls.Certificate{certificate}
+	}
+
+	return config, nil
+}
+
+// bearerTokenInterceptor attaches the context token to every outgoing request.
+type bearerTokenInterceptor struct {
+	token string
+}
+
+func (i *bearerTokenInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			req.Header().Set("Authorization", "Bearer "+i.token)
+		}
+		return next(ctx, req)
+	}
+}
+
+func (i *bearerTokenInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		conn := next(ctx, spec)
+		conn.RequestHeader().Set("Authorization", "Bearer "+i.token)
+		return conn
+	}
+}
+
+func (i *bearerTokenInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
 func Ptr[T any](v T) *T {
 	return &v
 }
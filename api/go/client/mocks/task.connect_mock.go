@@ -42,6 +42,21 @@ func (m *MockTaskServiceClient) EXPECT() *MockTaskServiceClientMockRecorder {
 	return m.recorder
 }
 
+// ApplyPreview mocks base method.
+func (m *MockTaskServiceClient) ApplyPreview(arg0 context.Context, arg1 *connect.Request[v1.ApplyPreviewRequest]) (*connect.Response[v1.ApplyPreviewResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyPreview", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.ApplyPreviewResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyPreview indicates an expected call of ApplyPreview.
+func (mr *MockTaskServiceClientMockRecorder) ApplyPreview(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyPreview", reflect.TypeOf((*MockTaskServiceClient)(nil).ApplyPreview), arg0, arg1)
+}
+
 // CreateTask mocks base method.
 func (m *MockTaskServiceClient) CreateTask(arg0 context.Context, arg1 *connect.Request[v1.CreateTaskRequest]) (*connect.Response[v1.CreateTaskResponse], error) {
 	m.ctrl.T.Helper()
@@ -72,6 +87,21 @@ func (mr *MockTaskServiceClientMockRecorder) DeleteTask(arg0, arg1 any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTask", reflect.TypeOf((*MockTaskServiceClient)(nil).DeleteTask), arg0, arg1)
 }
 
+// GetPreview mocks base method.
+func (m *MockTaskServiceClient) GetPreview(arg0 context.Context, arg1 *connect.Request[v1.GetPreviewRequest]) (*connect.Response[v1.GetPreviewResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreview", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.GetPreviewResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreview indicates an expected call of GetPreview.
+func (mr *MockTaskServiceClientMockRecorder) GetPreview(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreview", reflect.TypeOf((*MockTaskServiceClient)(nil).GetPreview), arg0, arg1)
+}
+
 // GetTask mocks base method.
 func (m *MockTaskServiceClient) GetTask(arg0 context.Context, arg1 *connect.Request[v1.GetTaskRequest]) (*connect.Response[v1.GetTaskResponse], error) {
 	m.ctrl.T.Helper()
@@ -102,6 +132,21 @@ func (mr *MockTaskServiceClientMockRecorder) ListTasks(arg0, arg1 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTasks", reflect.TypeOf((*MockTaskServiceClient)(nil).ListTasks), arg0, arg1)
 }
 
+// RenderTask mocks base method.
+func (m *MockTaskServiceClient) RenderTask(arg0 context.Context, arg1 *connect.Request[v1.RenderTaskRequest]) (*connect.Response[v1.RenderTaskResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderTask", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.RenderTaskResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderTask indicates an expected call of RenderTask.
+func (mr *MockTaskServiceClientMockRecorder) RenderTask(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderTask", reflect.TypeOf((*MockTaskServiceClient)(nil).RenderTask), arg0, arg1)
+}
+
 // Subscribe mocks base method.
 func (m *MockTaskServiceClient) Subscribe(arg0 context.Context, arg1 *connect.Request[v1.SubscribeRequest]) (*connect.ServerStreamForClient[v1.SubscribeResponse], error) {
 	m.ctrl.T.Helper()
@@ -171,6 +216,21 @@ func (m *MockTaskServiceHandler) EXPECT() *MockTaskServiceHandlerMockRecorder {
 	return m.recorder
 }
 
+// ApplyPreview mocks base method.
+func (m *MockTaskServiceHandler) ApplyPreview(arg0 context.Context, arg1 *connect.Request[v1.ApplyPreviewRequest]) (*connect.Response[v1.ApplyPreviewResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyPreview", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.ApplyPreviewResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyPreview indicates an expected call of ApplyPreview.
+func (mr *MockTaskServiceHandlerMockRecorder) ApplyPreview(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyPreview", reflect.TypeOf((*MockTaskServiceHandler)(nil).ApplyPreview), arg0, arg1)
+}
+
 // CreateTask mocks base method.
 func (m *MockTaskServiceHandler) CreateTask(arg0 context.Context, arg1 *connect.Request[v1.CreateTaskRequest]) (*connect.Response[v1.CreateTaskResponse], error) {
 	m.ctrl.T.Helper()
@@ -201,6 +261,21 @@ func (mr *MockTaskServiceHandlerMockRecorder) DeleteTask(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTask", reflect.TypeOf((*MockTaskServiceHandler)(nil).DeleteTask), arg0, arg1)
 }
 
+// GetPreview mocks base method.
+func (m *MockTaskServiceHandler) GetPreview(arg0 context.Context, arg1 *connect.Request[v1.GetPreviewRequest]) (*connect.Response[v1.GetPreviewResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreview", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.GetPreviewResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreview indicates an expected call of GetPreview.
+func (mr *MockTaskServiceHandlerMockRecorder) GetPreview(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreview", reflect.TypeOf((*MockTaskServiceHandler)(nil).GetPreview), arg0, arg1)
+}
+
 // GetTask mocks base method.
 func (m *MockTaskServiceHandler) GetTask(arg0 context.Context, arg1 *connect.Request[v1.GetTaskRequest]) (*connect.Response[v1.GetTaskResponse], error) {
 	m.ctrl.T.Helper()
@@ -231,6 +306,21 @@ func (mr *MockTaskServiceHandlerMockRecorder) ListTasks(arg0, arg1 any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTasks", reflect.TypeOf((*MockTaskServiceHandler)(nil).ListTasks), arg0, arg1)
 }
 
+// RenderTask mocks base method.
+func (m *MockTaskServiceHandler) RenderTask(arg0 context.Context, arg1 *connect.Request[v1.RenderTaskRequest]) (*connect.Response[v1.RenderTaskResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderTask", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.RenderTaskResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderTask indicates an expected call of RenderTask.
+func (mr *MockTaskServiceHandlerMockRecorder) RenderTask(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderTask", reflect.TypeOf((*MockTaskServiceHandler)(nil).RenderTask), arg0, arg1)
+}
+
 // Subscribe mocks base method.
 func (m *MockTaskServiceHandler) Subscribe(arg0 context.Context, arg1 *connect.Request[v1.SubscribeRequest], arg2 *connect.ServerStream[v1.SubscribeResponse]) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,217 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../v1/v1connect/tool.connect.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../v1/v1connect/tool.connect.go -destination=./mocks/tool.connect_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	connect "connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockToolServiceClient is a mock of ToolServiceClient interface.
+type MockToolServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockToolServiceClientMockRecorder
+	isgomock struct{}
+}
+
+// MockToolServiceClientMockRecorder is the mock recorder for MockToolServiceClient.
+type MockToolServiceClientMockRecorder struct {
+	mock *MockToolServiceClient
+}
+
+// NewMockToolServiceClient creates a new mock instance.
+func NewMockToolServiceClient(ctrl *gomock.Controller) *MockToolServiceClient {
+	mock := &MockToolServiceClient{ctrl: ctrl}
+	mock.recorder = &MockToolServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockToolServiceClient) EXPECT() *MockToolServiceClientMockRecorder {
+	return m.recorder
+}
+
+// CreateTool mocks base method.
+func (m *MockToolServiceClient) CreateTool(arg0 context.Context, arg1 *connect.Request[v1.CreateToolRequest]) (*connect.Response[v1.CreateToolResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTool", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.CreateToolResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTool indicates an expected call of CreateTool.
+func (mr *MockToolServiceClientMockRecorder) CreateTool(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTool", reflect.TypeOf((*MockToolServiceClient)(nil).CreateTool), arg0, arg1)
+}
+
+// DeleteTool mocks base method.
+func (m *MockToolServiceClient) DeleteTool(arg0 context.Context, arg1 *connect.Request[v1.DeleteToolRequest]) (*connect.Response[v1.DeleteToolResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTool", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.DeleteToolResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTool indicates an expected call of DeleteTool.
+func (mr *MockToolServiceClientMockRecorder) DeleteTool(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTool", reflect.TypeOf((*MockToolServiceClient)(nil).DeleteTool), arg0, arg1)
+}
+
+// GetTool mocks base method.
+func (m *MockToolServiceClient) GetTool(arg0 context.Context, arg1 *connect.Request[v1.GetToolRequest]) (*connect.Response[v1.GetToolResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTool", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.GetToolResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTool indicates an expected call of GetTool.
+func (mr *MockToolServiceClientMockRecorder) GetTool(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTool", reflect.TypeOf((*MockToolServiceClient)(nil).GetTool), arg0, arg1)
+}
+
+// ListTools mocks base method.
+func (m *MockToolServiceClient) ListTools(arg0 context.Context, arg1 *connect.Request[v1.ListToolsRequest]) (*connect.Response[v1.ListToolsResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTools", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.ListToolsResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTools indicates an expected call of ListTools.
+func (mr *MockToolServiceClientMockRecorder) ListTools(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTools", reflect.TypeOf((*MockToolServiceClient)(nil).ListTools), arg0, arg1)
+}
+
+// UpdateTool mocks base method.
+func (m *MockToolServiceClient) UpdateTool(arg0 context.Context, arg1 *connect.Request[v1.UpdateToolRequest]) (*connect.Response[v1.UpdateToolResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTool", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.UpdateToolResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTool indicates an expected call of UpdateTool.
+func (mr *MockToolServiceClientMockRecorder) UpdateTool(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTool", reflect.TypeOf((*MockToolServiceClient)(nil).UpdateTool), arg0, arg1)
+}
+
+// MockToolServiceHandler is a mock of ToolServiceHandler interface.
+type MockToolServiceHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockToolServiceHandlerMockRecorder
+	isgomock struct{}
+}
+
+// MockToolServiceHandlerMockRecorder is the mock recorder for MockToolServiceHandler.
+type MockToolServiceHandlerMockRecorder struct {
+	mock *MockToolServiceHandler
+}
+
+// NewMockToolServiceHandler creates a new mock instance.
+func NewMockToolServiceHandler(ctrl *gomock.Controller) *MockToolServiceHandler {
+	mock := &MockToolServiceHandler{ctrl: ctrl}
+	mock.recorder = &MockToolServiceHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockToolServiceHandler) EXPECT() *MockToolServiceHandlerMockRecorder {
+	return m.recorder
+}
+
+// CreateTool mocks base method.
+func (m *MockToolServiceHandler) CreateTool(arg0 context.Context, arg1 *connect.Request[v1.CreateToolRequest]) (*connect.Response[v1.CreateToolResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTool", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.CreateToolResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTool indicates an expected call of CreateTool.
+func (mr *MockToolServiceHandlerMockRecorder) CreateTool(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTool", reflect.TypeOf((*MockToolServiceHandler)(nil).CreateTool), arg0, arg1)
+}
+
+// DeleteTool mocks base method.
+func (m *MockToolServiceHandler) DeleteTool(arg0 context.Context, arg1 *connect.Request[v1.DeleteToolRequest]) (*connect.Response[v1.DeleteToolResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTool", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.DeleteToolResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTool indicates an expected call of DeleteTool.
+func (mr *MockToolServiceHandlerMockRecorder) DeleteTool(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTool", reflect.TypeOf((*MockToolServiceHandler)(nil).DeleteTool), arg0, arg1)
+}
+
+// GetTool mocks base method.
+func (m *MockToolServiceHandler) GetTool(arg0 context.Context, arg1 *connect.Request[v1.GetToolRequest]) (*connect.Response[v1.GetToolResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTool", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.GetToolResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTool indicates an expected call of GetTool.
+func (mr *MockToolServiceHandlerMockRecorder) GetTool(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTool", reflect.TypeOf((*MockToolServiceHandler)(nil).GetTool), arg0, arg1)
+}
+
+// ListTools mocks base method.
+func (m *MockToolServiceHandler) ListTools(arg0 context.Context, arg1 *connect.Request[v1.ListToolsRequest]) (*connect.Response[v1.ListToolsResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTools", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.ListToolsResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTools indicates an expected call of ListTools.
+func (mr *MockToolServiceHandlerMockRecorder) ListTools(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTools", reflect.TypeOf((*MockToolServiceHandler)(nil).ListTools), arg0, arg1)
+}
+
+// UpdateTool mocks base method.
+func (m *MockToolServiceHandler) UpdateTool(arg0 context.Context, arg1 *connect.Request[v1.UpdateToolRequest]) (*connect.Response[v1.UpdateToolResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTool", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.UpdateToolResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTool indicates an expected call of UpdateTool.
+func (mr *MockToolServiceHandlerMockRecorder) UpdateTool(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTool", reflect.TypeOf((*MockToolServiceHandler)(nil).UpdateTool), arg0, arg1)
+}
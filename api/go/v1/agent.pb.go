@@ -156,7 +156,14 @@ type AgentSpec struct {
 	// instructions define the agent's behavior and capabilities (1-10000 characters).
 	Instructions string `protobuf:"bytes,3,opt,name=instructions,proto3" json:"instructions,omitempty"`
 	// model_id references the AI model that powers this agent (UUID format).
-	ModelId       string `protobuf:"bytes,4,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	ModelId string `protobuf:"bytes,4,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	// mcp_servers are the Model Context Protocol servers whose tools are available to the agent.
+	McpServers []*McpServer `protobuf:"bytes,5,rep,name=mcp_servers,json=mcpServers,proto3" json:"mcp_servers,omitempty"`
+	// tool_set restricts the tools the agent may call. An absent tool set allows all tools.
+	ToolSet *ToolSet `protobuf:"bytes,6,opt,name=tool_set,json=toolSet,proto3" json:"tool_set,omitempty"`
+	// script_limits restricts the resources of the agent's CodeAct scripts. Absent limits use
+	// the defaults of the daemon.
+	ScriptLimits  *ScriptLimits `protobuf:"bytes,7,opt,name=script_limits,json=scriptLimits,proto3" json:"script_limits,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -219,6 +226,302 @@ func (x *AgentSpec) GetModelId() string {
 	return ""
 }
 
+func (x *AgentSpec) GetMcpServers() []*McpServer {
+	if x != nil {
+		return x.McpServers
+	}
+	return nil
+}
+
+func (x *AgentSpec) GetToolSet() *ToolSet {
+	if x != nil {
+		return x.ToolSet
+	}
+	return nil
+}
+
+func (x *AgentSpec) GetScriptLimits() *ScriptLimits {
+	if x != nil {
+		return x.ScriptLimits
+	}
+	return nil
+}
+
+// ScriptLimits restricts the resources a single CodeAct script may use. Zero uses the default
+// of the daemon. A script that exceeds a limit fails with an error the agent can act on.
+type ScriptLimits struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// timeout_seconds is the maximum wall-clock time of a script.
+	TimeoutSeconds int32 `protobuf:"varint,1,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	// max_tool_calls is the maximum number of tool calls of a script.
+	MaxToolCalls int32 `protobuf:"varint,2,opt,name=max_tool_calls,json=maxToolCalls,proto3" json:"max_tool_calls,omitempty"`
+	// max_output_bytes is the maximum size of the console output of a script. Longer output is
+	// truncated in the middle.
+	MaxOutputBytes int32 `protobuf:"varint,3,opt,name=max_output_bytes,json=maxOutputBytes,proto3" json:"max_output_bytes,omitempty"`
+	// max_tool_result_bytes is the maximum size of a single tool result, e.g. the content
+	// returned by read_file.
+	MaxToolResultBytes int32 `protobuf:"varint,4,opt,name=max_tool_result_bytes,json=maxToolResultBytes,proto3" json:"max_tool_result_bytes,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ScriptLimits) Reset() {
+	*x = ScriptLimits{}
+	mi := &file_construct_v1_agent_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScriptLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScriptLimits) ProtoMessage() {}
+
+func (x *ScriptLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_agent_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScriptLimits.ProtoReflect.Descriptor instead.
+func (*ScriptLimits) Descriptor() ([]byte, []int) {
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ScriptLimits) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *ScriptLimits) GetMaxToolCalls() int32 {
+	if x != nil {
+		return x.MaxToolCalls
+	}
+	return 0
+}
+
+func (x *ScriptLimits) GetMaxOutputBytes() int32 {
+	if x != nil {
+		return x.MaxOutputBytes
+	}
+	return 0
+}
+
+func (x *ScriptLimits) GetMaxToolResultBytes() int32 {
+	if x != nil {
+		return x.MaxToolResultBytes
+	}
+	return 0
+}
+
+// ToolSet restricts the tools available to an agent. Disabled tools are not documented in
+// the system prompt and calls to them fail.
+type ToolSet struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// allowed_tools lists the names of the tools the agent may call. A '*' matches any
+	// sequence of characters, so "mcp.github.*" allows all tools of the github MCP server.
+	// Empty allows all tools.
+	AllowedTools []string `protobuf:"bytes,1,rep,name=allowed_tools,json=allowedTools,proto3" json:"allowed_tools,omitempty"`
+	// read_only denies tools that modify files or run commands, custom tools and MCP tools
+	// that are not annotated as read-only.
+	ReadOnly      bool `protobuf:"varint,2,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolSet) Reset() {
+	*x = ToolSet{}
+	mi := &file_construct_v1_agent_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolSet) ProtoMessage() {}
+
+func (x *ToolSet) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_agent_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolSet.ProtoReflect.Descriptor instead.
+func (*ToolSet) Descriptor() ([]byte, []int) {
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ToolSet) GetAllowedTools() []string {
+	if x != nil {
+		return x.AllowedTools
+	}
+	return nil
+}
+
+func (x *ToolSet) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+// McpServer configures a Model Context Protocol server. The daemon starts or connects to
+// the server when a task of the agent runs and exposes its tools to CodeAct scripts as
+// mcp.<server>.<tool>(args).
+type McpServer struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name identifies the server in CodeAct scripts (lowercase letters, digits and underscores, max 64 characters).
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are valid to be assigned to Transport:
+	//
+	//	*McpServer_Stdio
+	//	*McpServer_Http
+	Transport     isMcpServer_Transport `protobuf_oneof:"transport"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *McpServer) Reset() {
+	*x = McpServer{}
+	mi := &file_construct_v1_agent_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *McpServer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*McpServer) ProtoMessage() {}
+
+func (x *McpServer) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_agent_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use McpServer.ProtoReflect.Descriptor instead.
+func (*McpServer) Descriptor() ([]byte, []int) {
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *McpServer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *McpServer) GetTransport() isMcpServer_Transport {
+	if x != nil {
+		return x.Transport
+	}
+	return nil
+}
+
+func (x *McpServer) GetStdio() *McpServer_StdioTransport {
+	if x != nil {
+		if x, ok := x.Transport.(*McpServer_Stdio); ok {
+			return x.Stdio
+		}
+	}
+	return nil
+}
+
+func (x *McpServer) GetHttp() *McpServer_HttpTransport {
+	if x != nil {
+		if x, ok := x.Transport.(*McpServer_Http); ok {
+			return x.Http
+		}
+	}
+	return nil
+}
+
+type isMcpServer_Transport interface {
+	isMcpServer_Transport()
+}
+
+type McpServer_Stdio struct {
+	Stdio *McpServer_StdioTransport `protobuf:"bytes,2,opt,name=stdio,proto3,oneof"`
+}
+
+type McpServer_Http struct {
+	Http *McpServer_HttpTransport `protobuf:"bytes,3,opt,name=http,proto3,oneof"`
+}
+
+func (*McpServer_Stdio) isMcpServer_Transport() {}
+
+func (*McpServer_Http) isMcpServer_Transport() {}
+
+// McpServers wraps a list of MCP servers so that an update can distinguish an empty list from an absent one.
+type McpServers struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// servers replaces the MCP servers of the agent.
+	Servers       []*McpServer `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *McpServers) Reset() {
+	*x = McpServers{}
+	mi := &file_construct_v1_agent_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *McpServers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*McpServers) ProtoMessage() {}
+
+func (x *McpServers) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_agent_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use McpServers.ProtoReflect.Descriptor instead.
+func (*McpServers) Descriptor() ([]byte, []int) {
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *McpServers) GetServers() []*McpServer {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
 // CreateAgentRequest contains the parameters needed to create a new agent.
 type CreateAgentRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -229,14 +532,20 @@ type CreateAgentRequest struct {
 	// instructions define the agent's behavior and capabilities (1-65536 characters).
 	Instructions string `protobuf:"bytes,3,opt,name=instructions,proto3" json:"instructions,omitempty"`
 	// model_id references the AI model that will power this agent (UUID format).
-	ModelId       string `protobuf:"bytes,4,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	ModelId string `protobuf:"bytes,4,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	// mcp_servers are the Model Context Protocol servers whose tools are available to the agent (max 16).
+	McpServers []*McpServer `protobuf:"bytes,5,rep,name=mcp_servers,json=mcpServers,proto3" json:"mcp_servers,omitempty"`
+	// tool_set restricts the tools the agent may call (optional).
+	ToolSet *ToolSet `protobuf:"bytes,6,opt,name=tool_set,json=toolSet,proto3" json:"tool_set,omitempty"`
+	// script_limits restricts the resources of the agent's CodeAct scripts (optional).
+	ScriptLimits  *ScriptLimits `protobuf:"bytes,7,opt,name=script_limits,json=scriptLimits,proto3" json:"script_limits,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateAgentRequest) Reset() {
 	*x = CreateAgentRequest{}
-	mi := &file_construct_v1_agent_proto_msgTypes[3]
+	mi := &file_construct_v1_agent_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -248,7 +557,7 @@ func (x *CreateAgentRequest) String() string {
 func (*CreateAgentRequest) ProtoMessage() {}
 
 func (x *CreateAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[3]
+	mi := &file_construct_v1_agent_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -261,7 +570,7 @@ func (x *CreateAgentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAgentRequest.ProtoReflect.Descriptor instead.
 func (*CreateAgentRequest) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{3}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreateAgentRequest) GetName() string {
@@ -292,6 +601,27 @@ func (x *CreateAgentRequest) GetModelId() string {
 	return ""
 }
 
+func (x *CreateAgentRequest) GetMcpServers() []*McpServer {
+	if x != nil {
+		return x.McpServers
+	}
+	return nil
+}
+
+func (x *CreateAgentRequest) GetToolSet() *ToolSet {
+	if x != nil {
+		return x.ToolSet
+	}
+	return nil
+}
+
+func (x *CreateAgentRequest) GetScriptLimits() *ScriptLimits {
+	if x != nil {
+		return x.ScriptLimits
+	}
+	return nil
+}
+
 // CreateAgentResponse contains the newly created agent.
 type CreateAgentResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -303,7 +633,7 @@ type CreateAgentResponse struct {
 
 func (x *CreateAgentResponse) Reset() {
 	*x = CreateAgentResponse{}
-	mi := &file_construct_v1_agent_proto_msgTypes[4]
+	mi := &file_construct_v1_agent_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -315,7 +645,7 @@ func (x *CreateAgentResponse) String() string {
 func (*CreateAgentResponse) ProtoMessage() {}
 
 func (x *CreateAgentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[4]
+	mi := &file_construct_v1_agent_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -328,7 +658,7 @@ func (x *CreateAgentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAgentResponse.ProtoReflect.Descriptor instead.
 func (*CreateAgentResponse) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{4}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CreateAgentResponse) GetAgent() *Agent {
@@ -349,7 +679,7 @@ type GetAgentRequest struct {
 
 func (x *GetAgentRequest) Reset() {
 	*x = GetAgentRequest{}
-	mi := &file_construct_v1_agent_proto_msgTypes[5]
+	mi := &file_construct_v1_agent_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -361,7 +691,7 @@ func (x *GetAgentRequest) String() string {
 func (*GetAgentRequest) ProtoMessage() {}
 
 func (x *GetAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[5]
+	mi := &file_construct_v1_agent_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -374,7 +704,7 @@ func (x *GetAgentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAgentRequest.ProtoReflect.Descriptor instead.
 func (*GetAgentRequest) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{5}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetAgentRequest) GetId() string {
@@ -395,7 +725,7 @@ type GetAgentResponse struct {
 
 func (x *GetAgentResponse) Reset() {
 	*x = GetAgentResponse{}
-	mi := &file_construct_v1_agent_proto_msgTypes[6]
+	mi := &file_construct_v1_agent_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -407,7 +737,7 @@ func (x *GetAgentResponse) String() string {
 func (*GetAgentResponse) ProtoMessage() {}
 
 func (x *GetAgentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[6]
+	mi := &file_construct_v1_agent_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -420,7 +750,7 @@ func (x *GetAgentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAgentResponse.ProtoReflect.Descriptor instead.
 func (*GetAgentResponse) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{6}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetAgentResponse) GetAgent() *Agent {
@@ -449,7 +779,7 @@ type ListAgentsRequest struct {
 
 func (x *ListAgentsRequest) Reset() {
 	*x = ListAgentsRequest{}
-	mi := &file_construct_v1_agent_proto_msgTypes[7]
+	mi := &file_construct_v1_agent_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -461,7 +791,7 @@ func (x *ListAgentsRequest) String() string {
 func (*ListAgentsRequest) ProtoMessage() {}
 
 func (x *ListAgentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[7]
+	mi := &file_construct_v1_agent_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -474,7 +804,7 @@ func (x *ListAgentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAgentsRequest.ProtoReflect.Descriptor instead.
 func (*ListAgentsRequest) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{7}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListAgentsRequest) GetFilter() *ListAgentsRequest_Filter {
@@ -525,7 +855,7 @@ type ListAgentsResponse struct {
 
 func (x *ListAgentsResponse) Reset() {
 	*x = ListAgentsResponse{}
-	mi := &file_construct_v1_agent_proto_msgTypes[8]
+	mi := &file_construct_v1_agent_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -537,7 +867,7 @@ func (x *ListAgentsResponse) String() string {
 func (*ListAgentsResponse) ProtoMessage() {}
 
 func (x *ListAgentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[8]
+	mi := &file_construct_v1_agent_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -550,7 +880,7 @@ func (x *ListAgentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAgentsResponse.ProtoReflect.Descriptor instead.
 func (*ListAgentsResponse) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{8}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListAgentsResponse) GetAgents() []*Agent {
@@ -579,14 +909,21 @@ type UpdateAgentRequest struct {
 	// instructions are the new instructions for the agent (1-65536 characters, optional).
 	Instructions *string `protobuf:"bytes,4,opt,name=instructions,proto3,oneof" json:"instructions,omitempty"`
 	// model_id is the new model reference for the agent (UUID format, optional).
-	ModelId       *string `protobuf:"bytes,5,opt,name=model_id,json=modelId,proto3,oneof" json:"model_id,omitempty"`
+	ModelId *string `protobuf:"bytes,5,opt,name=model_id,json=modelId,proto3,oneof" json:"model_id,omitempty"`
+	// mcp_servers replaces the MCP servers of the agent (optional).
+	McpServers *McpServers `protobuf:"bytes,6,opt,name=mcp_servers,json=mcpServers,proto3" json:"mcp_servers,omitempty"`
+	// tool_set replaces the tool set of the agent (optional). An empty tool set allows all tools.
+	ToolSet *ToolSet `protobuf:"bytes,7,opt,name=tool_set,json=toolSet,proto3" json:"tool_set,omitempty"`
+	// script_limits replaces the script limits of the agent (optional). Empty limits use the
+	// defaults of the daemon.
+	ScriptLimits  *ScriptLimits `protobuf:"bytes,8,opt,name=script_limits,json=scriptLimits,proto3" json:"script_limits,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateAgentRequest) Reset() {
 	*x = UpdateAgentRequest{}
-	mi := &file_construct_v1_agent_proto_msgTypes[9]
+	mi := &file_construct_v1_agent_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -598,7 +935,7 @@ func (x *UpdateAgentRequest) String() string {
 func (*UpdateAgentRequest) ProtoMessage() {}
 
 func (x *UpdateAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[9]
+	mi := &file_construct_v1_agent_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -611,7 +948,7 @@ func (x *UpdateAgentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAgentRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAgentRequest) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{9}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UpdateAgentRequest) GetId() string {
@@ -649,6 +986,27 @@ func (x *UpdateAgentRequest) GetModelId() string {
 	return ""
 }
 
+func (x *UpdateAgentRequest) GetMcpServers() *McpServers {
+	if x != nil {
+		return x.McpServers
+	}
+	return nil
+}
+
+func (x *UpdateAgentRequest) GetToolSet() *ToolSet {
+	if x != nil {
+		return x.ToolSet
+	}
+	return nil
+}
+
+func (x *UpdateAgentRequest) GetScriptLimits() *ScriptLimits {
+	if x != nil {
+		return x.ScriptLimits
+	}
+	return nil
+}
+
 // UpdateAgentResponse contains the updated agent.
 type UpdateAgentResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -660,7 +1018,7 @@ type UpdateAgentResponse struct {
 
 func (x *UpdateAgentResponse) Reset() {
 	*x = UpdateAgentResponse{}
-	mi := &file_construct_v1_agent_proto_msgTypes[10]
+	mi := &file_construct_v1_agent_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -672,7 +1030,7 @@ func (x *UpdateAgentResponse) String() string {
 func (*UpdateAgentResponse) ProtoMessage() {}
 
 func (x *UpdateAgentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[10]
+	mi := &file_construct_v1_agent_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -685,7 +1043,7 @@ func (x *UpdateAgentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAgentResponse.ProtoReflect.Descriptor instead.
 func (*UpdateAgentResponse) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{10}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *UpdateAgentResponse) GetAgent() *Agent {
@@ -706,7 +1064,7 @@ type DeleteAgentRequest struct {
 
 func (x *DeleteAgentRequest) Reset() {
 	*x = DeleteAgentRequest{}
-	mi := &file_construct_v1_agent_proto_msgTypes[11]
+	mi := &file_construct_v1_agent_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -718,7 +1076,7 @@ func (x *DeleteAgentRequest) String() string {
 func (*DeleteAgentRequest) ProtoMessage() {}
 
 func (x *DeleteAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[11]
+	mi := &file_construct_v1_agent_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -731,7 +1089,7 @@ func (x *DeleteAgentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAgentRequest.ProtoReflect.Descriptor instead.
 func (*DeleteAgentRequest) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{11}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *DeleteAgentRequest) GetId() string {
@@ -750,7 +1108,7 @@ type DeleteAgentResponse struct {
 
 func (x *DeleteAgentResponse) Reset() {
 	*x = DeleteAgentResponse{}
-	mi := &file_construct_v1_agent_proto_msgTypes[12]
+	mi := &file_construct_v1_agent_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -762,7 +1120,7 @@ func (x *DeleteAgentResponse) String() string {
 func (*DeleteAgentResponse) ProtoMessage() {}
 
 func (x *DeleteAgentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[12]
+	mi := &file_construct_v1_agent_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -775,7 +1133,128 @@ func (x *DeleteAgentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAgentResponse.ProtoReflect.Descriptor instead.
 func (*DeleteAgentResponse) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{12}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{16}
+}
+
+// StdioTransport runs the server as a child process of the daemon that communicates over stdin and stdout.
+type McpServer_StdioTransport struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// command is the executable that starts the server.
+	Command string `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
+	// args are passed to the command.
+	Args []string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	// env is added to the environment of the server. ${VAR} references are expanded
+	// from the environment of the daemon so that secrets do not have to be stored.
+	Env           map[string]string `protobuf:"bytes,3,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *McpServer_StdioTransport) Reset() {
+	*x = McpServer_StdioTransport{}
+	mi := &file_construct_v1_agent_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *McpServer_StdioTransport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*McpServer_StdioTransport) ProtoMessage() {}
+
+func (x *McpServer_StdioTransport) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_agent_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use McpServer_StdioTransport.ProtoReflect.Descriptor instead.
+func (*McpServer_StdioTransport) Descriptor() ([]byte, []int) {
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *McpServer_StdioTransport) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *McpServer_StdioTransport) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *McpServer_StdioTransport) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+// HttpTransport connects to a server with the streamable HTTP transport.
+type McpServer_HttpTransport struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// url is the MCP endpoint of the server.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// headers are sent with every request. ${VAR} references are expanded from the
+	// environment of the daemon.
+	Headers       map[string]string `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *McpServer_HttpTransport) Reset() {
+	*x = McpServer_HttpTransport{}
+	mi := &file_construct_v1_agent_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *McpServer_HttpTransport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*McpServer_HttpTransport) ProtoMessage() {}
+
+func (x *McpServer_HttpTransport) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_agent_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use McpServer_HttpTransport.ProtoReflect.Descriptor instead.
+func (*McpServer_HttpTransport) Descriptor() ([]byte, []int) {
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{5, 1}
+}
+
+func (x *McpServer_HttpTransport) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *McpServer_HttpTransport) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
 }
 
 // Filter specifies criteria for narrowing the list of returned agents.
@@ -789,7 +1268,7 @@ type ListAgentsRequest_Filter struct {
 
 func (x *ListAgentsRequest_Filter) Reset() {
 	*x = ListAgentsRequest_Filter{}
-	mi := &file_construct_v1_agent_proto_msgTypes[13]
+	mi := &file_construct_v1_agent_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -801,7 +1280,7 @@ func (x *ListAgentsRequest_Filter) String() string {
 func (*ListAgentsRequest_Filter) ProtoMessage() {}
 
 func (x *ListAgentsRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[13]
+	mi := &file_construct_v1_agent_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -814,7 +1293,7 @@ func (x *ListAgentsRequest_Filter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAgentsRequest_Filter.ProtoReflect.Descriptor instead.
 func (*ListAgentsRequest_Filter) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{7, 0}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{11, 0}
 }
 
 func (x *ListAgentsRequest_Filter) GetNames() []string {
@@ -837,19 +1316,57 @@ const file_construct_v1_agent_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tcreatedAt\x12A\n" +
 	"\n" +
-	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tupdatedAt\"\xad\x01\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tupdatedAt\"\xda\x02\n" +
 	"\tAgentSpec\x12\x1e\n" +
 	"\x04name\x18\x01 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12*\n" +
 	"\vdescription\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10R\vdescription\x12/\n" +
 	"\finstructions\x18\x03 \x01(\tB\v\xbaH\br\x06\x10\x01\x18\x80\x80\x04R\finstructions\x12#\n" +
-	"\bmodel_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\amodelId\"\xb6\x01\n" +
+	"\bmodel_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\amodelId\x128\n" +
+	"\vmcp_servers\x18\x05 \x03(\v2\x17.construct.v1.McpServerR\n" +
+	"mcpServers\x120\n" +
+	"\btool_set\x18\x06 \x01(\v2\x15.construct.v1.ToolSetR\atoolSet\x12?\n" +
+	"\rscript_limits\x18\a \x01(\v2\x1a.construct.v1.ScriptLimitsR\fscriptLimits\"\xe1\x01\n" +
+	"\fScriptLimits\x123\n" +
+	"\x0ftimeout_seconds\x18\x01 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\x90\x1c(\x00R\x0etimeoutSeconds\x12-\n" +
+	"\x0emax_tool_calls\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\fmaxToolCalls\x121\n" +
+	"\x10max_output_bytes\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x0emaxOutputBytes\x12:\n" +
+	"\x15max_tool_result_bytes\x18\x04 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x12maxToolResultBytes\"a\n" +
+	"\aToolSet\x129\n" +
+	"\rallowed_tools\x18\x01 \x03(\tB\x14\xbaH\x11\x92\x01\x0e\x10\x80\x01\x18\x01\"\ar\x05\x10\x01\x18\xff\x01R\fallowedTools\x12\x1b\n" +
+	"\tread_only\x18\x02 \x01(\bR\breadOnly\"\xc9\x04\n" +
+	"\tMcpServer\x12.\n" +
+	"\x04name\x18\x01 \x01(\tB\x1a\xbaH\x17r\x15\x18@2\x11^[a-z][a-z0-9_]*$R\x04name\x12>\n" +
+	"\x05stdio\x18\x02 \x01(\v2&.construct.v1.McpServer.StdioTransportH\x00R\x05stdio\x12;\n" +
+	"\x04http\x18\x03 \x01(\v2%.construct.v1.McpServer.HttpTransportH\x00R\x04http\x1a\xc2\x01\n" +
+	"\x0eStdioTransport\x12!\n" +
+	"\acommand\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\acommand\x12\x12\n" +
+	"\x04args\x18\x02 \x03(\tR\x04args\x12A\n" +
+	"\x03env\x18\x03 \x03(\v2/.construct.v1.McpServer.StdioTransport.EnvEntryR\x03env\x1a6\n" +
+	"\bEnvEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\xb5\x01\n" +
+	"\rHttpTransport\x12\x1a\n" +
+	"\x03url\x18\x01 \x01(\tB\b\xbaH\x05r\x03\x88\x01\x01R\x03url\x12L\n" +
+	"\aheaders\x18\x02 \x03(\v22.construct.v1.McpServer.HttpTransport.HeadersEntryR\aheaders\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x12\n" +
+	"\ttransport\x12\x05\xbaH\x02\b\x01\"I\n" +
+	"\n" +
+	"McpServers\x12;\n" +
+	"\aservers\x18\x01 \x03(\v2\x17.construct.v1.McpServerB\b\xbaH\x05\x92\x01\x02\x10\x10R\aservers\"\xed\x02\n" +
 	"\x12CreateAgentRequest\x12\x1e\n" +
 	"\x04name\x18\x01 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12*\n" +
 	"\vdescription\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10R\vdescription\x12/\n" +
 	"\finstructions\x18\x03 \x01(\tB\v\xbaH\br\x06\x10\x01\x18\x80\x80\x04R\finstructions\x12#\n" +
-	"\bmodel_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\amodelId\"H\n" +
+	"\bmodel_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\amodelId\x12B\n" +
+	"\vmcp_servers\x18\x05 \x03(\v2\x17.construct.v1.McpServerB\b\xbaH\x05\x92\x01\x02\x10\x10R\n" +
+	"mcpServers\x120\n" +
+	"\btool_set\x18\x06 \x01(\v2\x15.construct.v1.ToolSetR\atoolSet\x12?\n" +
+	"\rscript_limits\x18\a \x01(\v2\x1a.construct.v1.ScriptLimitsR\fscriptLimits\"H\n" +
 	"\x13CreateAgentResponse\x121\n" +
 	"\x05agent\x18\x01 \x01(\v2\x13.construct.v1.AgentB\x06\xbaH\x03\xc8\x01\x01R\x05agent\"+\n" +
 	"\x0fGetAgentRequest\x12\x18\n" +
@@ -873,14 +1390,18 @@ const file_construct_v1_agent_proto_rawDesc = "" +
 	"\v_sort_order\"i\n" +
 	"\x12ListAgentsResponse\x12+\n" +
 	"\x06agents\x18\x01 \x03(\v2\x13.construct.v1.AgentR\x06agents\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x9b\x02\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xc9\x03\n" +
 	"\x12UpdateAgentRequest\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12#\n" +
 	"\x04name\x18\x02 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x00R\x04name\x88\x01\x01\x12/\n" +
 	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aH\x01R\vdescription\x88\x01\x01\x124\n" +
 	"\finstructions\x18\x04 \x01(\tB\v\xbaH\br\x06\x10\x01\x18\x80\x80\x04H\x02R\finstructions\x88\x01\x01\x12(\n" +
-	"\bmodel_id\x18\x05 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x03R\amodelId\x88\x01\x01B\a\n" +
+	"\bmodel_id\x18\x05 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x03R\amodelId\x88\x01\x01\x129\n" +
+	"\vmcp_servers\x18\x06 \x01(\v2\x18.construct.v1.McpServersR\n" +
+	"mcpServers\x120\n" +
+	"\btool_set\x18\a \x01(\v2\x15.construct.v1.ToolSetR\atoolSet\x12?\n" +
+	"\rscript_limits\x18\b \x01(\v2\x1a.construct.v1.ScriptLimitsR\fscriptLimitsB\a\n" +
 	"\x05_nameB\x0e\n" +
 	"\f_descriptionB\x0f\n" +
 	"\r_instructionsB\v\n" +
@@ -910,53 +1431,75 @@ func file_construct_v1_agent_proto_rawDescGZIP() []byte {
 	return file_construct_v1_agent_proto_rawDescData
 }
 
-var file_construct_v1_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_construct_v1_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_construct_v1_agent_proto_goTypes = []any{
 	(*Agent)(nil),                    // 0: construct.v1.Agent
 	(*AgentMetadata)(nil),            // 1: construct.v1.AgentMetadata
 	(*AgentSpec)(nil),                // 2: construct.v1.AgentSpec
-	(*CreateAgentRequest)(nil),       // 3: construct.v1.CreateAgentRequest
-	(*CreateAgentResponse)(nil),      // 4: construct.v1.CreateAgentResponse
-	(*GetAgentRequest)(nil),          // 5: construct.v1.GetAgentRequest
-	(*GetAgentResponse)(nil),         // 6: construct.v1.GetAgentResponse
-	(*ListAgentsRequest)(nil),        // 7: construct.v1.ListAgentsRequest
-	(*ListAgentsResponse)(nil),       // 8: construct.v1.ListAgentsResponse
-	(*UpdateAgentRequest)(nil),       // 9: construct.v1.UpdateAgentRequest
-	(*UpdateAgentResponse)(nil),      // 10: construct.v1.UpdateAgentResponse
-	(*DeleteAgentRequest)(nil),       // 11: construct.v1.DeleteAgentRequest
-	(*DeleteAgentResponse)(nil),      // 12: construct.v1.DeleteAgentResponse
-	(*ListAgentsRequest_Filter)(nil), // 13: construct.v1.ListAgentsRequest.Filter
-	(*timestamppb.Timestamp)(nil),    // 14: google.protobuf.Timestamp
-	(SortField)(0),                   // 15: construct.v1.SortField
-	(SortOrder)(0),                   // 16: construct.v1.SortOrder
+	(*ScriptLimits)(nil),             // 3: construct.v1.ScriptLimits
+	(*ToolSet)(nil),                  // 4: construct.v1.ToolSet
+	(*McpServer)(nil),                // 5: construct.v1.McpServer
+	(*McpServers)(nil),               // 6: construct.v1.McpServers
+	(*CreateAgentRequest)(nil),       // 7: construct.v1.CreateAgentRequest
+	(*CreateAgentResponse)(nil),      // 8: construct.v1.CreateAgentResponse
+	(*GetAgentRequest)(nil),          // 9: construct.v1.GetAgentRequest
+	(*GetAgentResponse)(nil),         // 10: construct.v1.GetAgentResponse
+	(*ListAgentsRequest)(nil),        // 11: construct.v1.ListAgentsRequest
+	(*ListAgentsResponse)(nil),       // 12: construct.v1.ListAgentsResponse
+	(*UpdateAgentRequest)(nil),       // 13: construct.v1.UpdateAgentRequest
+	(*UpdateAgentResponse)(nil),      // 14: construct.v1.UpdateAgentResponse
+	(*DeleteAgentRequest)(nil),       // 15: construct.v1.DeleteAgentRequest
+	(*DeleteAgentResponse)(nil),      // 16: construct.v1.DeleteAgentResponse
+	(*McpServer_StdioTransport)(nil), // 17: construct.v1.McpServer.StdioTransport
+	(*McpServer_HttpTransport)(nil),  // 18: construct.v1.McpServer.HttpTransport
+	nil,                              // 19: construct.v1.McpServer.StdioTransport.EnvEntry
+	nil,                              // 20: construct.v1.McpServer.HttpTransport.HeadersEntry
+	(*ListAgentsRequest_Filter)(nil), // 21: construct.v1.ListAgentsRequest.Filter
+	(*timestamppb.Timestamp)(nil),    // 22: google.protobuf.Timestamp
+	(SortField)(0),                   // 23: construct.v1.SortField
+	(SortOrder)(0),                   // 24: construct.v1.SortOrder
 }
 var file_construct_v1_agent_proto_depIdxs = []int32{
 	1,  // 0: construct.v1.Agent.metadata:type_name -> construct.v1.AgentMetadata
 	2,  // 1: construct.v1.Agent.spec:type_name -> construct.v1.AgentSpec
-	14, // 2: construct.v1.AgentMetadata.created_at:type_name -> google.protobuf.Timestamp
-	14, // 3: construct.v1.AgentMetadata.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 4: construct.v1.CreateAgentResponse.agent:type_name -> construct.v1.Agent
-	0,  // 5: construct.v1.GetAgentResponse.agent:type_name -> construct.v1.Agent
-	13, // 6: construct.v1.ListAgentsRequest.filter:type_name -> construct.v1.ListAgentsRequest.Filter
-	15, // 7: construct.v1.ListAgentsRequest.sort_field:type_name -> construct.v1.SortField
-	16, // 8: construct.v1.ListAgentsRequest.sort_order:type_name -> construct.v1.SortOrder
-	0,  // 9: construct.v1.ListAgentsResponse.agents:type_name -> construct.v1.Agent
-	0,  // 10: construct.v1.UpdateAgentResponse.agent:type_name -> construct.v1.Agent
-	3,  // 11: construct.v1.AgentService.CreateAgent:input_type -> construct.v1.CreateAgentRequest
-	5,  // 12: construct.v1.AgentService.GetAgent:input_type -> construct.v1.GetAgentRequest
-	7,  // 13: construct.v1.AgentService.ListAgents:input_type -> construct.v1.ListAgentsRequest
-	9,  // 14: construct.v1.AgentService.UpdateAgent:input_type -> construct.v1.UpdateAgentRequest
-	11, // 15: construct.v1.AgentService.DeleteAgent:input_type -> construct.v1.DeleteAgentRequest
-	4,  // 16: construct.v1.AgentService.CreateAgent:output_type -> construct.v1.CreateAgentResponse
-	6,  // 17: construct.v1.AgentService.GetAgent:output_type -> construct.v1.GetAgentResponse
-	8,  // 18: construct.v1.AgentService.ListAgents:output_type -> construct.v1.ListAgentsResponse
-	10, // 19: construct.v1.AgentService.UpdateAgent:output_type -> construct.v1.UpdateAgentResponse
-	12, // 20: construct.v1.AgentService.DeleteAgent:output_type -> construct.v1.DeleteAgentResponse
-	16, // [16:21] is the sub-list for method output_type
-	11, // [11:16] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	22, // 2: construct.v1.AgentMetadata.created_at:type_name -> google.protobuf.Timestamp
+	22, // 3: construct.v1.AgentMetadata.updated_at:type_name -> google.protobuf.Timestamp
+	5,  // 4: construct.v1.AgentSpec.mcp_servers:type_name -> construct.v1.McpServer
+	4,  // 5: construct.v1.AgentSpec.tool_set:type_name -> construct.v1.ToolSet
+	3,  // 6: construct.v1.AgentSpec.script_limits:type_name -> construct.v1.ScriptLimits
+	17, // 7: construct.v1.McpServer.stdio:type_name -> construct.v1.McpServer.StdioTransport
+	18, // 8: construct.v1.McpServer.http:type_name -> construct.v1.McpServer.HttpTransport
+	5,  // 9: construct.v1.McpServers.servers:type_name -> construct.v1.McpServer
+	5,  // 10: construct.v1.CreateAgentRequest.mcp_servers:type_name -> construct.v1.McpServer
+	4,  // 11: construct.v1.CreateAgentRequest.tool_set:type_name -> construct.v1.ToolSet
+	3,  // 12: construct.v1.CreateAgentRequest.script_limits:type_name -> construct.v1.ScriptLimits
+	0,  // 13: construct.v1.CreateAgentResponse.agent:type_name -> construct.v1.Agent
+	0,  // 14: construct.v1.GetAgentResponse.agent:type_name -> construct.v1.Agent
+	21, // 15: construct.v1.ListAgentsRequest.filter:type_name -> construct.v1.ListAgentsRequest.Filter
+	23, // 16: construct.v1.ListAgentsRequest.sort_field:type_name -> construct.v1.SortField
+	24, // 17: construct.v1.ListAgentsRequest.sort_order:type_name -> construct.v1.SortOrder
+	0,  // 18: construct.v1.ListAgentsResponse.agents:type_name -> construct.v1.Agent
+	6,  // 19: construct.v1.UpdateAgentRequest.mcp_servers:type_name -> construct.v1.McpServers
+	4,  // 20: construct.v1.UpdateAgentRequest.tool_set:type_name -> construct.v1.ToolSet
+	3,  // 21: construct.v1.UpdateAgentRequest.script_limits:type_name -> construct.v1.ScriptLimits
+	0,  // 22: construct.v1.UpdateAgentResponse.agent:type_name -> construct.v1.Agent
+	19, // 23: construct.v1.McpServer.StdioTransport.env:type_name -> construct.v1.McpServer.StdioTransport.EnvEntry
+	20, // 24: construct.v1.McpServer.HttpTransport.headers:type_name -> construct.v1.McpServer.HttpTransport.HeadersEntry
+	7,  // 25: construct.v1.AgentService.CreateAgent:input_type -> construct.v1.CreateAgentRequest
+	9,  // 26: construct.v1.AgentService.GetAgent:input_type -> construct.v1.GetAgentRequest
+	11, // 27: construct.v1.AgentService.ListAgents:input_type -> construct.v1.ListAgentsRequest
+	13, // 28: construct.v1.AgentService.UpdateAgent:input_type -> construct.v1.UpdateAgentRequest
+	15, // 29: construct.v1.AgentService.DeleteAgent:input_type -> construct.v1.DeleteAgentRequest
+	8,  // 30: construct.v1.AgentService.CreateAgent:output_type -> construct.v1.CreateAgentResponse
+	10, // 31: construct.v1.AgentService.GetAgent:output_type -> construct.v1.GetAgentResponse
+	12, // 32: construct.v1.AgentService.ListAgents:output_type -> construct.v1.ListAgentsResponse
+	14, // 33: construct.v1.AgentService.UpdateAgent:output_type -> construct.v1.UpdateAgentResponse
+	16, // 34: construct.v1.AgentService.DeleteAgent:output_type -> construct.v1.DeleteAgentResponse
+	30, // [30:35] is the sub-list for method output_type
+	25, // [25:30] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_construct_v1_agent_proto_init() }
@@ -965,15 +1508,19 @@ func file_construct_v1_agent_proto_init() {
 		return
 	}
 	file_construct_v1_common_proto_init()
-	file_construct_v1_agent_proto_msgTypes[7].OneofWrappers = []any{}
-	file_construct_v1_agent_proto_msgTypes[9].OneofWrappers = []any{}
+	file_construct_v1_agent_proto_msgTypes[5].OneofWrappers = []any{
+		(*McpServer_Stdio)(nil),
+		(*McpServer_Http)(nil),
+	}
+	file_construct_v1_agent_proto_msgTypes[11].OneofWrappers = []any{}
+	file_construct_v1_agent_proto_msgTypes[13].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_construct_v1_agent_proto_rawDesc), len(file_construct_v1_agent_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
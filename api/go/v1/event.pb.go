@@ -0,0 +1,638 @@
+// Event API streams the events of the whole daemon, so that clients can follow
+// all running work without polling the individual services.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: construct/v1/event.proto
+
+package v1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EventType identifies the kind of event.
+type EventType int32
+
+const (
+	// EVENT_TYPE_UNSPECIFIED is the default value and should not be used.
+	EventType_EVENT_TYPE_UNSPECIFIED EventType = 0
+	// EVENT_TYPE_TASK_CREATED is emitted when a task is created.
+	EventType_EVENT_TYPE_TASK_CREATED EventType = 1
+	// EVENT_TYPE_TASK_UPDATED is emitted when the specification of a task is changed.
+	EventType_EVENT_TYPE_TASK_UPDATED EventType = 2
+	// EVENT_TYPE_TASK_DELETED is emitted when a task is deleted.
+	EventType_EVENT_TYPE_TASK_DELETED EventType = 3
+	// EVENT_TYPE_TASK_PHASE_CHANGED is emitted when a task moves to a different phase.
+	EventType_EVENT_TYPE_TASK_PHASE_CHANGED EventType = 4
+	// EVENT_TYPE_AGENT_CREATED is emitted when an agent is created.
+	EventType_EVENT_TYPE_AGENT_CREATED EventType = 5
+	// EVENT_TYPE_AGENT_UPDATED is emitted when an agent is changed.
+	EventType_EVENT_TYPE_AGENT_UPDATED EventType = 6
+	// EVENT_TYPE_AGENT_DELETED is emitted when an agent is deleted.
+	EventType_EVENT_TYPE_AGENT_DELETED EventType = 7
+	// EVENT_TYPE_MODEL_CREATED is emitted when a model is created.
+	EventType_EVENT_TYPE_MODEL_CREATED EventType = 8
+	// EVENT_TYPE_MODEL_UPDATED is emitted when a model is changed.
+	EventType_EVENT_TYPE_MODEL_UPDATED EventType = 9
+	// EVENT_TYPE_MODEL_DELETED is emitted when a model is deleted.
+	EventType_EVENT_TYPE_MODEL_DELETED EventType = 10
+	// EVENT_TYPE_MESSAGE_COMPLETED is emitted when a message of a task has been fully stored.
+	EventType_EVENT_TYPE_MESSAGE_COMPLETED EventType = 11
+)
+
+// Enum value maps for EventType.
+var (
+	EventType_name = map[int32]string{
+		0:  "EVENT_TYPE_UNSPECIFIED",
+		1:  "EVENT_TYPE_TASK_CREATED",
+		2:  "EVENT_TYPE_TASK_UPDATED",
+		3:  "EVENT_TYPE_TASK_DELETED",
+		4:  "EVENT_TYPE_TASK_PHASE_CHANGED",
+		5:  "EVENT_TYPE_AGENT_CREATED",
+		6:  "EVENT_TYPE_AGENT_UPDATED",
+		7:  "EVENT_TYPE_AGENT_DELETED",
+		8:  "EVENT_TYPE_MODEL_CREATED",
+		9:  "EVENT_TYPE_MODEL_UPDATED",
+		10: "EVENT_TYPE_MODEL_DELETED",
+		11: "EVENT_TYPE_MESSAGE_COMPLETED",
+	}
+	EventType_value = map[string]int32{
+		"EVENT_TYPE_UNSPECIFIED":        0,
+		"EVENT_TYPE_TASK_CREATED":       1,
+		"EVENT_TYPE_TASK_UPDATED":       2,
+		"EVENT_TYPE_TASK_DELETED":       3,
+		"EVENT_TYPE_TASK_PHASE_CHANGED": 4,
+		"EVENT_TYPE_AGENT_CREATED":      5,
+		"EVENT_TYPE_AGENT_UPDATED":      6,
+		"EVENT_TYPE_AGENT_DELETED":      7,
+		"EVENT_TYPE_MODEL_CREATED":      8,
+		"EVENT_TYPE_MODEL_UPDATED":      9,
+		"EVENT_TYPE_MODEL_DELETED":      10,
+		"EVENT_TYPE_MESSAGE_COMPLETED":  11,
+	}
+)
+
+func (x EventType) Enum() *EventType {
+	p := new(EventType)
+	*p = x
+	return p
+}
+
+func (x EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_construct_v1_event_proto_enumTypes[0].Descriptor()
+}
+
+func (EventType) Type() protoreflect.EnumType {
+	return &file_construct_v1_event_proto_enumTypes[0]
+}
+
+func (x EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventType.Descriptor instead.
+func (EventType) EnumDescriptor() ([]byte, []int) {
+	return file_construct_v1_event_proto_rawDescGZIP(), []int{0}
+}
+
+// WatchEvent describes a single change in the daemon.
+type WatchEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// cursor identifies the position of the event in the stream. Pass it to WatchEvents
+	// to resume the stream after this event.
+	Cursor string `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// type is the kind of event.
+	Type EventType `protobuf:"varint,2,opt,name=type,proto3,enum=construct.v1.EventType" json:"type,omitempty"`
+	// time is the timestamp when the daemon recorded the event.
+	Time *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	// resource_id is the ID of the task, agent, model or message the event refers to (UUID format).
+	ResourceId string `protobuf:"bytes,4,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// task_id is the ID of the task for task and message events (UUID format).
+	TaskId *string `protobuf:"bytes,5,opt,name=task_id,json=taskId,proto3,oneof" json:"task_id,omitempty"`
+	// details carries additional information for some event types.
+	//
+	// Types that are valid to be assigned to Details:
+	//
+	//	*WatchEvent_PhaseChange
+	//	*WatchEvent_Message
+	Details       isWatchEvent_Details `protobuf_oneof:"details"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchEvent) Reset() {
+	*x = WatchEvent{}
+	mi := &file_construct_v1_event_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEvent) ProtoMessage() {}
+
+func (x *WatchEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_event_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEvent.ProtoReflect.Descriptor instead.
+func (*WatchEvent) Descriptor() ([]byte, []int) {
+	return file_construct_v1_event_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WatchEvent) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *WatchEvent) GetType() EventType {
+	if x != nil {
+		return x.Type
+	}
+	return EventType_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *WatchEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *WatchEvent) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *WatchEvent) GetTaskId() string {
+	if x != nil && x.TaskId != nil {
+		return *x.TaskId
+	}
+	return ""
+}
+
+func (x *WatchEvent) GetDetails() isWatchEvent_Details {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *WatchEvent) GetPhaseChange() *TaskPhaseChange {
+	if x != nil {
+		if x, ok := x.Details.(*WatchEvent_PhaseChange); ok {
+			return x.PhaseChange
+		}
+	}
+	return nil
+}
+
+func (x *WatchEvent) GetMessage() *MessageCompletion {
+	if x != nil {
+		if x, ok := x.Details.(*WatchEvent_Message); ok {
+			return x.Message
+		}
+	}
+	return nil
+}
+
+type isWatchEvent_Details interface {
+	isWatchEvent_Details()
+}
+
+type WatchEvent_PhaseChange struct {
+	// phase_change is set for EVENT_TYPE_TASK_PHASE_CHANGED.
+	PhaseChange *TaskPhaseChange `protobuf:"bytes,6,opt,name=phase_change,json=phaseChange,proto3,oneof"`
+}
+
+type WatchEvent_Message struct {
+	// message is set for EVENT_TYPE_MESSAGE_COMPLETED.
+	Message *MessageCompletion `protobuf:"bytes,7,opt,name=message,proto3,oneof"`
+}
+
+func (*WatchEvent_PhaseChange) isWatchEvent_Details() {}
+
+func (*WatchEvent_Message) isWatchEvent_Details() {}
+
+// TaskPhaseChange describes the phase transition of a task.
+type TaskPhaseChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// phase is the new phase of the task.
+	Phase TaskPhase `protobuf:"varint,1,opt,name=phase,proto3,enum=construct.v1.TaskPhase" json:"phase,omitempty"`
+	// previous_phase is the phase the task was in before.
+	PreviousPhase TaskPhase `protobuf:"varint,2,opt,name=previous_phase,json=previousPhase,proto3,enum=construct.v1.TaskPhase" json:"previous_phase,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskPhaseChange) Reset() {
+	*x = TaskPhaseChange{}
+	mi := &file_construct_v1_event_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskPhaseChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskPhaseChange) ProtoMessage() {}
+
+func (x *TaskPhaseChange) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_event_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskPhaseChange.ProtoReflect.Descriptor instead.
+func (*TaskPhaseChange) Descriptor() ([]byte, []int) {
+	return file_construct_v1_event_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TaskPhaseChange) GetPhase() TaskPhase {
+	if x != nil {
+		return x.Phase
+	}
+	return TaskPhase_TASK_PHASE_UNSPECIFIED
+}
+
+func (x *TaskPhaseChange) GetPreviousPhase() TaskPhase {
+	if x != nil {
+		return x.PreviousPhase
+	}
+	return TaskPhase_TASK_PHASE_UNSPECIFIED
+}
+
+// MessageCompletion describes a message that has been fully stored.
+type MessageCompletion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// role is the role of the author of the message.
+	Role          MessageRole `protobuf:"varint,1,opt,name=role,proto3,enum=construct.v1.MessageRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageCompletion) Reset() {
+	*x = MessageCompletion{}
+	mi := &file_construct_v1_event_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageCompletion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageCompletion) ProtoMessage() {}
+
+func (x *MessageCompletion) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_event_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageCompletion.ProtoReflect.Descriptor instead.
+func (*MessageCompletion) Descriptor() ([]byte, []int) {
+	return file_construct_v1_event_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MessageCompletion) GetRole() MessageRole {
+	if x != nil {
+		return x.Role
+	}
+	return MessageRole_MESSAGE_ROLE_UNSPECIFIED
+}
+
+// WatchEventsRequest specifies which events to stream and where to resume.
+type WatchEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// filter specifies criteria for narrowing the results.
+	Filter *WatchEventsRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// cursor resumes the stream after the event with this cursor. Without a cursor only
+	// events that happen after the call are streamed.
+	Cursor        *string `protobuf:"bytes,2,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchEventsRequest) Reset() {
+	*x = WatchEventsRequest{}
+	mi := &file_construct_v1_event_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEventsRequest) ProtoMessage() {}
+
+func (x *WatchEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_event_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEventsRequest.ProtoReflect.Descriptor instead.
+func (*WatchEventsRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_event_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WatchEventsRequest) GetFilter() *WatchEventsRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *WatchEventsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+// WatchEventsResponse contains a single event.
+type WatchEventsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// event is the streamed event.
+	Event         *WatchEvent `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchEventsResponse) Reset() {
+	*x = WatchEventsResponse{}
+	mi := &file_construct_v1_event_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEventsResponse) ProtoMessage() {}
+
+func (x *WatchEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_event_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEventsResponse.ProtoReflect.Descriptor instead.
+func (*WatchEventsResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_event_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WatchEventsResponse) GetEvent() *WatchEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+// Filter specifies criteria for narrowing the streamed events.
+type WatchEventsRequest_Filter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// types restricts the stream to the given event types. All types are streamed if empty.
+	Types []EventType `protobuf:"varint,1,rep,packed,name=types,proto3,enum=construct.v1.EventType" json:"types,omitempty"`
+	// task_id restricts the stream to the events of a single task (UUID format).
+	TaskId        *string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3,oneof" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchEventsRequest_Filter) Reset() {
+	*x = WatchEventsRequest_Filter{}
+	mi := &file_construct_v1_event_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEventsRequest_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEventsRequest_Filter) ProtoMessage() {}
+
+func (x *WatchEventsRequest_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_event_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEventsRequest_Filter.ProtoReflect.Descriptor instead.
+func (*WatchEventsRequest_Filter) Descriptor() ([]byte, []int) {
+	return file_construct_v1_event_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *WatchEventsRequest_Filter) GetTypes() []EventType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *WatchEventsRequest_Filter) GetTaskId() string {
+	if x != nil && x.TaskId != nil {
+		return *x.TaskId
+	}
+	return ""
+}
+
+var File_construct_v1_event_proto protoreflect.FileDescriptor
+
+const file_construct_v1_event_proto_rawDesc = "" +
+	"\n" +
+	"\x18construct/v1/event.proto\x12\fconstruct.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1aconstruct/v1/message.proto\x1a\x17construct/v1/task.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x87\x03\n" +
+	"\n" +
+	"WatchEvent\x12\x1f\n" +
+	"\x06cursor\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06cursor\x125\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x17.construct.v1.EventTypeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04type\x126\n" +
+	"\x04time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\x04time\x12)\n" +
+	"\vresource_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"resourceId\x12&\n" +
+	"\atask_id\x18\x05 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x01R\x06taskId\x88\x01\x01\x12B\n" +
+	"\fphase_change\x18\x06 \x01(\v2\x1d.construct.v1.TaskPhaseChangeH\x00R\vphaseChange\x12;\n" +
+	"\amessage\x18\a \x01(\v2\x1f.construct.v1.MessageCompletionH\x00R\amessageB\t\n" +
+	"\adetailsB\n" +
+	"\n" +
+	"\b_task_id\"\x94\x01\n" +
+	"\x0fTaskPhaseChange\x127\n" +
+	"\x05phase\x18\x01 \x01(\x0e2\x17.construct.v1.TaskPhaseB\b\xbaH\x05\x82\x01\x02\x10\x01R\x05phase\x12H\n" +
+	"\x0eprevious_phase\x18\x02 \x01(\x0e2\x17.construct.v1.TaskPhaseB\b\xbaH\x05\x82\x01\x02\x10\x01R\rpreviousPhase\"L\n" +
+	"\x11MessageCompletion\x127\n" +
+	"\x04role\x18\x01 \x01(\x0e2\x19.construct.v1.MessageRoleB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04role\"\xf9\x01\n" +
+	"\x12WatchEventsRequest\x12?\n" +
+	"\x06filter\x18\x01 \x01(\v2'.construct.v1.WatchEventsRequest.FilterR\x06filter\x12\x1b\n" +
+	"\x06cursor\x18\x02 \x01(\tH\x00R\x06cursor\x88\x01\x01\x1az\n" +
+	"\x06Filter\x12<\n" +
+	"\x05types\x18\x01 \x03(\x0e2\x17.construct.v1.EventTypeB\r\xbaH\n" +
+	"\x92\x01\a\"\x05\x82\x01\x02\x10\x01R\x05types\x12&\n" +
+	"\atask_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x06taskId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_task_idB\t\n" +
+	"\a_cursor\"M\n" +
+	"\x13WatchEventsResponse\x126\n" +
+	"\x05event\x18\x01 \x01(\v2\x18.construct.v1.WatchEventB\x06\xbaH\x03\xc8\x01\x01R\x05event*\xf7\x02\n" +
+	"\tEventType\x12\x1a\n" +
+	"\x16EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17EVENT_TYPE_TASK_CREATED\x10\x01\x12\x1b\n" +
+	"\x17EVENT_TYPE_TASK_UPDATED\x10\x02\x12\x1b\n" +
+	"\x17EVENT_TYPE_TASK_DELETED\x10\x03\x12!\n" +
+	"\x1dEVENT_TYPE_TASK_PHASE_CHANGED\x10\x04\x12\x1c\n" +
+	"\x18EVENT_TYPE_AGENT_CREATED\x10\x05\x12\x1c\n" +
+	"\x18EVENT_TYPE_AGENT_UPDATED\x10\x06\x12\x1c\n" +
+	"\x18EVENT_TYPE_AGENT_DELETED\x10\a\x12\x1c\n" +
+	"\x18EVENT_TYPE_MODEL_CREATED\x10\b\x12\x1c\n" +
+	"\x18EVENT_TYPE_MODEL_UPDATED\x10\t\x12\x1c\n" +
+	"\x18EVENT_TYPE_MODEL_DELETED\x10\n" +
+	"\x12 \n" +
+	"\x1cEVENT_TYPE_MESSAGE_COMPLETED\x10\v2f\n" +
+	"\fEventService\x12V\n" +
+	"\vWatchEvents\x12 .construct.v1.WatchEventsRequest\x1a!.construct.v1.WatchEventsResponse\"\x000\x01B(Z&github.com/furisto/construct/api/go/v1b\x06proto3"
+
+var (
+	file_construct_v1_event_proto_rawDescOnce sync.Once
+	file_construct_v1_event_proto_rawDescData []byte
+)
+
+func file_construct_v1_event_proto_rawDescGZIP() []byte {
+	file_construct_v1_event_proto_rawDescOnce.Do(func() {
+		file_construct_v1_event_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_construct_v1_event_proto_rawDesc), len(file_construct_v1_event_proto_rawDesc)))
+	})
+	return file_construct_v1_event_proto_rawDescData
+}
+
+var file_construct_v1_event_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_construct_v1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_construct_v1_event_proto_goTypes = []any{
+	(EventType)(0),                    // 0: construct.v1.EventType
+	(*WatchEvent)(nil),                // 1: construct.v1.WatchEvent
+	(*TaskPhaseChange)(nil),           // 2: construct.v1.TaskPhaseChange
+	(*MessageCompletion)(nil),         // 3: construct.v1.MessageCompletion
+	(*WatchEventsRequest)(nil),        // 4: construct.v1.WatchEventsRequest
+	(*WatchEventsResponse)(nil),       // 5: construct.v1.WatchEventsResponse
+	(*WatchEventsRequest_Filter)(nil), // 6: construct.v1.WatchEventsRequest.Filter
+	(*timestamppb.Timestamp)(nil),     // 7: google.protobuf.Timestamp
+	(TaskPhase)(0),                    // 8: construct.v1.TaskPhase
+	(MessageRole)(0),                  // 9: construct.v1.MessageRole
+}
+var file_construct_v1_event_proto_depIdxs = []int32{
+	0,  // 0: construct.v1.WatchEvent.type:type_name -> construct.v1.EventType
+	7,  // 1: construct.v1.WatchEvent.time:type_name -> google.protobuf.Timestamp
+	2,  // 2: construct.v1.WatchEvent.phase_change:type_name -> construct.v1.TaskPhaseChange
+	3,  // 3: construct.v1.WatchEvent.message:type_name -> construct.v1.MessageCompletion
+	8,  // 4: construct.v1.TaskPhaseChange.phase:type_name -> construct.v1.TaskPhase
+	8,  // 5: construct.v1.TaskPhaseChange.previous_phase:type_name -> construct.v1.TaskPhase
+	9,  // 6: construct.v1.MessageCompletion.role:type_name -> construct.v1.MessageRole
+	6,  // 7: construct.v1.WatchEventsRequest.filter:type_name -> construct.v1.WatchEventsRequest.Filter
+	1,  // 8: construct.v1.WatchEventsResponse.event:type_name -> construct.v1.WatchEvent
+	0,  // 9: construct.v1.WatchEventsRequest.Filter.types:type_name -> construct.v1.EventType
+	4,  // 10: construct.v1.EventService.WatchEvents:input_type -> construct.v1.WatchEventsRequest
+	5,  // 11: construct.v1.EventService.WatchEvents:output_type -> construct.v1.WatchEventsResponse
+	11, // [11:12] is the sub-list for method output_type
+	10, // [10:11] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_construct_v1_event_proto_init() }
+func file_construct_v1_event_proto_init() {
+	if File_construct_v1_event_proto != nil {
+		return
+	}
+	file_construct_v1_message_proto_init()
+	file_construct_v1_task_proto_init()
+	file_construct_v1_event_proto_msgTypes[0].OneofWrappers = []any{
+		(*WatchEvent_PhaseChange)(nil),
+		(*WatchEvent_Message)(nil),
+	}
+	file_construct_v1_event_proto_msgTypes[3].OneofWrappers = []any{}
+	file_construct_v1_event_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_construct_v1_event_proto_rawDesc), len(file_construct_v1_event_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_construct_v1_event_proto_goTypes,
+		DependencyIndexes: file_construct_v1_event_proto_depIdxs,
+		EnumInfos:         file_construct_v1_event_proto_enumTypes,
+		MessageInfos:      file_construct_v1_event_proto_msgTypes,
+	}.Build()
+	File_construct_v1_event_proto = out.File
+	file_construct_v1_event_proto_goTypes = nil
+	file_construct_v1_event_proto_depIdxs = nil
+}
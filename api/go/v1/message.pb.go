@@ -27,6 +27,62 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// MessageDelivery controls how a user message is handled when it is sent while the
+// task is still working on a previous message.
+type MessageDelivery int32
+
+const (
+	// MESSAGE_DELIVERY_UNSPECIFIED defaults to MESSAGE_DELIVERY_QUEUE.
+	MessageDelivery_MESSAGE_DELIVERY_UNSPECIFIED MessageDelivery = 0
+	// MESSAGE_DELIVERY_QUEUE processes the message once the work in flight has finished.
+	// Queued messages are processed in the order they were sent.
+	MessageDelivery_MESSAGE_DELIVERY_QUEUE MessageDelivery = 1
+	// MESSAGE_DELIVERY_STEER interrupts the current model invocation or tool execution
+	// and processes the message ahead of any queued messages.
+	MessageDelivery_MESSAGE_DELIVERY_STEER MessageDelivery = 2
+)
+
+// Enum value maps for MessageDelivery.
+var (
+	MessageDelivery_name = map[int32]string{
+		0: "MESSAGE_DELIVERY_UNSPECIFIED",
+		1: "MESSAGE_DELIVERY_QUEUE",
+		2: "MESSAGE_DELIVERY_STEER",
+	}
+	MessageDelivery_value = map[string]int32{
+		"MESSAGE_DELIVERY_UNSPECIFIED": 0,
+		"MESSAGE_DELIVERY_QUEUE":       1,
+		"MESSAGE_DELIVERY_STEER":       2,
+	}
+)
+
+func (x MessageDelivery) Enum() *MessageDelivery {
+	p := new(MessageDelivery)
+	*p = x
+	return p
+}
+
+func (x MessageDelivery) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MessageDelivery) Descriptor() protoreflect.EnumDescriptor {
+	return file_construct_v1_message_proto_enumTypes[0].Descriptor()
+}
+
+func (MessageDelivery) Type() protoreflect.EnumType {
+	return &file_construct_v1_message_proto_enumTypes[0]
+}
+
+func (x MessageDelivery) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MessageDelivery.Descriptor instead.
+func (MessageDelivery) EnumDescriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{0}
+}
+
 type ContentStatus int32
 
 const (
@@ -60,11 +116,11 @@ func (x ContentStatus) String() string {
 }
 
 func (ContentStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_construct_v1_message_proto_enumTypes[0].Descriptor()
+	return file_construct_v1_message_proto_enumTypes[1].Descriptor()
 }
 
 func (ContentStatus) Type() protoreflect.EnumType {
-	return &file_construct_v1_message_proto_enumTypes[0]
+	return &file_construct_v1_message_proto_enumTypes[1]
 }
 
 func (x ContentStatus) Number() protoreflect.EnumNumber {
@@ -73,7 +129,7 @@ func (x ContentStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ContentStatus.Descriptor instead.
 func (ContentStatus) EnumDescriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{0}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{1}
 }
 
 // MessageRole indicates the source/author of a message in the conversation.
@@ -117,11 +173,11 @@ func (x MessageRole) String() string {
 }
 
 func (MessageRole) Descriptor() protoreflect.EnumDescriptor {
-	return file_construct_v1_message_proto_enumTypes[1].Descriptor()
+	return file_construct_v1_message_proto_enumTypes[2].Descriptor()
 }
 
 func (MessageRole) Type() protoreflect.EnumType {
-	return &file_construct_v1_message_proto_enumTypes[1]
+	return &file_construct_v1_message_proto_enumTypes[2]
 }
 
 func (x MessageRole) Number() protoreflect.EnumNumber {
@@ -130,7 +186,7 @@ func (x MessageRole) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MessageRole.Descriptor instead.
 func (MessageRole) EnumDescriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{1}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{2}
 }
 
 // Message represents a complete message entity with metadata, specification, and status.
@@ -301,7 +357,9 @@ func (x *MessageMetadata) GetRole() MessageRole {
 type MessageSpec struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// content contains the actual message content (text, images, etc.).
-	Content       []*MessagePart `protobuf:"bytes,1,rep,name=content,proto3" json:"content,omitempty"`
+	Content []*MessagePart `protobuf:"bytes,1,rep,name=content,proto3" json:"content,omitempty"`
+	// delivery describes how the message is handled when it arrives while the task is busy.
+	Delivery      MessageDelivery `protobuf:"varint,2,opt,name=delivery,proto3,enum=construct.v1.MessageDelivery" json:"delivery,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -343,6 +401,13 @@ func (x *MessageSpec) GetContent() []*MessagePart {
 	return nil
 }
 
+func (x *MessageSpec) GetDelivery() MessageDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return MessageDelivery_MESSAGE_DELIVERY_UNSPECIFIED
+}
+
 // MessageStatus contains the observed state and usage information of the message.
 type MessageStatus struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -615,7 +680,9 @@ type CreateMessageRequest struct {
 	// task_id references the task this message will belong to (UUID format).
 	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 	// content is the content of the message.
-	Content       []*MessagePart `protobuf:"bytes,2,rep,name=content,proto3" json:"content,omitempty"`
+	Content []*MessagePart `protobuf:"bytes,2,rep,name=content,proto3" json:"content,omitempty"`
+	// delivery controls whether the message is queued or steers the running task.
+	Delivery      MessageDelivery `protobuf:"varint,3,opt,name=delivery,proto3,enum=construct.v1.MessageDelivery" json:"delivery,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -664,6 +731,13 @@ func (x *CreateMessageRequest) GetContent() []*MessagePart {
 	return nil
 }
 
+func (x *CreateMessageRequest) GetDelivery() MessageDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return MessageDelivery_MESSAGE_DELIVERY_UNSPECIFIED
+}
+
 // CreateMessageResponse contains the newly created message.
 type CreateMessageResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1140,6 +1214,16 @@ type ToolCall struct {
 	//	*ToolCall_ReadFile
 	//	*ToolCall_SubmitReport
 	//	*ToolCall_CodeInterpreter
+	//	*ToolCall_CustomTool
+	//	*ToolCall_McpTool
+	//	*ToolCall_Delegate
+	//	*ToolCall_RunParallel
+	//	*ToolCall_DeleteFile
+	//	*ToolCall_MoveFile
+	//	*ToolCall_CopyFile
+	//	*ToolCall_ApplyPatch
+	//	*ToolCall_Git
+	//	*ToolCall_RunTests
 	Input         isToolCall_Input `protobuf_oneof:"Input"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1295,6 +1379,96 @@ func (x *ToolCall) GetCodeInterpreter() *ToolCall_CodeInterpreterInput {
 	return nil
 }
 
+func (x *ToolCall) GetCustomTool() *ToolCall_CustomToolInput {
+	if x != nil {
+		if x, ok := x.Input.(*ToolCall_CustomTool); ok {
+			return x.CustomTool
+		}
+	}
+	return nil
+}
+
+func (x *ToolCall) GetMcpTool() *ToolCall_McpToolInput {
+	if x != nil {
+		if x, ok := x.Input.(*ToolCall_McpTool); ok {
+			return x.McpTool
+		}
+	}
+	return nil
+}
+
+func (x *ToolCall) GetDelegate() *ToolCall_DelegateInput {
+	if x != nil {
+		if x, ok := x.Input.(*ToolCall_Delegate); ok {
+			return x.Delegate
+		}
+	}
+	return nil
+}
+
+func (x *ToolCall) GetRunParallel() *ToolCall_RunParallelInput {
+	if x != nil {
+		if x, ok := x.Input.(*ToolCall_RunParallel); ok {
+			return x.RunParallel
+		}
+	}
+	return nil
+}
+
+func (x *ToolCall) GetDeleteFile() *ToolCall_DeleteFileInput {
+	if x != nil {
+		if x, ok := x.Input.(*ToolCall_DeleteFile); ok {
+			return x.DeleteFile
+		}
+	}
+	return nil
+}
+
+func (x *ToolCall) GetMoveFile() *ToolCall_MoveFileInput {
+	if x != nil {
+		if x, ok := x.Input.(*ToolCall_MoveFile); ok {
+			return x.MoveFile
+		}
+	}
+	return nil
+}
+
+func (x *ToolCall) GetCopyFile() *ToolCall_CopyFileInput {
+	if x != nil {
+		if x, ok := x.Input.(*ToolCall_CopyFile); ok {
+			return x.CopyFile
+		}
+	}
+	return nil
+}
+
+func (x *ToolCall) GetApplyPatch() *ToolCall_ApplyPatchInput {
+	if x != nil {
+		if x, ok := x.Input.(*ToolCall_ApplyPatch); ok {
+			return x.ApplyPatch
+		}
+	}
+	return nil
+}
+
+func (x *ToolCall) GetGit() *ToolCall_GitInput {
+	if x != nil {
+		if x, ok := x.Input.(*ToolCall_Git); ok {
+			return x.Git
+		}
+	}
+	return nil
+}
+
+func (x *ToolCall) GetRunTests() *ToolCall_RunTestsInput {
+	if x != nil {
+		if x, ok := x.Input.(*ToolCall_RunTests); ok {
+			return x.RunTests
+		}
+	}
+	return nil
+}
+
 type isToolCall_Input interface {
 	isToolCall_Input()
 }
@@ -1343,6 +1517,46 @@ type ToolCall_CodeInterpreter struct {
 	CodeInterpreter *ToolCall_CodeInterpreterInput `protobuf:"bytes,13,opt,name=code_interpreter,json=codeInterpreter,proto3,oneof"`
 }
 
+type ToolCall_CustomTool struct {
+	CustomTool *ToolCall_CustomToolInput `protobuf:"bytes,14,opt,name=custom_tool,json=customTool,proto3,oneof"`
+}
+
+type ToolCall_McpTool struct {
+	McpTool *ToolCall_McpToolInput `protobuf:"bytes,15,opt,name=mcp_tool,json=mcpTool,proto3,oneof"`
+}
+
+type ToolCall_Delegate struct {
+	Delegate *ToolCall_DelegateInput `protobuf:"bytes,16,opt,name=delegate,proto3,oneof"`
+}
+
+type ToolCall_RunParallel struct {
+	RunParallel *ToolCall_RunParallelInput `protobuf:"bytes,17,opt,name=run_parallel,json=runParallel,proto3,oneof"`
+}
+
+type ToolCall_DeleteFile struct {
+	DeleteFile *ToolCall_DeleteFileInput `protobuf:"bytes,18,opt,name=delete_file,json=deleteFile,proto3,oneof"`
+}
+
+type ToolCall_MoveFile struct {
+	MoveFile *ToolCall_MoveFileInput `protobuf:"bytes,19,opt,name=move_file,json=moveFile,proto3,oneof"`
+}
+
+type ToolCall_CopyFile struct {
+	CopyFile *ToolCall_CopyFileInput `protobuf:"bytes,20,opt,name=copy_file,json=copyFile,proto3,oneof"`
+}
+
+type ToolCall_ApplyPatch struct {
+	ApplyPatch *ToolCall_ApplyPatchInput `protobuf:"bytes,21,opt,name=apply_patch,json=applyPatch,proto3,oneof"`
+}
+
+type ToolCall_Git struct {
+	Git *ToolCall_GitInput `protobuf:"bytes,22,opt,name=git,proto3,oneof"`
+}
+
+type ToolCall_RunTests struct {
+	RunTests *ToolCall_RunTestsInput `protobuf:"bytes,23,opt,name=run_tests,json=runTests,proto3,oneof"`
+}
+
 func (*ToolCall_CreateFile) isToolCall_Input() {}
 
 func (*ToolCall_EditFile) isToolCall_Input() {}
@@ -1365,6 +1579,26 @@ func (*ToolCall_SubmitReport) isToolCall_Input() {}
 
 func (*ToolCall_CodeInterpreter) isToolCall_Input() {}
 
+func (*ToolCall_CustomTool) isToolCall_Input() {}
+
+func (*ToolCall_McpTool) isToolCall_Input() {}
+
+func (*ToolCall_Delegate) isToolCall_Input() {}
+
+func (*ToolCall_RunParallel) isToolCall_Input() {}
+
+func (*ToolCall_DeleteFile) isToolCall_Input() {}
+
+func (*ToolCall_MoveFile) isToolCall_Input() {}
+
+func (*ToolCall_CopyFile) isToolCall_Input() {}
+
+func (*ToolCall_ApplyPatch) isToolCall_Input() {}
+
+func (*ToolCall_Git) isToolCall_Input() {}
+
+func (*ToolCall_RunTests) isToolCall_Input() {}
+
 type ToolResult struct {
 	state    protoimpl.MessageState `protogen:"open.v1"`
 	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1380,6 +1614,16 @@ type ToolResult struct {
 	//	*ToolResult_ReadFile
 	//	*ToolResult_SubmitReport
 	//	*ToolResult_CodeInterpreter
+	//	*ToolResult_CustomTool
+	//	*ToolResult_McpTool
+	//	*ToolResult_Delegate
+	//	*ToolResult_RunParallel
+	//	*ToolResult_DeleteFile
+	//	*ToolResult_MoveFile
+	//	*ToolResult_CopyFile
+	//	*ToolResult_ApplyPatch
+	//	*ToolResult_Git
+	//	*ToolResult_RunTests
 	Result        isToolResult_Result `protobuf_oneof:"result"`
 	Error         *ToolError          `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -1518,6 +1762,96 @@ func (x *ToolResult) GetCodeInterpreter() *ToolResult_CodeInterpreterResult {
 	return nil
 }
 
+func (x *ToolResult) GetCustomTool() *ToolResult_CustomToolResult {
+	if x != nil {
+		if x, ok := x.Result.(*ToolResult_CustomTool); ok {
+			return x.CustomTool
+		}
+	}
+	return nil
+}
+
+func (x *ToolResult) GetMcpTool() *ToolResult_McpToolResult {
+	if x != nil {
+		if x, ok := x.Result.(*ToolResult_McpTool); ok {
+			return x.McpTool
+		}
+	}
+	return nil
+}
+
+func (x *ToolResult) GetDelegate() *ToolResult_DelegateResult {
+	if x != nil {
+		if x, ok := x.Result.(*ToolResult_Delegate); ok {
+			return x.Delegate
+		}
+	}
+	return nil
+}
+
+func (x *ToolResult) GetRunParallel() *ToolResult_RunParallelResult {
+	if x != nil {
+		if x, ok := x.Result.(*ToolResult_RunParallel); ok {
+			return x.RunParallel
+		}
+	}
+	return nil
+}
+
+func (x *ToolResult) GetDeleteFile() *ToolResult_DeleteFileResult {
+	if x != nil {
+		if x, ok := x.Result.(*ToolResult_DeleteFile); ok {
+			return x.DeleteFile
+		}
+	}
+	return nil
+}
+
+func (x *ToolResult) GetMoveFile() *ToolResult_MoveFileResult {
+	if x != nil {
+		if x, ok := x.Result.(*ToolResult_MoveFile); ok {
+			return x.MoveFile
+		}
+	}
+	return nil
+}
+
+func (x *ToolResult) GetCopyFile() *ToolResult_CopyFileResult {
+	if x != nil {
+		if x, ok := x.Result.(*ToolResult_CopyFile); ok {
+			return x.CopyFile
+		}
+	}
+	return nil
+}
+
+func (x *ToolResult) GetApplyPatch() *ToolResult_ApplyPatchResult {
+	if x != nil {
+		if x, ok := x.Result.(*ToolResult_ApplyPatch); ok {
+			return x.ApplyPatch
+		}
+	}
+	return nil
+}
+
+func (x *ToolResult) GetGit() *ToolResult_GitResult {
+	if x != nil {
+		if x, ok := x.Result.(*ToolResult_Git); ok {
+			return x.Git
+		}
+	}
+	return nil
+}
+
+func (x *ToolResult) GetRunTests() *ToolResult_RunTestsResult {
+	if x != nil {
+		if x, ok := x.Result.(*ToolResult_RunTests); ok {
+			return x.RunTests
+		}
+	}
+	return nil
+}
+
 func (x *ToolResult) GetError() *ToolError {
 	if x != nil {
 		return x.Error
@@ -1565,6 +1899,46 @@ type ToolResult_CodeInterpreter struct {
 	CodeInterpreter *ToolResult_CodeInterpreterResult `protobuf:"bytes,11,opt,name=code_interpreter,json=codeInterpreter,proto3,oneof"`
 }
 
+type ToolResult_CustomTool struct {
+	CustomTool *ToolResult_CustomToolResult `protobuf:"bytes,12,opt,name=custom_tool,json=customTool,proto3,oneof"`
+}
+
+type ToolResult_McpTool struct {
+	McpTool *ToolResult_McpToolResult `protobuf:"bytes,14,opt,name=mcp_tool,json=mcpTool,proto3,oneof"`
+}
+
+type ToolResult_Delegate struct {
+	Delegate *ToolResult_DelegateResult `protobuf:"bytes,15,opt,name=delegate,proto3,oneof"`
+}
+
+type ToolResult_RunParallel struct {
+	RunParallel *ToolResult_RunParallelResult `protobuf:"bytes,16,opt,name=run_parallel,json=runParallel,proto3,oneof"`
+}
+
+type ToolResult_DeleteFile struct {
+	DeleteFile *ToolResult_DeleteFileResult `protobuf:"bytes,17,opt,name=delete_file,json=deleteFile,proto3,oneof"`
+}
+
+type ToolResult_MoveFile struct {
+	MoveFile *ToolResult_MoveFileResult `protobuf:"bytes,18,opt,name=move_file,json=moveFile,proto3,oneof"`
+}
+
+type ToolResult_CopyFile struct {
+	CopyFile *ToolResult_CopyFileResult `protobuf:"bytes,19,opt,name=copy_file,json=copyFile,proto3,oneof"`
+}
+
+type ToolResult_ApplyPatch struct {
+	ApplyPatch *ToolResult_ApplyPatchResult `protobuf:"bytes,20,opt,name=apply_patch,json=applyPatch,proto3,oneof"`
+}
+
+type ToolResult_Git struct {
+	Git *ToolResult_GitResult `protobuf:"bytes,21,opt,name=git,proto3,oneof"`
+}
+
+type ToolResult_RunTests struct {
+	RunTests *ToolResult_RunTestsResult `protobuf:"bytes,22,opt,name=run_tests,json=runTests,proto3,oneof"`
+}
+
 func (*ToolResult_CreateFile) isToolResult_Result() {}
 
 func (*ToolResult_EditFile) isToolResult_Result() {}
@@ -1583,6 +1957,26 @@ func (*ToolResult_SubmitReport) isToolResult_Result() {}
 
 func (*ToolResult_CodeInterpreter) isToolResult_Result() {}
 
+func (*ToolResult_CustomTool) isToolResult_Result() {}
+
+func (*ToolResult_McpTool) isToolResult_Result() {}
+
+func (*ToolResult_Delegate) isToolResult_Result() {}
+
+func (*ToolResult_RunParallel) isToolResult_Result() {}
+
+func (*ToolResult_DeleteFile) isToolResult_Result() {}
+
+func (*ToolResult_MoveFile) isToolResult_Result() {}
+
+func (*ToolResult_CopyFile) isToolResult_Result() {}
+
+func (*ToolResult_ApplyPatch) isToolResult_Result() {}
+
+func (*ToolResult_Git) isToolResult_Result() {}
+
+func (*ToolResult_RunTests) isToolResult_Result() {}
+
 type CreateFileToolResult struct {
 	state         protoimpl.MessageState      `protogen:"open.v1"`
 	Input         *CreateFileToolResult_Input `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
@@ -2851,28 +3245,29 @@ func (x *ToolCall_SubmitReportInput) GetNextSteps() string {
 	return ""
 }
 
-type ToolCall_EditFileInput_DiffPair struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Old           string                 `protobuf:"bytes,1,opt,name=old,proto3" json:"old,omitempty"`
-	New           string                 `protobuf:"bytes,2,opt,name=new,proto3" json:"new,omitempty"`
+// CustomToolInput is the input of a user defined tool.
+type ToolCall_CustomToolInput struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// arguments is the JSON encoded argument object the tool was called with.
+	Arguments     string `protobuf:"bytes,1,opt,name=arguments,proto3" json:"arguments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ToolCall_EditFileInput_DiffPair) Reset() {
-	*x = ToolCall_EditFileInput_DiffPair{}
+func (x *ToolCall_CustomToolInput) Reset() {
+	*x = ToolCall_CustomToolInput{}
 	mi := &file_construct_v1_message_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ToolCall_EditFileInput_DiffPair) String() string {
+func (x *ToolCall_CustomToolInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToolCall_EditFileInput_DiffPair) ProtoMessage() {}
+func (*ToolCall_CustomToolInput) ProtoMessage() {}
 
-func (x *ToolCall_EditFileInput_DiffPair) ProtoReflect() protoreflect.Message {
+func (x *ToolCall_CustomToolInput) ProtoReflect() protoreflect.Message {
 	mi := &file_construct_v1_message_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2884,46 +3279,45 @@ func (x *ToolCall_EditFileInput_DiffPair) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToolCall_EditFileInput_DiffPair.ProtoReflect.Descriptor instead.
-func (*ToolCall_EditFileInput_DiffPair) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 2, 0}
-}
-
-func (x *ToolCall_EditFileInput_DiffPair) GetOld() string {
-	if x != nil {
-		return x.Old
-	}
-	return ""
+// Deprecated: Use ToolCall_CustomToolInput.ProtoReflect.Descriptor instead.
+func (*ToolCall_CustomToolInput) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 11}
 }
 
-func (x *ToolCall_EditFileInput_DiffPair) GetNew() string {
+func (x *ToolCall_CustomToolInput) GetArguments() string {
 	if x != nil {
-		return x.New
+		return x.Arguments
 	}
 	return ""
 }
 
-type ToolResult_CodeInterpreterResult struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Output        string                 `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+// McpToolInput is the input of a request to a Model Context Protocol server.
+type ToolCall_McpToolInput struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// server is the name of the MCP server.
+	Server string `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	// tool is the name of the tool or, for resources and prompts, the MCP method.
+	Tool string `protobuf:"bytes,2,opt,name=tool,proto3" json:"tool,omitempty"`
+	// arguments is the JSON encoded argument object of the request.
+	Arguments     string `protobuf:"bytes,3,opt,name=arguments,proto3" json:"arguments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ToolResult_CodeInterpreterResult) Reset() {
-	*x = ToolResult_CodeInterpreterResult{}
+func (x *ToolCall_McpToolInput) Reset() {
+	*x = ToolCall_McpToolInput{}
 	mi := &file_construct_v1_message_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ToolResult_CodeInterpreterResult) String() string {
+func (x *ToolCall_McpToolInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToolResult_CodeInterpreterResult) ProtoMessage() {}
+func (*ToolCall_McpToolInput) ProtoMessage() {}
 
-func (x *ToolResult_CodeInterpreterResult) ProtoReflect() protoreflect.Message {
+func (x *ToolCall_McpToolInput) ProtoReflect() protoreflect.Message {
 	mi := &file_construct_v1_message_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2935,39 +3329,59 @@ func (x *ToolResult_CodeInterpreterResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToolResult_CodeInterpreterResult.ProtoReflect.Descriptor instead.
-func (*ToolResult_CodeInterpreterResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 0}
+// Deprecated: Use ToolCall_McpToolInput.ProtoReflect.Descriptor instead.
+func (*ToolCall_McpToolInput) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 12}
 }
 
-func (x *ToolResult_CodeInterpreterResult) GetOutput() string {
+func (x *ToolCall_McpToolInput) GetServer() string {
 	if x != nil {
-		return x.Output
+		return x.Server
 	}
 	return ""
 }
 
-type ToolResult_CreateFileResult struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Overwritten   bool                   `protobuf:"varint,1,opt,name=overwritten,proto3" json:"overwritten,omitempty"`
+func (x *ToolCall_McpToolInput) GetTool() string {
+	if x != nil {
+		return x.Tool
+	}
+	return ""
+}
+
+func (x *ToolCall_McpToolInput) GetArguments() string {
+	if x != nil {
+		return x.Arguments
+	}
+	return ""
+}
+
+// DelegateInput is the input of a delegation to another agent.
+type ToolCall_DelegateInput struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// agent is the name or ID of the agent the work is delegated to.
+	Agent string `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	// instructions is the initial message of the delegated task.
+	Instructions string `protobuf:"bytes,2,opt,name=instructions,proto3" json:"instructions,omitempty"`
+	// max_cost is the budget of the delegated task. Zero means the remaining budget of the parent.
+	MaxCost       float64 `protobuf:"fixed64,3,opt,name=max_cost,json=maxCost,proto3" json:"max_cost,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ToolResult_CreateFileResult) Reset() {
-	*x = ToolResult_CreateFileResult{}
+func (x *ToolCall_DelegateInput) Reset() {
+	*x = ToolCall_DelegateInput{}
 	mi := &file_construct_v1_message_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ToolResult_CreateFileResult) String() string {
+func (x *ToolCall_DelegateInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToolResult_CreateFileResult) ProtoMessage() {}
+func (*ToolCall_DelegateInput) ProtoMessage() {}
 
-func (x *ToolResult_CreateFileResult) ProtoReflect() protoreflect.Message {
+func (x *ToolCall_DelegateInput) ProtoReflect() protoreflect.Message {
 	mi := &file_construct_v1_message_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2979,40 +3393,56 @@ func (x *ToolResult_CreateFileResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToolResult_CreateFileResult.ProtoReflect.Descriptor instead.
-func (*ToolResult_CreateFileResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 1}
+// Deprecated: Use ToolCall_DelegateInput.ProtoReflect.Descriptor instead.
+func (*ToolCall_DelegateInput) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 13}
 }
 
-func (x *ToolResult_CreateFileResult) GetOverwritten() bool {
+func (x *ToolCall_DelegateInput) GetAgent() string {
 	if x != nil {
-		return x.Overwritten
+		return x.Agent
 	}
-	return false
+	return ""
 }
 
-type ToolResult_EditFileResult struct {
-	state         protoimpl.MessageState               `protogen:"open.v1"`
-	Path          string                               `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	PatchInfo     *ToolResult_EditFileResult_PatchInfo `protobuf:"bytes,2,opt,name=patch_info,json=patchInfo,proto3" json:"patch_info,omitempty"`
+func (x *ToolCall_DelegateInput) GetInstructions() string {
+	if x != nil {
+		return x.Instructions
+	}
+	return ""
+}
+
+func (x *ToolCall_DelegateInput) GetMaxCost() float64 {
+	if x != nil {
+		return x.MaxCost
+	}
+	return 0
+}
+
+// RunParallelInput is the input of a group of delegations that run concurrently.
+type ToolCall_RunParallelInput struct {
+	state protoimpl.MessageState    `protogen:"open.v1"`
+	Tasks []*ToolCall_DelegateInput `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	// concurrency is the number of tasks that run at the same time.
+	Concurrency   int32 `protobuf:"varint,2,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ToolResult_EditFileResult) Reset() {
-	*x = ToolResult_EditFileResult{}
+func (x *ToolCall_RunParallelInput) Reset() {
+	*x = ToolCall_RunParallelInput{}
 	mi := &file_construct_v1_message_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ToolResult_EditFileResult) String() string {
+func (x *ToolCall_RunParallelInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToolResult_EditFileResult) ProtoMessage() {}
+func (*ToolCall_RunParallelInput) ProtoMessage() {}
 
-func (x *ToolResult_EditFileResult) ProtoReflect() protoreflect.Message {
+func (x *ToolCall_RunParallelInput) ProtoReflect() protoreflect.Message {
 	mi := &file_construct_v1_message_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3024,49 +3454,47 @@ func (x *ToolResult_EditFileResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToolResult_EditFileResult.ProtoReflect.Descriptor instead.
-func (*ToolResult_EditFileResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 2}
+// Deprecated: Use ToolCall_RunParallelInput.ProtoReflect.Descriptor instead.
+func (*ToolCall_RunParallelInput) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 14}
 }
 
-func (x *ToolResult_EditFileResult) GetPath() string {
+func (x *ToolCall_RunParallelInput) GetTasks() []*ToolCall_DelegateInput {
 	if x != nil {
-		return x.Path
+		return x.Tasks
 	}
-	return ""
+	return nil
 }
 
-func (x *ToolResult_EditFileResult) GetPatchInfo() *ToolResult_EditFileResult_PatchInfo {
+func (x *ToolCall_RunParallelInput) GetConcurrency() int32 {
 	if x != nil {
-		return x.PatchInfo
+		return x.Concurrency
 	}
-	return nil
+	return 0
 }
 
-type ToolResult_ExecuteCommandResult struct {
+// DeleteFileInput is the input of a file deletion.
+type ToolCall_DeleteFileInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Stdout        string                 `protobuf:"bytes,1,opt,name=stdout,proto3" json:"stdout,omitempty"`
-	Stderr        string                 `protobuf:"bytes,2,opt,name=stderr,proto3" json:"stderr,omitempty"`
-	ExitCode      int32                  `protobuf:"varint,3,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
-	Command       string                 `protobuf:"bytes,4,opt,name=command,proto3" json:"command,omitempty"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ToolResult_ExecuteCommandResult) Reset() {
-	*x = ToolResult_ExecuteCommandResult{}
+func (x *ToolCall_DeleteFileInput) Reset() {
+	*x = ToolCall_DeleteFileInput{}
 	mi := &file_construct_v1_message_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ToolResult_ExecuteCommandResult) String() string {
+func (x *ToolCall_DeleteFileInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToolResult_ExecuteCommandResult) ProtoMessage() {}
+func (*ToolCall_DeleteFileInput) ProtoMessage() {}
 
-func (x *ToolResult_ExecuteCommandResult) ProtoReflect() protoreflect.Message {
+func (x *ToolCall_DeleteFileInput) ProtoReflect() protoreflect.Message {
 	mi := &file_construct_v1_message_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3078,63 +3506,167 @@ func (x *ToolResult_ExecuteCommandResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToolResult_ExecuteCommandResult.ProtoReflect.Descriptor instead.
-func (*ToolResult_ExecuteCommandResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 3}
+// Deprecated: Use ToolCall_DeleteFileInput.ProtoReflect.Descriptor instead.
+func (*ToolCall_DeleteFileInput) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 15}
 }
 
-func (x *ToolResult_ExecuteCommandResult) GetStdout() string {
+func (x *ToolCall_DeleteFileInput) GetPath() string {
 	if x != nil {
-		return x.Stdout
+		return x.Path
 	}
 	return ""
 }
 
-func (x *ToolResult_ExecuteCommandResult) GetStderr() string {
+// MoveFileInput is the input of a file move or rename.
+type ToolCall_MoveFileInput struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Source      string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Destination string                 `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	// overwrite replaces an existing file at the destination.
+	Overwrite     bool `protobuf:"varint,3,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolCall_MoveFileInput) Reset() {
+	*x = ToolCall_MoveFileInput{}
+	mi := &file_construct_v1_message_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolCall_MoveFileInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolCall_MoveFileInput) ProtoMessage() {}
+
+func (x *ToolCall_MoveFileInput) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[47]
 	if x != nil {
-		return x.Stderr
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolCall_MoveFileInput.ProtoReflect.Descriptor instead.
+func (*ToolCall_MoveFileInput) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 16}
+}
+
+func (x *ToolCall_MoveFileInput) GetSource() string {
+	if x != nil {
+		return x.Source
 	}
 	return ""
 }
 
-func (x *ToolResult_ExecuteCommandResult) GetExitCode() int32 {
+func (x *ToolCall_MoveFileInput) GetDestination() string {
 	if x != nil {
-		return x.ExitCode
+		return x.Destination
 	}
-	return 0
+	return ""
 }
 
-func (x *ToolResult_ExecuteCommandResult) GetCommand() string {
+func (x *ToolCall_MoveFileInput) GetOverwrite() bool {
 	if x != nil {
-		return x.Command
+		return x.Overwrite
+	}
+	return false
+}
+
+// CopyFileInput is the input of a file copy.
+type ToolCall_CopyFileInput struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Source      string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Destination string                 `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	// overwrite replaces an existing file at the destination.
+	Overwrite     bool `protobuf:"varint,3,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolCall_CopyFileInput) Reset() {
+	*x = ToolCall_CopyFileInput{}
+	mi := &file_construct_v1_message_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolCall_CopyFileInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolCall_CopyFileInput) ProtoMessage() {}
+
+func (x *ToolCall_CopyFileInput) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolCall_CopyFileInput.ProtoReflect.Descriptor instead.
+func (*ToolCall_CopyFileInput) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 17}
+}
+
+func (x *ToolCall_CopyFileInput) GetSource() string {
+	if x != nil {
+		return x.Source
 	}
 	return ""
 }
 
-type ToolResult_FindFileResult struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Files          []string               `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
-	TotalFiles     int32                  `protobuf:"varint,2,opt,name=total_files,json=totalFiles,proto3" json:"total_files,omitempty"`
-	TruncatedCount int32                  `protobuf:"varint,3,opt,name=truncated_count,json=truncatedCount,proto3" json:"truncated_count,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *ToolCall_CopyFileInput) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
 }
 
-func (x *ToolResult_FindFileResult) Reset() {
-	*x = ToolResult_FindFileResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[47]
+func (x *ToolCall_CopyFileInput) GetOverwrite() bool {
+	if x != nil {
+		return x.Overwrite
+	}
+	return false
+}
+
+// ApplyPatchInput is the input of a unified diff applied to the workspace.
+type ToolCall_ApplyPatchInput struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Patch string                 `protobuf:"bytes,1,opt,name=patch,proto3" json:"patch,omitempty"`
+	// dry_run checks the patch without writing any file.
+	DryRun        bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolCall_ApplyPatchInput) Reset() {
+	*x = ToolCall_ApplyPatchInput{}
+	mi := &file_construct_v1_message_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ToolResult_FindFileResult) String() string {
+func (x *ToolCall_ApplyPatchInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToolResult_FindFileResult) ProtoMessage() {}
+func (*ToolCall_ApplyPatchInput) ProtoMessage() {}
 
-func (x *ToolResult_FindFileResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[47]
+func (x *ToolCall_ApplyPatchInput) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3145,57 +3677,111 @@ func (x *ToolResult_FindFileResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToolResult_FindFileResult.ProtoReflect.Descriptor instead.
-func (*ToolResult_FindFileResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 4}
+// Deprecated: Use ToolCall_ApplyPatchInput.ProtoReflect.Descriptor instead.
+func (*ToolCall_ApplyPatchInput) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 18}
 }
 
-func (x *ToolResult_FindFileResult) GetFiles() []string {
+func (x *ToolCall_ApplyPatchInput) GetPatch() string {
 	if x != nil {
-		return x.Files
+		return x.Patch
 	}
-	return nil
+	return ""
 }
 
-func (x *ToolResult_FindFileResult) GetTotalFiles() int32 {
+func (x *ToolCall_ApplyPatchInput) GetDryRun() bool {
 	if x != nil {
-		return x.TotalFiles
+		return x.DryRun
 	}
-	return 0
+	return false
 }
 
-func (x *ToolResult_FindFileResult) GetTruncatedCount() int32 {
+// GitInput is the input of an operation of the git tool family.
+type ToolCall_GitInput struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// operation is one of status, diff, log, show, blame, branch, stash or commit.
+	Operation string `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	// arguments is the JSON encoded argument object of the operation.
+	Arguments     string `protobuf:"bytes,2,opt,name=arguments,proto3" json:"arguments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolCall_GitInput) Reset() {
+	*x = ToolCall_GitInput{}
+	mi := &file_construct_v1_message_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolCall_GitInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolCall_GitInput) ProtoMessage() {}
+
+func (x *ToolCall_GitInput) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[50]
 	if x != nil {
-		return x.TruncatedCount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-type ToolResult_GrepResult struct {
-	state          protoimpl.MessageState             `protogen:"open.v1"`
-	Matches        []*ToolResult_GrepResult_GrepMatch `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
-	TotalMatches   int32                              `protobuf:"varint,2,opt,name=total_matches,json=totalMatches,proto3" json:"total_matches,omitempty"`
-	TruncatedCount int32                              `protobuf:"varint,3,opt,name=truncated_count,json=truncatedCount,proto3" json:"truncated_count,omitempty"`
-	SearchedFiles  int32                              `protobuf:"varint,4,opt,name=searched_files,json=searchedFiles,proto3" json:"searched_files,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Deprecated: Use ToolCall_GitInput.ProtoReflect.Descriptor instead.
+func (*ToolCall_GitInput) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 19}
 }
 
-func (x *ToolResult_GrepResult) Reset() {
-	*x = ToolResult_GrepResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[48]
+func (x *ToolCall_GitInput) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *ToolCall_GitInput) GetArguments() string {
+	if x != nil {
+		return x.Arguments
+	}
+	return ""
+}
+
+// RunTestsInput is the input of a test run.
+type ToolCall_RunTestsInput struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// framework is empty if it was detected from the project files.
+	Framework string   `protobuf:"bytes,1,opt,name=framework,proto3" json:"framework,omitempty"`
+	Path      string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Tests     []string `protobuf:"bytes,3,rep,name=tests,proto3" json:"tests,omitempty"`
+	Filter    string   `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// command and report are set for the junit framework.
+	Command       string `protobuf:"bytes,5,opt,name=command,proto3" json:"command,omitempty"`
+	Report        string `protobuf:"bytes,6,opt,name=report,proto3" json:"report,omitempty"`
+	IncludePassed bool   `protobuf:"varint,7,opt,name=include_passed,json=includePassed,proto3" json:"include_passed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolCall_RunTestsInput) Reset() {
+	*x = ToolCall_RunTestsInput{}
+	mi := &file_construct_v1_message_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ToolResult_GrepResult) String() string {
+func (x *ToolCall_RunTestsInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToolResult_GrepResult) ProtoMessage() {}
+func (*ToolCall_RunTestsInput) ProtoMessage() {}
 
-func (x *ToolResult_GrepResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[48]
+func (x *ToolCall_RunTestsInput) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3206,62 +3792,1337 @@ func (x *ToolResult_GrepResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToolResult_GrepResult.ProtoReflect.Descriptor instead.
-func (*ToolResult_GrepResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 5}
+// Deprecated: Use ToolCall_RunTestsInput.ProtoReflect.Descriptor instead.
+func (*ToolCall_RunTestsInput) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 20}
+}
+
+func (x *ToolCall_RunTestsInput) GetFramework() string {
+	if x != nil {
+		return x.Framework
+	}
+	return ""
+}
+
+func (x *ToolCall_RunTestsInput) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ToolCall_RunTestsInput) GetTests() []string {
+	if x != nil {
+		return x.Tests
+	}
+	return nil
+}
+
+func (x *ToolCall_RunTestsInput) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ToolCall_RunTestsInput) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *ToolCall_RunTestsInput) GetReport() string {
+	if x != nil {
+		return x.Report
+	}
+	return ""
+}
+
+func (x *ToolCall_RunTestsInput) GetIncludePassed() bool {
+	if x != nil {
+		return x.IncludePassed
+	}
+	return false
+}
+
+type ToolCall_EditFileInput_DiffPair struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Old           string                 `protobuf:"bytes,1,opt,name=old,proto3" json:"old,omitempty"`
+	New           string                 `protobuf:"bytes,2,opt,name=new,proto3" json:"new,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolCall_EditFileInput_DiffPair) Reset() {
+	*x = ToolCall_EditFileInput_DiffPair{}
+	mi := &file_construct_v1_message_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolCall_EditFileInput_DiffPair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolCall_EditFileInput_DiffPair) ProtoMessage() {}
+
+func (x *ToolCall_EditFileInput_DiffPair) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolCall_EditFileInput_DiffPair.ProtoReflect.Descriptor instead.
+func (*ToolCall_EditFileInput_DiffPair) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 2, 0}
+}
+
+func (x *ToolCall_EditFileInput_DiffPair) GetOld() string {
+	if x != nil {
+		return x.Old
+	}
+	return ""
+}
+
+func (x *ToolCall_EditFileInput_DiffPair) GetNew() string {
+	if x != nil {
+		return x.New
+	}
+	return ""
+}
+
+type ToolResult_CodeInterpreterResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Output        string                 `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolResult_CodeInterpreterResult) Reset() {
+	*x = ToolResult_CodeInterpreterResult{}
+	mi := &file_construct_v1_message_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolResult_CodeInterpreterResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolResult_CodeInterpreterResult) ProtoMessage() {}
+
+func (x *ToolResult_CodeInterpreterResult) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolResult_CodeInterpreterResult.ProtoReflect.Descriptor instead.
+func (*ToolResult_CodeInterpreterResult) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 0}
+}
+
+func (x *ToolResult_CodeInterpreterResult) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+type ToolResult_CreateFileResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Overwritten   bool                   `protobuf:"varint,1,opt,name=overwritten,proto3" json:"overwritten,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolResult_CreateFileResult) Reset() {
+	*x = ToolResult_CreateFileResult{}
+	mi := &file_construct_v1_message_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolResult_CreateFileResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolResult_CreateFileResult) ProtoMessage() {}
+
+func (x *ToolResult_CreateFileResult) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolResult_CreateFileResult.ProtoReflect.Descriptor instead.
+func (*ToolResult_CreateFileResult) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 1}
+}
+
+func (x *ToolResult_CreateFileResult) GetOverwritten() bool {
+	if x != nil {
+		return x.Overwritten
+	}
+	return false
+}
+
+type ToolResult_EditFileResult struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Path          string                               `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	PatchInfo     *ToolResult_EditFileResult_PatchInfo `protobuf:"bytes,2,opt,name=patch_info,json=patchInfo,proto3" json:"patch_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolResult_EditFileResult) Reset() {
+	*x = ToolResult_EditFileResult{}
+	mi := &file_construct_v1_message_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolResult_EditFileResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolResult_EditFileResult) ProtoMessage() {}
+
+func (x *ToolResult_EditFileResult) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolResult_EditFileResult.ProtoReflect.Descriptor instead.
+func (*ToolResult_EditFileResult) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 2}
+}
+
+func (x *ToolResult_EditFileResult) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ToolResult_EditFileResult) GetPatchInfo() *ToolResult_EditFileResult_PatchInfo {
+	if x != nil {
+		return x.PatchInfo
+	}
+	return nil
+}
+
+type ToolResult_ExecuteCommandResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stdout        string                 `protobuf:"bytes,1,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr        string                 `protobuf:"bytes,2,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	ExitCode      int32                  `protobuf:"varint,3,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	Command       string                 `protobuf:"bytes,4,opt,name=command,proto3" json:"command,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolResult_ExecuteCommandResult) Reset() {
+	*x = ToolResult_ExecuteCommandResult{}
+	mi := &file_construct_v1_message_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolResult_ExecuteCommandResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolResult_ExecuteCommandResult) ProtoMessage() {}
+
+func (x *ToolResult_ExecuteCommandResult) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolResult_ExecuteCommandResult.ProtoReflect.Descriptor instead.
+func (*ToolResult_ExecuteCommandResult) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 3}
+}
+
+func (x *ToolResult_ExecuteCommandResult) GetStdout() string {
+	if x != nil {
+		return x.Stdout
+	}
+	return ""
+}
+
+func (x *ToolResult_ExecuteCommandResult) GetStderr() string {
+	if x != nil {
+		return x.Stderr
+	}
+	return ""
+}
+
+func (x *ToolResult_ExecuteCommandResult) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *ToolResult_ExecuteCommandResult) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+type ToolResult_FindFileResult struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Files          []string               `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	TotalFiles     int32                  `protobuf:"varint,2,opt,name=total_files,json=totalFiles,proto3" json:"total_files,omitempty"`
+	TruncatedCount int32                  `protobuf:"varint,3,opt,name=truncated_count,json=truncatedCount,proto3" json:"truncated_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ToolResult_FindFileResult) Reset() {
+	*x = ToolResult_FindFileResult{}
+	mi := &file_construct_v1_message_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolResult_FindFileResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolResult_FindFileResult) ProtoMessage() {}
+
+func (x *ToolResult_FindFileResult) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolResult_FindFileResult.ProtoReflect.Descriptor instead.
+func (*ToolResult_FindFileResult) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 4}
+}
+
+func (x *ToolResult_FindFileResult) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *ToolResult_FindFileResult) GetTotalFiles() int32 {
+	if x != nil {
+		return x.TotalFiles
+	}
+	return 0
+}
+
+func (x *ToolResult_FindFileResult) GetTruncatedCount() int32 {
+	if x != nil {
+		return x.TruncatedCount
+	}
+	return 0
+}
+
+type ToolResult_GrepResult struct {
+	state          protoimpl.MessageState             `protogen:"open.v1"`
+	Matches        []*ToolResult_GrepResult_GrepMatch `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	TotalMatches   int32                              `protobuf:"varint,2,opt,name=total_matches,json=totalMatches,proto3" json:"total_matches,omitempty"`
+	TruncatedCount int32                              `protobuf:"varint,3,opt,name=truncated_count,json=truncatedCount,proto3" json:"truncated_count,omitempty"`
+	SearchedFiles  int32                              `protobuf:"varint,4,opt,name=searched_files,json=searchedFiles,proto3" json:"searched_files,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ToolResult_GrepResult) Reset() {
+	*x = ToolResult_GrepResult{}
+	mi := &file_construct_v1_message_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolResult_GrepResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolResult_GrepResult) ProtoMessage() {}
+
+func (x *ToolResult_GrepResult) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolResult_GrepResult.ProtoReflect.Descriptor instead.
+func (*ToolResult_GrepResult) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 5}
+}
+
+func (x *ToolResult_GrepResult) GetMatches() []*ToolResult_GrepResult_GrepMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *ToolResult_GrepResult) GetTotalMatches() int32 {
+	if x != nil {
+		return x.TotalMatches
+	}
+	return 0
+}
+
+func (x *ToolResult_GrepResult) GetTruncatedCount() int32 {
+	if x != nil {
+		return x.TruncatedCount
+	}
+	return 0
+}
+
+func (x *ToolResult_GrepResult) GetSearchedFiles() int32 {
+	if x != nil {
+		return x.SearchedFiles
+	}
+	return 0
+}
+
+type ToolResult_ListFilesResult struct {
+	state         protoimpl.MessageState                       `protogen:"open.v1"`
+	Path          string                                       `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Entries       []*ToolResult_ListFilesResult_DirectoryEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolResult_ListFilesResult) Reset() {
+	*x = ToolResult_ListFilesResult{}
+	mi := &file_construct_v1_message_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolResult_ListFilesResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolResult_ListFilesResult) ProtoMessage() {}
+
+func (x *ToolResult_ListFilesResult) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolResult_ListFilesResult.ProtoReflect.Descriptor instead.
+func (*ToolResult_ListFilesResult) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 6}
+}
+
+func (x *ToolResult_ListFilesResult) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ToolResult_ListFilesResult) GetEntries() []*ToolResult_ListFilesResult_DirectoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type ToolResult_ReadFileResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolResult_ReadFileResult) Reset() {
+	*x = ToolResult_ReadFileResult{}
+	mi := &file_construct_v1_message_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolResult_ReadFileResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolResult_ReadFileResult) ProtoMessage() {}
+
+func (x *ToolResult_ReadFileResult) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolResult_ReadFileResult.ProtoReflect.Descriptor instead.
+func (*ToolResult_ReadFileResult) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 7}
+}
+
+func (x *ToolResult_ReadFileResult) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ToolResult_ReadFileResult) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type ToolResult_SubmitReportResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summary       string                 `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	Completed     bool                   `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	Deliverables  []string               `protobuf:"bytes,3,rep,name=deliverables,proto3" json:"deliverables,omitempty"`
+	NextSteps     string                 `protobuf:"bytes,4,opt,name=next_steps,json=nextSteps,proto3" json:"next_steps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolResult_SubmitReportResult) Reset() {
+	*x = ToolResult_SubmitReportResult{}
+	mi := &file_construct_v1_message_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolResult_SubmitReportResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolResult_SubmitReportResult) ProtoMessage() {}
+
+func (x *ToolResult_SubmitReportResult) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolResult_SubmitReportResult.ProtoReflect.Descriptor instead.
+func (*ToolResult_SubmitReportResult) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 8}
+}
+
+func (x *ToolResult_SubmitReportResult) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *ToolResult_SubmitReportResult) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *ToolResult_SubmitReportResult) GetDeliverables() []string {
+	if x != nil {
+		return x.Deliverables
+	}
+	return nil
+}
+
+func (x *ToolResult_SubmitReportResult) GetNextSteps() string {
+	if x != nil {
+		return x.NextSteps
+	}
+	return ""
+}
+
+// CustomToolResult is the output of a user defined tool.
+type ToolResult_CustomToolResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// output is the JSON encoded value the tool returned.
+	Output        string `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolResult_CustomToolResult) Reset() {
+	*x = ToolResult_CustomToolResult{}
+	mi := &file_construct_v1_message_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolResult_CustomToolResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolResult_CustomToolResult) ProtoMessage() {}
+
+func (x *ToolResult_CustomToolResult) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolResult_CustomToolResult.ProtoReflect.Descriptor instead.
+func (*ToolResult_CustomToolResult) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 9}
+}
+
+func (x *ToolResult_CustomToolResult) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+// McpToolResult is the output of a request to a Model Context Protocol server.
+type ToolResult_McpToolResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// output is the JSON encoded value the server returned.
+	Output        string `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolResult_McpToolResult) Reset() {
+	*x = ToolResult_McpToolResult{}
+	mi := &file_construct_v1_message_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolResult_McpToolResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolResult_McpToolResult) ProtoMessage() {}
+
+func (x *ToolResult_McpToolResult) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolResult_McpToolResult.ProtoReflect.Descriptor instead.
+func (*ToolResult_McpToolResult) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 10}
+}
+
+func (x *ToolResult_McpToolResult) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+// DelegateResult is the report of a delegated task.
+type ToolResult_DelegateResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// task_id is the ID of the delegated task.
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// agent is the name of the agent that worked on the delegated task.
+	Agent        string   `protobuf:"bytes,2,opt,name=agent,proto3" json:"agent,omitempty"`
+	Summary      string   `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	Completed    bool     `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
+	Deliverables []string `protobuf:"bytes,5,rep,name=deliverables,proto3" json:"deliverables,omitempty"`
+	NextSteps    string   `protobuf:"bytes,6,opt,name=next_steps,json=nextSteps,proto3" json:"next_steps,omitempty"`
+	// cost is the cost of the delegated task in USD.
+	Cost          float64 `protobuf:"fixed64,7,opt,name=cost,proto3" json:"cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolResult_DelegateResult) Reset() {
+	*x = ToolResult_DelegateResult{}
+	mi := &file_construct_v1_message_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolResult_DelegateResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolResult_DelegateResult) ProtoMessage() {}
+
+func (x *ToolResult_DelegateResult) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolResult_DelegateResult.ProtoReflect.Descriptor instead.
+func (*ToolResult_DelegateResult) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 11}
+}
+
+func (x *ToolResult_DelegateResult) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ToolResult_DelegateResult) GetAgent() string {
+	if x != nil {
+		return x.Agent
+	}
+	return ""
+}
+
+func (x *ToolResult_DelegateResult) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *ToolResult_DelegateResult) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *ToolResult_DelegateResult) GetDeliverables() []string {
+	if x != nil {
+		return x.Deliverables
+	}
+	return nil
+}
+
+func (x *ToolResult_DelegateResult) GetNextSteps() string {
+	if x != nil {
+		return x.NextSteps
+	}
+	return ""
+}
+
+func (x *ToolResult_DelegateResult) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+// RunParallelResult is the outcome of a group of delegations.
+type ToolResult_RunParallelResult struct {
+	state   protoimpl.MessageState                     `protogen:"open.v1"`
+	Results []*ToolResult_RunParallelResult_TaskResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// finished is the number of tasks that returned a result.
+	Finished int32 `protobuf:"varint,2,opt,name=finished,proto3" json:"finished,omitempty"`
+	// failed is the number of tasks that failed.
+	Failed int32 `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	// cost is the total cost of the finished tasks in USD.
+	Cost          float64 `protobuf:"fixed64,4,opt,name=cost,proto3" json:"cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolResult_RunParallelResult) Reset() {
+	*x = ToolResult_RunParallelResult{}
+	mi := &file_construct_v1_message_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolResult_RunParallelResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolResult_RunParallelResult) ProtoMessage() {}
+
+func (x *ToolResult_RunParallelResult) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolResult_RunParallelResult.ProtoReflect.Descriptor instead.
+func (*ToolResult_RunParallelResult) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 12}
+}
+
+func (x *ToolResult_RunParallelResult) GetResults() []*ToolResult_RunParallelResult_TaskResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ToolResult_RunParallelResult) GetFinished() int32 {
+	if x != nil {
+		return x.Finished
+	}
+	return 0
+}
+
+func (x *ToolResult_RunParallelResult) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ToolResult_RunParallelResult) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+// DeleteFileResult is the outcome of a file deletion.
+type ToolResult_DeleteFileResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolResult_DeleteFileResult) Reset() {
+	*x = ToolResult_DeleteFileResult{}
+	mi := &file_construct_v1_message_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolResult_DeleteFileResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolResult_DeleteFileResult) ProtoMessage() {}
+
+func (x *ToolResult_DeleteFileResult) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolResult_DeleteFileResult.ProtoReflect.Descriptor instead.
+func (*ToolResult_DeleteFileResult) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 13}
+}
+
+func (x *ToolResult_DeleteFileResult) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// MoveFileResult is the outcome of a file move or rename.
+type ToolResult_MoveFileResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// overwritten is true if an existing file at the destination was replaced.
+	Overwritten   bool `protobuf:"varint,1,opt,name=overwritten,proto3" json:"overwritten,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolResult_MoveFileResult) Reset() {
+	*x = ToolResult_MoveFileResult{}
+	mi := &file_construct_v1_message_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolResult_MoveFileResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolResult_MoveFileResult) ProtoMessage() {}
+
+func (x *ToolResult_MoveFileResult) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolResult_MoveFileResult.ProtoReflect.Descriptor instead.
+func (*ToolResult_MoveFileResult) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 14}
+}
+
+func (x *ToolResult_MoveFileResult) GetOverwritten() bool {
+	if x != nil {
+		return x.Overwritten
+	}
+	return false
+}
+
+// CopyFileResult is the outcome of a file copy.
+type ToolResult_CopyFileResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// overwritten is true if an existing file at the destination was replaced.
+	Overwritten   bool `protobuf:"varint,1,opt,name=overwritten,proto3" json:"overwritten,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolResult_CopyFileResult) Reset() {
+	*x = ToolResult_CopyFileResult{}
+	mi := &file_construct_v1_message_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolResult_CopyFileResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolResult_CopyFileResult) ProtoMessage() {}
+
+func (x *ToolResult_CopyFileResult) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolResult_CopyFileResult.ProtoReflect.Descriptor instead.
+func (*ToolResult_CopyFileResult) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 15}
+}
+
+func (x *ToolResult_CopyFileResult) GetOverwritten() bool {
+	if x != nil {
+		return x.Overwritten
+	}
+	return false
+}
+
+// ApplyPatchResult is the outcome of a unified diff applied to the workspace.
+type ToolResult_ApplyPatchResult struct {
+	state         protoimpl.MessageState                     `protogen:"open.v1"`
+	Files         []*ToolResult_ApplyPatchResult_PatchedFile `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	DryRun        bool                                       `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolResult_ApplyPatchResult) Reset() {
+	*x = ToolResult_ApplyPatchResult{}
+	mi := &file_construct_v1_message_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolResult_ApplyPatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolResult_ApplyPatchResult) ProtoMessage() {}
+
+func (x *ToolResult_ApplyPatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolResult_ApplyPatchResult.ProtoReflect.Descriptor instead.
+func (*ToolResult_ApplyPatchResult) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 16}
+}
+
+func (x *ToolResult_ApplyPatchResult) GetFiles() []*ToolResult_ApplyPatchResult_PatchedFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *ToolResult_ApplyPatchResult) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// GitResult is the outcome of an operation of the git tool family.
+type ToolResult_GitResult struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Operation string                 `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	// output is the JSON encoded result of the operation.
+	Output        string `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolResult_GitResult) Reset() {
+	*x = ToolResult_GitResult{}
+	mi := &file_construct_v1_message_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolResult_GitResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolResult_GitResult) ProtoMessage() {}
+
+func (x *ToolResult_GitResult) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolResult_GitResult.ProtoReflect.Descriptor instead.
+func (*ToolResult_GitResult) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 17}
+}
+
+func (x *ToolResult_GitResult) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *ToolResult_GitResult) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+// RunTestsResult is the outcome of a test run.
+type ToolResult_RunTestsResult struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Framework string                 `protobuf:"bytes,1,opt,name=framework,proto3" json:"framework,omitempty"`
+	Command   string                 `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	Success   bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Passed    int32                  `protobuf:"varint,4,opt,name=passed,proto3" json:"passed,omitempty"`
+	Failed    int32                  `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	Skipped   int32                  `protobuf:"varint,6,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// duration is in seconds.
+	Duration float64 `protobuf:"fixed64,7,opt,name=duration,proto3" json:"duration,omitempty"`
+	// tests lists the failed tests first, then the skipped and, if requested,
+	// the passed tests.
+	Tests     []*ToolResult_RunTestsResult_TestCase `protobuf:"bytes,8,rep,name=tests,proto3" json:"tests,omitempty"`
+	Truncated bool                                  `protobuf:"varint,9,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	// output is set if the run failed without a failing test, e.g. because
+	// the build failed.
+	Output        string `protobuf:"bytes,10,opt,name=output,proto3" json:"output,omitempty"`
+	TimedOut      bool   `protobuf:"varint,11,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolResult_RunTestsResult) Reset() {
+	*x = ToolResult_RunTestsResult{}
+	mi := &file_construct_v1_message_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolResult_RunTestsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolResult_RunTestsResult) ProtoMessage() {}
+
+func (x *ToolResult_RunTestsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolResult_RunTestsResult.ProtoReflect.Descriptor instead.
+func (*ToolResult_RunTestsResult) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 18}
+}
+
+func (x *ToolResult_RunTestsResult) GetFramework() string {
+	if x != nil {
+		return x.Framework
+	}
+	return ""
+}
+
+func (x *ToolResult_RunTestsResult) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *ToolResult_RunTestsResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ToolResult_RunTestsResult) GetPassed() int32 {
+	if x != nil {
+		return x.Passed
+	}
+	return 0
+}
+
+func (x *ToolResult_RunTestsResult) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ToolResult_RunTestsResult) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *ToolResult_RunTestsResult) GetDuration() float64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *ToolResult_RunTestsResult) GetTests() []*ToolResult_RunTestsResult_TestCase {
+	if x != nil {
+		return x.Tests
+	}
+	return nil
+}
+
+func (x *ToolResult_RunTestsResult) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *ToolResult_RunTestsResult) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *ToolResult_RunTestsResult) GetTimedOut() bool {
+	if x != nil {
+		return x.TimedOut
+	}
+	return false
+}
+
+type ToolResult_EditFileResult_PatchInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Patch         string                 `protobuf:"bytes,1,opt,name=patch,proto3" json:"patch,omitempty"`
+	LinesAdded    int32                  `protobuf:"varint,2,opt,name=lines_added,json=linesAdded,proto3" json:"lines_added,omitempty"`
+	LinesRemoved  int32                  `protobuf:"varint,3,opt,name=lines_removed,json=linesRemoved,proto3" json:"lines_removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolResult_EditFileResult_PatchInfo) Reset() {
+	*x = ToolResult_EditFileResult_PatchInfo{}
+	mi := &file_construct_v1_message_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolResult_EditFileResult_PatchInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolResult_EditFileResult_PatchInfo) ProtoMessage() {}
+
+func (x *ToolResult_EditFileResult_PatchInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *ToolResult_GrepResult) GetMatches() []*ToolResult_GrepResult_GrepMatch {
-	if x != nil {
-		return x.Matches
-	}
-	return nil
+// Deprecated: Use ToolResult_EditFileResult_PatchInfo.ProtoReflect.Descriptor instead.
+func (*ToolResult_EditFileResult_PatchInfo) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 2, 0}
 }
 
-func (x *ToolResult_GrepResult) GetTotalMatches() int32 {
+func (x *ToolResult_EditFileResult_PatchInfo) GetPatch() string {
 	if x != nil {
-		return x.TotalMatches
+		return x.Patch
 	}
-	return 0
+	return ""
 }
 
-func (x *ToolResult_GrepResult) GetTruncatedCount() int32 {
+func (x *ToolResult_EditFileResult_PatchInfo) GetLinesAdded() int32 {
 	if x != nil {
-		return x.TruncatedCount
+		return x.LinesAdded
 	}
 	return 0
 }
 
-func (x *ToolResult_GrepResult) GetSearchedFiles() int32 {
+func (x *ToolResult_EditFileResult_PatchInfo) GetLinesRemoved() int32 {
 	if x != nil {
-		return x.SearchedFiles
+		return x.LinesRemoved
 	}
 	return 0
 }
 
-type ToolResult_ListFilesResult struct {
-	state         protoimpl.MessageState                       `protogen:"open.v1"`
-	Path          string                                       `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Entries       []*ToolResult_ListFilesResult_DirectoryEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+type ToolResult_GrepResult_GrepMatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FilePath      string                 `protobuf:"bytes,1,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ToolResult_ListFilesResult) Reset() {
-	*x = ToolResult_ListFilesResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[49]
+func (x *ToolResult_GrepResult_GrepMatch) Reset() {
+	*x = ToolResult_GrepResult_GrepMatch{}
+	mi := &file_construct_v1_message_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ToolResult_ListFilesResult) String() string {
+func (x *ToolResult_GrepResult_GrepMatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToolResult_ListFilesResult) ProtoMessage() {}
+func (*ToolResult_GrepResult_GrepMatch) ProtoMessage() {}
 
-func (x *ToolResult_ListFilesResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[49]
+func (x *ToolResult_GrepResult_GrepMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3272,48 +5133,49 @@ func (x *ToolResult_ListFilesResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToolResult_ListFilesResult.ProtoReflect.Descriptor instead.
-func (*ToolResult_ListFilesResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 6}
+// Deprecated: Use ToolResult_GrepResult_GrepMatch.ProtoReflect.Descriptor instead.
+func (*ToolResult_GrepResult_GrepMatch) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 5, 0}
 }
 
-func (x *ToolResult_ListFilesResult) GetPath() string {
+func (x *ToolResult_GrepResult_GrepMatch) GetFilePath() string {
 	if x != nil {
-		return x.Path
+		return x.FilePath
 	}
 	return ""
 }
 
-func (x *ToolResult_ListFilesResult) GetEntries() []*ToolResult_ListFilesResult_DirectoryEntry {
+func (x *ToolResult_GrepResult_GrepMatch) GetValue() string {
 	if x != nil {
-		return x.Entries
+		return x.Value
 	}
-	return nil
+	return ""
 }
 
-type ToolResult_ReadFileResult struct {
+type ToolResult_ListFilesResult_DirectoryEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ToolResult_ReadFileResult) Reset() {
-	*x = ToolResult_ReadFileResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[50]
+func (x *ToolResult_ListFilesResult_DirectoryEntry) Reset() {
+	*x = ToolResult_ListFilesResult_DirectoryEntry{}
+	mi := &file_construct_v1_message_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ToolResult_ReadFileResult) String() string {
+func (x *ToolResult_ListFilesResult_DirectoryEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToolResult_ReadFileResult) ProtoMessage() {}
+func (*ToolResult_ListFilesResult_DirectoryEntry) ProtoMessage() {}
 
-func (x *ToolResult_ReadFileResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[50]
+func (x *ToolResult_ListFilesResult_DirectoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3324,50 +5186,57 @@ func (x *ToolResult_ReadFileResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToolResult_ReadFileResult.ProtoReflect.Descriptor instead.
-func (*ToolResult_ReadFileResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 7}
+// Deprecated: Use ToolResult_ListFilesResult_DirectoryEntry.ProtoReflect.Descriptor instead.
+func (*ToolResult_ListFilesResult_DirectoryEntry) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 6, 0}
 }
 
-func (x *ToolResult_ReadFileResult) GetPath() string {
+func (x *ToolResult_ListFilesResult_DirectoryEntry) GetName() string {
 	if x != nil {
-		return x.Path
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ToolResult_ReadFileResult) GetContent() string {
+func (x *ToolResult_ListFilesResult_DirectoryEntry) GetType() string {
 	if x != nil {
-		return x.Content
+		return x.Type
 	}
 	return ""
 }
 
-type ToolResult_SubmitReportResult struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Summary       string                 `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
-	Completed     bool                   `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
-	Deliverables  []string               `protobuf:"bytes,3,rep,name=deliverables,proto3" json:"deliverables,omitempty"`
-	NextSteps     string                 `protobuf:"bytes,4,opt,name=next_steps,json=nextSteps,proto3" json:"next_steps,omitempty"`
+func (x *ToolResult_ListFilesResult_DirectoryEntry) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// TaskResult is the outcome of one task of the group. Either result or error is set.
+type ToolResult_RunParallelResult_TaskResult struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Agent         string                     `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	Result        *ToolResult_DelegateResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	Error         string                     `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ToolResult_SubmitReportResult) Reset() {
-	*x = ToolResult_SubmitReportResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[51]
+func (x *ToolResult_RunParallelResult_TaskResult) Reset() {
+	*x = ToolResult_RunParallelResult_TaskResult{}
+	mi := &file_construct_v1_message_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ToolResult_SubmitReportResult) String() string {
+func (x *ToolResult_RunParallelResult_TaskResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToolResult_SubmitReportResult) ProtoMessage() {}
+func (*ToolResult_RunParallelResult_TaskResult) ProtoMessage() {}
 
-func (x *ToolResult_SubmitReportResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[51]
+func (x *ToolResult_RunParallelResult_TaskResult) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3378,63 +5247,63 @@ func (x *ToolResult_SubmitReportResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToolResult_SubmitReportResult.ProtoReflect.Descriptor instead.
-func (*ToolResult_SubmitReportResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 8}
+// Deprecated: Use ToolResult_RunParallelResult_TaskResult.ProtoReflect.Descriptor instead.
+func (*ToolResult_RunParallelResult_TaskResult) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 12, 0}
 }
 
-func (x *ToolResult_SubmitReportResult) GetSummary() string {
+func (x *ToolResult_RunParallelResult_TaskResult) GetAgent() string {
 	if x != nil {
-		return x.Summary
+		return x.Agent
 	}
 	return ""
 }
 
-func (x *ToolResult_SubmitReportResult) GetCompleted() bool {
-	if x != nil {
-		return x.Completed
-	}
-	return false
-}
-
-func (x *ToolResult_SubmitReportResult) GetDeliverables() []string {
+func (x *ToolResult_RunParallelResult_TaskResult) GetResult() *ToolResult_DelegateResult {
 	if x != nil {
-		return x.Deliverables
+		return x.Result
 	}
 	return nil
 }
 
-func (x *ToolResult_SubmitReportResult) GetNextSteps() string {
+func (x *ToolResult_RunParallelResult_TaskResult) GetError() string {
 	if x != nil {
-		return x.NextSteps
+		return x.Error
 	}
 	return ""
 }
 
-type ToolResult_EditFileResult_PatchInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Patch         string                 `protobuf:"bytes,1,opt,name=patch,proto3" json:"patch,omitempty"`
-	LinesAdded    int32                  `protobuf:"varint,2,opt,name=lines_added,json=linesAdded,proto3" json:"lines_added,omitempty"`
-	LinesRemoved  int32                  `protobuf:"varint,3,opt,name=lines_removed,json=linesRemoved,proto3" json:"lines_removed,omitempty"`
+// HunkResult reports where a hunk was applied or why it failed.
+type ToolResult_ApplyPatchResult_HunkResult struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Hunk    int32                  `protobuf:"varint,1,opt,name=hunk,proto3" json:"hunk,omitempty"`
+	Applied bool                   `protobuf:"varint,2,opt,name=applied,proto3" json:"applied,omitempty"`
+	// line is the 1-based line of the original file the hunk starts at.
+	Line int32 `protobuf:"varint,3,opt,name=line,proto3" json:"line,omitempty"`
+	// offset is the distance from the line given in the hunk header.
+	Offset int32 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	// fuzzy is true if whitespace or context lines were ignored.
+	Fuzzy         bool   `protobuf:"varint,5,opt,name=fuzzy,proto3" json:"fuzzy,omitempty"`
+	Error         string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ToolResult_EditFileResult_PatchInfo) Reset() {
-	*x = ToolResult_EditFileResult_PatchInfo{}
-	mi := &file_construct_v1_message_proto_msgTypes[52]
+func (x *ToolResult_ApplyPatchResult_HunkResult) Reset() {
+	*x = ToolResult_ApplyPatchResult_HunkResult{}
+	mi := &file_construct_v1_message_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ToolResult_EditFileResult_PatchInfo) String() string {
+func (x *ToolResult_ApplyPatchResult_HunkResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToolResult_EditFileResult_PatchInfo) ProtoMessage() {}
+func (*ToolResult_ApplyPatchResult_HunkResult) ProtoMessage() {}
 
-func (x *ToolResult_EditFileResult_PatchInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[52]
+func (x *ToolResult_ApplyPatchResult_HunkResult) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3445,55 +5314,79 @@ func (x *ToolResult_EditFileResult_PatchInfo) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToolResult_EditFileResult_PatchInfo.ProtoReflect.Descriptor instead.
-func (*ToolResult_EditFileResult_PatchInfo) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 2, 0}
+// Deprecated: Use ToolResult_ApplyPatchResult_HunkResult.ProtoReflect.Descriptor instead.
+func (*ToolResult_ApplyPatchResult_HunkResult) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 16, 0}
 }
 
-func (x *ToolResult_EditFileResult_PatchInfo) GetPatch() string {
+func (x *ToolResult_ApplyPatchResult_HunkResult) GetHunk() int32 {
 	if x != nil {
-		return x.Patch
+		return x.Hunk
 	}
-	return ""
+	return 0
 }
 
-func (x *ToolResult_EditFileResult_PatchInfo) GetLinesAdded() int32 {
+func (x *ToolResult_ApplyPatchResult_HunkResult) GetApplied() bool {
 	if x != nil {
-		return x.LinesAdded
+		return x.Applied
+	}
+	return false
+}
+
+func (x *ToolResult_ApplyPatchResult_HunkResult) GetLine() int32 {
+	if x != nil {
+		return x.Line
 	}
 	return 0
 }
 
-func (x *ToolResult_EditFileResult_PatchInfo) GetLinesRemoved() int32 {
+func (x *ToolResult_ApplyPatchResult_HunkResult) GetOffset() int32 {
 	if x != nil {
-		return x.LinesRemoved
+		return x.Offset
 	}
 	return 0
 }
 
-type ToolResult_GrepResult_GrepMatch struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FilePath      string                 `protobuf:"bytes,1,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
-	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+func (x *ToolResult_ApplyPatchResult_HunkResult) GetFuzzy() bool {
+	if x != nil {
+		return x.Fuzzy
+	}
+	return false
+}
+
+func (x *ToolResult_ApplyPatchResult_HunkResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ToolResult_ApplyPatchResult_PatchedFile struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Path  string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// action is one of created, modified or deleted.
+	Action        string                                    `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	PatchInfo     *ToolResult_EditFileResult_PatchInfo      `protobuf:"bytes,3,opt,name=patch_info,json=patchInfo,proto3" json:"patch_info,omitempty"`
+	Hunks         []*ToolResult_ApplyPatchResult_HunkResult `protobuf:"bytes,4,rep,name=hunks,proto3" json:"hunks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ToolResult_GrepResult_GrepMatch) Reset() {
-	*x = ToolResult_GrepResult_GrepMatch{}
-	mi := &file_construct_v1_message_proto_msgTypes[53]
+func (x *ToolResult_ApplyPatchResult_PatchedFile) Reset() {
+	*x = ToolResult_ApplyPatchResult_PatchedFile{}
+	mi := &file_construct_v1_message_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ToolResult_GrepResult_GrepMatch) String() string {
+func (x *ToolResult_ApplyPatchResult_PatchedFile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToolResult_GrepResult_GrepMatch) ProtoMessage() {}
+func (*ToolResult_ApplyPatchResult_PatchedFile) ProtoMessage() {}
 
-func (x *ToolResult_GrepResult_GrepMatch) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[53]
+func (x *ToolResult_ApplyPatchResult_PatchedFile) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3504,49 +5397,71 @@ func (x *ToolResult_GrepResult_GrepMatch) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToolResult_GrepResult_GrepMatch.ProtoReflect.Descriptor instead.
-func (*ToolResult_GrepResult_GrepMatch) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 5, 0}
+// Deprecated: Use ToolResult_ApplyPatchResult_PatchedFile.ProtoReflect.Descriptor instead.
+func (*ToolResult_ApplyPatchResult_PatchedFile) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 16, 1}
 }
 
-func (x *ToolResult_GrepResult_GrepMatch) GetFilePath() string {
+func (x *ToolResult_ApplyPatchResult_PatchedFile) GetPath() string {
 	if x != nil {
-		return x.FilePath
+		return x.Path
 	}
 	return ""
 }
 
-func (x *ToolResult_GrepResult_GrepMatch) GetValue() string {
+func (x *ToolResult_ApplyPatchResult_PatchedFile) GetAction() string {
 	if x != nil {
-		return x.Value
+		return x.Action
 	}
 	return ""
 }
 
-type ToolResult_ListFilesResult_DirectoryEntry struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+func (x *ToolResult_ApplyPatchResult_PatchedFile) GetPatchInfo() *ToolResult_EditFileResult_PatchInfo {
+	if x != nil {
+		return x.PatchInfo
+	}
+	return nil
+}
+
+func (x *ToolResult_ApplyPatchResult_PatchedFile) GetHunks() []*ToolResult_ApplyPatchResult_HunkResult {
+	if x != nil {
+		return x.Hunks
+	}
+	return nil
+}
+
+type ToolResult_RunTestsResult_TestCase struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// suite is the package, file or class of the test.
+	Suite string `protobuf:"bytes,2,opt,name=suite,proto3" json:"suite,omitempty"`
+	// status is one of passed, failed or skipped.
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// duration is in seconds.
+	Duration      float64 `protobuf:"fixed64,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	Message       string  `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	File          string  `protobuf:"bytes,6,opt,name=file,proto3" json:"file,omitempty"`
+	Line          int32   `protobuf:"varint,7,opt,name=line,proto3" json:"line,omitempty"`
+	Output        string  `protobuf:"bytes,8,opt,name=output,proto3" json:"output,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ToolResult_ListFilesResult_DirectoryEntry) Reset() {
-	*x = ToolResult_ListFilesResult_DirectoryEntry{}
-	mi := &file_construct_v1_message_proto_msgTypes[54]
+func (x *ToolResult_RunTestsResult_TestCase) Reset() {
+	*x = ToolResult_RunTestsResult_TestCase{}
+	mi := &file_construct_v1_message_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ToolResult_ListFilesResult_DirectoryEntry) String() string {
+func (x *ToolResult_RunTestsResult_TestCase) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToolResult_ListFilesResult_DirectoryEntry) ProtoMessage() {}
+func (*ToolResult_RunTestsResult_TestCase) ProtoMessage() {}
 
-func (x *ToolResult_ListFilesResult_DirectoryEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[54]
+func (x *ToolResult_RunTestsResult_TestCase) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3557,32 +5472,67 @@ func (x *ToolResult_ListFilesResult_DirectoryEntry) ProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToolResult_ListFilesResult_DirectoryEntry.ProtoReflect.Descriptor instead.
-func (*ToolResult_ListFilesResult_DirectoryEntry) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 6, 0}
+// Deprecated: Use ToolResult_RunTestsResult_TestCase.ProtoReflect.Descriptor instead.
+func (*ToolResult_RunTestsResult_TestCase) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 18, 0}
 }
 
-func (x *ToolResult_ListFilesResult_DirectoryEntry) GetName() string {
+func (x *ToolResult_RunTestsResult_TestCase) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *ToolResult_ListFilesResult_DirectoryEntry) GetType() string {
+func (x *ToolResult_RunTestsResult_TestCase) GetSuite() string {
 	if x != nil {
-		return x.Type
+		return x.Suite
 	}
 	return ""
 }
 
-func (x *ToolResult_ListFilesResult_DirectoryEntry) GetSize() int64 {
+func (x *ToolResult_RunTestsResult_TestCase) GetStatus() string {
 	if x != nil {
-		return x.Size
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ToolResult_RunTestsResult_TestCase) GetDuration() float64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *ToolResult_RunTestsResult_TestCase) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ToolResult_RunTestsResult_TestCase) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *ToolResult_RunTestsResult_TestCase) GetLine() int32 {
+	if x != nil {
+		return x.Line
 	}
 	return 0
 }
 
+func (x *ToolResult_RunTestsResult_TestCase) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
 type CreateFileToolResult_Input struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FilePath      string                 `protobuf:"bytes,1,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
@@ -3593,7 +5543,7 @@ type CreateFileToolResult_Input struct {
 
 func (x *CreateFileToolResult_Input) Reset() {
 	*x = CreateFileToolResult_Input{}
-	mi := &file_construct_v1_message_proto_msgTypes[55]
+	mi := &file_construct_v1_message_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3605,7 +5555,7 @@ func (x *CreateFileToolResult_Input) String() string {
 func (*CreateFileToolResult_Input) ProtoMessage() {}
 
 func (x *CreateFileToolResult_Input) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[55]
+	mi := &file_construct_v1_message_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3655,9 +5605,10 @@ const file_construct_v1_message_proto_rawDesc = "" +
 	"\bmodel_id\x18\x06 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x01R\amodelId\x88\x01\x01\x12-\n" +
 	"\x04role\x18\a \x01(\x0e2\x19.construct.v1.MessageRoleR\x04roleB\v\n" +
 	"\t_agent_idB\v\n" +
-	"\t_model_id\"B\n" +
+	"\t_model_id\"}\n" +
 	"\vMessageSpec\x123\n" +
-	"\acontent\x18\x01 \x03(\v2\x19.construct.v1.MessagePartR\acontent\"\xaf\x01\n" +
+	"\acontent\x18\x01 \x03(\v2\x19.construct.v1.MessagePartR\acontent\x129\n" +
+	"\bdelivery\x18\x02 \x01(\x0e2\x1d.construct.v1.MessageDeliveryR\bdelivery\"\xaf\x01\n" +
 	"\rMessageStatus\x120\n" +
 	"\x05usage\x18\x01 \x01(\v2\x1a.construct.v1.MessageUsageR\x05usage\x12@\n" +
 	"\rcontent_state\x18\x02 \x01(\x0e2\x1b.construct.v1.ContentStatusR\fcontentState\x12*\n" +
@@ -3678,11 +5629,12 @@ const file_construct_v1_message_proto_rawDesc = "" +
 	"\routput_tokens\x18\x02 \x01(\x03R\foutputTokens\x12,\n" +
 	"\x12cache_write_tokens\x18\x03 \x01(\x03R\x10cacheWriteTokens\x12*\n" +
 	"\x11cache_read_tokens\x18\x04 \x01(\x03R\x0fcacheReadTokens\x12\x12\n" +
-	"\x04cost\x18\x05 \x01(\x01R\x04cost\"z\n" +
+	"\x04cost\x18\x05 \x01(\x01R\x04cost\"\xbf\x01\n" +
 	"\x14CreateMessageRequest\x12!\n" +
 	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\x12?\n" +
 	"\acontent\x18\x02 \x03(\v2\x19.construct.v1.MessagePartB\n" +
-	"\xbaH\a\x92\x01\x04\b\x01\x10\x19R\acontent\"P\n" +
+	"\xbaH\a\x92\x01\x04\b\x01\x10\x19R\acontent\x12C\n" +
+	"\bdelivery\x18\x03 \x01(\x0e2\x1d.construct.v1.MessageDeliveryB\b\xbaH\x05\x82\x01\x02\x10\x01R\bdelivery\"P\n" +
 	"\x15CreateMessageResponse\x127\n" +
 	"\amessage\x18\x01 \x01(\v2\x15.construct.v1.MessageB\x06\xbaH\x03\xc8\x01\x01R\amessage\"-\n" +
 	"\x11GetMessageRequest\x12\x18\n" +
@@ -3724,7 +5676,7 @@ const file_construct_v1_message_proto_rawDesc = "" +
 	"\amessage\x18\x01 \x01(\v2\x15.construct.v1.MessageB\x06\xbaH\x03\xc8\x01\x01R\amessage\"0\n" +
 	"\x14DeleteMessageRequest\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x17\n" +
-	"\x15DeleteMessageResponse\"\xda\x0f\n" +
+	"\x15DeleteMessageResponse\"\xc4\x1c\n" +
 	"\bToolCall\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
 	"\ttool_name\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\btoolName\x12I\n" +
@@ -3741,7 +5693,20 @@ const file_construct_v1_message_proto_rawDesc = "" +
 	" \x01(\v2%.construct.v1.ToolCall.ListFilesInputH\x00R\tlistFiles\x12C\n" +
 	"\tread_file\x18\v \x01(\v2$.construct.v1.ToolCall.ReadFileInputH\x00R\breadFile\x12O\n" +
 	"\rsubmit_report\x18\f \x01(\v2(.construct.v1.ToolCall.SubmitReportInputH\x00R\fsubmitReport\x12X\n" +
-	"\x10code_interpreter\x18\r \x01(\v2+.construct.v1.ToolCall.CodeInterpreterInputH\x00R\x0fcodeInterpreter\x1a*\n" +
+	"\x10code_interpreter\x18\r \x01(\v2+.construct.v1.ToolCall.CodeInterpreterInputH\x00R\x0fcodeInterpreter\x12I\n" +
+	"\vcustom_tool\x18\x0e \x01(\v2&.construct.v1.ToolCall.CustomToolInputH\x00R\n" +
+	"customTool\x12@\n" +
+	"\bmcp_tool\x18\x0f \x01(\v2#.construct.v1.ToolCall.McpToolInputH\x00R\amcpTool\x12B\n" +
+	"\bdelegate\x18\x10 \x01(\v2$.construct.v1.ToolCall.DelegateInputH\x00R\bdelegate\x12L\n" +
+	"\frun_parallel\x18\x11 \x01(\v2'.construct.v1.ToolCall.RunParallelInputH\x00R\vrunParallel\x12I\n" +
+	"\vdelete_file\x18\x12 \x01(\v2&.construct.v1.ToolCall.DeleteFileInputH\x00R\n" +
+	"deleteFile\x12C\n" +
+	"\tmove_file\x18\x13 \x01(\v2$.construct.v1.ToolCall.MoveFileInputH\x00R\bmoveFile\x12C\n" +
+	"\tcopy_file\x18\x14 \x01(\v2$.construct.v1.ToolCall.CopyFileInputH\x00R\bcopyFile\x12I\n" +
+	"\vapply_patch\x18\x15 \x01(\v2&.construct.v1.ToolCall.ApplyPatchInputH\x00R\n" +
+	"applyPatch\x123\n" +
+	"\x03git\x18\x16 \x01(\v2\x1f.construct.v1.ToolCall.GitInputH\x00R\x03git\x12C\n" +
+	"\trun_tests\x18\x17 \x01(\v2$.construct.v1.ToolCall.RunTestsInputH\x00R\brunTests\x1a*\n" +
 	"\x14CodeInterpreterInput\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\tR\x04code\x1a?\n" +
 	"\x0fCreateFileInput\x12\x12\n" +
@@ -3788,8 +5753,45 @@ const file_construct_v1_message_proto_rawDesc = "" +
 	"\tcompleted\x18\x02 \x01(\bR\tcompleted\x12\"\n" +
 	"\fdeliverables\x18\x03 \x03(\tR\fdeliverables\x12\x1d\n" +
 	"\n" +
-	"next_steps\x18\x04 \x01(\tR\tnextStepsB\a\n" +
-	"\x05Input\"\x93\x10\n" +
+	"next_steps\x18\x04 \x01(\tR\tnextSteps\x1a/\n" +
+	"\x0fCustomToolInput\x12\x1c\n" +
+	"\targuments\x18\x01 \x01(\tR\targuments\x1aX\n" +
+	"\fMcpToolInput\x12\x16\n" +
+	"\x06server\x18\x01 \x01(\tR\x06server\x12\x12\n" +
+	"\x04tool\x18\x02 \x01(\tR\x04tool\x12\x1c\n" +
+	"\targuments\x18\x03 \x01(\tR\targuments\x1ad\n" +
+	"\rDelegateInput\x12\x14\n" +
+	"\x05agent\x18\x01 \x01(\tR\x05agent\x12\"\n" +
+	"\finstructions\x18\x02 \x01(\tR\finstructions\x12\x19\n" +
+	"\bmax_cost\x18\x03 \x01(\x01R\amaxCost\x1ap\n" +
+	"\x10RunParallelInput\x12:\n" +
+	"\x05tasks\x18\x01 \x03(\v2$.construct.v1.ToolCall.DelegateInputR\x05tasks\x12 \n" +
+	"\vconcurrency\x18\x02 \x01(\x05R\vconcurrency\x1a%\n" +
+	"\x0fDeleteFileInput\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x1ag\n" +
+	"\rMoveFileInput\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12 \n" +
+	"\vdestination\x18\x02 \x01(\tR\vdestination\x12\x1c\n" +
+	"\toverwrite\x18\x03 \x01(\bR\toverwrite\x1ag\n" +
+	"\rCopyFileInput\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12 \n" +
+	"\vdestination\x18\x02 \x01(\tR\vdestination\x12\x1c\n" +
+	"\toverwrite\x18\x03 \x01(\bR\toverwrite\x1a@\n" +
+	"\x0fApplyPatchInput\x12\x14\n" +
+	"\x05patch\x18\x01 \x01(\tR\x05patch\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x1aF\n" +
+	"\bGitInput\x12\x1c\n" +
+	"\toperation\x18\x01 \x01(\tR\toperation\x12\x1c\n" +
+	"\targuments\x18\x02 \x01(\tR\targuments\x1a\xc8\x01\n" +
+	"\rRunTestsInput\x12\x1c\n" +
+	"\tframework\x18\x01 \x01(\tR\tframework\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x14\n" +
+	"\x05tests\x18\x03 \x03(\tR\x05tests\x12\x16\n" +
+	"\x06filter\x18\x04 \x01(\tR\x06filter\x12\x18\n" +
+	"\acommand\x18\x05 \x01(\tR\acommand\x12\x16\n" +
+	"\x06report\x18\x06 \x01(\tR\x06report\x12%\n" +
+	"\x0einclude_passed\x18\a \x01(\bR\rincludePassedB\a\n" +
+	"\x05Input\"\xa2$\n" +
 	"\n" +
 	"ToolResult\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
@@ -3805,7 +5807,20 @@ const file_construct_v1_message_proto_rawDesc = "" +
 	"\tread_file\x18\t \x01(\v2'.construct.v1.ToolResult.ReadFileResultH\x00R\breadFile\x12R\n" +
 	"\rsubmit_report\x18\n" +
 	" \x01(\v2+.construct.v1.ToolResult.SubmitReportResultH\x00R\fsubmitReport\x12[\n" +
-	"\x10code_interpreter\x18\v \x01(\v2..construct.v1.ToolResult.CodeInterpreterResultH\x00R\x0fcodeInterpreter\x12-\n" +
+	"\x10code_interpreter\x18\v \x01(\v2..construct.v1.ToolResult.CodeInterpreterResultH\x00R\x0fcodeInterpreter\x12L\n" +
+	"\vcustom_tool\x18\f \x01(\v2).construct.v1.ToolResult.CustomToolResultH\x00R\n" +
+	"customTool\x12C\n" +
+	"\bmcp_tool\x18\x0e \x01(\v2&.construct.v1.ToolResult.McpToolResultH\x00R\amcpTool\x12E\n" +
+	"\bdelegate\x18\x0f \x01(\v2'.construct.v1.ToolResult.DelegateResultH\x00R\bdelegate\x12O\n" +
+	"\frun_parallel\x18\x10 \x01(\v2*.construct.v1.ToolResult.RunParallelResultH\x00R\vrunParallel\x12L\n" +
+	"\vdelete_file\x18\x11 \x01(\v2).construct.v1.ToolResult.DeleteFileResultH\x00R\n" +
+	"deleteFile\x12F\n" +
+	"\tmove_file\x18\x12 \x01(\v2'.construct.v1.ToolResult.MoveFileResultH\x00R\bmoveFile\x12F\n" +
+	"\tcopy_file\x18\x13 \x01(\v2'.construct.v1.ToolResult.CopyFileResultH\x00R\bcopyFile\x12L\n" +
+	"\vapply_patch\x18\x14 \x01(\v2).construct.v1.ToolResult.ApplyPatchResultH\x00R\n" +
+	"applyPatch\x126\n" +
+	"\x03git\x18\x15 \x01(\v2\".construct.v1.ToolResult.GitResultH\x00R\x03git\x12F\n" +
+	"\trun_tests\x18\x16 \x01(\v2'.construct.v1.ToolResult.RunTestsResultH\x00R\brunTests\x12-\n" +
 	"\x05error\x18\r \x01(\v2\x17.construct.v1.ToolErrorR\x05error\x1a/\n" +
 	"\x15CodeInterpreterResult\x12\x16\n" +
 	"\x06output\x18\x01 \x01(\tR\x06output\x1a4\n" +
@@ -3854,7 +5869,78 @@ const file_construct_v1_message_proto_rawDesc = "" +
 	"\tcompleted\x18\x02 \x01(\bR\tcompleted\x12\"\n" +
 	"\fdeliverables\x18\x03 \x03(\tR\fdeliverables\x12\x1d\n" +
 	"\n" +
-	"next_steps\x18\x04 \x01(\tR\tnextStepsB\b\n" +
+	"next_steps\x18\x04 \x01(\tR\tnextSteps\x1a*\n" +
+	"\x10CustomToolResult\x12\x16\n" +
+	"\x06output\x18\x01 \x01(\tR\x06output\x1a'\n" +
+	"\rMcpToolResult\x12\x16\n" +
+	"\x06output\x18\x01 \x01(\tR\x06output\x1a\xce\x01\n" +
+	"\x0eDelegateResult\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x14\n" +
+	"\x05agent\x18\x02 \x01(\tR\x05agent\x12\x18\n" +
+	"\asummary\x18\x03 \x01(\tR\asummary\x12\x1c\n" +
+	"\tcompleted\x18\x04 \x01(\bR\tcompleted\x12\"\n" +
+	"\fdeliverables\x18\x05 \x03(\tR\fdeliverables\x12\x1d\n" +
+	"\n" +
+	"next_steps\x18\x06 \x01(\tR\tnextSteps\x12\x12\n" +
+	"\x04cost\x18\a \x01(\x01R\x04cost\x1a\xa7\x02\n" +
+	"\x11RunParallelResult\x12O\n" +
+	"\aresults\x18\x01 \x03(\v25.construct.v1.ToolResult.RunParallelResult.TaskResultR\aresults\x12\x1a\n" +
+	"\bfinished\x18\x02 \x01(\x05R\bfinished\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\x12\x12\n" +
+	"\x04cost\x18\x04 \x01(\x01R\x04cost\x1ay\n" +
+	"\n" +
+	"TaskResult\x12\x14\n" +
+	"\x05agent\x18\x01 \x01(\tR\x05agent\x12?\n" +
+	"\x06result\x18\x02 \x01(\v2'.construct.v1.ToolResult.DelegateResultR\x06result\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x1a&\n" +
+	"\x10DeleteFileResult\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x1a2\n" +
+	"\x0eMoveFileResult\x12 \n" +
+	"\voverwritten\x18\x01 \x01(\bR\voverwritten\x1a2\n" +
+	"\x0eCopyFileResult\x12 \n" +
+	"\voverwritten\x18\x01 \x01(\bR\voverwritten\x1a\xe7\x03\n" +
+	"\x10ApplyPatchResult\x12K\n" +
+	"\x05files\x18\x01 \x03(\v25.construct.v1.ToolResult.ApplyPatchResult.PatchedFileR\x05files\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x1a\x92\x01\n" +
+	"\n" +
+	"HunkResult\x12\x12\n" +
+	"\x04hunk\x18\x01 \x01(\x05R\x04hunk\x12\x18\n" +
+	"\aapplied\x18\x02 \x01(\bR\aapplied\x12\x12\n" +
+	"\x04line\x18\x03 \x01(\x05R\x04line\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05fuzzy\x18\x05 \x01(\bR\x05fuzzy\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\x1a\xd7\x01\n" +
+	"\vPatchedFile\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12P\n" +
+	"\n" +
+	"patch_info\x18\x03 \x01(\v21.construct.v1.ToolResult.EditFileResult.PatchInfoR\tpatchInfo\x12J\n" +
+	"\x05hunks\x18\x04 \x03(\v24.construct.v1.ToolResult.ApplyPatchResult.HunkResultR\x05hunks\x1aA\n" +
+	"\tGitResult\x12\x1c\n" +
+	"\toperation\x18\x01 \x01(\tR\toperation\x12\x16\n" +
+	"\x06output\x18\x02 \x01(\tR\x06output\x1a\xa8\x04\n" +
+	"\x0eRunTestsResult\x12\x1c\n" +
+	"\tframework\x18\x01 \x01(\tR\tframework\x12\x18\n" +
+	"\acommand\x18\x02 \x01(\tR\acommand\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12\x16\n" +
+	"\x06passed\x18\x04 \x01(\x05R\x06passed\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\x05R\x06failed\x12\x18\n" +
+	"\askipped\x18\x06 \x01(\x05R\askipped\x12\x1a\n" +
+	"\bduration\x18\a \x01(\x01R\bduration\x12F\n" +
+	"\x05tests\x18\b \x03(\v20.construct.v1.ToolResult.RunTestsResult.TestCaseR\x05tests\x12\x1c\n" +
+	"\ttruncated\x18\t \x01(\bR\ttruncated\x12\x16\n" +
+	"\x06output\x18\n" +
+	" \x01(\tR\x06output\x12\x1b\n" +
+	"\ttimed_out\x18\v \x01(\bR\btimedOut\x1a\xc2\x01\n" +
+	"\bTestCase\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05suite\x18\x02 \x01(\tR\x05suite\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1a\n" +
+	"\bduration\x18\x04 \x01(\x01R\bduration\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12\x12\n" +
+	"\x04file\x18\x06 \x01(\tR\x04file\x12\x12\n" +
+	"\x04line\x18\a \x01(\x05R\x04line\x12\x16\n" +
+	"\x06output\x18\b \x01(\tR\x06outputB\b\n" +
 	"\x06result\"\xc1\x01\n" +
 	"\x14CreateFileToolResult\x12>\n" +
 	"\x05input\x18\x01 \x01(\v2(.construct.v1.CreateFileToolResult.InputR\x05input\x12 \n" +
@@ -3888,7 +5974,11 @@ const file_construct_v1_message_proto_rawDesc = "" +
 	"\adetails\x18\x02 \x03(\v2$.construct.v1.ToolError.DetailsEntryR\adetails\x1a:\n" +
 	"\fDetailsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01*h\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01*k\n" +
+	"\x0fMessageDelivery\x12 \n" +
+	"\x1cMESSAGE_DELIVERY_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16MESSAGE_DELIVERY_QUEUE\x10\x01\x12\x1a\n" +
+	"\x16MESSAGE_DELIVERY_STEER\x10\x02*h\n" +
 	"\rContentStatus\x12\x1e\n" +
 	"\x1aCONTENT_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16CONTENT_STATUS_PARTIAL\x10\x01\x12\x1b\n" +
@@ -3918,138 +6008,192 @@ func file_construct_v1_message_proto_rawDescGZIP() []byte {
 	return file_construct_v1_message_proto_rawDescData
 }
 
-var file_construct_v1_message_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_construct_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
+var file_construct_v1_message_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_construct_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 81)
 var file_construct_v1_message_proto_goTypes = []any{
-	(ContentStatus)(0),                                // 0: construct.v1.ContentStatus
-	(MessageRole)(0),                                  // 1: construct.v1.MessageRole
-	(*Message)(nil),                                   // 2: construct.v1.Message
-	(*MessageMetadata)(nil),                           // 3: construct.v1.MessageMetadata
-	(*MessageSpec)(nil),                               // 4: construct.v1.MessageSpec
-	(*MessageStatus)(nil),                             // 5: construct.v1.MessageStatus
-	(*MessagePart)(nil),                               // 6: construct.v1.MessagePart
-	(*MessageUsage)(nil),                              // 7: construct.v1.MessageUsage
-	(*CreateMessageRequest)(nil),                      // 8: construct.v1.CreateMessageRequest
-	(*CreateMessageResponse)(nil),                     // 9: construct.v1.CreateMessageResponse
-	(*GetMessageRequest)(nil),                         // 10: construct.v1.GetMessageRequest
-	(*GetMessageResponse)(nil),                        // 11: construct.v1.GetMessageResponse
-	(*ListMessagesRequest)(nil),                       // 12: construct.v1.ListMessagesRequest
-	(*ListMessagesResponse)(nil),                      // 13: construct.v1.ListMessagesResponse
-	(*UpdateMessageRequest)(nil),                      // 14: construct.v1.UpdateMessageRequest
-	(*UpdateMessageResponse)(nil),                     // 15: construct.v1.UpdateMessageResponse
-	(*DeleteMessageRequest)(nil),                      // 16: construct.v1.DeleteMessageRequest
-	(*DeleteMessageResponse)(nil),                     // 17: construct.v1.DeleteMessageResponse
-	(*ToolCall)(nil),                                  // 18: construct.v1.ToolCall
-	(*ToolResult)(nil),                                // 19: construct.v1.ToolResult
-	(*CreateFileToolResult)(nil),                      // 20: construct.v1.CreateFileToolResult
-	(*EditFileToolResult)(nil),                        // 21: construct.v1.EditFileToolResult
-	(*ExecuteCommandToolResult)(nil),                  // 22: construct.v1.ExecuteCommandToolResult
-	(*FindFileToolResult)(nil),                        // 23: construct.v1.FindFileToolResult
-	(*GrepToolResult)(nil),                            // 24: construct.v1.GrepToolResult
-	(*HandoffToolResult)(nil),                         // 25: construct.v1.HandoffToolResult
-	(*ListFilesToolResult)(nil),                       // 26: construct.v1.ListFilesToolResult
-	(*ReadFileToolResult)(nil),                        // 27: construct.v1.ReadFileToolResult
-	(*SubmitReport)(nil),                              // 28: construct.v1.SubmitReport
-	(*ToolError)(nil),                                 // 29: construct.v1.ToolError
-	(*MessagePart_Text)(nil),                          // 30: construct.v1.MessagePart.Text
-	(*MessagePart_Error)(nil),                         // 31: construct.v1.MessagePart.Error
-	(*ListMessagesRequest_Filter)(nil),                // 32: construct.v1.ListMessagesRequest.Filter
-	(*ToolCall_CodeInterpreterInput)(nil),             // 33: construct.v1.ToolCall.CodeInterpreterInput
-	(*ToolCall_CreateFileInput)(nil),                  // 34: construct.v1.ToolCall.CreateFileInput
-	(*ToolCall_EditFileInput)(nil),                    // 35: construct.v1.ToolCall.EditFileInput
-	(*ToolCall_ExecuteCommandInput)(nil),              // 36: construct.v1.ToolCall.ExecuteCommandInput
-	(*ToolCall_FindFileInput)(nil),                    // 37: construct.v1.ToolCall.FindFileInput
-	(*ToolCall_GrepInput)(nil),                        // 38: construct.v1.ToolCall.GrepInput
-	(*ToolCall_HandoffInput)(nil),                     // 39: construct.v1.ToolCall.HandoffInput
-	(*ToolCall_AskUserInput)(nil),                     // 40: construct.v1.ToolCall.AskUserInput
-	(*ToolCall_ListFilesInput)(nil),                   // 41: construct.v1.ToolCall.ListFilesInput
-	(*ToolCall_ReadFileInput)(nil),                    // 42: construct.v1.ToolCall.ReadFileInput
-	(*ToolCall_SubmitReportInput)(nil),                // 43: construct.v1.ToolCall.SubmitReportInput
-	(*ToolCall_EditFileInput_DiffPair)(nil),           // 44: construct.v1.ToolCall.EditFileInput.DiffPair
-	(*ToolResult_CodeInterpreterResult)(nil),          // 45: construct.v1.ToolResult.CodeInterpreterResult
-	(*ToolResult_CreateFileResult)(nil),               // 46: construct.v1.ToolResult.CreateFileResult
-	(*ToolResult_EditFileResult)(nil),                 // 47: construct.v1.ToolResult.EditFileResult
-	(*ToolResult_ExecuteCommandResult)(nil),           // 48: construct.v1.ToolResult.ExecuteCommandResult
-	(*ToolResult_FindFileResult)(nil),                 // 49: construct.v1.ToolResult.FindFileResult
-	(*ToolResult_GrepResult)(nil),                     // 50: construct.v1.ToolResult.GrepResult
-	(*ToolResult_ListFilesResult)(nil),                // 51: construct.v1.ToolResult.ListFilesResult
-	(*ToolResult_ReadFileResult)(nil),                 // 52: construct.v1.ToolResult.ReadFileResult
-	(*ToolResult_SubmitReportResult)(nil),             // 53: construct.v1.ToolResult.SubmitReportResult
-	(*ToolResult_EditFileResult_PatchInfo)(nil),       // 54: construct.v1.ToolResult.EditFileResult.PatchInfo
-	(*ToolResult_GrepResult_GrepMatch)(nil),           // 55: construct.v1.ToolResult.GrepResult.GrepMatch
-	(*ToolResult_ListFilesResult_DirectoryEntry)(nil), // 56: construct.v1.ToolResult.ListFilesResult.DirectoryEntry
-	(*CreateFileToolResult_Input)(nil),                // 57: construct.v1.CreateFileToolResult.Input
-	nil,                                               // 58: construct.v1.ToolError.DetailsEntry
-	(*timestamppb.Timestamp)(nil),                     // 59: google.protobuf.Timestamp
-	(SortField)(0),                                    // 60: construct.v1.SortField
-	(SortOrder)(0),                                    // 61: construct.v1.SortOrder
+	(MessageDelivery)(0),                              // 0: construct.v1.MessageDelivery
+	(ContentStatus)(0),                                // 1: construct.v1.ContentStatus
+	(MessageRole)(0),                                  // 2: construct.v1.MessageRole
+	(*Message)(nil),                                   // 3: construct.v1.Message
+	(*MessageMetadata)(nil),                           // 4: construct.v1.MessageMetadata
+	(*MessageSpec)(nil),                               // 5: construct.v1.MessageSpec
+	(*MessageStatus)(nil),                             // 6: construct.v1.MessageStatus
+	(*MessagePart)(nil),                               // 7: construct.v1.MessagePart
+	(*MessageUsage)(nil),                              // 8: construct.v1.MessageUsage
+	(*CreateMessageRequest)(nil),                      // 9: construct.v1.CreateMessageRequest
+	(*CreateMessageResponse)(nil),                     // 10: construct.v1.CreateMessageResponse
+	(*GetMessageRequest)(nil),                         // 11: construct.v1.GetMessageRequest
+	(*GetMessageResponse)(nil),                        // 12: construct.v1.GetMessageResponse
+	(*ListMessagesRequest)(nil),                       // 13: construct.v1.ListMessagesRequest
+	(*ListMessagesResponse)(nil),                      // 14: construct.v1.ListMessagesResponse
+	(*UpdateMessageRequest)(nil),                      // 15: construct.v1.UpdateMessageRequest
+	(*UpdateMessageResponse)(nil),                     // 16: construct.v1.UpdateMessageResponse
+	(*DeleteMessageRequest)(nil),                      // 17: construct.v1.DeleteMessageRequest
+	(*DeleteMessageResponse)(nil),                     // 18: construct.v1.DeleteMessageResponse
+	(*ToolCall)(nil),                                  // 19: construct.v1.ToolCall
+	(*ToolResult)(nil),                                // 20: construct.v1.ToolResult
+	(*CreateFileToolResult)(nil),                      // 21: construct.v1.CreateFileToolResult
+	(*EditFileToolResult)(nil),                        // 22: construct.v1.EditFileToolResult
+	(*ExecuteCommandToolResult)(nil),                  // 23: construct.v1.ExecuteCommandToolResult
+	(*FindFileToolResult)(nil),                        // 24: construct.v1.FindFileToolResult
+	(*GrepToolResult)(nil),                            // 25: construct.v1.GrepToolResult
+	(*HandoffToolResult)(nil),                         // 26: construct.v1.HandoffToolResult
+	(*ListFilesToolResult)(nil),                       // 27: construct.v1.ListFilesToolResult
+	(*ReadFileToolResult)(nil),                        // 28: construct.v1.ReadFileToolResult
+	(*SubmitReport)(nil),                              // 29: construct.v1.SubmitReport
+	(*ToolError)(nil),                                 // 30: construct.v1.ToolError
+	(*MessagePart_Text)(nil),                          // 31: construct.v1.MessagePart.Text
+	(*MessagePart_Error)(nil),                         // 32: construct.v1.MessagePart.Error
+	(*ListMessagesRequest_Filter)(nil),                // 33: construct.v1.ListMessagesRequest.Filter
+	(*ToolCall_CodeInterpreterInput)(nil),             // 34: construct.v1.ToolCall.CodeInterpreterInput
+	(*ToolCall_CreateFileInput)(nil),                  // 35: construct.v1.ToolCall.CreateFileInput
+	(*ToolCall_EditFileInput)(nil),                    // 36: construct.v1.ToolCall.EditFileInput
+	(*ToolCall_ExecuteCommandInput)(nil),              // 37: construct.v1.ToolCall.ExecuteCommandInput
+	(*ToolCall_FindFileInput)(nil),                    // 38: construct.v1.ToolCall.FindFileInput
+	(*ToolCall_GrepInput)(nil),                        // 39: construct.v1.ToolCall.GrepInput
+	(*ToolCall_HandoffInput)(nil),                     // 40: construct.v1.ToolCall.HandoffInput
+	(*ToolCall_AskUserInput)(nil),                     // 41: construct.v1.ToolCall.AskUserInput
+	(*ToolCall_ListFilesInput)(nil),                   // 42: construct.v1.ToolCall.ListFilesInput
+	(*ToolCall_ReadFileInput)(nil),                    // 43: construct.v1.ToolCall.ReadFileInput
+	(*ToolCall_SubmitReportInput)(nil),                // 44: construct.v1.ToolCall.SubmitReportInput
+	(*ToolCall_CustomToolInput)(nil),                  // 45: construct.v1.ToolCall.CustomToolInput
+	(*ToolCall_McpToolInput)(nil),                     // 46: construct.v1.ToolCall.McpToolInput
+	(*ToolCall_DelegateInput)(nil),                    // 47: construct.v1.ToolCall.DelegateInput
+	(*ToolCall_RunParallelInput)(nil),                 // 48: construct.v1.ToolCall.RunParallelInput
+	(*ToolCall_DeleteFileInput)(nil),                  // 49: construct.v1.ToolCall.DeleteFileInput
+	(*ToolCall_MoveFileInput)(nil),                    // 50: construct.v1.ToolCall.MoveFileInput
+	(*ToolCall_CopyFileInput)(nil),                    // 51: construct.v1.ToolCall.CopyFileInput
+	(*ToolCall_ApplyPatchInput)(nil),                  // 52: construct.v1.ToolCall.ApplyPatchInput
+	(*ToolCall_GitInput)(nil),                         // 53: construct.v1.ToolCall.GitInput
+	(*ToolCall_RunTestsInput)(nil),                    // 54: construct.v1.ToolCall.RunTestsInput
+	(*ToolCall_EditFileInput_DiffPair)(nil),           // 55: construct.v1.ToolCall.EditFileInput.DiffPair
+	(*ToolResult_CodeInterpreterResult)(nil),          // 56: construct.v1.ToolResult.CodeInterpreterResult
+	(*ToolResult_CreateFileResult)(nil),               // 57: construct.v1.ToolResult.CreateFileResult
+	(*ToolResult_EditFileResult)(nil),                 // 58: construct.v1.ToolResult.EditFileResult
+	(*ToolResult_ExecuteCommandResult)(nil),           // 59: construct.v1.ToolResult.ExecuteCommandResult
+	(*ToolResult_FindFileResult)(nil),                 // 60: construct.v1.ToolResult.FindFileResult
+	(*ToolResult_GrepResult)(nil),                     // 61: construct.v1.ToolResult.GrepResult
+	(*ToolResult_ListFilesResult)(nil),                // 62: construct.v1.ToolResult.ListFilesResult
+	(*ToolResult_ReadFileResult)(nil),                 // 63: construct.v1.ToolResult.ReadFileResult
+	(*ToolResult_SubmitReportResult)(nil),             // 64: construct.v1.ToolResult.SubmitReportResult
+	(*ToolResult_CustomToolResult)(nil),               // 65: construct.v1.ToolResult.CustomToolResult
+	(*ToolResult_McpToolResult)(nil),                  // 66: construct.v1.ToolResult.McpToolResult
+	(*ToolResult_DelegateResult)(nil),                 // 67: construct.v1.ToolResult.DelegateResult
+	(*ToolResult_RunParallelResult)(nil),              // 68: construct.v1.ToolResult.RunParallelResult
+	(*ToolResult_DeleteFileResult)(nil),               // 69: construct.v1.ToolResult.DeleteFileResult
+	(*ToolResult_MoveFileResult)(nil),                 // 70: construct.v1.ToolResult.MoveFileResult
+	(*ToolResult_CopyFileResult)(nil),                 // 71: construct.v1.ToolResult.CopyFileResult
+	(*ToolResult_ApplyPatchResult)(nil),               // 72: construct.v1.ToolResult.ApplyPatchResult
+	(*ToolResult_GitResult)(nil),                      // 73: construct.v1.ToolResult.GitResult
+	(*ToolResult_RunTestsResult)(nil),                 // 74: construct.v1.ToolResult.RunTestsResult
+	(*ToolResult_EditFileResult_PatchInfo)(nil),       // 75: construct.v1.ToolResult.EditFileResult.PatchInfo
+	(*ToolResult_GrepResult_GrepMatch)(nil),           // 76: construct.v1.ToolResult.GrepResult.GrepMatch
+	(*ToolResult_ListFilesResult_DirectoryEntry)(nil), // 77: construct.v1.ToolResult.ListFilesResult.DirectoryEntry
+	(*ToolResult_RunParallelResult_TaskResult)(nil),   // 78: construct.v1.ToolResult.RunParallelResult.TaskResult
+	(*ToolResult_ApplyPatchResult_HunkResult)(nil),    // 79: construct.v1.ToolResult.ApplyPatchResult.HunkResult
+	(*ToolResult_ApplyPatchResult_PatchedFile)(nil),   // 80: construct.v1.ToolResult.ApplyPatchResult.PatchedFile
+	(*ToolResult_RunTestsResult_TestCase)(nil),        // 81: construct.v1.ToolResult.RunTestsResult.TestCase
+	(*CreateFileToolResult_Input)(nil),                // 82: construct.v1.CreateFileToolResult.Input
+	nil,                                               // 83: construct.v1.ToolError.DetailsEntry
+	(*timestamppb.Timestamp)(nil),                     // 84: google.protobuf.Timestamp
+	(SortField)(0),                                    // 85: construct.v1.SortField
+	(SortOrder)(0),                                    // 86: construct.v1.SortOrder
 }
 var file_construct_v1_message_proto_depIdxs = []int32{
-	3,  // 0: construct.v1.Message.metadata:type_name -> construct.v1.MessageMetadata
-	4,  // 1: construct.v1.Message.spec:type_name -> construct.v1.MessageSpec
-	5,  // 2: construct.v1.Message.status:type_name -> construct.v1.MessageStatus
-	59, // 3: construct.v1.MessageMetadata.created_at:type_name -> google.protobuf.Timestamp
-	59, // 4: construct.v1.MessageMetadata.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 5: construct.v1.MessageMetadata.role:type_name -> construct.v1.MessageRole
-	6,  // 6: construct.v1.MessageSpec.content:type_name -> construct.v1.MessagePart
-	7,  // 7: construct.v1.MessageStatus.usage:type_name -> construct.v1.MessageUsage
-	0,  // 8: construct.v1.MessageStatus.content_state:type_name -> construct.v1.ContentStatus
-	30, // 9: construct.v1.MessagePart.text:type_name -> construct.v1.MessagePart.Text
-	18, // 10: construct.v1.MessagePart.tool_call:type_name -> construct.v1.ToolCall
-	19, // 11: construct.v1.MessagePart.tool_result:type_name -> construct.v1.ToolResult
-	31, // 12: construct.v1.MessagePart.error:type_name -> construct.v1.MessagePart.Error
-	6,  // 13: construct.v1.CreateMessageRequest.content:type_name -> construct.v1.MessagePart
-	2,  // 14: construct.v1.CreateMessageResponse.message:type_name -> construct.v1.Message
-	2,  // 15: construct.v1.GetMessageResponse.message:type_name -> construct.v1.Message
-	32, // 16: construct.v1.ListMessagesRequest.filter:type_name -> construct.v1.ListMessagesRequest.Filter
-	60, // 17: construct.v1.ListMessagesRequest.sort_field:type_name -> construct.v1.SortField
-	61, // 18: construct.v1.ListMessagesRequest.sort_order:type_name -> construct.v1.SortOrder
-	2,  // 19: construct.v1.ListMessagesResponse.messages:type_name -> construct.v1.Message
-	6,  // 20: construct.v1.UpdateMessageRequest.content:type_name -> construct.v1.MessagePart
-	2,  // 21: construct.v1.UpdateMessageResponse.message:type_name -> construct.v1.Message
-	34, // 22: construct.v1.ToolCall.create_file:type_name -> construct.v1.ToolCall.CreateFileInput
-	35, // 23: construct.v1.ToolCall.edit_file:type_name -> construct.v1.ToolCall.EditFileInput
-	36, // 24: construct.v1.ToolCall.execute_command:type_name -> construct.v1.ToolCall.ExecuteCommandInput
-	37, // 25: construct.v1.ToolCall.find_file:type_name -> construct.v1.ToolCall.FindFileInput
-	38, // 26: construct.v1.ToolCall.grep:type_name -> construct.v1.ToolCall.GrepInput
-	39, // 27: construct.v1.ToolCall.handoff:type_name -> construct.v1.ToolCall.HandoffInput
-	40, // 28: construct.v1.ToolCall.ask_user:type_name -> construct.v1.ToolCall.AskUserInput
-	41, // 29: construct.v1.ToolCall.list_files:type_name -> construct.v1.ToolCall.ListFilesInput
-	42, // 30: construct.v1.ToolCall.read_file:type_name -> construct.v1.ToolCall.ReadFileInput
-	43, // 31: construct.v1.ToolCall.submit_report:type_name -> construct.v1.ToolCall.SubmitReportInput
-	33, // 32: construct.v1.ToolCall.code_interpreter:type_name -> construct.v1.ToolCall.CodeInterpreterInput
-	46, // 33: construct.v1.ToolResult.create_file:type_name -> construct.v1.ToolResult.CreateFileResult
-	47, // 34: construct.v1.ToolResult.edit_file:type_name -> construct.v1.ToolResult.EditFileResult
-	48, // 35: construct.v1.ToolResult.execute_command:type_name -> construct.v1.ToolResult.ExecuteCommandResult
-	49, // 36: construct.v1.ToolResult.find_file:type_name -> construct.v1.ToolResult.FindFileResult
-	50, // 37: construct.v1.ToolResult.grep:type_name -> construct.v1.ToolResult.GrepResult
-	51, // 38: construct.v1.ToolResult.list_files:type_name -> construct.v1.ToolResult.ListFilesResult
-	52, // 39: construct.v1.ToolResult.read_file:type_name -> construct.v1.ToolResult.ReadFileResult
-	53, // 40: construct.v1.ToolResult.submit_report:type_name -> construct.v1.ToolResult.SubmitReportResult
-	45, // 41: construct.v1.ToolResult.code_interpreter:type_name -> construct.v1.ToolResult.CodeInterpreterResult
-	29, // 42: construct.v1.ToolResult.error:type_name -> construct.v1.ToolError
-	57, // 43: construct.v1.CreateFileToolResult.input:type_name -> construct.v1.CreateFileToolResult.Input
-	58, // 44: construct.v1.ToolError.details:type_name -> construct.v1.ToolError.DetailsEntry
-	1,  // 45: construct.v1.ListMessagesRequest.Filter.roles:type_name -> construct.v1.MessageRole
-	44, // 46: construct.v1.ToolCall.EditFileInput.diffs:type_name -> construct.v1.ToolCall.EditFileInput.DiffPair
-	54, // 47: construct.v1.ToolResult.EditFileResult.patch_info:type_name -> construct.v1.ToolResult.EditFileResult.PatchInfo
-	55, // 48: construct.v1.ToolResult.GrepResult.matches:type_name -> construct.v1.ToolResult.GrepResult.GrepMatch
-	56, // 49: construct.v1.ToolResult.ListFilesResult.entries:type_name -> construct.v1.ToolResult.ListFilesResult.DirectoryEntry
-	8,  // 50: construct.v1.MessageService.CreateMessage:input_type -> construct.v1.CreateMessageRequest
-	10, // 51: construct.v1.MessageService.GetMessage:input_type -> construct.v1.GetMessageRequest
-	12, // 52: construct.v1.MessageService.ListMessages:input_type -> construct.v1.ListMessagesRequest
-	14, // 53: construct.v1.MessageService.UpdateMessage:input_type -> construct.v1.UpdateMessageRequest
-	16, // 54: construct.v1.MessageService.DeleteMessage:input_type -> construct.v1.DeleteMessageRequest
-	9,  // 55: construct.v1.MessageService.CreateMessage:output_type -> construct.v1.CreateMessageResponse
-	11, // 56: construct.v1.MessageService.GetMessage:output_type -> construct.v1.GetMessageResponse
-	13, // 57: construct.v1.MessageService.ListMessages:output_type -> construct.v1.ListMessagesResponse
-	15, // 58: construct.v1.MessageService.UpdateMessage:output_type -> construct.v1.UpdateMessageResponse
-	17, // 59: construct.v1.MessageService.DeleteMessage:output_type -> construct.v1.DeleteMessageResponse
-	55, // [55:60] is the sub-list for method output_type
-	50, // [50:55] is the sub-list for method input_type
-	50, // [50:50] is the sub-list for extension type_name
-	50, // [50:50] is the sub-list for extension extendee
-	0,  // [0:50] is the sub-list for field type_name
+	4,  // 0: construct.v1.Message.metadata:type_name -> construct.v1.MessageMetadata
+	5,  // 1: construct.v1.Message.spec:type_name -> construct.v1.MessageSpec
+	6,  // 2: construct.v1.Message.status:type_name -> construct.v1.MessageStatus
+	84, // 3: construct.v1.MessageMetadata.created_at:type_name -> google.protobuf.Timestamp
+	84, // 4: construct.v1.MessageMetadata.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 5: construct.v1.MessageMetadata.role:type_name -> construct.v1.MessageRole
+	7,  // 6: construct.v1.MessageSpec.content:type_name -> construct.v1.MessagePart
+	0,  // 7: construct.v1.MessageSpec.delivery:type_name -> construct.v1.MessageDelivery
+	8,  // 8: construct.v1.MessageStatus.usage:type_name -> construct.v1.MessageUsage
+	1,  // 9: construct.v1.MessageStatus.content_state:type_name -> construct.v1.ContentStatus
+	31, // 10: construct.v1.MessagePart.text:type_name -> construct.v1.MessagePart.Text
+	19, // 11: construct.v1.MessagePart.tool_call:type_name -> construct.v1.ToolCall
+	20, // 12: construct.v1.MessagePart.tool_result:type_name -> construct.v1.ToolResult
+	32, // 13: construct.v1.MessagePart.error:type_name -> construct.v1.MessagePart.Error
+	7,  // 14: construct.v1.CreateMessageRequest.content:type_name -> construct.v1.MessagePart
+	0,  // 15: construct.v1.CreateMessageRequest.delivery:type_name -> construct.v1.MessageDelivery
+	3,  // 16: construct.v1.CreateMessageResponse.message:type_name -> construct.v1.Message
+	3,  // 17: construct.v1.GetMessageResponse.message:type_name -> construct.v1.Message
+	33, // 18: construct.v1.ListMessagesRequest.filter:type_name -> construct.v1.ListMessagesRequest.Filter
+	85, // 19: construct.v1.ListMessagesRequest.sort_field:type_name -> construct.v1.SortField
+	86, // 20: construct.v1.ListMessagesRequest.sort_order:type_name -> construct.v1.SortOrder
+	3,  // 21: construct.v1.ListMessagesResponse.messages:type_name -> construct.v1.Message
+	7,  // 22: construct.v1.UpdateMessageRequest.content:type_name -> construct.v1.MessagePart
+	3,  // 23: construct.v1.UpdateMessageResponse.message:type_name -> construct.v1.Message
+	35, // 24: construct.v1.ToolCall.create_file:type_name -> construct.v1.ToolCall.CreateFileInput
+	36, // 25: construct.v1.ToolCall.edit_file:type_name -> construct.v1.ToolCall.EditFileInput
+	37, // 26: construct.v1.ToolCall.execute_command:type_name -> construct.v1.ToolCall.ExecuteCommandInput
+	38, // 27: construct.v1.ToolCall.find_file:type_name -> construct.v1.ToolCall.FindFileInput
+	39, // 28: construct.v1.ToolCall.grep:type_name -> construct.v1.ToolCall.GrepInput
+	40, // 29: construct.v1.ToolCall.handoff:type_name -> construct.v1.ToolCall.HandoffInput
+	41, // 30: construct.v1.ToolCall.ask_user:type_name -> construct.v1.ToolCall.AskUserInput
+	42, // 31: construct.v1.ToolCall.list_files:type_name -> construct.v1.ToolCall.ListFilesInput
+	43, // 32: construct.v1.ToolCall.read_file:type_name -> construct.v1.ToolCall.ReadFileInput
+	44, // 33: construct.v1.ToolCall.submit_report:type_name -> construct.v1.ToolCall.SubmitReportInput
+	34, // 34: construct.v1.ToolCall.code_interpreter:type_name -> construct.v1.ToolCall.CodeInterpreterInput
+	45, // 35: construct.v1.ToolCall.custom_tool:type_name -> construct.v1.ToolCall.CustomToolInput
+	46, // 36: construct.v1.ToolCall.mcp_tool:type_name -> construct.v1.ToolCall.McpToolInput
+	47, // 37: construct.v1.ToolCall.delegate:type_name -> construct.v1.ToolCall.DelegateInput
+	48, // 38: construct.v1.ToolCall.run_parallel:type_name -> construct.v1.ToolCall.RunParallelInput
+	49, // 39: construct.v1.ToolCall.delete_file:type_name -> construct.v1.ToolCall.DeleteFileInput
+	50, // 40: construct.v1.ToolCall.move_file:type_name -> construct.v1.ToolCall.MoveFileInput
+	51, // 41: construct.v1.ToolCall.copy_file:type_name -> construct.v1.ToolCall.CopyFileInput
+	52, // 42: construct.v1.ToolCall.apply_patch:type_name -> construct.v1.ToolCall.ApplyPatchInput
+	53, // 43: construct.v1.ToolCall.git:type_name -> construct.v1.ToolCall.GitInput
+	54, // 44: construct.v1.ToolCall.run_tests:type_name -> construct.v1.ToolCall.RunTestsInput
+	57, // 45: construct.v1.ToolResult.create_file:type_name -> construct.v1.ToolResult.CreateFileResult
+	58, // 46: construct.v1.ToolResult.edit_file:type_name -> construct.v1.ToolResult.EditFileResult
+	59, // 47: construct.v1.ToolResult.execute_command:type_name -> construct.v1.ToolResult.ExecuteCommandResult
+	60, // 48: construct.v1.ToolResult.find_file:type_name -> construct.v1.ToolResult.FindFileResult
+	61, // 49: construct.v1.ToolResult.grep:type_name -> construct.v1.ToolResult.GrepResult
+	62, // 50: construct.v1.ToolResult.list_files:type_name -> construct.v1.ToolResult.ListFilesResult
+	63, // 51: construct.v1.ToolResult.read_file:type_name -> construct.v1.ToolResult.ReadFileResult
+	64, // 52: construct.v1.ToolResult.submit_report:type_name -> construct.v1.ToolResult.SubmitReportResult
+	56, // 53: construct.v1.ToolResult.code_interpreter:type_name -> construct.v1.ToolResult.CodeInterpreterResult
+	65, // 54: construct.v1.ToolResult.custom_tool:type_name -> construct.v1.ToolResult.CustomToolResult
+	66, // 55: construct.v1.ToolResult.mcp_tool:type_name -> construct.v1.ToolResult.McpToolResult
+	67, // 56: construct.v1.ToolResult.delegate:type_name -> construct.v1.ToolResult.DelegateResult
+	68, // 57: construct.v1.ToolResult.run_parallel:type_name -> construct.v1.ToolResult.RunParallelResult
+	69, // 58: construct.v1.ToolResult.delete_file:type_name -> construct.v1.ToolResult.DeleteFileResult
+	70, // 59: construct.v1.ToolResult.move_file:type_name -> construct.v1.ToolResult.MoveFileResult
+	71, // 60: construct.v1.ToolResult.copy_file:type_name -> construct.v1.ToolResult.CopyFileResult
+	72, // 61: construct.v1.ToolResult.apply_patch:type_name -> construct.v1.ToolResult.ApplyPatchResult
+	73, // 62: construct.v1.ToolResult.git:type_name -> construct.v1.ToolResult.GitResult
+	74, // 63: construct.v1.ToolResult.run_tests:type_name -> construct.v1.ToolResult.RunTestsResult
+	30, // 64: construct.v1.ToolResult.error:type_name -> construct.v1.ToolError
+	82, // 65: construct.v1.CreateFileToolResult.input:type_name -> construct.v1.CreateFileToolResult.Input
+	83, // 66: construct.v1.ToolError.details:type_name -> construct.v1.ToolError.DetailsEntry
+	2,  // 67: construct.v1.ListMessagesRequest.Filter.roles:type_name -> construct.v1.MessageRole
+	55, // 68: construct.v1.ToolCall.EditFileInput.diffs:type_name -> construct.v1.ToolCall.EditFileInput.DiffPair
+	47, // 69: construct.v1.ToolCall.RunParallelInput.tasks:type_name -> construct.v1.ToolCall.DelegateInput
+	75, // 70: construct.v1.ToolResult.EditFileResult.patch_info:type_name -> construct.v1.ToolResult.EditFileResult.PatchInfo
+	76, // 71: construct.v1.ToolResult.GrepResult.matches:type_name -> construct.v1.ToolResult.GrepResult.GrepMatch
+	77, // 72: construct.v1.ToolResult.ListFilesResult.entries:type_name -> construct.v1.ToolResult.ListFilesResult.DirectoryEntry
+	78, // 73: construct.v1.ToolResult.RunParallelResult.results:type_name -> construct.v1.ToolResult.RunParallelResult.TaskResult
+	80, // 74: construct.v1.ToolResult.ApplyPatchResult.files:type_name -> construct.v1.ToolResult.ApplyPatchResult.PatchedFile
+	81, // 75: construct.v1.ToolResult.RunTestsResult.tests:type_name -> construct.v1.ToolResult.RunTestsResult.TestCase
+	67, // 76: construct.v1.ToolResult.RunParallelResult.TaskResult.result:type_name -> construct.v1.ToolResult.DelegateResult
+	75, // 77: construct.v1.ToolResult.ApplyPatchResult.PatchedFile.patch_info:type_name -> construct.v1.ToolResult.EditFileResult.PatchInfo
+	79, // 78: construct.v1.ToolResult.ApplyPatchResult.PatchedFile.hunks:type_name -> construct.v1.ToolResult.ApplyPatchResult.HunkResult
+	9,  // 79: construct.v1.MessageService.CreateMessage:input_type -> construct.v1.CreateMessageRequest
+	11, // 80: construct.v1.MessageService.GetMessage:input_type -> construct.v1.GetMessageRequest
+	13, // 81: construct.v1.MessageService.ListMessages:input_type -> construct.v1.ListMessagesRequest
+	15, // 82: construct.v1.MessageService.UpdateMessage:input_type -> construct.v1.UpdateMessageRequest
+	17, // 83: construct.v1.MessageService.DeleteMessage:input_type -> construct.v1.DeleteMessageRequest
+	10, // 84: construct.v1.MessageService.CreateMessage:output_type -> construct.v1.CreateMessageResponse
+	12, // 85: construct.v1.MessageService.GetMessage:output_type -> construct.v1.GetMessageResponse
+	14, // 86: construct.v1.MessageService.ListMessages:output_type -> construct.v1.ListMessagesResponse
+	16, // 87: construct.v1.MessageService.UpdateMessage:output_type -> construct.v1.UpdateMessageResponse
+	18, // 88: construct.v1.MessageService.DeleteMessage:output_type -> construct.v1.DeleteMessageResponse
+	84, // [84:89] is the sub-list for method output_type
+	79, // [79:84] is the sub-list for method input_type
+	79, // [79:79] is the sub-list for extension type_name
+	79, // [79:79] is the sub-list for extension extendee
+	0,  // [0:79] is the sub-list for field type_name
 }
 
 func init() { file_construct_v1_message_proto_init() }
@@ -4078,6 +6222,16 @@ func file_construct_v1_message_proto_init() {
 		(*ToolCall_ReadFile)(nil),
 		(*ToolCall_SubmitReport)(nil),
 		(*ToolCall_CodeInterpreter)(nil),
+		(*ToolCall_CustomTool)(nil),
+		(*ToolCall_McpTool)(nil),
+		(*ToolCall_Delegate)(nil),
+		(*ToolCall_RunParallel)(nil),
+		(*ToolCall_DeleteFile)(nil),
+		(*ToolCall_MoveFile)(nil),
+		(*ToolCall_CopyFile)(nil),
+		(*ToolCall_ApplyPatch)(nil),
+		(*ToolCall_Git)(nil),
+		(*ToolCall_RunTests)(nil),
 	}
 	file_construct_v1_message_proto_msgTypes[17].OneofWrappers = []any{
 		(*ToolResult_CreateFile)(nil),
@@ -4089,6 +6243,16 @@ func file_construct_v1_message_proto_init() {
 		(*ToolResult_ReadFile)(nil),
 		(*ToolResult_SubmitReport)(nil),
 		(*ToolResult_CodeInterpreter)(nil),
+		(*ToolResult_CustomTool)(nil),
+		(*ToolResult_McpTool)(nil),
+		(*ToolResult_Delegate)(nil),
+		(*ToolResult_RunParallel)(nil),
+		(*ToolResult_DeleteFile)(nil),
+		(*ToolResult_MoveFile)(nil),
+		(*ToolResult_CopyFile)(nil),
+		(*ToolResult_ApplyPatch)(nil),
+		(*ToolResult_Git)(nil),
+		(*ToolResult_RunTests)(nil),
 	}
 	file_construct_v1_message_proto_msgTypes[30].OneofWrappers = []any{}
 	type x struct{}
@@ -4096,8 +6260,8 @@ func file_construct_v1_message_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_construct_v1_message_proto_rawDesc), len(file_construct_v1_message_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   57,
+			NumEnums:      3,
+			NumMessages:   81,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
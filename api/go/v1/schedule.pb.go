@@ -0,0 +1,1450 @@
+// Schedule API provides CRUD operations for managing schedules within Construct.
+// Schedules run an agent on a recurring basis by creating a new task with a prompt
+// every time the cron expression fires.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: construct/v1/schedule.proto
+
+package v1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ScheduleRunStatus is the outcome of a schedule firing.
+type ScheduleRunStatus int32
+
+const (
+	// SCHEDULE_RUN_STATUS_UNSPECIFIED indicates that the status is not specified.
+	ScheduleRunStatus_SCHEDULE_RUN_STATUS_UNSPECIFIED ScheduleRunStatus = 0
+	// SCHEDULE_RUN_STATUS_STARTED means a task was created for the run.
+	ScheduleRunStatus_SCHEDULE_RUN_STATUS_STARTED ScheduleRunStatus = 1
+	// SCHEDULE_RUN_STATUS_SKIPPED means the run was skipped because the previous run was still active.
+	ScheduleRunStatus_SCHEDULE_RUN_STATUS_SKIPPED ScheduleRunStatus = 2
+	// SCHEDULE_RUN_STATUS_FAILED means the run could not be started, e.g. because the prompt
+	// template could not be rendered.
+	ScheduleRunStatus_SCHEDULE_RUN_STATUS_FAILED ScheduleRunStatus = 3
+)
+
+// Enum value maps for ScheduleRunStatus.
+var (
+	ScheduleRunStatus_name = map[int32]string{
+		0: "SCHEDULE_RUN_STATUS_UNSPECIFIED",
+		1: "SCHEDULE_RUN_STATUS_STARTED",
+		2: "SCHEDULE_RUN_STATUS_SKIPPED",
+		3: "SCHEDULE_RUN_STATUS_FAILED",
+	}
+	ScheduleRunStatus_value = map[string]int32{
+		"SCHEDULE_RUN_STATUS_UNSPECIFIED": 0,
+		"SCHEDULE_RUN_STATUS_STARTED":     1,
+		"SCHEDULE_RUN_STATUS_SKIPPED":     2,
+		"SCHEDULE_RUN_STATUS_FAILED":      3,
+	}
+)
+
+func (x ScheduleRunStatus) Enum() *ScheduleRunStatus {
+	p := new(ScheduleRunStatus)
+	*p = x
+	return p
+}
+
+func (x ScheduleRunStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScheduleRunStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_construct_v1_schedule_proto_enumTypes[0].Descriptor()
+}
+
+func (ScheduleRunStatus) Type() protoreflect.EnumType {
+	return &file_construct_v1_schedule_proto_enumTypes[0]
+}
+
+func (x ScheduleRunStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScheduleRunStatus.Descriptor instead.
+func (ScheduleRunStatus) EnumDescriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{0}
+}
+
+// Schedule represents a complete schedule entity with metadata, specification, and status.
+type Schedule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// metadata contains system-managed and immutable information about the schedule.
+	Metadata *ScheduleMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// spec contains the user-configurable specification of the schedule.
+	Spec *ScheduleSpec `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	// status contains the observed state of the schedule, managed by the system.
+	Status        *ScheduleStatus `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Schedule) Reset() {
+	*x = Schedule{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schedule) ProtoMessage() {}
+
+func (x *Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Schedule) GetMetadata() *ScheduleMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Schedule) GetSpec() *ScheduleSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *Schedule) GetStatus() *ScheduleStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// ScheduleMetadata contains system-managed, immutable information about a schedule.
+type ScheduleMetadata struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier for the schedule (UUID format).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// created_at is the timestamp when the schedule was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// updated_at is the timestamp when the schedule was last modified.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleMetadata) Reset() {
+	*x = ScheduleMetadata{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMetadata) ProtoMessage() {}
+
+func (x *ScheduleMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMetadata.ProtoReflect.Descriptor instead.
+func (*ScheduleMetadata) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ScheduleMetadata) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScheduleMetadata) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ScheduleMetadata) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// ScheduleSpec defines the user-configurable specification of a schedule.
+type ScheduleSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is a unique, human-readable identifier for the schedule.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// cron_expression is a standard five field cron expression (minute, hour, day of month, month,
+	// day of week) or a descriptor such as @daily or @every 1h.
+	CronExpression string `protobuf:"bytes,2,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression,omitempty"`
+	// agent_id references the agent that executes the scheduled runs (UUID format).
+	AgentId string `protobuf:"bytes,3,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	// workspace is the file system path where the scheduled runs are executed.
+	Workspace string `protobuf:"bytes,4,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	// prompt is a text/template that is rendered into the first message of every run.
+	// The template has access to .ScheduleName, .Workspace and .Time.
+	Prompt string `protobuf:"bytes,5,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	// max_cost is the maximum cost of a single run. A run that exceeds it is suspended.
+	// Zero means unlimited.
+	MaxCost float64 `protobuf:"fixed64,6,opt,name=max_cost,json=maxCost,proto3" json:"max_cost,omitempty"`
+	// enabled indicates whether the schedule creates new runs.
+	Enabled       bool `protobuf:"varint,7,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleSpec) Reset() {
+	*x = ScheduleSpec{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleSpec) ProtoMessage() {}
+
+func (x *ScheduleSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleSpec.ProtoReflect.Descriptor instead.
+func (*ScheduleSpec) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ScheduleSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScheduleSpec) GetCronExpression() string {
+	if x != nil {
+		return x.CronExpression
+	}
+	return ""
+}
+
+func (x *ScheduleSpec) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+func (x *ScheduleSpec) GetWorkspace() string {
+	if x != nil {
+		return x.Workspace
+	}
+	return ""
+}
+
+func (x *ScheduleSpec) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *ScheduleSpec) GetMaxCost() float64 {
+	if x != nil {
+		return x.MaxCost
+	}
+	return 0
+}
+
+func (x *ScheduleSpec) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// ScheduleStatus contains the observed state of the schedule.
+type ScheduleStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// last_run_at is the time the schedule last fired, whether or not a run was started.
+	LastRunAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=last_run_at,json=lastRunAt,proto3,oneof" json:"last_run_at,omitempty"`
+	// next_run_at is the next time the schedule will fire. Unset if the schedule is disabled.
+	NextRunAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=next_run_at,json=nextRunAt,proto3,oneof" json:"next_run_at,omitempty"`
+	// last_task_id references the task created by the most recent run (UUID format).
+	LastTaskId *string `protobuf:"bytes,3,opt,name=last_task_id,json=lastTaskId,proto3,oneof" json:"last_task_id,omitempty"`
+	// run_count is the total number of runs started by the schedule.
+	RunCount int64 `protobuf:"varint,4,opt,name=run_count,json=runCount,proto3" json:"run_count,omitempty"`
+	// skipped_count is the number of times the schedule fired while the previous run was still active.
+	SkippedCount  int64 `protobuf:"varint,5,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleStatus) Reset() {
+	*x = ScheduleStatus{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleStatus) ProtoMessage() {}
+
+func (x *ScheduleStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleStatus.ProtoReflect.Descriptor instead.
+func (*ScheduleStatus) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ScheduleStatus) GetLastRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRunAt
+	}
+	return nil
+}
+
+func (x *ScheduleStatus) GetNextRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return nil
+}
+
+func (x *ScheduleStatus) GetLastTaskId() string {
+	if x != nil && x.LastTaskId != nil {
+		return *x.LastTaskId
+	}
+	return ""
+}
+
+func (x *ScheduleStatus) GetRunCount() int64 {
+	if x != nil {
+		return x.RunCount
+	}
+	return 0
+}
+
+func (x *ScheduleStatus) GetSkippedCount() int64 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+// ScheduleRun records a single time a schedule fired.
+type ScheduleRun struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the run (UUID format).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// schedule_id references the schedule that fired (UUID format).
+	ScheduleId string `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// status is the outcome of the run.
+	Status ScheduleRunStatus `protobuf:"varint,3,opt,name=status,proto3,enum=construct.v1.ScheduleRunStatus" json:"status,omitempty"`
+	// reason explains why the run was skipped or failed.
+	Reason *string `protobuf:"bytes,4,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	// scheduled_at is the time the schedule was due.
+	ScheduledAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	// run_at is the time the scheduler handled the run. It is later than scheduled_at if the
+	// daemon was not running when the schedule was due.
+	RunAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=run_at,json=runAt,proto3" json:"run_at,omitempty"`
+	// task_id references the task created by the run (UUID format). Only set for started runs.
+	TaskId        *string `protobuf:"bytes,7,opt,name=task_id,json=taskId,proto3,oneof" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleRun) Reset() {
+	*x = ScheduleRun{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleRun) ProtoMessage() {}
+
+func (x *ScheduleRun) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleRun.ProtoReflect.Descriptor instead.
+func (*ScheduleRun) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ScheduleRun) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScheduleRun) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *ScheduleRun) GetStatus() ScheduleRunStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ScheduleRunStatus_SCHEDULE_RUN_STATUS_UNSPECIFIED
+}
+
+func (x *ScheduleRun) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *ScheduleRun) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *ScheduleRun) GetRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RunAt
+	}
+	return nil
+}
+
+func (x *ScheduleRun) GetTaskId() string {
+	if x != nil && x.TaskId != nil {
+		return *x.TaskId
+	}
+	return ""
+}
+
+// CreateScheduleRequest contains the parameters needed to create a new schedule.
+type CreateScheduleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is a unique, human-readable identifier for the schedule.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// cron_expression determines when the schedule fires.
+	CronExpression string `protobuf:"bytes,2,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression,omitempty"`
+	// agent_id references the agent that executes the scheduled runs (UUID format).
+	AgentId string `protobuf:"bytes,3,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	// workspace is the file system path where the scheduled runs are executed.
+	Workspace string `protobuf:"bytes,4,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	// prompt is a text/template that is rendered into the first message of every run.
+	Prompt string `protobuf:"bytes,5,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	// max_cost is the maximum cost of a single run. Zero means unlimited.
+	MaxCost float64 `protobuf:"fixed64,6,opt,name=max_cost,json=maxCost,proto3" json:"max_cost,omitempty"`
+	// disabled creates the schedule without activating it.
+	Disabled      bool `protobuf:"varint,7,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateScheduleRequest) Reset() {
+	*x = CreateScheduleRequest{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScheduleRequest) ProtoMessage() {}
+
+func (x *CreateScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CreateScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateScheduleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateScheduleRequest) GetCronExpression() string {
+	if x != nil {
+		return x.CronExpression
+	}
+	return ""
+}
+
+func (x *CreateScheduleRequest) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+func (x *CreateScheduleRequest) GetWorkspace() string {
+	if x != nil {
+		return x.Workspace
+	}
+	return ""
+}
+
+func (x *CreateScheduleRequest) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *CreateScheduleRequest) GetMaxCost() float64 {
+	if x != nil {
+		return x.MaxCost
+	}
+	return 0
+}
+
+func (x *CreateScheduleRequest) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+// CreateScheduleResponse contains the newly created schedule.
+type CreateScheduleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// schedule is the newly created schedule instance.
+	Schedule      *Schedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateScheduleResponse) Reset() {
+	*x = CreateScheduleResponse{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScheduleResponse) ProtoMessage() {}
+
+func (x *CreateScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScheduleResponse.ProtoReflect.Descriptor instead.
+func (*CreateScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateScheduleResponse) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+// GetScheduleRequest specifies which schedule to retrieve.
+type GetScheduleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the schedule to retrieve (UUID format).
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScheduleRequest) Reset() {
+	*x = GetScheduleRequest{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduleRequest) ProtoMessage() {}
+
+func (x *GetScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetScheduleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetScheduleResponse contains the requested schedule.
+type GetScheduleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// schedule is the requested schedule instance.
+	Schedule      *Schedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScheduleResponse) Reset() {
+	*x = GetScheduleResponse{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduleResponse) ProtoMessage() {}
+
+func (x *GetScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduleResponse.ProtoReflect.Descriptor instead.
+func (*GetScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetScheduleResponse) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+// ListSchedulesRequest specifies parameters for listing schedules with optional filtering.
+type ListSchedulesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// filter specifies criteria for narrowing the results.
+	Filter        *ListSchedulesRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesRequest) Reset() {
+	*x = ListSchedulesRequest{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesRequest) ProtoMessage() {}
+
+func (x *ListSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListSchedulesRequest) GetFilter() *ListSchedulesRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// ListSchedulesResponse contains the list of schedules matching the request criteria.
+type ListSchedulesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// schedules is the list of schedules matching the filter criteria.
+	Schedules     []*Schedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesResponse) Reset() {
+	*x = ListSchedulesResponse{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesResponse) ProtoMessage() {}
+
+func (x *ListSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*ListSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListSchedulesResponse) GetSchedules() []*Schedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+// UpdateScheduleRequest specifies which schedule to update and the new values for its fields.
+type UpdateScheduleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the schedule to update (UUID format).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name is the new name of the schedule.
+	Name *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	// cron_expression is the new cron expression of the schedule.
+	CronExpression *string `protobuf:"bytes,3,opt,name=cron_expression,json=cronExpression,proto3,oneof" json:"cron_expression,omitempty"`
+	// agent_id is the new agent of the schedule (UUID format).
+	AgentId *string `protobuf:"bytes,4,opt,name=agent_id,json=agentId,proto3,oneof" json:"agent_id,omitempty"`
+	// workspace is the new workspace of the schedule.
+	Workspace *string `protobuf:"bytes,5,opt,name=workspace,proto3,oneof" json:"workspace,omitempty"`
+	// prompt is the new prompt template of the schedule.
+	Prompt *string `protobuf:"bytes,6,opt,name=prompt,proto3,oneof" json:"prompt,omitempty"`
+	// max_cost is the new maximum cost of a single run.
+	MaxCost *float64 `protobuf:"fixed64,7,opt,name=max_cost,json=maxCost,proto3,oneof" json:"max_cost,omitempty"`
+	// enabled enables or disables the schedule.
+	Enabled       *bool `protobuf:"varint,8,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateScheduleRequest) Reset() {
+	*x = UpdateScheduleRequest{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScheduleRequest) ProtoMessage() {}
+
+func (x *UpdateScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScheduleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateScheduleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetCronExpression() string {
+	if x != nil && x.CronExpression != nil {
+		return *x.CronExpression
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetAgentId() string {
+	if x != nil && x.AgentId != nil {
+		return *x.AgentId
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetWorkspace() string {
+	if x != nil && x.Workspace != nil {
+		return *x.Workspace
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetPrompt() string {
+	if x != nil && x.Prompt != nil {
+		return *x.Prompt
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetMaxCost() float64 {
+	if x != nil && x.MaxCost != nil {
+		return *x.MaxCost
+	}
+	return 0
+}
+
+func (x *UpdateScheduleRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+// UpdateScheduleResponse contains the updated schedule.
+type UpdateScheduleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// schedule is the updated schedule instance.
+	Schedule      *Schedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateScheduleResponse) Reset() {
+	*x = UpdateScheduleResponse{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScheduleResponse) ProtoMessage() {}
+
+func (x *UpdateScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScheduleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateScheduleResponse) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+// DeleteScheduleRequest specifies which schedule to delete.
+type DeleteScheduleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the schedule to delete (UUID format).
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteScheduleRequest) Reset() {
+	*x = DeleteScheduleRequest{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteScheduleRequest) ProtoMessage() {}
+
+func (x *DeleteScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteScheduleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteScheduleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DeleteScheduleResponse confirms the schedule deletion (empty response).
+type DeleteScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteScheduleResponse) Reset() {
+	*x = DeleteScheduleResponse{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteScheduleResponse) ProtoMessage() {}
+
+func (x *DeleteScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteScheduleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{14}
+}
+
+// ListScheduleRunsRequest specifies which schedule's run history to retrieve.
+type ListScheduleRunsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// schedule_id is the unique identifier of the schedule (UUID format).
+	ScheduleId string `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// limit is the maximum number of runs to return. Zero returns all runs.
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduleRunsRequest) Reset() {
+	*x = ListScheduleRunsRequest{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduleRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduleRunsRequest) ProtoMessage() {}
+
+func (x *ListScheduleRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduleRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduleRunsRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListScheduleRunsRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *ListScheduleRunsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListScheduleRunsResponse contains the runs of a schedule, newest first.
+type ListScheduleRunsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// runs is the list of runs of the schedule.
+	Runs          []*ScheduleRun `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduleRunsResponse) Reset() {
+	*x = ListScheduleRunsResponse{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduleRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduleRunsResponse) ProtoMessage() {}
+
+func (x *ListScheduleRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduleRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduleRunsResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListScheduleRunsResponse) GetRuns() []*ScheduleRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+// Filter specifies criteria for narrowing the list of returned schedules.
+type ListSchedulesRequest_Filter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// agent_id filters schedules by the agent that executes them (UUID format, optional).
+	AgentId *string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3,oneof" json:"agent_id,omitempty"`
+	// name filters schedules by their exact name.
+	Name *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	// enabled filters schedules by whether they are enabled.
+	Enabled       *bool `protobuf:"varint,3,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesRequest_Filter) Reset() {
+	*x = ListSchedulesRequest_Filter{}
+	mi := &file_construct_v1_schedule_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesRequest_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesRequest_Filter) ProtoMessage() {}
+
+func (x *ListSchedulesRequest_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_schedule_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesRequest_Filter.ProtoReflect.Descriptor instead.
+func (*ListSchedulesRequest_Filter) Descriptor() ([]byte, []int) {
+	return file_construct_v1_schedule_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *ListSchedulesRequest_Filter) GetAgentId() string {
+	if x != nil && x.AgentId != nil {
+		return *x.AgentId
+	}
+	return ""
+}
+
+func (x *ListSchedulesRequest_Filter) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ListSchedulesRequest_Filter) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+var File_construct_v1_schedule_proto protoreflect.FileDescriptor
+
+const file_construct_v1_schedule_proto_rawDesc = "" +
+	"\n" +
+	"\x1bconstruct/v1/schedule.proto\x12\fconstruct.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xac\x01\n" +
+	"\bSchedule\x12:\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x1e.construct.v1.ScheduleMetadataR\bmetadata\x12.\n" +
+	"\x04spec\x18\x02 \x01(\v2\x1a.construct.v1.ScheduleSpecR\x04spec\x124\n" +
+	"\x06status\x18\x03 \x01(\v2\x1c.construct.v1.ScheduleStatusR\x06status\"\xb2\x01\n" +
+	"\x10ScheduleMetadata\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12A\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tcreatedAt\x12A\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tupdatedAt\"\x92\x02\n" +
+	"\fScheduleSpec\x12\x1e\n" +
+	"\x04name\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x120\n" +
+	"\x0fcron_expression\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x0ecronExpression\x12#\n" +
+	"\bagent_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aagentId\x12%\n" +
+	"\tworkspace\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tworkspace\x12\x1f\n" +
+	"\x06prompt\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06prompt\x12)\n" +
+	"\bmax_cost\x18\x06 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\amaxCost\x12\x18\n" +
+	"\aenabled\x18\a \x01(\bR\aenabled\"\xac\x02\n" +
+	"\x0eScheduleStatus\x12?\n" +
+	"\vlast_run_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tlastRunAt\x88\x01\x01\x12?\n" +
+	"\vnext_run_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\tnextRunAt\x88\x01\x01\x12%\n" +
+	"\flast_task_id\x18\x03 \x01(\tH\x02R\n" +
+	"lastTaskId\x88\x01\x01\x12\x1b\n" +
+	"\trun_count\x18\x04 \x01(\x03R\brunCount\x12#\n" +
+	"\rskipped_count\x18\x05 \x01(\x03R\fskippedCountB\x0e\n" +
+	"\f_last_run_atB\x0e\n" +
+	"\f_next_run_atB\x0f\n" +
+	"\r_last_task_id\"\xcf\x02\n" +
+	"\vScheduleRun\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12)\n" +
+	"\vschedule_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"scheduleId\x127\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1f.construct.v1.ScheduleRunStatusR\x06status\x12\x1b\n" +
+	"\x06reason\x18\x04 \x01(\tH\x00R\x06reason\x88\x01\x01\x12=\n" +
+	"\fscheduled_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\x121\n" +
+	"\x06run_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05runAt\x12\x1c\n" +
+	"\atask_id\x18\a \x01(\tH\x01R\x06taskId\x88\x01\x01B\t\n" +
+	"\a_reasonB\n" +
+	"\n" +
+	"\b_task_id\"\x9d\x02\n" +
+	"\x15CreateScheduleRequest\x12\x1e\n" +
+	"\x04name\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x120\n" +
+	"\x0fcron_expression\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x0ecronExpression\x12#\n" +
+	"\bagent_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aagentId\x12%\n" +
+	"\tworkspace\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tworkspace\x12\x1f\n" +
+	"\x06prompt\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06prompt\x12)\n" +
+	"\bmax_cost\x18\x06 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\amaxCost\x12\x1a\n" +
+	"\bdisabled\x18\a \x01(\bR\bdisabled\"T\n" +
+	"\x16CreateScheduleResponse\x12:\n" +
+	"\bschedule\x18\x01 \x01(\v2\x16.construct.v1.ScheduleB\x06\xbaH\x03\xc8\x01\x01R\bschedule\".\n" +
+	"\x12GetScheduleRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"Q\n" +
+	"\x13GetScheduleResponse\x12:\n" +
+	"\bschedule\x18\x01 \x01(\v2\x16.construct.v1.ScheduleB\x06\xbaH\x03\xc8\x01\x01R\bschedule\"\xe8\x01\n" +
+	"\x14ListSchedulesRequest\x12A\n" +
+	"\x06filter\x18\x01 \x01(\v2).construct.v1.ListSchedulesRequest.FilterR\x06filter\x1a\x8c\x01\n" +
+	"\x06Filter\x12(\n" +
+	"\bagent_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\aagentId\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x01R\x04name\x88\x01\x01\x12\x1d\n" +
+	"\aenabled\x18\x03 \x01(\bH\x02R\aenabled\x88\x01\x01B\v\n" +
+	"\t_agent_idB\a\n" +
+	"\x05_nameB\n" +
+	"\n" +
+	"\b_enabled\"M\n" +
+	"\x15ListSchedulesResponse\x124\n" +
+	"\tschedules\x18\x01 \x03(\v2\x16.construct.v1.ScheduleR\tschedules\"\xb4\x03\n" +
+	"\x15UpdateScheduleRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12#\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x00R\x04name\x88\x01\x01\x125\n" +
+	"\x0fcron_expression\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x01R\x0ecronExpression\x88\x01\x01\x12(\n" +
+	"\bagent_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x02R\aagentId\x88\x01\x01\x12*\n" +
+	"\tworkspace\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x03R\tworkspace\x88\x01\x01\x12$\n" +
+	"\x06prompt\x18\x06 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x04R\x06prompt\x88\x01\x01\x12.\n" +
+	"\bmax_cost\x18\a \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00H\x05R\amaxCost\x88\x01\x01\x12\x1d\n" +
+	"\aenabled\x18\b \x01(\bH\x06R\aenabled\x88\x01\x01B\a\n" +
+	"\x05_nameB\x12\n" +
+	"\x10_cron_expressionB\v\n" +
+	"\t_agent_idB\f\n" +
+	"\n" +
+	"_workspaceB\t\n" +
+	"\a_promptB\v\n" +
+	"\t_max_costB\n" +
+	"\n" +
+	"\b_enabled\"T\n" +
+	"\x16UpdateScheduleResponse\x12:\n" +
+	"\bschedule\x18\x01 \x01(\v2\x16.construct.v1.ScheduleB\x06\xbaH\x03\xc8\x01\x01R\bschedule\"1\n" +
+	"\x15DeleteScheduleRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x18\n" +
+	"\x16DeleteScheduleResponse\"c\n" +
+	"\x17ListScheduleRunsRequest\x12)\n" +
+	"\vschedule_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"scheduleId\x12\x1d\n" +
+	"\x05limit\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x05limit\"I\n" +
+	"\x18ListScheduleRunsResponse\x12-\n" +
+	"\x04runs\x18\x01 \x03(\v2\x19.construct.v1.ScheduleRunR\x04runs*\x9a\x01\n" +
+	"\x11ScheduleRunStatus\x12#\n" +
+	"\x1fSCHEDULE_RUN_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bSCHEDULE_RUN_STATUS_STARTED\x10\x01\x12\x1f\n" +
+	"\x1bSCHEDULE_RUN_STATUS_SKIPPED\x10\x02\x12\x1e\n" +
+	"\x1aSCHEDULE_RUN_STATUS_FAILED\x10\x032\xce\x04\n" +
+	"\x0fScheduleService\x12]\n" +
+	"\x0eCreateSchedule\x12#.construct.v1.CreateScheduleRequest\x1a$.construct.v1.CreateScheduleResponse\"\x00\x12W\n" +
+	"\vGetSchedule\x12 .construct.v1.GetScheduleRequest\x1a!.construct.v1.GetScheduleResponse\"\x03\x90\x02\x01\x12]\n" +
+	"\rListSchedules\x12\".construct.v1.ListSchedulesRequest\x1a#.construct.v1.ListSchedulesResponse\"\x03\x90\x02\x01\x12]\n" +
+	"\x0eUpdateSchedule\x12#.construct.v1.UpdateScheduleRequest\x1a$.construct.v1.UpdateScheduleResponse\"\x00\x12]\n" +
+	"\x0eDeleteSchedule\x12#.construct.v1.DeleteScheduleRequest\x1a$.construct.v1.DeleteScheduleResponse\"\x00\x12f\n" +
+	"\x10ListScheduleRuns\x12%.construct.v1.ListScheduleRunsRequest\x1a&.construct.v1.ListScheduleRunsResponse\"\x03\x90\x02\x01B(Z&github.com/furisto/construct/api/go/v1b\x06proto3"
+
+var (
+	file_construct_v1_schedule_proto_rawDescOnce sync.Once
+	file_construct_v1_schedule_proto_rawDescData []byte
+)
+
+func file_construct_v1_schedule_proto_rawDescGZIP() []byte {
+	file_construct_v1_schedule_proto_rawDescOnce.Do(func() {
+		file_construct_v1_schedule_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_construct_v1_schedule_proto_rawDesc), len(file_construct_v1_schedule_proto_rawDesc)))
+	})
+	return file_construct_v1_schedule_proto_rawDescData
+}
+
+var file_construct_v1_schedule_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_construct_v1_schedule_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_construct_v1_schedule_proto_goTypes = []any{
+	(ScheduleRunStatus)(0),              // 0: construct.v1.ScheduleRunStatus
+	(*Schedule)(nil),                    // 1: construct.v1.Schedule
+	(*ScheduleMetadata)(nil),            // 2: construct.v1.ScheduleMetadata
+	(*ScheduleSpec)(nil),                // 3: construct.v1.ScheduleSpec
+	(*ScheduleStatus)(nil),              // 4: construct.v1.ScheduleStatus
+	(*ScheduleRun)(nil),                 // 5: construct.v1.ScheduleRun
+	(*CreateScheduleRequest)(nil),       // 6: construct.v1.CreateScheduleRequest
+	(*CreateScheduleResponse)(nil),      // 7: construct.v1.CreateScheduleResponse
+	(*GetScheduleRequest)(nil),          // 8: construct.v1.GetScheduleRequest
+	(*GetScheduleResponse)(nil),         // 9: construct.v1.GetScheduleResponse
+	(*ListSchedulesRequest)(nil),        // 10: construct.v1.ListSchedulesRequest
+	(*ListSchedulesResponse)(nil),       // 11: construct.v1.ListSchedulesResponse
+	(*UpdateScheduleRequest)(nil),       // 12: construct.v1.UpdateScheduleRequest
+	(*UpdateScheduleResponse)(nil),      // 13: construct.v1.UpdateScheduleResponse
+	(*DeleteScheduleRequest)(nil),       // 14: construct.v1.DeleteScheduleRequest
+	(*DeleteScheduleResponse)(nil),      // 15: construct.v1.DeleteScheduleResponse
+	(*ListScheduleRunsRequest)(nil),     // 16: construct.v1.ListScheduleRunsRequest
+	(*ListScheduleRunsResponse)(nil),    // 17: construct.v1.ListScheduleRunsResponse
+	(*ListSchedulesRequest_Filter)(nil), // 18: construct.v1.ListSchedulesRequest.Filter
+	(*timestamppb.Timestamp)(nil),       // 19: google.protobuf.Timestamp
+}
+var file_construct_v1_schedule_proto_depIdxs = []int32{
+	2,  // 0: construct.v1.Schedule.metadata:type_name -> construct.v1.ScheduleMetadata
+	3,  // 1: construct.v1.Schedule.spec:type_name -> construct.v1.ScheduleSpec
+	4,  // 2: construct.v1.Schedule.status:type_name -> construct.v1.ScheduleStatus
+	19, // 3: construct.v1.ScheduleMetadata.created_at:type_name -> google.protobuf.Timestamp
+	19, // 4: construct.v1.ScheduleMetadata.updated_at:type_name -> google.protobuf.Timestamp
+	19, // 5: construct.v1.ScheduleStatus.last_run_at:type_name -> google.protobuf.Timestamp
+	19, // 6: construct.v1.ScheduleStatus.next_run_at:type_name -> google.protobuf.Timestamp
+	0,  // 7: construct.v1.ScheduleRun.status:type_name -> construct.v1.ScheduleRunStatus
+	19, // 8: construct.v1.ScheduleRun.scheduled_at:type_name -> google.protobuf.Timestamp
+	19, // 9: construct.v1.ScheduleRun.run_at:type_name -> google.protobuf.Timestamp
+	1,  // 10: construct.v1.CreateScheduleResponse.schedule:type_name -> construct.v1.Schedule
+	1,  // 11: construct.v1.GetScheduleResponse.schedule:type_name -> construct.v1.Schedule
+	18, // 12: construct.v1.ListSchedulesRequest.filter:type_name -> construct.v1.ListSchedulesRequest.Filter
+	1,  // 13: construct.v1.ListSchedulesResponse.schedules:type_name -> construct.v1.Schedule
+	1,  // 14: construct.v1.UpdateScheduleResponse.schedule:type_name -> construct.v1.Schedule
+	5,  // 15: construct.v1.ListScheduleRunsResponse.runs:type_name -> construct.v1.ScheduleRun
+	6,  // 16: construct.v1.ScheduleService.CreateSchedule:input_type -> construct.v1.CreateScheduleRequest
+	8,  // 17: construct.v1.ScheduleService.GetSchedule:input_type -> construct.v1.GetScheduleRequest
+	10, // 18: construct.v1.ScheduleService.ListSchedules:input_type -> construct.v1.ListSchedulesRequest
+	12, // 19: construct.v1.ScheduleService.UpdateSchedule:input_type -> construct.v1.UpdateScheduleRequest
+	14, // 20: construct.v1.ScheduleService.DeleteSchedule:input_type -> construct.v1.DeleteScheduleRequest
+	16, // 21: construct.v1.ScheduleService.ListScheduleRuns:input_type -> construct.v1.ListScheduleRunsRequest
+	7,  // 22: construct.v1.ScheduleService.CreateSchedule:output_type -> construct.v1.CreateScheduleResponse
+	9,  // 23: construct.v1.ScheduleService.GetSchedule:output_type -> construct.v1.GetScheduleResponse
+	11, // 24: construct.v1.ScheduleService.ListSchedules:output_type -> construct.v1.ListSchedulesResponse
+	13, // 25: construct.v1.ScheduleService.UpdateSchedule:output_type -> construct.v1.UpdateScheduleResponse
+	15, // 26: construct.v1.ScheduleService.DeleteSchedule:output_type -> construct.v1.DeleteScheduleResponse
+	17, // 27: construct.v1.ScheduleService.ListScheduleRuns:output_type -> construct.v1.ListScheduleRunsResponse
+	22, // [22:28] is the sub-list for method output_type
+	16, // [16:22] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_construct_v1_schedule_proto_init() }
+func file_construct_v1_schedule_proto_init() {
+	if File_construct_v1_schedule_proto != nil {
+		return
+	}
+	file_construct_v1_schedule_proto_msgTypes[3].OneofWrappers = []any{}
+	file_construct_v1_schedule_proto_msgTypes[4].OneofWrappers = []any{}
+	file_construct_v1_schedule_proto_msgTypes[11].OneofWrappers = []any{}
+	file_construct_v1_schedule_proto_msgTypes[17].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_construct_v1_schedule_proto_rawDesc), len(file_construct_v1_schedule_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_construct_v1_schedule_proto_goTypes,
+		DependencyIndexes: file_construct_v1_schedule_proto_depIdxs,
+		EnumInfos:         file_construct_v1_schedule_proto_enumTypes,
+		MessageInfos:      file_construct_v1_schedule_proto_msgTypes,
+	}.Build()
+	File_construct_v1_schedule_proto = out.File
+	file_construct_v1_schedule_proto_goTypes = nil
+	file_construct_v1_schedule_proto_depIdxs = nil
+}
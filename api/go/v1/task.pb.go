@@ -84,6 +84,59 @@ func (TaskPhase) EnumDescriptor() ([]byte, []int) {
 	return file_construct_v1_task_proto_rawDescGZIP(), []int{0}
 }
 
+// TranscriptFormat specifies the document format of a rendered task transcript.
+type TranscriptFormat int32
+
+const (
+	// TRANSCRIPT_FORMAT_UNSPECIFIED defaults to markdown.
+	TranscriptFormat_TRANSCRIPT_FORMAT_UNSPECIFIED TranscriptFormat = 0
+	// TRANSCRIPT_FORMAT_MARKDOWN renders the transcript as GitHub flavored markdown.
+	TranscriptFormat_TRANSCRIPT_FORMAT_MARKDOWN TranscriptFormat = 1
+	// TRANSCRIPT_FORMAT_HTML renders the transcript as a standalone HTML document.
+	TranscriptFormat_TRANSCRIPT_FORMAT_HTML TranscriptFormat = 2
+)
+
+// Enum value maps for TranscriptFormat.
+var (
+	TranscriptFormat_name = map[int32]string{
+		0: "TRANSCRIPT_FORMAT_UNSPECIFIED",
+		1: "TRANSCRIPT_FORMAT_MARKDOWN",
+		2: "TRANSCRIPT_FORMAT_HTML",
+	}
+	TranscriptFormat_value = map[string]int32{
+		"TRANSCRIPT_FORMAT_UNSPECIFIED": 0,
+		"TRANSCRIPT_FORMAT_MARKDOWN":    1,
+		"TRANSCRIPT_FORMAT_HTML":        2,
+	}
+)
+
+func (x TranscriptFormat) Enum() *TranscriptFormat {
+	p := new(TranscriptFormat)
+	*p = x
+	return p
+}
+
+func (x TranscriptFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TranscriptFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_construct_v1_task_proto_enumTypes[1].Descriptor()
+}
+
+func (TranscriptFormat) Type() protoreflect.EnumType {
+	return &file_construct_v1_task_proto_enumTypes[1]
+}
+
+func (x TranscriptFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TranscriptFormat.Descriptor instead.
+func (TranscriptFormat) EnumDescriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{1}
+}
+
 // Task represents a complete task entity with metadata, specification, and status.
 type Task struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -222,7 +275,20 @@ type TaskSpec struct {
 	// phase is the desired operational state of the task.
 	DesiredPhase TaskPhase `protobuf:"varint,3,opt,name=desired_phase,json=desiredPhase,proto3,enum=construct.v1.TaskPhase" json:"desired_phase,omitempty"`
 	// description is a brief description of the task.
-	Description   string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// schedule_id references the schedule that created this task (UUID format, optional).
+	ScheduleId *string `protobuf:"bytes,5,opt,name=schedule_id,json=scheduleId,proto3,oneof" json:"schedule_id,omitempty"`
+	// max_cost is the budget of the task. The task is suspended once its cost exceeds it.
+	// Zero means unlimited.
+	MaxCost float64 `protobuf:"fixed64,6,opt,name=max_cost,json=maxCost,proto3" json:"max_cost,omitempty"`
+	// parent_task_id references the task that delegated this task (UUID format, optional).
+	ParentTaskId *string `protobuf:"bytes,7,opt,name=parent_task_id,json=parentTaskId,proto3,oneof" json:"parent_task_id,omitempty"`
+	// persistent_state keeps the JavaScript state of the code interpreter between calls, so
+	// variables defined by one script are available to the next one.
+	PersistentState bool `protobuf:"varint,8,opt,name=persistent_state,json=persistentState,proto3" json:"persistent_state,omitempty"`
+	// preview stages the file changes of the task for review instead of writing them to the
+	// workspace. Staged changes are written with ApplyPreview.
+	Preview       bool `protobuf:"varint,9,opt,name=preview,proto3" json:"preview,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -285,6 +351,41 @@ func (x *TaskSpec) GetDescription() string {
 	return ""
 }
 
+func (x *TaskSpec) GetScheduleId() string {
+	if x != nil && x.ScheduleId != nil {
+		return *x.ScheduleId
+	}
+	return ""
+}
+
+func (x *TaskSpec) GetMaxCost() float64 {
+	if x != nil {
+		return x.MaxCost
+	}
+	return 0
+}
+
+func (x *TaskSpec) GetParentTaskId() string {
+	if x != nil && x.ParentTaskId != nil {
+		return *x.ParentTaskId
+	}
+	return ""
+}
+
+func (x *TaskSpec) GetPersistentState() bool {
+	if x != nil {
+		return x.PersistentState
+	}
+	return false
+}
+
+func (x *TaskSpec) GetPreview() bool {
+	if x != nil {
+		return x.Preview
+	}
+	return false
+}
+
 // TaskStatus contains the observed state and usage information of the task.
 type TaskStatus struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -295,9 +396,14 @@ type TaskStatus struct {
 	// turn is the current turn of the task.
 	Turn int64 `protobuf:"varint,3,opt,name=turn,proto3" json:"turn,omitempty"`
 	// message_count is the total number of messages associated with this task.
-	MessageCount  int64 `protobuf:"varint,4,opt,name=message_count,json=messageCount,proto3" json:"message_count,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	MessageCount int64 `protobuf:"varint,4,opt,name=message_count,json=messageCount,proto3" json:"message_count,omitempty"`
+	// pending_messages is the number of user messages that have been sent but not yet processed.
+	PendingMessages int64 `protobuf:"varint,5,opt,name=pending_messages,json=pendingMessages,proto3" json:"pending_messages,omitempty"`
+	// instruction_files are the repository instruction files (e.g. AGENTS.md) that have been
+	// included in the conversation of the task.
+	InstructionFiles []string `protobuf:"bytes,6,rep,name=instruction_files,json=instructionFiles,proto3" json:"instruction_files,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *TaskStatus) Reset() {
@@ -358,6 +464,20 @@ func (x *TaskStatus) GetMessageCount() int64 {
 	return 0
 }
 
+func (x *TaskStatus) GetPendingMessages() int64 {
+	if x != nil {
+		return x.PendingMessages
+	}
+	return 0
+}
+
+func (x *TaskStatus) GetInstructionFiles() []string {
+	if x != nil {
+		return x.InstructionFiles
+	}
+	return nil
+}
+
 // TaskUsage tracks resource consumption and associated costs for a task.
 type TaskUsage struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -457,7 +577,11 @@ type CreateTaskRequest struct {
 	// project_directory is the file system path where the task will be executed.
 	ProjectDirectory string `protobuf:"bytes,2,opt,name=project_directory,json=projectDirectory,proto3" json:"project_directory,omitempty"`
 	// description is a brief description of the task.
-	Description   string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// persistent_state keeps the JavaScript state of the code interpreter between calls.
+	PersistentState bool `protobuf:"varint,4,opt,name=persistent_state,json=persistentState,proto3" json:"persistent_state,omitempty"`
+	// preview stages the file changes of the task for review instead of writing them to the workspace.
+	Preview       bool `protobuf:"varint,5,opt,name=preview,proto3" json:"preview,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -513,6 +637,20 @@ func (x *CreateTaskRequest) GetDescription() string {
 	return ""
 }
 
+func (x *CreateTaskRequest) GetPersistentState() bool {
+	if x != nil {
+		return x.PersistentState
+	}
+	return false
+}
+
+func (x *CreateTaskRequest) GetPreview() bool {
+	if x != nil {
+		return x.Preview
+	}
+	return false
+}
+
 // CreateTaskResponse contains the newly created task.
 type CreateTaskResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1233,6 +1371,364 @@ func (*SuspendTaskResponse) Descriptor() ([]byte, []int) {
 	return file_construct_v1_task_proto_rawDescGZIP(), []int{19}
 }
 
+// RenderTaskRequest specifies which task to render and in which format.
+type RenderTaskRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the task to render (UUID format).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// format is the document format of the transcript.
+	Format        TranscriptFormat `protobuf:"varint,2,opt,name=format,proto3,enum=construct.v1.TranscriptFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenderTaskRequest) Reset() {
+	*x = RenderTaskRequest{}
+	mi := &file_construct_v1_task_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderTaskRequest) ProtoMessage() {}
+
+func (x *RenderTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_task_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderTaskRequest.ProtoReflect.Descriptor instead.
+func (*RenderTaskRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RenderTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RenderTaskRequest) GetFormat() TranscriptFormat {
+	if x != nil {
+		return x.Format
+	}
+	return TranscriptFormat_TRANSCRIPT_FORMAT_UNSPECIFIED
+}
+
+// RenderTaskResponse contains the rendered transcript.
+type RenderTaskResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// content is the rendered transcript.
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// content_type is the media type of the content, e.g. text/markdown or text/html.
+	ContentType   string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenderTaskResponse) Reset() {
+	*x = RenderTaskResponse{}
+	mi := &file_construct_v1_task_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderTaskResponse) ProtoMessage() {}
+
+func (x *RenderTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_task_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderTaskResponse.ProtoReflect.Descriptor instead.
+func (*RenderTaskResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RenderTaskResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *RenderTaskResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+// PreviewChange is a file change staged by a task in preview mode.
+type PreviewChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// path is the absolute path of the file.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// created is true if the file does not exist in the workspace yet.
+	Created bool `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	// patch is the unified diff between the workspace and the staged content of the file.
+	Patch         string `protobuf:"bytes,3,opt,name=patch,proto3" json:"patch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewChange) Reset() {
+	*x = PreviewChange{}
+	mi := &file_construct_v1_task_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewChange) ProtoMessage() {}
+
+func (x *PreviewChange) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_task_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewChange.ProtoReflect.Descriptor instead.
+func (*PreviewChange) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PreviewChange) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PreviewChange) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+func (x *PreviewChange) GetPatch() string {
+	if x != nil {
+		return x.Patch
+	}
+	return ""
+}
+
+// GetPreviewRequest specifies the task whose staged changes to return.
+type GetPreviewRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the task (UUID format).
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPreviewRequest) Reset() {
+	*x = GetPreviewRequest{}
+	mi := &file_construct_v1_task_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPreviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPreviewRequest) ProtoMessage() {}
+
+func (x *GetPreviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_task_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPreviewRequest.ProtoReflect.Descriptor instead.
+func (*GetPreviewRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetPreviewRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetPreviewResponse contains the staged changes of the task.
+type GetPreviewResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// changes are the staged files that differ from the workspace, ordered by path.
+	Changes       []*PreviewChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPreviewResponse) Reset() {
+	*x = GetPreviewResponse{}
+	mi := &file_construct_v1_task_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPreviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPreviewResponse) ProtoMessage() {}
+
+func (x *GetPreviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_task_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPreviewResponse.ProtoReflect.Descriptor instead.
+func (*GetPreviewResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetPreviewResponse) GetChanges() []*PreviewChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// ApplyPreviewRequest specifies the task whose staged changes to write.
+type ApplyPreviewRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the task (UUID format).
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyPreviewRequest) Reset() {
+	*x = ApplyPreviewRequest{}
+	mi := &file_construct_v1_task_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyPreviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyPreviewRequest) ProtoMessage() {}
+
+func (x *ApplyPreviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_task_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyPreviewRequest.ProtoReflect.Descriptor instead.
+func (*ApplyPreviewRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ApplyPreviewRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ApplyPreviewResponse contains the changes that were written to the workspace.
+type ApplyPreviewResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// changes are the files that were written, ordered by path.
+	Changes       []*PreviewChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyPreviewResponse) Reset() {
+	*x = ApplyPreviewResponse{}
+	mi := &file_construct_v1_task_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyPreviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyPreviewResponse) ProtoMessage() {}
+
+func (x *ApplyPreviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_task_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyPreviewResponse.ProtoReflect.Descriptor instead.
+func (*ApplyPreviewResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ApplyPreviewResponse) GetChanges() []*PreviewChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
 // Filter specifies criteria for narrowing the list of returned tasks.
 type ListTasksRequest_Filter struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1244,14 +1740,18 @@ type ListTasksRequest_Filter struct {
 	// - if set to true: only tasks with at least one message
 	// - if set to false: only tasks with zero messages
 	// - if unset: no filtering by message presence
-	HasMessages   *bool `protobuf:"varint,3,opt,name=has_messages,json=hasMessages,proto3,oneof" json:"has_messages,omitempty"`
+	HasMessages *bool `protobuf:"varint,3,opt,name=has_messages,json=hasMessages,proto3,oneof" json:"has_messages,omitempty"`
+	// schedule_id filters tasks by the schedule that created them (UUID format, optional).
+	ScheduleId *string `protobuf:"bytes,4,opt,name=schedule_id,json=scheduleId,proto3,oneof" json:"schedule_id,omitempty"`
+	// parent_task_id filters tasks by the task that delegated them (UUID format, optional).
+	ParentTaskId  *string `protobuf:"bytes,5,opt,name=parent_task_id,json=parentTaskId,proto3,oneof" json:"parent_task_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListTasksRequest_Filter) Reset() {
 	*x = ListTasksRequest_Filter{}
-	mi := &file_construct_v1_task_proto_msgTypes[21]
+	mi := &file_construct_v1_task_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1263,7 +1763,7 @@ func (x *ListTasksRequest_Filter) String() string {
 func (*ListTasksRequest_Filter) ProtoMessage() {}
 
 func (x *ListTasksRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_task_proto_msgTypes[21]
+	mi := &file_construct_v1_task_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1300,6 +1800,20 @@ func (x *ListTasksRequest_Filter) GetHasMessages() bool {
 	return false
 }
 
+func (x *ListTasksRequest_Filter) GetScheduleId() string {
+	if x != nil && x.ScheduleId != nil {
+		return *x.ScheduleId
+	}
+	return ""
+}
+
+func (x *ListTasksRequest_Filter) GetParentTaskId() string {
+	if x != nil && x.ParentTaskId != nil {
+		return *x.ParentTaskId
+	}
+	return ""
+}
+
 var File_construct_v1_task_proto protoreflect.FileDescriptor
 
 const file_construct_v1_task_proto_rawDesc = "" +
@@ -1314,19 +1828,29 @@ const file_construct_v1_task_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tcreatedAt\x12A\n" +
 	"\n" +
-	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tupdatedAt\"\xdb\x01\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tupdatedAt\"\xd3\x03\n" +
 	"\bTaskSpec\x12(\n" +
 	"\bagent_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\aagentId\x88\x01\x01\x12$\n" +
 	"\tworkspace\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\tworkspace\x12F\n" +
 	"\rdesired_phase\x18\x03 \x01(\x0e2\x17.construct.v1.TaskPhaseB\b\xbaH\x05\x82\x01\x02\x10\x01R\fdesiredPhase\x12*\n" +
-	"\vdescription\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10R\vdescriptionB\v\n" +
-	"\t_agent_id\"\xad\x01\n" +
+	"\vdescription\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10R\vdescription\x12.\n" +
+	"\vschedule_id\x18\x05 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x01R\n" +
+	"scheduleId\x88\x01\x01\x12)\n" +
+	"\bmax_cost\x18\x06 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\amaxCost\x123\n" +
+	"\x0eparent_task_id\x18\a \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x02R\fparentTaskId\x88\x01\x01\x12)\n" +
+	"\x10persistent_state\x18\b \x01(\bR\x0fpersistentState\x12\x18\n" +
+	"\apreview\x18\t \x01(\bR\apreviewB\v\n" +
+	"\t_agent_idB\x0e\n" +
+	"\f_schedule_idB\x11\n" +
+	"\x0f_parent_task_id\"\x85\x02\n" +
 	"\n" +
 	"TaskStatus\x12-\n" +
 	"\x05usage\x18\x01 \x01(\v2\x17.construct.v1.TaskUsageR\x05usage\x127\n" +
 	"\x05phase\x18\x02 \x01(\x0e2\x17.construct.v1.TaskPhaseB\b\xbaH\x05\x82\x01\x02\x10\x01R\x05phase\x12\x12\n" +
 	"\x04turn\x18\x03 \x01(\x03R\x04turn\x12#\n" +
-	"\rmessage_count\x18\x04 \x01(\x03R\fmessageCount\"\xc2\x02\n" +
+	"\rmessage_count\x18\x04 \x01(\x03R\fmessageCount\x12)\n" +
+	"\x10pending_messages\x18\x05 \x01(\x03R\x0fpendingMessages\x12+\n" +
+	"\x11instruction_files\x18\x06 \x03(\tR\x10instructionFiles\"\xc2\x02\n" +
 	"\tTaskUsage\x12!\n" +
 	"\finput_tokens\x18\x01 \x01(\x03R\vinputTokens\x12#\n" +
 	"\routput_tokens\x18\x02 \x01(\x03R\foutputTokens\x12,\n" +
@@ -1336,17 +1860,19 @@ const file_construct_v1_task_proto_rawDesc = "" +
 	"\ttool_uses\x18\x06 \x03(\v2%.construct.v1.TaskUsage.ToolUsesEntryR\btoolUses\x1a;\n" +
 	"\rToolUsesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\x99\x01\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\xde\x01\n" +
 	"\x11CreateTaskRequest\x12#\n" +
 	"\bagent_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aagentId\x123\n" +
 	"\x11project_directory\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x10projectDirectory\x12*\n" +
-	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10R\vdescription\"D\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10R\vdescription\x12)\n" +
+	"\x10persistent_state\x18\x04 \x01(\bR\x0fpersistentState\x12\x18\n" +
+	"\apreview\x18\x05 \x01(\bR\apreview\"D\n" +
 	"\x12CreateTaskResponse\x12.\n" +
 	"\x04task\x18\x01 \x01(\v2\x12.construct.v1.TaskB\x06\xbaH\x03\xc8\x01\x01R\x04task\"*\n" +
 	"\x0eGetTaskRequest\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"A\n" +
 	"\x0fGetTaskResponse\x12.\n" +
-	"\x04task\x18\x01 \x01(\v2\x12.construct.v1.TaskB\x06\xbaH\x03\xc8\x01\x01R\x04task\"\x9a\x04\n" +
+	"\x04task\x18\x01 \x01(\v2\x12.construct.v1.TaskB\x06\xbaH\x03\xc8\x01\x01R\x04task\"\xa2\x05\n" +
 	"\x10ListTasksRequest\x12=\n" +
 	"\x06filter\x18\x01 \x01(\v2%.construct.v1.ListTasksRequest.FilterR\x06filter\x12+\n" +
 	"\tpage_size\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x01H\x00R\bpageSize\x88\x01\x01\x12'\n" +
@@ -1355,14 +1881,19 @@ const file_construct_v1_task_proto_rawDesc = "" +
 	"\n" +
 	"sort_field\x18\x04 \x01(\x0e2\x17.construct.v1.SortFieldB\b\xbaH\x05\x82\x01\x02\x10\x01H\x01R\tsortField\x88\x01\x01\x12E\n" +
 	"\n" +
-	"sort_order\x18\x05 \x01(\x0e2\x17.construct.v1.SortOrderB\b\xbaH\x05\x82\x01\x02\x10\x01H\x02R\tsortOrder\x88\x01\x01\x1a\xb6\x01\n" +
+	"sort_order\x18\x05 \x01(\x0e2\x17.construct.v1.SortOrderB\b\xbaH\x05\x82\x01\x02\x10\x01H\x02R\tsortOrder\x88\x01\x01\x1a\xbe\x02\n" +
 	"\x06Filter\x12(\n" +
 	"\bagent_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\aagentId\x88\x01\x01\x12)\n" +
 	"\x0etask_id_prefix\x18\x02 \x01(\tH\x01R\ftaskIdPrefix\x88\x01\x01\x12&\n" +
-	"\fhas_messages\x18\x03 \x01(\bH\x02R\vhasMessages\x88\x01\x01B\v\n" +
+	"\fhas_messages\x18\x03 \x01(\bH\x02R\vhasMessages\x88\x01\x01\x12.\n" +
+	"\vschedule_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x03R\n" +
+	"scheduleId\x88\x01\x01\x123\n" +
+	"\x0eparent_task_id\x18\x05 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x04R\fparentTaskId\x88\x01\x01B\v\n" +
 	"\t_agent_idB\x11\n" +
 	"\x0f_task_id_prefixB\x0f\n" +
-	"\r_has_messagesB\f\n" +
+	"\r_has_messagesB\x0e\n" +
+	"\f_schedule_idB\x11\n" +
+	"\x0f_parent_task_idB\f\n" +
 	"\n" +
 	"_page_sizeB\r\n" +
 	"\v_sort_fieldB\r\n" +
@@ -1391,12 +1922,34 @@ const file_construct_v1_task_proto_rawDesc = "" +
 	"\x05event\"7\n" +
 	"\x12SuspendTaskRequest\x12!\n" +
 	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\"\x15\n" +
-	"\x13SuspendTaskResponse*r\n" +
+	"\x13SuspendTaskResponse\"o\n" +
+	"\x11RenderTaskRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12@\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x1e.construct.v1.TranscriptFormatB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06format\"Q\n" +
+	"\x12RenderTaskResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\"S\n" +
+	"\rPreviewChange\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\bR\acreated\x12\x14\n" +
+	"\x05patch\x18\x03 \x01(\tR\x05patch\"-\n" +
+	"\x11GetPreviewRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"K\n" +
+	"\x12GetPreviewResponse\x125\n" +
+	"\achanges\x18\x01 \x03(\v2\x1b.construct.v1.PreviewChangeR\achanges\"/\n" +
+	"\x13ApplyPreviewRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"M\n" +
+	"\x14ApplyPreviewResponse\x125\n" +
+	"\achanges\x18\x01 \x03(\v2\x1b.construct.v1.PreviewChangeR\achanges*r\n" +
 	"\tTaskPhase\x12\x1a\n" +
 	"\x16TASK_PHASE_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13TASK_PHASE_AWAITING\x10\x01\x12\x16\n" +
 	"\x12TASK_PHASE_RUNNING\x10\x02\x12\x18\n" +
-	"\x14TASK_PHASE_SUSPENDED\x10\x032\xce\x04\n" +
+	"\x14TASK_PHASE_SUSPENDED\x10\x03*q\n" +
+	"\x10TranscriptFormat\x12!\n" +
+	"\x1dTRANSCRIPT_FORMAT_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aTRANSCRIPT_FORMAT_MARKDOWN\x10\x01\x12\x1a\n" +
+	"\x16TRANSCRIPT_FORMAT_HTML\x10\x022\xd3\x06\n" +
 	"\vTaskService\x12Q\n" +
 	"\n" +
 	"CreateTask\x12\x1f.construct.v1.CreateTaskRequest\x1a .construct.v1.CreateTaskResponse\"\x00\x12K\n" +
@@ -1407,7 +1960,12 @@ const file_construct_v1_task_proto_rawDesc = "" +
 	"\n" +
 	"DeleteTask\x12\x1f.construct.v1.DeleteTaskRequest\x1a .construct.v1.DeleteTaskResponse\"\x00\x12P\n" +
 	"\tSubscribe\x12\x1e.construct.v1.SubscribeRequest\x1a\x1f.construct.v1.SubscribeResponse\"\x000\x01\x12T\n" +
-	"\vSuspendTask\x12 .construct.v1.SuspendTaskRequest\x1a!.construct.v1.SuspendTaskResponse\"\x00B(Z&github.com/furisto/construct/api/go/v1b\x06proto3"
+	"\vSuspendTask\x12 .construct.v1.SuspendTaskRequest\x1a!.construct.v1.SuspendTaskResponse\"\x00\x12T\n" +
+	"\n" +
+	"RenderTask\x12\x1f.construct.v1.RenderTaskRequest\x1a .construct.v1.RenderTaskResponse\"\x03\x90\x02\x01\x12T\n" +
+	"\n" +
+	"GetPreview\x12\x1f.construct.v1.GetPreviewRequest\x1a .construct.v1.GetPreviewResponse\"\x03\x90\x02\x01\x12W\n" +
+	"\fApplyPreview\x12!.construct.v1.ApplyPreviewRequest\x1a\".construct.v1.ApplyPreviewResponse\"\x00B(Z&github.com/furisto/construct/api/go/v1b\x06proto3"
 
 var (
 	file_construct_v1_task_proto_rawDescOnce sync.Once
@@ -1421,76 +1979,93 @@ func file_construct_v1_task_proto_rawDescGZIP() []byte {
 	return file_construct_v1_task_proto_rawDescData
 }
 
-var file_construct_v1_task_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_construct_v1_task_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_construct_v1_task_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_construct_v1_task_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_construct_v1_task_proto_goTypes = []any{
 	(TaskPhase)(0),                  // 0: construct.v1.TaskPhase
-	(*Task)(nil),                    // 1: construct.v1.Task
-	(*TaskMetadata)(nil),            // 2: construct.v1.TaskMetadata
-	(*TaskSpec)(nil),                // 3: construct.v1.TaskSpec
-	(*TaskStatus)(nil),              // 4: construct.v1.TaskStatus
-	(*TaskUsage)(nil),               // 5: construct.v1.TaskUsage
-	(*CreateTaskRequest)(nil),       // 6: construct.v1.CreateTaskRequest
-	(*CreateTaskResponse)(nil),      // 7: construct.v1.CreateTaskResponse
-	(*GetTaskRequest)(nil),          // 8: construct.v1.GetTaskRequest
-	(*GetTaskResponse)(nil),         // 9: construct.v1.GetTaskResponse
-	(*ListTasksRequest)(nil),        // 10: construct.v1.ListTasksRequest
-	(*ListTasksResponse)(nil),       // 11: construct.v1.ListTasksResponse
-	(*UpdateTaskRequest)(nil),       // 12: construct.v1.UpdateTaskRequest
-	(*UpdateTaskResponse)(nil),      // 13: construct.v1.UpdateTaskResponse
-	(*DeleteTaskRequest)(nil),       // 14: construct.v1.DeleteTaskRequest
-	(*DeleteTaskResponse)(nil),      // 15: construct.v1.DeleteTaskResponse
-	(*SubscribeRequest)(nil),        // 16: construct.v1.SubscribeRequest
-	(*TaskEvent)(nil),               // 17: construct.v1.TaskEvent
-	(*SubscribeResponse)(nil),       // 18: construct.v1.SubscribeResponse
-	(*SuspendTaskRequest)(nil),      // 19: construct.v1.SuspendTaskRequest
-	(*SuspendTaskResponse)(nil),     // 20: construct.v1.SuspendTaskResponse
-	nil,                             // 21: construct.v1.TaskUsage.ToolUsesEntry
-	(*ListTasksRequest_Filter)(nil), // 22: construct.v1.ListTasksRequest.Filter
-	(*timestamppb.Timestamp)(nil),   // 23: google.protobuf.Timestamp
-	(SortField)(0),                  // 24: construct.v1.SortField
-	(SortOrder)(0),                  // 25: construct.v1.SortOrder
-	(*Message)(nil),                 // 26: construct.v1.Message
+	(TranscriptFormat)(0),           // 1: construct.v1.TranscriptFormat
+	(*Task)(nil),                    // 2: construct.v1.Task
+	(*TaskMetadata)(nil),            // 3: construct.v1.TaskMetadata
+	(*TaskSpec)(nil),                // 4: construct.v1.TaskSpec
+	(*TaskStatus)(nil),              // 5: construct.v1.TaskStatus
+	(*TaskUsage)(nil),               // 6: construct.v1.TaskUsage
+	(*CreateTaskRequest)(nil),       // 7: construct.v1.CreateTaskRequest
+	(*CreateTaskResponse)(nil),      // 8: construct.v1.CreateTaskResponse
+	(*GetTaskRequest)(nil),          // 9: construct.v1.GetTaskRequest
+	(*GetTaskResponse)(nil),         // 10: construct.v1.GetTaskResponse
+	(*ListTasksRequest)(nil),        // 11: construct.v1.ListTasksRequest
+	(*ListTasksResponse)(nil),       // 12: construct.v1.ListTasksResponse
+	(*UpdateTaskRequest)(nil),       // 13: construct.v1.UpdateTaskRequest
+	(*UpdateTaskResponse)(nil),      // 14: construct.v1.UpdateTaskResponse
+	(*DeleteTaskRequest)(nil),       // 15: construct.v1.DeleteTaskRequest
+	(*DeleteTaskResponse)(nil),      // 16: construct.v1.DeleteTaskResponse
+	(*SubscribeRequest)(nil),        // 17: construct.v1.SubscribeRequest
+	(*TaskEvent)(nil),               // 18: construct.v1.TaskEvent
+	(*SubscribeResponse)(nil),       // 19: construct.v1.SubscribeResponse
+	(*SuspendTaskRequest)(nil),      // 20: construct.v1.SuspendTaskRequest
+	(*SuspendTaskResponse)(nil),     // 21: construct.v1.SuspendTaskResponse
+	(*RenderTaskRequest)(nil),       // 22: construct.v1.RenderTaskRequest
+	(*RenderTaskResponse)(nil),      // 23: construct.v1.RenderTaskResponse
+	(*PreviewChange)(nil),           // 24: construct.v1.PreviewChange
+	(*GetPreviewRequest)(nil),       // 25: construct.v1.GetPreviewRequest
+	(*GetPreviewResponse)(nil),      // 26: construct.v1.GetPreviewResponse
+	(*ApplyPreviewRequest)(nil),     // 27: construct.v1.ApplyPreviewRequest
+	(*ApplyPreviewResponse)(nil),    // 28: construct.v1.ApplyPreviewResponse
+	nil,                             // 29: construct.v1.TaskUsage.ToolUsesEntry
+	(*ListTasksRequest_Filter)(nil), // 30: construct.v1.ListTasksRequest.Filter
+	(*timestamppb.Timestamp)(nil),   // 31: google.protobuf.Timestamp
+	(SortField)(0),                  // 32: construct.v1.SortField
+	(SortOrder)(0),                  // 33: construct.v1.SortOrder
+	(*Message)(nil),                 // 34: construct.v1.Message
 }
 var file_construct_v1_task_proto_depIdxs = []int32{
-	2,  // 0: construct.v1.Task.metadata:type_name -> construct.v1.TaskMetadata
-	3,  // 1: construct.v1.Task.spec:type_name -> construct.v1.TaskSpec
-	4,  // 2: construct.v1.Task.status:type_name -> construct.v1.TaskStatus
-	23, // 3: construct.v1.TaskMetadata.created_at:type_name -> google.protobuf.Timestamp
-	23, // 4: construct.v1.TaskMetadata.updated_at:type_name -> google.protobuf.Timestamp
+	3,  // 0: construct.v1.Task.metadata:type_name -> construct.v1.TaskMetadata
+	4,  // 1: construct.v1.Task.spec:type_name -> construct.v1.TaskSpec
+	5,  // 2: construct.v1.Task.status:type_name -> construct.v1.TaskStatus
+	31, // 3: construct.v1.TaskMetadata.created_at:type_name -> google.protobuf.Timestamp
+	31, // 4: construct.v1.TaskMetadata.updated_at:type_name -> google.protobuf.Timestamp
 	0,  // 5: construct.v1.TaskSpec.desired_phase:type_name -> construct.v1.TaskPhase
-	5,  // 6: construct.v1.TaskStatus.usage:type_name -> construct.v1.TaskUsage
+	6,  // 6: construct.v1.TaskStatus.usage:type_name -> construct.v1.TaskUsage
 	0,  // 7: construct.v1.TaskStatus.phase:type_name -> construct.v1.TaskPhase
-	21, // 8: construct.v1.TaskUsage.tool_uses:type_name -> construct.v1.TaskUsage.ToolUsesEntry
-	1,  // 9: construct.v1.CreateTaskResponse.task:type_name -> construct.v1.Task
-	1,  // 10: construct.v1.GetTaskResponse.task:type_name -> construct.v1.Task
-	22, // 11: construct.v1.ListTasksRequest.filter:type_name -> construct.v1.ListTasksRequest.Filter
-	24, // 12: construct.v1.ListTasksRequest.sort_field:type_name -> construct.v1.SortField
-	25, // 13: construct.v1.ListTasksRequest.sort_order:type_name -> construct.v1.SortOrder
-	1,  // 14: construct.v1.ListTasksResponse.tasks:type_name -> construct.v1.Task
-	1,  // 15: construct.v1.UpdateTaskResponse.task:type_name -> construct.v1.Task
-	23, // 16: construct.v1.TaskEvent.timestamp:type_name -> google.protobuf.Timestamp
-	26, // 17: construct.v1.SubscribeResponse.message:type_name -> construct.v1.Message
-	17, // 18: construct.v1.SubscribeResponse.task_event:type_name -> construct.v1.TaskEvent
-	6,  // 19: construct.v1.TaskService.CreateTask:input_type -> construct.v1.CreateTaskRequest
-	8,  // 20: construct.v1.TaskService.GetTask:input_type -> construct.v1.GetTaskRequest
-	10, // 21: construct.v1.TaskService.ListTasks:input_type -> construct.v1.ListTasksRequest
-	12, // 22: construct.v1.TaskService.UpdateTask:input_type -> construct.v1.UpdateTaskRequest
-	14, // 23: construct.v1.TaskService.DeleteTask:input_type -> construct.v1.DeleteTaskRequest
-	16, // 24: construct.v1.TaskService.Subscribe:input_type -> construct.v1.SubscribeRequest
-	19, // 25: construct.v1.TaskService.SuspendTask:input_type -> construct.v1.SuspendTaskRequest
-	7,  // 26: construct.v1.TaskService.CreateTask:output_type -> construct.v1.CreateTaskResponse
-	9,  // 27: construct.v1.TaskService.GetTask:output_type -> construct.v1.GetTaskResponse
-	11, // 28: construct.v1.TaskService.ListTasks:output_type -> construct.v1.ListTasksResponse
-	13, // 29: construct.v1.TaskService.UpdateTask:output_type -> construct.v1.UpdateTaskResponse
-	15, // 30: construct.v1.TaskService.DeleteTask:output_type -> construct.v1.DeleteTaskResponse
-	18, // 31: construct.v1.TaskService.Subscribe:output_type -> construct.v1.SubscribeResponse
-	20, // 32: construct.v1.TaskService.SuspendTask:output_type -> construct.v1.SuspendTaskResponse
-	26, // [26:33] is the sub-list for method output_type
-	19, // [19:26] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	29, // 8: construct.v1.TaskUsage.tool_uses:type_name -> construct.v1.TaskUsage.ToolUsesEntry
+	2,  // 9: construct.v1.CreateTaskResponse.task:type_name -> construct.v1.Task
+	2,  // 10: construct.v1.GetTaskResponse.task:type_name -> construct.v1.Task
+	30, // 11: construct.v1.ListTasksRequest.filter:type_name -> construct.v1.ListTasksRequest.Filter
+	32, // 12: construct.v1.ListTasksRequest.sort_field:type_name -> construct.v1.SortField
+	33, // 13: construct.v1.ListTasksRequest.sort_order:type_name -> construct.v1.SortOrder
+	2,  // 14: construct.v1.ListTasksResponse.tasks:type_name -> construct.v1.Task
+	2,  // 15: construct.v1.UpdateTaskResponse.task:type_name -> construct.v1.Task
+	31, // 16: construct.v1.TaskEvent.timestamp:type_name -> google.protobuf.Timestamp
+	34, // 17: construct.v1.SubscribeResponse.message:type_name -> construct.v1.Message
+	18, // 18: construct.v1.SubscribeResponse.task_event:type_name -> construct.v1.TaskEvent
+	1,  // 19: construct.v1.RenderTaskRequest.format:type_name -> construct.v1.TranscriptFormat
+	24, // 20: construct.v1.GetPreviewResponse.changes:type_name -> construct.v1.PreviewChange
+	24, // 21: construct.v1.ApplyPreviewResponse.changes:type_name -> construct.v1.PreviewChange
+	7,  // 22: construct.v1.TaskService.CreateTask:input_type -> construct.v1.CreateTaskRequest
+	9,  // 23: construct.v1.TaskService.GetTask:input_type -> construct.v1.GetTaskRequest
+	11, // 24: construct.v1.TaskService.ListTasks:input_type -> construct.v1.ListTasksRequest
+	13, // 25: construct.v1.TaskService.UpdateTask:input_type -> construct.v1.UpdateTaskRequest
+	15, // 26: construct.v1.TaskService.DeleteTask:input_type -> construct.v1.DeleteTaskRequest
+	17, // 27: construct.v1.TaskService.Subscribe:input_type -> construct.v1.SubscribeRequest
+	20, // 28: construct.v1.TaskService.SuspendTask:input_type -> construct.v1.SuspendTaskRequest
+	22, // 29: construct.v1.TaskService.RenderTask:input_type -> construct.v1.RenderTaskRequest
+	25, // 30: construct.v1.TaskService.GetPreview:input_type -> construct.v1.GetPreviewRequest
+	27, // 31: construct.v1.TaskService.ApplyPreview:input_type -> construct.v1.ApplyPreviewRequest
+	8,  // 32: construct.v1.TaskService.CreateTask:output_type -> construct.v1.CreateTaskResponse
+	10, // 33: construct.v1.TaskService.GetTask:output_type -> construct.v1.GetTaskResponse
+	12, // 34: construct.v1.TaskService.ListTasks:output_type -> construct.v1.ListTasksResponse
+	14, // 35: construct.v1.TaskService.UpdateTask:output_type -> construct.v1.UpdateTaskResponse
+	16, // 36: construct.v1.TaskService.DeleteTask:output_type -> construct.v1.DeleteTaskResponse
+	19, // 37: construct.v1.TaskService.Subscribe:output_type -> construct.v1.SubscribeResponse
+	21, // 38: construct.v1.TaskService.SuspendTask:output_type -> construct.v1.SuspendTaskResponse
+	23, // 39: construct.v1.TaskService.RenderTask:output_type -> construct.v1.RenderTaskResponse
+	26, // 40: construct.v1.TaskService.GetPreview:output_type -> construct.v1.GetPreviewResponse
+	28, // 41: construct.v1.TaskService.ApplyPreview:output_type -> construct.v1.ApplyPreviewResponse
+	32, // [32:42] is the sub-list for method output_type
+	22, // [22:32] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_construct_v1_task_proto_init() }
@@ -1507,14 +2082,14 @@ func file_construct_v1_task_proto_init() {
 		(*SubscribeResponse_Message)(nil),
 		(*SubscribeResponse_TaskEvent)(nil),
 	}
-	file_construct_v1_task_proto_msgTypes[21].OneofWrappers = []any{}
+	file_construct_v1_task_proto_msgTypes[28].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_construct_v1_task_proto_rawDesc), len(file_construct_v1_task_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   22,
+			NumEnums:      2,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,1353 @@
+// Tool API provides CRUD operations for managing user defined CodeAct tools within Construct.
+// Custom tools let agents call internal CLIs and services from their scripts in the same
+// way as the builtin tools.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: construct/v1/tool.proto
+
+package v1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Tool represents a complete custom tool entity with metadata and specification.
+type Tool struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// metadata contains system-managed and immutable information about the tool.
+	Metadata *ToolMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// spec contains the user-configurable specification of the tool.
+	Spec          *ToolSpec `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tool) Reset() {
+	*x = Tool{}
+	mi := &file_construct_v1_tool_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tool) ProtoMessage() {}
+
+func (x *Tool) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_tool_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tool.ProtoReflect.Descriptor instead.
+func (*Tool) Descriptor() ([]byte, []int) {
+	return file_construct_v1_tool_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Tool) GetMetadata() *ToolMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Tool) GetSpec() *ToolSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+// ToolMetadata contains system-managed, immutable information about a tool.
+type ToolMetadata struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier for the tool (UUID format).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// created_at is the timestamp when the tool was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// updated_at is the timestamp when the tool was last modified.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolMetadata) Reset() {
+	*x = ToolMetadata{}
+	mi := &file_construct_v1_tool_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolMetadata) ProtoMessage() {}
+
+func (x *ToolMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_tool_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolMetadata.ProtoReflect.Descriptor instead.
+func (*ToolMetadata) Descriptor() ([]byte, []int) {
+	return file_construct_v1_tool_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ToolMetadata) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ToolMetadata) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ToolMetadata) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CommandBackend runs a shell command in the project directory of the task.
+// The arguments are passed as JSON on stdin and stdout is the result of the call.
+type CommandBackend struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// command is the shell command that is executed.
+	Command       string `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommandBackend) Reset() {
+	*x = CommandBackend{}
+	mi := &file_construct_v1_tool_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommandBackend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandBackend) ProtoMessage() {}
+
+func (x *CommandBackend) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_tool_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandBackend.ProtoReflect.Descriptor instead.
+func (*CommandBackend) Descriptor() ([]byte, []int) {
+	return file_construct_v1_tool_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CommandBackend) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+// HttpBackend posts the arguments as JSON to an HTTP endpoint.
+// The response body is the result of the call.
+type HttpBackend struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// url is the HTTP or HTTPS endpoint the arguments are posted to.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// headers are additional headers sent with every request, e.g. for authentication.
+	// They are write-only and never returned.
+	Headers       map[string]string `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HttpBackend) Reset() {
+	*x = HttpBackend{}
+	mi := &file_construct_v1_tool_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HttpBackend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpBackend) ProtoMessage() {}
+
+func (x *HttpBackend) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_tool_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpBackend.ProtoReflect.Descriptor instead.
+func (*HttpBackend) Descriptor() ([]byte, []int) {
+	return file_construct_v1_tool_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HttpBackend) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *HttpBackend) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+// JavaScriptBackend evaluates a JavaScript module in an isolated interpreter.
+// The module assigns a function to module.exports that receives the arguments
+// and returns the result of the call.
+type JavaScriptBackend struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// source is the JavaScript source of the module.
+	Source        string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JavaScriptBackend) Reset() {
+	*x = JavaScriptBackend{}
+	mi := &file_construct_v1_tool_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JavaScriptBackend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JavaScriptBackend) ProtoMessage() {}
+
+func (x *JavaScriptBackend) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_tool_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JavaScriptBackend.ProtoReflect.Descriptor instead.
+func (*JavaScriptBackend) Descriptor() ([]byte, []int) {
+	return file_construct_v1_tool_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *JavaScriptBackend) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+// ToolSpec defines the user-configurable specification of a tool.
+type ToolSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the unique name of the JavaScript function the tool is exposed as.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// description explains to the agent what the tool does and when to use it.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// input_schema is the JSON schema of the argument object.
+	InputSchema string `protobuf:"bytes,3,opt,name=input_schema,json=inputSchema,proto3" json:"input_schema,omitempty"`
+	// backend determines how a call of the tool is executed.
+	//
+	// Types that are valid to be assigned to Backend:
+	//
+	//	*ToolSpec_Command
+	//	*ToolSpec_Http
+	//	*ToolSpec_Javascript
+	Backend isToolSpec_Backend `protobuf_oneof:"backend"`
+	// timeout_seconds limits how long a single call may take.
+	TimeoutSeconds int32 `protobuf:"varint,7,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	// enabled indicates whether the tool is exposed to agents.
+	Enabled       bool `protobuf:"varint,8,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolSpec) Reset() {
+	*x = ToolSpec{}
+	mi := &file_construct_v1_tool_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolSpec) ProtoMessage() {}
+
+func (x *ToolSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_tool_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolSpec.ProtoReflect.Descriptor instead.
+func (*ToolSpec) Descriptor() ([]byte, []int) {
+	return file_construct_v1_tool_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ToolSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ToolSpec) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ToolSpec) GetInputSchema() string {
+	if x != nil {
+		return x.InputSchema
+	}
+	return ""
+}
+
+func (x *ToolSpec) GetBackend() isToolSpec_Backend {
+	if x != nil {
+		return x.Backend
+	}
+	return nil
+}
+
+func (x *ToolSpec) GetCommand() *CommandBackend {
+	if x != nil {
+		if x, ok := x.Backend.(*ToolSpec_Command); ok {
+			return x.Command
+		}
+	}
+	return nil
+}
+
+func (x *ToolSpec) GetHttp() *HttpBackend {
+	if x != nil {
+		if x, ok := x.Backend.(*ToolSpec_Http); ok {
+			return x.Http
+		}
+	}
+	return nil
+}
+
+func (x *ToolSpec) GetJavascript() *JavaScriptBackend {
+	if x != nil {
+		if x, ok := x.Backend.(*ToolSpec_Javascript); ok {
+			return x.Javascript
+		}
+	}
+	return nil
+}
+
+func (x *ToolSpec) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *ToolSpec) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type isToolSpec_Backend interface {
+	isToolSpec_Backend()
+}
+
+type ToolSpec_Command struct {
+	Command *CommandBackend `protobuf:"bytes,4,opt,name=command,proto3,oneof"`
+}
+
+type ToolSpec_Http struct {
+	Http *HttpBackend `protobuf:"bytes,5,opt,name=http,proto3,oneof"`
+}
+
+type ToolSpec_Javascript struct {
+	Javascript *JavaScriptBackend `protobuf:"bytes,6,opt,name=javascript,proto3,oneof"`
+}
+
+func (*ToolSpec_Command) isToolSpec_Backend() {}
+
+func (*ToolSpec_Http) isToolSpec_Backend() {}
+
+func (*ToolSpec_Javascript) isToolSpec_Backend() {}
+
+// CreateToolRequest contains the parameters needed to create a new tool.
+type CreateToolRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the unique name of the JavaScript function the tool is exposed as.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// description explains to the agent what the tool does and when to use it.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// input_schema is the JSON schema of the argument object. If empty any object is accepted.
+	InputSchema string `protobuf:"bytes,3,opt,name=input_schema,json=inputSchema,proto3" json:"input_schema,omitempty"`
+	// backend determines how a call of the tool is executed.
+	//
+	// Types that are valid to be assigned to Backend:
+	//
+	//	*CreateToolRequest_Command
+	//	*CreateToolRequest_Http
+	//	*CreateToolRequest_Javascript
+	Backend isCreateToolRequest_Backend `protobuf_oneof:"backend"`
+	// timeout_seconds limits how long a single call may take. Defaults to 30 seconds.
+	TimeoutSeconds int32 `protobuf:"varint,7,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateToolRequest) Reset() {
+	*x = CreateToolRequest{}
+	mi := &file_construct_v1_tool_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateToolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateToolRequest) ProtoMessage() {}
+
+func (x *CreateToolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_tool_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateToolRequest.ProtoReflect.Descriptor instead.
+func (*CreateToolRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_tool_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateToolRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateToolRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateToolRequest) GetInputSchema() string {
+	if x != nil {
+		return x.InputSchema
+	}
+	return ""
+}
+
+func (x *CreateToolRequest) GetBackend() isCreateToolRequest_Backend {
+	if x != nil {
+		return x.Backend
+	}
+	return nil
+}
+
+func (x *CreateToolRequest) GetCommand() *CommandBackend {
+	if x != nil {
+		if x, ok := x.Backend.(*CreateToolRequest_Command); ok {
+			return x.Command
+		}
+	}
+	return nil
+}
+
+func (x *CreateToolRequest) GetHttp() *HttpBackend {
+	if x != nil {
+		if x, ok := x.Backend.(*CreateToolRequest_Http); ok {
+			return x.Http
+		}
+	}
+	return nil
+}
+
+func (x *CreateToolRequest) GetJavascript() *JavaScriptBackend {
+	if x != nil {
+		if x, ok := x.Backend.(*CreateToolRequest_Javascript); ok {
+			return x.Javascript
+		}
+	}
+	return nil
+}
+
+func (x *CreateToolRequest) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+type isCreateToolRequest_Backend interface {
+	isCreateToolRequest_Backend()
+}
+
+type CreateToolRequest_Command struct {
+	Command *CommandBackend `protobuf:"bytes,4,opt,name=command,proto3,oneof"`
+}
+
+type CreateToolRequest_Http struct {
+	Http *HttpBackend `protobuf:"bytes,5,opt,name=http,proto3,oneof"`
+}
+
+type CreateToolRequest_Javascript struct {
+	Javascript *JavaScriptBackend `protobuf:"bytes,6,opt,name=javascript,proto3,oneof"`
+}
+
+func (*CreateToolRequest_Command) isCreateToolRequest_Backend() {}
+
+func (*CreateToolRequest_Http) isCreateToolRequest_Backend() {}
+
+func (*CreateToolRequest_Javascript) isCreateToolRequest_Backend() {}
+
+// CreateToolResponse contains the newly created tool.
+type CreateToolResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tool is the newly created tool instance.
+	Tool          *Tool `protobuf:"bytes,1,opt,name=tool,proto3" json:"tool,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateToolResponse) Reset() {
+	*x = CreateToolResponse{}
+	mi := &file_construct_v1_tool_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateToolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateToolResponse) ProtoMessage() {}
+
+func (x *CreateToolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_tool_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateToolResponse.ProtoReflect.Descriptor instead.
+func (*CreateToolResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_tool_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateToolResponse) GetTool() *Tool {
+	if x != nil {
+		return x.Tool
+	}
+	return nil
+}
+
+// GetToolRequest specifies which tool to retrieve.
+type GetToolRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the tool to retrieve (UUID format).
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetToolRequest) Reset() {
+	*x = GetToolRequest{}
+	mi := &file_construct_v1_tool_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetToolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetToolRequest) ProtoMessage() {}
+
+func (x *GetToolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_tool_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetToolRequest.ProtoReflect.Descriptor instead.
+func (*GetToolRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_tool_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetToolRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetToolResponse contains the requested tool.
+type GetToolResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tool is the requested tool instance.
+	Tool          *Tool `protobuf:"bytes,1,opt,name=tool,proto3" json:"tool,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetToolResponse) Reset() {
+	*x = GetToolResponse{}
+	mi := &file_construct_v1_tool_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetToolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetToolResponse) ProtoMessage() {}
+
+func (x *GetToolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_tool_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetToolResponse.ProtoReflect.Descriptor instead.
+func (*GetToolResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_tool_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetToolResponse) GetTool() *Tool {
+	if x != nil {
+		return x.Tool
+	}
+	return nil
+}
+
+// ListToolsRequest specifies parameters for listing tools with optional filtering.
+type ListToolsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// filter specifies criteria for narrowing the results.
+	Filter        *ListToolsRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListToolsRequest) Reset() {
+	*x = ListToolsRequest{}
+	mi := &file_construct_v1_tool_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListToolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListToolsRequest) ProtoMessage() {}
+
+func (x *ListToolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_tool_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListToolsRequest.ProtoReflect.Descriptor instead.
+func (*ListToolsRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_tool_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListToolsRequest) GetFilter() *ListToolsRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// ListToolsResponse contains the list of tools matching the request criteria.
+type ListToolsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tools is the list of tools matching the filter criteria.
+	Tools         []*Tool `protobuf:"bytes,1,rep,name=tools,proto3" json:"tools,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListToolsResponse) Reset() {
+	*x = ListToolsResponse{}
+	mi := &file_construct_v1_tool_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListToolsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListToolsResponse) ProtoMessage() {}
+
+func (x *ListToolsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_tool_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListToolsResponse.ProtoReflect.Descriptor instead.
+func (*ListToolsResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_tool_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListToolsResponse) GetTools() []*Tool {
+	if x != nil {
+		return x.Tools
+	}
+	return nil
+}
+
+// UpdateToolRequest specifies which tool to update and the new values for its fields.
+type UpdateToolRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the tool to update (UUID format).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// description is the new description of the tool.
+	Description *string `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// input_schema is the new JSON schema of the argument object.
+	InputSchema *string `protobuf:"bytes,3,opt,name=input_schema,json=inputSchema,proto3,oneof" json:"input_schema,omitempty"`
+	// backend replaces how a call of the tool is executed if set, including the headers of an HTTP backend.
+	//
+	// Types that are valid to be assigned to Backend:
+	//
+	//	*UpdateToolRequest_Command
+	//	*UpdateToolRequest_Http
+	//	*UpdateToolRequest_Javascript
+	Backend isUpdateToolRequest_Backend `protobuf_oneof:"backend"`
+	// timeout_seconds is the new timeout of a single call.
+	TimeoutSeconds *int32 `protobuf:"varint,7,opt,name=timeout_seconds,json=timeoutSeconds,proto3,oneof" json:"timeout_seconds,omitempty"`
+	// enabled enables or disables the tool.
+	Enabled       *bool `protobuf:"varint,8,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateToolRequest) Reset() {
+	*x = UpdateToolRequest{}
+	mi := &file_construct_v1_tool_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateToolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateToolRequest) ProtoMessage() {}
+
+func (x *UpdateToolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_tool_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateToolRequest.ProtoReflect.Descriptor instead.
+func (*UpdateToolRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_tool_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateToolRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateToolRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateToolRequest) GetInputSchema() string {
+	if x != nil && x.InputSchema != nil {
+		return *x.InputSchema
+	}
+	return ""
+}
+
+func (x *UpdateToolRequest) GetBackend() isUpdateToolRequest_Backend {
+	if x != nil {
+		return x.Backend
+	}
+	return nil
+}
+
+func (x *UpdateToolRequest) GetCommand() *CommandBackend {
+	if x != nil {
+		if x, ok := x.Backend.(*UpdateToolRequest_Command); ok {
+			return x.Command
+		}
+	}
+	return nil
+}
+
+func (x *UpdateToolRequest) GetHttp() *HttpBackend {
+	if x != nil {
+		if x, ok := x.Backend.(*UpdateToolRequest_Http); ok {
+			return x.Http
+		}
+	}
+	return nil
+}
+
+func (x *UpdateToolRequest) GetJavascript() *JavaScriptBackend {
+	if x != nil {
+		if x, ok := x.Backend.(*UpdateToolRequest_Javascript); ok {
+			return x.Javascript
+		}
+	}
+	return nil
+}
+
+func (x *UpdateToolRequest) GetTimeoutSeconds() int32 {
+	if x != nil && x.TimeoutSeconds != nil {
+		return *x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *UpdateToolRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+type isUpdateToolRequest_Backend interface {
+	isUpdateToolRequest_Backend()
+}
+
+type UpdateToolRequest_Command struct {
+	Command *CommandBackend `protobuf:"bytes,4,opt,name=command,proto3,oneof"`
+}
+
+type UpdateToolRequest_Http struct {
+	Http *HttpBackend `protobuf:"bytes,5,opt,name=http,proto3,oneof"`
+}
+
+type UpdateToolRequest_Javascript struct {
+	Javascript *JavaScriptBackend `protobuf:"bytes,6,opt,name=javascript,proto3,oneof"`
+}
+
+func (*UpdateToolRequest_Command) isUpdateToolRequest_Backend() {}
+
+func (*UpdateToolRequest_Http) isUpdateToolRequest_Backend() {}
+
+func (*UpdateToolRequest_Javascript) isUpdateToolRequest_Backend() {}
+
+// UpdateToolResponse contains the updated tool.
+type UpdateToolResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tool is the updated tool instance.
+	Tool          *Tool `protobuf:"bytes,1,opt,name=tool,proto3" json:"tool,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateToolResponse) Reset() {
+	*x = UpdateToolResponse{}
+	mi := &file_construct_v1_tool_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateToolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateToolResponse) ProtoMessage() {}
+
+func (x *UpdateToolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_tool_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateToolResponse.ProtoReflect.Descriptor instead.
+func (*UpdateToolResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_tool_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateToolResponse) GetTool() *Tool {
+	if x != nil {
+		return x.Tool
+	}
+	return nil
+}
+
+// DeleteToolRequest specifies which tool to delete.
+type DeleteToolRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the tool to delete (UUID format).
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteToolRequest) Reset() {
+	*x = DeleteToolRequest{}
+	mi := &file_construct_v1_tool_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteToolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteToolRequest) ProtoMessage() {}
+
+func (x *DeleteToolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_tool_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteToolRequest.ProtoReflect.Descriptor instead.
+func (*DeleteToolRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_tool_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteToolRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DeleteToolResponse confirms the tool deletion (empty response).
+type DeleteToolResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteToolResponse) Reset() {
+	*x = DeleteToolResponse{}
+	mi := &file_construct_v1_tool_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteToolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteToolResponse) ProtoMessage() {}
+
+func (x *DeleteToolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_tool_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteToolResponse.ProtoReflect.Descriptor instead.
+func (*DeleteToolResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_tool_proto_rawDescGZIP(), []int{15}
+}
+
+// Filter specifies criteria for narrowing the list of returned tools.
+type ListToolsRequest_Filter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name filters tools by their exact name.
+	Name *string `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	// enabled filters tools by whether they are enabled.
+	Enabled       *bool `protobuf:"varint,2,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListToolsRequest_Filter) Reset() {
+	*x = ListToolsRequest_Filter{}
+	mi := &file_construct_v1_tool_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListToolsRequest_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListToolsRequest_Filter) ProtoMessage() {}
+
+func (x *ListToolsRequest_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_tool_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListToolsRequest_Filter.ProtoReflect.Descriptor instead.
+func (*ListToolsRequest_Filter) Descriptor() ([]byte, []int) {
+	return file_construct_v1_tool_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *ListToolsRequest_Filter) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ListToolsRequest_Filter) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+var File_construct_v1_tool_proto protoreflect.FileDescriptor
+
+const file_construct_v1_tool_proto_rawDesc = "" +
+	"\n" +
+	"\x17construct/v1/tool.proto\x12\fconstruct.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"j\n" +
+	"\x04Tool\x126\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x1a.construct.v1.ToolMetadataR\bmetadata\x12*\n" +
+	"\x04spec\x18\x02 \x01(\v2\x16.construct.v1.ToolSpecR\x04spec\"\xae\x01\n" +
+	"\fToolMetadata\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12A\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tcreatedAt\x12A\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tupdatedAt\"3\n" +
+	"\x0eCommandBackend\x12!\n" +
+	"\acommand\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\acommand\"\xa7\x01\n" +
+	"\vHttpBackend\x12\x1a\n" +
+	"\x03url\x18\x01 \x01(\tB\b\xbaH\x05r\x03\x88\x01\x01R\x03url\x12@\n" +
+	"\aheaders\x18\x02 \x03(\v2&.construct.v1.HttpBackend.HeadersEntryR\aheaders\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"4\n" +
+	"\x11JavaScriptBackend\x12\x1f\n" +
+	"\x06source\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06source\"\x92\x03\n" +
+	"\bToolSpec\x120\n" +
+	"\x04name\x18\x01 \x01(\tB\x1c\xbaH\x19r\x17\x10\x01\x18@2\x11^[a-z][a-z0-9_]*$R\x04name\x12,\n" +
+	"\vdescription\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80 R\vdescription\x12!\n" +
+	"\finput_schema\x18\x03 \x01(\tR\vinputSchema\x128\n" +
+	"\acommand\x18\x04 \x01(\v2\x1c.construct.v1.CommandBackendH\x00R\acommand\x12/\n" +
+	"\x04http\x18\x05 \x01(\v2\x19.construct.v1.HttpBackendH\x00R\x04http\x12A\n" +
+	"\n" +
+	"javascript\x18\x06 \x01(\v2\x1f.construct.v1.JavaScriptBackendH\x00R\n" +
+	"javascript\x120\n" +
+	"\x0ftimeout_seconds\x18\a \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x0etimeoutSeconds\x12\x18\n" +
+	"\aenabled\x18\b \x01(\bR\aenabledB\t\n" +
+	"\abackend\"\x8b\x03\n" +
+	"\x11CreateToolRequest\x120\n" +
+	"\x04name\x18\x01 \x01(\tB\x1c\xbaH\x19r\x17\x10\x01\x18@2\x11^[a-z][a-z0-9_]*$R\x04name\x12,\n" +
+	"\vdescription\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80 R\vdescription\x12!\n" +
+	"\finput_schema\x18\x03 \x01(\tR\vinputSchema\x128\n" +
+	"\acommand\x18\x04 \x01(\v2\x1c.construct.v1.CommandBackendH\x00R\acommand\x12/\n" +
+	"\x04http\x18\x05 \x01(\v2\x19.construct.v1.HttpBackendH\x00R\x04http\x12A\n" +
+	"\n" +
+	"javascript\x18\x06 \x01(\v2\x1f.construct.v1.JavaScriptBackendH\x00R\n" +
+	"javascript\x123\n" +
+	"\x0ftimeout_seconds\x18\a \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\x90\x1c(\x00R\x0etimeoutSecondsB\x10\n" +
+	"\abackend\x12\x05\xbaH\x02\b\x01\"D\n" +
+	"\x12CreateToolResponse\x12.\n" +
+	"\x04tool\x18\x01 \x01(\v2\x12.construct.v1.ToolB\x06\xbaH\x03\xc8\x01\x01R\x04tool\"*\n" +
+	"\x0eGetToolRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"A\n" +
+	"\x0fGetToolResponse\x12.\n" +
+	"\x04tool\x18\x01 \x01(\v2\x12.construct.v1.ToolB\x06\xbaH\x03\xc8\x01\x01R\x04tool\"\xa8\x01\n" +
+	"\x10ListToolsRequest\x12=\n" +
+	"\x06filter\x18\x01 \x01(\v2%.construct.v1.ListToolsRequest.FilterR\x06filter\x1aU\n" +
+	"\x06Filter\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x1d\n" +
+	"\aenabled\x18\x02 \x01(\bH\x01R\aenabled\x88\x01\x01B\a\n" +
+	"\x05_nameB\n" +
+	"\n" +
+	"\b_enabled\"=\n" +
+	"\x11ListToolsResponse\x12(\n" +
+	"\x05tools\x18\x01 \x03(\v2\x12.construct.v1.ToolR\x05tools\"\xdb\x03\n" +
+	"\x11UpdateToolRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x121\n" +
+	"\vdescription\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80 H\x01R\vdescription\x88\x01\x01\x12&\n" +
+	"\finput_schema\x18\x03 \x01(\tH\x02R\vinputSchema\x88\x01\x01\x128\n" +
+	"\acommand\x18\x04 \x01(\v2\x1c.construct.v1.CommandBackendH\x00R\acommand\x12/\n" +
+	"\x04http\x18\x05 \x01(\v2\x19.construct.v1.HttpBackendH\x00R\x04http\x12A\n" +
+	"\n" +
+	"javascript\x18\x06 \x01(\v2\x1f.construct.v1.JavaScriptBackendH\x00R\n" +
+	"javascript\x128\n" +
+	"\x0ftimeout_seconds\x18\a \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\x90\x1c(\x00H\x03R\x0etimeoutSeconds\x88\x01\x01\x12\x1d\n" +
+	"\aenabled\x18\b \x01(\bH\x04R\aenabled\x88\x01\x01B\t\n" +
+	"\abackendB\x0e\n" +
+	"\f_descriptionB\x0f\n" +
+	"\r_input_schemaB\x12\n" +
+	"\x10_timeout_secondsB\n" +
+	"\n" +
+	"\b_enabled\"D\n" +
+	"\x12UpdateToolResponse\x12.\n" +
+	"\x04tool\x18\x01 \x01(\v2\x12.construct.v1.ToolB\x06\xbaH\x03\xc8\x01\x01R\x04tool\"-\n" +
+	"\x11DeleteToolRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x14\n" +
+	"\x12DeleteToolResponse2\xa6\x03\n" +
+	"\vToolService\x12Q\n" +
+	"\n" +
+	"CreateTool\x12\x1f.construct.v1.CreateToolRequest\x1a .construct.v1.CreateToolResponse\"\x00\x12K\n" +
+	"\aGetTool\x12\x1c.construct.v1.GetToolRequest\x1a\x1d.construct.v1.GetToolResponse\"\x03\x90\x02\x01\x12Q\n" +
+	"\tListTools\x12\x1e.construct.v1.ListToolsRequest\x1a\x1f.construct.v1.ListToolsResponse\"\x03\x90\x02\x01\x12Q\n" +
+	"\n" +
+	"UpdateTool\x12\x1f.construct.v1.UpdateToolRequest\x1a .construct.v1.UpdateToolResponse\"\x00\x12Q\n" +
+	"\n" +
+	"DeleteTool\x12\x1f.construct.v1.DeleteToolRequest\x1a .construct.v1.DeleteToolResponse\"\x00B(Z&github.com/furisto/construct/api/go/v1b\x06proto3"
+
+var (
+	file_construct_v1_tool_proto_rawDescOnce sync.Once
+	file_construct_v1_tool_proto_rawDescData []byte
+)
+
+func file_construct_v1_tool_proto_rawDescGZIP() []byte {
+	file_construct_v1_tool_proto_rawDescOnce.Do(func() {
+		file_construct_v1_tool_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_construct_v1_tool_proto_rawDesc), len(file_construct_v1_tool_proto_rawDesc)))
+	})
+	return file_construct_v1_tool_proto_rawDescData
+}
+
+var file_construct_v1_tool_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_construct_v1_tool_proto_goTypes = []any{
+	(*Tool)(nil),                    // 0: construct.v1.Tool
+	(*ToolMetadata)(nil),            // 1: construct.v1.ToolMetadata
+	(*CommandBackend)(nil),          // 2: construct.v1.CommandBackend
+	(*HttpBackend)(nil),             // 3: construct.v1.HttpBackend
+	(*JavaScriptBackend)(nil),       // 4: construct.v1.JavaScriptBackend
+	(*ToolSpec)(nil),                // 5: construct.v1.ToolSpec
+	(*CreateToolRequest)(nil),       // 6: construct.v1.CreateToolRequest
+	(*CreateToolResponse)(nil),      // 7: construct.v1.CreateToolResponse
+	(*GetToolRequest)(nil),          // 8: construct.v1.GetToolRequest
+	(*GetToolResponse)(nil),         // 9: construct.v1.GetToolResponse
+	(*ListToolsRequest)(nil),        // 10: construct.v1.ListToolsRequest
+	(*ListToolsResponse)(nil),       // 11: construct.v1.ListToolsResponse
+	(*UpdateToolRequest)(nil),       // 12: construct.v1.UpdateToolRequest
+	(*UpdateToolResponse)(nil),      // 13: construct.v1.UpdateToolResponse
+	(*DeleteToolRequest)(nil),       // 14: construct.v1.DeleteToolRequest
+	(*DeleteToolResponse)(nil),      // 15: construct.v1.DeleteToolResponse
+	nil,                             // 16: construct.v1.HttpBackend.HeadersEntry
+	(*ListToolsRequest_Filter)(nil), // 17: construct.v1.ListToolsRequest.Filter
+	(*timestamppb.Timestamp)(nil),   // 18: google.protobuf.Timestamp
+}
+var file_construct_v1_tool_proto_depIdxs = []int32{
+	1,  // 0: construct.v1.Tool.metadata:type_name -> construct.v1.ToolMetadata
+	5,  // 1: construct.v1.Tool.spec:type_name -> construct.v1.ToolSpec
+	18, // 2: construct.v1.ToolMetadata.created_at:type_name -> google.protobuf.Timestamp
+	18, // 3: construct.v1.ToolMetadata.updated_at:type_name -> google.protobuf.Timestamp
+	16, // 4: construct.v1.HttpBackend.headers:type_name -> construct.v1.HttpBackend.HeadersEntry
+	2,  // 5: construct.v1.ToolSpec.command:type_name -> construct.v1.CommandBackend
+	3,  // 6: construct.v1.ToolSpec.http:type_name -> construct.v1.HttpBackend
+	4,  // 7: construct.v1.ToolSpec.javascript:type_name -> construct.v1.JavaScriptBackend
+	2,  // 8: construct.v1.CreateToolRequest.command:type_name -> construct.v1.CommandBackend
+	3,  // 9: construct.v1.CreateToolRequest.http:type_name -> construct.v1.HttpBackend
+	4,  // 10: construct.v1.CreateToolRequest.javascript:type_name -> construct.v1.JavaScriptBackend
+	0,  // 11: construct.v1.CreateToolResponse.tool:type_name -> construct.v1.Tool
+	0,  // 12: construct.v1.GetToolResponse.tool:type_name -> construct.v1.Tool
+	17, // 13: construct.v1.ListToolsRequest.filter:type_name -> construct.v1.ListToolsRequest.Filter
+	0,  // 14: construct.v1.ListToolsResponse.tools:type_name -> construct.v1.Tool
+	2,  // 15: construct.v1.UpdateToolRequest.command:type_name -> construct.v1.CommandBackend
+	3,  // 16: construct.v1.UpdateToolRequest.http:type_name -> construct.v1.HttpBackend
+	4,  // 17: construct.v1.UpdateToolRequest.javascript:type_name -> construct.v1.JavaScriptBackend
+	0,  // 18: construct.v1.UpdateToolResponse.tool:type_name -> construct.v1.Tool
+	6,  // 19: construct.v1.ToolService.CreateTool:input_type -> construct.v1.CreateToolRequest
+	8,  // 20: construct.v1.ToolService.GetTool:input_type -> construct.v1.GetToolRequest
+	10, // 21: construct.v1.ToolService.ListTools:input_type -> construct.v1.ListToolsRequest
+	12, // 22: construct.v1.ToolService.UpdateTool:input_type -> construct.v1.UpdateToolRequest
+	14, // 23: construct.v1.ToolService.DeleteTool:input_type -> construct.v1.DeleteToolRequest
+	7,  // 24: construct.v1.ToolService.CreateTool:output_type -> construct.v1.CreateToolResponse
+	9,  // 25: construct.v1.ToolService.GetTool:output_type -> construct.v1.GetToolResponse
+	11, // 26: construct.v1.ToolService.ListTools:output_type -> construct.v1.ListToolsResponse
+	13, // 27: construct.v1.ToolService.UpdateTool:output_type -> construct.v1.UpdateToolResponse
+	15, // 28: construct.v1.ToolService.DeleteTool:output_type -> construct.v1.DeleteToolResponse
+	24, // [24:29] is the sub-list for method output_type
+	19, // [19:24] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_construct_v1_tool_proto_init() }
+func file_construct_v1_tool_proto_init() {
+	if File_construct_v1_tool_proto != nil {
+		return
+	}
+	file_construct_v1_tool_proto_msgTypes[5].OneofWrappers = []any{
+		(*ToolSpec_Command)(nil),
+		(*ToolSpec_Http)(nil),
+		(*ToolSpec_Javascript)(nil),
+	}
+	file_construct_v1_tool_proto_msgTypes[6].OneofWrappers = []any{
+		(*CreateToolRequest_Command)(nil),
+		(*CreateToolRequest_Http)(nil),
+		(*CreateToolRequest_Javascript)(nil),
+	}
+	file_construct_v1_tool_proto_msgTypes[12].OneofWrappers = []any{
+		(*UpdateToolRequest_Command)(nil),
+		(*UpdateToolRequest_Http)(nil),
+		(*UpdateToolRequest_Javascript)(nil),
+	}
+	file_construct_v1_tool_proto_msgTypes[17].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_construct_v1_tool_proto_rawDesc), len(file_construct_v1_tool_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_construct_v1_tool_proto_goTypes,
+		DependencyIndexes: file_construct_v1_tool_proto_depIdxs,
+		MessageInfos:      file_construct_v1_tool_proto_msgTypes,
+	}.Build()
+	File_construct_v1_tool_proto = out.File
+	file_construct_v1_tool_proto_goTypes = nil
+	file_construct_v1_tool_proto_depIdxs = nil
+}
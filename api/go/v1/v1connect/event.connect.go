@@ -0,0 +1,124 @@
+// Event API streams the events of the whole daemon, so that clients can follow
+// all running work without polling the individual services.
+
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: construct/v1/event.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/furisto/construct/api/go/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// EventServiceName is the fully-qualified name of the EventService service.
+	EventServiceName = "construct.v1.EventService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// EventServiceWatchEventsProcedure is the fully-qualified name of the EventService's WatchEvents
+	// RPC.
+	EventServiceWatchEventsProcedure = "/construct.v1.EventService/WatchEvents"
+)
+
+// EventServiceClient is a client for the construct.v1.EventService service.
+type EventServiceClient interface {
+	// WatchEvents streams events as they happen. A stream can be resumed after a
+	// disconnect by passing the cursor of the last received event. If the events after
+	// the cursor are no longer retained, the call fails with OUT_OF_RANGE and the client
+	// has to reload its state with the List RPCs before watching again without a cursor.
+	// Clients that do not keep up with the stream are disconnected with RESOURCE_EXHAUSTED
+	// and can resume from the cursor of the last event they received.
+	WatchEvents(context.Context, *connect.Request[v1.WatchEventsRequest]) (*connect.ServerStreamForClient[v1.WatchEventsResponse], error)
+}
+
+// NewEventServiceClient constructs a client for the construct.v1.EventService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewEventServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) EventServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	eventServiceMethods := v1.File_construct_v1_event_proto.Services().ByName("EventService").Methods()
+	return &eventServiceClient{
+		watchEvents: connect.NewClient[v1.WatchEventsRequest, v1.WatchEventsResponse](
+			httpClient,
+			baseURL+EventServiceWatchEventsProcedure,
+			connect.WithSchema(eventServiceMethods.ByName("WatchEvents")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// eventServiceClient implements EventServiceClient.
+type eventServiceClient struct {
+	watchEvents *connect.Client[v1.WatchEventsRequest, v1.WatchEventsResponse]
+}
+
+// WatchEvents calls construct.v1.EventService.WatchEvents.
+func (c *eventServiceClient) WatchEvents(ctx context.Context, req *connect.Request[v1.WatchEventsRequest]) (*connect.ServerStreamForClient[v1.WatchEventsResponse], error) {
+	return c.watchEvents.CallServerStream(ctx, req)
+}
+
+// EventServiceHandler is an implementation of the construct.v1.EventService service.
+type EventServiceHandler interface {
+	// WatchEvents streams events as they happen. A stream can be resumed after a
+	// disconnect by passing the cursor of the last received event. If the events after
+	// the cursor are no longer retained, the call fails with OUT_OF_RANGE and the client
+	// has to reload its state with the List RPCs before watching again without a cursor.
+	// Clients that do not keep up with the stream are disconnected with RESOURCE_EXHAUSTED
+	// and can resume from the cursor of the last event they received.
+	WatchEvents(context.Context, *connect.Request[v1.WatchEventsRequest], *connect.ServerStream[v1.WatchEventsResponse]) error
+}
+
+// NewEventServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewEventServiceHandler(svc EventServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	eventServiceMethods := v1.File_construct_v1_event_proto.Services().ByName("EventService").Methods()
+	eventServiceWatchEventsHandler := connect.NewServerStreamHandler(
+		EventServiceWatchEventsProcedure,
+		svc.WatchEvents,
+		connect.WithSchema(eventServiceMethods.ByName("WatchEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/construct.v1.EventService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case EventServiceWatchEventsProcedure:
+			eventServiceWatchEventsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedEventServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedEventServiceHandler struct{}
+
+func (UnimplementedEventServiceHandler) WatchEvents(context.Context, *connect.Request[v1.WatchEventsRequest], *connect.ServerStream[v1.WatchEventsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.EventService.WatchEvents is not implemented"))
+}
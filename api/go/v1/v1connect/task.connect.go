@@ -51,6 +51,13 @@ const (
 	TaskServiceSubscribeProcedure = "/construct.v1.TaskService/Subscribe"
 	// TaskServiceSuspendTaskProcedure is the fully-qualified name of the TaskService's SuspendTask RPC.
 	TaskServiceSuspendTaskProcedure = "/construct.v1.TaskService/SuspendTask"
+	// TaskServiceRenderTaskProcedure is the fully-qualified name of the TaskService's RenderTask RPC.
+	TaskServiceRenderTaskProcedure = "/construct.v1.TaskService/RenderTask"
+	// TaskServiceGetPreviewProcedure is the fully-qualified name of the TaskService's GetPreview RPC.
+	TaskServiceGetPreviewProcedure = "/construct.v1.TaskService/GetPreview"
+	// TaskServiceApplyPreviewProcedure is the fully-qualified name of the TaskService's ApplyPreview
+	// RPC.
+	TaskServiceApplyPreviewProcedure = "/construct.v1.TaskService/ApplyPreview"
 )
 
 // TaskServiceClient is a client for the construct.v1.TaskService service.
@@ -69,6 +76,12 @@ type TaskServiceClient interface {
 	Subscribe(context.Context, *connect.Request[v1.SubscribeRequest]) (*connect.ServerStreamForClient[v1.SubscribeResponse], error)
 	// SuspendTask suspends a task.
 	SuspendTask(context.Context, *connect.Request[v1.SuspendTaskRequest]) (*connect.Response[v1.SuspendTaskResponse], error)
+	// RenderTask renders the conversation of a task as a human readable transcript.
+	RenderTask(context.Context, *connect.Request[v1.RenderTaskRequest]) (*connect.Response[v1.RenderTaskResponse], error)
+	// GetPreview returns the file changes staged by a task in preview mode.
+	GetPreview(context.Context, *connect.Request[v1.GetPreviewRequest]) (*connect.Response[v1.GetPreviewResponse], error)
+	// ApplyPreview writes the file changes staged by a task in preview mode to its workspace.
+	ApplyPreview(context.Context, *connect.Request[v1.ApplyPreviewRequest]) (*connect.Response[v1.ApplyPreviewResponse], error)
 }
 
 // NewTaskServiceClient constructs a client for the construct.v1.TaskService service. By default, it
@@ -126,18 +139,41 @@ func NewTaskServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(taskServiceMethods.ByName("SuspendTask")),
 			connect.WithClientOptions(opts...),
 		),
+		renderTask: connect.NewClient[v1.RenderTaskRequest, v1.RenderTaskResponse](
+			httpClient,
+			baseURL+TaskServiceRenderTaskProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("RenderTask")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getPreview: connect.NewClient[v1.GetPreviewRequest, v1.GetPreviewResponse](
+			httpClient,
+			baseURL+TaskServiceGetPreviewProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("GetPreview")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		applyPreview: connect.NewClient[v1.ApplyPreviewRequest, v1.ApplyPreviewResponse](
+			httpClient,
+			baseURL+TaskServiceApplyPreviewProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("ApplyPreview")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // taskServiceClient implements TaskServiceClient.
 type taskServiceClient struct {
-	createTask  *connect.Client[v1.CreateTaskRequest, v1.CreateTaskResponse]
-	getTask     *connect.Client[v1.GetTaskRequest, v1.GetTaskResponse]
-	listTasks   *connect.Client[v1.ListTasksRequest, v1.ListTasksResponse]
-	updateTask  *connect.Client[v1.UpdateTaskRequest, v1.UpdateTaskResponse]
-	deleteTask  *connect.Client[v1.DeleteTaskRequest, v1.DeleteTaskResponse]
-	subscribe   *connect.Client[v1.SubscribeRequest, v1.SubscribeResponse]
-	suspendTask *connect.Client[v1.SuspendTaskRequest, v1.SuspendTaskResponse]
+	createTask   *connect.Client[v1.CreateTaskRequest, v1.CreateTaskResponse]
+	getTask      *connect.Client[v1.GetTaskRequest, v1.GetTaskResponse]
+	listTasks    *connect.Client[v1.ListTasksRequest, v1.ListTasksResponse]
+	updateTask   *connect.Client[v1.UpdateTaskRequest, v1.UpdateTaskResponse]
+	deleteTask   *connect.Client[v1.DeleteTaskRequest, v1.DeleteTaskResponse]
+	subscribe    *connect.Client[v1.SubscribeRequest, v1.SubscribeResponse]
+	suspendTask  *connect.Client[v1.SuspendTaskRequest, v1.SuspendTaskResponse]
+	renderTask   *connect.Client[v1.RenderTaskRequest, v1.RenderTaskResponse]
+	getPreview   *connect.Client[v1.GetPreviewRequest, v1.GetPreviewResponse]
+	applyPreview *connect.Client[v1.ApplyPreviewRequest, v1.ApplyPreviewResponse]
 }
 
 // CreateTask calls construct.v1.TaskService.CreateTask.
@@ -175,6 +211,21 @@ func (c *taskServiceClient) SuspendTask(ctx context.Context, req *connect.Reques
 	return c.suspendTask.CallUnary(ctx, req)
 }
 
+// RenderTask calls construct.v1.TaskService.RenderTask.
+func (c *taskServiceClient) RenderTask(ctx context.Context, req *connect.Request[v1.RenderTaskRequest]) (*connect.Response[v1.RenderTaskResponse], error) {
+	return c.renderTask.CallUnary(ctx, req)
+}
+
+// GetPreview calls construct.v1.TaskService.GetPreview.
+func (c *taskServiceClient) GetPreview(ctx context.Context, req *connect.Request[v1.GetPreviewRequest]) (*connect.Response[v1.GetPreviewResponse], error) {
+	return c.getPreview.CallUnary(ctx, req)
+}
+
+// ApplyPreview calls construct.v1.TaskService.ApplyPreview.
+func (c *taskServiceClient) ApplyPreview(ctx context.Context, req *connect.Request[v1.ApplyPreviewRequest]) (*connect.Response[v1.ApplyPreviewResponse], error) {
+	return c.applyPreview.CallUnary(ctx, req)
+}
+
 // TaskServiceHandler is an implementation of the construct.v1.TaskService service.
 type TaskServiceHandler interface {
 	// CreateTask creates a new task for an agent to execute in a specified project directory.
@@ -191,6 +242,12 @@ type TaskServiceHandler interface {
 	Subscribe(context.Context, *connect.Request[v1.SubscribeRequest], *connect.ServerStream[v1.SubscribeResponse]) error
 	// SuspendTask suspends a task.
 	SuspendTask(context.Context, *connect.Request[v1.SuspendTaskRequest]) (*connect.Response[v1.SuspendTaskResponse], error)
+	// RenderTask renders the conversation of a task as a human readable transcript.
+	RenderTask(context.Context, *connect.Request[v1.RenderTaskRequest]) (*connect.Response[v1.RenderTaskResponse], error)
+	// GetPreview returns the file changes staged by a task in preview mode.
+	GetPreview(context.Context, *connect.Request[v1.GetPreviewRequest]) (*connect.Response[v1.GetPreviewResponse], error)
+	// ApplyPreview writes the file changes staged by a task in preview mode to its workspace.
+	ApplyPreview(context.Context, *connect.Request[v1.ApplyPreviewRequest]) (*connect.Response[v1.ApplyPreviewResponse], error)
 }
 
 // NewTaskServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -244,6 +301,26 @@ func NewTaskServiceHandler(svc TaskServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(taskServiceMethods.ByName("SuspendTask")),
 		connect.WithHandlerOptions(opts...),
 	)
+	taskServiceRenderTaskHandler := connect.NewUnaryHandler(
+		TaskServiceRenderTaskProcedure,
+		svc.RenderTask,
+		connect.WithSchema(taskServiceMethods.ByName("RenderTask")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceGetPreviewHandler := connect.NewUnaryHandler(
+		TaskServiceGetPreviewProcedure,
+		svc.GetPreview,
+		connect.WithSchema(taskServiceMethods.ByName("GetPreview")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceApplyPreviewHandler := connect.NewUnaryHandler(
+		TaskServiceApplyPreviewProcedure,
+		svc.ApplyPreview,
+		connect.WithSchema(taskServiceMethods.ByName("ApplyPreview")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/construct.v1.TaskService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TaskServiceCreateTaskProcedure:
@@ -260,6 +337,12 @@ func NewTaskServiceHandler(svc TaskServiceHandler, opts ...connect.HandlerOption
 			taskServiceSubscribeHandler.ServeHTTP(w, r)
 		case TaskServiceSuspendTaskProcedure:
 			taskServiceSuspendTaskHandler.ServeHTTP(w, r)
+		case TaskServiceRenderTaskProcedure:
+			taskServiceRenderTaskHandler.ServeHTTP(w, r)
+		case TaskServiceGetPreviewProcedure:
+			taskServiceGetPreviewHandler.ServeHTTP(w, r)
+		case TaskServiceApplyPreviewProcedure:
+			taskServiceApplyPreviewHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -296,3 +379,15 @@ func (UnimplementedTaskServiceHandler) Subscribe(context.Context, *connect.Reque
 func (UnimplementedTaskServiceHandler) SuspendTask(context.Context, *connect.Request[v1.SuspendTaskRequest]) (*connect.Response[v1.SuspendTaskResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.TaskService.SuspendTask is not implemented"))
 }
+
+func (UnimplementedTaskServiceHandler) RenderTask(context.Context, *connect.Request[v1.RenderTaskRequest]) (*connect.Response[v1.RenderTaskResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.TaskService.RenderTask is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) GetPreview(context.Context, *connect.Request[v1.GetPreviewRequest]) (*connect.Response[v1.GetPreviewResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.TaskService.GetPreview is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) ApplyPreview(context.Context, *connect.Request[v1.ApplyPreviewRequest]) (*connect.Response[v1.ApplyPreviewResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.TaskService.ApplyPreview is not implemented"))
+}
@@ -0,0 +1,238 @@
+// Tool API provides CRUD operations for managing user defined CodeAct tools within Construct.
+// Custom tools let agents call internal CLIs and services from their scripts in the same
+// way as the builtin tools.
+
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: construct/v1/tool.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/furisto/construct/api/go/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ToolServiceName is the fully-qualified name of the ToolService service.
+	ToolServiceName = "construct.v1.ToolService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ToolServiceCreateToolProcedure is the fully-qualified name of the ToolService's CreateTool RPC.
+	ToolServiceCreateToolProcedure = "/construct.v1.ToolService/CreateTool"
+	// ToolServiceGetToolProcedure is the fully-qualified name of the ToolService's GetTool RPC.
+	ToolServiceGetToolProcedure = "/construct.v1.ToolService/GetTool"
+	// ToolServiceListToolsProcedure is the fully-qualified name of the ToolService's ListTools RPC.
+	ToolServiceListToolsProcedure = "/construct.v1.ToolService/ListTools"
+	// ToolServiceUpdateToolProcedure is the fully-qualified name of the ToolService's UpdateTool RPC.
+	ToolServiceUpdateToolProcedure = "/construct.v1.ToolService/UpdateTool"
+	// ToolServiceDeleteToolProcedure is the fully-qualified name of the ToolService's DeleteTool RPC.
+	ToolServiceDeleteToolProcedure = "/construct.v1.ToolService/DeleteTool"
+)
+
+// ToolServiceClient is a client for the construct.v1.ToolService service.
+type ToolServiceClient interface {
+	// CreateTool creates a new custom tool.
+	CreateTool(context.Context, *connect.Request[v1.CreateToolRequest]) (*connect.Response[v1.CreateToolResponse], error)
+	// GetTool retrieves a specific tool by its unique identifier.
+	GetTool(context.Context, *connect.Request[v1.GetToolRequest]) (*connect.Response[v1.GetToolResponse], error)
+	// ListTools retrieves a list of tools with optional filtering.
+	ListTools(context.Context, *connect.Request[v1.ListToolsRequest]) (*connect.Response[v1.ListToolsResponse], error)
+	// UpdateTool modifies an existing tool's configuration.
+	UpdateTool(context.Context, *connect.Request[v1.UpdateToolRequest]) (*connect.Response[v1.UpdateToolResponse], error)
+	// DeleteTool removes a tool from the system.
+	DeleteTool(context.Context, *connect.Request[v1.DeleteToolRequest]) (*connect.Response[v1.DeleteToolResponse], error)
+}
+
+// NewToolServiceClient constructs a client for the construct.v1.ToolService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewToolServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ToolServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	toolServiceMethods := v1.File_construct_v1_tool_proto.Services().ByName("ToolService").Methods()
+	return &toolServiceClient{
+		createTool: connect.NewClient[v1.CreateToolRequest, v1.CreateToolResponse](
+			httpClient,
+			baseURL+ToolServiceCreateToolProcedure,
+			connect.WithSchema(toolServiceMethods.ByName("CreateTool")),
+			connect.WithClientOptions(opts...),
+		),
+		getTool: connect.NewClient[v1.GetToolRequest, v1.GetToolResponse](
+			httpClient,
+			baseURL+ToolServiceGetToolProcedure,
+			connect.WithSchema(toolServiceMethods.ByName("GetTool")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listTools: connect.NewClient[v1.ListToolsRequest, v1.ListToolsResponse](
+			httpClient,
+			baseURL+ToolServiceListToolsProcedure,
+			connect.WithSchema(toolServiceMethods.ByName("ListTools")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		updateTool: connect.NewClient[v1.UpdateToolRequest, v1.UpdateToolResponse](
+			httpClient,
+			baseURL+ToolServiceUpdateToolProcedure,
+			connect.WithSchema(toolServiceMethods.ByName("UpdateTool")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteTool: connect.NewClient[v1.DeleteToolRequest, v1.DeleteToolResponse](
+			httpClient,
+			baseURL+ToolServiceDeleteToolProcedure,
+			connect.WithSchema(toolServiceMethods.ByName("DeleteTool")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// toolServiceClient implements ToolServiceClient.
+type toolServiceClient struct {
+	createTool *connect.Client[v1.CreateToolRequest, v1.CreateToolResponse]
+	getTool    *connect.Client[v1.GetToolRequest, v1.GetToolResponse]
+	listTools  *connect.Client[v1.ListToolsRequest, v1.ListToolsResponse]
+	updateTool *connect.Client[v1.UpdateToolRequest, v1.UpdateToolResponse]
+	deleteTool *connect.Client[v1.DeleteToolRequest, v1.DeleteToolResponse]
+}
+
+// CreateTool calls construct.v1.ToolService.CreateTool.
+func (c *toolServiceClient) CreateTool(ctx context.Context, req *connect.Request[v1.CreateToolRequest]) (*connect.Response[v1.CreateToolResponse], error) {
+	return c.createTool.CallUnary(ctx, req)
+}
+
+// GetTool calls construct.v1.ToolService.GetTool.
+func (c *toolServiceClient) GetTool(ctx context.Context, req *connect.Request[v1.GetToolRequest]) (*connect.Response[v1.GetToolResponse], error) {
+	return c.getTool.CallUnary(ctx, req)
+}
+
+// ListTools calls construct.v1.ToolService.ListTools.
+func (c *toolServiceClient) ListTools(ctx context.Context, req *connect.Request[v1.ListToolsRequest]) (*connect.Response[v1.ListToolsResponse], error) {
+	return c.listTools.CallUnary(ctx, req)
+}
+
+// UpdateTool calls construct.v1.ToolService.UpdateTool.
+func (c *toolServiceClient) UpdateTool(ctx context.Context, req *connect.Request[v1.UpdateToolRequest]) (*connect.Response[v1.UpdateToolResponse], error) {
+	return c.updateTool.CallUnary(ctx, req)
+}
+
+// DeleteTool calls construct.v1.ToolService.DeleteTool.
+func (c *toolServiceClient) DeleteTool(ctx context.Context, req *connect.Request[v1.DeleteToolRequest]) (*connect.Response[v1.DeleteToolResponse], error) {
+	return c.deleteTool.CallUnary(ctx, req)
+}
+
+// ToolServiceHandler is an implementation of the construct.v1.ToolService service.
+type ToolServiceHandler interface {
+	// CreateTool creates a new custom tool.
+	CreateTool(context.Context, *connect.Request[v1.CreateToolRequest]) (*connect.Response[v1.CreateToolResponse], error)
+	// GetTool retrieves a specific tool by its unique identifier.
+	GetTool(context.Context, *connect.Request[v1.GetToolRequest]) (*connect.Response[v1.GetToolResponse], error)
+	// ListTools retrieves a list of tools with optional filtering.
+	ListTools(context.Context, *connect.Request[v1.ListToolsRequest]) (*connect.Response[v1.ListToolsResponse], error)
+	// UpdateTool modifies an existing tool's configuration.
+	UpdateTool(context.Context, *connect.Request[v1.UpdateToolRequest]) (*connect.Response[v1.UpdateToolResponse], error)
+	// DeleteTool removes a tool from the system.
+	DeleteTool(context.Context, *connect.Request[v1.DeleteToolRequest]) (*connect.Response[v1.DeleteToolResponse], error)
+}
+
+// NewToolServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewToolServiceHandler(svc ToolServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	toolServiceMethods := v1.File_construct_v1_tool_proto.Services().ByName("ToolService").Methods()
+	toolServiceCreateToolHandler := connect.NewUnaryHandler(
+		ToolServiceCreateToolProcedure,
+		svc.CreateTool,
+		connect.WithSchema(toolServiceMethods.ByName("CreateTool")),
+		connect.WithHandlerOptions(opts...),
+	)
+	toolServiceGetToolHandler := connect.NewUnaryHandler(
+		ToolServiceGetToolProcedure,
+		svc.GetTool,
+		connect.WithSchema(toolServiceMethods.ByName("GetTool")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	toolServiceListToolsHandler := connect.NewUnaryHandler(
+		ToolServiceListToolsProcedure,
+		svc.ListTools,
+		connect.WithSchema(toolServiceMethods.ByName("ListTools")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	toolServiceUpdateToolHandler := connect.NewUnaryHandler(
+		ToolServiceUpdateToolProcedure,
+		svc.UpdateTool,
+		connect.WithSchema(toolServiceMethods.ByName("UpdateTool")),
+		connect.WithHandlerOptions(opts...),
+	)
+	toolServiceDeleteToolHandler := connect.NewUnaryHandler(
+		ToolServiceDeleteToolProcedure,
+		svc.DeleteTool,
+		connect.WithSchema(toolServiceMethods.ByName("DeleteTool")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/construct.v1.ToolService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ToolServiceCreateToolProcedure:
+			toolServiceCreateToolHandler.ServeHTTP(w, r)
+		case ToolServiceGetToolProcedure:
+			toolServiceGetToolHandler.ServeHTTP(w, r)
+		case ToolServiceListToolsProcedure:
+			toolServiceListToolsHandler.ServeHTTP(w, r)
+		case ToolServiceUpdateToolProcedure:
+			toolServiceUpdateToolHandler.ServeHTTP(w, r)
+		case ToolServiceDeleteToolProcedure:
+			toolServiceDeleteToolHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedToolServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedToolServiceHandler struct{}
+
+func (UnimplementedToolServiceHandler) CreateTool(context.Context, *connect.Request[v1.CreateToolRequest]) (*connect.Response[v1.CreateToolResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.ToolService.CreateTool is not implemented"))
+}
+
+func (UnimplementedToolServiceHandler) GetTool(context.Context, *connect.Request[v1.GetToolRequest]) (*connect.Response[v1.GetToolResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.ToolService.GetTool is not implemented"))
+}
+
+func (UnimplementedToolServiceHandler) ListTools(context.Context, *connect.Request[v1.ListToolsRequest]) (*connect.Response[v1.ListToolsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.ToolService.ListTools is not implemented"))
+}
+
+func (UnimplementedToolServiceHandler) UpdateTool(context.Context, *connect.Request[v1.UpdateToolRequest]) (*connect.Response[v1.UpdateToolResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.ToolService.UpdateTool is not implemented"))
+}
+
+func (UnimplementedToolServiceHandler) DeleteTool(context.Context, *connect.Request[v1.DeleteToolRequest]) (*connect.Response[v1.DeleteToolResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.ToolService.DeleteTool is not implemented"))
+}
@@ -0,0 +1,1283 @@
+// Webhook API provides CRUD operations for managing outbound webhooks within Construct.
+// Webhooks notify external systems such as chat or CI about task events without
+// holding a subscription stream open.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: construct/v1/webhook.proto
+
+package v1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// WebhookEvent identifies the kind of task event a webhook is notified about.
+type WebhookEvent int32
+
+const (
+	// WEBHOOK_EVENT_UNSPECIFIED indicates that the event is not specified.
+	WebhookEvent_WEBHOOK_EVENT_UNSPECIFIED WebhookEvent = 0
+	// WEBHOOK_EVENT_TASK_PHASE_CHANGED is sent when a task changes its phase.
+	WebhookEvent_WEBHOOK_EVENT_TASK_PHASE_CHANGED WebhookEvent = 1
+	// WEBHOOK_EVENT_TASK_RESPONSE is sent when the agent gives a final response and waits for input.
+	WebhookEvent_WEBHOOK_EVENT_TASK_RESPONSE WebhookEvent = 2
+	// WEBHOOK_EVENT_TASK_ERROR is sent when processing a task fails.
+	WebhookEvent_WEBHOOK_EVENT_TASK_ERROR WebhookEvent = 3
+	// WEBHOOK_EVENT_TASK_COST_THRESHOLD is sent once when the cost of a task reaches the cost threshold of the webhook.
+	WebhookEvent_WEBHOOK_EVENT_TASK_COST_THRESHOLD WebhookEvent = 4
+)
+
+// Enum value maps for WebhookEvent.
+var (
+	WebhookEvent_name = map[int32]string{
+		0: "WEBHOOK_EVENT_UNSPECIFIED",
+		1: "WEBHOOK_EVENT_TASK_PHASE_CHANGED",
+		2: "WEBHOOK_EVENT_TASK_RESPONSE",
+		3: "WEBHOOK_EVENT_TASK_ERROR",
+		4: "WEBHOOK_EVENT_TASK_COST_THRESHOLD",
+	}
+	WebhookEvent_value = map[string]int32{
+		"WEBHOOK_EVENT_UNSPECIFIED":         0,
+		"WEBHOOK_EVENT_TASK_PHASE_CHANGED":  1,
+		"WEBHOOK_EVENT_TASK_RESPONSE":       2,
+		"WEBHOOK_EVENT_TASK_ERROR":          3,
+		"WEBHOOK_EVENT_TASK_COST_THRESHOLD": 4,
+	}
+)
+
+func (x WebhookEvent) Enum() *WebhookEvent {
+	p := new(WebhookEvent)
+	*p = x
+	return p
+}
+
+func (x WebhookEvent) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookEvent) Descriptor() protoreflect.EnumDescriptor {
+	return file_construct_v1_webhook_proto_enumTypes[0].Descriptor()
+}
+
+func (WebhookEvent) Type() protoreflect.EnumType {
+	return &file_construct_v1_webhook_proto_enumTypes[0]
+}
+
+func (x WebhookEvent) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookEvent.Descriptor instead.
+func (WebhookEvent) EnumDescriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{0}
+}
+
+// Webhook represents a complete webhook entity with metadata and specification.
+type Webhook struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// metadata contains system-managed and immutable information about the webhook.
+	Metadata *WebhookMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// spec contains the user-configurable specification of the webhook.
+	Spec          *WebhookSpec `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Webhook) GetMetadata() *WebhookMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Webhook) GetSpec() *WebhookSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+// WebhookMetadata contains system-managed, immutable information about a webhook.
+type WebhookMetadata struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier for the webhook (UUID format).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// created_at is the timestamp when the webhook was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// updated_at is the timestamp when the webhook was last modified.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookMetadata) Reset() {
+	*x = WebhookMetadata{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookMetadata) ProtoMessage() {}
+
+func (x *WebhookMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookMetadata.ProtoReflect.Descriptor instead.
+func (*WebhookMetadata) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WebhookMetadata) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookMetadata) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WebhookMetadata) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// WebhookSpec defines the user-configurable specification of a webhook.
+// The secret is write-only and never returned.
+type WebhookSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is a unique, human-readable identifier for the webhook.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// url is the HTTP or HTTPS endpoint the events are posted to.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// events are the kinds of events the webhook is notified about.
+	Events []WebhookEvent `protobuf:"varint,3,rep,packed,name=events,proto3,enum=construct.v1.WebhookEvent" json:"events,omitempty"`
+	// cost_threshold is the task cost in USD that triggers WEBHOOK_EVENT_TASK_COST_THRESHOLD.
+	CostThreshold float64 `protobuf:"fixed64,4,opt,name=cost_threshold,json=costThreshold,proto3" json:"cost_threshold,omitempty"`
+	// enabled indicates whether events are delivered to the webhook.
+	Enabled       bool `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookSpec) Reset() {
+	*x = WebhookSpec{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookSpec) ProtoMessage() {}
+
+func (x *WebhookSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookSpec.ProtoReflect.Descriptor instead.
+func (*WebhookSpec) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WebhookSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WebhookSpec) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookSpec) GetEvents() []WebhookEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *WebhookSpec) GetCostThreshold() float64 {
+	if x != nil {
+		return x.CostThreshold
+	}
+	return 0
+}
+
+func (x *WebhookSpec) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// WebhookDeadLetter is a delivery that failed on every attempt.
+type WebhookDeadLetter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the dead letter (UUID format).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// webhook_id references the webhook the delivery was addressed to (UUID format).
+	WebhookId string `protobuf:"bytes,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	// task_id references the task the event belongs to (UUID format).
+	TaskId string `protobuf:"bytes,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// event is the kind of event that was delivered.
+	Event WebhookEvent `protobuf:"varint,4,opt,name=event,proto3,enum=construct.v1.WebhookEvent" json:"event,omitempty"`
+	// payload is the JSON body of the delivery.
+	Payload string `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	// attempts is the number of delivery attempts that were made.
+	Attempts int32 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// last_error describes why the last attempt failed.
+	LastError string `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// created_at is the timestamp when the delivery was given up.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDeadLetter) Reset() {
+	*x = WebhookDeadLetter{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDeadLetter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDeadLetter) ProtoMessage() {}
+
+func (x *WebhookDeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDeadLetter.ProtoReflect.Descriptor instead.
+func (*WebhookDeadLetter) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WebhookDeadLetter) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookDeadLetter) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *WebhookDeadLetter) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *WebhookDeadLetter) GetEvent() WebhookEvent {
+	if x != nil {
+		return x.Event
+	}
+	return WebhookEvent_WEBHOOK_EVENT_UNSPECIFIED
+}
+
+func (x *WebhookDeadLetter) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *WebhookDeadLetter) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDeadLetter) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDeadLetter) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// CreateWebhookRequest contains the parameters needed to create a new webhook.
+type CreateWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is a unique, human-readable identifier for the webhook.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// url is the HTTP or HTTPS endpoint the events are posted to.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// secret is used to sign every delivery with HMAC-SHA256.
+	Secret string `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	// events are the kinds of events the webhook is notified about. At least one is required.
+	Events []WebhookEvent `protobuf:"varint,4,rep,packed,name=events,proto3,enum=construct.v1.WebhookEvent" json:"events,omitempty"`
+	// cost_threshold is the task cost in USD that triggers WEBHOOK_EVENT_TASK_COST_THRESHOLD.
+	CostThreshold float64 `protobuf:"fixed64,5,opt,name=cost_threshold,json=costThreshold,proto3" json:"cost_threshold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateWebhookRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetEvents() []WebhookEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *CreateWebhookRequest) GetCostThreshold() float64 {
+	if x != nil {
+		return x.CostThreshold
+	}
+	return 0
+}
+
+// CreateWebhookResponse contains the newly created webhook.
+type CreateWebhookResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// webhook is the newly created webhook instance.
+	Webhook       *Webhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookResponse) Reset() {
+	*x = CreateWebhookResponse{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookResponse) ProtoMessage() {}
+
+func (x *CreateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+// GetWebhookRequest specifies which webhook to retrieve.
+type GetWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the webhook to retrieve (UUID format).
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebhookRequest) Reset() {
+	*x = GetWebhookRequest{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookRequest) ProtoMessage() {}
+
+func (x *GetWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookRequest.ProtoReflect.Descriptor instead.
+func (*GetWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetWebhookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetWebhookResponse contains the requested webhook.
+type GetWebhookResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// webhook is the requested webhook instance.
+	Webhook       *Webhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebhookResponse) Reset() {
+	*x = GetWebhookResponse{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookResponse) ProtoMessage() {}
+
+func (x *GetWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookResponse.ProtoReflect.Descriptor instead.
+func (*GetWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+// ListWebhooksRequest specifies parameters for listing webhooks with optional filtering.
+type ListWebhooksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// filter specifies criteria for narrowing the results.
+	Filter        *ListWebhooksRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListWebhooksRequest) GetFilter() *ListWebhooksRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// ListWebhooksResponse contains the list of webhooks matching the request criteria.
+type ListWebhooksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// webhooks is the list of webhooks matching the filter criteria.
+	Webhooks      []*Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListWebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+// UpdateWebhookRequest specifies which webhook to update and the new values for its fields.
+type UpdateWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the webhook to update (UUID format).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name is the new name of the webhook.
+	Name *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	// url is the new endpoint of the webhook.
+	Url *string `protobuf:"bytes,3,opt,name=url,proto3,oneof" json:"url,omitempty"`
+	// secret is the new signing secret of the webhook.
+	Secret *string `protobuf:"bytes,4,opt,name=secret,proto3,oneof" json:"secret,omitempty"`
+	// events replaces the kinds of events the webhook is notified about if not empty.
+	Events []WebhookEvent `protobuf:"varint,5,rep,packed,name=events,proto3,enum=construct.v1.WebhookEvent" json:"events,omitempty"`
+	// cost_threshold is the new cost threshold of the webhook.
+	CostThreshold *float64 `protobuf:"fixed64,6,opt,name=cost_threshold,json=costThreshold,proto3,oneof" json:"cost_threshold,omitempty"`
+	// enabled enables or disables the webhook.
+	Enabled       *bool `protobuf:"varint,7,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookRequest) Reset() {
+	*x = UpdateWebhookRequest{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookRequest) ProtoMessage() {}
+
+func (x *UpdateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateWebhookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetSecret() string {
+	if x != nil && x.Secret != nil {
+		return *x.Secret
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetEvents() []WebhookEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *UpdateWebhookRequest) GetCostThreshold() float64 {
+	if x != nil && x.CostThreshold != nil {
+		return *x.CostThreshold
+	}
+	return 0
+}
+
+func (x *UpdateWebhookRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+// UpdateWebhookResponse contains the updated webhook.
+type UpdateWebhookResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// webhook is the updated webhook instance.
+	Webhook       *Webhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookResponse) Reset() {
+	*x = UpdateWebhookResponse{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookResponse) ProtoMessage() {}
+
+func (x *UpdateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+// DeleteWebhookRequest specifies which webhook to delete.
+type DeleteWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the webhook to delete (UUID format).
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteWebhookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DeleteWebhookResponse confirms the webhook deletion (empty response).
+type DeleteWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookResponse) Reset() {
+	*x = DeleteWebhookResponse{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{13}
+}
+
+// ListWebhookDeadLettersRequest specifies which dead letters to retrieve.
+type ListWebhookDeadLettersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// webhook_id is the unique identifier of the webhook (UUID format).
+	WebhookId     string `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeadLettersRequest) Reset() {
+	*x = ListWebhookDeadLettersRequest{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeadLettersRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListWebhookDeadLettersRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+// ListWebhookDeadLettersResponse contains the dead letters of a webhook, newest first.
+type ListWebhookDeadLettersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// dead_letters is the list of failed deliveries.
+	DeadLetters   []*WebhookDeadLetter `protobuf:"bytes,1,rep,name=dead_letters,json=deadLetters,proto3" json:"dead_letters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeadLettersResponse) Reset() {
+	*x = ListWebhookDeadLettersResponse{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeadLettersResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListWebhookDeadLettersResponse) GetDeadLetters() []*WebhookDeadLetter {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+// Filter specifies criteria for narrowing the list of returned webhooks.
+type ListWebhooksRequest_Filter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name filters webhooks by their exact name.
+	Name *string `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	// enabled filters webhooks by whether they are enabled.
+	Enabled       *bool `protobuf:"varint,2,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksRequest_Filter) Reset() {
+	*x = ListWebhooksRequest_Filter{}
+	mi := &file_construct_v1_webhook_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksRequest_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest_Filter) ProtoMessage() {}
+
+func (x *ListWebhooksRequest_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_webhook_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest_Filter.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest_Filter) Descriptor() ([]byte, []int) {
+	return file_construct_v1_webhook_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *ListWebhooksRequest_Filter) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ListWebhooksRequest_Filter) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+var File_construct_v1_webhook_proto protoreflect.FileDescriptor
+
+const file_construct_v1_webhook_proto_rawDesc = "" +
+	"\n" +
+	"\x1aconstruct/v1/webhook.proto\x12\fconstruct.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"s\n" +
+	"\aWebhook\x129\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x1d.construct.v1.WebhookMetadataR\bmetadata\x12-\n" +
+	"\x04spec\x18\x02 \x01(\v2\x19.construct.v1.WebhookSpecR\x04spec\"\xb1\x01\n" +
+	"\x0fWebhookMetadata\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12A\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tcreatedAt\x12A\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tupdatedAt\"\xce\x01\n" +
+	"\vWebhookSpec\x12\x1e\n" +
+	"\x04name\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12\x1a\n" +
+	"\x03url\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x88\x01\x01R\x03url\x122\n" +
+	"\x06events\x18\x03 \x03(\x0e2\x1a.construct.v1.WebhookEventR\x06events\x125\n" +
+	"\x0ecost_threshold\x18\x04 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\rcostThreshold\x12\x18\n" +
+	"\aenabled\x18\x05 \x01(\bR\aenabled\"\xb1\x02\n" +
+	"\x11WebhookDeadLetter\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12'\n" +
+	"\n" +
+	"webhook_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\twebhookId\x12\x17\n" +
+	"\atask_id\x18\x03 \x01(\tR\x06taskId\x120\n" +
+	"\x05event\x18\x04 \x01(\x0e2\x1a.construct.v1.WebhookEventR\x05event\x12\x18\n" +
+	"\apayload\x18\x05 \x01(\tR\apayload\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\x05R\battempts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\a \x01(\tR\tlastError\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xf4\x01\n" +
+	"\x14CreateWebhookRequest\x12\x1e\n" +
+	"\x04name\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12\x1a\n" +
+	"\x03url\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x88\x01\x01R\x03url\x12\"\n" +
+	"\x06secret\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x06secret\x12E\n" +
+	"\x06events\x18\x04 \x03(\x0e2\x1a.construct.v1.WebhookEventB\x11\xbaH\x0e\x92\x01\v\b\x01\"\a\x82\x01\x04\x10\x01 \x00R\x06events\x125\n" +
+	"\x0ecost_threshold\x18\x05 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\rcostThreshold\"P\n" +
+	"\x15CreateWebhookResponse\x127\n" +
+	"\awebhook\x18\x01 \x01(\v2\x15.construct.v1.WebhookB\x06\xbaH\x03\xc8\x01\x01R\awebhook\"-\n" +
+	"\x11GetWebhookRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"M\n" +
+	"\x12GetWebhookResponse\x127\n" +
+	"\awebhook\x18\x01 \x01(\v2\x15.construct.v1.WebhookB\x06\xbaH\x03\xc8\x01\x01R\awebhook\"\xae\x01\n" +
+	"\x13ListWebhooksRequest\x12@\n" +
+	"\x06filter\x18\x01 \x01(\v2(.construct.v1.ListWebhooksRequest.FilterR\x06filter\x1aU\n" +
+	"\x06Filter\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x1d\n" +
+	"\aenabled\x18\x02 \x01(\bH\x01R\aenabled\x88\x01\x01B\a\n" +
+	"\x05_nameB\n" +
+	"\n" +
+	"\b_enabled\"I\n" +
+	"\x14ListWebhooksResponse\x121\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x15.construct.v1.WebhookR\bwebhooks\"\xfa\x02\n" +
+	"\x14UpdateWebhookRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12#\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x00R\x04name\x88\x01\x01\x12\x1f\n" +
+	"\x03url\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x88\x01\x01H\x01R\x03url\x88\x01\x01\x12'\n" +
+	"\x06secret\x18\x04 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x02R\x06secret\x88\x01\x01\x12C\n" +
+	"\x06events\x18\x05 \x03(\x0e2\x1a.construct.v1.WebhookEventB\x0f\xbaH\f\x92\x01\t\"\a\x82\x01\x04\x10\x01 \x00R\x06events\x12:\n" +
+	"\x0ecost_threshold\x18\x06 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00H\x03R\rcostThreshold\x88\x01\x01\x12\x1d\n" +
+	"\aenabled\x18\a \x01(\bH\x04R\aenabled\x88\x01\x01B\a\n" +
+	"\x05_nameB\x06\n" +
+	"\x04_urlB\t\n" +
+	"\a_secretB\x11\n" +
+	"\x0f_cost_thresholdB\n" +
+	"\n" +
+	"\b_enabled\"P\n" +
+	"\x15UpdateWebhookResponse\x127\n" +
+	"\awebhook\x18\x01 \x01(\v2\x15.construct.v1.WebhookB\x06\xbaH\x03\xc8\x01\x01R\awebhook\"0\n" +
+	"\x14DeleteWebhookRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x17\n" +
+	"\x15DeleteWebhookResponse\"H\n" +
+	"\x1dListWebhookDeadLettersRequest\x12'\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\twebhookId\"d\n" +
+	"\x1eListWebhookDeadLettersResponse\x12B\n" +
+	"\fdead_letters\x18\x01 \x03(\v2\x1f.construct.v1.WebhookDeadLetterR\vdeadLetters*\xb9\x01\n" +
+	"\fWebhookEvent\x12\x1d\n" +
+	"\x19WEBHOOK_EVENT_UNSPECIFIED\x10\x00\x12$\n" +
+	" WEBHOOK_EVENT_TASK_PHASE_CHANGED\x10\x01\x12\x1f\n" +
+	"\x1bWEBHOOK_EVENT_TASK_RESPONSE\x10\x02\x12\x1c\n" +
+	"\x18WEBHOOK_EVENT_TASK_ERROR\x10\x03\x12%\n" +
+	"!WEBHOOK_EVENT_TASK_COST_THRESHOLD\x10\x042\xd0\x04\n" +
+	"\x0eWebhookService\x12Z\n" +
+	"\rCreateWebhook\x12\".construct.v1.CreateWebhookRequest\x1a#.construct.v1.CreateWebhookResponse\"\x00\x12T\n" +
+	"\n" +
+	"GetWebhook\x12\x1f.construct.v1.GetWebhookRequest\x1a .construct.v1.GetWebhookResponse\"\x03\x90\x02\x01\x12Z\n" +
+	"\fListWebhooks\x12!.construct.v1.ListWebhooksRequest\x1a\".construct.v1.ListWebhooksResponse\"\x03\x90\x02\x01\x12Z\n" +
+	"\rUpdateWebhook\x12\".construct.v1.UpdateWebhookRequest\x1a#.construct.v1.UpdateWebhookResponse\"\x00\x12Z\n" +
+	"\rDeleteWebhook\x12\".construct.v1.DeleteWebhookRequest\x1a#.construct.v1.DeleteWebhookResponse\"\x00\x12x\n" +
+	"\x16ListWebhookDeadLetters\x12+.construct.v1.ListWebhookDeadLettersRequest\x1a,.construct.v1.ListWebhookDeadLettersResponse\"\x03\x90\x02\x01B(Z&github.com/furisto/construct/api/go/v1b\x06proto3"
+
+var (
+	file_construct_v1_webhook_proto_rawDescOnce sync.Once
+	file_construct_v1_webhook_proto_rawDescData []byte
+)
+
+func file_construct_v1_webhook_proto_rawDescGZIP() []byte {
+	file_construct_v1_webhook_proto_rawDescOnce.Do(func() {
+		file_construct_v1_webhook_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_construct_v1_webhook_proto_rawDesc), len(file_construct_v1_webhook_proto_rawDesc)))
+	})
+	return file_construct_v1_webhook_proto_rawDescData
+}
+
+var file_construct_v1_webhook_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_construct_v1_webhook_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_construct_v1_webhook_proto_goTypes = []any{
+	(WebhookEvent)(0),                      // 0: construct.v1.WebhookEvent
+	(*Webhook)(nil),                        // 1: construct.v1.Webhook
+	(*WebhookMetadata)(nil),                // 2: construct.v1.WebhookMetadata
+	(*WebhookSpec)(nil),                    // 3: construct.v1.WebhookSpec
+	(*WebhookDeadLetter)(nil),              // 4: construct.v1.WebhookDeadLetter
+	(*CreateWebhookRequest)(nil),           // 5: construct.v1.CreateWebhookRequest
+	(*CreateWebhookResponse)(nil),          // 6: construct.v1.CreateWebhookResponse
+	(*GetWebhookRequest)(nil),              // 7: construct.v1.GetWebhookRequest
+	(*GetWebhookResponse)(nil),             // 8: construct.v1.GetWebhookResponse
+	(*ListWebhooksRequest)(nil),            // 9: construct.v1.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),           // 10: construct.v1.ListWebhooksResponse
+	(*UpdateWebhookRequest)(nil),           // 11: construct.v1.UpdateWebhookRequest
+	(*UpdateWebhookResponse)(nil),          // 12: construct.v1.UpdateWebhookResponse
+	(*DeleteWebhookRequest)(nil),           // 13: construct.v1.DeleteWebhookRequest
+	(*DeleteWebhookResponse)(nil),          // 14: construct.v1.DeleteWebhookResponse
+	(*ListWebhookDeadLettersRequest)(nil),  // 15: construct.v1.ListWebhookDeadLettersRequest
+	(*ListWebhookDeadLettersResponse)(nil), // 16: construct.v1.ListWebhookDeadLettersResponse
+	(*ListWebhooksRequest_Filter)(nil),     // 17: construct.v1.ListWebhooksRequest.Filter
+	(*timestamppb.Timestamp)(nil),          // 18: google.protobuf.Timestamp
+}
+var file_construct_v1_webhook_proto_depIdxs = []int32{
+	2,  // 0: construct.v1.Webhook.metadata:type_name -> construct.v1.WebhookMetadata
+	3,  // 1: construct.v1.Webhook.spec:type_name -> construct.v1.WebhookSpec
+	18, // 2: construct.v1.WebhookMetadata.created_at:type_name -> google.protobuf.Timestamp
+	18, // 3: construct.v1.WebhookMetadata.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: construct.v1.WebhookSpec.events:type_name -> construct.v1.WebhookEvent
+	0,  // 5: construct.v1.WebhookDeadLetter.event:type_name -> construct.v1.WebhookEvent
+	18, // 6: construct.v1.WebhookDeadLetter.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 7: construct.v1.CreateWebhookRequest.events:type_name -> construct.v1.WebhookEvent
+	1,  // 8: construct.v1.CreateWebhookResponse.webhook:type_name -> construct.v1.Webhook
+	1,  // 9: construct.v1.GetWebhookResponse.webhook:type_name -> construct.v1.Webhook
+	17, // 10: construct.v1.ListWebhooksRequest.filter:type_name -> construct.v1.ListWebhooksRequest.Filter
+	1,  // 11: construct.v1.ListWebhooksResponse.webhooks:type_name -> construct.v1.Webhook
+	0,  // 12: construct.v1.UpdateWebhookRequest.events:type_name -> construct.v1.WebhookEvent
+	1,  // 13: construct.v1.UpdateWebhookResponse.webhook:type_name -> construct.v1.Webhook
+	4,  // 14: construct.v1.ListWebhookDeadLettersResponse.dead_letters:type_name -> construct.v1.WebhookDeadLetter
+	5,  // 15: construct.v1.WebhookService.CreateWebhook:input_type -> construct.v1.CreateWebhookRequest
+	7,  // 16: construct.v1.WebhookService.GetWebhook:input_type -> construct.v1.GetWebhookRequest
+	9,  // 17: construct.v1.WebhookService.ListWebhooks:input_type -> construct.v1.ListWebhooksRequest
+	11, // 18: construct.v1.WebhookService.UpdateWebhook:input_type -> construct.v1.UpdateWebhookRequest
+	13, // 19: construct.v1.WebhookService.DeleteWebhook:input_type -> construct.v1.DeleteWebhookRequest
+	15, // 20: construct.v1.WebhookService.ListWebhookDeadLetters:input_type -> construct.v1.ListWebhookDeadLettersRequest
+	6,  // 21: construct.v1.WebhookService.CreateWebhook:output_type -> construct.v1.CreateWebhookResponse
+	8,  // 22: construct.v1.WebhookService.GetWebhook:output_type -> construct.v1.GetWebhookResponse
+	10, // 23: construct.v1.WebhookService.ListWebhooks:output_type -> construct.v1.ListWebhooksResponse
+	12, // 24: construct.v1.WebhookService.UpdateWebhook:output_type -> construct.v1.UpdateWebhookResponse
+	14, // 25: construct.v1.WebhookService.DeleteWebhook:output_type -> construct.v1.DeleteWebhookResponse
+	16, // 26: construct.v1.WebhookService.ListWebhookDeadLetters:output_type -> construct.v1.ListWebhookDeadLettersResponse
+	21, // [21:27] is the sub-list for method output_type
+	15, // [15:21] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_construct_v1_webhook_proto_init() }
+func file_construct_v1_webhook_proto_init() {
+	if File_construct_v1_webhook_proto != nil {
+		return
+	}
+	file_construct_v1_webhook_proto_msgTypes[10].OneofWrappers = []any{}
+	file_construct_v1_webhook_proto_msgTypes[16].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_construct_v1_webhook_proto_rawDesc), len(file_construct_v1_webhook_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_construct_v1_webhook_proto_goTypes,
+		DependencyIndexes: file_construct_v1_webhook_proto_depIdxs,
+		EnumInfos:         file_construct_v1_webhook_proto_enumTypes,
+		MessageInfos:      file_construct_v1_webhook_proto_msgTypes,
+	}.Build()
+	File_construct_v1_webhook_proto = out.File
+	file_construct_v1_webhook_proto_goTypes = nil
+	file_construct_v1_webhook_proto_depIdxs = nil
+}
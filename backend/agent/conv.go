@@ -261,6 +261,257 @@ func ConvertMemoryMessageToProto(m *memory.Message) (*v1.Message, error) {
 							},
 						},
 					})
+				case toolbase.ToolNameDeleteFile:
+					deleteFileInput := call.Input.DeleteFile
+					if deleteFileInput == nil {
+						slog.Error("delete file input not set")
+						continue
+					}
+
+					contentParts = append(contentParts, &v1.MessagePart{
+						Data: &v1.MessagePart_ToolCall{
+							ToolCall: &v1.ToolCall{
+								ToolName: call.ToolName,
+								Input: &v1.ToolCall_DeleteFile{
+									DeleteFile: &v1.ToolCall_DeleteFileInput{
+										Path: deleteFileInput.Path,
+									},
+								},
+							},
+						},
+					})
+
+					deleteFileResult := call.Output.DeleteFile
+					if deleteFileResult == nil {
+						slog.Error("delete file result not set")
+						continue
+					}
+
+					contentParts = append(contentParts, &v1.MessagePart{
+						Data: &v1.MessagePart_ToolResult{
+							ToolResult: &v1.ToolResult{
+								ToolName: call.ToolName,
+								Result: &v1.ToolResult_DeleteFile{
+									DeleteFile: &v1.ToolResult_DeleteFileResult{
+										Path: deleteFileResult.Path,
+									},
+								},
+							},
+						},
+					})
+				case toolbase.ToolNameMoveFile:
+					moveFileInput := call.Input.MoveFile
+					if moveFileInput == nil {
+						slog.Error("move file input not set")
+						continue
+					}
+
+					contentParts = append(contentParts, &v1.MessagePart{
+						Data: &v1.MessagePart_ToolCall{
+							ToolCall: &v1.ToolCall{
+								ToolName: call.ToolName,
+								Input: &v1.ToolCall_MoveFile{
+									MoveFile: &v1.ToolCall_MoveFileInput{
+										Source:      moveFileInput.Source,
+										Destination: moveFileInput.Destination,
+										Overwrite:   moveFileInput.Overwrite,
+									},
+								},
+							},
+						},
+					})
+
+					moveFileResult := call.Output.MoveFile
+					if moveFileResult == nil {
+						slog.Error("move file result not set")
+						continue
+					}
+
+					contentParts = append(contentParts, &v1.MessagePart{
+						Data: &v1.MessagePart_ToolResult{
+							ToolResult: &v1.ToolResult{
+								ToolName: call.ToolName,
+								Result: &v1.ToolResult_MoveFile{
+									MoveFile: &v1.ToolResult_MoveFileResult{
+										Overwritten: moveFileResult.Overwritten,
+									},
+								},
+							},
+						},
+					})
+				case toolbase.ToolNameCopyFile:
+					copyFileInput := call.Input.CopyFile
+					if copyFileInput == nil {
+						slog.Error("copy file input not set")
+						continue
+					}
+
+					contentParts = append(contentParts, &v1.MessagePart{
+						Data: &v1.MessagePart_ToolCall{
+							ToolCall: &v1.ToolCall{
+								ToolName: call.ToolName,
+								Input: &v1.ToolCall_CopyFile{
+									CopyFile: &v1.ToolCall_CopyFileInput{
+										Source:      copyFileInput.Source,
+										Destination: copyFileInput.Destination,
+										Overwrite:   copyFileInput.Overwrite,
+									},
+								},
+							},
+						},
+					})
+
+					copyFileResult := call.Output.CopyFile
+					if copyFileResult == nil {
+						slog.Error("copy file result not set")
+						continue
+					}
+
+					contentParts = append(contentParts, &v1.MessagePart{
+						Data: &v1.MessagePart_ToolResult{
+							ToolResult: &v1.ToolResult{
+								ToolName: call.ToolName,
+								Result: &v1.ToolResult_CopyFile{
+									CopyFile: &v1.ToolResult_CopyFileResult{
+										Overwritten: copyFileResult.Overwritten,
+									},
+								},
+							},
+						},
+					})
+				case toolbase.ToolNameApplyPatch:
+					applyPatchInput := call.Input.ApplyPatch
+					if applyPatchInput == nil {
+						slog.Error("apply patch input not set")
+						continue
+					}
+
+					contentParts = append(contentParts, &v1.MessagePart{
+						Data: &v1.MessagePart_ToolCall{
+							ToolCall: &v1.ToolCall{
+								ToolName: call.ToolName,
+								Input: &v1.ToolCall_ApplyPatch{
+									ApplyPatch: &v1.ToolCall_ApplyPatchInput{
+										Patch:  applyPatchInput.Patch,
+										DryRun: applyPatchInput.DryRun,
+									},
+								},
+							},
+						},
+					})
+
+					applyPatchResult := call.Output.ApplyPatch
+					if applyPatchResult == nil {
+						slog.Error("apply patch result not set")
+						continue
+					}
+
+					patchedFiles := make([]*v1.ToolResult_ApplyPatchResult_PatchedFile, 0, len(applyPatchResult.Files))
+					for _, file := range applyPatchResult.Files {
+						hunks := make([]*v1.ToolResult_ApplyPatchResult_HunkResult, 0, len(file.Hunks))
+						for _, hunk := range file.Hunks {
+							hunks = append(hunks, &v1.ToolResult_ApplyPatchResult_HunkResult{
+								Hunk:    int32(hunk.Hunk),
+								Applied: hunk.Applied,
+								Line:    int32(hunk.Line),
+								Offset:  int32(hunk.Offset),
+								Fuzzy:   hunk.Fuzzy,
+								Error:   hunk.Error,
+							})
+						}
+						patchedFiles = append(patchedFiles, &v1.ToolResult_ApplyPatchResult_PatchedFile{
+							Path:   file.Path,
+							Action: file.Action,
+							PatchInfo: &v1.ToolResult_EditFileResult_PatchInfo{
+								Patch:        file.PatchInfo.Patch,
+								LinesAdded:   int32(file.PatchInfo.LinesAdded),
+								LinesRemoved: int32(file.PatchInfo.LinesRemoved),
+							},
+							Hunks: hunks,
+						})
+					}
+
+					contentParts = append(contentParts, &v1.MessagePart{
+						Data: &v1.MessagePart_ToolResult{
+							ToolResult: &v1.ToolResult{
+								ToolName: call.ToolName,
+								Result: &v1.ToolResult_ApplyPatch{
+									ApplyPatch: &v1.ToolResult_ApplyPatchResult{
+										Files:  patchedFiles,
+										DryRun: applyPatchResult.DryRun,
+									},
+								},
+							},
+						},
+					})
+				case toolbase.ToolNameRunTests:
+					runTestsInput := call.Input.RunTests
+					if runTestsInput == nil {
+						slog.Error("run tests input not set")
+						continue
+					}
+
+					contentParts = append(contentParts, &v1.MessagePart{
+						Data: &v1.MessagePart_ToolCall{
+							ToolCall: &v1.ToolCall{
+								ToolName: call.ToolName,
+								Input: &v1.ToolCall_RunTests{
+									RunTests: &v1.ToolCall_RunTestsInput{
+										Framework:     runTestsInput.Framework,
+										Path:          runTestsInput.Path,
+										Tests:         runTestsInput.Tests,
+										Filter:        runTestsInput.Filter,
+										Command:       runTestsInput.Command,
+										Report:        runTestsInput.Report,
+										IncludePassed: runTestsInput.IncludePassed,
+									},
+								},
+							},
+						},
+					})
+
+					runTestsResult := call.Output.RunTests
+					if runTestsResult == nil {
+						slog.Error("run tests result not set")
+						continue
+					}
+
+					testCases := make([]*v1.ToolResult_RunTestsResult_TestCase, 0, len(runTestsResult.Tests))
+					for _, test := range runTestsResult.Tests {
+						testCases = append(testCases, &v1.ToolResult_RunTestsResult_TestCase{
+							Name:     test.Name,
+							Suite:    test.Suite,
+							Status:   test.Status,
+							Duration: test.Duration,
+							Message:  test.Message,
+							File:     test.File,
+							Line:     int32(test.Line),
+							Output:   test.Output,
+						})
+					}
+
+					contentParts = append(contentParts, &v1.MessagePart{
+						Data: &v1.MessagePart_ToolResult{
+							ToolResult: &v1.ToolResult{
+								ToolName: call.ToolName,
+								Result: &v1.ToolResult_RunTests{
+									RunTests: &v1.ToolResult_RunTestsResult{
+										Framework: runTestsResult.Framework,
+										Command:   runTestsResult.Command,
+										Success:   runTestsResult.Success,
+										Passed:    int32(runTestsResult.Passed),
+										Failed:    int32(runTestsResult.Failed),
+										Skipped:   int32(runTestsResult.Skipped),
+										Duration:  runTestsResult.Duration,
+										Tests:     testCases,
+										Truncated: runTestsResult.Truncated,
+										Output:    runTestsResult.Output,
+										TimedOut:  runTestsResult.TimedOut,
+									},
+								},
+							},
+						},
+					})
 				case toolbase.ToolNameExecuteCommand:
 					executeCommandInput := call.Input.ExecuteCommand
 					if executeCommandInput == nil {
@@ -570,6 +821,209 @@ func ConvertMemoryMessageToProto(m *memory.Message) (*v1.Message, error) {
 							},
 						},
 					})
+				case toolbase.ToolNameDelegate:
+					delegateInput := call.Input.Delegate
+					if delegateInput == nil {
+						slog.Error("delegate input not set")
+						continue
+					}
+
+					contentParts = append(contentParts, &v1.MessagePart{
+						Data: &v1.MessagePart_ToolCall{
+							ToolCall: &v1.ToolCall{
+								ToolName: call.ToolName,
+								Input: &v1.ToolCall_Delegate{
+									Delegate: codeact.ConvertDelegateInputToProto(delegateInput),
+								},
+							},
+						},
+					})
+
+					delegateResult := call.Output.Delegate
+					if delegateResult == nil {
+						slog.Error("delegate result not set")
+						continue
+					}
+
+					contentParts = append(contentParts, &v1.MessagePart{
+						Data: &v1.MessagePart_ToolResult{
+							ToolResult: &v1.ToolResult{
+								ToolName: call.ToolName,
+								Result: &v1.ToolResult_Delegate{
+									Delegate: codeact.ConvertDelegateResultToProto(delegateResult),
+								},
+							},
+						},
+					})
+				case toolbase.ToolNameRunParallel:
+					runParallelInput := call.Input.RunParallel
+					if runParallelInput == nil {
+						slog.Error("run parallel input not set")
+						continue
+					}
+
+					contentParts = append(contentParts, &v1.MessagePart{
+						Data: &v1.MessagePart_ToolCall{
+							ToolCall: &v1.ToolCall{
+								ToolName: call.ToolName,
+								Input: &v1.ToolCall_RunParallel{
+									RunParallel: codeact.ConvertRunParallelInputToProto(runParallelInput),
+								},
+							},
+						},
+					})
+
+					runParallelResult := call.Output.RunParallel
+					if runParallelResult == nil {
+						slog.Error("run parallel result not set")
+						continue
+					}
+
+					contentParts = append(contentParts, &v1.MessagePart{
+						Data: &v1.MessagePart_ToolResult{
+							ToolResult: &v1.ToolResult{
+								ToolName: call.ToolName,
+								Result: &v1.ToolResult_RunParallel{
+									RunParallel: codeact.ConvertRunParallelResultToProto(runParallelResult),
+								},
+							},
+						},
+					})
+				default:
+					if gitInput := call.Input.Git; gitInput != nil {
+						arguments, err := json.Marshal(gitInput.Arguments)
+						if err != nil {
+							return nil, fmt.Errorf("failed to marshal git arguments: %w", err)
+						}
+
+						contentParts = append(contentParts, &v1.MessagePart{
+							Data: &v1.MessagePart_ToolCall{
+								ToolCall: &v1.ToolCall{
+									ToolName: call.ToolName,
+									Input: &v1.ToolCall_Git{
+										Git: &v1.ToolCall_GitInput{
+											Operation: gitInput.Operation,
+											Arguments: string(arguments),
+										},
+									},
+								},
+							},
+						})
+						gitResult := call.Output.Git
+						if gitResult == nil {
+							slog.Error("git result not set")
+							continue
+						}
+						output, err := json.Marshal(gitResult.Output)
+						if err != nil {
+							return nil, fmt.Errorf("failed to marshal git output: %w", err)
+						}
+
+						contentParts = append(contentParts, &v1.MessagePart{
+							Data: &v1.MessagePart_ToolResult{
+								ToolResult: &v1.ToolResult{
+									ToolName: call.ToolName,
+									Result: &v1.ToolResult_Git{
+										Git: &v1.ToolResult_GitResult{
+											Operation: gitResult.Operation,
+											Output:    string(output),
+										},
+									},
+								},
+							},
+						})
+						continue
+					}
+
+					if mcpInput := call.Input.MCPTool; mcpInput != nil {
+						arguments, err := json.Marshal(mcpInput.Arguments)
+						if err != nil {
+							return nil, fmt.Errorf("failed to marshal mcp tool arguments: %w", err)
+						}
+
+						contentParts = append(contentParts, &v1.MessagePart{
+							Data: &v1.MessagePart_ToolCall{
+								ToolCall: &v1.ToolCall{
+									ToolName: call.ToolName,
+									Input: &v1.ToolCall_McpTool{
+										McpTool: &v1.ToolCall_McpToolInput{
+											Server:    mcpInput.Server,
+											Tool:      mcpInput.Tool,
+											Arguments: string(arguments),
+										},
+									},
+								},
+							},
+						})
+						mcpResult := call.Output.MCPTool
+						if mcpResult == nil {
+							slog.Error("mcp tool result not set")
+							continue
+						}
+						output, err := json.Marshal(mcpResult.Output)
+						if err != nil {
+							return nil, fmt.Errorf("failed to marshal mcp tool output: %w", err)
+						}
+
+						contentParts = append(contentParts, &v1.MessagePart{
+							Data: &v1.MessagePart_ToolResult{
+								ToolResult: &v1.ToolResult{
+									ToolName: call.ToolName,
+									Result: &v1.ToolResult_McpTool{
+										McpTool: &v1.ToolResult_McpToolResult{
+											Output: string(output),
+										},
+									},
+								},
+							},
+						})
+						continue
+					}
+
+					customInput := call.Input.CustomTool
+					if customInput == nil {
+						slog.Error("unknown tool name", "tool_name", call.ToolName)
+						continue
+					}
+					arguments, err := json.Marshal(customInput.Arguments)
+					if err != nil {
+						return nil, fmt.Errorf("failed to marshal custom tool arguments: %w", err)
+					}
+
+					contentParts = append(contentParts, &v1.MessagePart{
+						Data: &v1.MessagePart_ToolCall{
+							ToolCall: &v1.ToolCall{
+								ToolName: call.ToolName,
+								Input: &v1.ToolCall_CustomTool{
+									CustomTool: &v1.ToolCall_CustomToolInput{
+										Arguments: string(arguments),
+									},
+								},
+							},
+						},
+					})
+					customResult := call.Output.CustomTool
+					if customResult == nil {
+						slog.Error("custom tool result not set")
+						continue
+					}
+					output, err := json.Marshal(customResult.Output)
+					if err != nil {
+						return nil, fmt.Errorf("failed to marshal custom tool output: %w", err)
+					}
+
+					contentParts = append(contentParts, &v1.MessagePart{
+						Data: &v1.MessagePart_ToolResult{
+							ToolResult: &v1.ToolResult{
+								ToolName: call.ToolName,
+								Result: &v1.ToolResult_CustomTool{
+									CustomTool: &v1.ToolResult_CustomToolResult{
+										Output: string(output),
+									},
+								},
+							},
+						},
+					})
 				}
 			}
 		}
@@ -0,0 +1,323 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/furisto/construct/backend/event"
+	"github.com/furisto/construct/backend/memory"
+	memory_agent "github.com/furisto/construct/backend/memory/agent"
+	memory_message "github.com/furisto/construct/backend/memory/message"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	memory_task "github.com/furisto/construct/backend/memory/task"
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/furisto/construct/backend/tool/codeact"
+	"github.com/furisto/construct/backend/tool/communication"
+	"github.com/google/uuid"
+)
+
+var _ communication.Delegator = (*TaskReconciler)(nil)
+
+const delegatedTaskInstruction = `
+
+# Delegated Task
+Another agent delegated this task to you and waits for your answer. It cannot see this conversation and the user is not available for questions. When you are done, call submit_report with your results as the final step.
+`
+
+// Delegate creates a child task of the parent task and blocks until the child
+// answered. The parent holds its worker while it waits, so delegations are
+// limited to leave at least one worker for the child tasks.
+func (r *TaskReconciler) Delegate(ctx context.Context, parentID uuid.UUID, input *communication.DelegateInput) (*communication.DelegateResult, error) {
+	if int(r.delegations.Add(1)) >= r.concurrency {
+		r.delegations.Add(-1)
+		return nil, base.NewCustomError("too many delegated tasks are running", []string{
+			"Wait for other delegated tasks to finish or do the work yourself",
+		})
+	}
+	defer r.delegations.Add(-1)
+
+	logger := r.logger.With(KeyTaskID, parentID)
+
+	parent, err := r.memory.Task.Get(ctx, parentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch task: %w", err)
+	}
+
+	if parent.ParentID != uuid.Nil {
+		return nil, base.NewCustomError("delegated tasks cannot delegate work", []string{
+			"Complete the work yourself with the other available tools",
+		})
+	}
+
+	agent, err := r.delegateAgent(ctx, input.Agent)
+	if err != nil {
+		return nil, err
+	}
+
+	childID := uuid.New()
+	maxCost, err := r.reserveBudget(ctx, parent, childID, input.MaxCost)
+	if err != nil {
+		return nil, err
+	}
+	defer r.releaseBudget(parent.ID, childID)
+
+	// subscribe before the child exists, otherwise a fast child could finish
+	// before the parent listens
+	isChild := func(taskID uuid.UUID) bool { return taskID == childID }
+	responses, responseSub := event.SubscribeChannel(r.bus, 1, func(e event.TaskResponseEvent) bool { return isChild(e.TaskID) })
+	defer responseSub.Unsubscribe()
+	failures, failureSub := event.SubscribeChannel(r.bus, 1, func(e event.TaskErrorEvent) bool { return isChild(e.TaskID) })
+	defer failureSub.Unsubscribe()
+	suspensions, suspensionSub := event.SubscribeChannel(r.bus, 1, func(e event.TaskSuspendedEvent) bool { return isChild(e.TaskID) })
+	defer suspensionSub.Unsubscribe()
+
+	var instructions *memory.Message
+	child, err := memory.Transaction(ctx, r.memory, func(tx *memory.Client) (*memory.Task, error) {
+		taskCreate := tx.Task.Create().
+			SetID(childID).
+			SetAgentID(agent.ID).
+			SetProjectDirectory(parent.ProjectDirectory).
+			SetParentID(parent.ID).
+			SetNillableOwnerID(parent.OwnerID)
+
+		if maxCost > 0 {
+			taskCreate = taskCreate.SetMaxCost(maxCost)
+		}
+
+		child, err := taskCreate.Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		instructions, err = tx.Message.Create().
+			SetTask(child).
+			SetSource(types.MessageSourceUser).
+			SetContent(&types.MessageContent{
+				Blocks: []types.MessageBlock{
+					{
+						Kind:    types.MessageBlockKindText,
+						Payload: input.Instructions,
+					},
+				},
+			}).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return child, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create delegated task: %w", err)
+	}
+
+	logger.InfoContext(ctx, "delegated task started",
+		"child_task_id", child.ID,
+		KeyAgentID, agent.ID,
+	)
+	event.Publish(r.bus, event.ResourceChangedEvent{
+		Kind:      event.ResourceKindTask,
+		Operation: event.ResourceOperationCreated,
+		ID:        child.ID,
+	})
+	event.Publish(r.bus, event.MessageCompletedEvent{
+		TaskID:    child.ID,
+		MessageID: instructions.ID,
+		Source:    instructions.Source,
+	})
+	event.Publish(r.bus, event.TaskEvent{
+		TaskID: child.ID,
+	})
+
+	for {
+		select {
+		case response := <-responses:
+			logger.InfoContext(ctx, "delegated task finished", "child_task_id", child.ID)
+			return r.delegateResult(ctx, child, agent, response.Content)
+		case failure := <-failures:
+			// the reconciler retries the child itself, e.g. after a rate limit
+			if failure.Retryable {
+				logger.DebugContext(ctx, "delegated task is retrying", "child_task_id", child.ID, KeyError, failure.Error)
+				continue
+			}
+			r.suspendDelegatedTask(ctx, child.ID)
+			return nil, base.NewCustomError(fmt.Sprintf("delegated task %s failed: %s", child.ID, failure.Error), []string{
+				"Retry the delegation with clearer instructions or a larger budget",
+				"Do the work yourself with the other available tools",
+			})
+		case <-suspensions:
+			return nil, base.NewCustomError(fmt.Sprintf("delegated task %s was suspended before it finished", child.ID), []string{
+				"The user stopped the delegated task. Ask the user how to proceed",
+			})
+		case <-ctx.Done():
+			r.suspendDelegatedTask(ctx, child.ID)
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// delegateAgent resolves the agent by ID or by name.
+func (r *TaskReconciler) delegateAgent(ctx context.Context, nameOrID string) (*memory.Agent, error) {
+	query := r.memory.Agent.Query().Where(memory_agent.NameEQ(nameOrID))
+	if id, err := uuid.Parse(nameOrID); err == nil {
+		query = r.memory.Agent.Query().Where(memory_agent.IDEQ(id))
+	}
+
+	agent, err := query.First(ctx)
+	if err != nil {
+		if memory.IsNotFound(err) {
+			return nil, base.NewCustomError(fmt.Sprintf("agent %s does not exist", nameOrID), []string{
+				"Check the agent name and try again",
+			})
+		}
+		return nil, fmt.Errorf("failed to fetch agent: %w", err)
+	}
+	return agent, nil
+}
+
+// delegateResult returns the last report the child submitted. If the child
+// answered without a report, its answer is returned as an incomplete result.
+func (r *TaskReconciler) delegateResult(ctx context.Context, child *memory.Task, agent *memory.Agent, response string) (*communication.DelegateResult, error) {
+	messages, err := r.memory.Message.Query().
+		Where(memory_message.TaskIDEQ(child.ID), memory_message.SourceEQ(types.MessageSourceSystem)).
+		Order(memory_message.ByCreateTime()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch messages of delegated task: %w", err)
+	}
+
+	// the cost of the child changed while it was running
+	child, err = r.memory.Task.Get(ctx, child.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch delegated task: %w", err)
+	}
+
+	result := &communication.DelegateResult{
+		TaskID:  child.ID.String(),
+		Agent:   agent.Name,
+		Summary: response,
+		Cost:    child.Cost,
+	}
+
+	for _, message := range messages {
+		for _, block := range message.Content.Blocks {
+			if block.Kind != types.MessageBlockKindCodeInterpreterResult {
+				continue
+			}
+
+			var interpreterResult codeact.InterpreterToolResult
+			if err := json.Unmarshal([]byte(block.Payload), &interpreterResult); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal tool result: %w", err)
+			}
+
+			for _, call := range interpreterResult.FunctionCalls {
+				if report := call.Output.SubmitReport; report != nil {
+					result.Summary = report.Summary
+					result.Completed = report.Completed
+					result.Deliverables = report.Deliverables
+					result.NextSteps = report.NextSteps
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// suspendDelegatedTask stops a child task the parent no longer waits for. The
+// user can resume it by sending a message.
+func (r *TaskReconciler) suspendDelegatedTask(ctx context.Context, taskID uuid.UUID) {
+	ctx = context.WithoutCancel(ctx)
+	_, err := r.memory.Task.UpdateOneID(taskID).SetDesiredPhase(types.TaskPhaseSuspended).Save(ctx)
+	if err != nil {
+		LogError(r.logger.With(KeyTaskID, taskID), "failed to suspend delegated task", err)
+		return
+	}
+
+	event.Publish(r.bus, event.TaskSuspendedEvent{
+		TaskID: taskID,
+	})
+}
+
+// RemainingBudget returns the budget of the parent that is neither spent by
+// the parent and its children nor reserved by running children.
+func (r *TaskReconciler) RemainingBudget(ctx context.Context, parentID uuid.UUID) (float64, error) {
+	parent, err := r.memory.Task.Get(ctx, parentID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch task: %w", err)
+	}
+	if parent.MaxCost == 0 {
+		return 0, nil
+	}
+
+	r.budgetMu.Lock()
+	defer r.budgetMu.Unlock()
+
+	return r.remainingBudget(ctx, parent)
+}
+
+// reserveBudget reserves the budget of a child task. Without a parent budget
+// the requested budget is used as is, zero meaning unlimited. Otherwise the
+// child gets the requested budget, at most the remaining budget of the parent.
+func (r *TaskReconciler) reserveBudget(ctx context.Context, parent *memory.Task, childID uuid.UUID, requested float64) (float64, error) {
+	if parent.MaxCost == 0 {
+		return requested, nil
+	}
+
+	r.budgetMu.Lock()
+	defer r.budgetMu.Unlock()
+
+	remaining, err := r.remainingBudget(ctx, parent)
+	if err != nil {
+		return 0, err
+	}
+
+	budget := requested
+	if budget == 0 || budget > remaining {
+		budget = remaining
+	}
+
+	if r.reservedBudgets[parent.ID] == nil {
+		r.reservedBudgets[parent.ID] = make(map[uuid.UUID]float64)
+	}
+	r.reservedBudgets[parent.ID][childID] = budget
+	return budget, nil
+}
+
+func (r *TaskReconciler) releaseBudget(parentID, childID uuid.UUID) {
+	r.budgetMu.Lock()
+	defer r.budgetMu.Unlock()
+
+	delete(r.reservedBudgets[parentID], childID)
+	if len(r.reservedBudgets[parentID]) == 0 {
+		delete(r.reservedBudgets, parentID)
+	}
+}
+
+// remainingBudget must be called with budgetMu held. Running children count
+// with their reserved budget, finished children with their cost.
+func (r *TaskReconciler) remainingBudget(ctx context.Context, parent *memory.Task) (float64, error) {
+	children, err := r.memory.Task.Query().Where(memory_task.ParentID(parent.ID)).All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch delegated tasks: %w", err)
+	}
+
+	reserved := r.reservedBudgets[parent.ID]
+	remaining := parent.MaxCost - parent.Cost
+	for _, child := range children {
+		if _, ok := reserved[child.ID]; !ok {
+			remaining -= child.Cost
+		}
+	}
+	for _, budget := range reserved {
+		remaining -= budget
+	}
+
+	if remaining <= 0 {
+		return 0, base.NewCustomError("the budget of this task is exhausted", []string{
+			"Submit your results or ask the user to increase the budget",
+		})
+	}
+	return remaining, nil
+}
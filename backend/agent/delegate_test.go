@@ -0,0 +1,162 @@
+package agent
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/furisto/construct/backend/event"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/test"
+	"github.com/furisto/construct/backend/tool/communication"
+	"github.com/google/uuid"
+	_ "modernc.org/sqlite"
+)
+
+type delegateTestSetup struct {
+	db         *memory.Client
+	bus        *event.Bus
+	reconciler *TaskReconciler
+	parent     *memory.Task
+	agent      *memory.Agent
+}
+
+func newDelegateTestSetup(t *testing.T) *delegateTestSetup {
+	t.Helper()
+
+	db, err := memory.Open(dialect.SQLite, "file:construct_test?mode=memory&cache=private&_pragma=foreign_keys(1)")
+	if err != nil {
+		t.Fatalf("failed opening connection to sqlite: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	ctx := t.Context()
+	if err := db.Schema.Create(ctx); err != nil {
+		t.Fatalf("failed to create schema: %v", err)
+	}
+
+	bus := event.NewBus(nil)
+	t.Cleanup(bus.Close)
+
+	provider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+	model := test.NewModelBuilder(t, uuid.New(), db, provider).Build(ctx)
+	agent := test.NewAgentBuilder(t, uuid.New(), db, model).Build(ctx)
+	parent := test.NewTaskBuilder(t, uuid.New(), db, agent).Build(ctx)
+
+	return &delegateTestSetup{
+		db:  db,
+		bus: bus,
+		reconciler: &TaskReconciler{
+			memory:          db,
+			bus:             bus,
+			concurrency:     4,
+			runningTasks:    NewSyncMap[uuid.UUID, runningTask](),
+			reservedBudgets: make(map[uuid.UUID]map[uuid.UUID]float64),
+			logger:          slog.Default(),
+		},
+		parent: parent,
+		agent:  agent,
+	}
+}
+
+type delegateOutcome struct {
+	result *communication.DelegateResult
+	err    error
+}
+
+// delegate starts a delegation and returns the ID of the child task once it
+// was created.
+func (s *delegateTestSetup) delegate(t *testing.T) (uuid.UUID, <-chan delegateOutcome) {
+	t.Helper()
+
+	created, sub := event.SubscribeChannel(s.bus, 1, func(e event.ResourceChangedEvent) bool {
+		return e.Kind == event.ResourceKindTask && e.Operation == event.ResourceOperationCreated
+	})
+	defer sub.Unsubscribe()
+
+	outcome := make(chan delegateOutcome, 1)
+	go func() {
+		result, err := s.reconciler.Delegate(t.Context(), s.parent.ID, &communication.DelegateInput{
+			Agent:        s.agent.Name,
+			Instructions: "Summarize the README",
+		})
+		outcome <- delegateOutcome{result: result, err: err}
+	}()
+
+	select {
+	case e := <-created:
+		return e.ID, outcome
+	case o := <-outcome:
+		t.Fatalf("delegation returned before the child was created: %v", o.err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("child task was not created")
+	}
+	return uuid.Nil, nil
+}
+
+func TestDelegateRetryableError(t *testing.T) {
+	setup := newDelegateTestSetup(t)
+	childID, outcome := setup.delegate(t)
+
+	event.Publish(setup.bus, event.TaskErrorEvent{
+		TaskID:    childID,
+		Error:     "model provider is overloaded (529), retrying",
+		Retryable: true,
+	})
+
+	select {
+	case o := <-outcome:
+		t.Fatalf("expected the delegation to wait for the retry, got %+v, %v", o.result, o.err)
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	event.Publish(setup.bus, event.TaskResponseEvent{TaskID: childID, Content: "The README describes the CLI"})
+
+	select {
+	case o := <-outcome:
+		if o.err != nil {
+			t.Fatalf("unexpected error: %v", o.err)
+		}
+		if o.result.Summary != "The README describes the CLI" {
+			t.Errorf("unexpected summary %q", o.result.Summary)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("delegation did not finish")
+	}
+
+	child, err := setup.db.Task.Get(t.Context(), childID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if child.DesiredPhase == types.TaskPhaseSuspended {
+		t.Error("expected the child not to be suspended")
+	}
+}
+
+func TestDelegateError(t *testing.T) {
+	setup := newDelegateTestSetup(t)
+	childID, outcome := setup.delegate(t)
+
+	event.Publish(setup.bus, event.TaskErrorEvent{TaskID: childID, Error: "invalid api key"})
+
+	select {
+	case o := <-outcome:
+		if o.err == nil || !strings.Contains(o.err.Error(), "invalid api key") {
+			t.Fatalf("expected the delegation to fail, got %+v, %v", o.result, o.err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("delegation did not finish")
+	}
+
+	child, err := setup.db.Task.Get(context.WithoutCancel(t.Context()), childID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if child.DesiredPhase != types.TaskPhaseSuspended {
+		t.Errorf("expected the child to be suspended, got %s", child.DesiredPhase)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"os/user"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
 )
 
@@ -180,7 +181,7 @@ func AvailableDevTools() *DevTools {
 			"git", "gh",
 		},
 		"PackageManagers": {
-			"npm", "yarn", "pnpm", "pip", "pip3", "pipenv", "poetry",
+			"npm", "npx", "yarn", "pnpm", "pip", "pip3", "pipenv", "poetry",
 			"cargo", "go", "composer", "gem", "bundle", "maven", "gradle",
 			"brew",
 		},
@@ -193,7 +194,7 @@ func AvailableDevTools() *DevTools {
 			"gulp", "bazel",
 		},
 		"Testing": {
-			"jest", "mocha", "pytest", "phpunit", "rspec",
+			"jest", "vitest", "mocha", "pytest", "phpunit", "rspec",
 		},
 		"Database": {
 			"mysql", "psql", "sqlite3", "mongo", "redis-cli",
@@ -296,6 +297,21 @@ func AvailableDevTools() *DevTools {
 	return result
 }
 
+// Available reports whether the tool is in one of the categories.
+func (t *DevTools) Available(tool string) bool {
+	categories := [][]string{
+		t.VersionControl, t.PackageManagers, t.LanguageRuntimes, t.BuildTools, t.Testing, t.Database,
+		t.ContainerOrchestration, t.CloudInfrastructure, t.TextProcessing, t.FileOperations,
+		t.NetworkHTTP, t.SystemMonitoring,
+	}
+	for _, category := range categories {
+		if slices.Contains(category, tool) {
+			return true
+		}
+	}
+	return false
+}
+
 func isToolAvailable(tool string) bool {
 	_, err := exec.LookPath(tool)
 	return err == nil
@@ -9,9 +9,10 @@ import (
 // Log key constants for consistency across the codebase
 const (
 	// Task and agent identifiers
-	KeyTaskID    = "task_id"
-	KeyAgentID   = "agent_id"
-	KeyMessageID = "message_id"
+	KeyTaskID     = "task_id"
+	KeyAgentID    = "agent_id"
+	KeyMessageID  = "message_id"
+	KeyScheduleID = "schedule_id"
 
 	// Model and provider information
 	KeyModel         = "model"
@@ -16,6 +16,7 @@ import (
 	"github.com/furisto/construct/backend/memory"
 	"github.com/furisto/construct/backend/secret"
 	"github.com/furisto/construct/backend/tool/codeact"
+	"github.com/furisto/construct/backend/webhook"
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -71,8 +72,11 @@ type Runtime struct {
 	memory         *memory.Client
 	encryption     *secret.Encryption
 	eventHub       *event.MessageHub
+	journal        *event.Journal
 	bus            *event.Bus
 	taskReconciler *TaskReconciler
+	scheduler      *Scheduler
+	webhooks       *webhook.Dispatcher
 	logger         *slog.Logger
 
 	wg        sync.WaitGroup
@@ -109,6 +113,8 @@ func NewRuntime(memory *memory.Client, encryption *secret.Encryption, listener n
 	eventBus := event.NewBus(metricsRegistry)
 
 	interceptors := []codeact.Interceptor{
+		codeact.InterceptorFunc(codeact.PolicyInterceptor),
+		codeact.InterceptorFunc(codeact.NestedInstructionInterceptor),
 		codeact.InterceptorFunc(codeact.ToolStatisticsInterceptor),
 		codeact.InterceptorFunc(codeact.DurableFunctionInterceptor),
 		codeact.NewToolEventPublisher(messageHub),
@@ -121,8 +127,11 @@ func NewRuntime(memory *memory.Client, encryption *secret.Encryption, listener n
 		memory:         memory,
 		encryption:     encryption,
 		eventHub:       messageHub,
+		journal:        event.NewJournal(eventBus, event.DefaultJournalCapacity),
 		bus:            eventBus,
 		taskReconciler: NewTaskReconciler(memory, codeact.NewInterpreter(options.Tools, interceptors), options.Concurrency, eventBus, messageHub, clientFactory, metricsRegistry),
+		scheduler:      NewScheduler(memory, eventBus, DefaultSchedulerInterval),
+		webhooks:       webhook.NewDispatcher(memory, encryption, eventBus),
 		analytics:      options.Analytics,
 		logger:         logger,
 		metrics:        metricsRegistry,
@@ -164,6 +173,25 @@ func (rt *Runtime) Run(ctx context.Context) error {
 		}
 	}()
 
+	rt.wg.Add(1)
+	go func() {
+		defer rt.wg.Done()
+		err := rt.scheduler.Run(ctx)
+		if err != nil {
+			LogError(rt.logger, "scheduler run", err)
+		}
+	}()
+
+	rt.wg.Add(1)
+	go func() {
+		defer rt.wg.Done()
+		LogComponentStartup(rt.logger, "webhook dispatcher")
+		err := rt.webhooks.Run(ctx)
+		if err != nil {
+			LogError(rt.logger, "webhook dispatcher run", err)
+		}
+	}()
+
 	rt.logger.Info("agent runtime fully initialized, waiting for shutdown signal")
 	<-ctx.Done()
 
@@ -208,6 +236,10 @@ func (rt *Runtime) EventHub() *event.MessageHub {
 	return rt.eventHub
 }
 
+func (rt *Runtime) EventJournal() *event.Journal {
+	return rt.journal
+}
+
 func WithRole(role v1.MessageRole) func(*v1.Message) {
 	return func(msg *v1.Message) {
 		msg.Metadata.Role = role
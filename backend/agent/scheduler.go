@@ -0,0 +1,280 @@
+package agent
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"text/template"
+	"time"
+
+	"github.com/furisto/construct/backend/event"
+	"github.com/furisto/construct/backend/memory"
+	memory_message "github.com/furisto/construct/backend/memory/message"
+	memory_schedule "github.com/furisto/construct/backend/memory/schedule"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/uuid"
+	"github.com/robfig/cron/v3"
+)
+
+const DefaultSchedulerInterval = 30 * time.Second
+
+// Scheduler creates a new task for every enabled schedule whose next run time has
+// passed. A run is skipped if the task created by the previous run is still active.
+// Every run is recorded in the run history of the schedule.
+type Scheduler struct {
+	memory   *memory.Client
+	bus      *event.Bus
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+func NewScheduler(memory *memory.Client, bus *event.Bus, interval time.Duration) *Scheduler {
+	return &Scheduler{
+		memory:   memory,
+		bus:      bus,
+		interval: interval,
+		logger:   slog.With(KeyComponent, "scheduler"),
+	}
+}
+
+// SchedulePromptData is the data available to the prompt template of a schedule.
+type SchedulePromptData struct {
+	ScheduleName string
+	Workspace    string
+	Time         time.Time
+}
+
+func (s *Scheduler) Run(ctx context.Context) error {
+	LogComponentStartup(s.logger, "scheduler")
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.runDueSchedules(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			LogComponentShutdown(s.logger, "scheduler", time.Now())
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) runDueSchedules(ctx context.Context, now time.Time) {
+	schedules, err := s.memory.Schedule.Query().
+		Where(
+			memory_schedule.Enabled(true),
+			memory_schedule.NextRunTimeLTE(now),
+		).
+		All(ctx)
+	if err != nil {
+		LogError(s.logger, "fetch due schedules", err)
+		return
+	}
+
+	for _, schedule := range schedules {
+		if err := s.runSchedule(ctx, schedule, now); err != nil {
+			LogError(s.logger, "run schedule", err, KeyScheduleID, schedule.ID)
+		}
+	}
+}
+
+func (s *Scheduler) runSchedule(ctx context.Context, schedule *memory.Schedule, now time.Time) error {
+	logger := s.logger.With(KeyScheduleID, schedule.ID)
+
+	cronSchedule, err := cron.ParseStandard(schedule.CronExpression)
+	if err != nil {
+		err = fmt.Errorf("invalid cron expression %q: %w", schedule.CronExpression, err)
+		_, updateErr := memory.Transaction(ctx, s.memory, func(tx *memory.Client) (*memory.Schedule, error) {
+			if recordErr := recordRun(ctx, tx, schedule, now, types.ScheduleRunStatusFailed, err.Error(), uuid.Nil); recordErr != nil {
+				return nil, recordErr
+			}
+			return tx.Schedule.UpdateOne(schedule).ClearNextRunTime().Save(ctx)
+		})
+		if updateErr != nil {
+			return updateErr
+		}
+		return err
+	}
+	nextRun := cronSchedule.Next(now)
+
+	prompt, err := renderSchedulePrompt(schedule, now)
+	if err != nil {
+		_, updateErr := memory.Transaction(ctx, s.memory, func(tx *memory.Client) (*memory.Schedule, error) {
+			if recordErr := recordRun(ctx, tx, schedule, now, types.ScheduleRunStatusFailed, err.Error(), uuid.Nil); recordErr != nil {
+				return nil, recordErr
+			}
+			return tx.Schedule.UpdateOne(schedule).SetLastRunTime(now).SetNextRunTime(nextRun).Save(ctx)
+		})
+		if updateErr != nil {
+			return updateErr
+		}
+		return err
+	}
+
+	var promptMessage *memory.Message
+	task, err := memory.Transaction(ctx, s.memory, func(tx *memory.Client) (*memory.Task, error) {
+		active, err := s.previousRunActive(ctx, tx, schedule)
+		if err != nil {
+			return nil, err
+		}
+
+		if active {
+			logger.InfoContext(ctx, "previous run still active, skipping", KeyTaskID, schedule.LastTaskID)
+			reason := fmt.Sprintf("the task %s of the previous run is still active", schedule.LastTaskID)
+			if err := recordRun(ctx, tx, schedule, now, types.ScheduleRunStatusSkipped, reason, uuid.Nil); err != nil {
+				return nil, err
+			}
+			_, err = tx.Schedule.UpdateOne(schedule).
+				SetLastRunTime(now).
+				SetNextRunTime(nextRun).
+				AddSkippedCount(1).
+				Save(ctx)
+			return nil, err
+		}
+
+		taskCreate := tx.Task.Create().
+			SetAgentID(schedule.AgentID).
+			SetProjectDirectory(schedule.ProjectDirectory).
+			SetScheduleID(schedule.ID).
+			SetDescription(fmt.Sprintf("%s (%s)", schedule.Name, now.Format(time.DateTime)))
+
+		if schedule.MaxCost > 0 {
+			taskCreate = taskCreate.SetMaxCost(schedule.MaxCost)
+		}
+
+		task, err := taskCreate.Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		promptMessage, err = tx.Message.Create().
+			SetTask(task).
+			SetSource(types.MessageSourceUser).
+			SetContent(&types.MessageContent{
+				Blocks: []types.MessageBlock{
+					{
+						Kind:    types.MessageBlockKindText,
+						Payload: prompt,
+					},
+				},
+			}).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := recordRun(ctx, tx, schedule, now, types.ScheduleRunStatusStarted, "", task.ID); err != nil {
+			return nil, err
+		}
+
+		_, err = tx.Schedule.UpdateOne(schedule).
+			SetLastRunTime(now).
+			SetNextRunTime(nextRun).
+			SetLastTaskID(task.ID).
+			AddRunCount(1).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return task, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if task != nil {
+		logger.InfoContext(ctx, "scheduled run started", KeyTaskID, task.ID)
+		event.Publish(s.bus, event.ResourceChangedEvent{
+			Kind:      event.ResourceKindTask,
+			Operation: event.ResourceOperationCreated,
+			ID:        task.ID,
+		})
+		event.Publish(s.bus, event.MessageCompletedEvent{
+			TaskID:    task.ID,
+			MessageID: promptMessage.ID,
+			Source:    promptMessage.Source,
+		})
+		event.Publish(s.bus, event.TaskEvent{
+			TaskID: task.ID,
+		})
+	}
+
+	return nil
+}
+
+// recordRun appends a run to the history of the schedule. The scheduled time is
+// the time the schedule was due, which is earlier than the run time if the
+// daemon was not running.
+func recordRun(ctx context.Context, tx *memory.Client, schedule *memory.Schedule, now time.Time, status types.ScheduleRunStatus, reason string, taskID uuid.UUID) error {
+	create := tx.ScheduleRun.Create().
+		SetScheduleID(schedule.ID).
+		SetStatus(status).
+		SetScheduledTime(schedule.NextRunTime).
+		SetRunTime(now)
+
+	if reason != "" {
+		create = create.SetReason(reason)
+	}
+	if taskID != uuid.Nil {
+		create = create.SetTaskID(taskID)
+	}
+
+	_, err := create.Save(ctx)
+	return err
+}
+
+// previousRunActive reports whether the task created by the last run is still
+// working or has user messages it has not processed yet. Suspended tasks are
+// not considered active.
+func (s *Scheduler) previousRunActive(ctx context.Context, tx *memory.Client, schedule *memory.Schedule) (bool, error) {
+	if schedule.LastTaskID == uuid.Nil {
+		return false, nil
+	}
+
+	task, err := tx.Task.Get(ctx, schedule.LastTaskID)
+	if err != nil {
+		if memory.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if task.DesiredPhase == types.TaskPhaseSuspended {
+		return false, nil
+	}
+
+	if task.Phase == types.TaskPhaseRunning {
+		return true, nil
+	}
+
+	return tx.Message.Query().
+		Where(
+			memory_message.TaskIDEQ(task.ID),
+			memory_message.ProcessedTimeIsNil(),
+		).
+		Exist(ctx)
+}
+
+func renderSchedulePrompt(schedule *memory.Schedule, now time.Time) (string, error) {
+	tmpl, err := template.New(schedule.Name).Parse(schedule.Prompt)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse prompt template: %w", err)
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, SchedulePromptData{
+		ScheduleName: schedule.Name,
+		Workspace:    schedule.ProjectDirectory,
+		Time:         now,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to render prompt template: %w", err)
+	}
+
+	return buf.String(), nil
+}
@@ -0,0 +1,286 @@
+package agent
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/furisto/construct/backend/event"
+	"github.com/furisto/construct/backend/memory"
+	memory_message "github.com/furisto/construct/backend/memory/message"
+	memory_schedulerun "github.com/furisto/construct/backend/memory/schedulerun"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	memory_task "github.com/furisto/construct/backend/memory/task"
+	"github.com/furisto/construct/backend/memory/test"
+	"github.com/google/uuid"
+	"github.com/robfig/cron/v3"
+	_ "modernc.org/sqlite"
+)
+
+type schedulerTestSetup struct {
+	db        *memory.Client
+	scheduler *Scheduler
+	agent     *memory.Agent
+}
+
+func newSchedulerTestSetup(t *testing.T) *schedulerTestSetup {
+	t.Helper()
+
+	db, err := memory.Open(dialect.SQLite, "file:construct_test?mode=memory&cache=private&_pragma=foreign_keys(1)")
+	if err != nil {
+		t.Fatalf("failed opening connection to sqlite: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	ctx := t.Context()
+	if err := db.Schema.Create(ctx); err != nil {
+		t.Fatalf("failed to create schema: %v", err)
+	}
+
+	bus := event.NewBus(nil)
+	t.Cleanup(bus.Close)
+
+	provider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+	model := test.NewModelBuilder(t, uuid.New(), db, provider).Build(ctx)
+	agent := test.NewAgentBuilder(t, uuid.New(), db, model).Build(ctx)
+
+	return &schedulerTestSetup{
+		db:        db,
+		scheduler: NewScheduler(db, bus, DefaultSchedulerInterval),
+		agent:     agent,
+	}
+}
+
+// dueSchedule creates an enabled schedule whose next run time has passed.
+func (s *schedulerTestSetup) dueSchedule(t *testing.T, now time.Time) *memory.Schedule {
+	t.Helper()
+
+	schedule := test.NewScheduleBuilder(t, uuid.New(), s.db, s.agent).Build(t.Context())
+	schedule, err := s.db.Schedule.UpdateOne(schedule).SetNextRunTime(now.Add(-time.Minute)).Save(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return schedule
+}
+
+func TestSchedulerRunsDueSchedule(t *testing.T) {
+	setup := newSchedulerTestSetup(t)
+	ctx := t.Context()
+	now := time.Date(2026, 3, 14, 12, 0, 0, 0, time.Local)
+
+	due := setup.dueSchedule(t, now)
+	disabled := test.NewScheduleBuilder(t, uuid.New(), setup.db, setup.agent).WithName("disabled").WithEnabled(false).Build(ctx)
+	if _, err := setup.db.Schedule.UpdateOne(disabled).SetNextRunTime(now.Add(-time.Minute)).Save(ctx); err != nil {
+		t.Fatal(err)
+	}
+	later := test.NewScheduleBuilder(t, uuid.New(), setup.db, setup.agent).WithName("later").Build(ctx)
+	if _, err := setup.db.Schedule.UpdateOne(later).SetNextRunTime(now.Add(time.Hour)).Save(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	setup.scheduler.runDueSchedules(ctx, now)
+
+	tasks, err := setup.db.Task.Query().All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tasks) != 1 {
+		t.Fatalf("expected one task, got %d", len(tasks))
+	}
+	task := tasks[0]
+	if task.ScheduleID != due.ID || task.AgentID != setup.agent.ID || task.ProjectDirectory != due.ProjectDirectory {
+		t.Errorf("unexpected task %+v", task)
+	}
+
+	prompt, err := setup.db.Message.Query().Where(memory_message.TaskIDEQ(task.ID)).Only(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prompt.Source != types.MessageSourceUser || prompt.Content.Blocks[0].Payload != due.Prompt {
+		t.Errorf("unexpected prompt %+v", prompt.Content)
+	}
+
+	due, err = setup.db.Schedule.Get(ctx, due.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if due.LastTaskID != task.ID || due.RunCount != 1 || due.SkippedCount != 0 {
+		t.Errorf("expected the run to be recorded, got last task %s, %d runs and %d skipped", due.LastTaskID, due.RunCount, due.SkippedCount)
+	}
+	if !due.LastRunTime.Equal(now) {
+		t.Errorf("expected last run time %s, got %s", now, due.LastRunTime)
+	}
+	expectNextRun(t, due, now)
+
+	run, err := setup.db.ScheduleRun.Query().Where(memory_schedulerun.ScheduleIDEQ(due.ID)).Only(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if run.Status != types.ScheduleRunStatusStarted || run.TaskID != task.ID || run.Reason != "" {
+		t.Errorf("expected a started run of task %s, got %+v", task.ID, run)
+	}
+	if !run.RunTime.Equal(now) || !run.ScheduledTime.Equal(now.Add(-time.Minute)) {
+		t.Errorf("expected run time %s and scheduled time %s, got %s and %s", now, now.Add(-time.Minute), run.RunTime, run.ScheduledTime)
+	}
+
+	later, err = setup.db.Schedule.Get(ctx, later.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if later.RunCount != 0 || !later.NextRunTime.Equal(now.Add(time.Hour)) {
+		t.Errorf("expected the schedule that is not due to be left alone, got %+v", later)
+	}
+}
+
+func TestSchedulerPreviousRun(t *testing.T) {
+	tests := []struct {
+		Name string
+		// Previous creates the task of the previous run.
+		Previous func(t *testing.T, setup *schedulerTestSetup) *memory.Task
+		Skipped  bool
+	}{
+		{
+			Name: "running task is skipped",
+			Previous: func(t *testing.T, setup *schedulerTestSetup) *memory.Task {
+				task := test.NewTaskBuilder(t, uuid.New(), setup.db, setup.agent).Build(t.Context())
+				return setup.db.Task.UpdateOne(task).SetPhase(types.TaskPhaseRunning).SaveX(t.Context())
+			},
+			Skipped: true,
+		},
+		{
+			Name: "task with unprocessed messages is skipped",
+			Previous: func(t *testing.T, setup *schedulerTestSetup) *memory.Task {
+				task := test.NewTaskBuilder(t, uuid.New(), setup.db, setup.agent).Build(t.Context())
+				test.NewMessageBuilder(t, uuid.New(), setup.db, task).Build(t.Context())
+				return task
+			},
+			Skipped: true,
+		},
+		{
+			Name: "finished task runs again",
+			Previous: func(t *testing.T, setup *schedulerTestSetup) *memory.Task {
+				task := test.NewTaskBuilder(t, uuid.New(), setup.db, setup.agent).Build(t.Context())
+				test.NewMessageBuilder(t, uuid.New(), setup.db, task).WithProcessedTime(time.Now()).Build(t.Context())
+				return task
+			},
+		},
+		{
+			Name: "suspended task runs again",
+			Previous: func(t *testing.T, setup *schedulerTestSetup) *memory.Task {
+				task := test.NewTaskBuilder(t, uuid.New(), setup.db, setup.agent).Build(t.Context())
+				return setup.db.Task.UpdateOne(task).
+					SetPhase(types.TaskPhaseRunning).
+					SetDesiredPhase(types.TaskPhaseSuspended).
+					SaveX(t.Context())
+			},
+		},
+		{
+			Name: "deleted task runs again",
+			Previous: func(t *testing.T, setup *schedulerTestSetup) *memory.Task {
+				return &memory.Task{ID: uuid.New()}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			setup := newSchedulerTestSetup(t)
+			ctx := t.Context()
+			now := time.Date(2026, 3, 14, 12, 0, 0, 0, time.Local)
+
+			previous := tt.Previous(t, setup)
+			schedule := setup.dueSchedule(t, now)
+			schedule, err := setup.db.Schedule.UpdateOne(schedule).SetLastTaskID(previous.ID).Save(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := setup.scheduler.runSchedule(ctx, schedule, now); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			schedule, err = setup.db.Schedule.Get(ctx, schedule.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			created, err := setup.db.Task.Query().Where(memory_task.ScheduleIDEQ(schedule.ID)).Count(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			run, err := setup.db.ScheduleRun.Query().Where(memory_schedulerun.ScheduleIDEQ(schedule.ID)).Only(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.Skipped {
+				if run.Status != types.ScheduleRunStatusSkipped || run.TaskID != uuid.Nil || !strings.Contains(run.Reason, previous.ID.String()) {
+					t.Errorf("expected a skipped run naming the previous task, got %+v", run)
+				}
+				if created != 0 || schedule.SkippedCount != 1 || schedule.RunCount != 0 {
+					t.Errorf("expected the run to be skipped, got %d tasks, %d runs and %d skipped", created, schedule.RunCount, schedule.SkippedCount)
+				}
+				if schedule.LastTaskID != previous.ID {
+					t.Errorf("expected the previous task to stay the last task, got %s", schedule.LastTaskID)
+				}
+			} else {
+				if run.Status != types.ScheduleRunStatusStarted || run.TaskID != schedule.LastTaskID {
+					t.Errorf("expected a started run of the new task, got %+v", run)
+				}
+				if created != 1 || schedule.SkippedCount != 0 || schedule.RunCount != 1 {
+					t.Errorf("expected a new run, got %d tasks, %d runs and %d skipped", created, schedule.RunCount, schedule.SkippedCount)
+				}
+				if schedule.LastTaskID == previous.ID {
+					t.Error("expected the new task to become the last task")
+				}
+			}
+			expectNextRun(t, schedule, now)
+		})
+	}
+}
+
+func TestSchedulerRecordsFailedRuns(t *testing.T) {
+	setup := newSchedulerTestSetup(t)
+	ctx := t.Context()
+	now := time.Date(2026, 3, 14, 12, 0, 0, 0, time.Local)
+
+	schedule := setup.dueSchedule(t, now)
+	schedule, err := setup.db.Schedule.UpdateOne(schedule).SetPrompt("Review {{.Missing}}").Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := setup.scheduler.runSchedule(ctx, schedule, now); err == nil {
+		t.Fatal("expected the prompt template to fail")
+	}
+
+	run, err := setup.db.ScheduleRun.Query().Where(memory_schedulerun.ScheduleIDEQ(schedule.ID)).Only(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if run.Status != types.ScheduleRunStatusFailed || !strings.Contains(run.Reason, "failed to render prompt template") || run.TaskID != uuid.Nil {
+		t.Errorf("expected a failed run, got %+v", run)
+	}
+
+	schedule, err = setup.db.Schedule.Get(ctx, schedule.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectNextRun(t, schedule, now)
+}
+
+// expectNextRun checks that the next run time was advanced to the next time
+// the cron expression matches after now.
+func expectNextRun(t *testing.T, schedule *memory.Schedule, now time.Time) {
+	t.Helper()
+
+	cronSchedule, err := cron.ParseStandard(schedule.CronExpression)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := cronSchedule.Next(now)
+	if !schedule.NextRunTime.Equal(expected) {
+		t.Errorf("expected next run time %s, got %s", expected, schedule.NextRunTime)
+	}
+}
@@ -8,21 +8,32 @@ import (
 	"log/slog"
 	"os"
 	"runtime"
+	"slices"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"text/template"
 	"time"
 
 	v1 "github.com/furisto/construct/api/go/v1"
 	"github.com/furisto/construct/backend/event"
+	"github.com/furisto/construct/backend/instruction"
 	"github.com/furisto/construct/backend/memory"
 	memory_message "github.com/furisto/construct/backend/memory/message"
+	memory_model "github.com/furisto/construct/backend/memory/model"
 	"github.com/furisto/construct/backend/memory/schema/types"
 	memory_task "github.com/furisto/construct/backend/memory/task"
+	memory_tool "github.com/furisto/construct/backend/memory/tool"
 	"github.com/furisto/construct/backend/model"
 	"github.com/furisto/construct/backend/prompt"
 	"github.com/furisto/construct/backend/tool/base"
 	"github.com/furisto/construct/backend/tool/codeact"
+	"github.com/furisto/construct/backend/tool/communication"
+	"github.com/furisto/construct/backend/tool/custom"
+	"github.com/furisto/construct/backend/tool/filesystem"
+	"github.com/furisto/construct/backend/tool/mcp"
+	"github.com/furisto/construct/shared"
+	"github.com/furisto/construct/shared/config"
 	"github.com/furisto/construct/shared/conv"
 	"github.com/google/uuid"
 	"github.com/prometheus/client_golang/prometheus"
@@ -43,6 +54,10 @@ type TaskStatus struct {
 	Phase             TaskPhase
 	NextMessage       *memory.Message
 	ProcessedMessages []*memory.Message
+	// SteeringMessages are unprocessed user messages that asked to steer the task.
+	// They are delivered to the model together with NextMessage if NextMessage is
+	// a tool result that has to be answered first.
+	SteeringMessages []*memory.Message
 }
 
 type TaskPhase string
@@ -62,12 +77,24 @@ type TaskReconciler struct {
 	queue           workqueue.TypedDelayingInterface[uuid.UUID]
 	providerFactory *ModelProviderFactory
 	concurrency     int
-	runningTasks    *SyncMap[uuid.UUID, context.CancelFunc]
+	runningTasks    *SyncMap[uuid.UUID, runningTask]
 	titleGenGroup   singleflight.Group
+	taskSettings    func(projectDirectory string) (*config.TaskSettings, error)
+	mcp             *mcp.Manager
+	delegations     atomic.Int32
+	budgetMu        sync.Mutex
+	reservedBudgets map[uuid.UUID]map[uuid.UUID]float64
 	wg              sync.WaitGroup
 	logger          *slog.Logger
 }
 
+// runningTask tracks an in-flight reconciliation so that it can be cancelled when
+// the task is suspended or steered.
+type runningTask struct {
+	cancel    context.CancelFunc
+	startTime time.Time
+}
+
 func NewTaskReconciler(
 	memory *memory.Client,
 	interpreter *codeact.Interpreter,
@@ -91,11 +118,22 @@ func NewTaskReconciler(
 		providerFactory: providerFactory,
 		queue:           queue,
 		concurrency:     concurrency,
-		runningTasks:    NewSyncMap[uuid.UUID, context.CancelFunc](),
+		runningTasks:    NewSyncMap[uuid.UUID, runningTask](),
+		reservedBudgets: make(map[uuid.UUID]map[uuid.UUID]float64),
+		taskSettings:    loadTaskSettings,
+		mcp:             mcp.NewManager(),
 		logger:          slog.With(KeyComponent, "task_reconciler"),
 	}
 }
 
+// loadTaskSettings reads the user configuration of the daemon and the project
+// configuration of the task. It is called on every reconciliation so that
+// changes to .construct/config.yaml apply to the next turn of a running task.
+func loadTaskSettings(projectDirectory string) (*config.TaskSettings, error) {
+	fs := &afero.Afero{Fs: afero.NewOsFs()}
+	return config.LoadTaskSettings(fs, shared.NewDefaultUserInfo(fs), projectDirectory)
+}
+
 func (r *TaskReconciler) Run(ctx context.Context) error {
 	LogComponentStartup(r.logger, "task reconciler",
 		KeyConcurrency, r.concurrency,
@@ -113,12 +151,26 @@ func (r *TaskReconciler) Run(ctx context.Context) error {
 	}, nil)
 
 	taskSuspendedEventSub := event.Subscribe(r.bus, func(ctx context.Context, e event.TaskSuspendedEvent) {
-		cancel, ok := r.runningTasks.Get(e.TaskID)
+		r.interpreter.States.Evict(e.TaskID, "the task was suspended")
+
+		running, ok := r.runningTasks.Get(e.TaskID)
 		if ok {
 			r.logger.DebugContext(ctx, "task suspension signal received",
 				KeyTaskID, e.TaskID,
 			)
-			cancel()
+			running.cancel()
+		}
+	}, nil)
+
+	taskInterruptedEventSub := event.Subscribe(r.bus, func(ctx context.Context, e event.TaskInterruptedEvent) {
+		running, ok := r.runningTasks.Get(e.TaskID)
+		// a reconciliation that started after the steering message was created
+		// already sees it, so only work that predates the message is interrupted
+		if ok && running.startTime.Before(e.IssuedAt) {
+			r.logger.DebugContext(ctx, "task interrupt signal received",
+				KeyTaskID, e.TaskID,
+			)
+			running.cancel()
 		}
 	}, nil)
 
@@ -126,9 +178,11 @@ func (r *TaskReconciler) Run(ctx context.Context) error {
 	<-ctx.Done()
 	r.logger.InfoContext(ctx, "task reconciler shutdown initiated")
 	shutdownStart := time.Now()
+	defer r.mcp.Close()
 
 	taskEventSub.Unsubscribe()
 	taskSuspendedEventSub.Unsubscribe()
+	taskInterruptedEventSub.Unsubscribe()
 
 	r.queue.ShutDownWithDrain()
 	r.logger.DebugContext(ctx, "task queue shutdown with drain complete")
@@ -169,7 +223,7 @@ func (r *TaskReconciler) worker(ctx context.Context) {
 				KeyTaskID, taskID,
 				"error", err,
 			)
-			r.publishError(ctx, err, taskID)
+			r.publishError(ctx, err, taskID, result.RetryAfter > 0)
 		}
 
 		switch {
@@ -190,7 +244,7 @@ func (r *TaskReconciler) worker(ctx context.Context) {
 	}
 }
 
-func (r *TaskReconciler) publishError(ctx context.Context, err error, taskID uuid.UUID) {
+func (r *TaskReconciler) publishError(ctx context.Context, err error, taskID uuid.UUID, retryable bool) {
 	if errors.Is(err, context.Canceled) {
 		return
 	}
@@ -209,6 +263,12 @@ func (r *TaskReconciler) publishError(ctx context.Context, err error, taskID uui
 			Message: msg,
 		},
 	})
+
+	event.Publish(r.bus, event.TaskErrorEvent{
+		TaskID:    taskID,
+		Error:     err.Error(),
+		Retryable: retryable,
+	})
 }
 
 // Reconcile is the main entry point for reconciling a task's conversation state
@@ -227,7 +287,7 @@ func (r *TaskReconciler) reconcile(ctx context.Context, taskID uuid.UUID) (Resul
 	logger.DebugContext(ctx, "reconciliation started")
 
 	ctx, cancel := context.WithCancel(ctx)
-	r.runningTasks.Set(taskID, cancel)
+	r.runningTasks.Set(taskID, runningTask{cancel: cancel, startTime: time.Now()})
 	defer r.runningTasks.Delete(taskID)
 	defer cancel()
 
@@ -236,6 +296,25 @@ func (r *TaskReconciler) reconcile(ctx context.Context, taskID uuid.UUID) (Resul
 		LogError(logger, "failed to fetch task with agent", err)
 		return Result{}, fmt.Errorf("failed to fetch task: %w", err)
 	}
+
+	settings, err := r.taskSettings(task.ProjectDirectory)
+	if err != nil {
+		LogError(logger, "failed to load project configuration", err)
+		return Result{}, fmt.Errorf("failed to load project configuration: %w", err)
+	}
+
+	err = r.applyModelOverride(ctx, agent, settings)
+	if err != nil {
+		LogError(logger, "failed to apply model override", err)
+		return Result{}, err
+	}
+
+	interpreter, release, err := r.taskInterpreter(ctx, task, agent)
+	if err != nil {
+		LogError(logger, "failed to load custom tools", err)
+		return Result{}, fmt.Errorf("failed to load custom tools: %w", err)
+	}
+	defer release()
 	logger.DebugContext(ctx, "task and agent fetched",
 		KeyAgentID, agent.ID,
 		KeyModel, agent.Edges.Model.Name,
@@ -284,10 +363,10 @@ func (r *TaskReconciler) reconcile(ctx context.Context, taskID uuid.UUID) (Resul
 		return Result{}, nil
 
 	case TaskPhaseInvokeModel:
-		return r.reconcileInvokeModel(ctx, taskID, task, agent, status)
+		return r.reconcileInvokeModel(ctx, taskID, task, agent, settings, interpreter, status)
 
 	case TaskPhaseExecuteTools:
-		return r.reconcileExecuteTools(ctx, taskID, task, status)
+		return r.reconcileExecuteTools(ctx, taskID, task, agent, settings, interpreter, status)
 
 	default:
 		logger.ErrorContext(ctx, "unknown phase",
@@ -316,6 +395,26 @@ func (r *TaskReconciler) fetchTaskWithAgent(ctx context.Context, taskID uuid.UUI
 	return task, task.Edges.Agent, nil
 }
 
+// applyModelOverride replaces the model of the agent if the configuration
+// assigns a different model to it.
+func (r *TaskReconciler) applyModelOverride(ctx context.Context, agent *memory.Agent, settings *config.TaskSettings) error {
+	modelName, ok := settings.ModelFor(agent.Name)
+	if !ok || modelName == agent.Edges.Model.Name {
+		return nil
+	}
+
+	override, err := r.memory.Model.Query().Where(memory_model.NameEQ(modelName)).First(ctx)
+	if err != nil {
+		if memory.IsNotFound(err) {
+			return fmt.Errorf("model %s configured for agent %s not found", modelName, agent.Name)
+		}
+		return fmt.Errorf("failed to fetch model %s: %w", modelName, err)
+	}
+
+	agent.Edges.Model = override
+	return nil
+}
+
 // computeStatus analyzes the message history and determines what action to take
 func (r *TaskReconciler) computeStatus(task *memory.Task, messages []*memory.Message) (*TaskStatus, error) {
 	if task.DesiredPhase == types.TaskPhaseSuspended {
@@ -329,6 +428,7 @@ func (r *TaskReconciler) computeStatus(task *memory.Task, messages []*memory.Mes
 	categorized := map[string][]*memory.Message{
 		"processed":            make([]*memory.Message, 0),
 		"unprocessedUser":      make([]*memory.Message, 0),
+		"unprocessedSteering":  make([]*memory.Message, 0),
 		"unprocessedAssistant": make([]*memory.Message, 0),
 		"unprocessedSystem":    make([]*memory.Message, 0),
 	}
@@ -337,6 +437,9 @@ func (r *TaskReconciler) computeStatus(task *memory.Task, messages []*memory.Mes
 		if message.ProcessedTime.IsZero() {
 			switch message.Source {
 			case types.MessageSourceUser:
+				if message.Delivery == types.MessageDeliverySteer {
+					categorized["unprocessedSteering"] = append(categorized["unprocessedSteering"], message)
+				}
 				categorized["unprocessedUser"] = append(categorized["unprocessedUser"], message)
 			case types.MessageSourceAssistant:
 				categorized["unprocessedAssistant"] = append(categorized["unprocessedAssistant"], message)
@@ -354,8 +457,13 @@ func (r *TaskReconciler) computeStatus(task *memory.Task, messages []*memory.Mes
 
 	taskStatus := &TaskStatus{
 		ProcessedMessages: categorized["processed"],
+		SteeringMessages:  categorized["unprocessedSteering"],
 	}
 
+	// Work already in flight is finished first: tool results are sent to the model
+	// and tool calls are executed (or skipped if the user is steering). Afterwards
+	// steering messages are processed before queued messages, which are processed
+	// in the order they were received.
 	switch {
 	case len(categorized["unprocessedSystem"]) > 0:
 		taskStatus.Phase = TaskPhaseInvokeModel
@@ -363,9 +471,14 @@ func (r *TaskReconciler) computeStatus(task *memory.Task, messages []*memory.Mes
 	case len(categorized["unprocessedAssistant"]) > 0:
 		taskStatus.Phase = TaskPhaseExecuteTools
 		taskStatus.NextMessage = categorized["unprocessedAssistant"][0]
+	case len(categorized["unprocessedSteering"]) > 0:
+		taskStatus.Phase = TaskPhaseInvokeModel
+		taskStatus.NextMessage = categorized["unprocessedSteering"][0]
+		taskStatus.SteeringMessages = nil
 	case len(categorized["unprocessedUser"]) > 0:
 		taskStatus.Phase = TaskPhaseInvokeModel
 		taskStatus.NextMessage = categorized["unprocessedUser"][0]
+		taskStatus.SteeringMessages = nil
 	}
 
 	return taskStatus, nil
@@ -375,7 +488,7 @@ func hasUnprocessedMessages(categorized map[string][]*memory.Message) bool {
 	return len(categorized["unprocessedUser"]) > 0 || len(categorized["unprocessedAssistant"]) > 0 || len(categorized["unprocessedSystem"]) > 0
 }
 
-func (r *TaskReconciler) reconcileInvokeModel(ctx context.Context, taskID uuid.UUID, task *memory.Task, agent *memory.Agent, status *TaskStatus) (Result, error) {
+func (r *TaskReconciler) reconcileInvokeModel(ctx context.Context, taskID uuid.UUID, task *memory.Task, agent *memory.Agent, settings *config.TaskSettings, interpreter *codeact.Interpreter, status *TaskStatus) (Result, error) {
 	logger := r.logger.With(
 		KeyTaskID, taskID,
 		KeyMessageID, status.NextMessage.ID,
@@ -387,8 +500,12 @@ func (r *TaskReconciler) reconcileInvokeModel(ctx context.Context, taskID uuid.U
 	reconcileStart := time.Now()
 	logger.InfoContext(ctx, "model invocation phase started")
 
-	if status.NextMessage.Source == types.MessageSourceUser {
-		msg, err := ConvertMemoryMessageToProto(status.NextMessage)
+	for _, message := range append([]*memory.Message{status.NextMessage}, status.SteeringMessages...) {
+		if message.Source != types.MessageSourceUser {
+			continue
+		}
+
+		msg, err := ConvertMemoryMessageToProto(message)
 		if err != nil {
 			LogError(logger, "failed to convert user message", err)
 			return Result{}, err
@@ -397,7 +514,7 @@ func (r *TaskReconciler) reconcileInvokeModel(ctx context.Context, taskID uuid.U
 		logger.DebugContext(ctx, "user message published")
 	}
 
-	modelMessages, err := r.buildMessageHistory(status.ProcessedMessages, status.NextMessage)
+	modelMessages, err := r.buildMessageHistory(status.ProcessedMessages, status.NextMessage, status.SteeringMessages...)
 	if err != nil {
 		LogError(logger, "failed to build message history", err)
 		return Result{}, fmt.Errorf("failed to prepare model messages: %w", err)
@@ -412,11 +529,20 @@ func (r *TaskReconciler) reconcileInvokeModel(ctx context.Context, taskID uuid.U
 		return Result{}, fmt.Errorf("failed to create model provider: %w", err)
 	}
 
-	systemPrompt, err := r.assembleSystemPrompt(ctx, agent.Instructions, task.ProjectDirectory)
+	instructionFiles, err := instruction.ForProject(afero.NewOsFs(), task.ProjectDirectory)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to load instruction files", "error", err)
+	}
+	r.recordInstructionFiles(ctx, task, instruction.Paths(instructionFiles))
+
+	systemPrompt, err := r.assembleSystemPrompt(ctx, interpreter, taskPolicy(settings, agent, task), agent.Instructions, task.ProjectDirectory, instructionFiles, settings.Instructions)
 	if err != nil {
 		LogError(logger, "failed to assemble system prompt", err)
 		return Result{}, fmt.Errorf("failed to assemble system prompt: %w", err)
 	}
+	if task.ParentID != uuid.Nil {
+		systemPrompt += delegatedTaskInstruction
+	}
 
 	LogOperationStart(logger, "invoke model")
 	invokeStart := time.Now()
@@ -425,7 +551,7 @@ func (r *TaskReconciler) reconcileInvokeModel(ctx context.Context, taskID uuid.U
 		agent.Edges.Model.Name,
 		systemPrompt,
 		modelMessages,
-		model.WithTools(r.interpreter),
+		model.WithTools(interpreter),
 		model.WithStreamHandler(func(ctx context.Context, chunk string) {
 			r.publishMessage(taskID, NewAssistantMessage(taskID,
 				WithContent(&v1.MessagePart{
@@ -444,8 +570,11 @@ func (r *TaskReconciler) reconcileInvokeModel(ctx context.Context, taskID uuid.U
 	if err != nil {
 		if errors.Is(err, context.Canceled) {
 			logger.InfoContext(ctx, "model invocation cancelled by user")
-			_, err = memory.Transaction(ctx, r.memory, func(tx *memory.Client) (*memory.Message, error) {
-				err := r.markMessageAsProcessed(ctx, status.NextMessage)
+			// the reconcile context is cancelled at this point, but the cancellation
+			// itself still has to be recorded
+			persistCtx := context.WithoutCancel(ctx)
+			_, err = memory.Transaction(persistCtx, r.memory, func(tx *memory.Client) (*memory.Message, error) {
+				err := r.markMessageAsProcessed(persistCtx, status.NextMessage)
 				if err != nil {
 					return nil, err
 				}
@@ -481,9 +610,11 @@ func (r *TaskReconciler) reconcileInvokeModel(ctx context.Context, taskID uuid.U
 	)
 
 	modelMessage, err := memory.Transaction(ctx, r.memory, func(tx *memory.Client) (*memory.Message, error) {
-		err = r.markMessageAsProcessed(ctx, status.NextMessage)
-		if err != nil {
-			return nil, fmt.Errorf("failed to mark message as processed: %w", err)
+		for _, message := range append([]*memory.Message{status.NextMessage}, status.SteeringMessages...) {
+			err = r.markMessageAsProcessed(ctx, message)
+			if err != nil {
+				return nil, fmt.Errorf("failed to mark message as processed: %w", err)
+			}
 		}
 
 		modelMessage, err := r.persistModelResponse(ctx, taskID, message, cost)
@@ -506,14 +637,49 @@ func (r *TaskReconciler) reconcileInvokeModel(ctx context.Context, taskID uuid.U
 	protoMessage.Status.IsFinalResponse = !hasToolCalls(message.Content)
 	protoMessage.Status.ContentState = v1.ContentStatus_CONTENT_STATUS_COMPLETE
 	r.publishMessage(taskID, protoMessage)
+	event.Publish(r.bus, event.MessageCompletedEvent{
+		TaskID:    taskID,
+		MessageID: modelMessage.ID,
+		Source:    modelMessage.Source,
+	})
+
+	if cost > 0 {
+		event.Publish(r.bus, event.TaskCostEvent{
+			TaskID:       taskID,
+			PreviousCost: task.Cost,
+			Cost:         task.Cost + cost,
+		})
+	}
+
+	if !hasToolCalls(message.Content) {
+		event.Publish(r.bus, event.TaskResponseEvent{
+			TaskID:    taskID,
+			MessageID: modelMessage.ID,
+			Content:   textContent(message.Content),
+		})
+	}
+
+	if task.MaxCost > 0 && task.Cost+cost >= task.MaxCost {
+		logger.InfoContext(ctx, "task exceeded its budget, suspending",
+			KeyCost, task.Cost+cost,
+		)
+		_, err = r.memory.Task.UpdateOneID(taskID).SetDesiredPhase(types.TaskPhaseSuspended).Save(ctx)
+		if err != nil {
+			LogError(logger, "failed to suspend task over budget", err)
+			return Result{}, fmt.Errorf("failed to suspend task over budget: %w", err)
+		}
+		r.publishError(ctx, fmt.Errorf("task suspended because it reached its budget of $%.2f", task.MaxCost), taskID, false)
+		LogOperationEnd(logger, "reconciliation (invoke_model, over budget)", reconcileStart)
+		return Result{}, nil
+	}
 
 	LogOperationEnd(logger, "reconciliation (invoke_model)", reconcileStart)
 
 	return Result{Retry: true}, nil
 }
 
-func (r *TaskReconciler) buildMessageHistory(processedMessages []*memory.Message, nextMessage *memory.Message) ([]*model.Message, error) {
-	modelMessages := make([]*model.Message, 0, len(processedMessages)+1)
+func (r *TaskReconciler) buildMessageHistory(processedMessages []*memory.Message, nextMessage *memory.Message, steeringMessages ...*memory.Message) ([]*model.Message, error) {
+	modelMessages := make([]*model.Message, 0, len(processedMessages)+1+len(steeringMessages))
 
 	for _, msg := range processedMessages {
 		modelMsg, err := ConvertMemoryMessageToModel(msg)
@@ -523,23 +689,34 @@ func (r *TaskReconciler) buildMessageHistory(processedMessages []*memory.Message
 		modelMessages = append(modelMessages, modelMsg)
 	}
 
-	modelMsg, err := ConvertMemoryMessageToModel(nextMessage)
-	if err != nil {
-		return nil, err
+	for _, msg := range append([]*memory.Message{nextMessage}, steeringMessages...) {
+		modelMsg, err := ConvertMemoryMessageToModel(msg)
+		if err != nil {
+			return nil, err
+		}
+		modelMessages = append(modelMessages, modelMsg)
 	}
-	modelMessages = append(modelMessages, modelMsg)
 
 	return modelMessages, nil
 }
 
-func (r *TaskReconciler) assembleSystemPrompt(ctx context.Context, agentInstruction string, cwd string) (string, error) {
+func (r *TaskReconciler) assembleSystemPrompt(ctx context.Context, interpreter *codeact.Interpreter, policy *codeact.Policy, agentInstruction string, cwd string, instructionFiles []instruction.File, projectInstruction string) (string, error) {
+	// tools the policy disables are not documented so that the model does
+	// not plan with them
+	var tools []codeact.Tool
+	for _, tool := range interpreter.Tools {
+		if policy.ToolEnabled(tool) {
+			tools = append(tools, tool)
+		}
+	}
+
 	var toolInstruction string
-	if len(r.interpreter.Tools) != 0 {
+	if len(tools) != 0 {
 		toolInstruction = prompt.ToolInstructions()
 	}
 
 	var builder strings.Builder
-	for _, tool := range r.interpreter.Tools {
+	for _, tool := range tools {
 		fmt.Fprintf(&builder, "# %s\n%s\n\n", tool.Name(), tool.Description())
 	}
 
@@ -556,22 +733,24 @@ func (r *TaskReconciler) assembleSystemPrompt(ctx context.Context, agentInstruct
 	devTools := AvailableDevTools()
 
 	tmplParams := struct {
-		CurrentTime      string
-		WorkingDirectory string
-		OperatingSystem  string
-		DefaultShell     string
-		ProjectStructure string
-		ToolInstructions string
-		Tools            string
-		DevTools         *DevTools
+		CurrentTime         string
+		WorkingDirectory    string
+		OperatingSystem     string
+		DefaultShell        string
+		ProjectStructure    string
+		ProjectInstructions string
+		ToolInstructions    string
+		Tools               string
+		DevTools            *DevTools
 	}{
-		WorkingDirectory: cwd,
-		OperatingSystem:  runtime.GOOS,
-		DefaultShell:     shell.Name,
-		ProjectStructure: projectStructure,
-		ToolInstructions: toolInstruction,
-		Tools:            builder.String(),
-		DevTools:         devTools,
+		WorkingDirectory:    cwd,
+		OperatingSystem:     runtime.GOOS,
+		DefaultShell:        shell.Name,
+		ProjectStructure:    projectStructure,
+		ProjectInstructions: instruction.Format(instructionFiles),
+		ToolInstructions:    toolInstruction,
+		Tools:               builder.String(),
+		DevTools:            devTools,
 	}
 
 	tmpl, err := template.New("system_prompt").Parse(agentInstruction)
@@ -585,6 +764,16 @@ func (r *TaskReconciler) assembleSystemPrompt(ctx context.Context, agentInstruct
 		return "", err
 	}
 
+	// agents with custom instructions may not reference the repository
+	// instructions, they are too important to be dropped silently
+	if tmplParams.ProjectInstructions != "" && !strings.Contains(agentInstruction, ".ProjectInstructions") {
+		fmt.Fprintf(&builder, "\n\n# Repository Instructions\n%s\n", tmplParams.ProjectInstructions)
+	}
+
+	if projectInstruction != "" {
+		fmt.Fprintf(&builder, "\n\n# Project Instructions\n%s\n", strings.TrimSpace(projectInstruction))
+	}
+
 	return builder.String(), nil
 }
 
@@ -635,7 +824,7 @@ func (r *TaskReconciler) persistModelResponse(ctx context.Context, taskID uuid.U
 	return message, err
 }
 
-func (r *TaskReconciler) reconcileExecuteTools(ctx context.Context, taskID uuid.UUID, task *memory.Task, status *TaskStatus) (Result, error) {
+func (r *TaskReconciler) reconcileExecuteTools(ctx context.Context, taskID uuid.UUID, task *memory.Task, agent *memory.Agent, settings *config.TaskSettings, interpreter *codeact.Interpreter, status *TaskStatus) (Result, error) {
 	logger := r.logger.With(
 		KeyTaskID, taskID,
 		KeyMessageID, status.NextMessage.ID,
@@ -645,19 +834,32 @@ func (r *TaskReconciler) reconcileExecuteTools(ctx context.Context, taskID uuid.
 	toolStart := time.Now()
 	logger.DebugContext(ctx, "tool execution phase started")
 
-	toolResults, toolStats, err := r.callTools(ctx, task, status.NextMessage)
+	var (
+		toolResults []base.ToolResult
+		toolStats   map[string]int64
+		err         error
+	)
+	if len(status.SteeringMessages) > 0 {
+		logger.InfoContext(ctx, "skipping tool execution, task is being steered by the user")
+		toolResults, err = skipTools(status.NextMessage)
+	} else {
+		toolResults, toolStats, err = r.callTools(ctx, interpreter, task, agent, taskPolicy(settings, agent, task), status.NextMessage)
+	}
 	if err != nil {
 		LogError(logger, "failed to call tools", err)
 	}
 
-	_, err = memory.Transaction(ctx, r.memory, func(tx *memory.Client) (*memory.Message, error) {
+	// results of interrupted tool executions still have to be recorded, otherwise
+	// the model would see tool calls without results
+	ctx = context.WithoutCancel(ctx)
+	toolMessage, err := memory.Transaction(ctx, r.memory, func(tx *memory.Client) (*memory.Message, error) {
 		err = r.markMessageAsProcessed(ctx, status.NextMessage)
 		if err != nil {
 			return nil, fmt.Errorf("failed to mark message as processed: %w", err)
 		}
 
 		if len(toolResults) > 0 {
-			_, persistErr := r.persistToolResults(ctx, taskID, toolResults, tx)
+			toolMessage, persistErr := r.persistToolResults(ctx, taskID, toolResults, tx)
 			if persistErr != nil {
 				LogError(logger, "failed to persist tool results", persistErr)
 				return nil, fmt.Errorf("failed to update message with results: %w", persistErr)
@@ -667,6 +869,10 @@ func (r *TaskReconciler) reconcileExecuteTools(ctx context.Context, taskID uuid.
 				"result_count", len(toolResults),
 			)
 
+			if len(toolStats) == 0 {
+				return toolMessage, nil
+			}
+
 			// Update task tool usage statistics
 			for tool, count := range toolStats {
 				task.ToolUses[tool] += count
@@ -681,12 +887,20 @@ func (r *TaskReconciler) reconcileExecuteTools(ctx context.Context, taskID uuid.
 				KeyToolStats, toolStats,
 			)
 
-			return nil, nil
+			return toolMessage, nil
 		}
 
 		return nil, nil
 	})
 
+	if err == nil && toolMessage != nil {
+		event.Publish(r.bus, event.MessageCompletedEvent{
+			TaskID:    taskID,
+			MessageID: toolMessage.ID,
+			Source:    toolMessage.Source,
+		})
+	}
+
 	logger.InfoContext(ctx, "tool execution completed",
 		"result_count", len(toolResults),
 	)
@@ -695,7 +909,7 @@ func (r *TaskReconciler) reconcileExecuteTools(ctx context.Context, taskID uuid.
 	return Result{Retry: true}, nil
 }
 
-func (r *TaskReconciler) callTools(ctx context.Context, task *memory.Task, message *memory.Message) ([]base.ToolResult, map[string]int64, error) {
+func (r *TaskReconciler) callTools(ctx context.Context, interpreter *codeact.Interpreter, task *memory.Task, agent *memory.Agent, policy *codeact.Policy, message *memory.Message) ([]base.ToolResult, map[string]int64, error) {
 	logger := r.logger.With(
 		KeyTaskID, task.ID,
 		KeyMessageID, message.ID,
@@ -705,6 +919,19 @@ func (r *TaskReconciler) callTools(ctx context.Context, task *memory.Task, messa
 	var toolResults []base.ToolResult
 	toolStats := make(map[string]int64)
 
+	var (
+		fsys    afero.Fs = afero.NewOsFs()
+		preview *filesystem.Overlay
+	)
+	if task.Preview != nil {
+		var err error
+		preview, err = filesystem.PreviewOverlay(fsys, task.Preview)
+		if err != nil {
+			return nil, nil, err
+		}
+		fsys = preview.Fs()
+	}
+
 	for _, block := range message.Content.Blocks {
 		switch block.Kind {
 		case types.MessageBlockKindCodeInterpreterCall:
@@ -717,9 +944,14 @@ func (r *TaskReconciler) callTools(ctx context.Context, task *memory.Task, messa
 			logInterpreterArgs(ctx, task.ID, toolCall.ID, toolCall.Args)
 
 			toolStart := time.Now()
-			result, err := r.interpreter.Interpret(ctx, afero.NewOsFs(), toolCall.Args, &codeact.Task{
+			result, err := interpreter.Interpret(ctx, fsys, toolCall.Args, &codeact.Task{
 				ID:               task.ID,
 				ProjectDirectory: task.ProjectDirectory,
+				Policy:           policy,
+				InstructionFiles: task.InstructionFiles,
+				Delegator:        r.taskDelegator(task),
+				PersistentState:  task.PersistentState,
+				Limits:           scriptLimits(agent),
 			})
 			toolDuration := time.Since(toolStart)
 
@@ -744,6 +976,8 @@ func (r *TaskReconciler) callTools(ctx context.Context, task *memory.Task, messa
 			}
 			toolResults = append(toolResults, interpreterResult)
 
+			r.recordInstructionFiles(ctx, task, result.InstructionFiles)
+
 			for tool, count := range result.ToolStats {
 				toolStats[tool] += count
 				logger.DebugContext(ctx, "tool invoked",
@@ -755,6 +989,12 @@ func (r *TaskReconciler) callTools(ctx context.Context, task *memory.Task, messa
 		}
 	}
 
+	if preview != nil {
+		if err := r.savePreview(ctx, task, preview); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	logger.DebugContext(ctx, "all tools executed",
 		"result_count", len(toolResults),
 		KeyToolStats, toolStats,
@@ -763,6 +1003,216 @@ func (r *TaskReconciler) callTools(ctx context.Context, task *memory.Task, messa
 	return toolResults, toolStats, nil
 }
 
+// taskInterpreter returns the interpreter extended by the enabled custom
+// tools and the tools of the agent's MCP servers. Tools are loaded on every
+// reconciliation so that changes apply to running tasks without restarting
+// the daemon. Top-level tasks can delegate work, delegated tasks report their
+// results with submit_report instead. Tasks with persistent state can reset it.
+// The returned function releases the MCP sessions once the reconciliation is
+// done with the interpreter.
+func (r *TaskReconciler) taskInterpreter(ctx context.Context, task *memory.Task, agent *memory.Agent) (*codeact.Interpreter, func(), error) {
+	tools, err := r.memory.Tool.Query().
+		Where(memory_tool.Enabled(true)).
+		Order(memory_tool.ByName()).
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	customTools := make([]codeact.Tool, 0, len(tools))
+	for _, t := range tools {
+		customTools = append(customTools, codeact.NewCustomTool(&custom.Definition{
+			Name:        t.Name,
+			Description: t.Description,
+			InputSchema: t.InputSchema,
+			Kind:        custom.Kind(t.Kind),
+			Command:     t.Command,
+			URL:         t.URL,
+			Headers:     t.Headers,
+			Source:      t.Source,
+			Timeout:     time.Duration(t.TimeoutSeconds) * time.Second,
+		}))
+	}
+
+	delegationTools := []codeact.Tool{codeact.NewDelegateTool(), codeact.NewRunParallelTool()}
+	if task.ParentID != uuid.Nil {
+		delegationTools = []codeact.Tool{codeact.NewSubmitReportTool()}
+	}
+	if task.PersistentState {
+		delegationTools = append(delegationTools, codeact.NewResetStateTool())
+	}
+
+	mcpTools, release := r.mcpTools(ctx, agent)
+	return r.interpreter.WithTools(append(append(customTools, delegationTools...), mcpTools...)...), release, nil
+}
+
+// taskDelegator returns the delegator of the task. Delegated tasks may not
+// delegate further.
+func (r *TaskReconciler) taskDelegator(task *memory.Task) communication.Delegator {
+	if task.ParentID != uuid.Nil {
+		return nil
+	}
+	return r
+}
+
+// mcpTools starts or reuses the MCP servers of the agent and returns their
+// tools. Servers that cannot be started are skipped so that the task can
+// continue without them; they are retried on the next reconciliation. The
+// sessions are kept open until the returned function is called.
+func (r *TaskReconciler) mcpTools(ctx context.Context, agent *memory.Agent) ([]codeact.Tool, func()) {
+	var (
+		sessions []*mcp.Session
+		tools    []codeact.Tool
+		releases []func()
+	)
+	release := func() {
+		for _, release := range releases {
+			release()
+		}
+	}
+	for _, server := range agent.McpServers {
+		logger := r.logger.With(KeyAgentID, agent.ID, "mcp_server", server.Name)
+
+		session, releaseSession, err := r.mcp.Session(ctx, mcp.Server{
+			Name:      server.Name,
+			Transport: mcp.Transport(server.Transport),
+			Command:   server.Command,
+			Args:      server.Args,
+			Env:       server.Env,
+			URL:       server.URL,
+			Headers:   server.Headers,
+		})
+		if err != nil {
+			LogError(logger, "failed to start MCP server", err)
+			continue
+		}
+		releases = append(releases, releaseSession)
+
+		serverTools, err := session.Tools(ctx)
+		if err != nil {
+			LogError(logger, "failed to discover MCP tools", err)
+			continue
+		}
+
+		sessions = append(sessions, session)
+		for _, tool := range serverTools {
+			tools = append(tools, codeact.NewMCPTool(session, tool))
+		}
+	}
+
+	return append(codeact.NewMCPResourceTools(sessions), tools...), release
+}
+
+// recordInstructionFiles adds the paths to the instruction files of the task so
+// that users can see which files influenced the agent.
+func (r *TaskReconciler) recordInstructionFiles(ctx context.Context, task *memory.Task, paths []string) {
+	recorded := make(map[string]bool, len(task.InstructionFiles))
+	for _, path := range task.InstructionFiles {
+		recorded[path] = true
+	}
+
+	files := task.InstructionFiles
+	for _, path := range paths {
+		if !recorded[path] {
+			recorded[path] = true
+			files = append(files, path)
+		}
+	}
+
+	if len(files) == len(task.InstructionFiles) {
+		return
+	}
+
+	_, err := r.memory.Task.UpdateOneID(task.ID).SetInstructionFiles(files).Save(ctx)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to record instruction files", KeyTaskID, task.ID, "error", err)
+		return
+	}
+	task.InstructionFiles = files
+	r.publishTaskEvent(task.ID)
+}
+
+// taskPolicy combines the project configuration with the tool set of the
+// agent. It returns nil if neither restricts the tools nor permits destructive
+// git operations.
+func taskPolicy(settings *config.TaskSettings, agent *memory.Agent, task *memory.Task) *codeact.Policy {
+	policy := &codeact.Policy{
+		ReadOnly:            settings.PermissionPolicy == config.PermissionPolicyReadOnly,
+		Preview:             task.Preview != nil,
+		AllowedCommands:     settings.AllowedCommands,
+		Ignore:              settings.Ignore,
+		AllowDestructiveGit: settings.AllowDestructiveGit,
+	}
+	if agent.ToolSet != nil {
+		policy.AgentReadOnly = agent.ToolSet.ReadOnly
+		policy.AllowedTools = agent.ToolSet.AllowedTools
+	}
+
+	if !policy.ReadOnly && !policy.AgentReadOnly && !policy.Preview && len(policy.AllowedTools) == 0 && policy.AllowedCommands == nil && len(policy.Ignore) == 0 && !policy.AllowDestructiveGit {
+		return nil
+	}
+	return policy
+}
+
+// savePreview persists the files staged by the scripts of a task in preview
+// mode. The task is only updated if the staged files changed.
+func (r *TaskReconciler) savePreview(ctx context.Context, task *memory.Task, overlay *filesystem.Overlay) error {
+	files, err := filesystem.PreviewFiles(overlay, task.Preview)
+	if err != nil {
+		return err
+	}
+
+	if slices.Equal(files, task.Preview.Files) {
+		return nil
+	}
+
+	updated, err := r.memory.Task.UpdateOneID(task.ID).SetPreview(&types.TaskPreview{Files: files}).Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to save the preview: %w", err)
+	}
+	task.Preview = updated.Preview
+	return nil
+}
+
+// scriptLimits returns the script limits of the agent. Nil uses the defaults
+// of the interpreter.
+func scriptLimits(agent *memory.Agent) *codeact.Limits {
+	if agent.ScriptLimits == nil {
+		return nil
+	}
+
+	return &codeact.Limits{
+		Timeout:            time.Duration(agent.ScriptLimits.TimeoutSeconds) * time.Second,
+		MaxToolCalls:       agent.ScriptLimits.MaxToolCalls,
+		MaxOutputBytes:     agent.ScriptLimits.MaxOutputBytes,
+		MaxToolResultBytes: agent.ScriptLimits.MaxToolResultBytes,
+	}
+}
+
+// skipTools answers every tool call of the message without executing it. It is used
+// when the user steers the task after the model requested the tool calls.
+func skipTools(message *memory.Message) ([]base.ToolResult, error) {
+	var toolResults []base.ToolResult
+	for _, block := range message.Content.Blocks {
+		if block.Kind != types.MessageBlockKindCodeInterpreterCall {
+			continue
+		}
+
+		var toolCall model.ToolCallBlock
+		err := json.Unmarshal([]byte(block.Payload), &toolCall)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal tool call: %w", err)
+		}
+
+		toolResults = append(toolResults, &codeact.InterpreterToolResult{
+			ID:    toolCall.ID,
+			Error: "tool execution was skipped because the user sent new instructions",
+		})
+	}
+
+	return toolResults, nil
+}
+
 func (r *TaskReconciler) persistToolResults(ctx context.Context, taskID uuid.UUID, toolResults []base.ToolResult, tx *memory.Client) (*memory.Message, error) {
 	toolBlocks := make([]types.MessageBlock, 0, len(toolResults))
 	for _, result := range toolResults {
@@ -879,6 +1329,17 @@ func hasToolCalls(content []model.ContentBlock) bool {
 	return false
 }
 
+func textContent(content []model.ContentBlock) string {
+	var parts []string
+	for _, block := range content {
+		if text, ok := block.(*model.TextBlock); ok {
+			parts = append(parts, text.Text)
+		}
+	}
+
+	return strings.Join(parts, "\n")
+}
+
 func (r *TaskReconciler) publishMessage(taskID uuid.UUID, message *v1.Message) {
 	r.eventHub.Publish(taskID, &v1.SubscribeResponse{
 		Event: &v1.SubscribeResponse_Message{
@@ -902,8 +1363,15 @@ func (r *TaskReconciler) publishTaskEvent(taskID uuid.UUID) {
 
 func (r *TaskReconciler) setTaskPhaseAndPublish(ctx context.Context, taskID uuid.UUID, phase TaskPhase) {
 	p := convertTaskPhaseToMemory(phase)
+	var previous types.TaskPhase
 	_, err := memory.Transaction(ctx, r.memory, func(tx *memory.Client) (*memory.Task, error) {
-		return tx.Task.UpdateOneID(taskID).SetPhase(p).Save(ctx)
+		task, err := tx.Task.Get(ctx, taskID)
+		if err != nil {
+			return nil, err
+		}
+		previous = task.Phase
+
+		return tx.Task.UpdateOne(task).SetPhase(p).Save(ctx)
 	})
 
 	if err != nil {
@@ -911,6 +1379,12 @@ func (r *TaskReconciler) setTaskPhaseAndPublish(ctx context.Context, taskID uuid
 			"error", err,
 			KeyPhase, string(phase),
 		)
+	} else if previous != p {
+		event.Publish(r.bus, event.TaskPhaseChangedEvent{
+			TaskID:        taskID,
+			Phase:         p,
+			PreviousPhase: previous,
+		})
 	}
 
 	r.logger.DebugContext(ctx, "task phase updated",
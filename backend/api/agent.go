@@ -3,33 +3,47 @@ package api
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"slices"
 
 	"connectrpc.com/connect"
 	v1 "github.com/furisto/construct/api/go/v1"
 	"github.com/furisto/construct/api/go/v1/v1connect"
 	"github.com/furisto/construct/backend/analytics"
 	"github.com/furisto/construct/backend/api/conv"
+	"github.com/furisto/construct/backend/event"
 	"github.com/furisto/construct/backend/memory"
 	"github.com/furisto/construct/backend/memory/agent"
+	"github.com/furisto/construct/backend/tool/codeact"
 	"github.com/google/uuid"
 )
 
 var _ v1connect.AgentServiceHandler = (*AgentHandler)(nil)
 
-func NewAgentHandler(db *memory.Client, analytics analytics.Client) *AgentHandler {
+func NewAgentHandler(db *memory.Client, eventBus *event.Bus, analytics analytics.Client) *AgentHandler {
 	return &AgentHandler{
 		db:        db,
+		eventBus:  eventBus,
 		analytics: analytics,
 	}
 }
 
 type AgentHandler struct {
 	db        *memory.Client
+	eventBus  *event.Bus
 	analytics analytics.Client
 	v1connect.UnimplementedAgentServiceHandler
 }
 
 func (h *AgentHandler) CreateAgent(ctx context.Context, req *connect.Request[v1.CreateAgentRequest]) (*connect.Response[v1.CreateAgentResponse], error) {
+	if err := requireWrite(ctx, "create agents"); err != nil {
+		return nil, apiError(err)
+	}
+
+	if err := validateMCPServers(req.Msg.McpServers); err != nil {
+		return nil, apiError(err)
+	}
+
 	modelID, err := uuid.Parse(req.Msg.ModelId)
 	if err != nil {
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid model ID format: %w", err)))
@@ -43,7 +57,8 @@ func (h *AgentHandler) CreateAgent(ctx context.Context, req *connect.Request[v1.
 	am, err := memory.Transaction(ctx, h.db, func(tx *memory.Client) (*agentModel, error) {
 		create := tx.Agent.Create().
 			SetName(req.Msg.Name).
-			SetInstructions(req.Msg.Instructions)
+			SetInstructions(req.Msg.Instructions).
+			SetNillableOwnerID(ownerOf(ctx))
 
 		model, err := tx.Model.Get(ctx, modelID)
 		if err != nil {
@@ -59,6 +74,18 @@ func (h *AgentHandler) CreateAgent(ctx context.Context, req *connect.Request[v1.
 			create = create.SetDescription(req.Msg.Description)
 		}
 
+		if len(req.Msg.McpServers) > 0 {
+			create = create.SetMcpServers(conv.ConvertMCPServersFromProto(req.Msg.McpServers))
+		}
+
+		if toolSet := conv.ConvertToolSetFromProto(req.Msg.ToolSet); toolSet != nil {
+			create = create.SetToolSet(toolSet)
+		}
+
+		if limits := conv.ConvertScriptLimitsFromProto(req.Msg.ScriptLimits); limits != nil {
+			create = create.SetScriptLimits(limits)
+		}
+
 		agent, err := create.Save(ctx)
 		if err != nil {
 			return nil, err
@@ -80,6 +107,11 @@ func (h *AgentHandler) CreateAgent(ctx context.Context, req *connect.Request[v1.
 	}
 
 	analytics.EmitAgentCreated(h.analytics, am.agent.ID.String(), am.agent.Name, am.model.Name)
+	event.Publish(h.eventBus, event.ResourceChangedEvent{
+		Kind:      event.ResourceKindAgent,
+		Operation: event.ResourceOperationCreated,
+		ID:        am.agent.ID,
+	})
 
 	return connect.NewResponse(&v1.CreateAgentResponse{
 		Agent: protoAgent,
@@ -142,6 +174,15 @@ func (h *AgentHandler) UpdateAgent(ctx context.Context, req *connect.Request[v1.
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid agent ID format: %w", err)))
 	}
 
+	existing, err := h.db.Agent.Get(ctx, id)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	if err := authorizeAgentWrite(ctx, existing); err != nil {
+		return nil, apiError(err)
+	}
+
 	update := h.db.Agent.UpdateOneID(id)
 
 	var updatedFields []string
@@ -173,6 +214,32 @@ func (h *AgentHandler) UpdateAgent(ctx context.Context, req *connect.Request[v1.
 		updatedFields = append(updatedFields, "model_id")
 	}
 
+	if req.Msg.McpServers != nil {
+		if err := validateMCPServers(req.Msg.McpServers.Servers); err != nil {
+			return nil, apiError(err)
+		}
+		update = update.SetMcpServers(conv.ConvertMCPServersFromProto(req.Msg.McpServers.Servers))
+		updatedFields = append(updatedFields, "mcp_servers")
+	}
+
+	if req.Msg.ToolSet != nil {
+		if toolSet := conv.ConvertToolSetFromProto(req.Msg.ToolSet); toolSet != nil {
+			update = update.SetToolSet(toolSet)
+		} else {
+			update = update.ClearToolSet()
+		}
+		updatedFields = append(updatedFields, "tool_set")
+	}
+
+	if req.Msg.ScriptLimits != nil {
+		if limits := conv.ConvertScriptLimitsFromProto(req.Msg.ScriptLimits); limits != nil {
+			update = update.SetScriptLimits(limits)
+		} else {
+			update = update.ClearScriptLimits()
+		}
+		updatedFields = append(updatedFields, "script_limits")
+	}
+
 	updatedAgent, err := update.Save(ctx)
 	if err != nil {
 		return nil, apiError(err)
@@ -192,6 +259,11 @@ func (h *AgentHandler) UpdateAgent(ctx context.Context, req *connect.Request[v1.
 	}
 
 	analytics.EmitAgentUpdated(h.analytics, updatedAgent.ID.String(), updatedAgent.Name, updatedFields)
+	event.Publish(h.eventBus, event.ResourceChangedEvent{
+		Kind:      event.ResourceKindAgent,
+		Operation: event.ResourceOperationUpdated,
+		ID:        updatedAgent.ID,
+	})
 
 	return connect.NewResponse(&v1.UpdateAgentResponse{
 		Agent: protoAgent,
@@ -209,11 +281,46 @@ func (h *AgentHandler) DeleteAgent(ctx context.Context, req *connect.Request[v1.
 		return nil, apiError(err)
 	}
 
+	if err := authorizeAgentWrite(ctx, agent); err != nil {
+		return nil, apiError(err)
+	}
+
 	if err := h.db.Agent.DeleteOne(agent).Exec(ctx); err != nil {
 		return nil, apiError(fmt.Errorf("failed to delete agent: %w", err))
 	}
 
 	analytics.EmitAgentDeleted(h.analytics, agent.ID.String(), agent.Name)
+	event.Publish(h.eventBus, event.ResourceChangedEvent{
+		Kind:      event.ResourceKindAgent,
+		Operation: event.ResourceOperationDeleted,
+		ID:        agent.ID,
+	})
 
 	return connect.NewResponse(&v1.DeleteAgentResponse{}), nil
 }
+
+// validateMCPServers checks what the proto constraints cannot express: names
+// are unique and do not shadow the resource functions of the mcp namespace,
+// and HTTP servers use an http or https URL.
+func validateMCPServers(servers []*v1.McpServer) error {
+	names := make(map[string]bool, len(servers))
+	for _, server := range servers {
+		if names[server.Name] {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("duplicate MCP server name %s", server.Name))
+		}
+		names[server.Name] = true
+
+		if slices.Contains(codeact.MCPReservedServerNames, server.Name) {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("MCP server name %s is reserved", server.Name))
+		}
+
+		if http := server.GetHttp(); http != nil {
+			u, err := url.Parse(http.Url)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid URL %q of MCP server %s: must be an absolute http or https URL", http.Url, server.Name))
+			}
+		}
+	}
+
+	return nil
+}
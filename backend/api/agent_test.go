@@ -77,6 +77,35 @@ func TestCreateAgent(t *testing.T) {
 				Error: "invalid_argument: default model is disabled",
 			},
 		},
+		{
+			Name: "duplicate MCP server name",
+			Request: &v1.CreateAgentRequest{
+				Name:         "architect-agent",
+				Instructions: "Instructions for architect agent",
+				ModelId:      modelID.String(),
+				McpServers: []*v1.McpServer{
+					{Name: "github", Transport: &v1.McpServer_Stdio{Stdio: &v1.McpServer_StdioTransport{Command: "github-mcp"}}},
+					{Name: "github", Transport: &v1.McpServer_Http{Http: &v1.McpServer_HttpTransport{Url: "https://example.com/mcp"}}},
+				},
+			},
+			Expected: ServiceTestExpectation[v1.CreateAgentResponse]{
+				Error: "invalid_argument: duplicate MCP server name github",
+			},
+		},
+		{
+			Name: "reserved MCP server name",
+			Request: &v1.CreateAgentRequest{
+				Name:         "architect-agent",
+				Instructions: "Instructions for architect agent",
+				ModelId:      modelID.String(),
+				McpServers: []*v1.McpServer{
+					{Name: "read_resource", Transport: &v1.McpServer_Stdio{Stdio: &v1.McpServer_StdioTransport{Command: "docs-mcp"}}},
+				},
+			},
+			Expected: ServiceTestExpectation[v1.CreateAgentResponse]{
+				Error: "invalid_argument: MCP server name read_resource is reserved",
+			},
+		},
 		{
 			Name: "success",
 			SeedDatabase: func(ctx context.Context, db *memory.Client) {
@@ -115,6 +144,41 @@ func TestCreateAgent(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "success with MCP servers and tool set",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				test.NewModelBuilder(t, modelID, db, modelProvider).
+					Build(ctx)
+			},
+			Request: &v1.CreateAgentRequest{
+				Name:         "architect-agent",
+				Instructions: "Instructions for architect agent",
+				ModelId:      modelID.String(),
+				McpServers: []*v1.McpServer{
+					{Name: "github", Transport: &v1.McpServer_Stdio{Stdio: &v1.McpServer_StdioTransport{Command: "github-mcp", Args: []string{"stdio"}, Env: map[string]string{"GITHUB_TOKEN": "${GITHUB_TOKEN}"}}}},
+					{Name: "docs", Transport: &v1.McpServer_Http{Http: &v1.McpServer_HttpTransport{Url: "https://example.com/mcp"}}},
+				},
+				ToolSet: &v1.ToolSet{AllowedTools: []string{"read_file", "grep", "mcp.docs.*"}, ReadOnly: true},
+			},
+			Expected: ServiceTestExpectation[v1.CreateAgentResponse]{
+				Response: v1.CreateAgentResponse{
+					Agent: &v1.Agent{
+						Metadata: &v1.AgentMetadata{},
+						Spec: &v1.AgentSpec{
+							Name:         "architect-agent",
+							Instructions: "Instructions for architect agent",
+							ModelId:      modelID.String(),
+							McpServers: []*v1.McpServer{
+								{Name: "github", Transport: &v1.McpServer_Stdio{Stdio: &v1.McpServer_StdioTransport{Command: "github-mcp", Args: []string{"stdio"}, Env: map[string]string{"GITHUB_TOKEN": "${GITHUB_TOKEN}"}}}},
+								{Name: "docs", Transport: &v1.McpServer_Http{Http: &v1.McpServer_HttpTransport{Url: "https://example.com/mcp"}}},
+							},
+							ToolSet: &v1.ToolSet{AllowedTools: []string{"read_file", "grep", "mcp.docs.*"}, ReadOnly: true},
+						},
+					},
+				},
+			},
+		},
 	})
 }
 
@@ -467,6 +531,72 @@ func TestUpdateAgent(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "success - update tool set",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				model := test.NewModelBuilder(t, modelID, db, modelProvider).
+					Build(ctx)
+
+				test.NewAgentBuilder(t, agentID, db, model).
+					WithName("architect-agent").
+					WithInstructions("Architect agent instructions").
+					Build(ctx)
+			},
+			Request: &v1.UpdateAgentRequest{
+				Id:      agentID.String(),
+				ToolSet: &v1.ToolSet{ReadOnly: true},
+			},
+			Expected: ServiceTestExpectation[v1.UpdateAgentResponse]{
+				Response: v1.UpdateAgentResponse{
+					Agent: &v1.Agent{
+						Metadata: &v1.AgentMetadata{
+							Id: agentID.String(),
+						},
+						Spec: &v1.AgentSpec{
+							Name:         "architect-agent",
+							Description:  "Writes code",
+							Instructions: "Architect agent instructions",
+							ModelId:      modelID.String(),
+							ToolSet:      &v1.ToolSet{ReadOnly: true},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "success - update script limits",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				model := test.NewModelBuilder(t, modelID, db, modelProvider).
+					Build(ctx)
+
+				test.NewAgentBuilder(t, agentID, db, model).
+					WithName("architect-agent").
+					WithInstructions("Architect agent instructions").
+					Build(ctx)
+			},
+			Request: &v1.UpdateAgentRequest{
+				Id:           agentID.String(),
+				ScriptLimits: &v1.ScriptLimits{TimeoutSeconds: 60, MaxOutputBytes: 4096},
+			},
+			Expected: ServiceTestExpectation[v1.UpdateAgentResponse]{
+				Response: v1.UpdateAgentResponse{
+					Agent: &v1.Agent{
+						Metadata: &v1.AgentMetadata{
+							Id: agentID.String(),
+						},
+						Spec: &v1.AgentSpec{
+							Name:         "architect-agent",
+							Description:  "Writes code",
+							Instructions: "Architect agent instructions",
+							ModelId:      modelID.String(),
+							ScriptLimits: &v1.ScriptLimits{TimeoutSeconds: 60, MaxOutputBytes: 4096},
+						},
+					},
+				},
+			},
+		},
 	})
 }
 
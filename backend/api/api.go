@@ -22,6 +22,7 @@ type AgentRuntime interface {
 	Memory() *memory.Client
 	Encryption() *secret.Encryption
 	EventHub() *event.MessageHub
+	EventJournal() *event.Journal
 }
 
 type Server struct {
@@ -31,16 +32,24 @@ type Server struct {
 }
 
 func NewServer(runtime AgentRuntime, listener net.Listener, eventBus *event.Bus, analyticsClient analytics.Client) *Server {
-	apiHandler := NewHandler(
-		HandlerOptions{
-			DB:           runtime.Memory(),
-			Encryption:   runtime.Encryption(),
-			AgentRuntime: runtime,
-			MessageHub:   runtime.EventHub(),
-			EventBus:     eventBus,
-			Analytics:    analyticsClient,
-		},
-	)
+	handlerOptions := HandlerOptions{
+		DB:           runtime.Memory(),
+		Encryption:   runtime.Encryption(),
+		AgentRuntime: runtime,
+		MessageHub:   runtime.EventHub(),
+		EventJournal: runtime.EventJournal(),
+		EventBus:     eventBus,
+		Analytics:    analyticsClient,
+	}
+
+	// Unix sockets are protected by file permissions. Anything reachable over
+	// the network has to present a token or a trusted client certificate.
+	if requiresAuthentication(listener) {
+		handlerOptions.RequestOptions = append(handlerOptions.RequestOptions,
+			connect.WithInterceptors(NewAuthInterceptor(runtime.Memory())),
+		)
+	}
+	apiHandler := NewHandler(handlerOptions)
 
 	mux := http.NewServeMux()
 	mux.Handle("/api/", http.StripPrefix("/api", apiHandler))
@@ -60,11 +69,16 @@ func (s *Server) ListenAndServe(ctx context.Context) error {
 		BaseContext: func(l net.Listener) context.Context {
 			return ctx
 		},
+		ConnContext: connContext,
 	}
 
 	return s.server.Serve(s.listener)
 }
 
+func requiresAuthentication(listener net.Listener) bool {
+	return listener.Addr().Network() != "unix"
+}
+
 func (s *Server) Shutdown(ctx context.Context) error {
 	return s.server.Shutdown(ctx)
 }
@@ -74,9 +88,10 @@ type HandlerOptions struct {
 	Encryption   *secret.Encryption
 	AgentRuntime AgentRuntime
 
-	EventBus   *event.Bus
-	MessageHub *event.MessageHub
-	Analytics  analytics.Client
+	EventBus     *event.Bus
+	MessageHub   *event.MessageHub
+	EventJournal *event.Journal
+	Analytics    analytics.Client
 
 	RequestOptions []connect.HandlerOption
 }
@@ -93,18 +108,30 @@ func NewHandler(opts HandlerOptions) *Handler {
 	modelProviderHandler := NewModelProviderHandler(opts.DB, opts.Encryption)
 	handler.mux.Handle(v1connect.NewModelProviderServiceHandler(modelProviderHandler, opts.RequestOptions...))
 
-	modelHandler := NewModelHandler(opts.DB)
+	modelHandler := NewModelHandler(opts.DB, opts.EventBus)
 	handler.mux.Handle(v1connect.NewModelServiceHandler(modelHandler, opts.RequestOptions...))
 
-	agentHandler := NewAgentHandler(opts.DB, opts.Analytics)
+	agentHandler := NewAgentHandler(opts.DB, opts.EventBus, opts.Analytics)
 	handler.mux.Handle(v1connect.NewAgentServiceHandler(agentHandler, opts.RequestOptions...))
 
 	taskHandler := NewTaskHandler(opts.DB, opts.MessageHub, opts.EventBus, opts.AgentRuntime, opts.Analytics)
 	handler.mux.Handle(v1connect.NewTaskServiceHandler(taskHandler, opts.RequestOptions...))
 
+	scheduleHandler := NewScheduleHandler(opts.DB)
+	handler.mux.Handle(v1connect.NewScheduleServiceHandler(scheduleHandler, opts.RequestOptions...))
+
+	webhookHandler := NewWebhookHandler(opts.DB, opts.Encryption, opts.EventBus)
+	handler.mux.Handle(v1connect.NewWebhookServiceHandler(webhookHandler, opts.RequestOptions...))
+
+	toolHandler := NewToolHandler(opts.DB)
+	handler.mux.Handle(v1connect.NewToolServiceHandler(toolHandler, opts.RequestOptions...))
+
 	messageHandler := NewMessageHandler(opts.DB, opts.AgentRuntime, opts.MessageHub, opts.EventBus)
 	handler.mux.Handle(v1connect.NewMessageServiceHandler(messageHandler, opts.RequestOptions...))
 
+	eventHandler := NewEventHandler(opts.DB, opts.EventJournal)
+	handler.mux.Handle(v1connect.NewEventServiceHandler(eventHandler, opts.RequestOptions...))
+
 	return handler
 }
 
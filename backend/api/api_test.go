@@ -147,6 +147,7 @@ func DefaultTestHandlerOptions(t *testing.T) HandlerOptions {
 		AgentRuntime: runtime,
 		EventBus:     eventBus,
 		MessageHub:   messageHub,
+		EventJournal: event.NewJournal(eventBus, event.DefaultJournalCapacity),
 		Analytics:    analytics.NewInMemoryClient(),
 	}
 }
@@ -195,6 +196,26 @@ func (s *TestServer) ClearDatabase(ctx context.Context, t *testing.T) error {
 			return nil, fmt.Errorf("failed to delete tasks: %w", err)
 		}
 
+		_, err = tx.AuthToken.Delete().Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete auth tokens: %w", err)
+		}
+
+		_, err = tx.Webhook.Delete().Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete webhooks: %w", err)
+		}
+
+		_, err = tx.Tool.Delete().Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete tools: %w", err)
+		}
+
+		_, err = tx.Schedule.Delete().Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete schedules: %w", err)
+		}
+
 		_, err = tx.Agent.Delete().Exec(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("failed to delete agents: %w", err)
@@ -210,6 +231,11 @@ func (s *TestServer) ClearDatabase(ctx context.Context, t *testing.T) error {
 			return nil, fmt.Errorf("failed to delete model providers: %w", err)
 		}
 
+		_, err = tx.User.Delete().Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete users: %w", err)
+		}
+
 		return nil, nil
 	})
 
@@ -306,5 +332,9 @@ func (m *MockAgentRuntime) EventHub() *event.MessageHub {
 	return nil
 }
 
+func (m *MockAgentRuntime) EventJournal() *event.Journal {
+	return nil
+}
+
 func (m *MockAgentRuntime) CancelTask(id uuid.UUID) {
 }
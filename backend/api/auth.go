@@ -0,0 +1,153 @@
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/furisto/construct/backend/auth"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/authtoken"
+	"github.com/furisto/construct/backend/memory/user"
+)
+
+// lastUsedResolution bounds how often a token's last_used_at is written, so
+// that busy clients do not turn every request into a database write.
+const lastUsedResolution = time.Minute
+
+type connContextKey struct{}
+
+// connContext stores the accepted connection in the request context so that
+// the auth interceptor can inspect the TLS state of the peer.
+func connContext(ctx context.Context, conn net.Conn) context.Context {
+	return context.WithValue(ctx, connContextKey{}, conn)
+}
+
+// verifiedClientCertificate returns the leaf of a client certificate that was
+// verified against the configured client CA.
+func verifiedClientCertificate(ctx context.Context) (*x509.Certificate, bool) {
+	conn, ok := ctx.Value(connContextKey{}).(*tls.Conn)
+	if !ok {
+		return nil, false
+	}
+
+	chains := conn.ConnectionState().VerifiedChains
+	if len(chains) == 0 || len(chains[0]) == 0 {
+		return nil, false
+	}
+	return chains[0][0], true
+}
+
+// AuthInterceptor rejects requests that carry neither a valid bearer token nor
+// a client certificate signed by the configured client CA. Authenticated
+// requests run as the user the token was issued for or, for certificates, the
+// user named by the certificate's common name.
+type AuthInterceptor struct {
+	db  *memory.Client
+	now func() time.Time
+}
+
+var _ connect.Interceptor = (*AuthInterceptor)(nil)
+
+func NewAuthInterceptor(db *memory.Client) *AuthInterceptor {
+	return &AuthInterceptor{
+		db:  db,
+		now: time.Now,
+	}
+}
+
+func (i *AuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+
+		principal, err := i.authenticate(ctx, req.Peer(), req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return next(auth.WithPrincipal(ctx, principal), req)
+	}
+}
+
+func (i *AuthInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *AuthInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		principal, err := i.authenticate(ctx, conn.Peer(), conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+		return next(auth.WithPrincipal(ctx, principal), conn)
+	}
+}
+
+func (i *AuthInterceptor) authenticate(ctx context.Context, peer connect.Peer, header http.Header) (auth.Principal, error) {
+	if certificate, ok := verifiedClientCertificate(ctx); ok {
+		return i.authenticateCertificate(ctx, peer, certificate)
+	}
+
+	token, ok := auth.ParseBearer(header.Get("Authorization"))
+	if !ok {
+		slog.Warn("rejected unauthenticated request", "peer", peer.Addr)
+		return auth.Principal{}, connect.NewError(connect.CodeUnauthenticated, errors.New("missing bearer token"))
+	}
+
+	authToken, err := i.db.AuthToken.Query().
+		Where(authtoken.TokenHash(auth.HashToken(token))).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if memory.IsNotFound(err) {
+			slog.Warn("rejected request with unknown token", "peer", peer.Addr)
+			return auth.Principal{}, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid token"))
+		}
+		return auth.Principal{}, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to look up token: %w", err))
+	}
+
+	now := i.now()
+	if authToken.ExpiresAt != nil && !now.Before(*authToken.ExpiresAt) {
+		slog.Warn("rejected request with expired token", "peer", peer.Addr, "token", authToken.Name)
+		return auth.Principal{}, connect.NewError(connect.CodeUnauthenticated, errors.New("token expired"))
+	}
+
+	if authToken.LastUsedAt == nil || now.Sub(*authToken.LastUsedAt) >= lastUsedResolution {
+		err = i.db.AuthToken.UpdateOneID(authToken.ID).SetLastUsedAt(now).Exec(ctx)
+		if err != nil {
+			slog.Warn("failed to record token usage", "token", authToken.Name, "error", err)
+		}
+	}
+
+	return principalForUser(authToken.Edges.User), nil
+}
+
+func (i *AuthInterceptor) authenticateCertificate(ctx context.Context, peer connect.Peer, certificate *x509.Certificate) (auth.Principal, error) {
+	name := certificate.Subject.CommonName
+	user, err := i.db.User.Query().Where(user.Name(name)).Only(ctx)
+	if err != nil {
+		if memory.IsNotFound(err) {
+			slog.Warn("rejected client certificate for unknown user", "peer", peer.Addr, "user", name)
+			return auth.Principal{}, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("no user for client certificate %q", name))
+		}
+		return auth.Principal{}, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to look up user: %w", err))
+	}
+
+	return principalForUser(user), nil
+}
+
+func principalForUser(user *memory.User) auth.Principal {
+	return auth.Principal{
+		UserID: &user.ID,
+		Name:   user.Name,
+		Role:   user.Role,
+	}
+}
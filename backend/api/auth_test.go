@@ -0,0 +1,252 @@
+package api
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/auth"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	_ "modernc.org/sqlite"
+)
+
+func TestAuthInterceptor(t *testing.T) {
+	ctx := context.Background()
+
+	handlerOptions := DefaultTestHandlerOptions(t)
+	handlerOptions.RequestOptions = append(handlerOptions.RequestOptions,
+		connect.WithInterceptors(NewAuthInterceptor(handlerOptions.DB)),
+	)
+	server := NewTestServer(t, handlerOptions)
+	server.Start(ctx)
+	t.Cleanup(server.Close)
+
+	db := handlerOptions.DB
+	user := seedUser(t, ctx, db, "alice", types.UserRoleMember)
+	validToken := seedAuthToken(t, ctx, db, user, "laptop", nil)
+	expiredAt := time.Now().Add(-time.Hour)
+	expiredToken := seedAuthToken(t, ctx, db, user, "expired", &expiredAt)
+
+	tests := []struct {
+		name     string
+		token    string
+		wantCode connect.Code
+	}{
+		{name: "missing token", wantCode: connect.CodeUnauthenticated},
+		{name: "unknown token", token: "ct_unknown", wantCode: connect.CodeUnauthenticated},
+		{name: "expired token", token: expiredToken, wantCode: connect.CodeUnauthenticated},
+		{name: "valid token", token: validToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := api_client.NewClient(api_client.EndpointContext{
+				Address: server.API.URL,
+				Kind:    "http",
+				Token:   tt.token,
+			})
+			if err != nil {
+				t.Fatalf("failed to create api client: %v", err)
+			}
+
+			_, err = client.Agent().ListAgents(ctx, connect.NewRequest(&v1.ListAgentsRequest{}))
+			if tt.wantCode == 0 {
+				if err != nil {
+					t.Fatalf("expected request to succeed, got %v", err)
+				}
+				return
+			}
+
+			if connect.CodeOf(err) != tt.wantCode {
+				t.Fatalf("expected code %v, got %v", tt.wantCode, err)
+			}
+		})
+	}
+
+	tokens, err := db.AuthToken.Query().All(ctx)
+	if err != nil {
+		t.Fatalf("failed to query tokens: %v", err)
+	}
+	for _, token := range tokens {
+		if token.Name == "laptop" && token.LastUsedAt == nil {
+			t.Error("expected last used time to be recorded for the valid token")
+		}
+		if token.Name == "expired" && token.LastUsedAt != nil {
+			t.Error("expected no last used time for the expired token")
+		}
+	}
+}
+
+func TestAuthInterceptorClientCertificate(t *testing.T) {
+	ctx := context.Background()
+
+	handlerOptions := DefaultTestHandlerOptions(t)
+	handlerOptions.RequestOptions = append(handlerOptions.RequestOptions,
+		connect.WithInterceptors(NewAuthInterceptor(handlerOptions.DB)),
+	)
+	server := NewTestServer(t, handlerOptions)
+	if err := handlerOptions.DB.Schema.Create(ctx); err != nil {
+		t.Fatalf("failed creating schema resources: %v", err)
+	}
+
+	seedUser(t, ctx, handlerOptions.DB, "alice", types.UserRoleMember)
+
+	caCert, caKey := generateTestCertificate(t, "construct test ca", nil, nil)
+	clientCert, clientKey := generateTestCertificate(t, "alice", caCert, caKey)
+	unknownCert, unknownKey := generateTestCertificate(t, "mallory", caCert, caKey)
+	untrustedCert, untrustedKey := generateTestCertificate(t, "untrusted", nil, nil)
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(caCert)
+	server.API.TLS = &tls.Config{
+		ClientCAs:  clientCAs,
+		ClientAuth: tls.VerifyClientCertIfGiven,
+	}
+	server.API.Config.ConnContext = connContext
+	server.API.StartTLS()
+	t.Cleanup(server.Close)
+
+	tests := []struct {
+		name        string
+		certificate *tls.Certificate
+		wantCode    connect.Code
+	}{
+		{name: "no certificate", wantCode: connect.CodeUnauthenticated},
+		{name: "trusted certificate", certificate: &tls.Certificate{Certificate: [][]byte{clientCert.Raw}, PrivateKey: clientKey}},
+		{name: "trusted certificate of unknown user", certificate: &tls.Certificate{Certificate: [][]byte{unknownCert.Raw}, PrivateKey: unknownKey}, wantCode: connect.CodeUnauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpClient := testTLSClient(server, tt.certificate)
+
+			client, err := api_client.NewClient(api_client.EndpointContext{
+				Address: server.API.URL,
+				Kind:    "http",
+			}, api_client.WithHTTPClient(httpClient))
+			if err != nil {
+				t.Fatalf("failed to create api client: %v", err)
+			}
+
+			_, err = client.Agent().ListAgents(ctx, connect.NewRequest(&v1.ListAgentsRequest{}))
+			if tt.wantCode == 0 {
+				if err != nil {
+					t.Fatalf("expected request to succeed, got %v", err)
+				}
+				return
+			}
+
+			if connect.CodeOf(err) != tt.wantCode {
+				t.Fatalf("expected code %v, got %v", tt.wantCode, err)
+			}
+		})
+	}
+
+	t.Run("untrusted certificate", func(t *testing.T) {
+		httpClient := testTLSClient(server, &tls.Certificate{Certificate: [][]byte{untrustedCert.Raw}, PrivateKey: untrustedKey})
+
+		client, err := api_client.NewClient(api_client.EndpointContext{
+			Address: server.API.URL,
+			Kind:    "http",
+		}, api_client.WithHTTPClient(httpClient))
+		if err != nil {
+			t.Fatalf("failed to create api client: %v", err)
+		}
+
+		_, err = client.Agent().ListAgents(ctx, connect.NewRequest(&v1.ListAgentsRequest{}))
+		if err == nil {
+			t.Fatal("expected request with an untrusted certificate to fail")
+		}
+	})
+}
+
+// testTLSClient returns a client that trusts the test server and does not share
+// connections with other clients, so each one performs its own handshake.
+func testTLSClient(server *TestServer, certificate *tls.Certificate) *http.Client {
+	transport := server.API.Client().Transport.(*http.Transport).Clone()
+	if certificate != nil {
+		transport.TLSClientConfig.Certificates = []tls.Certificate{*certificate}
+	}
+	return &http.Client{Transport: transport}
+}
+
+func seedUser(t *testing.T, ctx context.Context, db *memory.Client, name string, role types.UserRole) *memory.User {
+	t.Helper()
+
+	user, err := db.User.Create().SetName(name).SetRole(role).Save(ctx)
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	return user
+}
+
+func seedAuthToken(t *testing.T, ctx context.Context, db *memory.Client, user *memory.User, name string, expiresAt *time.Time) string {
+	t.Helper()
+
+	token, err := auth.GenerateToken()
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+
+	err = db.AuthToken.Create().
+		SetName(name).
+		SetUser(user).
+		SetTokenHash(auth.HashToken(token)).
+		SetNillableExpiresAt(expiresAt).
+		Exec(ctx)
+	if err != nil {
+		t.Fatalf("failed to create token: %v", err)
+	}
+
+	return token
+}
+
+// generateTestCertificate creates a certificate for name. It is self-signed
+// when parent is nil and can sign other certificates itself.
+func generateTestCertificate(t *testing.T, name string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		parent = template
+		parentKey = key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("failed to parse certificate: %v", err)
+	}
+
+	return certificate, key
+}
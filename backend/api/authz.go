@@ -0,0 +1,112 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/furisto/construct/backend/auth"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/message"
+	"github.com/furisto/construct/backend/memory/predicate"
+	"github.com/furisto/construct/backend/memory/task"
+	"github.com/google/uuid"
+)
+
+// The functions in this file enforce the role model of the daemon:
+//
+//   - admins manage model providers, models, builtin agents, schedules,
+//     webhooks and custom tools and may act on every resource.
+//   - members create agents and tasks and may only change, and for tasks only
+//     see, the ones they own.
+//   - viewers read every task and transcript but change nothing.
+//
+// Tasks a caller may not read are reported as not found so that their
+// existence is not leaked.
+
+func permissionDenied(format string, args ...any) error {
+	return connect.NewError(connect.CodePermissionDenied, fmt.Errorf(format, args...))
+}
+
+func requireAdmin(ctx context.Context, action string) error {
+	principal := auth.PrincipalFromContext(ctx)
+	if !principal.IsAdmin() {
+		return permissionDenied("only admins may %s", action)
+	}
+	return nil
+}
+
+func requireReadAll(ctx context.Context, action string) error {
+	principal := auth.PrincipalFromContext(ctx)
+	if !principal.CanReadAll() {
+		return permissionDenied("only admins and viewers may %s", action)
+	}
+	return nil
+}
+
+func requireWrite(ctx context.Context, action string) error {
+	principal := auth.PrincipalFromContext(ctx)
+	if !principal.CanWrite() {
+		return permissionDenied("role %s may not %s", principal.Role, action)
+	}
+	return nil
+}
+
+// ownerOf returns the owner to record for resources created by the caller.
+func ownerOf(ctx context.Context) *uuid.UUID {
+	return auth.PrincipalFromContext(ctx).UserID
+}
+
+// readableTasks restricts a task query to the tasks the caller may read.
+func readableTasks(ctx context.Context) []predicate.Task {
+	principal := auth.PrincipalFromContext(ctx)
+	if principal.CanReadAll() {
+		return nil
+	}
+
+	if principal.UserID == nil {
+		return []predicate.Task{task.OwnerIDIsNil()}
+	}
+	return []predicate.Task{task.OwnerID(*principal.UserID)}
+}
+
+// readableMessages restricts a message query to the messages of readable tasks.
+func readableMessages(ctx context.Context) []predicate.Message {
+	tasks := readableTasks(ctx)
+	if tasks == nil {
+		return nil
+	}
+	return []predicate.Message{message.HasTaskWith(tasks...)}
+}
+
+func authorizeTaskRead(ctx context.Context, t *memory.Task) error {
+	principal := auth.PrincipalFromContext(ctx)
+	if principal.CanReadAll() || principal.Owns(t.OwnerID) {
+		return nil
+	}
+	return connect.NewError(connect.CodeNotFound, fmt.Errorf("task %s not found", t.ID))
+}
+
+func authorizeTaskWrite(ctx context.Context, t *memory.Task) error {
+	if err := authorizeTaskRead(ctx, t); err != nil {
+		return err
+	}
+
+	principal := auth.PrincipalFromContext(ctx)
+	if !principal.CanWrite() || !principal.Owns(t.OwnerID) {
+		return permissionDenied("not allowed to modify task %s", t.ID)
+	}
+	return nil
+}
+
+func authorizeAgentWrite(ctx context.Context, a *memory.Agent) error {
+	principal := auth.PrincipalFromContext(ctx)
+	if a.Builtin && !principal.IsAdmin() {
+		return permissionDenied("only admins may modify builtin agent %s", a.Name)
+	}
+
+	if !principal.CanWrite() || !principal.Owns(a.OwnerID) {
+		return permissionDenied("not allowed to modify agent %s", a.Name)
+	}
+	return nil
+}
@@ -0,0 +1,252 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/test"
+	"github.com/google/uuid"
+	_ "modernc.org/sqlite"
+)
+
+type authzTestClients struct {
+	admin  *api_client.Client
+	alice  *api_client.Client
+	bob    *api_client.Client
+	viewer *api_client.Client
+}
+
+func TestAuthorizationTasks(t *testing.T) {
+	ctx := context.Background()
+	server, clients := startAuthzTestServer(t, ctx)
+
+	modelProvider := test.NewModelProviderBuilder(t, uuid.New(), server.Options.DB).Build(ctx)
+	model := test.NewModelBuilder(t, uuid.New(), server.Options.DB, modelProvider).Build(ctx)
+	agent := test.NewAgentBuilder(t, uuid.New(), server.Options.DB, model).Build(ctx)
+
+	created, err := clients.alice.Task().CreateTask(ctx, connect.NewRequest(&v1.CreateTaskRequest{
+		AgentId:          agent.ID.String(),
+		ProjectDirectory: "/tmp/alice",
+	}))
+	if err != nil {
+		t.Fatalf("member failed to create task: %v", err)
+	}
+	taskID := created.Msg.Task.Metadata.Id
+
+	_, err = clients.viewer.Task().CreateTask(ctx, connect.NewRequest(&v1.CreateTaskRequest{
+		AgentId: agent.ID.String(),
+	}))
+	expectCode(t, "viewer creates task", err, connect.CodePermissionDenied)
+
+	_, err = clients.bob.Task().GetTask(ctx, connect.NewRequest(&v1.GetTaskRequest{Id: taskID}))
+	expectCode(t, "other member reads task", err, connect.CodeNotFound)
+
+	for name, client := range map[string]*api_client.Client{"owner": clients.alice, "viewer": clients.viewer, "admin": clients.admin} {
+		_, err = client.Task().GetTask(ctx, connect.NewRequest(&v1.GetTaskRequest{Id: taskID}))
+		expectCode(t, name+" reads task", err, 0)
+	}
+
+	expectTaskCount(t, ctx, clients.alice, 1)
+	expectTaskCount(t, ctx, clients.bob, 0)
+	expectTaskCount(t, ctx, clients.viewer, 1)
+
+	message := &v1.CreateMessageRequest{
+		TaskId: taskID,
+		Content: []*v1.MessagePart{
+			{Data: &v1.MessagePart_Text_{Text: &v1.MessagePart_Text{Content: "Fix the failing build"}}},
+		},
+	}
+	_, err = clients.viewer.Message().CreateMessage(ctx, connect.NewRequest(message))
+	expectCode(t, "viewer sends message", err, connect.CodePermissionDenied)
+
+	_, err = clients.bob.Message().CreateMessage(ctx, connect.NewRequest(message))
+	expectCode(t, "other member sends message", err, connect.CodeNotFound)
+
+	_, err = clients.alice.Message().CreateMessage(ctx, connect.NewRequest(message))
+	expectCode(t, "owner sends message", err, 0)
+
+	messages, err := clients.bob.Message().ListMessages(ctx, connect.NewRequest(&v1.ListMessagesRequest{}))
+	expectCode(t, "other member lists messages", err, 0)
+	if err == nil && len(messages.Msg.Messages) != 0 {
+		t.Errorf("expected other member to see no messages, got %d", len(messages.Msg.Messages))
+	}
+
+	_, err = clients.viewer.Task().RenderTask(ctx, connect.NewRequest(&v1.RenderTaskRequest{Id: taskID}))
+	expectCode(t, "viewer renders transcript", err, 0)
+
+	_, err = clients.viewer.Task().DeleteTask(ctx, connect.NewRequest(&v1.DeleteTaskRequest{Id: taskID}))
+	expectCode(t, "viewer deletes task", err, connect.CodePermissionDenied)
+
+	_, err = clients.admin.Task().DeleteTask(ctx, connect.NewRequest(&v1.DeleteTaskRequest{Id: taskID}))
+	expectCode(t, "admin deletes task", err, 0)
+}
+
+func TestAuthorizationWatchEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	server, clients := startAuthzTestServer(t, ctx)
+
+	modelProvider := test.NewModelProviderBuilder(t, uuid.New(), server.Options.DB).Build(ctx)
+	model := test.NewModelBuilder(t, uuid.New(), server.Options.DB, modelProvider).Build(ctx)
+	agent := test.NewAgentBuilder(t, uuid.New(), server.Options.DB, model).Build(ctx)
+
+	stream := watchEvents(t, ctx, server, clients.bob, &v1.WatchEventsRequest{
+		Filter: &v1.WatchEventsRequest_Filter{
+			Types: []v1.EventType{v1.EventType_EVENT_TYPE_TASK_CREATED},
+		},
+	})
+
+	_, err := clients.alice.Task().CreateTask(ctx, connect.NewRequest(&v1.CreateTaskRequest{AgentId: agent.ID.String()}))
+	if err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+
+	own, err := clients.bob.Task().CreateTask(ctx, connect.NewRequest(&v1.CreateTaskRequest{AgentId: agent.ID.String()}))
+	if err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+
+	received := receiveWatchEvent(t, stream)
+	if received.ResourceId != own.Msg.Task.Metadata.Id {
+		t.Errorf("expected only the member's own task event, got event for %s", received.ResourceId)
+	}
+}
+
+func TestAuthorizationAgents(t *testing.T) {
+	ctx := context.Background()
+	server, clients := startAuthzTestServer(t, ctx)
+
+	modelProvider := test.NewModelProviderBuilder(t, uuid.New(), server.Options.DB).Build(ctx)
+	model := test.NewModelBuilder(t, uuid.New(), server.Options.DB, modelProvider).Build(ctx)
+	builtin := test.NewAgentBuilder(t, uuid.New(), server.Options.DB, model).WithName("coder").Build(ctx)
+	err := server.Options.DB.Agent.UpdateOne(builtin).SetBuiltin(true).Exec(ctx)
+	if err != nil {
+		t.Fatalf("failed to mark agent as builtin: %v", err)
+	}
+
+	created, err := clients.alice.Agent().CreateAgent(ctx, connect.NewRequest(&v1.CreateAgentRequest{
+		Name:         "reviewer",
+		Instructions: "Review the changes",
+		ModelId:      model.ID.String(),
+	}))
+	if err != nil {
+		t.Fatalf("member failed to create agent: %v", err)
+	}
+	agentID := created.Msg.Agent.Metadata.Id
+
+	description := "Reviews pull requests"
+	_, err = clients.bob.Agent().UpdateAgent(ctx, connect.NewRequest(&v1.UpdateAgentRequest{Id: agentID, Description: &description}))
+	expectCode(t, "other member updates agent", err, connect.CodePermissionDenied)
+
+	_, err = clients.alice.Agent().UpdateAgent(ctx, connect.NewRequest(&v1.UpdateAgentRequest{Id: agentID, Description: &description}))
+	expectCode(t, "owner updates agent", err, 0)
+
+	_, err = clients.alice.Agent().UpdateAgent(ctx, connect.NewRequest(&v1.UpdateAgentRequest{Id: builtin.ID.String(), Description: &description}))
+	expectCode(t, "member updates builtin agent", err, connect.CodePermissionDenied)
+
+	_, err = clients.admin.Agent().UpdateAgent(ctx, connect.NewRequest(&v1.UpdateAgentRequest{Id: builtin.ID.String(), Description: &description}))
+	expectCode(t, "admin updates builtin agent", err, 0)
+
+	agents, err := clients.viewer.Agent().ListAgents(ctx, connect.NewRequest(&v1.ListAgentsRequest{}))
+	expectCode(t, "viewer lists agents", err, 0)
+	if err == nil && len(agents.Msg.Agents) != 2 {
+		t.Errorf("expected viewer to see 2 agents, got %d", len(agents.Msg.Agents))
+	}
+
+	_, err = clients.viewer.Agent().DeleteAgent(ctx, connect.NewRequest(&v1.DeleteAgentRequest{Id: agentID}))
+	expectCode(t, "viewer deletes agent", err, connect.CodePermissionDenied)
+}
+
+func TestAuthorizationAdminResources(t *testing.T) {
+	ctx := context.Background()
+	_, clients := startAuthzTestServer(t, ctx)
+
+	missingID := uuid.New().String()
+
+	_, err := clients.alice.ModelProvider().DeleteModelProvider(ctx, connect.NewRequest(&v1.DeleteModelProviderRequest{Id: missingID}))
+	expectCode(t, "member deletes model provider", err, connect.CodePermissionDenied)
+
+	_, err = clients.admin.ModelProvider().DeleteModelProvider(ctx, connect.NewRequest(&v1.DeleteModelProviderRequest{Id: missingID}))
+	expectCode(t, "admin deletes model provider", err, connect.CodeNotFound)
+
+	_, err = clients.alice.Model().DeleteModel(ctx, connect.NewRequest(&v1.DeleteModelRequest{Id: missingID}))
+	expectCode(t, "member deletes model", err, connect.CodePermissionDenied)
+
+	_, err = clients.alice.ModelProvider().ListModelProviders(ctx, connect.NewRequest(&v1.ListModelProvidersRequest{}))
+	expectCode(t, "member lists model providers", err, 0)
+
+	_, err = clients.alice.Schedule().ListSchedules(ctx, connect.NewRequest(&v1.ListSchedulesRequest{}))
+	expectCode(t, "member lists schedules", err, connect.CodePermissionDenied)
+
+	_, err = clients.viewer.Schedule().ListSchedules(ctx, connect.NewRequest(&v1.ListSchedulesRequest{}))
+	expectCode(t, "viewer lists schedules", err, 0)
+
+	_, err = clients.viewer.Webhook().ListWebhooks(ctx, connect.NewRequest(&v1.ListWebhooksRequest{}))
+	expectCode(t, "viewer lists webhooks", err, connect.CodePermissionDenied)
+}
+
+func startAuthzTestServer(t *testing.T, ctx context.Context) (*TestServer, authzTestClients) {
+	t.Helper()
+
+	handlerOptions := DefaultTestHandlerOptions(t)
+	handlerOptions.RequestOptions = append(handlerOptions.RequestOptions,
+		connect.WithInterceptors(NewAuthInterceptor(handlerOptions.DB)),
+	)
+	server := NewTestServer(t, handlerOptions)
+	server.Start(ctx)
+	t.Cleanup(server.Close)
+
+	newClient := func(name string, role types.UserRole) *api_client.Client {
+		user := seedUser(t, ctx, handlerOptions.DB, name, role)
+		token := seedAuthToken(t, ctx, handlerOptions.DB, user, name, nil)
+
+		client, err := api_client.NewClient(api_client.EndpointContext{
+			Address: server.API.URL,
+			Kind:    "http",
+			Token:   token,
+		})
+		if err != nil {
+			t.Fatalf("failed to create api client: %v", err)
+		}
+		return client
+	}
+
+	return server, authzTestClients{
+		admin:  newClient("root", types.UserRoleAdmin),
+		alice:  newClient("alice", types.UserRoleMember),
+		bob:    newClient("bob", types.UserRoleMember),
+		viewer: newClient("victor", types.UserRoleViewer),
+	}
+}
+
+func expectCode(t *testing.T, action string, err error, want connect.Code) {
+	t.Helper()
+
+	if want == 0 {
+		if err != nil {
+			t.Errorf("%s: expected success, got %v", action, err)
+		}
+		return
+	}
+
+	if connect.CodeOf(err) != want {
+		t.Errorf("%s: expected code %v, got %v", action, want, err)
+	}
+}
+
+func expectTaskCount(t *testing.T, ctx context.Context, client *api_client.Client, want int) {
+	t.Helper()
+
+	resp, err := client.Task().ListTasks(ctx, connect.NewRequest(&v1.ListTasksRequest{}))
+	if err != nil {
+		t.Fatalf("failed to list tasks: %v", err)
+	}
+
+	if len(resp.Msg.Tasks) != want {
+		t.Errorf("expected %d tasks, got %d", want, len(resp.Msg.Tasks))
+	}
+}
@@ -3,6 +3,7 @@ package conv
 import (
 	v1 "github.com/furisto/construct/api/go/v1"
 	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
 )
 
 func ConvertAgentToProto(a *memory.Agent) (*v1.Agent, error) {
@@ -31,5 +32,116 @@ func ConvertAgentSpecToProto(a *memory.Agent) (*v1.AgentSpec, error) {
 		Description:  a.Description,
 		Instructions: a.Instructions,
 		ModelId:      ConvertUUIDToString(a.ModelID),
+		McpServers:   ConvertMCPServersToProto(a.McpServers),
+		ToolSet:      ConvertToolSetToProto(a.ToolSet),
+		ScriptLimits: ConvertScriptLimitsToProto(a.ScriptLimits),
 	}, nil
 }
+
+func ConvertScriptLimitsToProto(limits *types.ScriptLimits) *v1.ScriptLimits {
+	if limits == nil {
+		return nil
+	}
+
+	return &v1.ScriptLimits{
+		TimeoutSeconds:     int32(limits.TimeoutSeconds),
+		MaxToolCalls:       int32(limits.MaxToolCalls),
+		MaxOutputBytes:     int32(limits.MaxOutputBytes),
+		MaxToolResultBytes: int32(limits.MaxToolResultBytes),
+	}
+}
+
+// ConvertScriptLimitsFromProto returns nil for limits that are all zero so
+// that such agents are stored like agents without limits.
+func ConvertScriptLimitsFromProto(protoLimits *v1.ScriptLimits) *types.ScriptLimits {
+	if protoLimits == nil {
+		return nil
+	}
+
+	limits := &types.ScriptLimits{
+		TimeoutSeconds:     int(protoLimits.TimeoutSeconds),
+		MaxToolCalls:       int(protoLimits.MaxToolCalls),
+		MaxOutputBytes:     int(protoLimits.MaxOutputBytes),
+		MaxToolResultBytes: int(protoLimits.MaxToolResultBytes),
+	}
+	if *limits == (types.ScriptLimits{}) {
+		return nil
+	}
+	return limits
+}
+
+func ConvertToolSetToProto(toolSet *types.ToolSet) *v1.ToolSet {
+	if toolSet == nil {
+		return nil
+	}
+
+	return &v1.ToolSet{
+		AllowedTools: toolSet.AllowedTools,
+		ReadOnly:     toolSet.ReadOnly,
+	}
+}
+
+// ConvertToolSetFromProto returns nil for a tool set that does not restrict
+// anything so that such agents are stored like agents without a tool set.
+func ConvertToolSetFromProto(protoToolSet *v1.ToolSet) *types.ToolSet {
+	if protoToolSet == nil || (len(protoToolSet.AllowedTools) == 0 && !protoToolSet.ReadOnly) {
+		return nil
+	}
+
+	return &types.ToolSet{
+		AllowedTools: protoToolSet.AllowedTools,
+		ReadOnly:     protoToolSet.ReadOnly,
+	}
+}
+
+func ConvertMCPServersToProto(servers []types.MCPServer) []*v1.McpServer {
+	if len(servers) == 0 {
+		return nil
+	}
+
+	protoServers := make([]*v1.McpServer, 0, len(servers))
+	for _, server := range servers {
+		protoServer := &v1.McpServer{Name: server.Name}
+		switch server.Transport {
+		case types.MCPTransportStdio:
+			protoServer.Transport = &v1.McpServer_Stdio{
+				Stdio: &v1.McpServer_StdioTransport{
+					Command: server.Command,
+					Args:    server.Args,
+					Env:     server.Env,
+				},
+			}
+		case types.MCPTransportHTTP:
+			protoServer.Transport = &v1.McpServer_Http{
+				Http: &v1.McpServer_HttpTransport{
+					Url:     server.URL,
+					Headers: server.Headers,
+				},
+			}
+		}
+		protoServers = append(protoServers, protoServer)
+	}
+
+	return protoServers
+}
+
+func ConvertMCPServersFromProto(protoServers []*v1.McpServer) []types.MCPServer {
+	servers := make([]types.MCPServer, 0, len(protoServers))
+	for _, protoServer := range protoServers {
+		server := types.MCPServer{Name: protoServer.Name}
+		switch transport := protoServer.Transport.(type) {
+		case *v1.McpServer_Stdio:
+			server.Transport = types.MCPTransportStdio
+			server.Command = transport.Stdio.Command
+			server.Args = transport.Stdio.Args
+			server.Env = transport.Stdio.Env
+		case *v1.McpServer_Http:
+			server.Transport = types.MCPTransportHTTP
+			server.URL = transport.Http.Url
+			server.Headers = transport.Http.Headers
+		}
+		servers = append(servers, server)
+	}
+
+	return servers
+}
@@ -0,0 +1,80 @@
+package conv
+
+import (
+	"fmt"
+
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/event"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ConvertJournalEntryToProto converts an entry of the event journal to the event
+// that is streamed by WatchEvents.
+func ConvertJournalEntryToProto(entry event.JournalEntry) (*v1.WatchEvent, error) {
+	watchEvent := &v1.WatchEvent{
+		Cursor: entry.Cursor,
+		Time:   timestamppb.New(entry.Time),
+	}
+
+	switch e := entry.Event.(type) {
+	case event.ResourceChangedEvent:
+		eventType, err := convertResourceChangeToProto(e.Kind, e.Operation)
+		if err != nil {
+			return nil, err
+		}
+		watchEvent.Type = eventType
+		watchEvent.ResourceId = e.ID.String()
+		if e.Kind == event.ResourceKindTask {
+			watchEvent.TaskId = strPtr(e.ID.String())
+		}
+	case event.TaskPhaseChangedEvent:
+		watchEvent.Type = v1.EventType_EVENT_TYPE_TASK_PHASE_CHANGED
+		watchEvent.ResourceId = e.TaskID.String()
+		watchEvent.TaskId = strPtr(e.TaskID.String())
+		watchEvent.Details = &v1.WatchEvent_PhaseChange{
+			PhaseChange: &v1.TaskPhaseChange{
+				Phase:         ConvertTaskPhaseToProto(e.Phase),
+				PreviousPhase: ConvertTaskPhaseToProto(e.PreviousPhase),
+			},
+		}
+	case event.MessageCompletedEvent:
+		watchEvent.Type = v1.EventType_EVENT_TYPE_MESSAGE_COMPLETED
+		watchEvent.ResourceId = e.MessageID.String()
+		watchEvent.TaskId = strPtr(e.TaskID.String())
+		watchEvent.Details = &v1.WatchEvent_Message{
+			Message: &v1.MessageCompletion{
+				Role: convertRole(e.Source),
+			},
+		}
+	default:
+		return nil, fmt.Errorf("unsupported journal event: %T", entry.Event)
+	}
+
+	return watchEvent, nil
+}
+
+func convertResourceChangeToProto(kind event.ResourceKind, operation event.ResourceOperation) (v1.EventType, error) {
+	eventTypes := map[event.ResourceKind]map[event.ResourceOperation]v1.EventType{
+		event.ResourceKindTask: {
+			event.ResourceOperationCreated: v1.EventType_EVENT_TYPE_TASK_CREATED,
+			event.ResourceOperationUpdated: v1.EventType_EVENT_TYPE_TASK_UPDATED,
+			event.ResourceOperationDeleted: v1.EventType_EVENT_TYPE_TASK_DELETED,
+		},
+		event.ResourceKindAgent: {
+			event.ResourceOperationCreated: v1.EventType_EVENT_TYPE_AGENT_CREATED,
+			event.ResourceOperationUpdated: v1.EventType_EVENT_TYPE_AGENT_UPDATED,
+			event.ResourceOperationDeleted: v1.EventType_EVENT_TYPE_AGENT_DELETED,
+		},
+		event.ResourceKindModel: {
+			event.ResourceOperationCreated: v1.EventType_EVENT_TYPE_MODEL_CREATED,
+			event.ResourceOperationUpdated: v1.EventType_EVENT_TYPE_MODEL_UPDATED,
+			event.ResourceOperationDeleted: v1.EventType_EVENT_TYPE_MODEL_DELETED,
+		},
+	}
+
+	eventType, ok := eventTypes[kind][operation]
+	if !ok {
+		return v1.EventType_EVENT_TYPE_UNSPECIFIED, fmt.Errorf("unsupported resource change: %s %s", kind, operation)
+	}
+	return eventType, nil
+}
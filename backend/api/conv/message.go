@@ -34,6 +34,7 @@ func ConvertMemoryMessageToProto(m *memory.Message) (*v1.Message, error) {
 					},
 				},
 			},
+			Delivery: ConvertMessageDeliveryToProto(m.Source, m.Delivery),
 		},
 		Status: &v1.MessageStatus{
 			Usage: convertUsage(m.Usage),
@@ -52,6 +53,30 @@ func convertRole(role types.MessageSource) v1.MessageRole {
 	}
 }
 
+// ConvertMessageDeliveryToProto returns the delivery mode of a user message. Delivery
+// only applies to user messages and is left unspecified for all other sources.
+func ConvertMessageDeliveryToProto(source types.MessageSource, delivery types.MessageDelivery) v1.MessageDelivery {
+	if source != types.MessageSourceUser {
+		return v1.MessageDelivery_MESSAGE_DELIVERY_UNSPECIFIED
+	}
+
+	switch delivery {
+	case types.MessageDeliverySteer:
+		return v1.MessageDelivery_MESSAGE_DELIVERY_STEER
+	default:
+		return v1.MessageDelivery_MESSAGE_DELIVERY_QUEUE
+	}
+}
+
+func ConvertProtoMessageDeliveryToMemory(delivery v1.MessageDelivery) types.MessageDelivery {
+	switch delivery {
+	case v1.MessageDelivery_MESSAGE_DELIVERY_STEER:
+		return types.MessageDeliverySteer
+	default:
+		return types.MessageDeliveryQueue
+	}
+}
+
 func convertUsage(usage *types.MessageUsage) *v1.MessageUsage {
 	if usage == nil {
 		return nil
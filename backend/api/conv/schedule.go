@@ -0,0 +1,83 @@
+package conv
+
+import (
+	"time"
+
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ConvertScheduleToProto(s *memory.Schedule) (*v1.Schedule, error) {
+	return &v1.Schedule{
+		Metadata: ConvertScheduleMetadataToProto(s),
+		Spec:     ConvertScheduleSpecToProto(s),
+		Status:   ConvertScheduleStatusToProto(s),
+	}, nil
+}
+
+func ConvertScheduleMetadataToProto(s *memory.Schedule) *v1.ScheduleMetadata {
+	return &v1.ScheduleMetadata{
+		Id:        s.ID.String(),
+		CreatedAt: ConvertTimeToTimestamp(s.CreateTime),
+		UpdatedAt: ConvertTimeToTimestamp(s.UpdateTime),
+	}
+}
+
+func ConvertScheduleSpecToProto(s *memory.Schedule) *v1.ScheduleSpec {
+	return &v1.ScheduleSpec{
+		Name:           s.Name,
+		CronExpression: s.CronExpression,
+		AgentId:        ConvertUUIDToString(s.AgentID),
+		Workspace:      s.ProjectDirectory,
+		Prompt:         s.Prompt,
+		MaxCost:        s.MaxCost,
+		Enabled:        s.Enabled,
+	}
+}
+
+func ConvertScheduleStatusToProto(s *memory.Schedule) *v1.ScheduleStatus {
+	return &v1.ScheduleStatus{
+		LastRunAt:    optionalTimestamp(s.LastRunTime),
+		NextRunAt:    optionalTimestamp(s.NextRunTime),
+		LastTaskId:   ConvertUUIDPtrToStringPtr(s.LastTaskID),
+		RunCount:     s.RunCount,
+		SkippedCount: s.SkippedCount,
+	}
+}
+
+func ConvertScheduleRunToProto(r *memory.ScheduleRun) *v1.ScheduleRun {
+	run := &v1.ScheduleRun{
+		Id:          r.ID.String(),
+		ScheduleId:  r.ScheduleID.String(),
+		Status:      ConvertScheduleRunStatusToProto(r.Status),
+		ScheduledAt: ConvertTimeToTimestamp(r.ScheduledTime),
+		RunAt:       ConvertTimeToTimestamp(r.RunTime),
+		TaskId:      ConvertUUIDPtrToStringPtr(r.TaskID),
+	}
+	if r.Reason != "" {
+		run.Reason = strPtr(r.Reason)
+	}
+	return run
+}
+
+func ConvertScheduleRunStatusToProto(s types.ScheduleRunStatus) v1.ScheduleRunStatus {
+	switch s {
+	case types.ScheduleRunStatusStarted:
+		return v1.ScheduleRunStatus_SCHEDULE_RUN_STATUS_STARTED
+	case types.ScheduleRunStatusSkipped:
+		return v1.ScheduleRunStatus_SCHEDULE_RUN_STATUS_SKIPPED
+	case types.ScheduleRunStatusFailed:
+		return v1.ScheduleRunStatus_SCHEDULE_RUN_STATUS_FAILED
+	default:
+		return v1.ScheduleRunStatus_SCHEDULE_RUN_STATUS_UNSPECIFIED
+	}
+}
+
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return ConvertTimeToTimestamp(t)
+}
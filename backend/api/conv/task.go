@@ -29,10 +29,15 @@ func ConvertTaskMetadataToProto(t *memory.Task) *v1.TaskMetadata {
 
 func ConvertTaskSpecToProto(t *memory.Task) (*v1.TaskSpec, error) {
 	return &v1.TaskSpec{
-		AgentId:      strPtr(t.AgentID.String()),
-		Workspace:    t.ProjectDirectory,
-		DesiredPhase: ConvertTaskPhaseToProto(t.DesiredPhase),
-		Description:  t.Description,
+		AgentId:         strPtr(t.AgentID.String()),
+		Workspace:       t.ProjectDirectory,
+		DesiredPhase:    ConvertTaskPhaseToProto(t.DesiredPhase),
+		Description:     t.Description,
+		ScheduleId:      ConvertUUIDPtrToStringPtr(t.ScheduleID),
+		MaxCost:         t.MaxCost,
+		ParentTaskId:    ConvertUUIDPtrToStringPtr(t.ParentID),
+		PersistentState: t.PersistentState,
+		Preview:         t.Preview != nil,
 	}, nil
 }
 
@@ -47,8 +52,9 @@ func ConvertTaskStatusToProto(t *memory.Task) *v1.TaskStatus {
 	}
 
 	return &v1.TaskStatus{
-		Usage: usage,
-		Phase: ConvertTaskPhaseToProto(t.Phase),
+		Usage:            usage,
+		Phase:            ConvertTaskPhaseToProto(t.Phase),
+		InstructionFiles: t.InstructionFiles,
 	}
 }
 
@@ -0,0 +1,49 @@
+package conv
+
+import (
+	"encoding/json"
+	"fmt"
+
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+)
+
+// ConvertToolToProto converts a custom tool to its API representation. The
+// headers of HTTP tools are write-only and omitted.
+func ConvertToolToProto(t *memory.Tool) (*v1.Tool, error) {
+	spec := &v1.ToolSpec{
+		Name:           t.Name,
+		Description:    t.Description,
+		TimeoutSeconds: int32(t.TimeoutSeconds),
+		Enabled:        t.Enabled,
+	}
+
+	if t.InputSchema != nil {
+		schema, err := json.Marshal(t.InputSchema)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode input schema of tool %s: %w", t.Name, err)
+		}
+		spec.InputSchema = string(schema)
+	}
+
+	switch t.Kind {
+	case types.ToolKindCommand:
+		spec.Backend = &v1.ToolSpec_Command{Command: &v1.CommandBackend{Command: t.Command}}
+	case types.ToolKindHTTP:
+		spec.Backend = &v1.ToolSpec_Http{Http: &v1.HttpBackend{Url: t.URL}}
+	case types.ToolKindJavaScript:
+		spec.Backend = &v1.ToolSpec_Javascript{Javascript: &v1.JavaScriptBackend{Source: t.Source}}
+	default:
+		return nil, fmt.Errorf("unsupported tool kind: %s", t.Kind)
+	}
+
+	return &v1.Tool{
+		Metadata: &v1.ToolMetadata{
+			Id:        t.ID.String(),
+			CreatedAt: ConvertTimeToTimestamp(t.CreateTime),
+			UpdatedAt: ConvertTimeToTimestamp(t.UpdateTime),
+		},
+		Spec: spec,
+	}, nil
+}
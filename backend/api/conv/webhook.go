@@ -0,0 +1,84 @@
+package conv
+
+import (
+	"fmt"
+	"slices"
+
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+)
+
+func ConvertWebhookToProto(w *memory.Webhook) (*v1.Webhook, error) {
+	events := make([]v1.WebhookEvent, 0, len(w.Events))
+	for _, e := range w.Events {
+		events = append(events, ConvertWebhookEventToProto(e))
+	}
+
+	return &v1.Webhook{
+		Metadata: &v1.WebhookMetadata{
+			Id:        w.ID.String(),
+			CreatedAt: ConvertTimeToTimestamp(w.CreateTime),
+			UpdatedAt: ConvertTimeToTimestamp(w.UpdateTime),
+		},
+		Spec: &v1.WebhookSpec{
+			Name:          w.Name,
+			Url:           w.URL,
+			Events:        events,
+			CostThreshold: w.CostThreshold,
+			Enabled:       w.Enabled,
+		},
+	}, nil
+}
+
+func ConvertWebhookDeadLetterToProto(d *memory.WebhookDeadLetter) *v1.WebhookDeadLetter {
+	return &v1.WebhookDeadLetter{
+		Id:        d.ID.String(),
+		WebhookId: d.WebhookID.String(),
+		TaskId:    ConvertUUIDToString(d.TaskID),
+		Event:     ConvertWebhookEventToProto(d.EventType),
+		Payload:   d.Payload,
+		Attempts:  int32(d.Attempts),
+		LastError: d.LastError,
+		CreatedAt: ConvertTimeToTimestamp(d.CreateTime),
+	}
+}
+
+func ConvertWebhookEventToProto(e types.WebhookEventType) v1.WebhookEvent {
+	switch e {
+	case types.WebhookEventTaskPhaseChanged:
+		return v1.WebhookEvent_WEBHOOK_EVENT_TASK_PHASE_CHANGED
+	case types.WebhookEventTaskResponse:
+		return v1.WebhookEvent_WEBHOOK_EVENT_TASK_RESPONSE
+	case types.WebhookEventTaskError:
+		return v1.WebhookEvent_WEBHOOK_EVENT_TASK_ERROR
+	case types.WebhookEventTaskCostThreshold:
+		return v1.WebhookEvent_WEBHOOK_EVENT_TASK_COST_THRESHOLD
+	default:
+		return v1.WebhookEvent_WEBHOOK_EVENT_UNSPECIFIED
+	}
+}
+
+func ConvertProtoWebhookEventsToMemory(events []v1.WebhookEvent) ([]types.WebhookEventType, error) {
+	result := make([]types.WebhookEventType, 0, len(events))
+	for _, e := range events {
+		var converted types.WebhookEventType
+		switch e {
+		case v1.WebhookEvent_WEBHOOK_EVENT_TASK_PHASE_CHANGED:
+			converted = types.WebhookEventTaskPhaseChanged
+		case v1.WebhookEvent_WEBHOOK_EVENT_TASK_RESPONSE:
+			converted = types.WebhookEventTaskResponse
+		case v1.WebhookEvent_WEBHOOK_EVENT_TASK_ERROR:
+			converted = types.WebhookEventTaskError
+		case v1.WebhookEvent_WEBHOOK_EVENT_TASK_COST_THRESHOLD:
+			converted = types.WebhookEventTaskCostThreshold
+		default:
+			return nil, fmt.Errorf("unsupported webhook event: %s", e)
+		}
+
+		if !slices.Contains(result, converted) {
+			result = append(result, converted)
+		}
+	}
+	return result, nil
+}
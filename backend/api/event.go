@@ -0,0 +1,152 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/api/go/v1/v1connect"
+	"github.com/furisto/construct/backend/api/conv"
+	"github.com/furisto/construct/backend/auth"
+	"github.com/furisto/construct/backend/event"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/google/uuid"
+)
+
+var _ v1connect.EventServiceHandler = (*EventHandler)(nil)
+
+func NewEventHandler(db *memory.Client, journal *event.Journal) *EventHandler {
+	return &EventHandler{
+		db:      db,
+		journal: journal,
+	}
+}
+
+type EventHandler struct {
+	db      *memory.Client
+	journal *event.Journal
+	v1connect.UnimplementedEventServiceHandler
+}
+
+func (h *EventHandler) WatchEvents(ctx context.Context, req *connect.Request[v1.WatchEventsRequest], stream *connect.ServerStream[v1.WatchEventsResponse]) error {
+	filter := req.Msg.GetFilter()
+	for _, eventType := range filter.GetTypes() {
+		if eventType == v1.EventType_EVENT_TYPE_UNSPECIFIED {
+			return apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported event type: %s", eventType)))
+		}
+	}
+
+	if filter != nil && filter.TaskId != nil {
+		taskID, err := uuid.Parse(filter.GetTaskId())
+		if err != nil {
+			return apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid task ID format: %w", err)))
+		}
+		normalized := taskID.String()
+		filter.TaskId = &normalized
+	}
+
+	entries, err := h.journal.Watch(ctx, req.Msg.GetCursor())
+	if err != nil {
+		return apiError(convertJournalError(err))
+	}
+
+	visible := h.taskVisibility(ctx)
+
+	// send the response headers right away, otherwise clients block until the
+	// first event is published
+	if err := stream.Send(nil); err != nil {
+		return err
+	}
+
+	for entry, err := range entries {
+		if err != nil {
+			return apiError(convertJournalError(err))
+		}
+
+		watchEvent, err := conv.ConvertJournalEntryToProto(entry)
+		if err != nil {
+			return apiError(err)
+		}
+
+		if !matchesWatchFilter(watchEvent, filter) {
+			continue
+		}
+
+		if watchEvent.TaskId != nil && !visible(watchEvent.GetTaskId()) {
+			continue
+		}
+
+		if err := stream.Send(&v1.WatchEventsResponse{Event: watchEvent}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// taskVisibility returns a function that reports whether events of a task may
+// be sent to the caller. Ownership is looked up once per task and remembered
+// for the lifetime of the stream, so that the deletion of a task that was
+// visible is still delivered.
+func (h *EventHandler) taskVisibility(ctx context.Context) func(taskID string) bool {
+	principal := auth.PrincipalFromContext(ctx)
+	if principal.CanReadAll() {
+		return func(string) bool { return true }
+	}
+
+	known := make(map[string]bool)
+	return func(taskID string) bool {
+		if visible, ok := known[taskID]; ok {
+			return visible
+		}
+
+		id, err := uuid.Parse(taskID)
+		if err != nil {
+			return false
+		}
+
+		t, err := h.db.Task.Get(ctx, id)
+		if err != nil {
+			if !memory.IsNotFound(err) {
+				slog.Warn("failed to look up task owner", "task_id", taskID, "error", err)
+			}
+			return false
+		}
+
+		known[taskID] = principal.Owns(t.OwnerID)
+		return known[taskID]
+	}
+}
+
+func matchesWatchFilter(watchEvent *v1.WatchEvent, filter *v1.WatchEventsRequest_Filter) bool {
+	if filter == nil {
+		return true
+	}
+
+	if len(filter.Types) > 0 && !slices.Contains(filter.Types, watchEvent.Type) {
+		return false
+	}
+
+	if filter.TaskId != nil && watchEvent.GetTaskId() != filter.GetTaskId() {
+		return false
+	}
+
+	return true
+}
+
+func convertJournalError(err error) error {
+	switch {
+	case errors.Is(err, event.ErrInvalidCursor):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, event.ErrCursorExpired):
+		return connect.NewError(connect.CodeOutOfRange, err)
+	case errors.Is(err, event.ErrWatcherLagged):
+		return connect.NewError(connect.CodeResourceExhausted, err)
+	default:
+		return err
+	}
+}
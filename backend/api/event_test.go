@@ -0,0 +1,225 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/memory/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/testing/protocmp"
+	_ "modernc.org/sqlite"
+)
+
+func TestWatchEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server, client := startEventTestServer(t, ctx)
+	db := server.Options.DB
+
+	modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+	model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+	agent := test.NewAgentBuilder(t, uuid.New(), db, model).Build(ctx)
+
+	stream := watchEvents(t, ctx, server, client, &v1.WatchEventsRequest{
+		Filter: &v1.WatchEventsRequest_Filter{
+			Types: []v1.EventType{
+				v1.EventType_EVENT_TYPE_TASK_CREATED,
+				v1.EventType_EVENT_TYPE_TASK_DELETED,
+				v1.EventType_EVENT_TYPE_MESSAGE_COMPLETED,
+			},
+		},
+	})
+
+	created, err := client.Task().CreateTask(ctx, connect.NewRequest(&v1.CreateTaskRequest{
+		AgentId:          agent.ID.String(),
+		ProjectDirectory: "/tmp/test",
+	}))
+	if err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+	taskID := created.Msg.Task.Metadata.Id
+
+	createdEvent := receiveWatchEvent(t, stream)
+	expectWatchEvent(t, createdEvent, &v1.WatchEvent{
+		Type:       v1.EventType_EVENT_TYPE_TASK_CREATED,
+		ResourceId: taskID,
+		TaskId:     &taskID,
+	})
+
+	message, err := client.Message().CreateMessage(ctx, connect.NewRequest(&v1.CreateMessageRequest{
+		TaskId: taskID,
+		Content: []*v1.MessagePart{
+			{
+				Data: &v1.MessagePart_Text_{
+					Text: &v1.MessagePart_Text{Content: "Fix the failing build"},
+				},
+			},
+		},
+	}))
+	if err != nil {
+		t.Fatalf("failed to create message: %v", err)
+	}
+
+	expectWatchEvent(t, receiveWatchEvent(t, stream), &v1.WatchEvent{
+		Type:       v1.EventType_EVENT_TYPE_MESSAGE_COMPLETED,
+		ResourceId: message.Msg.Message.Metadata.Id,
+		TaskId:     &taskID,
+		Details: &v1.WatchEvent_Message{
+			Message: &v1.MessageCompletion{Role: v1.MessageRole_MESSAGE_ROLE_USER},
+		},
+	})
+
+	_, err = client.Task().DeleteTask(ctx, connect.NewRequest(&v1.DeleteTaskRequest{Id: taskID}))
+	if err != nil {
+		t.Fatalf("failed to delete task: %v", err)
+	}
+
+	deletedEvent := &v1.WatchEvent{
+		Type:       v1.EventType_EVENT_TYPE_TASK_DELETED,
+		ResourceId: taskID,
+		TaskId:     &taskID,
+	}
+	expectWatchEvent(t, receiveWatchEvent(t, stream), deletedEvent)
+
+	t.Run("resume from cursor", func(t *testing.T) {
+		resumed, err := client.Event().WatchEvents(ctx, connect.NewRequest(&v1.WatchEventsRequest{
+			Filter: &v1.WatchEventsRequest_Filter{
+				TaskId: &taskID,
+				Types:  []v1.EventType{v1.EventType_EVENT_TYPE_TASK_DELETED},
+			},
+			Cursor: &createdEvent.Cursor,
+		}))
+		if err != nil {
+			t.Fatalf("failed to watch events: %v", err)
+		}
+		defer resumed.Close()
+
+		expectWatchEvent(t, receiveWatchEvent(t, resumed), deletedEvent)
+	})
+}
+
+func TestWatchEventsInvalidRequest(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, client := startEventTestServer(t, ctx)
+
+	tests := []struct {
+		name    string
+		request *v1.WatchEventsRequest
+		error   string
+	}{
+		{
+			name: "invalid task ID",
+			request: &v1.WatchEventsRequest{
+				Filter: &v1.WatchEventsRequest_Filter{TaskId: strPtr("not-a-valid-uuid")},
+			},
+			error: "invalid_argument: invalid task ID format: invalid UUID length: 16",
+		},
+		{
+			name: "unspecified event type",
+			request: &v1.WatchEventsRequest{
+				Filter: &v1.WatchEventsRequest_Filter{
+					Types: []v1.EventType{v1.EventType_EVENT_TYPE_UNSPECIFIED},
+				},
+			},
+			error: "invalid_argument: unsupported event type: EVENT_TYPE_UNSPECIFIED",
+		},
+		{
+			name:    "malformed cursor",
+			request: &v1.WatchEventsRequest{Cursor: strPtr("not-a-cursor")},
+			error:   "invalid_argument: invalid cursor",
+		},
+		{
+			name:    "cursor of a previous daemon",
+			request: &v1.WatchEventsRequest{Cursor: strPtr("previous-1")},
+			error:   "out_of_range: cursor expired",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream, err := client.Event().WatchEvents(ctx, connect.NewRequest(tt.request))
+			if err != nil {
+				t.Fatalf("failed to watch events: %v", err)
+			}
+			defer stream.Close()
+
+			if stream.Receive() {
+				t.Fatalf("expected stream to fail, got %v", stream.Msg())
+			}
+			if diff := cmp.Diff(tt.error, stream.Err().Error()); diff != "" {
+				t.Errorf("error mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func startEventTestServer(t *testing.T, ctx context.Context) (*TestServer, *api_client.Client) {
+	server := NewTestServer(t, DefaultTestHandlerOptions(t))
+	server.Start(ctx)
+	t.Cleanup(server.Close)
+
+	client, err := api_client.NewClient(api_client.EndpointContext{
+		Address: server.API.URL,
+		Kind:    "http",
+	})
+	if err != nil {
+		t.Fatalf("failed to create api client: %v", err)
+	}
+
+	return server, client
+}
+
+// watchEvents opens an event stream and waits until the server is watching the
+// journal, so that no event published afterwards is missed.
+func watchEvents(t *testing.T, ctx context.Context, server *TestServer, client *api_client.Client, req *v1.WatchEventsRequest) *connect.ServerStreamForClient[v1.WatchEventsResponse] {
+	stream, err := client.Event().WatchEvents(ctx, connect.NewRequest(req))
+	if err != nil {
+		t.Fatalf("failed to watch events: %v", err)
+	}
+	t.Cleanup(func() { stream.Close() })
+
+	deadline := time.Now().Add(time.Second)
+	for server.Options.EventJournal.WatcherCount() == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("server did not start watching events")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	return stream
+}
+
+func receiveWatchEvent(t *testing.T, stream *connect.ServerStreamForClient[v1.WatchEventsResponse]) *v1.WatchEvent {
+	t.Helper()
+
+	if !stream.Receive() {
+		t.Fatalf("expected event, stream ended: %v", stream.Err())
+	}
+	return stream.Msg().Event
+}
+
+func expectWatchEvent(t *testing.T, actual, expected *v1.WatchEvent) {
+	t.Helper()
+
+	if actual.Cursor == "" || actual.Time == nil {
+		t.Errorf("expected event to have a cursor and time: %v", actual)
+	}
+
+	diff := cmp.Diff(expected, actual,
+		cmpopts.IgnoreUnexported(v1.WatchEvent{}, v1.MessageCompletion{}),
+		protocmp.Transform(),
+		protocmp.IgnoreFields(&v1.WatchEvent{}, "cursor", "time"),
+	)
+	if diff != "" {
+		t.Errorf("event mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -47,6 +47,10 @@ func (h *MessageHandler) CreateMessage(ctx context.Context, req *connect.Request
 			return nil, err
 		}
 
+		if err := authorizeTaskWrite(ctx, task); err != nil {
+			return nil, err
+		}
+
 		if task.DesiredPhase == types.TaskPhaseSuspended {
 			_, err = tx.Task.UpdateOneID(taskID).SetDesiredPhase(types.TaskPhaseRunning).Save(ctx)
 			if err != nil {
@@ -58,6 +62,7 @@ func (h *MessageHandler) CreateMessage(ctx context.Context, req *connect.Request
 			SetTask(task).
 			SetContent(conv.ConvertProtoContentToMemory(req.Msg.Content)).
 			SetSource(types.MessageSourceUser).
+			SetDelivery(conv.ConvertProtoMessageDeliveryToMemory(req.Msg.Delivery)).
 			Save(ctx)
 	})
 
@@ -70,9 +75,21 @@ func (h *MessageHandler) CreateMessage(ctx context.Context, req *connect.Request
 		return nil, apiError(err)
 	}
 
+	if msg.Delivery == types.MessageDeliverySteer {
+		event.Publish(h.eventBus, event.TaskInterruptedEvent{
+			TaskID:   taskID,
+			IssuedAt: msg.CreateTime,
+		})
+	}
+
 	event.Publish(h.eventBus, event.TaskEvent{
 		TaskID: taskID,
 	})
+	event.Publish(h.eventBus, event.MessageCompletedEvent{
+		TaskID:    taskID,
+		MessageID: msg.ID,
+		Source:    msg.Source,
+	})
 
 	return connect.NewResponse(&v1.CreateMessageResponse{
 		Message: protoMsg,
@@ -87,6 +104,7 @@ func (h *MessageHandler) GetMessage(ctx context.Context, req *connect.Request[v1
 
 	msg, err := h.db.Message.Query().
 		Where(message.ID(id)).
+		Where(readableMessages(ctx)...).
 		First(ctx)
 
 	if err != nil {
@@ -104,7 +122,9 @@ func (h *MessageHandler) GetMessage(ctx context.Context, req *connect.Request[v1
 }
 
 func (h *MessageHandler) ListMessages(ctx context.Context, req *connect.Request[v1.ListMessagesRequest]) (*connect.Response[v1.ListMessagesResponse], error) {
-	query := h.db.Message.Query().WithTask()
+	query := h.db.Message.Query().
+		Where(readableMessages(ctx)...).
+		WithTask()
 
 	if req.Msg.Filter != nil {
 		if req.Msg.Filter.TaskIds != nil {
@@ -160,6 +180,10 @@ func (h *MessageHandler) UpdateMessage(ctx context.Context, req *connect.Request
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ID format: %w", err)))
 	}
 
+	if err := h.authorizeMessageWrite(ctx, id); err != nil {
+		return nil, apiError(err)
+	}
+
 	msg, err := h.db.Message.UpdateOneID(id).
 		SetContent(conv.ConvertProtoContentToMemory(req.Msg.Content)).
 		Save(ctx)
@@ -183,6 +207,10 @@ func (h *MessageHandler) DeleteMessage(ctx context.Context, req *connect.Request
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ID format: %w", err)))
 	}
 
+	if err := h.authorizeMessageWrite(ctx, id); err != nil {
+		return nil, apiError(err)
+	}
+
 	err = h.db.Message.DeleteOneID(id).Exec(ctx)
 	if err != nil {
 		return nil, apiError(err)
@@ -190,3 +218,15 @@ func (h *MessageHandler) DeleteMessage(ctx context.Context, req *connect.Request
 
 	return connect.NewResponse(&v1.DeleteMessageResponse{}), nil
 }
+
+func (h *MessageHandler) authorizeMessageWrite(ctx context.Context, id uuid.UUID) error {
+	msg, err := h.db.Message.Query().Where(message.ID(id)).WithTask().Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	if msg.Edges.Task == nil {
+		return requireAdmin(ctx, "modify messages without a task")
+	}
+	return authorizeTaskWrite(ctx, msg.Edges.Task)
+}
@@ -107,6 +107,54 @@ func TestCreateMessage(t *testing.T) {
 									},
 								},
 							},
+							Delivery: v1.MessageDelivery_MESSAGE_DELIVERY_QUEUE,
+						},
+						Status: &v1.MessageStatus{},
+					},
+				},
+			},
+		},
+		{
+			Name: "steer running task",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+
+				agent := test.NewAgentBuilder(t, uuid.New(), db, model).Build(ctx)
+
+				test.NewTaskBuilder(t, taskID, db, agent).Build(ctx)
+			},
+			Request: &v1.CreateMessageRequest{
+				TaskId: taskID.String(),
+				Content: []*v1.MessagePart{
+					{
+						Data: &v1.MessagePart_Text_{
+							Text: &v1.MessagePart_Text{
+								Content: "Stop and use the new API instead",
+							},
+						},
+					},
+				},
+				Delivery: v1.MessageDelivery_MESSAGE_DELIVERY_STEER,
+			},
+			Expected: ServiceTestExpectation[v1.CreateMessageResponse]{
+				Response: v1.CreateMessageResponse{
+					Message: &v1.Message{
+						Metadata: &v1.MessageMetadata{
+							TaskId: taskID.String(),
+							Role:   v1.MessageRole_MESSAGE_ROLE_USER,
+						},
+						Spec: &v1.MessageSpec{
+							Content: []*v1.MessagePart{
+								{
+									Data: &v1.MessagePart_Text_{
+										Text: &v1.MessagePart_Text{
+											Content: "Stop and use the new API instead",
+										},
+									},
+								},
+							},
+							Delivery: v1.MessageDelivery_MESSAGE_DELIVERY_STEER,
 						},
 						Status: &v1.MessageStatus{},
 					},
@@ -504,6 +552,7 @@ func TestListMessages(t *testing.T) {
 										},
 									},
 								},
+								Delivery: v1.MessageDelivery_MESSAGE_DELIVERY_QUEUE,
 							},
 							Status: &v1.MessageStatus{},
 						},
@@ -638,6 +687,7 @@ func TestUpdateMessage(t *testing.T) {
 									},
 								},
 							},
+							Delivery: v1.MessageDelivery_MESSAGE_DELIVERY_QUEUE,
 						},
 						Status: &v1.MessageStatus{},
 					},
@@ -8,6 +8,7 @@ import (
 	v1 "github.com/furisto/construct/api/go/v1"
 	"github.com/furisto/construct/api/go/v1/v1connect"
 	"github.com/furisto/construct/backend/api/conv"
+	"github.com/furisto/construct/backend/event"
 	"github.com/furisto/construct/backend/memory"
 	"github.com/furisto/construct/backend/memory/model"
 	"github.com/furisto/construct/backend/memory/schema/types"
@@ -15,19 +16,25 @@ import (
 )
 
 type ModelHandler struct {
-	db *memory.Client
+	db       *memory.Client
+	eventBus *event.Bus
 	v1connect.UnimplementedModelServiceHandler
 }
 
-func NewModelHandler(db *memory.Client) *ModelHandler {
+func NewModelHandler(db *memory.Client, eventBus *event.Bus) *ModelHandler {
 	return &ModelHandler{
-		db: db,
+		db:       db,
+		eventBus: eventBus,
 	}
 }
 
 var _ v1connect.ModelServiceHandler = (*ModelHandler)(nil)
 
 func (h *ModelHandler) CreateModel(ctx context.Context, req *connect.Request[v1.CreateModelRequest]) (*connect.Response[v1.CreateModelResponse], error) {
+	if err := requireAdmin(ctx, "create models"); err != nil {
+		return nil, apiError(err)
+	}
+
 	modelProviderID, err := uuid.Parse(req.Msg.ModelProviderId)
 	if err != nil {
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid model provider ID format: %w", err)))
@@ -86,6 +93,12 @@ func (h *ModelHandler) CreateModel(ctx context.Context, req *connect.Request[v1.
 		return nil, apiError(err)
 	}
 
+	event.Publish(h.eventBus, event.ResourceChangedEvent{
+		Kind:      event.ResourceKindModel,
+		Operation: event.ResourceOperationCreated,
+		ID:        model.ID,
+	})
+
 	return connect.NewResponse(&v1.CreateModelResponse{
 		Model: protoModel,
 	}), nil
@@ -153,6 +166,10 @@ func (h *ModelHandler) ListModels(ctx context.Context, req *connect.Request[v1.L
 }
 
 func (h *ModelHandler) UpdateModel(ctx context.Context, req *connect.Request[v1.UpdateModelRequest]) (*connect.Response[v1.UpdateModelResponse], error) {
+	if err := requireAdmin(ctx, "update models"); err != nil {
+		return nil, apiError(err)
+	}
+
 	id, err := uuid.Parse(req.Msg.Id)
 	if err != nil {
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ID format: %w", err)))
@@ -223,12 +240,22 @@ func (h *ModelHandler) UpdateModel(ctx context.Context, req *connect.Request[v1.
 		return nil, apiError(err)
 	}
 
+	event.Publish(h.eventBus, event.ResourceChangedEvent{
+		Kind:      event.ResourceKindModel,
+		Operation: event.ResourceOperationUpdated,
+		ID:        model.ID,
+	})
+
 	return connect.NewResponse(&v1.UpdateModelResponse{
 		Model: protoModel,
 	}), nil
 }
 
 func (h *ModelHandler) DeleteModel(ctx context.Context, req *connect.Request[v1.DeleteModelRequest]) (*connect.Response[v1.DeleteModelResponse], error) {
+	if err := requireAdmin(ctx, "delete models"); err != nil {
+		return nil, apiError(err)
+	}
+
 	id, err := uuid.Parse(req.Msg.Id)
 	if err != nil {
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ID format: %w", err)))
@@ -239,5 +266,11 @@ func (h *ModelHandler) DeleteModel(ctx context.Context, req *connect.Request[v1.
 		return nil, apiError(err)
 	}
 
+	event.Publish(h.eventBus, event.ResourceChangedEvent{
+		Kind:      event.ResourceKindModel,
+		Operation: event.ResourceOperationDeleted,
+		ID:        id,
+	})
+
 	return connect.NewResponse(&v1.DeleteModelResponse{}), nil
 }
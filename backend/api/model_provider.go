@@ -36,6 +36,10 @@ type ModelProviderHandler struct {
 }
 
 func (h *ModelProviderHandler) CreateModelProvider(ctx context.Context, req *connect.Request[v1.CreateModelProviderRequest]) (*connect.Response[v1.CreateModelProviderResponse], error) {
+	if err := requireAdmin(ctx, "create model providers"); err != nil {
+		return nil, apiError(err)
+	}
+
 	providerType, err := conv.ConvertModelProviderTypeToMemory(req.Msg.ProviderType)
 	if err != nil {
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
@@ -188,6 +192,10 @@ func (h *ModelProviderHandler) ListModelProviders(ctx context.Context, req *conn
 }
 
 func (h *ModelProviderHandler) UpdateModelProvider(ctx context.Context, req *connect.Request[v1.UpdateModelProviderRequest]) (*connect.Response[v1.UpdateModelProviderResponse], error) {
+	if err := requireAdmin(ctx, "update model providers"); err != nil {
+		return nil, apiError(err)
+	}
+
 	id, err := uuid.Parse(req.Msg.Id)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ID format: %w", err))
@@ -241,6 +249,10 @@ func (h *ModelProviderHandler) UpdateModelProvider(ctx context.Context, req *con
 }
 
 func (h *ModelProviderHandler) DeleteModelProvider(ctx context.Context, req *connect.Request[v1.DeleteModelProviderRequest]) (*connect.Response[v1.DeleteModelProviderResponse], error) {
+	if err := requireAdmin(ctx, "delete model providers"); err != nil {
+		return nil, apiError(err)
+	}
+
 	id, err := uuid.Parse(req.Msg.Id)
 	if err != nil {
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ID format: %w", err)))
@@ -301,20 +313,22 @@ func createBuiltinAgents(ctx context.Context, tx *memory.Client, modelProvider *
 		return fmt.Errorf("failed to get plan model: %w", err)
 	}
 
-	err = createBuiltinAgent(ctx, tx, uuid.MustParse("00000001-0000-0000-0000-000000000001"), "edit", prompt.Edit, "Implements code changes from plans or direct requests", defaultModel.ID)
+	err = createBuiltinAgent(ctx, tx, uuid.MustParse("00000001-0000-0000-0000-000000000001"), "edit", prompt.Edit, "Implements code changes from plans or direct requests", defaultModel.ID, nil)
 	if err != nil {
 		return err
 	}
 
-	err = createBuiltinAgent(ctx, tx, uuid.MustParse("00000001-0000-0000-0000-000000000002"), "quick", prompt.Edit, "Fast, targeted edits for simple code changes", budgetModel.ID)
+	err = createBuiltinAgent(ctx, tx, uuid.MustParse("00000001-0000-0000-0000-000000000002"), "quick", prompt.Edit, "Fast, targeted edits for simple code changes", budgetModel.ID, nil)
 	if err != nil {
 		return err
 	}
 
-	return createBuiltinAgent(ctx, tx, uuid.MustParse("00000001-0000-0000-0000-000000000003"), "plan", prompt.Plan, "Analyzes requirements and creates detailed implementation plans", planModel.ID)
+	// the plan agent only describes changes, the edit agent applies them
+	planTools := &types.ToolSet{ReadOnly: true}
+	return createBuiltinAgent(ctx, tx, uuid.MustParse("00000001-0000-0000-0000-000000000003"), "plan", prompt.Plan, "Analyzes requirements and creates detailed implementation plans", planModel.ID, planTools)
 }
 
-func createBuiltinAgent(ctx context.Context, tx *memory.Client, agentID uuid.UUID, name string, instructions string, description string, modelID uuid.UUID) error {
+func createBuiltinAgent(ctx context.Context, tx *memory.Client, agentID uuid.UUID, name string, instructions string, description string, modelID uuid.UUID, toolSet *types.ToolSet) error {
 	_, err := tx.Agent.Get(ctx, agentID)
 	if err != nil && !memory.IsNotFound(err) {
 		return fmt.Errorf("failed to retrieve %s agent: %w", name, err)
@@ -331,6 +345,10 @@ func createBuiltinAgent(ctx context.Context, tx *memory.Client, agentID uuid.UUI
 		create = create.SetDescription(description)
 	}
 
+	if toolSet != nil {
+		create = create.SetToolSet(toolSet)
+	}
+
 	_, err = create.Save(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to create agent: %w", err)
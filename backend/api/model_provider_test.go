@@ -90,6 +90,7 @@ func TestCreateModelProvider(t *testing.T) {
 						{
 							Name:    "plan",
 							Builtin: true,
+							ToolSet: &types.ToolSet{ReadOnly: true},
 						},
 					},
 				},
@@ -0,0 +1,318 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"text/template"
+	"time"
+
+	"connectrpc.com/connect"
+	"entgo.io/ent/dialect/sql"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/api/go/v1/v1connect"
+	"github.com/furisto/construct/backend/api/conv"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schedule"
+	"github.com/furisto/construct/backend/memory/schedulerun"
+	"github.com/google/uuid"
+	"github.com/robfig/cron/v3"
+)
+
+var _ v1connect.ScheduleServiceHandler = (*ScheduleHandler)(nil)
+
+func NewScheduleHandler(db *memory.Client) *ScheduleHandler {
+	return &ScheduleHandler{
+		db: db,
+	}
+}
+
+type ScheduleHandler struct {
+	db *memory.Client
+	v1connect.UnimplementedScheduleServiceHandler
+}
+
+func (h *ScheduleHandler) CreateSchedule(ctx context.Context, req *connect.Request[v1.CreateScheduleRequest]) (*connect.Response[v1.CreateScheduleResponse], error) {
+	if err := requireAdmin(ctx, "create schedules"); err != nil {
+		return nil, apiError(err)
+	}
+
+	agentID, err := uuid.Parse(req.Msg.AgentId)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid agent ID format: %w", err)))
+	}
+
+	cronSchedule, err := parseCronExpression(req.Msg.CronExpression)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	if err := validatePromptTemplate(req.Msg.Prompt); err != nil {
+		return nil, apiError(err)
+	}
+
+	s, err := memory.Transaction(ctx, h.db, func(tx *memory.Client) (*memory.Schedule, error) {
+		if _, err := tx.Agent.Get(ctx, agentID); err != nil {
+			return nil, err
+		}
+
+		create := tx.Schedule.Create().
+			SetName(req.Msg.Name).
+			SetCronExpression(req.Msg.CronExpression).
+			SetAgentID(agentID).
+			SetProjectDirectory(req.Msg.Workspace).
+			SetPrompt(req.Msg.Prompt).
+			SetEnabled(!req.Msg.Disabled)
+
+		if req.Msg.MaxCost > 0 {
+			create = create.SetMaxCost(req.Msg.MaxCost)
+		}
+
+		if !req.Msg.Disabled {
+			create = create.SetNextRunTime(cronSchedule.Next(time.Now()))
+		}
+
+		return create.Save(ctx)
+	})
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoSchedule, err := conv.ConvertScheduleToProto(s)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.CreateScheduleResponse{
+		Schedule: protoSchedule,
+	}), nil
+}
+
+func (h *ScheduleHandler) GetSchedule(ctx context.Context, req *connect.Request[v1.GetScheduleRequest]) (*connect.Response[v1.GetScheduleResponse], error) {
+	if err := requireReadAll(ctx, "read schedules"); err != nil {
+		return nil, apiError(err)
+	}
+
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid schedule ID format: %w", err)))
+	}
+
+	s, err := h.db.Schedule.Get(ctx, id)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoSchedule, err := conv.ConvertScheduleToProto(s)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.GetScheduleResponse{
+		Schedule: protoSchedule,
+	}), nil
+}
+
+func (h *ScheduleHandler) ListSchedules(ctx context.Context, req *connect.Request[v1.ListSchedulesRequest]) (*connect.Response[v1.ListSchedulesResponse], error) {
+	if err := requireReadAll(ctx, "list schedules"); err != nil {
+		return nil, apiError(err)
+	}
+
+	query := h.db.Schedule.Query()
+
+	if req.Msg.Filter != nil {
+		if req.Msg.Filter.AgentId != nil {
+			agentID, err := uuid.Parse(*req.Msg.Filter.AgentId)
+			if err != nil {
+				return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid agent ID format: %w", err)))
+			}
+			query = query.Where(schedule.AgentID(agentID))
+		}
+
+		if req.Msg.Filter.Name != nil {
+			query = query.Where(schedule.Name(*req.Msg.Filter.Name))
+		}
+
+		if req.Msg.Filter.Enabled != nil {
+			query = query.Where(schedule.Enabled(*req.Msg.Filter.Enabled))
+		}
+	}
+
+	schedules, err := query.Order(schedule.ByName()).All(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoSchedules := make([]*v1.Schedule, 0, len(schedules))
+	for _, s := range schedules {
+		protoSchedule, err := conv.ConvertScheduleToProto(s)
+		if err != nil {
+			return nil, apiError(err)
+		}
+		protoSchedules = append(protoSchedules, protoSchedule)
+	}
+
+	return connect.NewResponse(&v1.ListSchedulesResponse{
+		Schedules: protoSchedules,
+	}), nil
+}
+
+func (h *ScheduleHandler) UpdateSchedule(ctx context.Context, req *connect.Request[v1.UpdateScheduleRequest]) (*connect.Response[v1.UpdateScheduleResponse], error) {
+	if err := requireAdmin(ctx, "update schedules"); err != nil {
+		return nil, apiError(err)
+	}
+
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid schedule ID format: %w", err)))
+	}
+
+	if req.Msg.Prompt != nil {
+		if err := validatePromptTemplate(*req.Msg.Prompt); err != nil {
+			return nil, apiError(err)
+		}
+	}
+
+	s, err := memory.Transaction(ctx, h.db, func(tx *memory.Client) (*memory.Schedule, error) {
+		existing, err := tx.Schedule.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		update := tx.Schedule.UpdateOne(existing)
+
+		if req.Msg.Name != nil {
+			update = update.SetName(*req.Msg.Name)
+		}
+
+		cronExpression := existing.CronExpression
+		if req.Msg.CronExpression != nil {
+			cronExpression = *req.Msg.CronExpression
+			update = update.SetCronExpression(cronExpression)
+		}
+
+		if req.Msg.AgentId != nil {
+			agentID, err := uuid.Parse(*req.Msg.AgentId)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid agent ID format: %w", err))
+			}
+			if _, err := tx.Agent.Get(ctx, agentID); err != nil {
+				return nil, err
+			}
+			update = update.SetAgentID(agentID)
+		}
+
+		if req.Msg.Workspace != nil {
+			update = update.SetProjectDirectory(*req.Msg.Workspace)
+		}
+
+		if req.Msg.Prompt != nil {
+			update = update.SetPrompt(*req.Msg.Prompt)
+		}
+
+		if req.Msg.MaxCost != nil {
+			if *req.Msg.MaxCost > 0 {
+				update = update.SetMaxCost(*req.Msg.MaxCost)
+			} else {
+				update = update.ClearMaxCost()
+			}
+		}
+
+		enabled := existing.Enabled
+		if req.Msg.Enabled != nil {
+			enabled = *req.Msg.Enabled
+			update = update.SetEnabled(enabled)
+		}
+
+		if enabled {
+			cronSchedule, err := parseCronExpression(cronExpression)
+			if err != nil {
+				return nil, err
+			}
+			update = update.SetNextRunTime(cronSchedule.Next(time.Now()))
+		} else {
+			update = update.ClearNextRunTime()
+		}
+
+		return update.Save(ctx)
+	})
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoSchedule, err := conv.ConvertScheduleToProto(s)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.UpdateScheduleResponse{
+		Schedule: protoSchedule,
+	}), nil
+}
+
+func (h *ScheduleHandler) DeleteSchedule(ctx context.Context, req *connect.Request[v1.DeleteScheduleRequest]) (*connect.Response[v1.DeleteScheduleResponse], error) {
+	if err := requireAdmin(ctx, "delete schedules"); err != nil {
+		return nil, apiError(err)
+	}
+
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid schedule ID format: %w", err)))
+	}
+
+	if err := h.db.Schedule.DeleteOneID(id).Exec(ctx); err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.DeleteScheduleResponse{}), nil
+}
+
+func (h *ScheduleHandler) ListScheduleRuns(ctx context.Context, req *connect.Request[v1.ListScheduleRunsRequest]) (*connect.Response[v1.ListScheduleRunsResponse], error) {
+	if err := requireReadAll(ctx, "list schedule runs"); err != nil {
+		return nil, apiError(err)
+	}
+
+	id, err := uuid.Parse(req.Msg.ScheduleId)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid schedule ID format: %w", err)))
+	}
+
+	if _, err := h.db.Schedule.Get(ctx, id); err != nil {
+		return nil, apiError(err)
+	}
+
+	query := h.db.ScheduleRun.Query().
+		Where(schedulerun.ScheduleID(id)).
+		Order(schedulerun.ByRunTime(sql.OrderDesc()), schedulerun.ByCreateTime(sql.OrderDesc()))
+	if req.Msg.Limit > 0 {
+		query = query.Limit(int(req.Msg.Limit))
+	}
+
+	runs, err := query.All(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoRuns := make([]*v1.ScheduleRun, 0, len(runs))
+	for _, r := range runs {
+		protoRuns = append(protoRuns, conv.ConvertScheduleRunToProto(r))
+	}
+
+	return connect.NewResponse(&v1.ListScheduleRunsResponse{
+		Runs: protoRuns,
+	}), nil
+}
+
+func parseCronExpression(expression string) (cron.Schedule, error) {
+	s, err := cron.ParseStandard(expression)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid cron expression %q: %w", expression, err))
+	}
+	return s, nil
+}
+
+func validatePromptTemplate(prompt string) error {
+	if _, err := template.New("prompt").Parse(prompt); err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid prompt template: %w", err))
+	}
+	return nil
+}
@@ -0,0 +1,520 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	_ "modernc.org/sqlite"
+)
+
+func TestCreateSchedule(t *testing.T) {
+	setup := ServiceTestSetup[v1.CreateScheduleRequest, v1.CreateScheduleResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.CreateScheduleRequest]) (*connect.Response[v1.CreateScheduleResponse], error) {
+			return client.Schedule().CreateSchedule(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.CreateScheduleResponse{}, v1.Schedule{}, v1.ScheduleMetadata{}, v1.ScheduleSpec{}, v1.ScheduleStatus{}),
+			protocmp.Transform(),
+			protocmp.IgnoreFields(&v1.ScheduleMetadata{}, "id", "created_at", "updated_at"),
+			protocmp.IgnoreFields(&v1.ScheduleStatus{}, "next_run_at"),
+		},
+	}
+
+	agentID := uuid.New()
+
+	seedAgent := func(ctx context.Context, db *memory.Client) {
+		modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+		model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+		test.NewAgentBuilder(t, agentID, db, model).Build(ctx)
+	}
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.CreateScheduleRequest, v1.CreateScheduleResponse]{
+		{
+			Name: "invalid agent ID",
+			Request: &v1.CreateScheduleRequest{
+				Name:           "nightly-review",
+				CronExpression: "0 2 * * *",
+				AgentId:        "not-a-valid-uuid",
+				Workspace:      "/workspace/project",
+				Prompt:         "Review the changes of the last day",
+			},
+			Expected: ServiceTestExpectation[v1.CreateScheduleResponse]{
+				Error: "invalid_argument: invalid agent ID format: invalid UUID length: 16",
+			},
+		},
+		{
+			Name: "invalid cron expression",
+			Request: &v1.CreateScheduleRequest{
+				Name:           "nightly-review",
+				CronExpression: "every night",
+				AgentId:        agentID.String(),
+				Workspace:      "/workspace/project",
+				Prompt:         "Review the changes of the last day",
+			},
+			Expected: ServiceTestExpectation[v1.CreateScheduleResponse]{
+				Error: `invalid_argument: invalid cron expression "every night": expected exactly 5 fields, found 2: [every night]`,
+			},
+		},
+		{
+			Name: "invalid prompt template",
+			Request: &v1.CreateScheduleRequest{
+				Name:           "nightly-review",
+				CronExpression: "0 2 * * *",
+				AgentId:        agentID.String(),
+				Workspace:      "/workspace/project",
+				Prompt:         "Review the changes since {{.Time",
+			},
+			Expected: ServiceTestExpectation[v1.CreateScheduleResponse]{
+				Error: `invalid_argument: invalid prompt template: template: prompt:1: unclosed action`,
+			},
+		},
+		{
+			Name: "agent not found",
+			Request: &v1.CreateScheduleRequest{
+				Name:           "nightly-review",
+				CronExpression: "0 2 * * *",
+				AgentId:        agentID.String(),
+				Workspace:      "/workspace/project",
+				Prompt:         "Review the changes of the last day",
+			},
+			Expected: ServiceTestExpectation[v1.CreateScheduleResponse]{
+				Error: "not_found: agent not found",
+			},
+		},
+		{
+			Name:         "success",
+			SeedDatabase: seedAgent,
+			Request: &v1.CreateScheduleRequest{
+				Name:           "nightly-review",
+				CronExpression: "@daily",
+				AgentId:        agentID.String(),
+				Workspace:      "/workspace/project",
+				Prompt:         "Review the changes of the last day",
+				MaxCost:        2.5,
+			},
+			Expected: ServiceTestExpectation[v1.CreateScheduleResponse]{
+				Response: v1.CreateScheduleResponse{
+					Schedule: &v1.Schedule{
+						Metadata: &v1.ScheduleMetadata{},
+						Spec: &v1.ScheduleSpec{
+							Name:           "nightly-review",
+							CronExpression: "@daily",
+							AgentId:        agentID.String(),
+							Workspace:      "/workspace/project",
+							Prompt:         "Review the changes of the last day",
+							MaxCost:        2.5,
+							Enabled:        true,
+						},
+						Status: &v1.ScheduleStatus{},
+					},
+				},
+			},
+		},
+		{
+			Name:         "success - disabled",
+			SeedDatabase: seedAgent,
+			Request: &v1.CreateScheduleRequest{
+				Name:           "nightly-review",
+				CronExpression: "0 2 * * *",
+				AgentId:        agentID.String(),
+				Workspace:      "/workspace/project",
+				Prompt:         "Review the changes of the last day",
+				Disabled:       true,
+			},
+			Expected: ServiceTestExpectation[v1.CreateScheduleResponse]{
+				Response: v1.CreateScheduleResponse{
+					Schedule: &v1.Schedule{
+						Metadata: &v1.ScheduleMetadata{},
+						Spec: &v1.ScheduleSpec{
+							Name:           "nightly-review",
+							CronExpression: "0 2 * * *",
+							AgentId:        agentID.String(),
+							Workspace:      "/workspace/project",
+							Prompt:         "Review the changes of the last day",
+						},
+						Status: &v1.ScheduleStatus{},
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestGetSchedule(t *testing.T) {
+	setup := ServiceTestSetup[v1.GetScheduleRequest, v1.GetScheduleResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.GetScheduleRequest]) (*connect.Response[v1.GetScheduleResponse], error) {
+			return client.Schedule().GetSchedule(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.GetScheduleResponse{}, v1.Schedule{}, v1.ScheduleMetadata{}, v1.ScheduleSpec{}, v1.ScheduleStatus{}),
+			protocmp.Transform(),
+			protocmp.IgnoreFields(&v1.ScheduleMetadata{}, "created_at", "updated_at"),
+		},
+	}
+
+	agentID := uuid.New()
+	scheduleID := uuid.New()
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.GetScheduleRequest, v1.GetScheduleResponse]{
+		{
+			Name: "invalid id format",
+			Request: &v1.GetScheduleRequest{
+				Id: "not-a-valid-uuid",
+			},
+			Expected: ServiceTestExpectation[v1.GetScheduleResponse]{
+				Error: "invalid_argument: invalid schedule ID format: invalid UUID length: 16",
+			},
+		},
+		{
+			Name: "schedule not found",
+			Request: &v1.GetScheduleRequest{
+				Id: scheduleID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.GetScheduleResponse]{
+				Error: "not_found: schedule not found",
+			},
+		},
+		{
+			Name: "success",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+				agent := test.NewAgentBuilder(t, agentID, db, model).Build(ctx)
+				test.NewScheduleBuilder(t, scheduleID, db, agent).WithEnabled(false).Build(ctx)
+			},
+			Request: &v1.GetScheduleRequest{
+				Id: scheduleID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.GetScheduleResponse]{
+				Response: v1.GetScheduleResponse{
+					Schedule: &v1.Schedule{
+						Metadata: &v1.ScheduleMetadata{
+							Id: scheduleID.String(),
+						},
+						Spec: &v1.ScheduleSpec{
+							Name:           "nightly-review",
+							CronExpression: "0 2 * * *",
+							AgentId:        agentID.String(),
+							Workspace:      "/workspace/project",
+							Prompt:         "Review the changes of the last day",
+						},
+						Status: &v1.ScheduleStatus{},
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestListSchedules(t *testing.T) {
+	setup := ServiceTestSetup[v1.ListSchedulesRequest, v1.ListSchedulesResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.ListSchedulesRequest]) (*connect.Response[v1.ListSchedulesResponse], error) {
+			return client.Schedule().ListSchedules(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.ListSchedulesResponse{}, v1.Schedule{}, v1.ScheduleMetadata{}, v1.ScheduleSpec{}, v1.ScheduleStatus{}),
+			protocmp.Transform(),
+			protocmp.IgnoreFields(&v1.ScheduleMetadata{}, "created_at", "updated_at"),
+		},
+	}
+
+	agentID := uuid.New()
+	enabledID := uuid.New()
+	disabledID := uuid.New()
+
+	seed := func(ctx context.Context, db *memory.Client) {
+		modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+		model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+		agent := test.NewAgentBuilder(t, agentID, db, model).Build(ctx)
+		test.NewScheduleBuilder(t, enabledID, db, agent).WithName("a-enabled").Build(ctx)
+		test.NewScheduleBuilder(t, disabledID, db, agent).WithName("b-disabled").WithEnabled(false).Build(ctx)
+	}
+
+	schedule := func(id uuid.UUID, name string, enabled bool) *v1.Schedule {
+		return &v1.Schedule{
+			Metadata: &v1.ScheduleMetadata{
+				Id: id.String(),
+			},
+			Spec: &v1.ScheduleSpec{
+				Name:           name,
+				CronExpression: "0 2 * * *",
+				AgentId:        agentID.String(),
+				Workspace:      "/workspace/project",
+				Prompt:         "Review the changes of the last day",
+				Enabled:        enabled,
+			},
+			Status: &v1.ScheduleStatus{},
+		}
+	}
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.ListSchedulesRequest, v1.ListSchedulesResponse]{
+		{
+			Name:    "empty list",
+			Request: &v1.ListSchedulesRequest{},
+			Expected: ServiceTestExpectation[v1.ListSchedulesResponse]{
+				Response: v1.ListSchedulesResponse{},
+			},
+		},
+		{
+			Name:         "all schedules",
+			SeedDatabase: seed,
+			Request:      &v1.ListSchedulesRequest{},
+			Expected: ServiceTestExpectation[v1.ListSchedulesResponse]{
+				Response: v1.ListSchedulesResponse{
+					Schedules: []*v1.Schedule{
+						schedule(enabledID, "a-enabled", true),
+						schedule(disabledID, "b-disabled", false),
+					},
+				},
+			},
+		},
+		{
+			Name:         "filter by enabled",
+			SeedDatabase: seed,
+			Request: &v1.ListSchedulesRequest{
+				Filter: &v1.ListSchedulesRequest_Filter{
+					Enabled: boolPtr(false),
+				},
+			},
+			Expected: ServiceTestExpectation[v1.ListSchedulesResponse]{
+				Response: v1.ListSchedulesResponse{
+					Schedules: []*v1.Schedule{
+						schedule(disabledID, "b-disabled", false),
+					},
+				},
+			},
+		},
+		{
+			Name:         "filter by name",
+			SeedDatabase: seed,
+			Request: &v1.ListSchedulesRequest{
+				Filter: &v1.ListSchedulesRequest_Filter{
+					Name: strPtr("a-enabled"),
+				},
+			},
+			Expected: ServiceTestExpectation[v1.ListSchedulesResponse]{
+				Response: v1.ListSchedulesResponse{
+					Schedules: []*v1.Schedule{
+						schedule(enabledID, "a-enabled", true),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestUpdateSchedule(t *testing.T) {
+	setup := ServiceTestSetup[v1.UpdateScheduleRequest, v1.UpdateScheduleResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.UpdateScheduleRequest]) (*connect.Response[v1.UpdateScheduleResponse], error) {
+			return client.Schedule().UpdateSchedule(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.UpdateScheduleResponse{}, v1.Schedule{}, v1.ScheduleMetadata{}, v1.ScheduleSpec{}, v1.ScheduleStatus{}),
+			protocmp.Transform(),
+			protocmp.IgnoreFields(&v1.ScheduleMetadata{}, "created_at", "updated_at"),
+		},
+	}
+
+	agentID := uuid.New()
+	scheduleID := uuid.New()
+
+	seed := func(ctx context.Context, db *memory.Client) {
+		modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+		model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+		agent := test.NewAgentBuilder(t, agentID, db, model).Build(ctx)
+		test.NewScheduleBuilder(t, scheduleID, db, agent).Build(ctx)
+	}
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.UpdateScheduleRequest, v1.UpdateScheduleResponse]{
+		{
+			Name: "schedule not found",
+			Request: &v1.UpdateScheduleRequest{
+				Id:      scheduleID.String(),
+				Enabled: boolPtr(false),
+			},
+			Expected: ServiceTestExpectation[v1.UpdateScheduleResponse]{
+				Error: "not_found: schedule not found",
+			},
+		},
+		{
+			Name:         "invalid cron expression",
+			SeedDatabase: seed,
+			Request: &v1.UpdateScheduleRequest{
+				Id:             scheduleID.String(),
+				CronExpression: strPtr("61 * * * *"),
+			},
+			Expected: ServiceTestExpectation[v1.UpdateScheduleResponse]{
+				Error: `invalid_argument: invalid cron expression "61 * * * *": end of range (61) above maximum (59): 61`,
+			},
+		},
+		{
+			Name:         "disable and change prompt",
+			SeedDatabase: seed,
+			Request: &v1.UpdateScheduleRequest{
+				Id:      scheduleID.String(),
+				Prompt:  strPtr("Summarize the open issues in {{.Workspace}}"),
+				MaxCost: ptr(1.0),
+				Enabled: boolPtr(false),
+			},
+			Expected: ServiceTestExpectation[v1.UpdateScheduleResponse]{
+				Response: v1.UpdateScheduleResponse{
+					Schedule: &v1.Schedule{
+						Metadata: &v1.ScheduleMetadata{
+							Id: scheduleID.String(),
+						},
+						Spec: &v1.ScheduleSpec{
+							Name:           "nightly-review",
+							CronExpression: "0 2 * * *",
+							AgentId:        agentID.String(),
+							Workspace:      "/workspace/project",
+							Prompt:         "Summarize the open issues in {{.Workspace}}",
+							MaxCost:        1.0,
+						},
+						Status: &v1.ScheduleStatus{},
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestDeleteSchedule(t *testing.T) {
+	setup := ServiceTestSetup[v1.DeleteScheduleRequest, v1.DeleteScheduleResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.DeleteScheduleRequest]) (*connect.Response[v1.DeleteScheduleResponse], error) {
+			return client.Schedule().DeleteSchedule(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.DeleteScheduleResponse{}),
+			protocmp.Transform(),
+		},
+		QueryDatabase: func(ctx context.Context, db *memory.Client) (any, error) {
+			return db.Task.Query().Count(ctx)
+		},
+	}
+
+	scheduleID := uuid.New()
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.DeleteScheduleRequest, v1.DeleteScheduleResponse]{
+		{
+			Name: "schedule not found",
+			Request: &v1.DeleteScheduleRequest{
+				Id: scheduleID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.DeleteScheduleResponse]{
+				Error: "not_found: schedule not found",
+			},
+		},
+		{
+			Name: "success - keeps tasks of the schedule",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+				agent := test.NewAgentBuilder(t, uuid.New(), db, model).Build(ctx)
+				schedule := test.NewScheduleBuilder(t, scheduleID, db, agent).Build(ctx)
+				task := test.NewTaskBuilder(t, uuid.New(), db, agent).WithSchedule(schedule).Build(ctx)
+				test.NewScheduleRunBuilder(t, uuid.New(), db, schedule).WithTask(task).Build(ctx)
+			},
+			Request: &v1.DeleteScheduleRequest{
+				Id: scheduleID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.DeleteScheduleResponse]{
+				Response: v1.DeleteScheduleResponse{},
+				Database: 1,
+			},
+		},
+	})
+}
+
+func TestListScheduleRuns(t *testing.T) {
+	setup := ServiceTestSetup[v1.ListScheduleRunsRequest, v1.ListScheduleRunsResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.ListScheduleRunsRequest]) (*connect.Response[v1.ListScheduleRunsResponse], error) {
+			return client.Schedule().ListScheduleRuns(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.ListScheduleRunsResponse{}, v1.ScheduleRun{}),
+			protocmp.Transform(),
+		},
+	}
+
+	scheduleID := uuid.New()
+	taskID := uuid.New()
+	startedID := uuid.New()
+	skippedID := uuid.New()
+	firstRun := time.Date(2026, 3, 14, 2, 0, 0, 0, time.UTC)
+	secondRun := firstRun.Add(24 * time.Hour)
+
+	seed := func(ctx context.Context, db *memory.Client) {
+		modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+		model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+		agent := test.NewAgentBuilder(t, uuid.New(), db, model).Build(ctx)
+		schedule := test.NewScheduleBuilder(t, scheduleID, db, agent).Build(ctx)
+		task := test.NewTaskBuilder(t, taskID, db, agent).WithSchedule(schedule).Build(ctx)
+		test.NewScheduleRunBuilder(t, startedID, db, schedule).WithTask(task).WithRunTime(firstRun).Build(ctx)
+		test.NewScheduleRunBuilder(t, skippedID, db, schedule).WithSkipped("the previous run is still active").WithRunTime(secondRun).Build(ctx)
+	}
+
+	skipped := &v1.ScheduleRun{
+		Id:          skippedID.String(),
+		ScheduleId:  scheduleID.String(),
+		Status:      v1.ScheduleRunStatus_SCHEDULE_RUN_STATUS_SKIPPED,
+		Reason:      strPtr("the previous run is still active"),
+		ScheduledAt: timestamppb.New(secondRun),
+		RunAt:       timestamppb.New(secondRun),
+	}
+	started := &v1.ScheduleRun{
+		Id:          startedID.String(),
+		ScheduleId:  scheduleID.String(),
+		Status:      v1.ScheduleRunStatus_SCHEDULE_RUN_STATUS_STARTED,
+		ScheduledAt: timestamppb.New(firstRun),
+		RunAt:       timestamppb.New(firstRun),
+		TaskId:      strPtr(taskID.String()),
+	}
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.ListScheduleRunsRequest, v1.ListScheduleRunsResponse]{
+		{
+			Name: "schedule not found",
+			Request: &v1.ListScheduleRunsRequest{
+				ScheduleId: scheduleID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.ListScheduleRunsResponse]{
+				Error: "not_found: schedule not found",
+			},
+		},
+		{
+			Name:         "success - newest first",
+			SeedDatabase: seed,
+			Request: &v1.ListScheduleRunsRequest{
+				ScheduleId: scheduleID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.ListScheduleRunsResponse]{
+				Response: v1.ListScheduleRunsResponse{
+					Runs: []*v1.ScheduleRun{skipped, started},
+				},
+			},
+		},
+		{
+			Name:         "success - limit",
+			SeedDatabase: seed,
+			Request: &v1.ListScheduleRunsRequest{
+				ScheduleId: scheduleID.String(),
+				Limit:      1,
+			},
+			Expected: ServiceTestExpectation[v1.ListScheduleRunsResponse]{
+				Response: v1.ListScheduleRunsResponse{
+					Runs: []*v1.ScheduleRun{skipped},
+				},
+			},
+		},
+	})
+}
@@ -1,7 +1,9 @@
 package api
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 
 	"connectrpc.com/connect"
@@ -17,7 +19,10 @@ import (
 	"github.com/furisto/construct/backend/memory/message"
 	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/furisto/construct/backend/memory/task"
+	"github.com/furisto/construct/backend/tool/filesystem"
+	"github.com/furisto/construct/backend/transcript"
 	"github.com/google/uuid"
+	"github.com/spf13/afero"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -43,6 +48,10 @@ type TaskHandler struct {
 }
 
 func (h *TaskHandler) CreateTask(ctx context.Context, req *connect.Request[v1.CreateTaskRequest]) (*connect.Response[v1.CreateTaskResponse], error) {
+	if err := requireWrite(ctx, "create tasks"); err != nil {
+		return nil, apiError(err)
+	}
+
 	agentID, err := uuid.Parse(req.Msg.AgentId)
 	if err != nil {
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid agent ID format: %w", err)))
@@ -56,12 +65,18 @@ func (h *TaskHandler) CreateTask(ctx context.Context, req *connect.Request[v1.Cr
 
 		taskCreate := tx.Task.Create().
 			SetAgentID(agentID).
-			SetProjectDirectory(req.Msg.ProjectDirectory)
+			SetProjectDirectory(req.Msg.ProjectDirectory).
+			SetPersistentState(req.Msg.PersistentState).
+			SetNillableOwnerID(ownerOf(ctx))
 
 		if req.Msg.Description != "" {
 			taskCreate = taskCreate.SetDescription(req.Msg.Description)
 		}
 
+		if req.Msg.Preview {
+			taskCreate = taskCreate.SetPreview(&types.TaskPreview{})
+		}
+
 		return taskCreate.Save(ctx)
 	})
 
@@ -75,6 +90,11 @@ func (h *TaskHandler) CreateTask(ctx context.Context, req *connect.Request[v1.Cr
 	}
 
 	analytics.EmitTaskCreated(h.analytics, createdTask.ID.String(), createdTask.AgentID.String())
+	event.Publish(h.eventBus, event.ResourceChangedEvent{
+		Kind:      event.ResourceKindTask,
+		Operation: event.ResourceOperationCreated,
+		ID:        createdTask.ID,
+	})
 
 	return connect.NewResponse(&v1.CreateTaskResponse{
 		Task: protoTask,
@@ -92,18 +112,34 @@ func (h *TaskHandler) GetTask(ctx context.Context, req *connect.Request[v1.GetTa
 		return nil, apiError(err)
 	}
 
+	if err := authorizeTaskRead(ctx, task); err != nil {
+		return nil, apiError(err)
+	}
+
 	protoTask, err := conv.ConvertTaskToProto(task)
 	if err != nil {
 		return nil, apiError(err)
 	}
 
+	pending, err := h.db.Message.Query().
+		Where(
+			message.TaskID(id),
+			message.SourceEQ(types.MessageSourceUser),
+			message.ProcessedTimeIsNil(),
+		).
+		Count(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+	protoTask.Status.PendingMessages = int64(pending)
+
 	return connect.NewResponse(&v1.GetTaskResponse{
 		Task: protoTask,
 	}), nil
 }
 
 func (h *TaskHandler) ListTasks(ctx context.Context, req *connect.Request[v1.ListTasksRequest]) (*connect.Response[v1.ListTasksResponse], error) {
-	query := h.db.Task.Query()
+	query := h.db.Task.Query().Where(readableTasks(ctx)...)
 
 	if req.Msg.Filter != nil && req.Msg.Filter.AgentId != nil {
 		agentID, err := uuid.Parse(*req.Msg.Filter.AgentId)
@@ -113,6 +149,22 @@ func (h *TaskHandler) ListTasks(ctx context.Context, req *connect.Request[v1.Lis
 		query = query.Where(task.HasAgentWith(agent.ID(agentID)))
 	}
 
+	if req.Msg.Filter != nil && req.Msg.Filter.ScheduleId != nil {
+		scheduleID, err := uuid.Parse(*req.Msg.Filter.ScheduleId)
+		if err != nil {
+			return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid schedule ID format: %w", err)))
+		}
+		query = query.Where(task.ScheduleID(scheduleID))
+	}
+
+	if req.Msg.Filter != nil && req.Msg.Filter.ParentTaskId != nil {
+		parentID, err := uuid.Parse(*req.Msg.Filter.ParentTaskId)
+		if err != nil {
+			return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid parent task ID format: %w", err)))
+		}
+		query = query.Where(task.ParentID(parentID))
+	}
+
 	if req.Msg.Filter != nil && req.Msg.Filter.TaskIdPrefix != nil {
 		query = query.Where(extension.UUIDHasPrefix(task.Table, task.FieldID, *req.Msg.Filter.TaskIdPrefix))
 	}
@@ -122,6 +174,9 @@ func (h *TaskHandler) ListTasks(ctx context.Context, req *connect.Request[v1.Lis
 		countExpr := sql.Count(m.C(message.FieldTaskID))
 		s.LeftJoin(m).On(s.C(task.FieldID), m.C(message.FieldTaskID))
 		s.AppendSelect(sql.As(countExpr, "messages_count"))
+		s.AppendSelect(sql.As(fmt.Sprintf("SUM(CASE WHEN %s = '%s' AND %s IS NULL THEN 1 ELSE 0 END)",
+			m.C(message.FieldSource), types.MessageSourceUser, m.C(message.FieldProcessedTime),
+		), "pending_messages_count"))
 		s.GroupBy(s.C(task.FieldID))
 
 		if req.Msg.Filter != nil && req.Msg.Filter.HasMessages != nil {
@@ -181,6 +236,12 @@ func (h *TaskHandler) ListTasks(ctx context.Context, req *connect.Request[v1.Lis
 			}
 		}
 		protoTask.Status.MessageCount = mc
+
+		if v, err := t.Value("pending_messages_count"); err == nil {
+			if n, ok := v.(int64); ok {
+				protoTask.Status.PendingMessages = n
+			}
+		}
 		protoTasks = append(protoTasks, protoTask)
 	}
 
@@ -201,6 +262,10 @@ func (h *TaskHandler) UpdateTask(ctx context.Context, req *connect.Request[v1.Up
 		if err != nil {
 			return nil, err
 		}
+
+		if err := authorizeTaskWrite(ctx, t); err != nil {
+			return nil, err
+		}
 		update := t.Update()
 
 		if req.Msg.AgentId != nil {
@@ -231,6 +296,11 @@ func (h *TaskHandler) UpdateTask(ctx context.Context, req *connect.Request[v1.Up
 	}
 
 	analytics.EmitTaskUpdated(h.analytics, updatedTask.ID.String(), updatedFields)
+	event.Publish(h.eventBus, event.ResourceChangedEvent{
+		Kind:      event.ResourceKindTask,
+		Operation: event.ResourceOperationUpdated,
+		ID:        updatedTask.ID,
+	})
 
 	for _, field := range updatedFields {
 		if field == "agent_id" {
@@ -258,10 +328,25 @@ func (h *TaskHandler) DeleteTask(ctx context.Context, req *connect.Request[v1.De
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid task ID format: %w", err)))
 	}
 
+	t, err := h.db.Task.Get(ctx, id)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	if err := authorizeTaskWrite(ctx, t); err != nil {
+		return nil, apiError(err)
+	}
+
 	if err := h.db.Task.DeleteOneID(id).Exec(ctx); err != nil {
 		return nil, apiError(err)
 	}
 
+	event.Publish(h.eventBus, event.ResourceChangedEvent{
+		Kind:      event.ResourceKindTask,
+		Operation: event.ResourceOperationDeleted,
+		ID:        id,
+	})
+
 	return connect.NewResponse(&v1.DeleteTaskResponse{}), nil
 }
 
@@ -271,11 +356,15 @@ func (h *TaskHandler) Subscribe(ctx context.Context, req *connect.Request[v1.Sub
 		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid task ID format: %w", err))
 	}
 
-	_, err = h.db.Task.Get(ctx, taskID)
+	t, err := h.db.Task.Get(ctx, taskID)
 	if err != nil {
 		return apiError(err)
 	}
 
+	if err := authorizeTaskRead(ctx, t); err != nil {
+		return apiError(err)
+	}
+
 	event.Publish(h.eventBus, event.TaskEvent{
 		TaskID: taskID,
 	})
@@ -299,7 +388,16 @@ func (h *TaskHandler) SuspendTask(ctx context.Context, req *connect.Request[v1.S
 	}
 
 	_, err = memory.Transaction(ctx, h.db, func(tx *memory.Client) (*memory.Task, error) {
-		_, err = h.db.Task.UpdateOneID(taskID).SetPhase(types.TaskPhaseSuspended).Save(ctx)
+		t, err := tx.Task.Get(ctx, taskID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := authorizeTaskWrite(ctx, t); err != nil {
+			return nil, err
+		}
+
+		_, err = tx.Task.UpdateOneID(taskID).SetPhase(types.TaskPhaseSuspended).Save(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -315,3 +413,171 @@ func (h *TaskHandler) SuspendTask(ctx context.Context, req *connect.Request[v1.S
 	})
 	return connect.NewResponse(&v1.SuspendTaskResponse{}), nil
 }
+
+func (h *TaskHandler) RenderTask(ctx context.Context, req *connect.Request[v1.RenderTaskRequest]) (*connect.Response[v1.RenderTaskResponse], error) {
+	taskID, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid task ID format: %w", err)))
+	}
+
+	format, err := convertTranscriptFormat(req.Msg.Format)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	t, err := h.db.Task.Query().Where(task.ID(taskID)).WithAgent().Only(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	if err := authorizeTaskRead(ctx, t); err != nil {
+		return nil, apiError(err)
+	}
+
+	messages, err := h.db.Message.Query().
+		Where(message.TaskIDEQ(taskID)).
+		Order(message.ByCreateTime()).
+		All(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	var agentName string
+	if t.Edges.Agent != nil {
+		agentName = t.Edges.Agent.Name
+	}
+
+	doc, err := transcript.Build(t, agentName, messages)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	var buf bytes.Buffer
+	if err := transcript.Render(&buf, doc, format); err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.RenderTaskResponse{
+		Content:     buf.String(),
+		ContentType: format.ContentType(),
+	}), nil
+}
+
+func (h *TaskHandler) GetPreview(ctx context.Context, req *connect.Request[v1.GetPreviewRequest]) (*connect.Response[v1.GetPreviewResponse], error) {
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid task ID format: %w", err)))
+	}
+
+	t, err := h.db.Task.Get(ctx, id)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	if err := authorizeTaskRead(ctx, t); err != nil {
+		return nil, apiError(err)
+	}
+
+	overlay, err := previewOverlay(t)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	changes, err := overlay.Changes()
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.GetPreviewResponse{
+		Changes: convertPreviewChanges(changes),
+	}), nil
+}
+
+func (h *TaskHandler) ApplyPreview(ctx context.Context, req *connect.Request[v1.ApplyPreviewRequest]) (*connect.Response[v1.ApplyPreviewResponse], error) {
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid task ID format: %w", err)))
+	}
+
+	var changes []filesystem.OverlayChange
+	_, err = memory.Transaction(ctx, h.db, func(tx *memory.Client) (*memory.Task, error) {
+		t, err := tx.Task.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := authorizeTaskWrite(ctx, t); err != nil {
+			return nil, err
+		}
+
+		if t.Phase == types.TaskPhaseRunning {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("task %s is running, suspend it or wait until it is done before applying the preview", id))
+		}
+
+		overlay, err := previewOverlay(t)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := filesystem.CheckPreviewBase(overlay.Base(), t.Preview); err != nil {
+			var conflict *filesystem.PreviewConflictError
+			if errors.As(err, &conflict) {
+				return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("the preview was not applied because %w, review the changes again", err))
+			}
+			return nil, err
+		}
+
+		changes, err = overlay.Commit()
+		if err != nil {
+			return nil, connect.NewError(connect.CodeAborted, err)
+		}
+
+		return tx.Task.UpdateOneID(id).SetPreview(&types.TaskPreview{}).Save(ctx)
+	})
+
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	event.Publish(h.eventBus, event.ResourceChangedEvent{
+		Kind:      event.ResourceKindTask,
+		Operation: event.ResourceOperationUpdated,
+		ID:        id,
+	})
+
+	return connect.NewResponse(&v1.ApplyPreviewResponse{
+		Changes: convertPreviewChanges(changes),
+	}), nil
+}
+
+// previewOverlay stages the files of a task in preview mode on top of the
+// local file system.
+func previewOverlay(t *memory.Task) (*filesystem.Overlay, error) {
+	if t.Preview == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("task %s does not run in preview mode", t.ID))
+	}
+	return filesystem.PreviewOverlay(afero.NewOsFs(), t.Preview)
+}
+
+func convertPreviewChanges(changes []filesystem.OverlayChange) []*v1.PreviewChange {
+	protoChanges := make([]*v1.PreviewChange, 0, len(changes))
+	for _, change := range changes {
+		protoChanges = append(protoChanges, &v1.PreviewChange{
+			Path:    change.Path,
+			Created: change.Created,
+			Patch:   change.Patch,
+		})
+	}
+	return protoChanges
+}
+
+func convertTranscriptFormat(format v1.TranscriptFormat) (transcript.Format, error) {
+	switch format {
+	case v1.TranscriptFormat_TRANSCRIPT_FORMAT_UNSPECIFIED, v1.TranscriptFormat_TRANSCRIPT_FORMAT_MARKDOWN:
+		return transcript.FormatMarkdown, nil
+	case v1.TranscriptFormat_TRANSCRIPT_FORMAT_HTML:
+		return transcript.FormatHTML, nil
+	default:
+		return "", fmt.Errorf("unsupported transcript format: %v", format)
+	}
+}
@@ -2,16 +2,23 @@ package api
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/furisto/construct/api/go/client"
 	v1 "github.com/furisto/construct/api/go/v1"
 	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/furisto/construct/backend/memory/test"
+	"github.com/furisto/construct/backend/tool/filesystem"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/google/uuid"
+	diff "github.com/sourcegraph/go-diff-patch"
 	"google.golang.org/protobuf/testing/protocmp"
 	_ "modernc.org/sqlite"
 )
@@ -149,6 +156,42 @@ func TestGetTask(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "pending user messages",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				model := test.NewModelBuilder(t, modelID, db, modelProvider).Build(ctx)
+
+				agent := test.NewAgentBuilder(t, agentID, db, model).Build(ctx)
+				task := test.NewTaskBuilder(t, taskID, db, agent).Build(ctx)
+
+				test.NewMessageBuilder(t, uuid.New(), db, task).WithProcessedTime(time.Now()).Build(ctx)
+				test.NewMessageBuilder(t, uuid.New(), db, task).WithAgent(agent).Build(ctx)
+				test.NewMessageBuilder(t, uuid.New(), db, task).Build(ctx)
+				test.NewMessageBuilder(t, uuid.New(), db, task).Build(ctx)
+			},
+			Request: &v1.GetTaskRequest{
+				Id: taskID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.GetTaskResponse]{
+				Response: v1.GetTaskResponse{
+					Task: &v1.Task{
+						Metadata: &v1.TaskMetadata{
+							Id: taskID.String(),
+						},
+						Spec: &v1.TaskSpec{
+							AgentId:      strPtr(agentID.String()),
+							DesiredPhase: v1.TaskPhase_TASK_PHASE_RUNNING,
+						},
+						Status: &v1.TaskStatus{
+							Usage:           &v1.TaskUsage{},
+							Phase:           v1.TaskPhase_TASK_PHASE_AWAITING,
+							PendingMessages: 2,
+						},
+					},
+				},
+			},
+		},
 	})
 }
 
@@ -168,6 +211,7 @@ func TestListTasks(t *testing.T) {
 	taskID2 := uuid.New()
 	agentID := uuid.New()
 	modelID := uuid.New()
+	scheduleID := uuid.New()
 
 	setup.RunServiceTests(t, []ServiceTestScenario[v1.ListTasksRequest, v1.ListTasksResponse]{
 		{
@@ -220,6 +264,79 @@ func TestListTasks(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "filter by schedule ID",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				model := test.NewModelBuilder(t, modelID, db, modelProvider).Build(ctx)
+				agent := test.NewAgentBuilder(t, agentID, db, model).Build(ctx)
+				schedule := test.NewScheduleBuilder(t, scheduleID, db, agent).Build(ctx)
+
+				test.NewTaskBuilder(t, taskID1, db, agent).WithSchedule(schedule).Build(ctx)
+				test.NewTaskBuilder(t, taskID2, db, agent).Build(ctx)
+			},
+			Request: &v1.ListTasksRequest{
+				Filter: &v1.ListTasksRequest_Filter{
+					ScheduleId: strPtr(scheduleID.String()),
+				},
+			},
+			Expected: ServiceTestExpectation[v1.ListTasksResponse]{
+				Response: v1.ListTasksResponse{
+					Tasks: []*v1.Task{
+						{
+							Metadata: &v1.TaskMetadata{
+								Id: taskID1.String(),
+							},
+							Spec: &v1.TaskSpec{
+								AgentId:      strPtr(agentID.String()),
+								ScheduleId:   strPtr(scheduleID.String()),
+								DesiredPhase: v1.TaskPhase_TASK_PHASE_RUNNING,
+							},
+							Status: &v1.TaskStatus{
+								Usage: &v1.TaskUsage{},
+								Phase: v1.TaskPhase_TASK_PHASE_AWAITING,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "filter by parent task ID",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				model := test.NewModelBuilder(t, modelID, db, modelProvider).Build(ctx)
+				agent := test.NewAgentBuilder(t, agentID, db, model).Build(ctx)
+
+				parent := test.NewTaskBuilder(t, taskID1, db, agent).Build(ctx)
+				test.NewTaskBuilder(t, taskID2, db, agent).WithParent(parent).Build(ctx)
+			},
+			Request: &v1.ListTasksRequest{
+				Filter: &v1.ListTasksRequest_Filter{
+					ParentTaskId: strPtr(taskID1.String()),
+				},
+			},
+			Expected: ServiceTestExpectation[v1.ListTasksResponse]{
+				Response: v1.ListTasksResponse{
+					Tasks: []*v1.Task{
+						{
+							Metadata: &v1.TaskMetadata{
+								Id: taskID2.String(),
+							},
+							Spec: &v1.TaskSpec{
+								AgentId:      strPtr(agentID.String()),
+								ParentTaskId: strPtr(taskID1.String()),
+								DesiredPhase: v1.TaskPhase_TASK_PHASE_RUNNING,
+							},
+							Status: &v1.TaskStatus{
+								Usage: &v1.TaskUsage{},
+								Phase: v1.TaskPhase_TASK_PHASE_AWAITING,
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			Name: "invalid agent ID format",
 			Request: &v1.ListTasksRequest{
@@ -482,3 +599,329 @@ func TestDeleteTask(t *testing.T) {
 		},
 	})
 }
+
+func TestRenderTask(t *testing.T) {
+	setup := ServiceTestSetup[v1.RenderTaskRequest, v1.RenderTaskResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.RenderTaskRequest]) (*connect.Response[v1.RenderTaskResponse], error) {
+			return client.Task().RenderTask(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.RenderTaskResponse{}),
+			protocmp.Transform(),
+		},
+	}
+
+	taskID := uuid.MustParse("01234567-89ab-cdef-0123-456789abcdef")
+	agentID := uuid.MustParse("98765432-10fe-dcba-9876-543210fedcba")
+	modelID := uuid.MustParse("11111111-2222-3333-4444-555555555555")
+
+	seedConversation := func(ctx context.Context, db *memory.Client) {
+		modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+		model := test.NewModelBuilder(t, modelID, db, modelProvider).Build(ctx)
+		agent := test.NewAgentBuilder(t, agentID, db, model).WithName("coder").Build(ctx)
+		task := test.NewTaskBuilder(t, taskID, db, agent).Build(ctx)
+
+		test.NewMessageBuilder(t, uuid.New(), db, task).
+			WithContent(&types.MessageContent{Blocks: []types.MessageBlock{
+				{Kind: types.MessageBlockKindText, Payload: "Rename foo to bar"},
+			}}).
+			Build(ctx)
+
+		test.NewMessageBuilder(t, uuid.New(), db, task).
+			WithAgent(agent).
+			WithContent(&types.MessageContent{Blocks: []types.MessageBlock{
+				{Kind: types.MessageBlockKindText, Payload: "Renaming the function."},
+				{Kind: types.MessageBlockKindCodeInterpreterCall, Payload: `{"id":"call_1","tool":"code_interpreter","args":{"script":"edit_file(\"/src/main.go\", [{old: \"foo\", new: \"bar\"}]);"}}`},
+			}}).
+			WithUsage(&types.MessageUsage{InputTokens: 100, OutputTokens: 20, Cost: 0.0006}).
+			Build(ctx)
+
+		test.NewMessageBuilder(t, uuid.New(), db, task).
+			WithSource(types.MessageSourceSystem).
+			WithContent(&types.MessageContent{Blocks: []types.MessageBlock{
+				{Kind: types.MessageBlockKindCodeInterpreterResult, Payload: `{"id":"call_1","output":"done\n","function_calls":[{"tool_name":"edit_file","input":{"edit_file":{"path":"/src/main.go","diffs":[{"old":"foo","new":"bar"}]}},"output":{"edit_file":{"success":true,"path":"/src/main.go","replacements_made":1,"expected_replacements":1,"patch_info":{"patch":"--- main.go\n+++ main.go\n@@ -1 +1 @@\n-func foo() {}\n+func bar() {}\n","lines_added":1,"lines_removed":1}}},"index":0}],"error":""}`},
+			}}).
+			Build(ctx)
+	}
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.RenderTaskRequest, v1.RenderTaskResponse]{
+		{
+			Name: "invalid id format",
+			Request: &v1.RenderTaskRequest{
+				Id: "not-a-valid-uuid",
+			},
+			Expected: ServiceTestExpectation[v1.RenderTaskResponse]{
+				Error: "invalid_argument: invalid task ID format: invalid UUID length: 16",
+			},
+		},
+		{
+			Name: "task not found",
+			Request: &v1.RenderTaskRequest{
+				Id: taskID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.RenderTaskResponse]{
+				Error: "not_found: task not found",
+			},
+		},
+		{
+			Name:         "markdown",
+			SeedDatabase: seedConversation,
+			Request: &v1.RenderTaskRequest{
+				Id:     taskID.String(),
+				Format: v1.TranscriptFormat_TRANSCRIPT_FORMAT_MARKDOWN,
+			},
+			Expected: ServiceTestExpectation[v1.RenderTaskResponse]{
+				Response: v1.RenderTaskResponse{
+					Content: "# Task 01234567-89ab-cdef-0123-456789abcdef\n" +
+						"\n" +
+						"| | |\n" +
+						"|---|---|\n" +
+						"| Task | `01234567-89ab-cdef-0123-456789abcdef` |\n" +
+						"| Agent | coder |\n" +
+						"| Tokens | 0 input, 0 output, 0 cache write, 0 cache read |\n" +
+						"| Cost | $0.0000 |\n" +
+						"\n" +
+						"## 1. User\n" +
+						"\n" +
+						"Rename foo to bar\n" +
+						"\n" +
+						"## 2. Assistant\n" +
+						"\n" +
+						"Renaming the function.\n" +
+						"\n" +
+						"```javascript\n" +
+						"edit_file(\"/src/main.go\", [{old: \"foo\", new: \"bar\"}]);\n" +
+						"```\n" +
+						"\n" +
+						"_Usage: 100 input, 20 output, 0 cache write, 0 cache read tokens, $0.0006_\n" +
+						"\n" +
+						"## 3. Tool results\n" +
+						"\n" +
+						"**Output**\n" +
+						"\n" +
+						"```\n" +
+						"done\n" +
+						"```\n" +
+						"\n" +
+						"### 1. `edit_file`\n" +
+						"\n" +
+						"<details><summary>Input</summary>\n" +
+						"\n" +
+						"```json\n" +
+						"{\n" +
+						"  \"path\": \"/src/main.go\",\n" +
+						"  \"diffs\": [\n" +
+						"    {\n" +
+						"      \"old\": \"foo\",\n" +
+						"      \"new\": \"bar\"\n" +
+						"    }\n" +
+						"  ]\n" +
+						"}\n" +
+						"```\n" +
+						"\n" +
+						"</details>\n" +
+						"\n" +
+						"```diff\n" +
+						"--- main.go\n" +
+						"+++ main.go\n" +
+						"@@ -1 +1 @@\n" +
+						"-func foo() {}\n" +
+						"+func bar() {}\n" +
+						"```\n" +
+						"\n",
+					ContentType: "text/markdown; charset=utf-8",
+				},
+			},
+		},
+	})
+}
+
+func TestGetPreview(t *testing.T) {
+	setup := ServiceTestSetup[v1.GetPreviewRequest, v1.GetPreviewResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.GetPreviewRequest]) (*connect.Response[v1.GetPreviewResponse], error) {
+			return client.Task().GetPreview(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.GetPreviewResponse{}, v1.PreviewChange{}),
+			protocmp.Transform(),
+		},
+	}
+
+	taskID := uuid.MustParse("01234567-89ab-cdef-0123-456789abcdef")
+	agentID := uuid.MustParse("98765432-10fe-dcba-9876-543210fedcba")
+	modelID := uuid.MustParse("11111111-2222-3333-4444-555555555555")
+
+	dir := t.TempDir()
+	mainFile := filepath.Join(dir, "main.go")
+	if err := os.WriteFile(mainFile, []byte("func foo() {}\n"), 0644); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+	readmeFile := filepath.Join(dir, "README.md")
+
+	seedTask := func(preview *types.TaskPreview) func(ctx context.Context, db *memory.Client) {
+		return func(ctx context.Context, db *memory.Client) {
+			modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+			model := test.NewModelBuilder(t, modelID, db, modelProvider).Build(ctx)
+			agent := test.NewAgentBuilder(t, agentID, db, model).Build(ctx)
+
+			builder := test.NewTaskBuilder(t, taskID, db, agent)
+			if preview != nil {
+				builder = builder.WithPreview(preview)
+			}
+			builder.Build(ctx)
+		}
+	}
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.GetPreviewRequest, v1.GetPreviewResponse]{
+		{
+			Name: "invalid id format",
+			Request: &v1.GetPreviewRequest{
+				Id: "not-a-valid-uuid",
+			},
+			Expected: ServiceTestExpectation[v1.GetPreviewResponse]{
+				Error: "invalid_argument: invalid task ID format: invalid UUID length: 16",
+			},
+		},
+		{
+			Name:         "task not in preview mode",
+			SeedDatabase: seedTask(nil),
+			Request: &v1.GetPreviewRequest{
+				Id: taskID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.GetPreviewResponse]{
+				Error: "failed_precondition: task 01234567-89ab-cdef-0123-456789abcdef does not run in preview mode",
+			},
+		},
+		{
+			Name:         "no staged changes",
+			SeedDatabase: seedTask(&types.TaskPreview{}),
+			Request: &v1.GetPreviewRequest{
+				Id: taskID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.GetPreviewResponse]{
+				Response: v1.GetPreviewResponse{},
+			},
+		},
+		{
+			Name: "staged changes",
+			SeedDatabase: seedTask(&types.TaskPreview{Files: []types.PreviewFile{
+				{Path: readmeFile, Content: "# Project\n"},
+				{Path: mainFile, Content: "func bar() {}\n"},
+			}}),
+			Request: &v1.GetPreviewRequest{
+				Id: taskID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.GetPreviewResponse]{
+				Response: v1.GetPreviewResponse{
+					Changes: []*v1.PreviewChange{
+						{
+							Path:    readmeFile,
+							Created: true,
+							Patch:   diff.GeneratePatch(readmeFile, "", "# Project\n"),
+						},
+						{
+							Path:  mainFile,
+							Patch: diff.GeneratePatch(mainFile, "func foo() {}\n", "func bar() {}\n"),
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestApplyPreview(t *testing.T) {
+	setup := ServiceTestSetup[v1.ApplyPreviewRequest, v1.ApplyPreviewResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.ApplyPreviewRequest]) (*connect.Response[v1.ApplyPreviewResponse], error) {
+			return client.Task().ApplyPreview(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.ApplyPreviewResponse{}, v1.PreviewChange{}),
+			protocmp.Transform(),
+		},
+		QueryDatabase: func(ctx context.Context, db *memory.Client) (any, error) {
+			t, err := db.Task.Get(ctx, uuid.MustParse("01234567-89ab-cdef-0123-456789abcdef"))
+			if err != nil {
+				return nil, err
+			}
+			return t.Preview, nil
+		},
+	}
+
+	taskID := uuid.MustParse("01234567-89ab-cdef-0123-456789abcdef")
+	agentID := uuid.MustParse("98765432-10fe-dcba-9876-543210fedcba")
+	modelID := uuid.MustParse("11111111-2222-3333-4444-555555555555")
+
+	dir := t.TempDir()
+	mainFile := filepath.Join(dir, "main.go")
+	if err := os.WriteFile(mainFile, []byte("func foo() {}\n"), 0644); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+	preview := &types.TaskPreview{Files: []types.PreviewFile{
+		{Path: mainFile, Content: "func bar() {}\n", BaseHash: filesystem.HashContent([]byte("func foo() {}\n"))},
+	}}
+	outdated := &types.TaskPreview{Files: []types.PreviewFile{
+		{Path: mainFile, Content: "func bar() {}\n", BaseHash: filesystem.HashContent([]byte("func baz() {}\n"))},
+	}}
+
+	seedTask := func(phase types.TaskPhase, preview *types.TaskPreview) func(ctx context.Context, db *memory.Client) {
+		return func(ctx context.Context, db *memory.Client) {
+			modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+			model := test.NewModelBuilder(t, modelID, db, modelProvider).Build(ctx)
+			agent := test.NewAgentBuilder(t, agentID, db, model).Build(ctx)
+
+			task := test.NewTaskBuilder(t, taskID, db, agent).WithPreview(preview).Build(ctx)
+			db.Task.UpdateOne(task).SetPhase(phase).ExecX(ctx)
+		}
+	}
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.ApplyPreviewRequest, v1.ApplyPreviewResponse]{
+		{
+			Name:         "task is running",
+			SeedDatabase: seedTask(types.TaskPhaseRunning, preview),
+			Request: &v1.ApplyPreviewRequest{
+				Id: taskID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.ApplyPreviewResponse]{
+				Error: "failed_precondition: task 01234567-89ab-cdef-0123-456789abcdef is running, suspend it or wait until it is done before applying the preview",
+			},
+		},
+		{
+			Name:         "file changed since it was staged",
+			SeedDatabase: seedTask(types.TaskPhaseAwaiting, outdated),
+			Request: &v1.ApplyPreviewRequest{
+				Id: taskID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.ApplyPreviewResponse]{
+				Error:    fmt.Sprintf("failed_precondition: the preview was not applied because %s changed since the preview was created, review the changes again", mainFile),
+				Database: outdated,
+			},
+		},
+		{
+			Name:         "success",
+			SeedDatabase: seedTask(types.TaskPhaseAwaiting, preview),
+			Request: &v1.ApplyPreviewRequest{
+				Id: taskID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.ApplyPreviewResponse]{
+				Response: v1.ApplyPreviewResponse{
+					Changes: []*v1.PreviewChange{
+						{
+							Path:  mainFile,
+							Patch: diff.GeneratePatch(mainFile, "func foo() {}\n", "func bar() {}\n"),
+						},
+					},
+				},
+				Database: &types.TaskPreview{},
+			},
+		},
+	})
+
+	content, err := os.ReadFile(mainFile)
+	if err != nil {
+		t.Fatalf("failed to read file: %v", err)
+	}
+	if string(content) != "func bar() {}\n" {
+		t.Errorf("expected the preview to be written to %s, got %q", mainFile, content)
+	}
+}
@@ -0,0 +1,254 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/api/go/v1/v1connect"
+	"github.com/furisto/construct/backend/api/conv"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/tool"
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/furisto/construct/backend/tool/custom"
+	"github.com/google/uuid"
+)
+
+var _ v1connect.ToolServiceHandler = (*ToolHandler)(nil)
+
+func NewToolHandler(db *memory.Client) *ToolHandler {
+	return &ToolHandler{
+		db: db,
+	}
+}
+
+type ToolHandler struct {
+	db *memory.Client
+	v1connect.UnimplementedToolServiceHandler
+}
+
+func (h *ToolHandler) CreateTool(ctx context.Context, req *connect.Request[v1.CreateToolRequest]) (*connect.Response[v1.CreateToolResponse], error) {
+	if err := requireAdmin(ctx, "create tools"); err != nil {
+		return nil, apiError(err)
+	}
+
+	if slices.Contains(base.BuiltinToolNames, req.Msg.Name) {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("tool name %s is reserved for a builtin tool", req.Msg.Name)))
+	}
+
+	schema, err := custom.ParseSchema(req.Msg.InputSchema)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	create := h.db.Tool.Create().
+		SetName(req.Msg.Name).
+		SetDescription(req.Msg.Description)
+
+	if schema != nil {
+		create = create.SetInputSchema(schema)
+	}
+
+	if req.Msg.TimeoutSeconds > 0 {
+		create = create.SetTimeoutSeconds(int(req.Msg.TimeoutSeconds))
+	}
+
+	if err := applyToolBackend(create.Mutation(), req.Msg); err != nil {
+		return nil, apiError(err)
+	}
+
+	t, err := create.Save(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoTool, err := conv.ConvertToolToProto(t)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.CreateToolResponse{
+		Tool: protoTool,
+	}), nil
+}
+
+func (h *ToolHandler) GetTool(ctx context.Context, req *connect.Request[v1.GetToolRequest]) (*connect.Response[v1.GetToolResponse], error) {
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid tool ID format: %w", err)))
+	}
+
+	t, err := h.db.Tool.Get(ctx, id)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoTool, err := conv.ConvertToolToProto(t)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.GetToolResponse{
+		Tool: protoTool,
+	}), nil
+}
+
+func (h *ToolHandler) ListTools(ctx context.Context, req *connect.Request[v1.ListToolsRequest]) (*connect.Response[v1.ListToolsResponse], error) {
+	query := h.db.Tool.Query()
+
+	if req.Msg.Filter != nil {
+		if req.Msg.Filter.Name != nil {
+			query = query.Where(tool.Name(*req.Msg.Filter.Name))
+		}
+
+		if req.Msg.Filter.Enabled != nil {
+			query = query.Where(tool.Enabled(*req.Msg.Filter.Enabled))
+		}
+	}
+
+	tools, err := query.Order(tool.ByName()).All(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoTools := make([]*v1.Tool, 0, len(tools))
+	for _, t := range tools {
+		protoTool, err := conv.ConvertToolToProto(t)
+		if err != nil {
+			return nil, apiError(err)
+		}
+		protoTools = append(protoTools, protoTool)
+	}
+
+	return connect.NewResponse(&v1.ListToolsResponse{
+		Tools: protoTools,
+	}), nil
+}
+
+func (h *ToolHandler) UpdateTool(ctx context.Context, req *connect.Request[v1.UpdateToolRequest]) (*connect.Response[v1.UpdateToolResponse], error) {
+	if err := requireAdmin(ctx, "update tools"); err != nil {
+		return nil, apiError(err)
+	}
+
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid tool ID format: %w", err)))
+	}
+
+	update := h.db.Tool.UpdateOneID(id)
+
+	if req.Msg.Description != nil {
+		update = update.SetDescription(*req.Msg.Description)
+	}
+
+	if req.Msg.InputSchema != nil {
+		schema, err := custom.ParseSchema(*req.Msg.InputSchema)
+		if err != nil {
+			return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+		}
+		if schema != nil {
+			update = update.SetInputSchema(schema)
+		} else {
+			update = update.ClearInputSchema()
+		}
+	}
+
+	if req.Msg.Backend != nil {
+		if err := applyToolBackend(update.Mutation(), req.Msg); err != nil {
+			return nil, apiError(err)
+		}
+	}
+
+	if req.Msg.TimeoutSeconds != nil {
+		timeout := int(*req.Msg.TimeoutSeconds)
+		if timeout == 0 {
+			timeout = int(custom.DefaultTimeout / time.Second)
+		}
+		update = update.SetTimeoutSeconds(timeout)
+	}
+
+	if req.Msg.Enabled != nil {
+		update = update.SetEnabled(*req.Msg.Enabled)
+	}
+
+	t, err := update.Save(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoTool, err := conv.ConvertToolToProto(t)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.UpdateToolResponse{
+		Tool: protoTool,
+	}), nil
+}
+
+func (h *ToolHandler) DeleteTool(ctx context.Context, req *connect.Request[v1.DeleteToolRequest]) (*connect.Response[v1.DeleteToolResponse], error) {
+	if err := requireAdmin(ctx, "delete tools"); err != nil {
+		return nil, apiError(err)
+	}
+
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid tool ID format: %w", err)))
+	}
+
+	if err := h.db.Tool.DeleteOneID(id).Exec(ctx); err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.DeleteToolResponse{}), nil
+}
+
+// toolBackend is implemented by the requests that carry the backend oneof.
+type toolBackend interface {
+	GetCommand() *v1.CommandBackend
+	GetHttp() *v1.HttpBackend
+	GetJavascript() *v1.JavaScriptBackend
+}
+
+// applyToolBackend validates the backend of the request and replaces the
+// backend fields of the mutation with it.
+func applyToolBackend(m *memory.ToolMutation, backend toolBackend) error {
+	m.ClearCommand()
+	m.ClearURL()
+	m.ClearHeaders()
+	m.ClearSource()
+
+	switch {
+	case backend.GetCommand() != nil:
+		if backend.GetCommand().Command == "" {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("tool command is required"))
+		}
+		m.SetKind(types.ToolKindCommand)
+		m.SetCommand(backend.GetCommand().Command)
+	case backend.GetHttp() != nil:
+		u, err := url.Parse(backend.GetHttp().Url)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid tool URL %q: must be an absolute http or https URL", backend.GetHttp().Url))
+		}
+		m.SetKind(types.ToolKindHTTP)
+		m.SetURL(backend.GetHttp().Url)
+		if len(backend.GetHttp().Headers) > 0 {
+			m.SetHeaders(backend.GetHttp().Headers)
+		}
+	case backend.GetJavascript() != nil:
+		if _, err := custom.CompileModule("tool", backend.GetJavascript().Source); err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid tool source: %w", err))
+		}
+		m.SetKind(types.ToolKindJavaScript)
+		m.SetSource(backend.GetJavascript().Source)
+	default:
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("tool backend is required"))
+	}
+
+	return nil
+}
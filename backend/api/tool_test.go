@@ -0,0 +1,286 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/testing/protocmp"
+	_ "modernc.org/sqlite"
+)
+
+func TestCreateTool(t *testing.T) {
+	setup := ServiceTestSetup[v1.CreateToolRequest, v1.CreateToolResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.CreateToolRequest]) (*connect.Response[v1.CreateToolResponse], error) {
+			return client.Tool().CreateTool(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.CreateToolResponse{}, v1.Tool{}, v1.ToolMetadata{}, v1.ToolSpec{}),
+			protocmp.Transform(),
+			protocmp.IgnoreFields(&v1.ToolMetadata{}, "id", "created_at", "updated_at"),
+		},
+		QueryDatabase: func(ctx context.Context, db *memory.Client) (any, error) {
+			tools, err := db.Tool.Query().All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			headers := make([]map[string]string, 0, len(tools))
+			for _, t := range tools {
+				headers = append(headers, t.Headers)
+			}
+			return headers, nil
+		},
+	}
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.CreateToolRequest, v1.CreateToolResponse]{
+		{
+			Name: "builtin name",
+			Request: &v1.CreateToolRequest{
+				Name:        "read_file",
+				Description: "Reads a file",
+				Backend:     &v1.CreateToolRequest_Command{Command: &v1.CommandBackend{Command: "cat"}},
+			},
+			Expected: ServiceTestExpectation[v1.CreateToolResponse]{
+				Error: "invalid_argument: tool name read_file is reserved for a builtin tool",
+			},
+		},
+		{
+			Name: "invalid input schema",
+			Request: &v1.CreateToolRequest{
+				Name:        "lookup_ticket",
+				Description: "Looks up a ticket",
+				InputSchema: `{"type": "string"}`,
+				Backend:     &v1.CreateToolRequest_Command{Command: &v1.CommandBackend{Command: "ticket"}},
+			},
+			Expected: ServiceTestExpectation[v1.CreateToolResponse]{
+				Error: "invalid_argument: input schema must describe an object, got type string",
+			},
+		},
+		{
+			Name: "invalid javascript",
+			Request: &v1.CreateToolRequest{
+				Name:        "preview_url",
+				Description: "Returns the preview URL of a branch",
+				Backend:     &v1.CreateToolRequest_Javascript{Javascript: &v1.JavaScriptBackend{Source: "module.exports = ;"}},
+			},
+			Expected: ServiceTestExpectation[v1.CreateToolResponse]{
+				Error: "invalid_argument: invalid tool source: SyntaxError: tool: Line 2:18 Unexpected token ; (and 2 more errors)",
+			},
+		},
+		{
+			Name: "success",
+			Request: &v1.CreateToolRequest{
+				Name:        "lookup_ticket",
+				Description: "Looks up a ticket",
+				InputSchema: `{"type":"object","properties":{"id":{"type":"string"}},"required":["id"]}`,
+				Backend: &v1.CreateToolRequest_Http{Http: &v1.HttpBackend{
+					Url:     "https://tickets.example.com/api/lookup",
+					Headers: map[string]string{"Authorization": "Bearer secret"},
+				}},
+			},
+			Expected: ServiceTestExpectation[v1.CreateToolResponse]{
+				Response: v1.CreateToolResponse{
+					Tool: &v1.Tool{
+						Metadata: &v1.ToolMetadata{},
+						Spec: &v1.ToolSpec{
+							Name:           "lookup_ticket",
+							Description:    "Looks up a ticket",
+							InputSchema:    `{"properties":{"id":{"type":"string"}},"required":["id"],"type":"object"}`,
+							Backend:        &v1.ToolSpec_Http{Http: &v1.HttpBackend{Url: "https://tickets.example.com/api/lookup"}},
+							TimeoutSeconds: 30,
+							Enabled:        true,
+						},
+					},
+				},
+				Database: []map[string]string{{"Authorization": "Bearer secret"}},
+			},
+		},
+	})
+}
+
+func TestUpdateTool(t *testing.T) {
+	setup := ServiceTestSetup[v1.UpdateToolRequest, v1.UpdateToolResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.UpdateToolRequest]) (*connect.Response[v1.UpdateToolResponse], error) {
+			return client.Tool().UpdateTool(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.UpdateToolResponse{}, v1.Tool{}, v1.ToolMetadata{}, v1.ToolSpec{}),
+			protocmp.Transform(),
+			protocmp.IgnoreFields(&v1.ToolMetadata{}, "created_at", "updated_at"),
+		},
+		QueryDatabase: func(ctx context.Context, db *memory.Client) (any, error) {
+			tools, err := db.Tool.Query().All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			urls := make([]string, 0, len(tools))
+			for _, t := range tools {
+				urls = append(urls, t.URL)
+			}
+			return urls, nil
+		},
+	}
+
+	toolID := uuid.New()
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.UpdateToolRequest, v1.UpdateToolResponse]{
+		{
+			Name: "tool not found",
+			Request: &v1.UpdateToolRequest{
+				Id:      toolID.String(),
+				Enabled: boolPtr(false),
+			},
+			Expected: ServiceTestExpectation[v1.UpdateToolResponse]{
+				Error: "not_found: tool not found",
+			},
+		},
+		{
+			Name: "replace backend and disable",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				seedTool(t, ctx, db, toolID)
+			},
+			Request: &v1.UpdateToolRequest{
+				Id:      toolID.String(),
+				Backend: &v1.UpdateToolRequest_Command{Command: &v1.CommandBackend{Command: "deploy-preview --json"}},
+				Enabled: boolPtr(false),
+			},
+			Expected: ServiceTestExpectation[v1.UpdateToolResponse]{
+				Response: v1.UpdateToolResponse{
+					Tool: &v1.Tool{
+						Metadata: &v1.ToolMetadata{
+							Id: toolID.String(),
+						},
+						Spec: &v1.ToolSpec{
+							Name:           "deploy_preview",
+							Description:    "Deploys a preview environment",
+							Backend:        &v1.ToolSpec_Command{Command: &v1.CommandBackend{Command: "deploy-preview --json"}},
+							TimeoutSeconds: 30,
+						},
+					},
+				},
+				Database: []string{""},
+			},
+		},
+	})
+}
+
+func TestListTools(t *testing.T) {
+	setup := ServiceTestSetup[v1.ListToolsRequest, v1.ListToolsResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.ListToolsRequest]) (*connect.Response[v1.ListToolsResponse], error) {
+			return client.Tool().ListTools(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.ListToolsResponse{}, v1.Tool{}, v1.ToolMetadata{}, v1.ToolSpec{}),
+			protocmp.Transform(),
+			protocmp.IgnoreFields(&v1.ToolMetadata{}, "created_at", "updated_at"),
+		},
+	}
+
+	toolID := uuid.New()
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.ListToolsRequest, v1.ListToolsResponse]{
+		{
+			Name: "filter by enabled",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				seedTool(t, ctx, db, toolID)
+				_, err := db.Tool.Create().
+					SetName("lookup_ticket").
+					SetDescription("Looks up a ticket").
+					SetKind(types.ToolKindCommand).
+					SetCommand("ticket").
+					SetEnabled(false).
+					Save(ctx)
+				if err != nil {
+					t.Fatalf("failed to create tool: %v", err)
+				}
+			},
+			Request: &v1.ListToolsRequest{
+				Filter: &v1.ListToolsRequest_Filter{
+					Enabled: boolPtr(true),
+				},
+			},
+			Expected: ServiceTestExpectation[v1.ListToolsResponse]{
+				Response: v1.ListToolsResponse{
+					Tools: []*v1.Tool{
+						{
+							Metadata: &v1.ToolMetadata{
+								Id: toolID.String(),
+							},
+							Spec: &v1.ToolSpec{
+								Name:           "deploy_preview",
+								Description:    "Deploys a preview environment",
+								Backend:        &v1.ToolSpec_Http{Http: &v1.HttpBackend{Url: "https://deploy.example.com/preview"}},
+								TimeoutSeconds: 30,
+								Enabled:        true,
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestDeleteTool(t *testing.T) {
+	setup := ServiceTestSetup[v1.DeleteToolRequest, v1.DeleteToolResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.DeleteToolRequest]) (*connect.Response[v1.DeleteToolResponse], error) {
+			return client.Tool().DeleteTool(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.DeleteToolResponse{}),
+			protocmp.Transform(),
+		},
+		QueryDatabase: func(ctx context.Context, db *memory.Client) (any, error) {
+			return db.Tool.Query().Count(ctx)
+		},
+	}
+
+	toolID := uuid.New()
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.DeleteToolRequest, v1.DeleteToolResponse]{
+		{
+			Name: "tool not found",
+			Request: &v1.DeleteToolRequest{
+				Id: toolID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.DeleteToolResponse]{
+				Error: "not_found: tool not found",
+			},
+		},
+		{
+			Name: "success",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				seedTool(t, ctx, db, toolID)
+			},
+			Request: &v1.DeleteToolRequest{
+				Id: toolID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.DeleteToolResponse]{
+				Response: v1.DeleteToolResponse{},
+				Database: 0,
+			},
+		},
+	})
+}
+
+func seedTool(t *testing.T, ctx context.Context, db *memory.Client, id uuid.UUID) {
+	t.Helper()
+
+	_, err := db.Tool.Create().
+		SetID(id).
+		SetName("deploy_preview").
+		SetDescription("Deploys a preview environment").
+		SetKind(types.ToolKindHTTP).
+		SetURL("https://deploy.example.com/preview").
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("failed to create tool: %v", err)
+	}
+}
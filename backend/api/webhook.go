@@ -0,0 +1,273 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"connectrpc.com/connect"
+	"entgo.io/ent/dialect/sql"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/api/go/v1/v1connect"
+	"github.com/furisto/construct/backend/api/conv"
+	"github.com/furisto/construct/backend/event"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/webhook"
+	"github.com/furisto/construct/backend/memory/webhookdeadletter"
+	"github.com/furisto/construct/backend/secret"
+	"github.com/google/uuid"
+)
+
+var _ v1connect.WebhookServiceHandler = (*WebhookHandler)(nil)
+
+func NewWebhookHandler(db *memory.Client, encryption *secret.Encryption, eventBus *event.Bus) *WebhookHandler {
+	return &WebhookHandler{
+		db:         db,
+		encryption: encryption,
+		eventBus:   eventBus,
+	}
+}
+
+type WebhookHandler struct {
+	db         *memory.Client
+	encryption *secret.Encryption
+	eventBus   *event.Bus
+	v1connect.UnimplementedWebhookServiceHandler
+}
+
+func (h *WebhookHandler) CreateWebhook(ctx context.Context, req *connect.Request[v1.CreateWebhookRequest]) (*connect.Response[v1.CreateWebhookResponse], error) {
+	if err := requireAdmin(ctx, "create webhooks"); err != nil {
+		return nil, apiError(err)
+	}
+
+	if err := validateWebhookURL(req.Msg.Url); err != nil {
+		return nil, apiError(err)
+	}
+
+	if req.Msg.Secret == "" {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("webhook secret is required")))
+	}
+
+	if len(req.Msg.Events) == 0 {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("at least one webhook event is required")))
+	}
+
+	events, err := conv.ConvertProtoWebhookEventsToMemory(req.Msg.Events)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	webhookID := uuid.New()
+	encryptedSecret, err := h.encryption.Encrypt([]byte(req.Msg.Secret), secret.WebhookAssociated(webhookID))
+	if err != nil {
+		return nil, apiError(fmt.Errorf("failed to encrypt webhook secret"))
+	}
+
+	create := h.db.Webhook.Create().
+		SetID(webhookID).
+		SetName(req.Msg.Name).
+		SetURL(req.Msg.Url).
+		SetSecret(encryptedSecret).
+		SetEvents(events)
+
+	if req.Msg.CostThreshold > 0 {
+		create = create.SetCostThreshold(req.Msg.CostThreshold)
+	}
+
+	w, err := create.Save(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+	event.Publish(h.eventBus, event.WebhookChangedEvent{WebhookID: w.ID})
+
+	protoWebhook, err := conv.ConvertWebhookToProto(w)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.CreateWebhookResponse{
+		Webhook: protoWebhook,
+	}), nil
+}
+
+func (h *WebhookHandler) GetWebhook(ctx context.Context, req *connect.Request[v1.GetWebhookRequest]) (*connect.Response[v1.GetWebhookResponse], error) {
+	if err := requireAdmin(ctx, "read webhooks"); err != nil {
+		return nil, apiError(err)
+	}
+
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid webhook ID format: %w", err)))
+	}
+
+	w, err := h.db.Webhook.Get(ctx, id)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoWebhook, err := conv.ConvertWebhookToProto(w)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.GetWebhookResponse{
+		Webhook: protoWebhook,
+	}), nil
+}
+
+func (h *WebhookHandler) ListWebhooks(ctx context.Context, req *connect.Request[v1.ListWebhooksRequest]) (*connect.Response[v1.ListWebhooksResponse], error) {
+	if err := requireAdmin(ctx, "list webhooks"); err != nil {
+		return nil, apiError(err)
+	}
+
+	query := h.db.Webhook.Query()
+
+	if req.Msg.Filter != nil {
+		if req.Msg.Filter.Name != nil {
+			query = query.Where(webhook.Name(*req.Msg.Filter.Name))
+		}
+
+		if req.Msg.Filter.Enabled != nil {
+			query = query.Where(webhook.Enabled(*req.Msg.Filter.Enabled))
+		}
+	}
+
+	webhooks, err := query.Order(webhook.ByName()).All(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoWebhooks := make([]*v1.Webhook, 0, len(webhooks))
+	for _, w := range webhooks {
+		protoWebhook, err := conv.ConvertWebhookToProto(w)
+		if err != nil {
+			return nil, apiError(err)
+		}
+		protoWebhooks = append(protoWebhooks, protoWebhook)
+	}
+
+	return connect.NewResponse(&v1.ListWebhooksResponse{
+		Webhooks: protoWebhooks,
+	}), nil
+}
+
+func (h *WebhookHandler) UpdateWebhook(ctx context.Context, req *connect.Request[v1.UpdateWebhookRequest]) (*connect.Response[v1.UpdateWebhookResponse], error) {
+	if err := requireAdmin(ctx, "update webhooks"); err != nil {
+		return nil, apiError(err)
+	}
+
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid webhook ID format: %w", err)))
+	}
+
+	update := h.db.Webhook.UpdateOneID(id)
+
+	if req.Msg.Name != nil {
+		update = update.SetName(*req.Msg.Name)
+	}
+
+	if req.Msg.Url != nil {
+		if err := validateWebhookURL(*req.Msg.Url); err != nil {
+			return nil, apiError(err)
+		}
+		update = update.SetURL(*req.Msg.Url)
+	}
+
+	if req.Msg.Secret != nil {
+		encryptedSecret, err := h.encryption.Encrypt([]byte(*req.Msg.Secret), secret.WebhookAssociated(id))
+		if err != nil {
+			return nil, apiError(fmt.Errorf("failed to encrypt webhook secret"))
+		}
+		update = update.SetSecret(encryptedSecret)
+	}
+
+	if len(req.Msg.Events) > 0 {
+		events, err := conv.ConvertProtoWebhookEventsToMemory(req.Msg.Events)
+		if err != nil {
+			return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+		}
+		update = update.SetEvents(events)
+	}
+
+	if req.Msg.CostThreshold != nil {
+		if *req.Msg.CostThreshold > 0 {
+			update = update.SetCostThreshold(*req.Msg.CostThreshold)
+		} else {
+			update = update.ClearCostThreshold()
+		}
+	}
+
+	if req.Msg.Enabled != nil {
+		update = update.SetEnabled(*req.Msg.Enabled)
+	}
+
+	w, err := update.Save(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+	event.Publish(h.eventBus, event.WebhookChangedEvent{WebhookID: w.ID})
+
+	protoWebhook, err := conv.ConvertWebhookToProto(w)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.UpdateWebhookResponse{
+		Webhook: protoWebhook,
+	}), nil
+}
+
+func (h *WebhookHandler) DeleteWebhook(ctx context.Context, req *connect.Request[v1.DeleteWebhookRequest]) (*connect.Response[v1.DeleteWebhookResponse], error) {
+	if err := requireAdmin(ctx, "delete webhooks"); err != nil {
+		return nil, apiError(err)
+	}
+
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid webhook ID format: %w", err)))
+	}
+
+	if err := h.db.Webhook.DeleteOneID(id).Exec(ctx); err != nil {
+		return nil, apiError(err)
+	}
+	event.Publish(h.eventBus, event.WebhookChangedEvent{WebhookID: id})
+
+	return connect.NewResponse(&v1.DeleteWebhookResponse{}), nil
+}
+
+func (h *WebhookHandler) ListWebhookDeadLetters(ctx context.Context, req *connect.Request[v1.ListWebhookDeadLettersRequest]) (*connect.Response[v1.ListWebhookDeadLettersResponse], error) {
+	if err := requireAdmin(ctx, "list webhook dead letters"); err != nil {
+		return nil, apiError(err)
+	}
+
+	id, err := uuid.Parse(req.Msg.WebhookId)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid webhook ID format: %w", err)))
+	}
+
+	deadLetters, err := h.db.WebhookDeadLetter.Query().
+		Where(webhookdeadletter.WebhookID(id)).
+		Order(webhookdeadletter.ByCreateTime(sql.OrderDesc())).
+		All(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoDeadLetters := make([]*v1.WebhookDeadLetter, 0, len(deadLetters))
+	for _, d := range deadLetters {
+		protoDeadLetters = append(protoDeadLetters, conv.ConvertWebhookDeadLetterToProto(d))
+	}
+
+	return connect.NewResponse(&v1.ListWebhookDeadLettersResponse{
+		DeadLetters: protoDeadLetters,
+	}), nil
+}
+
+func validateWebhookURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid webhook URL %q: must be an absolute http or https URL", rawURL))
+	}
+	return nil
+}
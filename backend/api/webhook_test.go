@@ -0,0 +1,279 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/testing/protocmp"
+	_ "modernc.org/sqlite"
+)
+
+func TestCreateWebhook(t *testing.T) {
+	setup := ServiceTestSetup[v1.CreateWebhookRequest, v1.CreateWebhookResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.CreateWebhookRequest]) (*connect.Response[v1.CreateWebhookResponse], error) {
+			return client.Webhook().CreateWebhook(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.CreateWebhookResponse{}, v1.Webhook{}, v1.WebhookMetadata{}, v1.WebhookSpec{}),
+			protocmp.Transform(),
+			protocmp.IgnoreFields(&v1.WebhookMetadata{}, "id", "created_at", "updated_at"),
+		},
+		QueryDatabase: func(ctx context.Context, db *memory.Client) (any, error) {
+			webhooks, err := db.Webhook.Query().All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			encrypted := make([]bool, 0, len(webhooks))
+			for _, w := range webhooks {
+				encrypted = append(encrypted, string(w.Secret) != "s3cret")
+			}
+			return encrypted, nil
+		},
+	}
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.CreateWebhookRequest, v1.CreateWebhookResponse]{
+		{
+			Name: "invalid url",
+			Request: &v1.CreateWebhookRequest{
+				Name:   "slack",
+				Url:    "hooks.slack.com/services/T000",
+				Secret: "s3cret",
+				Events: []v1.WebhookEvent{v1.WebhookEvent_WEBHOOK_EVENT_TASK_RESPONSE},
+			},
+			Expected: ServiceTestExpectation[v1.CreateWebhookResponse]{
+				Error: `invalid_argument: invalid webhook URL "hooks.slack.com/services/T000": must be an absolute http or https URL`,
+			},
+		},
+		{
+			Name: "missing events",
+			Request: &v1.CreateWebhookRequest{
+				Name:   "slack",
+				Url:    "https://hooks.slack.com/services/T000",
+				Secret: "s3cret",
+			},
+			Expected: ServiceTestExpectation[v1.CreateWebhookResponse]{
+				Error: "invalid_argument: at least one webhook event is required",
+			},
+		},
+		{
+			Name: "unspecified event",
+			Request: &v1.CreateWebhookRequest{
+				Name:   "slack",
+				Url:    "https://hooks.slack.com/services/T000",
+				Secret: "s3cret",
+				Events: []v1.WebhookEvent{v1.WebhookEvent_WEBHOOK_EVENT_UNSPECIFIED},
+			},
+			Expected: ServiceTestExpectation[v1.CreateWebhookResponse]{
+				Error: "invalid_argument: unsupported webhook event: WEBHOOK_EVENT_UNSPECIFIED",
+			},
+		},
+		{
+			Name: "success",
+			Request: &v1.CreateWebhookRequest{
+				Name:   "slack",
+				Url:    "https://hooks.slack.com/services/T000",
+				Secret: "s3cret",
+				Events: []v1.WebhookEvent{
+					v1.WebhookEvent_WEBHOOK_EVENT_TASK_RESPONSE,
+					v1.WebhookEvent_WEBHOOK_EVENT_TASK_COST_THRESHOLD,
+					v1.WebhookEvent_WEBHOOK_EVENT_TASK_RESPONSE,
+				},
+				CostThreshold: 5,
+			},
+			Expected: ServiceTestExpectation[v1.CreateWebhookResponse]{
+				Response: v1.CreateWebhookResponse{
+					Webhook: &v1.Webhook{
+						Metadata: &v1.WebhookMetadata{},
+						Spec: &v1.WebhookSpec{
+							Name: "slack",
+							Url:  "https://hooks.slack.com/services/T000",
+							Events: []v1.WebhookEvent{
+								v1.WebhookEvent_WEBHOOK_EVENT_TASK_RESPONSE,
+								v1.WebhookEvent_WEBHOOK_EVENT_TASK_COST_THRESHOLD,
+							},
+							CostThreshold: 5,
+							Enabled:       true,
+						},
+					},
+				},
+				Database: []bool{true},
+			},
+		},
+	})
+}
+
+func TestUpdateWebhook(t *testing.T) {
+	setup := ServiceTestSetup[v1.UpdateWebhookRequest, v1.UpdateWebhookResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.UpdateWebhookRequest]) (*connect.Response[v1.UpdateWebhookResponse], error) {
+			return client.Webhook().UpdateWebhook(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.UpdateWebhookResponse{}, v1.Webhook{}, v1.WebhookMetadata{}, v1.WebhookSpec{}),
+			protocmp.Transform(),
+			protocmp.IgnoreFields(&v1.WebhookMetadata{}, "created_at", "updated_at"),
+		},
+	}
+
+	webhookID := uuid.New()
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.UpdateWebhookRequest, v1.UpdateWebhookResponse]{
+		{
+			Name: "webhook not found",
+			Request: &v1.UpdateWebhookRequest{
+				Id:      webhookID.String(),
+				Enabled: boolPtr(false),
+			},
+			Expected: ServiceTestExpectation[v1.UpdateWebhookResponse]{
+				Error: "not_found: webhook not found",
+			},
+		},
+		{
+			Name: "disable and change events",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				seedWebhook(t, ctx, db, webhookID)
+			},
+			Request: &v1.UpdateWebhookRequest{
+				Id:            webhookID.String(),
+				Events:        []v1.WebhookEvent{v1.WebhookEvent_WEBHOOK_EVENT_TASK_ERROR},
+				CostThreshold: ptr(0.0),
+				Enabled:       boolPtr(false),
+			},
+			Expected: ServiceTestExpectation[v1.UpdateWebhookResponse]{
+				Response: v1.UpdateWebhookResponse{
+					Webhook: &v1.Webhook{
+						Metadata: &v1.WebhookMetadata{
+							Id: webhookID.String(),
+						},
+						Spec: &v1.WebhookSpec{
+							Name:   "ci",
+							Url:    "https://ci.example.com/hooks/construct",
+							Events: []v1.WebhookEvent{v1.WebhookEvent_WEBHOOK_EVENT_TASK_ERROR},
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestListWebhookDeadLetters(t *testing.T) {
+	setup := ServiceTestSetup[v1.ListWebhookDeadLettersRequest, v1.ListWebhookDeadLettersResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.ListWebhookDeadLettersRequest]) (*connect.Response[v1.ListWebhookDeadLettersResponse], error) {
+			return client.Webhook().ListWebhookDeadLetters(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.ListWebhookDeadLettersResponse{}, v1.WebhookDeadLetter{}),
+			protocmp.Transform(),
+			protocmp.IgnoreFields(&v1.WebhookDeadLetter{}, "id", "created_at"),
+		},
+	}
+
+	webhookID := uuid.New()
+	taskID := uuid.New()
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.ListWebhookDeadLettersRequest, v1.ListWebhookDeadLettersResponse]{
+		{
+			Name: "invalid webhook ID",
+			Request: &v1.ListWebhookDeadLettersRequest{
+				WebhookId: "not-a-valid-uuid",
+			},
+			Expected: ServiceTestExpectation[v1.ListWebhookDeadLettersResponse]{
+				Error: "invalid_argument: invalid webhook ID format: invalid UUID length: 16",
+			},
+		},
+		{
+			Name: "success",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				seedWebhook(t, ctx, db, webhookID)
+				_, err := db.WebhookDeadLetter.Create().
+					SetWebhookID(webhookID).
+					SetTaskID(taskID).
+					SetEventType(types.WebhookEventTaskResponse).
+					SetPayload(`{"event":"task.response"}`).
+					SetAttempts(5).
+					SetLastError("unexpected status code 502").
+					Save(ctx)
+				if err != nil {
+					t.Fatalf("failed to create dead letter: %v", err)
+				}
+			},
+			Request: &v1.ListWebhookDeadLettersRequest{
+				WebhookId: webhookID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.ListWebhookDeadLettersResponse]{
+				Response: v1.ListWebhookDeadLettersResponse{
+					DeadLetters: []*v1.WebhookDeadLetter{
+						{
+							WebhookId: webhookID.String(),
+							TaskId:    taskID.String(),
+							Event:     v1.WebhookEvent_WEBHOOK_EVENT_TASK_RESPONSE,
+							Payload:   `{"event":"task.response"}`,
+							Attempts:  5,
+							LastError: "unexpected status code 502",
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	setup := ServiceTestSetup[v1.DeleteWebhookRequest, v1.DeleteWebhookResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.DeleteWebhookRequest]) (*connect.Response[v1.DeleteWebhookResponse], error) {
+			return client.Webhook().DeleteWebhook(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.DeleteWebhookResponse{}),
+			protocmp.Transform(),
+		},
+	}
+
+	webhookID := uuid.New()
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.DeleteWebhookRequest, v1.DeleteWebhookResponse]{
+		{
+			Name: "webhook not found",
+			Request: &v1.DeleteWebhookRequest{
+				Id: webhookID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.DeleteWebhookResponse]{
+				Error: "not_found: webhook not found",
+			},
+		},
+		{
+			Name: "success",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				seedWebhook(t, ctx, db, webhookID)
+			},
+			Request: &v1.DeleteWebhookRequest{
+				Id: webhookID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.DeleteWebhookResponse]{
+				Response: v1.DeleteWebhookResponse{},
+			},
+		},
+	})
+}
+
+func seedWebhook(t *testing.T, ctx context.Context, db *memory.Client, id uuid.UUID) {
+	_, err := db.Webhook.Create().
+		SetID(id).
+		SetName("ci").
+		SetURL("https://ci.example.com/hooks/construct").
+		SetSecret([]byte("encrypted")).
+		SetEvents([]types.WebhookEventType{types.WebhookEventTaskResponse}).
+		SetCostThreshold(2).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("failed to create webhook: %v", err)
+	}
+}
@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/uuid"
+)
+
+// Principal is the caller of an API request.
+type Principal struct {
+	// UserID is nil for the local principal, which has no user record.
+	UserID *uuid.UUID
+	Name   string
+	Role   types.UserRole
+}
+
+// LocalPrincipal is used for callers on the unix socket. Access to the socket
+// is guarded by file permissions, so they are trusted as admins.
+var LocalPrincipal = Principal{
+	Name: "local",
+	Role: types.UserRoleAdmin,
+}
+
+type principalContextKey struct{}
+
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+// PrincipalFromContext returns the principal set by the auth interceptor. When
+// none is set the request did not pass through it, which only happens on the
+// unix socket, so the local principal is returned.
+func PrincipalFromContext(ctx context.Context) Principal {
+	principal, ok := ctx.Value(principalContextKey{}).(Principal)
+	if !ok {
+		return LocalPrincipal
+	}
+	return principal
+}
+
+func (p Principal) IsAdmin() bool {
+	return p.Role == types.UserRoleAdmin
+}
+
+// CanWrite reports whether the principal may create agents, tasks and messages.
+func (p Principal) CanWrite() bool {
+	return p.Role == types.UserRoleAdmin || p.Role == types.UserRoleMember
+}
+
+// CanReadAll reports whether the principal may read resources owned by others.
+func (p Principal) CanReadAll() bool {
+	return p.Role == types.UserRoleAdmin || p.Role == types.UserRoleViewer
+}
+
+// Owns reports whether a resource with the given owner belongs to the
+// principal. Resources without an owner were created locally and belong to
+// admins only.
+func (p Principal) Owns(ownerID *uuid.UUID) bool {
+	if p.IsAdmin() {
+		return true
+	}
+
+	return p.UserID != nil && ownerID != nil && *p.UserID == *ownerID
+}
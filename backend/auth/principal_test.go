@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/uuid"
+)
+
+func TestPrincipalFromContext(t *testing.T) {
+	if got := PrincipalFromContext(context.Background()); got != LocalPrincipal {
+		t.Errorf("expected local principal without interceptor, got %+v", got)
+	}
+
+	userID := uuid.New()
+	principal := Principal{UserID: &userID, Name: "alice", Role: types.UserRoleViewer}
+	if got := PrincipalFromContext(WithPrincipal(context.Background(), principal)); got != principal {
+		t.Errorf("expected %+v, got %+v", principal, got)
+	}
+}
+
+func TestPrincipalPermissions(t *testing.T) {
+	userID := uuid.New()
+	otherID := uuid.New()
+
+	tests := []struct {
+		role       types.UserRole
+		canWrite   bool
+		canReadAll bool
+		ownsOther  bool
+		ownsLocal  bool
+	}{
+		{role: types.UserRoleAdmin, canWrite: true, canReadAll: true, ownsOther: true, ownsLocal: true},
+		{role: types.UserRoleMember, canWrite: true},
+		{role: types.UserRoleViewer, canReadAll: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.role), func(t *testing.T) {
+			principal := Principal{UserID: &userID, Name: "alice", Role: tt.role}
+
+			if got := principal.CanWrite(); got != tt.canWrite {
+				t.Errorf("CanWrite() = %v, want %v", got, tt.canWrite)
+			}
+			if got := principal.CanReadAll(); got != tt.canReadAll {
+				t.Errorf("CanReadAll() = %v, want %v", got, tt.canReadAll)
+			}
+			if !principal.Owns(&userID) {
+				t.Error("expected principal to own its own resources")
+			}
+			if got := principal.Owns(&otherID); got != tt.ownsOther {
+				t.Errorf("Owns(other) = %v, want %v", got, tt.ownsOther)
+			}
+			if got := principal.Owns(nil); got != tt.ownsLocal {
+				t.Errorf("Owns(nil) = %v, want %v", got, tt.ownsLocal)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestGenerateToken(t *testing.T) {
+	first, err := GenerateToken()
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+	second, err := GenerateToken()
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+
+	if !strings.HasPrefix(first, TokenPrefix) {
+		t.Errorf("expected token to start with %q, got %q", TokenPrefix, first)
+	}
+	if first == second {
+		t.Error("expected distinct tokens")
+	}
+	if bytes.Equal(HashToken(first), HashToken(second)) {
+		t.Error("expected distinct token hashes")
+	}
+}
+
+func TestParseBearer(t *testing.T) {
+	tests := []struct {
+		header string
+		token  string
+		ok     bool
+	}{
+		{header: "Bearer ct_abc", token: "ct_abc", ok: true},
+		{header: "bearer ct_abc", token: "ct_abc", ok: true},
+		{header: "Bearer  ct_abc ", token: "ct_abc", ok: true},
+		{header: "Basic dXNlcjpwYXNz", ok: false},
+		{header: "Bearer", ok: false},
+		{header: "Bearer ", ok: false},
+		{header: "", ok: false},
+	}
+
+	for _, tt := range tests {
+		token, ok := ParseBearer(tt.header)
+		if ok != tt.ok || token != tt.token {
+			t.Errorf("ParseBearer(%q) = (%q, %v), want (%q, %v)", tt.header, token, ok, tt.token, tt.ok)
+		}
+	}
+}
@@ -1,6 +1,11 @@
 package event
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/uuid"
+)
 
 type TaskEvent struct {
 	TaskID uuid.UUID
@@ -14,9 +19,104 @@ type TaskSuspendedEvent struct {
 
 func (TaskSuspendedEvent) Event() {}
 
+// TaskInterruptedEvent is published when a user steers a running task. Work on the
+// task that started before IssuedAt is cancelled so that the steering message is
+// picked up next.
+type TaskInterruptedEvent struct {
+	TaskID   uuid.UUID
+	IssuedAt time.Time
+}
+
+func (TaskInterruptedEvent) Event() {}
+
 type MessageEvent struct {
 	MessageID uuid.UUID
 	TaskID    uuid.UUID
 }
 
 func (MessageEvent) Event() {}
+
+// TaskPhaseChangedEvent is published when the phase of a task differs from its
+// previously recorded phase.
+type TaskPhaseChangedEvent struct {
+	TaskID        uuid.UUID
+	Phase         types.TaskPhase
+	PreviousPhase types.TaskPhase
+}
+
+func (TaskPhaseChangedEvent) Event() {}
+
+// TaskResponseEvent is published when the agent answers without calling tools
+// and the task waits for new input.
+type TaskResponseEvent struct {
+	TaskID    uuid.UUID
+	MessageID uuid.UUID
+	Content   string
+}
+
+func (TaskResponseEvent) Event() {}
+
+// TaskErrorEvent is published when processing a task fails. Retryable errors,
+// e.g. rate limits of the model provider, are retried by the reconciler and
+// do not end the task.
+type TaskErrorEvent struct {
+	TaskID    uuid.UUID
+	Error     string
+	Retryable bool
+}
+
+func (TaskErrorEvent) Event() {}
+
+// TaskCostEvent is published whenever the accumulated cost of a task increases.
+type TaskCostEvent struct {
+	TaskID       uuid.UUID
+	PreviousCost float64
+	Cost         float64
+}
+
+func (TaskCostEvent) Event() {}
+
+// ResourceKind identifies the resource a ResourceChangedEvent refers to.
+type ResourceKind string
+
+const (
+	ResourceKindTask  ResourceKind = "task"
+	ResourceKindAgent ResourceKind = "agent"
+	ResourceKindModel ResourceKind = "model"
+)
+
+// ResourceOperation describes how a resource was changed.
+type ResourceOperation string
+
+const (
+	ResourceOperationCreated ResourceOperation = "created"
+	ResourceOperationUpdated ResourceOperation = "updated"
+	ResourceOperationDeleted ResourceOperation = "deleted"
+)
+
+// ResourceChangedEvent is published when a task, agent or model is created,
+// updated or deleted.
+type ResourceChangedEvent struct {
+	Kind      ResourceKind
+	Operation ResourceOperation
+	ID        uuid.UUID
+}
+
+func (ResourceChangedEvent) Event() {}
+
+// WebhookChangedEvent is published when a webhook is created, updated or
+// deleted, so that the dispatcher reloads the webhooks.
+type WebhookChangedEvent struct {
+	WebhookID uuid.UUID
+}
+
+func (WebhookChangedEvent) Event() {}
+
+// MessageCompletedEvent is published once a message has been fully persisted.
+type MessageCompletedEvent struct {
+	TaskID    uuid.UUID
+	MessageID uuid.UUID
+	Source    types.MessageSource
+}
+
+func (MessageCompletedEvent) Event() {}
@@ -0,0 +1,212 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultJournalCapacity is the number of events the journal retains so that
+// watchers can resume after a disconnect.
+const DefaultJournalCapacity = 4096
+
+const journalWatcherBuffer = 256
+
+var (
+	// ErrInvalidCursor is returned when a cursor was not issued by a journal.
+	ErrInvalidCursor = errors.New("invalid cursor")
+	// ErrCursorExpired is returned when the events after a cursor are no longer
+	// retained, either because they were evicted or because the daemon restarted.
+	ErrCursorExpired = errors.New("cursor expired")
+	// ErrWatcherLagged is returned when a watcher does not keep up with the
+	// published events. The watcher can resume from the last cursor it received.
+	ErrWatcherLagged = errors.New("watcher fell behind")
+)
+
+// JournalEntry is an event recorded by the journal.
+type JournalEntry struct {
+	// Cursor identifies the position of the entry in the journal. Passing it to
+	// Watch resumes the stream after this entry.
+	Cursor string
+	Time   time.Time
+	// Event is one of TaskPhaseChangedEvent, ResourceChangedEvent or
+	// MessageCompletedEvent.
+	Event any
+
+	sequence uint64
+}
+
+type journalWatcher struct {
+	channel chan JournalEntry
+}
+
+// Journal records the daemon wide events published on the bus in a bounded,
+// in-memory log and fans them out to watchers. Entries are ordered by the time
+// the journal received them.
+type Journal struct {
+	epoch    string
+	capacity int
+	entries  []JournalEntry
+	next     uint64
+	watchers map[*journalWatcher]struct{}
+	mu       sync.Mutex
+}
+
+func NewJournal(bus *Bus, capacity int) *Journal {
+	journal := &Journal{
+		epoch:    strconv.FormatInt(time.Now().UnixNano(), 36),
+		capacity: capacity,
+		next:     1,
+		watchers: make(map[*journalWatcher]struct{}),
+	}
+
+	Subscribe(bus, func(ctx context.Context, e TaskPhaseChangedEvent) {
+		journal.record(e)
+	}, nil)
+	Subscribe(bus, func(ctx context.Context, e ResourceChangedEvent) {
+		journal.record(e)
+	}, nil)
+	Subscribe(bus, func(ctx context.Context, e MessageCompletedEvent) {
+		journal.record(e)
+	}, nil)
+
+	return journal
+}
+
+func (j *Journal) record(event any) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	entry := JournalEntry{
+		Cursor:   j.cursor(j.next),
+		Time:     time.Now(),
+		Event:    event,
+		sequence: j.next,
+	}
+	j.next++
+
+	j.entries = append(j.entries, entry)
+	if len(j.entries) > j.capacity {
+		j.entries = j.entries[len(j.entries)-j.capacity:]
+	}
+
+	for watcher := range j.watchers {
+		select {
+		case watcher.channel <- entry:
+		default:
+			delete(j.watchers, watcher)
+			close(watcher.channel)
+		}
+	}
+}
+
+// Watch starts watching the journal. The returned sequence yields the entries
+// recorded after the cursor followed by all entries recorded afterwards. An empty
+// cursor only yields new entries. The watch ends when the context is cancelled
+// or the iteration is stopped.
+func (j *Journal) Watch(ctx context.Context, cursor string) (iter.Seq2[JournalEntry, error], error) {
+	watcher := &journalWatcher{
+		channel: make(chan JournalEntry, journalWatcherBuffer),
+	}
+
+	backlog, err := j.register(watcher, cursor)
+	if err != nil {
+		return nil, err
+	}
+	stop := context.AfterFunc(ctx, func() { j.unregister(watcher) })
+
+	return func(yield func(JournalEntry, error) bool) {
+		defer func() {
+			stop()
+			j.unregister(watcher)
+		}()
+
+		for _, entry := range backlog {
+			if !yield(entry, nil) {
+				return
+			}
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case entry, ok := <-watcher.channel:
+				if !ok {
+					yield(JournalEntry{}, ErrWatcherLagged)
+					return
+				}
+				if !yield(entry, nil) {
+					return
+				}
+			}
+		}
+	}, nil
+}
+
+func (j *Journal) register(watcher *journalWatcher, cursor string) ([]JournalEntry, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	var backlog []JournalEntry
+	if cursor != "" {
+		after, err := j.parseCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		oldest := j.next - uint64(len(j.entries))
+		if after+1 < oldest {
+			return nil, ErrCursorExpired
+		}
+		if after >= j.next {
+			return nil, ErrInvalidCursor
+		}
+		backlog = slices.Clone(j.entries[after+1-oldest:])
+	}
+
+	j.watchers[watcher] = struct{}{}
+	return backlog, nil
+}
+
+func (j *Journal) unregister(watcher *journalWatcher) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	delete(j.watchers, watcher)
+}
+
+// WatcherCount returns the number of active watchers.
+// This is primarily useful for testing and debugging.
+func (j *Journal) WatcherCount() int {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return len(j.watchers)
+}
+
+func (j *Journal) cursor(sequence uint64) string {
+	return fmt.Sprintf("%s-%d", j.epoch, sequence)
+}
+
+func (j *Journal) parseCursor(cursor string) (uint64, error) {
+	epoch, sequence, ok := strings.Cut(cursor, "-")
+	if !ok {
+		return 0, ErrInvalidCursor
+	}
+
+	seq, err := strconv.ParseUint(sequence, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidCursor
+	}
+
+	if epoch != j.epoch {
+		return 0, ErrCursorExpired
+	}
+
+	return seq, nil
+}
@@ -0,0 +1,216 @@
+package event_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/furisto/construct/backend/event"
+	"github.com/google/uuid"
+)
+
+func TestJournal_WatchNewEvents(t *testing.T) {
+	t.Parallel()
+
+	bus := event.NewBus(nil)
+	defer bus.Close()
+	journal := event.NewJournal(bus, event.DefaultJournalCapacity)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	entries := watchJournal(ctx, journal, "")
+	waitForJournal(t, func() bool { return journal.WatcherCount() == 1 })
+
+	agentID := uuid.New()
+	event.Publish(bus, event.ResourceChangedEvent{
+		Kind:      event.ResourceKindAgent,
+		Operation: event.ResourceOperationCreated,
+		ID:        agentID,
+	})
+
+	entry := receiveJournalEntry(t, entries)
+	changed, ok := entry.Event.(event.ResourceChangedEvent)
+	if !ok || changed.ID != agentID {
+		t.Fatalf("unexpected journal entry: %+v", entry)
+	}
+	if entry.Cursor == "" {
+		t.Error("expected entry to have a cursor")
+	}
+
+	cancel()
+	waitForJournal(t, func() bool { return journal.WatcherCount() == 0 })
+}
+
+func TestJournal_ResumeFromCursor(t *testing.T) {
+	t.Parallel()
+
+	bus := event.NewBus(nil)
+	defer bus.Close()
+	journal := event.NewJournal(bus, event.DefaultJournalCapacity)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	recorded := publishTaskEvents(t, bus, journal, 3)
+
+	resumed := watchJournal(ctx, journal, recorded[0].Cursor)
+	for _, want := range recorded[1:] {
+		got := receiveJournalEntry(t, resumed)
+		if got.Cursor != want.Cursor {
+			t.Errorf("expected cursor %s, got %s", want.Cursor, got.Cursor)
+		}
+	}
+
+	latest := watchJournal(ctx, journal, recorded[2].Cursor)
+	select {
+	case entry := <-latest:
+		t.Errorf("expected no backlog after the latest cursor, got %+v", entry)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestJournal_CursorErrors(t *testing.T) {
+	t.Parallel()
+
+	bus := event.NewBus(nil)
+	defer bus.Close()
+	journal := event.NewJournal(bus, 1)
+
+	otherBus := event.NewBus(nil)
+	defer otherBus.Close()
+	other := event.NewJournal(otherBus, 1)
+
+	recorded := publishTaskEvents(t, bus, journal, 3)
+	foreign := publishTaskEvents(t, otherBus, other, 1)
+
+	tests := []struct {
+		name   string
+		cursor string
+		err    error
+	}{
+		{name: "evicted", cursor: recorded[0].Cursor, err: event.ErrCursorExpired},
+		{name: "malformed", cursor: "not-a-cursor", err: event.ErrInvalidCursor},
+		{name: "other journal", cursor: foreign[0].Cursor, err: event.ErrCursorExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := journal.Watch(context.Background(), tt.cursor)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("expected %v, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestJournal_LaggingWatcher(t *testing.T) {
+	t.Parallel()
+
+	bus := event.NewBus(nil)
+	defer bus.Close()
+	journal := event.NewJournal(bus, event.DefaultJournalCapacity)
+
+	release := make(chan struct{})
+	result := make(chan error, 1)
+	go func() {
+		received := 0
+		entries, err := journal.Watch(context.Background(), "")
+		if err != nil {
+			result <- err
+			return
+		}
+		for _, err := range entries {
+			if err != nil {
+				result <- err
+				return
+			}
+			if received == 0 {
+				<-release
+			}
+			received++
+		}
+		result <- nil
+	}()
+	waitForJournal(t, func() bool { return journal.WatcherCount() == 1 })
+
+	for range 400 {
+		event.Publish(bus, event.TaskPhaseChangedEvent{TaskID: uuid.New()})
+	}
+	waitForJournal(t, func() bool { return journal.WatcherCount() == 0 })
+	close(release)
+
+	select {
+	case err := <-result:
+		if !errors.Is(err, event.ErrWatcherLagged) {
+			t.Errorf("expected %v, got %v", event.ErrWatcherLagged, err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("watcher did not finish")
+	}
+}
+
+func watchJournal(ctx context.Context, journal *event.Journal, cursor string) <-chan event.JournalEntry {
+	entries := make(chan event.JournalEntry, 16)
+	watch, err := journal.Watch(ctx, cursor)
+	if err != nil {
+		close(entries)
+		return entries
+	}
+	go func() {
+		for entry, err := range watch {
+			if err != nil {
+				return
+			}
+			entries <- entry
+		}
+	}()
+	return entries
+}
+
+// publishTaskEvents publishes the events one after another so that they are
+// recorded in a deterministic order.
+func publishTaskEvents(t *testing.T, bus *event.Bus, journal *event.Journal, count int) []event.JournalEntry {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	entries := watchJournal(ctx, journal, "")
+	waitForJournal(t, func() bool { return journal.WatcherCount() == 1 })
+
+	var recorded []event.JournalEntry
+	for range count {
+		event.Publish(bus, event.TaskPhaseChangedEvent{TaskID: uuid.New()})
+		recorded = append(recorded, receiveJournalEntry(t, entries))
+	}
+
+	cancel()
+	waitForJournal(t, func() bool { return journal.WatcherCount() == 0 })
+	return recorded
+}
+
+func receiveJournalEntry(t *testing.T, entries <-chan event.JournalEntry) event.JournalEntry {
+	t.Helper()
+
+	select {
+	case entry := <-entries:
+		return entry
+	case <-time.After(time.Second):
+		t.Fatal("expected journal entry")
+		return event.JournalEntry{}
+	}
+}
+
+func waitForJournal(t *testing.T, condition func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met in time")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
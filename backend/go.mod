@@ -19,6 +19,7 @@ require (
 	github.com/openai/openai-go v1.2.0
 	github.com/posthog/posthog-go v1.5.12
 	github.com/prometheus/client_golang v1.23.2
+	github.com/robfig/cron/v3 v3.0.1
 	github.com/shopspring/decimal v1.4.0
 	github.com/sourcegraph/go-diff-patch v0.0.0-20240223163233-798fd1e94a8e
 	github.com/spf13/afero v1.14.0
@@ -0,0 +1,169 @@
+package instruction
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+const (
+	// NestedFileName is the instruction file picked up from subdirectories
+	// of the project when the agent works inside them.
+	NestedFileName = "AGENTS.md"
+
+	// MaxFileSize is the number of bytes included from a single file.
+	MaxFileSize = 16 * 1024
+	// MaxProjectSize is the number of bytes included from all project level
+	// instruction files together.
+	MaxProjectSize = 48 * 1024
+)
+
+// ProjectFileNames are the instruction files looked up in the project
+// directory and its parents up to the root of the repository.
+var ProjectFileNames = []string{
+	NestedFileName,
+	filepath.Join(".construct", "instructions.md"),
+}
+
+type File struct {
+	Path      string
+	Content   string
+	Truncated bool
+}
+
+// ForProject returns the instruction files that apply to the whole project.
+// Files of the repository root come first so that more specific instructions
+// closer to the project directory are read last.
+func ForProject(fsys afero.Fs, projectDirectory string) ([]File, error) {
+	if projectDirectory == "" {
+		return nil, nil
+	}
+
+	var files []File
+	budget := MaxProjectSize
+	for _, dir := range projectDirectories(fsys, projectDirectory) {
+		for _, name := range ProjectFileNames {
+			if budget <= 0 {
+				return files, nil
+			}
+
+			file, found, err := readFile(fsys, filepath.Join(dir, name), min(budget, MaxFileSize))
+			if err != nil {
+				return nil, err
+			}
+			if found {
+				files = append(files, *file)
+				budget -= len(file.Content)
+			}
+		}
+	}
+
+	return files, nil
+}
+
+// projectDirectories returns the directories from the repository root down to
+// the project directory. Without a repository only the project directory is
+// searched.
+func projectDirectories(fsys afero.Fs, projectDirectory string) []string {
+	dirs := []string{projectDirectory}
+	for dir := projectDirectory; ; {
+		if exists, _ := afero.Exists(fsys, filepath.Join(dir, ".git")); exists {
+			break
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return []string{projectDirectory}
+		}
+		dir = parent
+		dirs = append([]string{dir}, dirs...)
+	}
+	return dirs
+}
+
+// ForPath returns the nested instruction files between the project directory
+// and dir that are not in loaded. The project directory itself is covered by
+// ForProject and skipped.
+func ForPath(fsys afero.Fs, projectDirectory, dir string, loaded map[string]bool) ([]File, error) {
+	rel, err := filepath.Rel(projectDirectory, dir)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return nil, nil
+	}
+
+	var files []File
+	current := projectDirectory
+	for _, segment := range strings.Split(rel, string(filepath.Separator)) {
+		current = filepath.Join(current, segment)
+		path := filepath.Join(current, NestedFileName)
+		if loaded[path] {
+			continue
+		}
+
+		file, found, err := readFile(fsys, path, MaxFileSize)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			files = append(files, *file)
+		}
+	}
+
+	return files, nil
+}
+
+func readFile(fsys afero.Fs, path string, limit int) (*File, bool, error) {
+	f, err := fsys.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("failed to open instruction file %s: %w", path, err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to stat instruction file %s: %w", path, err)
+	}
+	if info.IsDir() {
+		return nil, false, nil
+	}
+
+	content, err := io.ReadAll(io.LimitReader(f, int64(limit)))
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read instruction file %s: %w", path, err)
+	}
+
+	return &File{
+		Path:      path,
+		Content:   string(content),
+		Truncated: info.Size() > int64(limit),
+	}, true, nil
+}
+
+// Format renders the files for inclusion in a prompt or tool output.
+func Format(files []File) string {
+	var builder strings.Builder
+	for i, file := range files {
+		if i > 0 {
+			builder.WriteString("\n\n")
+		}
+		fmt.Fprintf(&builder, "## Instructions from %s\n%s", file.Path, strings.TrimSpace(file.Content))
+		if file.Truncated {
+			builder.WriteString("\n[truncated: the file exceeds the size limit for instructions, read it for the remaining content]")
+		}
+	}
+	return builder.String()
+}
+
+func Paths(files []File) []string {
+	paths := make([]string, 0, len(files))
+	for _, file := range files {
+		paths = append(paths, file.Path)
+	}
+	return paths
+}
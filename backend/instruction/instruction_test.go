@@ -0,0 +1,107 @@
+package instruction
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/afero"
+)
+
+func TestForProject(t *testing.T) {
+	tests := []struct {
+		Name      string
+		Directory string
+		Setup     func(fs afero.Fs)
+		Expected  []File
+	}{
+		{
+			Name:      "no instruction files",
+			Directory: "/repo",
+			Setup: func(fs afero.Fs) {
+				fs.MkdirAll("/repo/.git", 0755)
+			},
+		},
+		{
+			Name:      "repository root before project directory",
+			Directory: "/repo/service",
+			Setup: func(fs afero.Fs) {
+				fs.MkdirAll("/repo/.git", 0755)
+				afero.WriteFile(fs, "/AGENTS.md", []byte("outside"), 0644)
+				afero.WriteFile(fs, "/repo/AGENTS.md", []byte("root"), 0644)
+				afero.WriteFile(fs, "/repo/service/AGENTS.md", []byte("service"), 0644)
+				afero.WriteFile(fs, "/repo/service/.construct/instructions.md", []byte("construct"), 0644)
+			},
+			Expected: []File{
+				{Path: "/repo/AGENTS.md", Content: "root"},
+				{Path: "/repo/service/AGENTS.md", Content: "service"},
+				{Path: "/repo/service/.construct/instructions.md", Content: "construct"},
+			},
+		},
+		{
+			Name:      "without repository only the project directory",
+			Directory: "/work/project",
+			Setup: func(fs afero.Fs) {
+				afero.WriteFile(fs, "/work/AGENTS.md", []byte("parent"), 0644)
+				afero.WriteFile(fs, "/work/project/AGENTS.md", []byte("project"), 0644)
+			},
+			Expected: []File{
+				{Path: "/work/project/AGENTS.md", Content: "project"},
+			},
+		},
+		{
+			Name:      "large file is truncated",
+			Directory: "/repo",
+			Setup: func(fs afero.Fs) {
+				fs.MkdirAll("/repo/.git", 0755)
+				afero.WriteFile(fs, "/repo/AGENTS.md", []byte(strings.Repeat("a", MaxFileSize+10)), 0644)
+			},
+			Expected: []File{
+				{Path: "/repo/AGENTS.md", Content: strings.Repeat("a", MaxFileSize), Truncated: true},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			test.Setup(fs)
+
+			files, err := ForProject(fs, test.Directory)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(test.Expected, files); diff != "" {
+				t.Errorf("files mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestForPath(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	afero.WriteFile(fs, "/repo/AGENTS.md", []byte("root"), 0644)
+	afero.WriteFile(fs, "/repo/web/AGENTS.md", []byte("web"), 0644)
+	afero.WriteFile(fs, "/repo/web/ui/AGENTS.md", []byte("ui"), 0644)
+
+	files, err := ForPath(fs, "/repo", "/repo/web/ui/components", map[string]bool{"/repo/web/AGENTS.md": true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []File{{Path: "/repo/web/ui/AGENTS.md", Content: "ui"}}
+	if diff := cmp.Diff(expected, files); diff != "" {
+		t.Errorf("files mismatch (-want +got):\n%s", diff)
+	}
+
+	for _, dir := range []string{"/repo", "/elsewhere/web"} {
+		files, err := ForPath(fs, "/repo", dir, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(files) != 0 {
+			t.Errorf("expected no files for %s, got %v", dir, files)
+		}
+	}
+}
@@ -3,6 +3,7 @@
 package memory
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -11,6 +12,8 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/furisto/construct/backend/memory/agent"
 	"github.com/furisto/construct/backend/memory/model"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/user"
 	"github.com/google/uuid"
 )
 
@@ -31,8 +34,16 @@ type Agent struct {
 	Instructions string `json:"instructions,omitempty"`
 	// Builtin holds the value of the "builtin" field.
 	Builtin bool `json:"builtin,omitempty"`
+	// McpServers holds the value of the "mcp_servers" field.
+	McpServers []types.MCPServer `json:"mcp_servers,omitempty"`
+	// ToolSet holds the value of the "tool_set" field.
+	ToolSet *types.ToolSet `json:"tool_set,omitempty"`
+	// ScriptLimits holds the value of the "script_limits" field.
+	ScriptLimits *types.ScriptLimits `json:"script_limits,omitempty"`
 	// ModelID holds the value of the "model_id" field.
 	ModelID uuid.UUID `json:"model_id,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID *uuid.UUID `json:"owner_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the AgentQuery when eager-loading is set.
 	Edges        AgentEdges `json:"edges"`
@@ -43,13 +54,17 @@ type Agent struct {
 type AgentEdges struct {
 	// Model holds the value of the model edge.
 	Model *Model `json:"model,omitempty"`
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
 	// Tasks holds the value of the tasks edge.
 	Tasks []*Task `json:"tasks,omitempty"`
 	// Messages holds the value of the messages edge.
 	Messages []*Message `json:"messages,omitempty"`
+	// Schedules holds the value of the schedules edge.
+	Schedules []*Schedule `json:"schedules,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [5]bool
 }
 
 // ModelOrErr returns the Model value or an error if the edge
@@ -63,10 +78,21 @@ func (e AgentEdges) ModelOrErr() (*Model, error) {
 	return nil, &NotLoadedError{edge: "model"}
 }
 
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AgentEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
 // TasksOrErr returns the Tasks value or an error if the edge
 // was not loaded in eager-loading.
 func (e AgentEdges) TasksOrErr() ([]*Task, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[2] {
 		return e.Tasks, nil
 	}
 	return nil, &NotLoadedError{edge: "tasks"}
@@ -75,17 +101,30 @@ func (e AgentEdges) TasksOrErr() ([]*Task, error) {
 // MessagesOrErr returns the Messages value or an error if the edge
 // was not loaded in eager-loading.
 func (e AgentEdges) MessagesOrErr() ([]*Message, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[3] {
 		return e.Messages, nil
 	}
 	return nil, &NotLoadedError{edge: "messages"}
 }
 
+// SchedulesOrErr returns the Schedules value or an error if the edge
+// was not loaded in eager-loading.
+func (e AgentEdges) SchedulesOrErr() ([]*Schedule, error) {
+	if e.loadedTypes[4] {
+		return e.Schedules, nil
+	}
+	return nil, &NotLoadedError{edge: "schedules"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Agent) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case agent.FieldOwnerID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case agent.FieldMcpServers, agent.FieldToolSet, agent.FieldScriptLimits:
+			values[i] = new([]byte)
 		case agent.FieldBuiltin:
 			values[i] = new(sql.NullBool)
 		case agent.FieldName, agent.FieldDescription, agent.FieldInstructions:
@@ -151,12 +190,43 @@ func (a *Agent) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				a.Builtin = value.Bool
 			}
+		case agent.FieldMcpServers:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field mcp_servers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &a.McpServers); err != nil {
+					return fmt.Errorf("unmarshal field mcp_servers: %w", err)
+				}
+			}
+		case agent.FieldToolSet:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tool_set", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &a.ToolSet); err != nil {
+					return fmt.Errorf("unmarshal field tool_set: %w", err)
+				}
+			}
+		case agent.FieldScriptLimits:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field script_limits", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &a.ScriptLimits); err != nil {
+					return fmt.Errorf("unmarshal field script_limits: %w", err)
+				}
+			}
 		case agent.FieldModelID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field model_id", values[i])
 			} else if value != nil {
 				a.ModelID = *value
 			}
+		case agent.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				a.OwnerID = new(uuid.UUID)
+				*a.OwnerID = *value.S.(*uuid.UUID)
+			}
 		default:
 			a.selectValues.Set(columns[i], values[i])
 		}
@@ -175,6 +245,11 @@ func (a *Agent) QueryModel() *ModelQuery {
 	return NewAgentClient(a.config).QueryModel(a)
 }
 
+// QueryOwner queries the "owner" edge of the Agent entity.
+func (a *Agent) QueryOwner() *UserQuery {
+	return NewAgentClient(a.config).QueryOwner(a)
+}
+
 // QueryTasks queries the "tasks" edge of the Agent entity.
 func (a *Agent) QueryTasks() *TaskQuery {
 	return NewAgentClient(a.config).QueryTasks(a)
@@ -185,6 +260,11 @@ func (a *Agent) QueryMessages() *MessageQuery {
 	return NewAgentClient(a.config).QueryMessages(a)
 }
 
+// QuerySchedules queries the "schedules" edge of the Agent entity.
+func (a *Agent) QuerySchedules() *ScheduleQuery {
+	return NewAgentClient(a.config).QuerySchedules(a)
+}
+
 // Update returns a builder for updating this Agent.
 // Note that you need to call Agent.Unwrap() before calling this method if this Agent
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -226,8 +306,22 @@ func (a *Agent) String() string {
 	builder.WriteString("builtin=")
 	builder.WriteString(fmt.Sprintf("%v", a.Builtin))
 	builder.WriteString(", ")
+	builder.WriteString("mcp_servers=")
+	builder.WriteString(fmt.Sprintf("%v", a.McpServers))
+	builder.WriteString(", ")
+	builder.WriteString("tool_set=")
+	builder.WriteString(fmt.Sprintf("%v", a.ToolSet))
+	builder.WriteString(", ")
+	builder.WriteString("script_limits=")
+	builder.WriteString(fmt.Sprintf("%v", a.ScriptLimits))
+	builder.WriteString(", ")
 	builder.WriteString("model_id=")
 	builder.WriteString(fmt.Sprintf("%v", a.ModelID))
+	builder.WriteString(", ")
+	if v := a.OwnerID; v != nil {
+		builder.WriteString("owner_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
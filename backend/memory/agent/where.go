@@ -91,6 +91,11 @@ func ModelID(v uuid.UUID) predicate.Agent {
 	return predicate.Agent(sql.FieldEQ(FieldModelID, v))
 }
 
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v uuid.UUID) predicate.Agent {
+	return predicate.Agent(sql.FieldEQ(FieldOwnerID, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Agent {
 	return predicate.Agent(sql.FieldEQ(FieldCreateTime, v))
@@ -386,6 +391,36 @@ func BuiltinNEQ(v bool) predicate.Agent {
 	return predicate.Agent(sql.FieldNEQ(FieldBuiltin, v))
 }
 
+// McpServersIsNil applies the IsNil predicate on the "mcp_servers" field.
+func McpServersIsNil() predicate.Agent {
+	return predicate.Agent(sql.FieldIsNull(FieldMcpServers))
+}
+
+// McpServersNotNil applies the NotNil predicate on the "mcp_servers" field.
+func McpServersNotNil() predicate.Agent {
+	return predicate.Agent(sql.FieldNotNull(FieldMcpServers))
+}
+
+// ToolSetIsNil applies the IsNil predicate on the "tool_set" field.
+func ToolSetIsNil() predicate.Agent {
+	return predicate.Agent(sql.FieldIsNull(FieldToolSet))
+}
+
+// ToolSetNotNil applies the NotNil predicate on the "tool_set" field.
+func ToolSetNotNil() predicate.Agent {
+	return predicate.Agent(sql.FieldNotNull(FieldToolSet))
+}
+
+// ScriptLimitsIsNil applies the IsNil predicate on the "script_limits" field.
+func ScriptLimitsIsNil() predicate.Agent {
+	return predicate.Agent(sql.FieldIsNull(FieldScriptLimits))
+}
+
+// ScriptLimitsNotNil applies the NotNil predicate on the "script_limits" field.
+func ScriptLimitsNotNil() predicate.Agent {
+	return predicate.Agent(sql.FieldNotNull(FieldScriptLimits))
+}
+
 // ModelIDEQ applies the EQ predicate on the "model_id" field.
 func ModelIDEQ(v uuid.UUID) predicate.Agent {
 	return predicate.Agent(sql.FieldEQ(FieldModelID, v))
@@ -416,6 +451,36 @@ func ModelIDNotNil() predicate.Agent {
 	return predicate.Agent(sql.FieldNotNull(FieldModelID))
 }
 
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v uuid.UUID) predicate.Agent {
+	return predicate.Agent(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v uuid.UUID) predicate.Agent {
+	return predicate.Agent(sql.FieldNEQ(FieldOwnerID, v))
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...uuid.UUID) predicate.Agent {
+	return predicate.Agent(sql.FieldIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...uuid.UUID) predicate.Agent {
+	return predicate.Agent(sql.FieldNotIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDIsNil applies the IsNil predicate on the "owner_id" field.
+func OwnerIDIsNil() predicate.Agent {
+	return predicate.Agent(sql.FieldIsNull(FieldOwnerID))
+}
+
+// OwnerIDNotNil applies the NotNil predicate on the "owner_id" field.
+func OwnerIDNotNil() predicate.Agent {
+	return predicate.Agent(sql.FieldNotNull(FieldOwnerID))
+}
+
 // HasModel applies the HasEdge predicate on the "model" edge.
 func HasModel() predicate.Agent {
 	return predicate.Agent(func(s *sql.Selector) {
@@ -439,6 +504,29 @@ func HasModelWith(preds ...predicate.Model) predicate.Agent {
 	})
 }
 
+// HasOwner applies the HasEdge predicate on the "owner" edge.
+func HasOwner() predicate.Agent {
+	return predicate.Agent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, OwnerTable, OwnerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOwnerWith applies the HasEdge predicate on the "owner" edge with a given conditions (other predicates).
+func HasOwnerWith(preds ...predicate.User) predicate.Agent {
+	return predicate.Agent(func(s *sql.Selector) {
+		step := newOwnerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasTasks applies the HasEdge predicate on the "tasks" edge.
 func HasTasks() predicate.Agent {
 	return predicate.Agent(func(s *sql.Selector) {
@@ -485,6 +573,29 @@ func HasMessagesWith(preds ...predicate.Message) predicate.Agent {
 	})
 }
 
+// HasSchedules applies the HasEdge predicate on the "schedules" edge.
+func HasSchedules() predicate.Agent {
+	return predicate.Agent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, SchedulesTable, SchedulesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSchedulesWith applies the HasEdge predicate on the "schedules" edge with a given conditions (other predicates).
+func HasSchedulesWith(preds ...predicate.Schedule) predicate.Agent {
+	return predicate.Agent(func(s *sql.Selector) {
+		step := newSchedulesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Agent) predicate.Agent {
 	return predicate.Agent(sql.AndPredicates(predicates...))
@@ -13,7 +13,10 @@ import (
 	"github.com/furisto/construct/backend/memory/agent"
 	"github.com/furisto/construct/backend/memory/message"
 	"github.com/furisto/construct/backend/memory/model"
+	"github.com/furisto/construct/backend/memory/schedule"
+	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/furisto/construct/backend/memory/task"
+	"github.com/furisto/construct/backend/memory/user"
 	"github.com/google/uuid"
 )
 
@@ -92,6 +95,24 @@ func (ac *AgentCreate) SetNillableBuiltin(b *bool) *AgentCreate {
 	return ac
 }
 
+// SetMcpServers sets the "mcp_servers" field.
+func (ac *AgentCreate) SetMcpServers(ts []types.MCPServer) *AgentCreate {
+	ac.mutation.SetMcpServers(ts)
+	return ac
+}
+
+// SetToolSet sets the "tool_set" field.
+func (ac *AgentCreate) SetToolSet(ts *types.ToolSet) *AgentCreate {
+	ac.mutation.SetToolSet(ts)
+	return ac
+}
+
+// SetScriptLimits sets the "script_limits" field.
+func (ac *AgentCreate) SetScriptLimits(tl *types.ScriptLimits) *AgentCreate {
+	ac.mutation.SetScriptLimits(tl)
+	return ac
+}
+
 // SetModelID sets the "model_id" field.
 func (ac *AgentCreate) SetModelID(u uuid.UUID) *AgentCreate {
 	ac.mutation.SetModelID(u)
@@ -106,6 +127,20 @@ func (ac *AgentCreate) SetNillableModelID(u *uuid.UUID) *AgentCreate {
 	return ac
 }
 
+// SetOwnerID sets the "owner_id" field.
+func (ac *AgentCreate) SetOwnerID(u uuid.UUID) *AgentCreate {
+	ac.mutation.SetOwnerID(u)
+	return ac
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (ac *AgentCreate) SetNillableOwnerID(u *uuid.UUID) *AgentCreate {
+	if u != nil {
+		ac.SetOwnerID(*u)
+	}
+	return ac
+}
+
 // SetID sets the "id" field.
 func (ac *AgentCreate) SetID(u uuid.UUID) *AgentCreate {
 	ac.mutation.SetID(u)
@@ -125,6 +160,11 @@ func (ac *AgentCreate) SetModel(m *Model) *AgentCreate {
 	return ac.SetModelID(m.ID)
 }
 
+// SetOwner sets the "owner" edge to the User entity.
+func (ac *AgentCreate) SetOwner(u *User) *AgentCreate {
+	return ac.SetOwnerID(u.ID)
+}
+
 // AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
 func (ac *AgentCreate) AddTaskIDs(ids ...uuid.UUID) *AgentCreate {
 	ac.mutation.AddTaskIDs(ids...)
@@ -155,6 +195,21 @@ func (ac *AgentCreate) AddMessages(m ...*Message) *AgentCreate {
 	return ac.AddMessageIDs(ids...)
 }
 
+// AddScheduleIDs adds the "schedules" edge to the Schedule entity by IDs.
+func (ac *AgentCreate) AddScheduleIDs(ids ...uuid.UUID) *AgentCreate {
+	ac.mutation.AddScheduleIDs(ids...)
+	return ac
+}
+
+// AddSchedules adds the "schedules" edges to the Schedule entity.
+func (ac *AgentCreate) AddSchedules(s ...*Schedule) *AgentCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ac.AddScheduleIDs(ids...)
+}
+
 // Mutation returns the AgentMutation object of the builder.
 func (ac *AgentCreate) Mutation() *AgentMutation {
 	return ac.mutation
@@ -289,6 +344,18 @@ func (ac *AgentCreate) createSpec() (*Agent, *sqlgraph.CreateSpec) {
 		_spec.SetField(agent.FieldBuiltin, field.TypeBool, value)
 		_node.Builtin = value
 	}
+	if value, ok := ac.mutation.McpServers(); ok {
+		_spec.SetField(agent.FieldMcpServers, field.TypeJSON, value)
+		_node.McpServers = value
+	}
+	if value, ok := ac.mutation.ToolSet(); ok {
+		_spec.SetField(agent.FieldToolSet, field.TypeJSON, value)
+		_node.ToolSet = value
+	}
+	if value, ok := ac.mutation.ScriptLimits(); ok {
+		_spec.SetField(agent.FieldScriptLimits, field.TypeJSON, value)
+		_node.ScriptLimits = value
+	}
 	if nodes := ac.mutation.ModelIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -306,6 +373,23 @@ func (ac *AgentCreate) createSpec() (*Agent, *sqlgraph.CreateSpec) {
 		_node.ModelID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ac.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   agent.OwnerTable,
+			Columns: []string{agent.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OwnerID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := ac.mutation.TasksIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -338,6 +422,22 @@ func (ac *AgentCreate) createSpec() (*Agent, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ac.mutation.SchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   agent.SchedulesTable,
+			Columns: []string{agent.SchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -16,21 +16,25 @@ import (
 	"github.com/furisto/construct/backend/memory/message"
 	"github.com/furisto/construct/backend/memory/model"
 	"github.com/furisto/construct/backend/memory/predicate"
+	"github.com/furisto/construct/backend/memory/schedule"
 	"github.com/furisto/construct/backend/memory/task"
+	"github.com/furisto/construct/backend/memory/user"
 	"github.com/google/uuid"
 )
 
 // AgentQuery is the builder for querying Agent entities.
 type AgentQuery struct {
 	config
-	ctx          *QueryContext
-	order        []agent.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.Agent
-	withModel    *ModelQuery
-	withTasks    *TaskQuery
-	withMessages *MessageQuery
-	modifiers    []func(*sql.Selector)
+	ctx           *QueryContext
+	order         []agent.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.Agent
+	withModel     *ModelQuery
+	withOwner     *UserQuery
+	withTasks     *TaskQuery
+	withMessages  *MessageQuery
+	withSchedules *ScheduleQuery
+	modifiers     []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -89,6 +93,28 @@ func (aq *AgentQuery) QueryModel() *ModelQuery {
 	return query
 }
 
+// QueryOwner chains the current query on the "owner" edge.
+func (aq *AgentQuery) QueryOwner() *UserQuery {
+	query := (&UserClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(agent.Table, agent.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, agent.OwnerTable, agent.OwnerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryTasks chains the current query on the "tasks" edge.
 func (aq *AgentQuery) QueryTasks() *TaskQuery {
 	query := (&TaskClient{config: aq.config}).Query()
@@ -133,6 +159,28 @@ func (aq *AgentQuery) QueryMessages() *MessageQuery {
 	return query
 }
 
+// QuerySchedules chains the current query on the "schedules" edge.
+func (aq *AgentQuery) QuerySchedules() *ScheduleQuery {
+	query := (&ScheduleClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(agent.Table, agent.FieldID, selector),
+			sqlgraph.To(schedule.Table, schedule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, agent.SchedulesTable, agent.SchedulesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Agent entity from the query.
 // Returns a *NotFoundError when no Agent was found.
 func (aq *AgentQuery) First(ctx context.Context) (*Agent, error) {
@@ -320,14 +368,16 @@ func (aq *AgentQuery) Clone() *AgentQuery {
 		return nil
 	}
 	return &AgentQuery{
-		config:       aq.config,
-		ctx:          aq.ctx.Clone(),
-		order:        append([]agent.OrderOption{}, aq.order...),
-		inters:       append([]Interceptor{}, aq.inters...),
-		predicates:   append([]predicate.Agent{}, aq.predicates...),
-		withModel:    aq.withModel.Clone(),
-		withTasks:    aq.withTasks.Clone(),
-		withMessages: aq.withMessages.Clone(),
+		config:        aq.config,
+		ctx:           aq.ctx.Clone(),
+		order:         append([]agent.OrderOption{}, aq.order...),
+		inters:        append([]Interceptor{}, aq.inters...),
+		predicates:    append([]predicate.Agent{}, aq.predicates...),
+		withModel:     aq.withModel.Clone(),
+		withOwner:     aq.withOwner.Clone(),
+		withTasks:     aq.withTasks.Clone(),
+		withMessages:  aq.withMessages.Clone(),
+		withSchedules: aq.withSchedules.Clone(),
 		// clone intermediate query.
 		sql:       aq.sql.Clone(),
 		path:      aq.path,
@@ -346,6 +396,17 @@ func (aq *AgentQuery) WithModel(opts ...func(*ModelQuery)) *AgentQuery {
 	return aq
 }
 
+// WithOwner tells the query-builder to eager-load the nodes that are connected to
+// the "owner" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *AgentQuery) WithOwner(opts ...func(*UserQuery)) *AgentQuery {
+	query := (&UserClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withOwner = query
+	return aq
+}
+
 // WithTasks tells the query-builder to eager-load the nodes that are connected to
 // the "tasks" edge. The optional arguments are used to configure the query builder of the edge.
 func (aq *AgentQuery) WithTasks(opts ...func(*TaskQuery)) *AgentQuery {
@@ -368,6 +429,17 @@ func (aq *AgentQuery) WithMessages(opts ...func(*MessageQuery)) *AgentQuery {
 	return aq
 }
 
+// WithSchedules tells the query-builder to eager-load the nodes that are connected to
+// the "schedules" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *AgentQuery) WithSchedules(opts ...func(*ScheduleQuery)) *AgentQuery {
+	query := (&ScheduleClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withSchedules = query
+	return aq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -446,10 +518,12 @@ func (aq *AgentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Agent,
 	var (
 		nodes       = []*Agent{}
 		_spec       = aq.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [5]bool{
 			aq.withModel != nil,
+			aq.withOwner != nil,
 			aq.withTasks != nil,
 			aq.withMessages != nil,
+			aq.withSchedules != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -479,6 +553,12 @@ func (aq *AgentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Agent,
 			return nil, err
 		}
 	}
+	if query := aq.withOwner; query != nil {
+		if err := aq.loadOwner(ctx, query, nodes, nil,
+			func(n *Agent, e *User) { n.Edges.Owner = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := aq.withTasks; query != nil {
 		if err := aq.loadTasks(ctx, query, nodes,
 			func(n *Agent) { n.Edges.Tasks = []*Task{} },
@@ -493,6 +573,13 @@ func (aq *AgentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Agent,
 			return nil, err
 		}
 	}
+	if query := aq.withSchedules; query != nil {
+		if err := aq.loadSchedules(ctx, query, nodes,
+			func(n *Agent) { n.Edges.Schedules = []*Schedule{} },
+			func(n *Agent, e *Schedule) { n.Edges.Schedules = append(n.Edges.Schedules, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -525,6 +612,38 @@ func (aq *AgentQuery) loadModel(ctx context.Context, query *ModelQuery, nodes []
 	}
 	return nil
 }
+func (aq *AgentQuery) loadOwner(ctx context.Context, query *UserQuery, nodes []*Agent, init func(*Agent), assign func(*Agent, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Agent)
+	for i := range nodes {
+		if nodes[i].OwnerID == nil {
+			continue
+		}
+		fk := *nodes[i].OwnerID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "owner_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (aq *AgentQuery) loadTasks(ctx context.Context, query *TaskQuery, nodes []*Agent, init func(*Agent), assign func(*Agent, *Task)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Agent)
@@ -585,6 +704,36 @@ func (aq *AgentQuery) loadMessages(ctx context.Context, query *MessageQuery, nod
 	}
 	return nil
 }
+func (aq *AgentQuery) loadSchedules(ctx context.Context, query *ScheduleQuery, nodes []*Agent, init func(*Agent), assign func(*Agent, *Schedule)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Agent)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(schedule.FieldAgentID)
+	}
+	query.Where(predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(agent.SchedulesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AgentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "agent_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (aq *AgentQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := aq.querySpec()
@@ -617,6 +766,9 @@ func (aq *AgentQuery) querySpec() *sqlgraph.QuerySpec {
 		if aq.withModel != nil {
 			_spec.Node.AddColumnOnce(agent.FieldModelID)
 		}
+		if aq.withOwner != nil {
+			_spec.Node.AddColumnOnce(agent.FieldOwnerID)
+		}
 	}
 	if ps := aq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -10,12 +10,16 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/furisto/construct/backend/memory/agent"
 	"github.com/furisto/construct/backend/memory/message"
 	"github.com/furisto/construct/backend/memory/model"
 	"github.com/furisto/construct/backend/memory/predicate"
+	"github.com/furisto/construct/backend/memory/schedule"
+	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/furisto/construct/backend/memory/task"
+	"github.com/furisto/construct/backend/memory/user"
 	"github.com/google/uuid"
 )
 
@@ -101,6 +105,48 @@ func (au *AgentUpdate) SetNillableBuiltin(b *bool) *AgentUpdate {
 	return au
 }
 
+// SetMcpServers sets the "mcp_servers" field.
+func (au *AgentUpdate) SetMcpServers(ts []types.MCPServer) *AgentUpdate {
+	au.mutation.SetMcpServers(ts)
+	return au
+}
+
+// AppendMcpServers appends ts to the "mcp_servers" field.
+func (au *AgentUpdate) AppendMcpServers(ts []types.MCPServer) *AgentUpdate {
+	au.mutation.AppendMcpServers(ts)
+	return au
+}
+
+// ClearMcpServers clears the value of the "mcp_servers" field.
+func (au *AgentUpdate) ClearMcpServers() *AgentUpdate {
+	au.mutation.ClearMcpServers()
+	return au
+}
+
+// SetToolSet sets the "tool_set" field.
+func (au *AgentUpdate) SetToolSet(ts *types.ToolSet) *AgentUpdate {
+	au.mutation.SetToolSet(ts)
+	return au
+}
+
+// ClearToolSet clears the value of the "tool_set" field.
+func (au *AgentUpdate) ClearToolSet() *AgentUpdate {
+	au.mutation.ClearToolSet()
+	return au
+}
+
+// SetScriptLimits sets the "script_limits" field.
+func (au *AgentUpdate) SetScriptLimits(tl *types.ScriptLimits) *AgentUpdate {
+	au.mutation.SetScriptLimits(tl)
+	return au
+}
+
+// ClearScriptLimits clears the value of the "script_limits" field.
+func (au *AgentUpdate) ClearScriptLimits() *AgentUpdate {
+	au.mutation.ClearScriptLimits()
+	return au
+}
+
 // SetModelID sets the "model_id" field.
 func (au *AgentUpdate) SetModelID(u uuid.UUID) *AgentUpdate {
 	au.mutation.SetModelID(u)
@@ -121,11 +167,36 @@ func (au *AgentUpdate) ClearModelID() *AgentUpdate {
 	return au
 }
 
+// SetOwnerID sets the "owner_id" field.
+func (au *AgentUpdate) SetOwnerID(u uuid.UUID) *AgentUpdate {
+	au.mutation.SetOwnerID(u)
+	return au
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (au *AgentUpdate) SetNillableOwnerID(u *uuid.UUID) *AgentUpdate {
+	if u != nil {
+		au.SetOwnerID(*u)
+	}
+	return au
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (au *AgentUpdate) ClearOwnerID() *AgentUpdate {
+	au.mutation.ClearOwnerID()
+	return au
+}
+
 // SetModel sets the "model" edge to the Model entity.
 func (au *AgentUpdate) SetModel(m *Model) *AgentUpdate {
 	return au.SetModelID(m.ID)
 }
 
+// SetOwner sets the "owner" edge to the User entity.
+func (au *AgentUpdate) SetOwner(u *User) *AgentUpdate {
+	return au.SetOwnerID(u.ID)
+}
+
 // AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
 func (au *AgentUpdate) AddTaskIDs(ids ...uuid.UUID) *AgentUpdate {
 	au.mutation.AddTaskIDs(ids...)
@@ -156,6 +227,21 @@ func (au *AgentUpdate) AddMessages(m ...*Message) *AgentUpdate {
 	return au.AddMessageIDs(ids...)
 }
 
+// AddScheduleIDs adds the "schedules" edge to the Schedule entity by IDs.
+func (au *AgentUpdate) AddScheduleIDs(ids ...uuid.UUID) *AgentUpdate {
+	au.mutation.AddScheduleIDs(ids...)
+	return au
+}
+
+// AddSchedules adds the "schedules" edges to the Schedule entity.
+func (au *AgentUpdate) AddSchedules(s ...*Schedule) *AgentUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return au.AddScheduleIDs(ids...)
+}
+
 // Mutation returns the AgentMutation object of the builder.
 func (au *AgentUpdate) Mutation() *AgentMutation {
 	return au.mutation
@@ -167,6 +253,12 @@ func (au *AgentUpdate) ClearModel() *AgentUpdate {
 	return au
 }
 
+// ClearOwner clears the "owner" edge to the User entity.
+func (au *AgentUpdate) ClearOwner() *AgentUpdate {
+	au.mutation.ClearOwner()
+	return au
+}
+
 // ClearTasks clears all "tasks" edges to the Task entity.
 func (au *AgentUpdate) ClearTasks() *AgentUpdate {
 	au.mutation.ClearTasks()
@@ -209,6 +301,27 @@ func (au *AgentUpdate) RemoveMessages(m ...*Message) *AgentUpdate {
 	return au.RemoveMessageIDs(ids...)
 }
 
+// ClearSchedules clears all "schedules" edges to the Schedule entity.
+func (au *AgentUpdate) ClearSchedules() *AgentUpdate {
+	au.mutation.ClearSchedules()
+	return au
+}
+
+// RemoveScheduleIDs removes the "schedules" edge to Schedule entities by IDs.
+func (au *AgentUpdate) RemoveScheduleIDs(ids ...uuid.UUID) *AgentUpdate {
+	au.mutation.RemoveScheduleIDs(ids...)
+	return au
+}
+
+// RemoveSchedules removes "schedules" edges to Schedule entities.
+func (au *AgentUpdate) RemoveSchedules(s ...*Schedule) *AgentUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return au.RemoveScheduleIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (au *AgentUpdate) Save(ctx context.Context) (int, error) {
 	au.defaults()
@@ -291,6 +404,29 @@ func (au *AgentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := au.mutation.Builtin(); ok {
 		_spec.SetField(agent.FieldBuiltin, field.TypeBool, value)
 	}
+	if value, ok := au.mutation.McpServers(); ok {
+		_spec.SetField(agent.FieldMcpServers, field.TypeJSON, value)
+	}
+	if value, ok := au.mutation.AppendedMcpServers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, agent.FieldMcpServers, value)
+		})
+	}
+	if au.mutation.McpServersCleared() {
+		_spec.ClearField(agent.FieldMcpServers, field.TypeJSON)
+	}
+	if value, ok := au.mutation.ToolSet(); ok {
+		_spec.SetField(agent.FieldToolSet, field.TypeJSON, value)
+	}
+	if au.mutation.ToolSetCleared() {
+		_spec.ClearField(agent.FieldToolSet, field.TypeJSON)
+	}
+	if value, ok := au.mutation.ScriptLimits(); ok {
+		_spec.SetField(agent.FieldScriptLimits, field.TypeJSON, value)
+	}
+	if au.mutation.ScriptLimitsCleared() {
+		_spec.ClearField(agent.FieldScriptLimits, field.TypeJSON)
+	}
 	if au.mutation.ModelCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -320,6 +456,35 @@ func (au *AgentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if au.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   agent.OwnerTable,
+			Columns: []string{agent.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   agent.OwnerTable,
+			Columns: []string{agent.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if au.mutation.TasksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -410,6 +575,51 @@ func (au *AgentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if au.mutation.SchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   agent.SchedulesTable,
+			Columns: []string{agent.SchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedSchedulesIDs(); len(nodes) > 0 && !au.mutation.SchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   agent.SchedulesTable,
+			Columns: []string{agent.SchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.SchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   agent.SchedulesTable,
+			Columns: []string{agent.SchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(au.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -500,6 +710,48 @@ func (auo *AgentUpdateOne) SetNillableBuiltin(b *bool) *AgentUpdateOne {
 	return auo
 }
 
+// SetMcpServers sets the "mcp_servers" field.
+func (auo *AgentUpdateOne) SetMcpServers(ts []types.MCPServer) *AgentUpdateOne {
+	auo.mutation.SetMcpServers(ts)
+	return auo
+}
+
+// AppendMcpServers appends ts to the "mcp_servers" field.
+func (auo *AgentUpdateOne) AppendMcpServers(ts []types.MCPServer) *AgentUpdateOne {
+	auo.mutation.AppendMcpServers(ts)
+	return auo
+}
+
+// ClearMcpServers clears the value of the "mcp_servers" field.
+func (auo *AgentUpdateOne) ClearMcpServers() *AgentUpdateOne {
+	auo.mutation.ClearMcpServers()
+	return auo
+}
+
+// SetToolSet sets the "tool_set" field.
+func (auo *AgentUpdateOne) SetToolSet(ts *types.ToolSet) *AgentUpdateOne {
+	auo.mutation.SetToolSet(ts)
+	return auo
+}
+
+// ClearToolSet clears the value of the "tool_set" field.
+func (auo *AgentUpdateOne) ClearToolSet() *AgentUpdateOne {
+	auo.mutation.ClearToolSet()
+	return auo
+}
+
+// SetScriptLimits sets the "script_limits" field.
+func (auo *AgentUpdateOne) SetScriptLimits(tl *types.ScriptLimits) *AgentUpdateOne {
+	auo.mutation.SetScriptLimits(tl)
+	return auo
+}
+
+// ClearScriptLimits clears the value of the "script_limits" field.
+func (auo *AgentUpdateOne) ClearScriptLimits() *AgentUpdateOne {
+	auo.mutation.ClearScriptLimits()
+	return auo
+}
+
 // SetModelID sets the "model_id" field.
 func (auo *AgentUpdateOne) SetModelID(u uuid.UUID) *AgentUpdateOne {
 	auo.mutation.SetModelID(u)
@@ -520,11 +772,36 @@ func (auo *AgentUpdateOne) ClearModelID() *AgentUpdateOne {
 	return auo
 }
 
+// SetOwnerID sets the "owner_id" field.
+func (auo *AgentUpdateOne) SetOwnerID(u uuid.UUID) *AgentUpdateOne {
+	auo.mutation.SetOwnerID(u)
+	return auo
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (auo *AgentUpdateOne) SetNillableOwnerID(u *uuid.UUID) *AgentUpdateOne {
+	if u != nil {
+		auo.SetOwnerID(*u)
+	}
+	return auo
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (auo *AgentUpdateOne) ClearOwnerID() *AgentUpdateOne {
+	auo.mutation.ClearOwnerID()
+	return auo
+}
+
 // SetModel sets the "model" edge to the Model entity.
 func (auo *AgentUpdateOne) SetModel(m *Model) *AgentUpdateOne {
 	return auo.SetModelID(m.ID)
 }
 
+// SetOwner sets the "owner" edge to the User entity.
+func (auo *AgentUpdateOne) SetOwner(u *User) *AgentUpdateOne {
+	return auo.SetOwnerID(u.ID)
+}
+
 // AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
 func (auo *AgentUpdateOne) AddTaskIDs(ids ...uuid.UUID) *AgentUpdateOne {
 	auo.mutation.AddTaskIDs(ids...)
@@ -555,6 +832,21 @@ func (auo *AgentUpdateOne) AddMessages(m ...*Message) *AgentUpdateOne {
 	return auo.AddMessageIDs(ids...)
 }
 
+// AddScheduleIDs adds the "schedules" edge to the Schedule entity by IDs.
+func (auo *AgentUpdateOne) AddScheduleIDs(ids ...uuid.UUID) *AgentUpdateOne {
+	auo.mutation.AddScheduleIDs(ids...)
+	return auo
+}
+
+// AddSchedules adds the "schedules" edges to the Schedule entity.
+func (auo *AgentUpdateOne) AddSchedules(s ...*Schedule) *AgentUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return auo.AddScheduleIDs(ids...)
+}
+
 // Mutation returns the AgentMutation object of the builder.
 func (auo *AgentUpdateOne) Mutation() *AgentMutation {
 	return auo.mutation
@@ -566,6 +858,12 @@ func (auo *AgentUpdateOne) ClearModel() *AgentUpdateOne {
 	return auo
 }
 
+// ClearOwner clears the "owner" edge to the User entity.
+func (auo *AgentUpdateOne) ClearOwner() *AgentUpdateOne {
+	auo.mutation.ClearOwner()
+	return auo
+}
+
 // ClearTasks clears all "tasks" edges to the Task entity.
 func (auo *AgentUpdateOne) ClearTasks() *AgentUpdateOne {
 	auo.mutation.ClearTasks()
@@ -608,6 +906,27 @@ func (auo *AgentUpdateOne) RemoveMessages(m ...*Message) *AgentUpdateOne {
 	return auo.RemoveMessageIDs(ids...)
 }
 
+// ClearSchedules clears all "schedules" edges to the Schedule entity.
+func (auo *AgentUpdateOne) ClearSchedules() *AgentUpdateOne {
+	auo.mutation.ClearSchedules()
+	return auo
+}
+
+// RemoveScheduleIDs removes the "schedules" edge to Schedule entities by IDs.
+func (auo *AgentUpdateOne) RemoveScheduleIDs(ids ...uuid.UUID) *AgentUpdateOne {
+	auo.mutation.RemoveScheduleIDs(ids...)
+	return auo
+}
+
+// RemoveSchedules removes "schedules" edges to Schedule entities.
+func (auo *AgentUpdateOne) RemoveSchedules(s ...*Schedule) *AgentUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return auo.RemoveScheduleIDs(ids...)
+}
+
 // Where appends a list predicates to the AgentUpdate builder.
 func (auo *AgentUpdateOne) Where(ps ...predicate.Agent) *AgentUpdateOne {
 	auo.mutation.Where(ps...)
@@ -720,6 +1039,29 @@ func (auo *AgentUpdateOne) sqlSave(ctx context.Context) (_node *Agent, err error
 	if value, ok := auo.mutation.Builtin(); ok {
 		_spec.SetField(agent.FieldBuiltin, field.TypeBool, value)
 	}
+	if value, ok := auo.mutation.McpServers(); ok {
+		_spec.SetField(agent.FieldMcpServers, field.TypeJSON, value)
+	}
+	if value, ok := auo.mutation.AppendedMcpServers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, agent.FieldMcpServers, value)
+		})
+	}
+	if auo.mutation.McpServersCleared() {
+		_spec.ClearField(agent.FieldMcpServers, field.TypeJSON)
+	}
+	if value, ok := auo.mutation.ToolSet(); ok {
+		_spec.SetField(agent.FieldToolSet, field.TypeJSON, value)
+	}
+	if auo.mutation.ToolSetCleared() {
+		_spec.ClearField(agent.FieldToolSet, field.TypeJSON)
+	}
+	if value, ok := auo.mutation.ScriptLimits(); ok {
+		_spec.SetField(agent.FieldScriptLimits, field.TypeJSON, value)
+	}
+	if auo.mutation.ScriptLimitsCleared() {
+		_spec.ClearField(agent.FieldScriptLimits, field.TypeJSON)
+	}
 	if auo.mutation.ModelCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -749,6 +1091,35 @@ func (auo *AgentUpdateOne) sqlSave(ctx context.Context) (_node *Agent, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if auo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   agent.OwnerTable,
+			Columns: []string{agent.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   agent.OwnerTable,
+			Columns: []string{agent.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if auo.mutation.TasksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -839,6 +1210,51 @@ func (auo *AgentUpdateOne) sqlSave(ctx context.Context) (_node *Agent, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if auo.mutation.SchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   agent.SchedulesTable,
+			Columns: []string{agent.SchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedSchedulesIDs(); len(nodes) > 0 && !auo.mutation.SchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   agent.SchedulesTable,
+			Columns: []string{agent.SchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.SchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   agent.SchedulesTable,
+			Columns: []string{agent.SchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(auo.modifiers...)
 	_node = &Agent{config: auo.config}
 	_spec.Assign = _node.assignValues
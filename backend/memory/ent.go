@@ -13,10 +13,17 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/furisto/construct/backend/memory/agent"
+	"github.com/furisto/construct/backend/memory/authtoken"
 	"github.com/furisto/construct/backend/memory/message"
 	"github.com/furisto/construct/backend/memory/model"
 	"github.com/furisto/construct/backend/memory/modelprovider"
+	"github.com/furisto/construct/backend/memory/schedule"
+	"github.com/furisto/construct/backend/memory/schedulerun"
 	"github.com/furisto/construct/backend/memory/task"
+	"github.com/furisto/construct/backend/memory/tool"
+	"github.com/furisto/construct/backend/memory/user"
+	"github.com/furisto/construct/backend/memory/webhook"
+	"github.com/furisto/construct/backend/memory/webhookdeadletter"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -77,11 +84,18 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			agent.Table:         agent.ValidColumn,
-			message.Table:       message.ValidColumn,
-			model.Table:         model.ValidColumn,
-			modelprovider.Table: modelprovider.ValidColumn,
-			task.Table:          task.ValidColumn,
+			agent.Table:             agent.ValidColumn,
+			authtoken.Table:         authtoken.ValidColumn,
+			message.Table:           message.ValidColumn,
+			model.Table:             model.ValidColumn,
+			modelprovider.Table:     modelprovider.ValidColumn,
+			schedule.Table:          schedule.ValidColumn,
+			schedulerun.Table:       schedulerun.ValidColumn,
+			task.Table:              task.ValidColumn,
+			tool.Table:              tool.ValidColumn,
+			user.Table:              user.ValidColumn,
+			webhook.Table:           webhook.ValidColumn,
+			webhookdeadletter.Table: webhookdeadletter.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -21,6 +21,18 @@ func (f AgentFunc) Mutate(ctx context.Context, m memory.Mutation) (memory.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *memory.AgentMutation", m)
 }
 
+// The AuthTokenFunc type is an adapter to allow the use of ordinary
+// function as AuthToken mutator.
+type AuthTokenFunc func(context.Context, *memory.AuthTokenMutation) (memory.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuthTokenFunc) Mutate(ctx context.Context, m memory.Mutation) (memory.Value, error) {
+	if mv, ok := m.(*memory.AuthTokenMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *memory.AuthTokenMutation", m)
+}
+
 // The MessageFunc type is an adapter to allow the use of ordinary
 // function as Message mutator.
 type MessageFunc func(context.Context, *memory.MessageMutation) (memory.Value, error)
@@ -57,6 +69,30 @@ func (f ModelProviderFunc) Mutate(ctx context.Context, m memory.Mutation) (memor
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *memory.ModelProviderMutation", m)
 }
 
+// The ScheduleFunc type is an adapter to allow the use of ordinary
+// function as Schedule mutator.
+type ScheduleFunc func(context.Context, *memory.ScheduleMutation) (memory.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScheduleFunc) Mutate(ctx context.Context, m memory.Mutation) (memory.Value, error) {
+	if mv, ok := m.(*memory.ScheduleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *memory.ScheduleMutation", m)
+}
+
+// The ScheduleRunFunc type is an adapter to allow the use of ordinary
+// function as ScheduleRun mutator.
+type ScheduleRunFunc func(context.Context, *memory.ScheduleRunMutation) (memory.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScheduleRunFunc) Mutate(ctx context.Context, m memory.Mutation) (memory.Value, error) {
+	if mv, ok := m.(*memory.ScheduleRunMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *memory.ScheduleRunMutation", m)
+}
+
 // The TaskFunc type is an adapter to allow the use of ordinary
 // function as Task mutator.
 type TaskFunc func(context.Context, *memory.TaskMutation) (memory.Value, error)
@@ -69,6 +105,54 @@ func (f TaskFunc) Mutate(ctx context.Context, m memory.Mutation) (memory.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *memory.TaskMutation", m)
 }
 
+// The ToolFunc type is an adapter to allow the use of ordinary
+// function as Tool mutator.
+type ToolFunc func(context.Context, *memory.ToolMutation) (memory.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ToolFunc) Mutate(ctx context.Context, m memory.Mutation) (memory.Value, error) {
+	if mv, ok := m.(*memory.ToolMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *memory.ToolMutation", m)
+}
+
+// The UserFunc type is an adapter to allow the use of ordinary
+// function as User mutator.
+type UserFunc func(context.Context, *memory.UserMutation) (memory.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserFunc) Mutate(ctx context.Context, m memory.Mutation) (memory.Value, error) {
+	if mv, ok := m.(*memory.UserMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *memory.UserMutation", m)
+}
+
+// The WebhookFunc type is an adapter to allow the use of ordinary
+// function as Webhook mutator.
+type WebhookFunc func(context.Context, *memory.WebhookMutation) (memory.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookFunc) Mutate(ctx context.Context, m memory.Mutation) (memory.Value, error) {
+	if mv, ok := m.(*memory.WebhookMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *memory.WebhookMutation", m)
+}
+
+// The WebhookDeadLetterFunc type is an adapter to allow the use of ordinary
+// function as WebhookDeadLetter mutator.
+type WebhookDeadLetterFunc func(context.Context, *memory.WebhookDeadLetterMutation) (memory.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookDeadLetterFunc) Mutate(ctx context.Context, m memory.Mutation) (memory.Value, error) {
+	if mv, ok := m.(*memory.WebhookDeadLetterMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *memory.WebhookDeadLetterMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, memory.Mutation) bool
 
@@ -29,6 +29,8 @@ type Message struct {
 	UpdateTime time.Time `json:"update_time,omitempty"`
 	// Source holds the value of the "source" field.
 	Source types.MessageSource `json:"source,omitempty"`
+	// Delivery holds the value of the "delivery" field.
+	Delivery types.MessageDelivery `json:"delivery,omitempty"`
 	// Content holds the value of the "content" field.
 	Content *types.MessageContent `json:"content,omitempty"`
 	// Usage holds the value of the "usage" field.
@@ -100,7 +102,7 @@ func (*Message) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case message.FieldContent, message.FieldUsage:
 			values[i] = new([]byte)
-		case message.FieldSource:
+		case message.FieldSource, message.FieldDelivery:
 			values[i] = new(sql.NullString)
 		case message.FieldCreateTime, message.FieldUpdateTime, message.FieldProcessedTime:
 			values[i] = new(sql.NullTime)
@@ -145,6 +147,12 @@ func (m *Message) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				m.Source = types.MessageSource(value.String)
 			}
+		case message.FieldDelivery:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery", values[i])
+			} else if value.Valid {
+				m.Delivery = types.MessageDelivery(value.String)
+			}
 		case message.FieldContent:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field content", values[i])
@@ -245,6 +253,9 @@ func (m *Message) String() string {
 	builder.WriteString("source=")
 	builder.WriteString(fmt.Sprintf("%v", m.Source))
 	builder.WriteString(", ")
+	builder.WriteString("delivery=")
+	builder.WriteString(fmt.Sprintf("%v", m.Delivery))
+	builder.WriteString(", ")
 	builder.WriteString("content=")
 	builder.WriteString(fmt.Sprintf("%v", m.Content))
 	builder.WriteString(", ")
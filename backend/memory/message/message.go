@@ -23,6 +23,8 @@ const (
 	FieldUpdateTime = "update_time"
 	// FieldSource holds the string denoting the source field in the database.
 	FieldSource = "source"
+	// FieldDelivery holds the string denoting the delivery field in the database.
+	FieldDelivery = "delivery"
 	// FieldContent holds the string denoting the content field in the database.
 	FieldContent = "content"
 	// FieldUsage holds the string denoting the usage field in the database.
@@ -72,6 +74,7 @@ var Columns = []string{
 	FieldCreateTime,
 	FieldUpdateTime,
 	FieldSource,
+	FieldDelivery,
 	FieldContent,
 	FieldUsage,
 	FieldProcessedTime,
@@ -111,6 +114,18 @@ func SourceValidator(s types.MessageSource) error {
 	}
 }
 
+const DefaultDelivery types.MessageDelivery = "queue"
+
+// DeliveryValidator is a validator for the "delivery" field enum values. It is called by the builders before save.
+func DeliveryValidator(d types.MessageDelivery) error {
+	switch d {
+	case "queue", "steer":
+		return nil
+	default:
+		return fmt.Errorf("message: invalid enum value for delivery field: %q", d)
+	}
+}
+
 // OrderOption defines the ordering options for the Message queries.
 type OrderOption func(*sql.Selector)
 
@@ -134,6 +149,11 @@ func BySource(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSource, opts...).ToFunc()
 }
 
+// ByDelivery orders the results by the delivery field.
+func ByDelivery(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDelivery, opts...).ToFunc()
+}
+
 // ByProcessedTime orders the results by the processed_time field.
 func ByProcessedTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProcessedTime, opts...).ToFunc()
@@ -197,6 +197,36 @@ func SourceNotIn(vs ...types.MessageSource) predicate.Message {
 	return predicate.Message(sql.FieldNotIn(FieldSource, v...))
 }
 
+// DeliveryEQ applies the EQ predicate on the "delivery" field.
+func DeliveryEQ(v types.MessageDelivery) predicate.Message {
+	vc := v
+	return predicate.Message(sql.FieldEQ(FieldDelivery, vc))
+}
+
+// DeliveryNEQ applies the NEQ predicate on the "delivery" field.
+func DeliveryNEQ(v types.MessageDelivery) predicate.Message {
+	vc := v
+	return predicate.Message(sql.FieldNEQ(FieldDelivery, vc))
+}
+
+// DeliveryIn applies the In predicate on the "delivery" field.
+func DeliveryIn(vs ...types.MessageDelivery) predicate.Message {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Message(sql.FieldIn(FieldDelivery, v...))
+}
+
+// DeliveryNotIn applies the NotIn predicate on the "delivery" field.
+func DeliveryNotIn(vs ...types.MessageDelivery) predicate.Message {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Message(sql.FieldNotIn(FieldDelivery, v...))
+}
+
 // UsageIsNil applies the IsNil predicate on the "usage" field.
 func UsageIsNil() predicate.Message {
 	return predicate.Message(sql.FieldIsNull(FieldUsage))
@@ -59,6 +59,20 @@ func (mc *MessageCreate) SetSource(ts types.MessageSource) *MessageCreate {
 	return mc
 }
 
+// SetDelivery sets the "delivery" field.
+func (mc *MessageCreate) SetDelivery(td types.MessageDelivery) *MessageCreate {
+	mc.mutation.SetDelivery(td)
+	return mc
+}
+
+// SetNillableDelivery sets the "delivery" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableDelivery(td *types.MessageDelivery) *MessageCreate {
+	if td != nil {
+		mc.SetDelivery(*td)
+	}
+	return mc
+}
+
 // SetContent sets the "content" field.
 func (mc *MessageCreate) SetContent(tc *types.MessageContent) *MessageCreate {
 	mc.mutation.SetContent(tc)
@@ -191,6 +205,10 @@ func (mc *MessageCreate) defaults() {
 		v := message.DefaultUpdateTime()
 		mc.mutation.SetUpdateTime(v)
 	}
+	if _, ok := mc.mutation.Delivery(); !ok {
+		v := message.DefaultDelivery
+		mc.mutation.SetDelivery(v)
+	}
 	if _, ok := mc.mutation.ID(); !ok {
 		v := message.DefaultID()
 		mc.mutation.SetID(v)
@@ -213,6 +231,14 @@ func (mc *MessageCreate) check() error {
 			return &ValidationError{Name: "source", err: fmt.Errorf(`memory: validator failed for field "Message.source": %w`, err)}
 		}
 	}
+	if _, ok := mc.mutation.Delivery(); !ok {
+		return &ValidationError{Name: "delivery", err: errors.New(`memory: missing required field "Message.delivery"`)}
+	}
+	if v, ok := mc.mutation.Delivery(); ok {
+		if err := message.DeliveryValidator(v); err != nil {
+			return &ValidationError{Name: "delivery", err: fmt.Errorf(`memory: validator failed for field "Message.delivery": %w`, err)}
+		}
+	}
 	if _, ok := mc.mutation.Content(); !ok {
 		return &ValidationError{Name: "content", err: errors.New(`memory: missing required field "Message.content"`)}
 	}
@@ -269,6 +295,10 @@ func (mc *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_spec.SetField(message.FieldSource, field.TypeEnum, value)
 		_node.Source = value
 	}
+	if value, ok := mc.mutation.Delivery(); ok {
+		_spec.SetField(message.FieldDelivery, field.TypeEnum, value)
+		_node.Delivery = value
+	}
 	if value, ok := mc.mutation.Content(); ok {
 		_spec.SetField(message.FieldContent, field.TypeJSON, value)
 		_node.Content = value
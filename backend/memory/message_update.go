@@ -54,6 +54,20 @@ func (mu *MessageUpdate) SetNillableSource(ts *types.MessageSource) *MessageUpda
 	return mu
 }
 
+// SetDelivery sets the "delivery" field.
+func (mu *MessageUpdate) SetDelivery(td types.MessageDelivery) *MessageUpdate {
+	mu.mutation.SetDelivery(td)
+	return mu
+}
+
+// SetNillableDelivery sets the "delivery" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableDelivery(td *types.MessageDelivery) *MessageUpdate {
+	if td != nil {
+		mu.SetDelivery(*td)
+	}
+	return mu
+}
+
 // SetContent sets the "content" field.
 func (mu *MessageUpdate) SetContent(tc *types.MessageContent) *MessageUpdate {
 	mu.mutation.SetContent(tc)
@@ -227,6 +241,11 @@ func (mu *MessageUpdate) check() error {
 			return &ValidationError{Name: "source", err: fmt.Errorf(`memory: validator failed for field "Message.source": %w`, err)}
 		}
 	}
+	if v, ok := mu.mutation.Delivery(); ok {
+		if err := message.DeliveryValidator(v); err != nil {
+			return &ValidationError{Name: "delivery", err: fmt.Errorf(`memory: validator failed for field "Message.delivery": %w`, err)}
+		}
+	}
 	if mu.mutation.TaskCleared() && len(mu.mutation.TaskIDs()) > 0 {
 		return errors.New(`memory: clearing a required unique edge "Message.task"`)
 	}
@@ -257,6 +276,9 @@ func (mu *MessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := mu.mutation.Source(); ok {
 		_spec.SetField(message.FieldSource, field.TypeEnum, value)
 	}
+	if value, ok := mu.mutation.Delivery(); ok {
+		_spec.SetField(message.FieldDelivery, field.TypeEnum, value)
+	}
 	if value, ok := mu.mutation.Content(); ok {
 		_spec.SetField(message.FieldContent, field.TypeJSON, value)
 	}
@@ -401,6 +423,20 @@ func (muo *MessageUpdateOne) SetNillableSource(ts *types.MessageSource) *Message
 	return muo
 }
 
+// SetDelivery sets the "delivery" field.
+func (muo *MessageUpdateOne) SetDelivery(td types.MessageDelivery) *MessageUpdateOne {
+	muo.mutation.SetDelivery(td)
+	return muo
+}
+
+// SetNillableDelivery sets the "delivery" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableDelivery(td *types.MessageDelivery) *MessageUpdateOne {
+	if td != nil {
+		muo.SetDelivery(*td)
+	}
+	return muo
+}
+
 // SetContent sets the "content" field.
 func (muo *MessageUpdateOne) SetContent(tc *types.MessageContent) *MessageUpdateOne {
 	muo.mutation.SetContent(tc)
@@ -587,6 +623,11 @@ func (muo *MessageUpdateOne) check() error {
 			return &ValidationError{Name: "source", err: fmt.Errorf(`memory: validator failed for field "Message.source": %w`, err)}
 		}
 	}
+	if v, ok := muo.mutation.Delivery(); ok {
+		if err := message.DeliveryValidator(v); err != nil {
+			return &ValidationError{Name: "delivery", err: fmt.Errorf(`memory: validator failed for field "Message.delivery": %w`, err)}
+		}
+	}
 	if muo.mutation.TaskCleared() && len(muo.mutation.TaskIDs()) > 0 {
 		return errors.New(`memory: clearing a required unique edge "Message.task"`)
 	}
@@ -634,6 +675,9 @@ func (muo *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err e
 	if value, ok := muo.mutation.Source(); ok {
 		_spec.SetField(message.FieldSource, field.TypeEnum, value)
 	}
+	if value, ok := muo.mutation.Delivery(); ok {
+		_spec.SetField(message.FieldDelivery, field.TypeEnum, value)
+	}
 	if value, ok := muo.mutation.Content(); ok {
 		_spec.SetField(message.FieldContent, field.TypeJSON, value)
 	}
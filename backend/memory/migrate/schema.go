@@ -18,7 +18,11 @@ var (
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "instructions", Type: field.TypeString},
 		{Name: "builtin", Type: field.TypeBool, Default: false},
+		{Name: "mcp_servers", Type: field.TypeJSON, Nullable: true},
+		{Name: "tool_set", Type: field.TypeJSON, Nullable: true},
+		{Name: "script_limits", Type: field.TypeJSON, Nullable: true},
 		{Name: "model_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "owner_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// AgentsTable holds the schema information for the "agents" table.
 	AgentsTable = &schema.Table{
@@ -28,10 +32,16 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "agents_models_model",
-				Columns:    []*schema.Column{AgentsColumns[7]},
+				Columns:    []*schema.Column{AgentsColumns[10]},
 				RefColumns: []*schema.Column{ModelsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "agents_users_owner",
+				Columns:    []*schema.Column{AgentsColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 		Indexes: []*schema.Index{
 			{
@@ -41,12 +51,50 @@ var (
 			},
 		},
 	}
+	// AuthTokensColumns holds the columns for the "auth_tokens" table.
+	AuthTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "token_hash", Type: field.TypeBytes},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// AuthTokensTable holds the schema information for the "auth_tokens" table.
+	AuthTokensTable = &schema.Table{
+		Name:       "auth_tokens",
+		Columns:    AuthTokensColumns,
+		PrimaryKey: []*schema.Column{AuthTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "auth_tokens_users_user",
+				Columns:    []*schema.Column{AuthTokensColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "authtoken_name",
+				Unique:  true,
+				Columns: []*schema.Column{AuthTokensColumns[3]},
+			},
+			{
+				Name:    "authtoken_token_hash",
+				Unique:  true,
+				Columns: []*schema.Column{AuthTokensColumns[4]},
+			},
+		},
+	}
 	// MessagesColumns holds the columns for the "messages" table.
 	MessagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "create_time", Type: field.TypeTime},
 		{Name: "update_time", Type: field.TypeTime},
 		{Name: "source", Type: field.TypeEnum, Enums: []string{"user", "assistant", "system"}},
+		{Name: "delivery", Type: field.TypeEnum, Enums: []string{"queue", "steer"}, Default: "queue"},
 		{Name: "content", Type: field.TypeJSON},
 		{Name: "usage", Type: field.TypeJSON, Nullable: true},
 		{Name: "processed_time", Type: field.TypeTime, Nullable: true},
@@ -62,19 +110,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "messages_tasks_task",
-				Columns:    []*schema.Column{MessagesColumns[7]},
+				Columns:    []*schema.Column{MessagesColumns[8]},
 				RefColumns: []*schema.Column{TasksColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "messages_agents_agent",
-				Columns:    []*schema.Column{MessagesColumns[8]},
+				Columns:    []*schema.Column{MessagesColumns[9]},
 				RefColumns: []*schema.Column{AgentsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "messages_models_model",
-				Columns:    []*schema.Column{MessagesColumns[9]},
+				Columns:    []*schema.Column{MessagesColumns[10]},
 				RefColumns: []*schema.Column{ModelsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -93,7 +141,7 @@ var (
 			{
 				Name:    "message_task_id",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[7]},
+				Columns: []*schema.Column{MessagesColumns[8]},
 			},
 		},
 	}
@@ -151,6 +199,83 @@ var (
 		Columns:    ModelProvidersColumns,
 		PrimaryKey: []*schema.Column{ModelProvidersColumns[0]},
 	}
+	// SchedulesColumns holds the columns for the "schedules" table.
+	SchedulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "cron_expression", Type: field.TypeString},
+		{Name: "project_directory", Type: field.TypeString},
+		{Name: "prompt", Type: field.TypeString, Size: 2147483647},
+		{Name: "max_cost", Type: field.TypeFloat64, Nullable: true},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "last_run_time", Type: field.TypeTime, Nullable: true},
+		{Name: "next_run_time", Type: field.TypeTime, Nullable: true},
+		{Name: "run_count", Type: field.TypeInt64, Default: 0},
+		{Name: "skipped_count", Type: field.TypeInt64, Default: 0},
+		{Name: "last_task_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "agent_id", Type: field.TypeUUID},
+	}
+	// SchedulesTable holds the schema information for the "schedules" table.
+	SchedulesTable = &schema.Table{
+		Name:       "schedules",
+		Columns:    SchedulesColumns,
+		PrimaryKey: []*schema.Column{SchedulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "schedules_agents_agent",
+				Columns:    []*schema.Column{SchedulesColumns[14]},
+				RefColumns: []*schema.Column{AgentsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "schedule_name",
+				Unique:  true,
+				Columns: []*schema.Column{SchedulesColumns[3]},
+			},
+			{
+				Name:    "schedule_next_run_time",
+				Unique:  false,
+				Columns: []*schema.Column{SchedulesColumns[10]},
+			},
+		},
+	}
+	// ScheduleRunsColumns holds the columns for the "schedule_runs" table.
+	ScheduleRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"started", "skipped", "failed"}},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "scheduled_time", Type: field.TypeTime},
+		{Name: "run_time", Type: field.TypeTime},
+		{Name: "task_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "schedule_id", Type: field.TypeUUID},
+	}
+	// ScheduleRunsTable holds the schema information for the "schedule_runs" table.
+	ScheduleRunsTable = &schema.Table{
+		Name:       "schedule_runs",
+		Columns:    ScheduleRunsColumns,
+		PrimaryKey: []*schema.Column{ScheduleRunsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "schedule_runs_schedules_schedule",
+				Columns:    []*schema.Column{ScheduleRunsColumns[8]},
+				RefColumns: []*schema.Column{SchedulesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "schedulerun_schedule_id_run_time",
+				Unique:  false,
+				Columns: []*schema.Column{ScheduleRunsColumns[8], ScheduleRunsColumns[6]},
+			},
+		},
+	}
 	// TasksColumns holds the columns for the "tasks" table.
 	TasksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -166,8 +291,15 @@ var (
 		{Name: "tool_uses", Type: field.TypeJSON},
 		{Name: "desired_phase", Type: field.TypeEnum, Enums: []string{"unspecified", "running", "awaiting", "suspended"}, Default: "running"},
 		{Name: "phase", Type: field.TypeEnum, Enums: []string{"unspecified", "running", "awaiting", "suspended"}, Default: "awaiting"},
+		{Name: "max_cost", Type: field.TypeFloat64, Nullable: true},
+		{Name: "instruction_files", Type: field.TypeJSON, Nullable: true},
+		{Name: "persistent_state", Type: field.TypeBool, Default: false},
+		{Name: "preview", Type: field.TypeJSON, Nullable: true},
 		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "parent_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "agent_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "schedule_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "owner_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// TasksTable holds the schema information for the "tasks" table.
 	TasksTable = &schema.Table{
@@ -177,10 +309,22 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "tasks_agents_agent",
-				Columns:    []*schema.Column{TasksColumns[14]},
+				Columns:    []*schema.Column{TasksColumns[19]},
 				RefColumns: []*schema.Column{AgentsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "tasks_schedules_schedule",
+				Columns:    []*schema.Column{TasksColumns[20]},
+				RefColumns: []*schema.Column{SchedulesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "tasks_users_owner",
+				Columns:    []*schema.Column{TasksColumns[21]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 		Indexes: []*schema.Index{
 			{
@@ -195,18 +339,135 @@ var (
 			},
 		},
 	}
+	// ToolsColumns holds the columns for the "tools" table.
+	ToolsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Size: 2147483647},
+		{Name: "input_schema", Type: field.TypeJSON, Nullable: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"command", "http", "javascript"}},
+		{Name: "command", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "url", Type: field.TypeString, Nullable: true},
+		{Name: "headers", Type: field.TypeJSON, Nullable: true},
+		{Name: "source", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "timeout_seconds", Type: field.TypeInt, Default: 30},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+	}
+	// ToolsTable holds the schema information for the "tools" table.
+	ToolsTable = &schema.Table{
+		Name:       "tools",
+		Columns:    ToolsColumns,
+		PrimaryKey: []*schema.Column{ToolsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tool_name",
+				Unique:  true,
+				Columns: []*schema.Column{ToolsColumns[3]},
+			},
+		},
+	}
+	// UsersColumns holds the columns for the "users" table.
+	UsersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"admin", "member", "viewer"}, Default: "member"},
+	}
+	// UsersTable holds the schema information for the "users" table.
+	UsersTable = &schema.Table{
+		Name:       "users",
+		Columns:    UsersColumns,
+		PrimaryKey: []*schema.Column{UsersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "user_name",
+				Unique:  true,
+				Columns: []*schema.Column{UsersColumns[3]},
+			},
+		},
+	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "url", Type: field.TypeString},
+		{Name: "secret", Type: field.TypeBytes},
+		{Name: "events", Type: field.TypeJSON},
+		{Name: "cost_threshold", Type: field.TypeFloat64, Nullable: true},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:       "webhooks",
+		Columns:    WebhooksColumns,
+		PrimaryKey: []*schema.Column{WebhooksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhook_name",
+				Unique:  true,
+				Columns: []*schema.Column{WebhooksColumns[3]},
+			},
+		},
+	}
+	// WebhookDeadLettersColumns holds the columns for the "webhook_dead_letters" table.
+	WebhookDeadLettersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "event_type", Type: field.TypeEnum, Enums: []string{"task.phase_changed", "task.response", "task.error", "task.cost_threshold"}},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "attempts", Type: field.TypeInt},
+		{Name: "last_error", Type: field.TypeString, Size: 2147483647},
+		{Name: "task_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "webhook_id", Type: field.TypeUUID},
+	}
+	// WebhookDeadLettersTable holds the schema information for the "webhook_dead_letters" table.
+	WebhookDeadLettersTable = &schema.Table{
+		Name:       "webhook_dead_letters",
+		Columns:    WebhookDeadLettersColumns,
+		PrimaryKey: []*schema.Column{WebhookDeadLettersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_dead_letters_webhooks_webhook",
+				Columns:    []*schema.Column{WebhookDeadLettersColumns[8]},
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdeadletter_webhook_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeadLettersColumns[8]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AgentsTable,
+		AuthTokensTable,
 		MessagesTable,
 		ModelsTable,
 		ModelProvidersTable,
+		SchedulesTable,
+		ScheduleRunsTable,
 		TasksTable,
+		ToolsTable,
+		UsersTable,
+		WebhooksTable,
+		WebhookDeadLettersTable,
 	}
 )
 
 func init() {
 	AgentsTable.ForeignKeys[0].RefTable = ModelsTable
+	AgentsTable.ForeignKeys[1].RefTable = UsersTable
+	AuthTokensTable.ForeignKeys[0].RefTable = UsersTable
 	MessagesTable.ForeignKeys[0].RefTable = TasksTable
 	MessagesTable.ForeignKeys[1].RefTable = AgentsTable
 	MessagesTable.ForeignKeys[2].RefTable = ModelsTable
@@ -215,5 +476,10 @@ func init() {
 		"agent_model": "(agent_id IS NULL OR agent_id IS NOT NULL AND model_id IS NOT NULL)",
 	}
 	ModelsTable.ForeignKeys[0].RefTable = ModelProvidersTable
+	SchedulesTable.ForeignKeys[0].RefTable = AgentsTable
+	ScheduleRunsTable.ForeignKeys[0].RefTable = SchedulesTable
 	TasksTable.ForeignKeys[0].RefTable = AgentsTable
+	TasksTable.ForeignKeys[1].RefTable = SchedulesTable
+	TasksTable.ForeignKeys[2].RefTable = UsersTable
+	WebhookDeadLettersTable.ForeignKeys[0].RefTable = WebhooksTable
 }
@@ -12,12 +12,19 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/furisto/construct/backend/memory/agent"
+	"github.com/furisto/construct/backend/memory/authtoken"
 	"github.com/furisto/construct/backend/memory/message"
 	"github.com/furisto/construct/backend/memory/model"
 	"github.com/furisto/construct/backend/memory/modelprovider"
 	"github.com/furisto/construct/backend/memory/predicate"
+	"github.com/furisto/construct/backend/memory/schedule"
+	"github.com/furisto/construct/backend/memory/schedulerun"
 	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/furisto/construct/backend/memory/task"
+	"github.com/furisto/construct/backend/memory/tool"
+	"github.com/furisto/construct/backend/memory/user"
+	"github.com/furisto/construct/backend/memory/webhook"
+	"github.com/furisto/construct/backend/memory/webhookdeadletter"
 	"github.com/google/uuid"
 )
 
@@ -30,37 +37,53 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAgent         = "Agent"
-	TypeMessage       = "Message"
-	TypeModel         = "Model"
-	TypeModelProvider = "ModelProvider"
-	TypeTask          = "Task"
+	TypeAgent             = "Agent"
+	TypeAuthToken         = "AuthToken"
+	TypeMessage           = "Message"
+	TypeModel             = "Model"
+	TypeModelProvider     = "ModelProvider"
+	TypeSchedule          = "Schedule"
+	TypeScheduleRun       = "ScheduleRun"
+	TypeTask              = "Task"
+	TypeTool              = "Tool"
+	TypeUser              = "User"
+	TypeWebhook           = "Webhook"
+	TypeWebhookDeadLetter = "WebhookDeadLetter"
 )
 
 // AgentMutation represents an operation that mutates the Agent nodes in the graph.
 type AgentMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *uuid.UUID
-	create_time     *time.Time
-	update_time     *time.Time
-	name            *string
-	description     *string
-	instructions    *string
-	builtin         *bool
-	clearedFields   map[string]struct{}
-	model           *uuid.UUID
-	clearedmodel    bool
-	tasks           map[uuid.UUID]struct{}
-	removedtasks    map[uuid.UUID]struct{}
-	clearedtasks    bool
-	messages        map[uuid.UUID]struct{}
-	removedmessages map[uuid.UUID]struct{}
-	clearedmessages bool
-	done            bool
-	oldValue        func(context.Context) (*Agent, error)
-	predicates      []predicate.Agent
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	create_time       *time.Time
+	update_time       *time.Time
+	name              *string
+	description       *string
+	instructions      *string
+	builtin           *bool
+	mcp_servers       *[]types.MCPServer
+	appendmcp_servers []types.MCPServer
+	tool_set          **types.ToolSet
+	script_limits     **types.ScriptLimits
+	clearedFields     map[string]struct{}
+	model             *uuid.UUID
+	clearedmodel      bool
+	owner             *uuid.UUID
+	clearedowner      bool
+	tasks             map[uuid.UUID]struct{}
+	removedtasks      map[uuid.UUID]struct{}
+	clearedtasks      bool
+	messages          map[uuid.UUID]struct{}
+	removedmessages   map[uuid.UUID]struct{}
+	clearedmessages   bool
+	schedules         map[uuid.UUID]struct{}
+	removedschedules  map[uuid.UUID]struct{}
+	clearedschedules  bool
+	done              bool
+	oldValue          func(context.Context) (*Agent, error)
+	predicates        []predicate.Agent
 }
 
 var _ ent.Mutation = (*AgentMutation)(nil)
@@ -396,6 +419,169 @@ func (m *AgentMutation) ResetBuiltin() {
 	m.builtin = nil
 }
 
+// SetMcpServers sets the "mcp_servers" field.
+func (m *AgentMutation) SetMcpServers(ts []types.MCPServer) {
+	m.mcp_servers = &ts
+	m.appendmcp_servers = nil
+}
+
+// McpServers returns the value of the "mcp_servers" field in the mutation.
+func (m *AgentMutation) McpServers() (r []types.MCPServer, exists bool) {
+	v := m.mcp_servers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMcpServers returns the old "mcp_servers" field's value of the Agent entity.
+// If the Agent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentMutation) OldMcpServers(ctx context.Context) (v []types.MCPServer, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMcpServers is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMcpServers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMcpServers: %w", err)
+	}
+	return oldValue.McpServers, nil
+}
+
+// AppendMcpServers adds ts to the "mcp_servers" field.
+func (m *AgentMutation) AppendMcpServers(ts []types.MCPServer) {
+	m.appendmcp_servers = append(m.appendmcp_servers, ts...)
+}
+
+// AppendedMcpServers returns the list of values that were appended to the "mcp_servers" field in this mutation.
+func (m *AgentMutation) AppendedMcpServers() ([]types.MCPServer, bool) {
+	if len(m.appendmcp_servers) == 0 {
+		return nil, false
+	}
+	return m.appendmcp_servers, true
+}
+
+// ClearMcpServers clears the value of the "mcp_servers" field.
+func (m *AgentMutation) ClearMcpServers() {
+	m.mcp_servers = nil
+	m.appendmcp_servers = nil
+	m.clearedFields[agent.FieldMcpServers] = struct{}{}
+}
+
+// McpServersCleared returns if the "mcp_servers" field was cleared in this mutation.
+func (m *AgentMutation) McpServersCleared() bool {
+	_, ok := m.clearedFields[agent.FieldMcpServers]
+	return ok
+}
+
+// ResetMcpServers resets all changes to the "mcp_servers" field.
+func (m *AgentMutation) ResetMcpServers() {
+	m.mcp_servers = nil
+	m.appendmcp_servers = nil
+	delete(m.clearedFields, agent.FieldMcpServers)
+}
+
+// SetToolSet sets the "tool_set" field.
+func (m *AgentMutation) SetToolSet(ts *types.ToolSet) {
+	m.tool_set = &ts
+}
+
+// ToolSet returns the value of the "tool_set" field in the mutation.
+func (m *AgentMutation) ToolSet() (r *types.ToolSet, exists bool) {
+	v := m.tool_set
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToolSet returns the old "tool_set" field's value of the Agent entity.
+// If the Agent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentMutation) OldToolSet(ctx context.Context) (v *types.ToolSet, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToolSet is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToolSet requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToolSet: %w", err)
+	}
+	return oldValue.ToolSet, nil
+}
+
+// ClearToolSet clears the value of the "tool_set" field.
+func (m *AgentMutation) ClearToolSet() {
+	m.tool_set = nil
+	m.clearedFields[agent.FieldToolSet] = struct{}{}
+}
+
+// ToolSetCleared returns if the "tool_set" field was cleared in this mutation.
+func (m *AgentMutation) ToolSetCleared() bool {
+	_, ok := m.clearedFields[agent.FieldToolSet]
+	return ok
+}
+
+// ResetToolSet resets all changes to the "tool_set" field.
+func (m *AgentMutation) ResetToolSet() {
+	m.tool_set = nil
+	delete(m.clearedFields, agent.FieldToolSet)
+}
+
+// SetScriptLimits sets the "script_limits" field.
+func (m *AgentMutation) SetScriptLimits(tl *types.ScriptLimits) {
+	m.script_limits = &tl
+}
+
+// ScriptLimits returns the value of the "script_limits" field in the mutation.
+func (m *AgentMutation) ScriptLimits() (r *types.ScriptLimits, exists bool) {
+	v := m.script_limits
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScriptLimits returns the old "script_limits" field's value of the Agent entity.
+// If the Agent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentMutation) OldScriptLimits(ctx context.Context) (v *types.ScriptLimits, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScriptLimits is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScriptLimits requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScriptLimits: %w", err)
+	}
+	return oldValue.ScriptLimits, nil
+}
+
+// ClearScriptLimits clears the value of the "script_limits" field.
+func (m *AgentMutation) ClearScriptLimits() {
+	m.script_limits = nil
+	m.clearedFields[agent.FieldScriptLimits] = struct{}{}
+}
+
+// ScriptLimitsCleared returns if the "script_limits" field was cleared in this mutation.
+func (m *AgentMutation) ScriptLimitsCleared() bool {
+	_, ok := m.clearedFields[agent.FieldScriptLimits]
+	return ok
+}
+
+// ResetScriptLimits resets all changes to the "script_limits" field.
+func (m *AgentMutation) ResetScriptLimits() {
+	m.script_limits = nil
+	delete(m.clearedFields, agent.FieldScriptLimits)
+}
+
 // SetModelID sets the "model_id" field.
 func (m *AgentMutation) SetModelID(u uuid.UUID) {
 	m.model = &u
@@ -445,6 +631,55 @@ func (m *AgentMutation) ResetModelID() {
 	delete(m.clearedFields, agent.FieldModelID)
 }
 
+// SetOwnerID sets the "owner_id" field.
+func (m *AgentMutation) SetOwnerID(u uuid.UUID) {
+	m.owner = &u
+}
+
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *AgentMutation) OwnerID() (r uuid.UUID, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerID returns the old "owner_id" field's value of the Agent entity.
+// If the Agent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentMutation) OldOwnerID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (m *AgentMutation) ClearOwnerID() {
+	m.owner = nil
+	m.clearedFields[agent.FieldOwnerID] = struct{}{}
+}
+
+// OwnerIDCleared returns if the "owner_id" field was cleared in this mutation.
+func (m *AgentMutation) OwnerIDCleared() bool {
+	_, ok := m.clearedFields[agent.FieldOwnerID]
+	return ok
+}
+
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *AgentMutation) ResetOwnerID() {
+	m.owner = nil
+	delete(m.clearedFields, agent.FieldOwnerID)
+}
+
 // ClearModel clears the "model" edge to the Model entity.
 func (m *AgentMutation) ClearModel() {
 	m.clearedmodel = true
@@ -472,6 +707,33 @@ func (m *AgentMutation) ResetModel() {
 	m.clearedmodel = false
 }
 
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *AgentMutation) ClearOwner() {
+	m.clearedowner = true
+	m.clearedFields[agent.FieldOwnerID] = struct{}{}
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *AgentMutation) OwnerCleared() bool {
+	return m.OwnerIDCleared() || m.clearedowner
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *AgentMutation) OwnerIDs() (ids []uuid.UUID) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *AgentMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
 // AddTaskIDs adds the "tasks" edge to the Task entity by ids.
 func (m *AgentMutation) AddTaskIDs(ids ...uuid.UUID) {
 	if m.tasks == nil {
@@ -580,6 +842,60 @@ func (m *AgentMutation) ResetMessages() {
 	m.removedmessages = nil
 }
 
+// AddScheduleIDs adds the "schedules" edge to the Schedule entity by ids.
+func (m *AgentMutation) AddScheduleIDs(ids ...uuid.UUID) {
+	if m.schedules == nil {
+		m.schedules = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.schedules[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSchedules clears the "schedules" edge to the Schedule entity.
+func (m *AgentMutation) ClearSchedules() {
+	m.clearedschedules = true
+}
+
+// SchedulesCleared reports if the "schedules" edge to the Schedule entity was cleared.
+func (m *AgentMutation) SchedulesCleared() bool {
+	return m.clearedschedules
+}
+
+// RemoveScheduleIDs removes the "schedules" edge to the Schedule entity by IDs.
+func (m *AgentMutation) RemoveScheduleIDs(ids ...uuid.UUID) {
+	if m.removedschedules == nil {
+		m.removedschedules = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.schedules, ids[i])
+		m.removedschedules[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSchedules returns the removed IDs of the "schedules" edge to the Schedule entity.
+func (m *AgentMutation) RemovedSchedulesIDs() (ids []uuid.UUID) {
+	for id := range m.removedschedules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SchedulesIDs returns the "schedules" edge IDs in the mutation.
+func (m *AgentMutation) SchedulesIDs() (ids []uuid.UUID) {
+	for id := range m.schedules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSchedules resets all changes to the "schedules" edge.
+func (m *AgentMutation) ResetSchedules() {
+	m.schedules = nil
+	m.clearedschedules = false
+	m.removedschedules = nil
+}
+
 // Where appends a list predicates to the AgentMutation builder.
 func (m *AgentMutation) Where(ps ...predicate.Agent) {
 	m.predicates = append(m.predicates, ps...)
@@ -614,7 +930,7 @@ func (m *AgentMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *AgentMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+	fields := make([]string, 0, 11)
 	if m.create_time != nil {
 		fields = append(fields, agent.FieldCreateTime)
 	}
@@ -633,9 +949,21 @@ func (m *AgentMutation) Fields() []string {
 	if m.builtin != nil {
 		fields = append(fields, agent.FieldBuiltin)
 	}
+	if m.mcp_servers != nil {
+		fields = append(fields, agent.FieldMcpServers)
+	}
+	if m.tool_set != nil {
+		fields = append(fields, agent.FieldToolSet)
+	}
+	if m.script_limits != nil {
+		fields = append(fields, agent.FieldScriptLimits)
+	}
 	if m.model != nil {
 		fields = append(fields, agent.FieldModelID)
 	}
+	if m.owner != nil {
+		fields = append(fields, agent.FieldOwnerID)
+	}
 	return fields
 }
 
@@ -656,8 +984,16 @@ func (m *AgentMutation) Field(name string) (ent.Value, bool) {
 		return m.Instructions()
 	case agent.FieldBuiltin:
 		return m.Builtin()
+	case agent.FieldMcpServers:
+		return m.McpServers()
+	case agent.FieldToolSet:
+		return m.ToolSet()
+	case agent.FieldScriptLimits:
+		return m.ScriptLimits()
 	case agent.FieldModelID:
 		return m.ModelID()
+	case agent.FieldOwnerID:
+		return m.OwnerID()
 	}
 	return nil, false
 }
@@ -679,8 +1015,16 @@ func (m *AgentMutation) OldField(ctx context.Context, name string) (ent.Value, e
 		return m.OldInstructions(ctx)
 	case agent.FieldBuiltin:
 		return m.OldBuiltin(ctx)
+	case agent.FieldMcpServers:
+		return m.OldMcpServers(ctx)
+	case agent.FieldToolSet:
+		return m.OldToolSet(ctx)
+	case agent.FieldScriptLimits:
+		return m.OldScriptLimits(ctx)
 	case agent.FieldModelID:
 		return m.OldModelID(ctx)
+	case agent.FieldOwnerID:
+		return m.OldOwnerID(ctx)
 	}
 	return nil, fmt.Errorf("unknown Agent field %s", name)
 }
@@ -732,6 +1076,27 @@ func (m *AgentMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetBuiltin(v)
 		return nil
+	case agent.FieldMcpServers:
+		v, ok := value.([]types.MCPServer)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMcpServers(v)
+		return nil
+	case agent.FieldToolSet:
+		v, ok := value.(*types.ToolSet)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToolSet(v)
+		return nil
+	case agent.FieldScriptLimits:
+		v, ok := value.(*types.ScriptLimits)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScriptLimits(v)
+		return nil
 	case agent.FieldModelID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
@@ -739,6 +1104,13 @@ func (m *AgentMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetModelID(v)
 		return nil
+	case agent.FieldOwnerID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerID(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Agent field %s", name)
 }
@@ -772,9 +1144,21 @@ func (m *AgentMutation) ClearedFields() []string {
 	if m.FieldCleared(agent.FieldDescription) {
 		fields = append(fields, agent.FieldDescription)
 	}
+	if m.FieldCleared(agent.FieldMcpServers) {
+		fields = append(fields, agent.FieldMcpServers)
+	}
+	if m.FieldCleared(agent.FieldToolSet) {
+		fields = append(fields, agent.FieldToolSet)
+	}
+	if m.FieldCleared(agent.FieldScriptLimits) {
+		fields = append(fields, agent.FieldScriptLimits)
+	}
 	if m.FieldCleared(agent.FieldModelID) {
 		fields = append(fields, agent.FieldModelID)
 	}
+	if m.FieldCleared(agent.FieldOwnerID) {
+		fields = append(fields, agent.FieldOwnerID)
+	}
 	return fields
 }
 
@@ -792,9 +1176,21 @@ func (m *AgentMutation) ClearField(name string) error {
 	case agent.FieldDescription:
 		m.ClearDescription()
 		return nil
+	case agent.FieldMcpServers:
+		m.ClearMcpServers()
+		return nil
+	case agent.FieldToolSet:
+		m.ClearToolSet()
+		return nil
+	case agent.FieldScriptLimits:
+		m.ClearScriptLimits()
+		return nil
 	case agent.FieldModelID:
 		m.ClearModelID()
 		return nil
+	case agent.FieldOwnerID:
+		m.ClearOwnerID()
+		return nil
 	}
 	return fmt.Errorf("unknown Agent nullable field %s", name)
 }
@@ -821,25 +1217,43 @@ func (m *AgentMutation) ResetField(name string) error {
 	case agent.FieldBuiltin:
 		m.ResetBuiltin()
 		return nil
+	case agent.FieldMcpServers:
+		m.ResetMcpServers()
+		return nil
+	case agent.FieldToolSet:
+		m.ResetToolSet()
+		return nil
+	case agent.FieldScriptLimits:
+		m.ResetScriptLimits()
+		return nil
 	case agent.FieldModelID:
 		m.ResetModelID()
 		return nil
+	case agent.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
 	}
 	return fmt.Errorf("unknown Agent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *AgentMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
+	edges := make([]string, 0, 5)
 	if m.model != nil {
 		edges = append(edges, agent.EdgeModel)
 	}
+	if m.owner != nil {
+		edges = append(edges, agent.EdgeOwner)
+	}
 	if m.tasks != nil {
 		edges = append(edges, agent.EdgeTasks)
 	}
 	if m.messages != nil {
 		edges = append(edges, agent.EdgeMessages)
 	}
+	if m.schedules != nil {
+		edges = append(edges, agent.EdgeSchedules)
+	}
 	return edges
 }
 
@@ -851,6 +1265,10 @@ func (m *AgentMutation) AddedIDs(name string) []ent.Value {
 		if id := m.model; id != nil {
 			return []ent.Value{*id}
 		}
+	case agent.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
 	case agent.EdgeTasks:
 		ids := make([]ent.Value, 0, len(m.tasks))
 		for id := range m.tasks {
@@ -863,19 +1281,28 @@ func (m *AgentMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case agent.EdgeSchedules:
+		ids := make([]ent.Value, 0, len(m.schedules))
+		for id := range m.schedules {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *AgentMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
+	edges := make([]string, 0, 5)
 	if m.removedtasks != nil {
 		edges = append(edges, agent.EdgeTasks)
 	}
 	if m.removedmessages != nil {
 		edges = append(edges, agent.EdgeMessages)
 	}
+	if m.removedschedules != nil {
+		edges = append(edges, agent.EdgeSchedules)
+	}
 	return edges
 }
 
@@ -895,22 +1322,34 @@ func (m *AgentMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case agent.EdgeSchedules:
+		ids := make([]ent.Value, 0, len(m.removedschedules))
+		for id := range m.removedschedules {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *AgentMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
+	edges := make([]string, 0, 5)
 	if m.clearedmodel {
 		edges = append(edges, agent.EdgeModel)
 	}
+	if m.clearedowner {
+		edges = append(edges, agent.EdgeOwner)
+	}
 	if m.clearedtasks {
 		edges = append(edges, agent.EdgeTasks)
 	}
 	if m.clearedmessages {
 		edges = append(edges, agent.EdgeMessages)
 	}
+	if m.clearedschedules {
+		edges = append(edges, agent.EdgeSchedules)
+	}
 	return edges
 }
 
@@ -920,10 +1359,14 @@ func (m *AgentMutation) EdgeCleared(name string) bool {
 	switch name {
 	case agent.EdgeModel:
 		return m.clearedmodel
+	case agent.EdgeOwner:
+		return m.clearedowner
 	case agent.EdgeTasks:
 		return m.clearedtasks
 	case agent.EdgeMessages:
 		return m.clearedmessages
+	case agent.EdgeSchedules:
+		return m.clearedschedules
 	}
 	return false
 }
@@ -935,6 +1378,9 @@ func (m *AgentMutation) ClearEdge(name string) error {
 	case agent.EdgeModel:
 		m.ClearModel()
 		return nil
+	case agent.EdgeOwner:
+		m.ClearOwner()
+		return nil
 	}
 	return fmt.Errorf("unknown Agent unique edge %s", name)
 }
@@ -946,51 +1392,53 @@ func (m *AgentMutation) ResetEdge(name string) error {
 	case agent.EdgeModel:
 		m.ResetModel()
 		return nil
+	case agent.EdgeOwner:
+		m.ResetOwner()
+		return nil
 	case agent.EdgeTasks:
 		m.ResetTasks()
 		return nil
 	case agent.EdgeMessages:
 		m.ResetMessages()
 		return nil
+	case agent.EdgeSchedules:
+		m.ResetSchedules()
+		return nil
 	}
 	return fmt.Errorf("unknown Agent edge %s", name)
 }
 
-// MessageMutation represents an operation that mutates the Message nodes in the graph.
-type MessageMutation struct {
+// AuthTokenMutation represents an operation that mutates the AuthToken nodes in the graph.
+type AuthTokenMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *uuid.UUID
-	create_time    *time.Time
-	update_time    *time.Time
-	source         *types.MessageSource
-	content        **types.MessageContent
-	usage          **types.MessageUsage
-	processed_time *time.Time
-	clearedFields  map[string]struct{}
-	task           *uuid.UUID
-	clearedtask    bool
-	agent          *uuid.UUID
-	clearedagent   bool
-	model          *uuid.UUID
-	clearedmodel   bool
-	done           bool
-	oldValue       func(context.Context) (*Message, error)
-	predicates     []predicate.Message
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	create_time   *time.Time
+	update_time   *time.Time
+	name          *string
+	token_hash    *[]byte
+	expires_at    *time.Time
+	last_used_at  *time.Time
+	clearedFields map[string]struct{}
+	user          *uuid.UUID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*AuthToken, error)
+	predicates    []predicate.AuthToken
 }
 
-var _ ent.Mutation = (*MessageMutation)(nil)
+var _ ent.Mutation = (*AuthTokenMutation)(nil)
 
-// messageOption allows management of the mutation configuration using functional options.
-type messageOption func(*MessageMutation)
+// authtokenOption allows management of the mutation configuration using functional options.
+type authtokenOption func(*AuthTokenMutation)
 
-// newMessageMutation creates new mutation for the Message entity.
-func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
-	m := &MessageMutation{
+// newAuthTokenMutation creates new mutation for the AuthToken entity.
+func newAuthTokenMutation(c config, op Op, opts ...authtokenOption) *AuthTokenMutation {
+	m := &AuthTokenMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessage,
+		typ:           TypeAuthToken,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -999,20 +1447,20 @@ func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation
 	return m
 }
 
-// withMessageID sets the ID field of the mutation.
-func withMessageID(id uuid.UUID) messageOption {
-	return func(m *MessageMutation) {
+// withAuthTokenID sets the ID field of the mutation.
+func withAuthTokenID(id uuid.UUID) authtokenOption {
+	return func(m *AuthTokenMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Message
+			value *AuthToken
 		)
-		m.oldValue = func(ctx context.Context) (*Message, error) {
+		m.oldValue = func(ctx context.Context) (*AuthToken, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Message.Get(ctx, id)
+					value, err = m.Client().AuthToken.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1021,10 +1469,10 @@ func withMessageID(id uuid.UUID) messageOption {
 	}
 }
 
-// withMessage sets the old Message of the mutation.
-func withMessage(node *Message) messageOption {
-	return func(m *MessageMutation) {
-		m.oldValue = func(context.Context) (*Message, error) {
+// withAuthToken sets the old AuthToken of the mutation.
+func withAuthToken(node *AuthToken) authtokenOption {
+	return func(m *AuthTokenMutation) {
+		m.oldValue = func(context.Context) (*AuthToken, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1033,7 +1481,7 @@ func withMessage(node *Message) messageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageMutation) Client() *Client {
+func (m AuthTokenMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1041,7 +1489,7 @@ func (m MessageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MessageMutation) Tx() (*Tx, error) {
+func (m AuthTokenMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("memory: mutation is not running in a transaction")
 	}
@@ -1051,14 +1499,14 @@ func (m MessageMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Message entities.
-func (m *MessageMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of AuthToken entities.
+func (m *AuthTokenMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MessageMutation) ID() (id uuid.UUID, exists bool) {
+func (m *AuthTokenMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1069,7 +1517,7 @@ func (m *MessageMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *AuthTokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1078,19 +1526,19 @@ func (m *MessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuthToken.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *MessageMutation) SetCreateTime(t time.Time) {
+func (m *AuthTokenMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *MessageMutation) CreateTime() (r time.Time, exists bool) {
+func (m *AuthTokenMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -1098,10 +1546,10 @@ func (m *MessageMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the AuthToken entity.
+// If the AuthToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *AuthTokenMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -1116,17 +1564,17 @@ func (m *MessageMutation) OldCreateTime(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *MessageMutation) ResetCreateTime() {
+func (m *AuthTokenMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *MessageMutation) SetUpdateTime(t time.Time) {
+func (m *AuthTokenMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *MessageMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *AuthTokenMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -1134,10 +1582,10 @@ func (m *MessageMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the AuthToken entity.
+// If the AuthToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *AuthTokenMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -1152,718 +1600,506 @@ func (m *MessageMutation) OldUpdateTime(ctx context.Context) (v time.Time, err e
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *MessageMutation) ResetUpdateTime() {
+func (m *AuthTokenMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetSource sets the "source" field.
-func (m *MessageMutation) SetSource(ts types.MessageSource) {
-	m.source = &ts
+// SetName sets the "name" field.
+func (m *AuthTokenMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Source returns the value of the "source" field in the mutation.
-func (m *MessageMutation) Source() (r types.MessageSource, exists bool) {
-	v := m.source
+// Name returns the value of the "name" field in the mutation.
+func (m *AuthTokenMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSource returns the old "source" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the AuthToken entity.
+// If the AuthToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldSource(ctx context.Context) (v types.MessageSource, err error) {
+func (m *AuthTokenMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSource requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Source, nil
+	return oldValue.Name, nil
 }
 
-// ResetSource resets all changes to the "source" field.
-func (m *MessageMutation) ResetSource() {
-	m.source = nil
+// ResetName resets all changes to the "name" field.
+func (m *AuthTokenMutation) ResetName() {
+	m.name = nil
 }
 
-// SetContent sets the "content" field.
-func (m *MessageMutation) SetContent(tc *types.MessageContent) {
-	m.content = &tc
+// SetTokenHash sets the "token_hash" field.
+func (m *AuthTokenMutation) SetTokenHash(b []byte) {
+	m.token_hash = &b
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *MessageMutation) Content() (r *types.MessageContent, exists bool) {
-	v := m.content
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *AuthTokenMutation) TokenHash() (r []byte, exists bool) {
+	v := m.token_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContent returns the old "content" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldTokenHash returns the old "token_hash" field's value of the AuthToken entity.
+// If the AuthToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldContent(ctx context.Context) (v *types.MessageContent, err error) {
+func (m *AuthTokenMutation) OldTokenHash(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
 	}
-	return oldValue.Content, nil
+	return oldValue.TokenHash, nil
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *MessageMutation) ResetContent() {
-	m.content = nil
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *AuthTokenMutation) ResetTokenHash() {
+	m.token_hash = nil
 }
 
-// SetUsage sets the "usage" field.
-func (m *MessageMutation) SetUsage(tu *types.MessageUsage) {
-	m.usage = &tu
+// SetExpiresAt sets the "expires_at" field.
+func (m *AuthTokenMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// Usage returns the value of the "usage" field in the mutation.
-func (m *MessageMutation) Usage() (r *types.MessageUsage, exists bool) {
-	v := m.usage
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *AuthTokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUsage returns the old "usage" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the AuthToken entity.
+// If the AuthToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldUsage(ctx context.Context) (v *types.MessageUsage, err error) {
+func (m *AuthTokenMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsage is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsage requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsage: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.Usage, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ClearUsage clears the value of the "usage" field.
-func (m *MessageMutation) ClearUsage() {
-	m.usage = nil
-	m.clearedFields[message.FieldUsage] = struct{}{}
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *AuthTokenMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[authtoken.FieldExpiresAt] = struct{}{}
 }
 
-// UsageCleared returns if the "usage" field was cleared in this mutation.
-func (m *MessageMutation) UsageCleared() bool {
-	_, ok := m.clearedFields[message.FieldUsage]
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *AuthTokenMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[authtoken.FieldExpiresAt]
 	return ok
 }
 
-// ResetUsage resets all changes to the "usage" field.
-func (m *MessageMutation) ResetUsage() {
-	m.usage = nil
-	delete(m.clearedFields, message.FieldUsage)
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *AuthTokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, authtoken.FieldExpiresAt)
 }
 
-// SetProcessedTime sets the "processed_time" field.
-func (m *MessageMutation) SetProcessedTime(t time.Time) {
-	m.processed_time = &t
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *AuthTokenMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
 }
 
-// ProcessedTime returns the value of the "processed_time" field in the mutation.
-func (m *MessageMutation) ProcessedTime() (r time.Time, exists bool) {
-	v := m.processed_time
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *AuthTokenMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProcessedTime returns the old "processed_time" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldLastUsedAt returns the old "last_used_at" field's value of the AuthToken entity.
+// If the AuthToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldProcessedTime(ctx context.Context) (v time.Time, err error) {
+func (m *AuthTokenMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProcessedTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProcessedTime requires an ID field in the mutation")
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProcessedTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
 	}
-	return oldValue.ProcessedTime, nil
+	return oldValue.LastUsedAt, nil
 }
 
-// ClearProcessedTime clears the value of the "processed_time" field.
-func (m *MessageMutation) ClearProcessedTime() {
-	m.processed_time = nil
-	m.clearedFields[message.FieldProcessedTime] = struct{}{}
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *AuthTokenMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[authtoken.FieldLastUsedAt] = struct{}{}
 }
 
-// ProcessedTimeCleared returns if the "processed_time" field was cleared in this mutation.
-func (m *MessageMutation) ProcessedTimeCleared() bool {
-	_, ok := m.clearedFields[message.FieldProcessedTime]
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *AuthTokenMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[authtoken.FieldLastUsedAt]
 	return ok
 }
 
-// ResetProcessedTime resets all changes to the "processed_time" field.
-func (m *MessageMutation) ResetProcessedTime() {
-	m.processed_time = nil
-	delete(m.clearedFields, message.FieldProcessedTime)
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *AuthTokenMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, authtoken.FieldLastUsedAt)
 }
 
-// SetTaskID sets the "task_id" field.
-func (m *MessageMutation) SetTaskID(u uuid.UUID) {
-	m.task = &u
+// SetUserID sets the "user_id" field.
+func (m *AuthTokenMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
 }
 
-// TaskID returns the value of the "task_id" field in the mutation.
-func (m *MessageMutation) TaskID() (r uuid.UUID, exists bool) {
-	v := m.task
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *AuthTokenMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTaskID returns the old "task_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the AuthToken entity.
+// If the AuthToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldTaskID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *AuthTokenMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTaskID requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.TaskID, nil
+	return oldValue.UserID, nil
 }
 
-// ResetTaskID resets all changes to the "task_id" field.
-func (m *MessageMutation) ResetTaskID() {
-	m.task = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *AuthTokenMutation) ResetUserID() {
+	m.user = nil
 }
 
-// SetAgentID sets the "agent_id" field.
-func (m *MessageMutation) SetAgentID(u uuid.UUID) {
-	m.agent = &u
+// ClearUser clears the "user" edge to the User entity.
+func (m *AuthTokenMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[authtoken.FieldUserID] = struct{}{}
 }
 
-// AgentID returns the value of the "agent_id" field in the mutation.
-func (m *MessageMutation) AgentID() (r uuid.UUID, exists bool) {
-	v := m.agent
-	if v == nil {
-		return
-	}
-	return *v, true
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *AuthTokenMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// OldAgentID returns the old "agent_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldAgentID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAgentID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAgentID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAgentID: %w", err)
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *AuthTokenMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.AgentID, nil
-}
-
-// ClearAgentID clears the value of the "agent_id" field.
-func (m *MessageMutation) ClearAgentID() {
-	m.agent = nil
-	m.clearedFields[message.FieldAgentID] = struct{}{}
-}
-
-// AgentIDCleared returns if the "agent_id" field was cleared in this mutation.
-func (m *MessageMutation) AgentIDCleared() bool {
-	_, ok := m.clearedFields[message.FieldAgentID]
-	return ok
+	return
 }
 
-// ResetAgentID resets all changes to the "agent_id" field.
-func (m *MessageMutation) ResetAgentID() {
-	m.agent = nil
-	delete(m.clearedFields, message.FieldAgentID)
+// ResetUser resets all changes to the "user" edge.
+func (m *AuthTokenMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// SetModelID sets the "model_id" field.
-func (m *MessageMutation) SetModelID(u uuid.UUID) {
-	m.model = &u
+// Where appends a list predicates to the AuthTokenMutation builder.
+func (m *AuthTokenMutation) Where(ps ...predicate.AuthToken) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ModelID returns the value of the "model_id" field in the mutation.
-func (m *MessageMutation) ModelID() (r uuid.UUID, exists bool) {
-	v := m.model
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the AuthTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AuthTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuthToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldModelID returns the old "model_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldModelID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModelID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModelID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModelID: %w", err)
-	}
-	return oldValue.ModelID, nil
+// Op returns the operation name.
+func (m *AuthTokenMutation) Op() Op {
+	return m.op
 }
 
-// ClearModelID clears the value of the "model_id" field.
-func (m *MessageMutation) ClearModelID() {
-	m.model = nil
-	m.clearedFields[message.FieldModelID] = struct{}{}
+// SetOp allows setting the mutation operation.
+func (m *AuthTokenMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// ModelIDCleared returns if the "model_id" field was cleared in this mutation.
-func (m *MessageMutation) ModelIDCleared() bool {
-	_, ok := m.clearedFields[message.FieldModelID]
-	return ok
+// Type returns the node type of this mutation (AuthToken).
+func (m *AuthTokenMutation) Type() string {
+	return m.typ
 }
 
-// ResetModelID resets all changes to the "model_id" field.
-func (m *MessageMutation) ResetModelID() {
-	m.model = nil
-	delete(m.clearedFields, message.FieldModelID)
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuthTokenMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.create_time != nil {
+		fields = append(fields, authtoken.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, authtoken.FieldUpdateTime)
+	}
+	if m.name != nil {
+		fields = append(fields, authtoken.FieldName)
+	}
+	if m.token_hash != nil {
+		fields = append(fields, authtoken.FieldTokenHash)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, authtoken.FieldExpiresAt)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, authtoken.FieldLastUsedAt)
+	}
+	if m.user != nil {
+		fields = append(fields, authtoken.FieldUserID)
+	}
+	return fields
 }
 
-// ClearTask clears the "task" edge to the Task entity.
-func (m *MessageMutation) ClearTask() {
-	m.clearedtask = true
-	m.clearedFields[message.FieldTaskID] = struct{}{}
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AuthTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case authtoken.FieldCreateTime:
+		return m.CreateTime()
+	case authtoken.FieldUpdateTime:
+		return m.UpdateTime()
+	case authtoken.FieldName:
+		return m.Name()
+	case authtoken.FieldTokenHash:
+		return m.TokenHash()
+	case authtoken.FieldExpiresAt:
+		return m.ExpiresAt()
+	case authtoken.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case authtoken.FieldUserID:
+		return m.UserID()
+	}
+	return nil, false
 }
 
-// TaskCleared reports if the "task" edge to the Task entity was cleared.
-func (m *MessageMutation) TaskCleared() bool {
-	return m.clearedtask
-}
-
-// TaskIDs returns the "task" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TaskID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) TaskIDs() (ids []uuid.UUID) {
-	if id := m.task; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetTask resets all changes to the "task" edge.
-func (m *MessageMutation) ResetTask() {
-	m.task = nil
-	m.clearedtask = false
-}
-
-// ClearAgent clears the "agent" edge to the Agent entity.
-func (m *MessageMutation) ClearAgent() {
-	m.clearedagent = true
-	m.clearedFields[message.FieldAgentID] = struct{}{}
-}
-
-// AgentCleared reports if the "agent" edge to the Agent entity was cleared.
-func (m *MessageMutation) AgentCleared() bool {
-	return m.AgentIDCleared() || m.clearedagent
-}
-
-// AgentIDs returns the "agent" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// AgentID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) AgentIDs() (ids []uuid.UUID) {
-	if id := m.agent; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetAgent resets all changes to the "agent" edge.
-func (m *MessageMutation) ResetAgent() {
-	m.agent = nil
-	m.clearedagent = false
-}
-
-// ClearModel clears the "model" edge to the Model entity.
-func (m *MessageMutation) ClearModel() {
-	m.clearedmodel = true
-	m.clearedFields[message.FieldModelID] = struct{}{}
-}
-
-// ModelCleared reports if the "model" edge to the Model entity was cleared.
-func (m *MessageMutation) ModelCleared() bool {
-	return m.ModelIDCleared() || m.clearedmodel
-}
-
-// ModelIDs returns the "model" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ModelID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) ModelIDs() (ids []uuid.UUID) {
-	if id := m.model; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetModel resets all changes to the "model" edge.
-func (m *MessageMutation) ResetModel() {
-	m.model = nil
-	m.clearedmodel = false
-}
-
-// Where appends a list predicates to the MessageMutation builder.
-func (m *MessageMutation) Where(ps ...predicate.Message) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Message, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *MessageMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *MessageMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (Message).
-func (m *MessageMutation) Type() string {
-	return m.typ
-}
-
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *MessageMutation) Fields() []string {
-	fields := make([]string, 0, 9)
-	if m.create_time != nil {
-		fields = append(fields, message.FieldCreateTime)
-	}
-	if m.update_time != nil {
-		fields = append(fields, message.FieldUpdateTime)
-	}
-	if m.source != nil {
-		fields = append(fields, message.FieldSource)
-	}
-	if m.content != nil {
-		fields = append(fields, message.FieldContent)
-	}
-	if m.usage != nil {
-		fields = append(fields, message.FieldUsage)
-	}
-	if m.processed_time != nil {
-		fields = append(fields, message.FieldProcessedTime)
-	}
-	if m.task != nil {
-		fields = append(fields, message.FieldTaskID)
-	}
-	if m.agent != nil {
-		fields = append(fields, message.FieldAgentID)
-	}
-	if m.model != nil {
-		fields = append(fields, message.FieldModelID)
-	}
-	return fields
-}
-
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *MessageMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case message.FieldCreateTime:
-		return m.CreateTime()
-	case message.FieldUpdateTime:
-		return m.UpdateTime()
-	case message.FieldSource:
-		return m.Source()
-	case message.FieldContent:
-		return m.Content()
-	case message.FieldUsage:
-		return m.Usage()
-	case message.FieldProcessedTime:
-		return m.ProcessedTime()
-	case message.FieldTaskID:
-		return m.TaskID()
-	case message.FieldAgentID:
-		return m.AgentID()
-	case message.FieldModelID:
-		return m.ModelID()
-	}
-	return nil, false
-}
-
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case message.FieldCreateTime:
-		return m.OldCreateTime(ctx)
-	case message.FieldUpdateTime:
-		return m.OldUpdateTime(ctx)
-	case message.FieldSource:
-		return m.OldSource(ctx)
-	case message.FieldContent:
-		return m.OldContent(ctx)
-	case message.FieldUsage:
-		return m.OldUsage(ctx)
-	case message.FieldProcessedTime:
-		return m.OldProcessedTime(ctx)
-	case message.FieldTaskID:
-		return m.OldTaskID(ctx)
-	case message.FieldAgentID:
-		return m.OldAgentID(ctx)
-	case message.FieldModelID:
-		return m.OldModelID(ctx)
-	}
-	return nil, fmt.Errorf("unknown Message field %s", name)
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AuthTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case authtoken.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case authtoken.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case authtoken.FieldName:
+		return m.OldName(ctx)
+	case authtoken.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case authtoken.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case authtoken.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case authtoken.FieldUserID:
+		return m.OldUserID(ctx)
+	}
+	return nil, fmt.Errorf("unknown AuthToken field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) SetField(name string, value ent.Value) error {
+func (m *AuthTokenMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case message.FieldCreateTime:
+	case authtoken.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case message.FieldUpdateTime:
+	case authtoken.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case message.FieldSource:
-		v, ok := value.(types.MessageSource)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSource(v)
-		return nil
-	case message.FieldContent:
-		v, ok := value.(*types.MessageContent)
+	case authtoken.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContent(v)
+		m.SetName(v)
 		return nil
-	case message.FieldUsage:
-		v, ok := value.(*types.MessageUsage)
+	case authtoken.FieldTokenHash:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUsage(v)
+		m.SetTokenHash(v)
 		return nil
-	case message.FieldProcessedTime:
+	case authtoken.FieldExpiresAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProcessedTime(v)
-		return nil
-	case message.FieldTaskID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTaskID(v)
+		m.SetExpiresAt(v)
 		return nil
-	case message.FieldAgentID:
-		v, ok := value.(uuid.UUID)
+	case authtoken.FieldLastUsedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAgentID(v)
+		m.SetLastUsedAt(v)
 		return nil
-	case message.FieldModelID:
+	case authtoken.FieldUserID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetModelID(v)
+		m.SetUserID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown AuthToken field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageMutation) AddedFields() []string {
+func (m *AuthTokenMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AuthTokenMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) AddField(name string, value ent.Value) error {
+func (m *AuthTokenMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Message numeric field %s", name)
+	return fmt.Errorf("unknown AuthToken numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageMutation) ClearedFields() []string {
+func (m *AuthTokenMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(message.FieldUsage) {
-		fields = append(fields, message.FieldUsage)
-	}
-	if m.FieldCleared(message.FieldProcessedTime) {
-		fields = append(fields, message.FieldProcessedTime)
+	if m.FieldCleared(authtoken.FieldExpiresAt) {
+		fields = append(fields, authtoken.FieldExpiresAt)
 	}
-	if m.FieldCleared(message.FieldAgentID) {
-		fields = append(fields, message.FieldAgentID)
-	}
-	if m.FieldCleared(message.FieldModelID) {
-		fields = append(fields, message.FieldModelID)
+	if m.FieldCleared(authtoken.FieldLastUsedAt) {
+		fields = append(fields, authtoken.FieldLastUsedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageMutation) FieldCleared(name string) bool {
+func (m *AuthTokenMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageMutation) ClearField(name string) error {
+func (m *AuthTokenMutation) ClearField(name string) error {
 	switch name {
-	case message.FieldUsage:
-		m.ClearUsage()
-		return nil
-	case message.FieldProcessedTime:
-		m.ClearProcessedTime()
+	case authtoken.FieldExpiresAt:
+		m.ClearExpiresAt()
 		return nil
-	case message.FieldAgentID:
-		m.ClearAgentID()
-		return nil
-	case message.FieldModelID:
-		m.ClearModelID()
+	case authtoken.FieldLastUsedAt:
+		m.ClearLastUsedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Message nullable field %s", name)
+	return fmt.Errorf("unknown AuthToken nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageMutation) ResetField(name string) error {
+func (m *AuthTokenMutation) ResetField(name string) error {
 	switch name {
-	case message.FieldCreateTime:
+	case authtoken.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case message.FieldUpdateTime:
+	case authtoken.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case message.FieldSource:
-		m.ResetSource()
+	case authtoken.FieldName:
+		m.ResetName()
 		return nil
-	case message.FieldContent:
-		m.ResetContent()
+	case authtoken.FieldTokenHash:
+		m.ResetTokenHash()
 		return nil
-	case message.FieldUsage:
-		m.ResetUsage()
+	case authtoken.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
-	case message.FieldProcessedTime:
-		m.ResetProcessedTime()
-		return nil
-	case message.FieldTaskID:
-		m.ResetTaskID()
-		return nil
-	case message.FieldAgentID:
-		m.ResetAgentID()
+	case authtoken.FieldLastUsedAt:
+		m.ResetLastUsedAt()
 		return nil
-	case message.FieldModelID:
-		m.ResetModelID()
+	case authtoken.FieldUserID:
+		m.ResetUserID()
 		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown AuthToken field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.task != nil {
-		edges = append(edges, message.EdgeTask)
-	}
-	if m.agent != nil {
-		edges = append(edges, message.EdgeAgent)
-	}
-	if m.model != nil {
-		edges = append(edges, message.EdgeModel)
+func (m *AuthTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, authtoken.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+func (m *AuthTokenMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case message.EdgeTask:
-		if id := m.task; id != nil {
-			return []ent.Value{*id}
-		}
-	case message.EdgeAgent:
-		if id := m.agent; id != nil {
-			return []ent.Value{*id}
-		}
-	case message.EdgeModel:
-		if id := m.model; id != nil {
+	case authtoken.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -1871,128 +2107,94 @@ func (m *MessageMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
+func (m *AuthTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+func (m *AuthTokenMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedtask {
-		edges = append(edges, message.EdgeTask)
-	}
-	if m.clearedagent {
-		edges = append(edges, message.EdgeAgent)
-	}
-	if m.clearedmodel {
-		edges = append(edges, message.EdgeModel)
+func (m *AuthTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, authtoken.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageMutation) EdgeCleared(name string) bool {
+func (m *AuthTokenMutation) EdgeCleared(name string) bool {
 	switch name {
-	case message.EdgeTask:
-		return m.clearedtask
-	case message.EdgeAgent:
-		return m.clearedagent
-	case message.EdgeModel:
-		return m.clearedmodel
+	case authtoken.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageMutation) ClearEdge(name string) error {
+func (m *AuthTokenMutation) ClearEdge(name string) error {
 	switch name {
-	case message.EdgeTask:
-		m.ClearTask()
-		return nil
-	case message.EdgeAgent:
-		m.ClearAgent()
-		return nil
-	case message.EdgeModel:
-		m.ClearModel()
+	case authtoken.EdgeUser:
+		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Message unique edge %s", name)
+	return fmt.Errorf("unknown AuthToken unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageMutation) ResetEdge(name string) error {
+func (m *AuthTokenMutation) ResetEdge(name string) error {
 	switch name {
-	case message.EdgeTask:
-		m.ResetTask()
-		return nil
-	case message.EdgeAgent:
-		m.ResetAgent()
-		return nil
-	case message.EdgeModel:
-		m.ResetModel()
+	case authtoken.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Message edge %s", name)
+	return fmt.Errorf("unknown AuthToken edge %s", name)
 }
 
-// ModelMutation represents an operation that mutates the Model nodes in the graph.
-type ModelMutation struct {
+// MessageMutation represents an operation that mutates the Message nodes in the graph.
+type MessageMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *uuid.UUID
-	create_time           *time.Time
-	update_time           *time.Time
-	name                  *string
-	context_window        *int64
-	addcontext_window     *int64
-	capabilities          *[]types.ModelCapability
-	appendcapabilities    []types.ModelCapability
-	input_cost            *float64
-	addinput_cost         *float64
-	output_cost           *float64
-	addoutput_cost        *float64
-	cache_write_cost      *float64
-	addcache_write_cost   *float64
-	cache_read_cost       *float64
-	addcache_read_cost    *float64
-	enabled               *bool
-	alias                 *string
-	clearedFields         map[string]struct{}
-	agents                map[uuid.UUID]struct{}
-	removedagents         map[uuid.UUID]struct{}
-	clearedagents         bool
-	model_provider        *uuid.UUID
-	clearedmodel_provider bool
-	messages              map[uuid.UUID]struct{}
-	removedmessages       map[uuid.UUID]struct{}
-	clearedmessages       bool
-	done                  bool
-	oldValue              func(context.Context) (*Model, error)
-	predicates            []predicate.Model
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	create_time    *time.Time
+	update_time    *time.Time
+	source         *types.MessageSource
+	delivery       *types.MessageDelivery
+	content        **types.MessageContent
+	usage          **types.MessageUsage
+	processed_time *time.Time
+	clearedFields  map[string]struct{}
+	task           *uuid.UUID
+	clearedtask    bool
+	agent          *uuid.UUID
+	clearedagent   bool
+	model          *uuid.UUID
+	clearedmodel   bool
+	done           bool
+	oldValue       func(context.Context) (*Message, error)
+	predicates     []predicate.Message
 }
 
-var _ ent.Mutation = (*ModelMutation)(nil)
+var _ ent.Mutation = (*MessageMutation)(nil)
 
-// modelOption allows management of the mutation configuration using functional options.
-type modelOption func(*ModelMutation)
+// messageOption allows management of the mutation configuration using functional options.
+type messageOption func(*MessageMutation)
 
-// newModelMutation creates new mutation for the Model entity.
-func newModelMutation(c config, op Op, opts ...modelOption) *ModelMutation {
-	m := &ModelMutation{
+// newMessageMutation creates new mutation for the Message entity.
+func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
+	m := &MessageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeModel,
+		typ:           TypeMessage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2001,20 +2203,20 @@ func newModelMutation(c config, op Op, opts ...modelOption) *ModelMutation {
 	return m
 }
 
-// withModelID sets the ID field of the mutation.
-func withModelID(id uuid.UUID) modelOption {
-	return func(m *ModelMutation) {
+// withMessageID sets the ID field of the mutation.
+func withMessageID(id uuid.UUID) messageOption {
+	return func(m *MessageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Model
+			value *Message
 		)
-		m.oldValue = func(ctx context.Context) (*Model, error) {
+		m.oldValue = func(ctx context.Context) (*Message, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Model.Get(ctx, id)
+					value, err = m.Client().Message.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2023,10 +2225,10 @@ func withModelID(id uuid.UUID) modelOption {
 	}
 }
 
-// withModel sets the old Model of the mutation.
-func withModel(node *Model) modelOption {
-	return func(m *ModelMutation) {
-		m.oldValue = func(context.Context) (*Model, error) {
+// withMessage sets the old Message of the mutation.
+func withMessage(node *Message) messageOption {
+	return func(m *MessageMutation) {
+		m.oldValue = func(context.Context) (*Message, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2035,7 +2237,7 @@ func withModel(node *Model) modelOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ModelMutation) Client() *Client {
+func (m MessageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2043,7 +2245,7 @@ func (m ModelMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ModelMutation) Tx() (*Tx, error) {
+func (m MessageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("memory: mutation is not running in a transaction")
 	}
@@ -2053,14 +2255,14 @@ func (m ModelMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Model entities.
-func (m *ModelMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Message entities.
+func (m *MessageMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ModelMutation) ID() (id uuid.UUID, exists bool) {
+func (m *MessageMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2071,7 +2273,7 @@ func (m *ModelMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ModelMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *MessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2080,19 +2282,19 @@ func (m *ModelMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Model.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *ModelMutation) SetCreateTime(t time.Time) {
+func (m *MessageMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *ModelMutation) CreateTime() (r time.Time, exists bool) {
+func (m *MessageMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -2100,10 +2302,10 @@ func (m *ModelMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *MessageMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -2118,17 +2320,17 @@ func (m *ModelMutation) OldCreateTime(ctx context.Context) (v time.Time, err err
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *ModelMutation) ResetCreateTime() {
+func (m *MessageMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *ModelMutation) SetUpdateTime(t time.Time) {
+func (m *MessageMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *ModelMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *MessageMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -2136,10 +2338,10 @@ func (m *ModelMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *MessageMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -2154,541 +2356,1596 @@ func (m *ModelMutation) OldUpdateTime(ctx context.Context) (v time.Time, err err
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *ModelMutation) ResetUpdateTime() {
+func (m *MessageMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetName sets the "name" field.
-func (m *ModelMutation) SetName(s string) {
-	m.name = &s
+// SetSource sets the "source" field.
+func (m *MessageMutation) SetSource(ts types.MessageSource) {
+	m.source = &ts
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *ModelMutation) Name() (r string, exists bool) {
-	v := m.name
+// Source returns the value of the "source" field in the mutation.
+func (m *MessageMutation) Source() (r types.MessageSource, exists bool) {
+	v := m.source
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// OldSource returns the old "source" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *MessageMutation) OldSource(ctx context.Context) (v types.MessageSource, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldSource requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Source, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *ModelMutation) ResetName() {
-	m.name = nil
+// ResetSource resets all changes to the "source" field.
+func (m *MessageMutation) ResetSource() {
+	m.source = nil
 }
 
-// SetContextWindow sets the "context_window" field.
-func (m *ModelMutation) SetContextWindow(i int64) {
-	m.context_window = &i
-	m.addcontext_window = nil
+// SetDelivery sets the "delivery" field.
+func (m *MessageMutation) SetDelivery(td types.MessageDelivery) {
+	m.delivery = &td
 }
 
-// ContextWindow returns the value of the "context_window" field in the mutation.
-func (m *ModelMutation) ContextWindow() (r int64, exists bool) {
-	v := m.context_window
+// Delivery returns the value of the "delivery" field in the mutation.
+func (m *MessageMutation) Delivery() (r types.MessageDelivery, exists bool) {
+	v := m.delivery
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContextWindow returns the old "context_window" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// OldDelivery returns the old "delivery" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldContextWindow(ctx context.Context) (v int64, err error) {
+func (m *MessageMutation) OldDelivery(ctx context.Context) (v types.MessageDelivery, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContextWindow is only allowed on UpdateOne operations")
+		return v, errors.New("OldDelivery is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContextWindow requires an ID field in the mutation")
+		return v, errors.New("OldDelivery requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContextWindow: %w", err)
+		return v, fmt.Errorf("querying old value for OldDelivery: %w", err)
 	}
-	return oldValue.ContextWindow, nil
+	return oldValue.Delivery, nil
 }
 
-// AddContextWindow adds i to the "context_window" field.
-func (m *ModelMutation) AddContextWindow(i int64) {
-	if m.addcontext_window != nil {
-		*m.addcontext_window += i
-	} else {
-		m.addcontext_window = &i
-	}
+// ResetDelivery resets all changes to the "delivery" field.
+func (m *MessageMutation) ResetDelivery() {
+	m.delivery = nil
 }
 
-// AddedContextWindow returns the value that was added to the "context_window" field in this mutation.
-func (m *ModelMutation) AddedContextWindow() (r int64, exists bool) {
-	v := m.addcontext_window
+// SetContent sets the "content" field.
+func (m *MessageMutation) SetContent(tc *types.MessageContent) {
+	m.content = &tc
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *MessageMutation) Content() (r *types.MessageContent, exists bool) {
+	v := m.content
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetContextWindow resets all changes to the "context_window" field.
-func (m *ModelMutation) ResetContextWindow() {
-	m.context_window = nil
-	m.addcontext_window = nil
+// OldContent returns the old "content" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldContent(ctx context.Context) (v *types.MessageContent, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
 }
 
-// SetCapabilities sets the "capabilities" field.
-func (m *ModelMutation) SetCapabilities(tc []types.ModelCapability) {
-	m.capabilities = &tc
-	m.appendcapabilities = nil
+// ResetContent resets all changes to the "content" field.
+func (m *MessageMutation) ResetContent() {
+	m.content = nil
 }
 
-// Capabilities returns the value of the "capabilities" field in the mutation.
-func (m *ModelMutation) Capabilities() (r []types.ModelCapability, exists bool) {
-	v := m.capabilities
+// SetUsage sets the "usage" field.
+func (m *MessageMutation) SetUsage(tu *types.MessageUsage) {
+	m.usage = &tu
+}
+
+// Usage returns the value of the "usage" field in the mutation.
+func (m *MessageMutation) Usage() (r *types.MessageUsage, exists bool) {
+	v := m.usage
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCapabilities returns the old "capabilities" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// OldUsage returns the old "usage" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldCapabilities(ctx context.Context) (v []types.ModelCapability, err error) {
+func (m *MessageMutation) OldUsage(ctx context.Context) (v *types.MessageUsage, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCapabilities is only allowed on UpdateOne operations")
+		return v, errors.New("OldUsage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCapabilities requires an ID field in the mutation")
+		return v, errors.New("OldUsage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCapabilities: %w", err)
-	}
-	return oldValue.Capabilities, nil
-}
-
-// AppendCapabilities adds tc to the "capabilities" field.
-func (m *ModelMutation) AppendCapabilities(tc []types.ModelCapability) {
-	m.appendcapabilities = append(m.appendcapabilities, tc...)
-}
-
-// AppendedCapabilities returns the list of values that were appended to the "capabilities" field in this mutation.
-func (m *ModelMutation) AppendedCapabilities() ([]types.ModelCapability, bool) {
-	if len(m.appendcapabilities) == 0 {
-		return nil, false
+		return v, fmt.Errorf("querying old value for OldUsage: %w", err)
 	}
-	return m.appendcapabilities, true
+	return oldValue.Usage, nil
 }
 
-// ClearCapabilities clears the value of the "capabilities" field.
-func (m *ModelMutation) ClearCapabilities() {
-	m.capabilities = nil
-	m.appendcapabilities = nil
-	m.clearedFields[model.FieldCapabilities] = struct{}{}
+// ClearUsage clears the value of the "usage" field.
+func (m *MessageMutation) ClearUsage() {
+	m.usage = nil
+	m.clearedFields[message.FieldUsage] = struct{}{}
 }
 
-// CapabilitiesCleared returns if the "capabilities" field was cleared in this mutation.
-func (m *ModelMutation) CapabilitiesCleared() bool {
-	_, ok := m.clearedFields[model.FieldCapabilities]
+// UsageCleared returns if the "usage" field was cleared in this mutation.
+func (m *MessageMutation) UsageCleared() bool {
+	_, ok := m.clearedFields[message.FieldUsage]
 	return ok
 }
 
-// ResetCapabilities resets all changes to the "capabilities" field.
-func (m *ModelMutation) ResetCapabilities() {
-	m.capabilities = nil
-	m.appendcapabilities = nil
-	delete(m.clearedFields, model.FieldCapabilities)
+// ResetUsage resets all changes to the "usage" field.
+func (m *MessageMutation) ResetUsage() {
+	m.usage = nil
+	delete(m.clearedFields, message.FieldUsage)
 }
 
-// SetInputCost sets the "input_cost" field.
-func (m *ModelMutation) SetInputCost(f float64) {
-	m.input_cost = &f
-	m.addinput_cost = nil
+// SetProcessedTime sets the "processed_time" field.
+func (m *MessageMutation) SetProcessedTime(t time.Time) {
+	m.processed_time = &t
 }
 
-// InputCost returns the value of the "input_cost" field in the mutation.
-func (m *ModelMutation) InputCost() (r float64, exists bool) {
-	v := m.input_cost
+// ProcessedTime returns the value of the "processed_time" field in the mutation.
+func (m *MessageMutation) ProcessedTime() (r time.Time, exists bool) {
+	v := m.processed_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInputCost returns the old "input_cost" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// OldProcessedTime returns the old "processed_time" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldInputCost(ctx context.Context) (v float64, err error) {
+func (m *MessageMutation) OldProcessedTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInputCost is only allowed on UpdateOne operations")
+		return v, errors.New("OldProcessedTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInputCost requires an ID field in the mutation")
+		return v, errors.New("OldProcessedTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInputCost: %w", err)
+		return v, fmt.Errorf("querying old value for OldProcessedTime: %w", err)
 	}
-	return oldValue.InputCost, nil
+	return oldValue.ProcessedTime, nil
 }
 
-// AddInputCost adds f to the "input_cost" field.
-func (m *ModelMutation) AddInputCost(f float64) {
-	if m.addinput_cost != nil {
-		*m.addinput_cost += f
-	} else {
-		m.addinput_cost = &f
-	}
+// ClearProcessedTime clears the value of the "processed_time" field.
+func (m *MessageMutation) ClearProcessedTime() {
+	m.processed_time = nil
+	m.clearedFields[message.FieldProcessedTime] = struct{}{}
 }
 
-// AddedInputCost returns the value that was added to the "input_cost" field in this mutation.
-func (m *ModelMutation) AddedInputCost() (r float64, exists bool) {
-	v := m.addinput_cost
-	if v == nil {
-		return
-	}
-	return *v, true
+// ProcessedTimeCleared returns if the "processed_time" field was cleared in this mutation.
+func (m *MessageMutation) ProcessedTimeCleared() bool {
+	_, ok := m.clearedFields[message.FieldProcessedTime]
+	return ok
 }
 
-// ResetInputCost resets all changes to the "input_cost" field.
-func (m *ModelMutation) ResetInputCost() {
-	m.input_cost = nil
-	m.addinput_cost = nil
+// ResetProcessedTime resets all changes to the "processed_time" field.
+func (m *MessageMutation) ResetProcessedTime() {
+	m.processed_time = nil
+	delete(m.clearedFields, message.FieldProcessedTime)
 }
 
-// SetOutputCost sets the "output_cost" field.
-func (m *ModelMutation) SetOutputCost(f float64) {
-	m.output_cost = &f
-	m.addoutput_cost = nil
+// SetTaskID sets the "task_id" field.
+func (m *MessageMutation) SetTaskID(u uuid.UUID) {
+	m.task = &u
 }
 
-// OutputCost returns the value of the "output_cost" field in the mutation.
-func (m *ModelMutation) OutputCost() (r float64, exists bool) {
-	v := m.output_cost
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *MessageMutation) TaskID() (r uuid.UUID, exists bool) {
+	v := m.task
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOutputCost returns the old "output_cost" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// OldTaskID returns the old "task_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldOutputCost(ctx context.Context) (v float64, err error) {
+func (m *MessageMutation) OldTaskID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOutputCost is only allowed on UpdateOne operations")
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOutputCost requires an ID field in the mutation")
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOutputCost: %w", err)
-	}
-	return oldValue.OutputCost, nil
-}
-
-// AddOutputCost adds f to the "output_cost" field.
-func (m *ModelMutation) AddOutputCost(f float64) {
-	if m.addoutput_cost != nil {
-		*m.addoutput_cost += f
-	} else {
-		m.addoutput_cost = &f
-	}
-}
-
-// AddedOutputCost returns the value that was added to the "output_cost" field in this mutation.
-func (m *ModelMutation) AddedOutputCost() (r float64, exists bool) {
-	v := m.addoutput_cost
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
 	}
-	return *v, true
+	return oldValue.TaskID, nil
 }
 
-// ResetOutputCost resets all changes to the "output_cost" field.
-func (m *ModelMutation) ResetOutputCost() {
-	m.output_cost = nil
-	m.addoutput_cost = nil
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *MessageMutation) ResetTaskID() {
+	m.task = nil
 }
 
-// SetCacheWriteCost sets the "cache_write_cost" field.
-func (m *ModelMutation) SetCacheWriteCost(f float64) {
-	m.cache_write_cost = &f
-	m.addcache_write_cost = nil
+// SetAgentID sets the "agent_id" field.
+func (m *MessageMutation) SetAgentID(u uuid.UUID) {
+	m.agent = &u
 }
 
-// CacheWriteCost returns the value of the "cache_write_cost" field in the mutation.
-func (m *ModelMutation) CacheWriteCost() (r float64, exists bool) {
-	v := m.cache_write_cost
+// AgentID returns the value of the "agent_id" field in the mutation.
+func (m *MessageMutation) AgentID() (r uuid.UUID, exists bool) {
+	v := m.agent
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCacheWriteCost returns the old "cache_write_cost" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// OldAgentID returns the old "agent_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldCacheWriteCost(ctx context.Context) (v float64, err error) {
+func (m *MessageMutation) OldAgentID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCacheWriteCost is only allowed on UpdateOne operations")
+		return v, errors.New("OldAgentID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCacheWriteCost requires an ID field in the mutation")
+		return v, errors.New("OldAgentID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCacheWriteCost: %w", err)
-	}
-	return oldValue.CacheWriteCost, nil
-}
-
-// AddCacheWriteCost adds f to the "cache_write_cost" field.
-func (m *ModelMutation) AddCacheWriteCost(f float64) {
-	if m.addcache_write_cost != nil {
-		*m.addcache_write_cost += f
-	} else {
-		m.addcache_write_cost = &f
+		return v, fmt.Errorf("querying old value for OldAgentID: %w", err)
 	}
+	return oldValue.AgentID, nil
 }
 
-// AddedCacheWriteCost returns the value that was added to the "cache_write_cost" field in this mutation.
-func (m *ModelMutation) AddedCacheWriteCost() (r float64, exists bool) {
-	v := m.addcache_write_cost
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearAgentID clears the value of the "agent_id" field.
+func (m *MessageMutation) ClearAgentID() {
+	m.agent = nil
+	m.clearedFields[message.FieldAgentID] = struct{}{}
 }
 
-// ResetCacheWriteCost resets all changes to the "cache_write_cost" field.
-func (m *ModelMutation) ResetCacheWriteCost() {
-	m.cache_write_cost = nil
-	m.addcache_write_cost = nil
+// AgentIDCleared returns if the "agent_id" field was cleared in this mutation.
+func (m *MessageMutation) AgentIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldAgentID]
+	return ok
 }
 
-// SetCacheReadCost sets the "cache_read_cost" field.
-func (m *ModelMutation) SetCacheReadCost(f float64) {
-	m.cache_read_cost = &f
-	m.addcache_read_cost = nil
+// ResetAgentID resets all changes to the "agent_id" field.
+func (m *MessageMutation) ResetAgentID() {
+	m.agent = nil
+	delete(m.clearedFields, message.FieldAgentID)
 }
 
-// CacheReadCost returns the value of the "cache_read_cost" field in the mutation.
-func (m *ModelMutation) CacheReadCost() (r float64, exists bool) {
-	v := m.cache_read_cost
+// SetModelID sets the "model_id" field.
+func (m *MessageMutation) SetModelID(u uuid.UUID) {
+	m.model = &u
+}
+
+// ModelID returns the value of the "model_id" field in the mutation.
+func (m *MessageMutation) ModelID() (r uuid.UUID, exists bool) {
+	v := m.model
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCacheReadCost returns the old "cache_read_cost" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// OldModelID returns the old "model_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldCacheReadCost(ctx context.Context) (v float64, err error) {
+func (m *MessageMutation) OldModelID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCacheReadCost is only allowed on UpdateOne operations")
+		return v, errors.New("OldModelID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCacheReadCost requires an ID field in the mutation")
+		return v, errors.New("OldModelID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCacheReadCost: %w", err)
+		return v, fmt.Errorf("querying old value for OldModelID: %w", err)
 	}
-	return oldValue.CacheReadCost, nil
+	return oldValue.ModelID, nil
 }
 
-// AddCacheReadCost adds f to the "cache_read_cost" field.
-func (m *ModelMutation) AddCacheReadCost(f float64) {
-	if m.addcache_read_cost != nil {
-		*m.addcache_read_cost += f
-	} else {
-		m.addcache_read_cost = &f
-	}
+// ClearModelID clears the value of the "model_id" field.
+func (m *MessageMutation) ClearModelID() {
+	m.model = nil
+	m.clearedFields[message.FieldModelID] = struct{}{}
 }
 
-// AddedCacheReadCost returns the value that was added to the "cache_read_cost" field in this mutation.
-func (m *ModelMutation) AddedCacheReadCost() (r float64, exists bool) {
-	v := m.addcache_read_cost
-	if v == nil {
-		return
-	}
-	return *v, true
+// ModelIDCleared returns if the "model_id" field was cleared in this mutation.
+func (m *MessageMutation) ModelIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldModelID]
+	return ok
 }
 
-// ResetCacheReadCost resets all changes to the "cache_read_cost" field.
-func (m *ModelMutation) ResetCacheReadCost() {
-	m.cache_read_cost = nil
-	m.addcache_read_cost = nil
+// ResetModelID resets all changes to the "model_id" field.
+func (m *MessageMutation) ResetModelID() {
+	m.model = nil
+	delete(m.clearedFields, message.FieldModelID)
 }
 
-// SetEnabled sets the "enabled" field.
-func (m *ModelMutation) SetEnabled(b bool) {
-	m.enabled = &b
+// ClearTask clears the "task" edge to the Task entity.
+func (m *MessageMutation) ClearTask() {
+	m.clearedtask = true
+	m.clearedFields[message.FieldTaskID] = struct{}{}
 }
 
-// Enabled returns the value of the "enabled" field in the mutation.
-func (m *ModelMutation) Enabled() (r bool, exists bool) {
-	v := m.enabled
-	if v == nil {
-		return
-	}
-	return *v, true
+// TaskCleared reports if the "task" edge to the Task entity was cleared.
+func (m *MessageMutation) TaskCleared() bool {
+	return m.clearedtask
 }
 
-// OldEnabled returns the old "enabled" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldEnabled(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnabled requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+// TaskIDs returns the "task" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TaskID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) TaskIDs() (ids []uuid.UUID) {
+	if id := m.task; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.Enabled, nil
+	return
 }
 
-// ResetEnabled resets all changes to the "enabled" field.
-func (m *ModelMutation) ResetEnabled() {
-	m.enabled = nil
+// ResetTask resets all changes to the "task" edge.
+func (m *MessageMutation) ResetTask() {
+	m.task = nil
+	m.clearedtask = false
 }
 
-// SetAlias sets the "alias" field.
-func (m *ModelMutation) SetAlias(s string) {
-	m.alias = &s
+// ClearAgent clears the "agent" edge to the Agent entity.
+func (m *MessageMutation) ClearAgent() {
+	m.clearedagent = true
+	m.clearedFields[message.FieldAgentID] = struct{}{}
 }
 
-// Alias returns the value of the "alias" field in the mutation.
-func (m *ModelMutation) Alias() (r string, exists bool) {
-	v := m.alias
-	if v == nil {
-		return
-	}
-	return *v, true
+// AgentCleared reports if the "agent" edge to the Agent entity was cleared.
+func (m *MessageMutation) AgentCleared() bool {
+	return m.AgentIDCleared() || m.clearedagent
 }
 
-// OldAlias returns the old "alias" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldAlias(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAlias is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAlias requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAlias: %w", err)
+// AgentIDs returns the "agent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AgentID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) AgentIDs() (ids []uuid.UUID) {
+	if id := m.agent; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.Alias, nil
-}
-
-// ClearAlias clears the value of the "alias" field.
-func (m *ModelMutation) ClearAlias() {
-	m.alias = nil
-	m.clearedFields[model.FieldAlias] = struct{}{}
+	return
 }
 
-// AliasCleared returns if the "alias" field was cleared in this mutation.
-func (m *ModelMutation) AliasCleared() bool {
-	_, ok := m.clearedFields[model.FieldAlias]
-	return ok
+// ResetAgent resets all changes to the "agent" edge.
+func (m *MessageMutation) ResetAgent() {
+	m.agent = nil
+	m.clearedagent = false
 }
 
-// ResetAlias resets all changes to the "alias" field.
-func (m *ModelMutation) ResetAlias() {
-	m.alias = nil
-	delete(m.clearedFields, model.FieldAlias)
+// ClearModel clears the "model" edge to the Model entity.
+func (m *MessageMutation) ClearModel() {
+	m.clearedmodel = true
+	m.clearedFields[message.FieldModelID] = struct{}{}
 }
 
-// SetModelProviderID sets the "model_provider_id" field.
-func (m *ModelMutation) SetModelProviderID(u uuid.UUID) {
-	m.model_provider = &u
+// ModelCleared reports if the "model" edge to the Model entity was cleared.
+func (m *MessageMutation) ModelCleared() bool {
+	return m.ModelIDCleared() || m.clearedmodel
 }
 
-// ModelProviderID returns the value of the "model_provider_id" field in the mutation.
-func (m *ModelMutation) ModelProviderID() (r uuid.UUID, exists bool) {
-	v := m.model_provider
-	if v == nil {
-		return
+// ModelIDs returns the "model" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ModelID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) ModelIDs() (ids []uuid.UUID) {
+	if id := m.model; id != nil {
+		ids = append(ids, *id)
 	}
-	return *v, true
+	return
 }
 
-// OldModelProviderID returns the old "model_provider_id" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldModelProviderID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModelProviderID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModelProviderID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModelProviderID: %w", err)
-	}
-	return oldValue.ModelProviderID, nil
+// ResetModel resets all changes to the "model" edge.
+func (m *MessageMutation) ResetModel() {
+	m.model = nil
+	m.clearedmodel = false
 }
 
-// ResetModelProviderID resets all changes to the "model_provider_id" field.
-func (m *ModelMutation) ResetModelProviderID() {
-	m.model_provider = nil
+// Where appends a list predicates to the MessageMutation builder.
+func (m *MessageMutation) Where(ps ...predicate.Message) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// AddAgentIDs adds the "agents" edge to the Agent entity by ids.
-func (m *ModelMutation) AddAgentIDs(ids ...uuid.UUID) {
-	if m.agents == nil {
-		m.agents = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.agents[ids[i]] = struct{}{}
+// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Message, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
+	m.Where(p...)
 }
 
-// ClearAgents clears the "agents" edge to the Agent entity.
-func (m *ModelMutation) ClearAgents() {
-	m.clearedagents = true
-}
-
-// AgentsCleared reports if the "agents" edge to the Agent entity was cleared.
-func (m *ModelMutation) AgentsCleared() bool {
-	return m.clearedagents
+// Op returns the operation name.
+func (m *MessageMutation) Op() Op {
+	return m.op
 }
 
-// RemoveAgentIDs removes the "agents" edge to the Agent entity by IDs.
-func (m *ModelMutation) RemoveAgentIDs(ids ...uuid.UUID) {
-	if m.removedagents == nil {
-		m.removedagents = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.agents, ids[i])
-		m.removedagents[ids[i]] = struct{}{}
-	}
+// SetOp allows setting the mutation operation.
+func (m *MessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Message).
+func (m *MessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.create_time != nil {
+		fields = append(fields, message.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, message.FieldUpdateTime)
+	}
+	if m.source != nil {
+		fields = append(fields, message.FieldSource)
+	}
+	if m.delivery != nil {
+		fields = append(fields, message.FieldDelivery)
+	}
+	if m.content != nil {
+		fields = append(fields, message.FieldContent)
+	}
+	if m.usage != nil {
+		fields = append(fields, message.FieldUsage)
+	}
+	if m.processed_time != nil {
+		fields = append(fields, message.FieldProcessedTime)
+	}
+	if m.task != nil {
+		fields = append(fields, message.FieldTaskID)
+	}
+	if m.agent != nil {
+		fields = append(fields, message.FieldAgentID)
+	}
+	if m.model != nil {
+		fields = append(fields, message.FieldModelID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case message.FieldCreateTime:
+		return m.CreateTime()
+	case message.FieldUpdateTime:
+		return m.UpdateTime()
+	case message.FieldSource:
+		return m.Source()
+	case message.FieldDelivery:
+		return m.Delivery()
+	case message.FieldContent:
+		return m.Content()
+	case message.FieldUsage:
+		return m.Usage()
+	case message.FieldProcessedTime:
+		return m.ProcessedTime()
+	case message.FieldTaskID:
+		return m.TaskID()
+	case message.FieldAgentID:
+		return m.AgentID()
+	case message.FieldModelID:
+		return m.ModelID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case message.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case message.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case message.FieldSource:
+		return m.OldSource(ctx)
+	case message.FieldDelivery:
+		return m.OldDelivery(ctx)
+	case message.FieldContent:
+		return m.OldContent(ctx)
+	case message.FieldUsage:
+		return m.OldUsage(ctx)
+	case message.FieldProcessedTime:
+		return m.OldProcessedTime(ctx)
+	case message.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case message.FieldAgentID:
+		return m.OldAgentID(ctx)
+	case message.FieldModelID:
+		return m.OldModelID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Message field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case message.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case message.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case message.FieldSource:
+		v, ok := value.(types.MessageSource)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case message.FieldDelivery:
+		v, ok := value.(types.MessageDelivery)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDelivery(v)
+		return nil
+	case message.FieldContent:
+		v, ok := value.(*types.MessageContent)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case message.FieldUsage:
+		v, ok := value.(*types.MessageUsage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsage(v)
+		return nil
+	case message.FieldProcessedTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessedTime(v)
+		return nil
+	case message.FieldTaskID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskID(v)
+		return nil
+	case message.FieldAgentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAgentID(v)
+		return nil
+	case message.FieldModelID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Message numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(message.FieldUsage) {
+		fields = append(fields, message.FieldUsage)
+	}
+	if m.FieldCleared(message.FieldProcessedTime) {
+		fields = append(fields, message.FieldProcessedTime)
+	}
+	if m.FieldCleared(message.FieldAgentID) {
+		fields = append(fields, message.FieldAgentID)
+	}
+	if m.FieldCleared(message.FieldModelID) {
+		fields = append(fields, message.FieldModelID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageMutation) ClearField(name string) error {
+	switch name {
+	case message.FieldUsage:
+		m.ClearUsage()
+		return nil
+	case message.FieldProcessedTime:
+		m.ClearProcessedTime()
+		return nil
+	case message.FieldAgentID:
+		m.ClearAgentID()
+		return nil
+	case message.FieldModelID:
+		m.ClearModelID()
+		return nil
+	}
+	return fmt.Errorf("unknown Message nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageMutation) ResetField(name string) error {
+	switch name {
+	case message.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case message.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case message.FieldSource:
+		m.ResetSource()
+		return nil
+	case message.FieldDelivery:
+		m.ResetDelivery()
+		return nil
+	case message.FieldContent:
+		m.ResetContent()
+		return nil
+	case message.FieldUsage:
+		m.ResetUsage()
+		return nil
+	case message.FieldProcessedTime:
+		m.ResetProcessedTime()
+		return nil
+	case message.FieldTaskID:
+		m.ResetTaskID()
+		return nil
+	case message.FieldAgentID:
+		m.ResetAgentID()
+		return nil
+	case message.FieldModelID:
+		m.ResetModelID()
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.task != nil {
+		edges = append(edges, message.EdgeTask)
+	}
+	if m.agent != nil {
+		edges = append(edges, message.EdgeAgent)
+	}
+	if m.model != nil {
+		edges = append(edges, message.EdgeModel)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case message.EdgeTask:
+		if id := m.task; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeAgent:
+		if id := m.agent; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeModel:
+		if id := m.model; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedtask {
+		edges = append(edges, message.EdgeTask)
+	}
+	if m.clearedagent {
+		edges = append(edges, message.EdgeAgent)
+	}
+	if m.clearedmodel {
+		edges = append(edges, message.EdgeModel)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case message.EdgeTask:
+		return m.clearedtask
+	case message.EdgeAgent:
+		return m.clearedagent
+	case message.EdgeModel:
+		return m.clearedmodel
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageMutation) ClearEdge(name string) error {
+	switch name {
+	case message.EdgeTask:
+		m.ClearTask()
+		return nil
+	case message.EdgeAgent:
+		m.ClearAgent()
+		return nil
+	case message.EdgeModel:
+		m.ClearModel()
+		return nil
+	}
+	return fmt.Errorf("unknown Message unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageMutation) ResetEdge(name string) error {
+	switch name {
+	case message.EdgeTask:
+		m.ResetTask()
+		return nil
+	case message.EdgeAgent:
+		m.ResetAgent()
+		return nil
+	case message.EdgeModel:
+		m.ResetModel()
+		return nil
+	}
+	return fmt.Errorf("unknown Message edge %s", name)
+}
+
+// ModelMutation represents an operation that mutates the Model nodes in the graph.
+type ModelMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *uuid.UUID
+	create_time           *time.Time
+	update_time           *time.Time
+	name                  *string
+	context_window        *int64
+	addcontext_window     *int64
+	capabilities          *[]types.ModelCapability
+	appendcapabilities    []types.ModelCapability
+	input_cost            *float64
+	addinput_cost         *float64
+	output_cost           *float64
+	addoutput_cost        *float64
+	cache_write_cost      *float64
+	addcache_write_cost   *float64
+	cache_read_cost       *float64
+	addcache_read_cost    *float64
+	enabled               *bool
+	alias                 *string
+	clearedFields         map[string]struct{}
+	agents                map[uuid.UUID]struct{}
+	removedagents         map[uuid.UUID]struct{}
+	clearedagents         bool
+	model_provider        *uuid.UUID
+	clearedmodel_provider bool
+	messages              map[uuid.UUID]struct{}
+	removedmessages       map[uuid.UUID]struct{}
+	clearedmessages       bool
+	done                  bool
+	oldValue              func(context.Context) (*Model, error)
+	predicates            []predicate.Model
+}
+
+var _ ent.Mutation = (*ModelMutation)(nil)
+
+// modelOption allows management of the mutation configuration using functional options.
+type modelOption func(*ModelMutation)
+
+// newModelMutation creates new mutation for the Model entity.
+func newModelMutation(c config, op Op, opts ...modelOption) *ModelMutation {
+	m := &ModelMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeModel,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withModelID sets the ID field of the mutation.
+func withModelID(id uuid.UUID) modelOption {
+	return func(m *ModelMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Model
+		)
+		m.oldValue = func(ctx context.Context) (*Model, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Model.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withModel sets the old Model of the mutation.
+func withModel(node *Model) modelOption {
+	return func(m *ModelMutation) {
+		m.oldValue = func(context.Context) (*Model, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ModelMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ModelMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("memory: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Model entities.
+func (m *ModelMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ModelMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ModelMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Model.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ModelMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ModelMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ModelMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ModelMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ModelMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ModelMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetName sets the "name" field.
+func (m *ModelMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ModelMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ModelMutation) ResetName() {
+	m.name = nil
+}
+
+// SetContextWindow sets the "context_window" field.
+func (m *ModelMutation) SetContextWindow(i int64) {
+	m.context_window = &i
+	m.addcontext_window = nil
+}
+
+// ContextWindow returns the value of the "context_window" field in the mutation.
+func (m *ModelMutation) ContextWindow() (r int64, exists bool) {
+	v := m.context_window
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContextWindow returns the old "context_window" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldContextWindow(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContextWindow is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContextWindow requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContextWindow: %w", err)
+	}
+	return oldValue.ContextWindow, nil
+}
+
+// AddContextWindow adds i to the "context_window" field.
+func (m *ModelMutation) AddContextWindow(i int64) {
+	if m.addcontext_window != nil {
+		*m.addcontext_window += i
+	} else {
+		m.addcontext_window = &i
+	}
+}
+
+// AddedContextWindow returns the value that was added to the "context_window" field in this mutation.
+func (m *ModelMutation) AddedContextWindow() (r int64, exists bool) {
+	v := m.addcontext_window
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetContextWindow resets all changes to the "context_window" field.
+func (m *ModelMutation) ResetContextWindow() {
+	m.context_window = nil
+	m.addcontext_window = nil
+}
+
+// SetCapabilities sets the "capabilities" field.
+func (m *ModelMutation) SetCapabilities(tc []types.ModelCapability) {
+	m.capabilities = &tc
+	m.appendcapabilities = nil
+}
+
+// Capabilities returns the value of the "capabilities" field in the mutation.
+func (m *ModelMutation) Capabilities() (r []types.ModelCapability, exists bool) {
+	v := m.capabilities
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCapabilities returns the old "capabilities" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldCapabilities(ctx context.Context) (v []types.ModelCapability, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCapabilities is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCapabilities requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCapabilities: %w", err)
+	}
+	return oldValue.Capabilities, nil
+}
+
+// AppendCapabilities adds tc to the "capabilities" field.
+func (m *ModelMutation) AppendCapabilities(tc []types.ModelCapability) {
+	m.appendcapabilities = append(m.appendcapabilities, tc...)
+}
+
+// AppendedCapabilities returns the list of values that were appended to the "capabilities" field in this mutation.
+func (m *ModelMutation) AppendedCapabilities() ([]types.ModelCapability, bool) {
+	if len(m.appendcapabilities) == 0 {
+		return nil, false
+	}
+	return m.appendcapabilities, true
+}
+
+// ClearCapabilities clears the value of the "capabilities" field.
+func (m *ModelMutation) ClearCapabilities() {
+	m.capabilities = nil
+	m.appendcapabilities = nil
+	m.clearedFields[model.FieldCapabilities] = struct{}{}
+}
+
+// CapabilitiesCleared returns if the "capabilities" field was cleared in this mutation.
+func (m *ModelMutation) CapabilitiesCleared() bool {
+	_, ok := m.clearedFields[model.FieldCapabilities]
+	return ok
+}
+
+// ResetCapabilities resets all changes to the "capabilities" field.
+func (m *ModelMutation) ResetCapabilities() {
+	m.capabilities = nil
+	m.appendcapabilities = nil
+	delete(m.clearedFields, model.FieldCapabilities)
+}
+
+// SetInputCost sets the "input_cost" field.
+func (m *ModelMutation) SetInputCost(f float64) {
+	m.input_cost = &f
+	m.addinput_cost = nil
+}
+
+// InputCost returns the value of the "input_cost" field in the mutation.
+func (m *ModelMutation) InputCost() (r float64, exists bool) {
+	v := m.input_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInputCost returns the old "input_cost" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldInputCost(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInputCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInputCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInputCost: %w", err)
+	}
+	return oldValue.InputCost, nil
+}
+
+// AddInputCost adds f to the "input_cost" field.
+func (m *ModelMutation) AddInputCost(f float64) {
+	if m.addinput_cost != nil {
+		*m.addinput_cost += f
+	} else {
+		m.addinput_cost = &f
+	}
+}
+
+// AddedInputCost returns the value that was added to the "input_cost" field in this mutation.
+func (m *ModelMutation) AddedInputCost() (r float64, exists bool) {
+	v := m.addinput_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetInputCost resets all changes to the "input_cost" field.
+func (m *ModelMutation) ResetInputCost() {
+	m.input_cost = nil
+	m.addinput_cost = nil
+}
+
+// SetOutputCost sets the "output_cost" field.
+func (m *ModelMutation) SetOutputCost(f float64) {
+	m.output_cost = &f
+	m.addoutput_cost = nil
+}
+
+// OutputCost returns the value of the "output_cost" field in the mutation.
+func (m *ModelMutation) OutputCost() (r float64, exists bool) {
+	v := m.output_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOutputCost returns the old "output_cost" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldOutputCost(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOutputCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOutputCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOutputCost: %w", err)
+	}
+	return oldValue.OutputCost, nil
+}
+
+// AddOutputCost adds f to the "output_cost" field.
+func (m *ModelMutation) AddOutputCost(f float64) {
+	if m.addoutput_cost != nil {
+		*m.addoutput_cost += f
+	} else {
+		m.addoutput_cost = &f
+	}
+}
+
+// AddedOutputCost returns the value that was added to the "output_cost" field in this mutation.
+func (m *ModelMutation) AddedOutputCost() (r float64, exists bool) {
+	v := m.addoutput_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOutputCost resets all changes to the "output_cost" field.
+func (m *ModelMutation) ResetOutputCost() {
+	m.output_cost = nil
+	m.addoutput_cost = nil
+}
+
+// SetCacheWriteCost sets the "cache_write_cost" field.
+func (m *ModelMutation) SetCacheWriteCost(f float64) {
+	m.cache_write_cost = &f
+	m.addcache_write_cost = nil
+}
+
+// CacheWriteCost returns the value of the "cache_write_cost" field in the mutation.
+func (m *ModelMutation) CacheWriteCost() (r float64, exists bool) {
+	v := m.cache_write_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCacheWriteCost returns the old "cache_write_cost" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldCacheWriteCost(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCacheWriteCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCacheWriteCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCacheWriteCost: %w", err)
+	}
+	return oldValue.CacheWriteCost, nil
+}
+
+// AddCacheWriteCost adds f to the "cache_write_cost" field.
+func (m *ModelMutation) AddCacheWriteCost(f float64) {
+	if m.addcache_write_cost != nil {
+		*m.addcache_write_cost += f
+	} else {
+		m.addcache_write_cost = &f
+	}
+}
+
+// AddedCacheWriteCost returns the value that was added to the "cache_write_cost" field in this mutation.
+func (m *ModelMutation) AddedCacheWriteCost() (r float64, exists bool) {
+	v := m.addcache_write_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCacheWriteCost resets all changes to the "cache_write_cost" field.
+func (m *ModelMutation) ResetCacheWriteCost() {
+	m.cache_write_cost = nil
+	m.addcache_write_cost = nil
+}
+
+// SetCacheReadCost sets the "cache_read_cost" field.
+func (m *ModelMutation) SetCacheReadCost(f float64) {
+	m.cache_read_cost = &f
+	m.addcache_read_cost = nil
+}
+
+// CacheReadCost returns the value of the "cache_read_cost" field in the mutation.
+func (m *ModelMutation) CacheReadCost() (r float64, exists bool) {
+	v := m.cache_read_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCacheReadCost returns the old "cache_read_cost" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldCacheReadCost(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCacheReadCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCacheReadCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCacheReadCost: %w", err)
+	}
+	return oldValue.CacheReadCost, nil
+}
+
+// AddCacheReadCost adds f to the "cache_read_cost" field.
+func (m *ModelMutation) AddCacheReadCost(f float64) {
+	if m.addcache_read_cost != nil {
+		*m.addcache_read_cost += f
+	} else {
+		m.addcache_read_cost = &f
+	}
+}
+
+// AddedCacheReadCost returns the value that was added to the "cache_read_cost" field in this mutation.
+func (m *ModelMutation) AddedCacheReadCost() (r float64, exists bool) {
+	v := m.addcache_read_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCacheReadCost resets all changes to the "cache_read_cost" field.
+func (m *ModelMutation) ResetCacheReadCost() {
+	m.cache_read_cost = nil
+	m.addcache_read_cost = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *ModelMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *ModelMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *ModelMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetAlias sets the "alias" field.
+func (m *ModelMutation) SetAlias(s string) {
+	m.alias = &s
+}
+
+// Alias returns the value of the "alias" field in the mutation.
+func (m *ModelMutation) Alias() (r string, exists bool) {
+	v := m.alias
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlias returns the old "alias" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldAlias(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlias is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlias requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlias: %w", err)
+	}
+	return oldValue.Alias, nil
+}
+
+// ClearAlias clears the value of the "alias" field.
+func (m *ModelMutation) ClearAlias() {
+	m.alias = nil
+	m.clearedFields[model.FieldAlias] = struct{}{}
+}
+
+// AliasCleared returns if the "alias" field was cleared in this mutation.
+func (m *ModelMutation) AliasCleared() bool {
+	_, ok := m.clearedFields[model.FieldAlias]
+	return ok
+}
+
+// ResetAlias resets all changes to the "alias" field.
+func (m *ModelMutation) ResetAlias() {
+	m.alias = nil
+	delete(m.clearedFields, model.FieldAlias)
+}
+
+// SetModelProviderID sets the "model_provider_id" field.
+func (m *ModelMutation) SetModelProviderID(u uuid.UUID) {
+	m.model_provider = &u
+}
+
+// ModelProviderID returns the value of the "model_provider_id" field in the mutation.
+func (m *ModelMutation) ModelProviderID() (r uuid.UUID, exists bool) {
+	v := m.model_provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModelProviderID returns the old "model_provider_id" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldModelProviderID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelProviderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelProviderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelProviderID: %w", err)
+	}
+	return oldValue.ModelProviderID, nil
+}
+
+// ResetModelProviderID resets all changes to the "model_provider_id" field.
+func (m *ModelMutation) ResetModelProviderID() {
+	m.model_provider = nil
+}
+
+// AddAgentIDs adds the "agents" edge to the Agent entity by ids.
+func (m *ModelMutation) AddAgentIDs(ids ...uuid.UUID) {
+	if m.agents == nil {
+		m.agents = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.agents[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAgents clears the "agents" edge to the Agent entity.
+func (m *ModelMutation) ClearAgents() {
+	m.clearedagents = true
+}
+
+// AgentsCleared reports if the "agents" edge to the Agent entity was cleared.
+func (m *ModelMutation) AgentsCleared() bool {
+	return m.clearedagents
+}
+
+// RemoveAgentIDs removes the "agents" edge to the Agent entity by IDs.
+func (m *ModelMutation) RemoveAgentIDs(ids ...uuid.UUID) {
+	if m.removedagents == nil {
+		m.removedagents = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.agents, ids[i])
+		m.removedagents[ids[i]] = struct{}{}
+	}
 }
 
 // RemovedAgents returns the removed IDs of the "agents" edge to the Agent entity.
@@ -2696,114 +3953,6467 @@ func (m *ModelMutation) RemovedAgentsIDs() (ids []uuid.UUID) {
 	for id := range m.removedagents {
 		ids = append(ids, id)
 	}
-	return
+	return
+}
+
+// AgentsIDs returns the "agents" edge IDs in the mutation.
+func (m *ModelMutation) AgentsIDs() (ids []uuid.UUID) {
+	for id := range m.agents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAgents resets all changes to the "agents" edge.
+func (m *ModelMutation) ResetAgents() {
+	m.agents = nil
+	m.clearedagents = false
+	m.removedagents = nil
+}
+
+// ClearModelProvider clears the "model_provider" edge to the ModelProvider entity.
+func (m *ModelMutation) ClearModelProvider() {
+	m.clearedmodel_provider = true
+	m.clearedFields[model.FieldModelProviderID] = struct{}{}
+}
+
+// ModelProviderCleared reports if the "model_provider" edge to the ModelProvider entity was cleared.
+func (m *ModelMutation) ModelProviderCleared() bool {
+	return m.clearedmodel_provider
+}
+
+// ModelProviderIDs returns the "model_provider" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ModelProviderID instead. It exists only for internal usage by the builders.
+func (m *ModelMutation) ModelProviderIDs() (ids []uuid.UUID) {
+	if id := m.model_provider; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetModelProvider resets all changes to the "model_provider" edge.
+func (m *ModelMutation) ResetModelProvider() {
+	m.model_provider = nil
+	m.clearedmodel_provider = false
+}
+
+// AddMessageIDs adds the "messages" edge to the Message entity by ids.
+func (m *ModelMutation) AddMessageIDs(ids ...uuid.UUID) {
+	if m.messages == nil {
+		m.messages = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.messages[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMessages clears the "messages" edge to the Message entity.
+func (m *ModelMutation) ClearMessages() {
+	m.clearedmessages = true
+}
+
+// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
+func (m *ModelMutation) MessagesCleared() bool {
+	return m.clearedmessages
+}
+
+// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
+func (m *ModelMutation) RemoveMessageIDs(ids ...uuid.UUID) {
+	if m.removedmessages == nil {
+		m.removedmessages = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.messages, ids[i])
+		m.removedmessages[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
+func (m *ModelMutation) RemovedMessagesIDs() (ids []uuid.UUID) {
+	for id := range m.removedmessages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MessagesIDs returns the "messages" edge IDs in the mutation.
+func (m *ModelMutation) MessagesIDs() (ids []uuid.UUID) {
+	for id := range m.messages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMessages resets all changes to the "messages" edge.
+func (m *ModelMutation) ResetMessages() {
+	m.messages = nil
+	m.clearedmessages = false
+	m.removedmessages = nil
+}
+
+// Where appends a list predicates to the ModelMutation builder.
+func (m *ModelMutation) Where(ps ...predicate.Model) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ModelMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ModelMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Model, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ModelMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ModelMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Model).
+func (m *ModelMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ModelMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.create_time != nil {
+		fields = append(fields, model.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, model.FieldUpdateTime)
+	}
+	if m.name != nil {
+		fields = append(fields, model.FieldName)
+	}
+	if m.context_window != nil {
+		fields = append(fields, model.FieldContextWindow)
+	}
+	if m.capabilities != nil {
+		fields = append(fields, model.FieldCapabilities)
+	}
+	if m.input_cost != nil {
+		fields = append(fields, model.FieldInputCost)
+	}
+	if m.output_cost != nil {
+		fields = append(fields, model.FieldOutputCost)
+	}
+	if m.cache_write_cost != nil {
+		fields = append(fields, model.FieldCacheWriteCost)
+	}
+	if m.cache_read_cost != nil {
+		fields = append(fields, model.FieldCacheReadCost)
+	}
+	if m.enabled != nil {
+		fields = append(fields, model.FieldEnabled)
+	}
+	if m.alias != nil {
+		fields = append(fields, model.FieldAlias)
+	}
+	if m.model_provider != nil {
+		fields = append(fields, model.FieldModelProviderID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ModelMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case model.FieldCreateTime:
+		return m.CreateTime()
+	case model.FieldUpdateTime:
+		return m.UpdateTime()
+	case model.FieldName:
+		return m.Name()
+	case model.FieldContextWindow:
+		return m.ContextWindow()
+	case model.FieldCapabilities:
+		return m.Capabilities()
+	case model.FieldInputCost:
+		return m.InputCost()
+	case model.FieldOutputCost:
+		return m.OutputCost()
+	case model.FieldCacheWriteCost:
+		return m.CacheWriteCost()
+	case model.FieldCacheReadCost:
+		return m.CacheReadCost()
+	case model.FieldEnabled:
+		return m.Enabled()
+	case model.FieldAlias:
+		return m.Alias()
+	case model.FieldModelProviderID:
+		return m.ModelProviderID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ModelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case model.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case model.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case model.FieldName:
+		return m.OldName(ctx)
+	case model.FieldContextWindow:
+		return m.OldContextWindow(ctx)
+	case model.FieldCapabilities:
+		return m.OldCapabilities(ctx)
+	case model.FieldInputCost:
+		return m.OldInputCost(ctx)
+	case model.FieldOutputCost:
+		return m.OldOutputCost(ctx)
+	case model.FieldCacheWriteCost:
+		return m.OldCacheWriteCost(ctx)
+	case model.FieldCacheReadCost:
+		return m.OldCacheReadCost(ctx)
+	case model.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case model.FieldAlias:
+		return m.OldAlias(ctx)
+	case model.FieldModelProviderID:
+		return m.OldModelProviderID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Model field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModelMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case model.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case model.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case model.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case model.FieldContextWindow:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContextWindow(v)
+		return nil
+	case model.FieldCapabilities:
+		v, ok := value.([]types.ModelCapability)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCapabilities(v)
+		return nil
+	case model.FieldInputCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInputCost(v)
+		return nil
+	case model.FieldOutputCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutputCost(v)
+		return nil
+	case model.FieldCacheWriteCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCacheWriteCost(v)
+		return nil
+	case model.FieldCacheReadCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCacheReadCost(v)
+		return nil
+	case model.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case model.FieldAlias:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlias(v)
+		return nil
+	case model.FieldModelProviderID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelProviderID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Model field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ModelMutation) AddedFields() []string {
+	var fields []string
+	if m.addcontext_window != nil {
+		fields = append(fields, model.FieldContextWindow)
+	}
+	if m.addinput_cost != nil {
+		fields = append(fields, model.FieldInputCost)
+	}
+	if m.addoutput_cost != nil {
+		fields = append(fields, model.FieldOutputCost)
+	}
+	if m.addcache_write_cost != nil {
+		fields = append(fields, model.FieldCacheWriteCost)
+	}
+	if m.addcache_read_cost != nil {
+		fields = append(fields, model.FieldCacheReadCost)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ModelMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case model.FieldContextWindow:
+		return m.AddedContextWindow()
+	case model.FieldInputCost:
+		return m.AddedInputCost()
+	case model.FieldOutputCost:
+		return m.AddedOutputCost()
+	case model.FieldCacheWriteCost:
+		return m.AddedCacheWriteCost()
+	case model.FieldCacheReadCost:
+		return m.AddedCacheReadCost()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModelMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case model.FieldContextWindow:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddContextWindow(v)
+		return nil
+	case model.FieldInputCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInputCost(v)
+		return nil
+	case model.FieldOutputCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOutputCost(v)
+		return nil
+	case model.FieldCacheWriteCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCacheWriteCost(v)
+		return nil
+	case model.FieldCacheReadCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCacheReadCost(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Model numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ModelMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(model.FieldCapabilities) {
+		fields = append(fields, model.FieldCapabilities)
+	}
+	if m.FieldCleared(model.FieldAlias) {
+		fields = append(fields, model.FieldAlias)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ModelMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ModelMutation) ClearField(name string) error {
+	switch name {
+	case model.FieldCapabilities:
+		m.ClearCapabilities()
+		return nil
+	case model.FieldAlias:
+		m.ClearAlias()
+		return nil
+	}
+	return fmt.Errorf("unknown Model nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ModelMutation) ResetField(name string) error {
+	switch name {
+	case model.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case model.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case model.FieldName:
+		m.ResetName()
+		return nil
+	case model.FieldContextWindow:
+		m.ResetContextWindow()
+		return nil
+	case model.FieldCapabilities:
+		m.ResetCapabilities()
+		return nil
+	case model.FieldInputCost:
+		m.ResetInputCost()
+		return nil
+	case model.FieldOutputCost:
+		m.ResetOutputCost()
+		return nil
+	case model.FieldCacheWriteCost:
+		m.ResetCacheWriteCost()
+		return nil
+	case model.FieldCacheReadCost:
+		m.ResetCacheReadCost()
+		return nil
+	case model.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case model.FieldAlias:
+		m.ResetAlias()
+		return nil
+	case model.FieldModelProviderID:
+		m.ResetModelProviderID()
+		return nil
+	}
+	return fmt.Errorf("unknown Model field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ModelMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.agents != nil {
+		edges = append(edges, model.EdgeAgents)
+	}
+	if m.model_provider != nil {
+		edges = append(edges, model.EdgeModelProvider)
+	}
+	if m.messages != nil {
+		edges = append(edges, model.EdgeMessages)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ModelMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case model.EdgeAgents:
+		ids := make([]ent.Value, 0, len(m.agents))
+		for id := range m.agents {
+			ids = append(ids, id)
+		}
+		return ids
+	case model.EdgeModelProvider:
+		if id := m.model_provider; id != nil {
+			return []ent.Value{*id}
+		}
+	case model.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.messages))
+		for id := range m.messages {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ModelMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedagents != nil {
+		edges = append(edges, model.EdgeAgents)
+	}
+	if m.removedmessages != nil {
+		edges = append(edges, model.EdgeMessages)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ModelMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case model.EdgeAgents:
+		ids := make([]ent.Value, 0, len(m.removedagents))
+		for id := range m.removedagents {
+			ids = append(ids, id)
+		}
+		return ids
+	case model.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.removedmessages))
+		for id := range m.removedmessages {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ModelMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedagents {
+		edges = append(edges, model.EdgeAgents)
+	}
+	if m.clearedmodel_provider {
+		edges = append(edges, model.EdgeModelProvider)
+	}
+	if m.clearedmessages {
+		edges = append(edges, model.EdgeMessages)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ModelMutation) EdgeCleared(name string) bool {
+	switch name {
+	case model.EdgeAgents:
+		return m.clearedagents
+	case model.EdgeModelProvider:
+		return m.clearedmodel_provider
+	case model.EdgeMessages:
+		return m.clearedmessages
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ModelMutation) ClearEdge(name string) error {
+	switch name {
+	case model.EdgeModelProvider:
+		m.ClearModelProvider()
+		return nil
+	}
+	return fmt.Errorf("unknown Model unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ModelMutation) ResetEdge(name string) error {
+	switch name {
+	case model.EdgeAgents:
+		m.ResetAgents()
+		return nil
+	case model.EdgeModelProvider:
+		m.ResetModelProvider()
+		return nil
+	case model.EdgeMessages:
+		m.ResetMessages()
+		return nil
+	}
+	return fmt.Errorf("unknown Model edge %s", name)
+}
+
+// ModelProviderMutation represents an operation that mutates the ModelProvider nodes in the graph.
+type ModelProviderMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	create_time   *time.Time
+	update_time   *time.Time
+	name          *string
+	provider_type *types.ModelProviderType
+	url           *string
+	secret        *[]byte
+	enabled       *bool
+	clearedFields map[string]struct{}
+	models        map[uuid.UUID]struct{}
+	removedmodels map[uuid.UUID]struct{}
+	clearedmodels bool
+	done          bool
+	oldValue      func(context.Context) (*ModelProvider, error)
+	predicates    []predicate.ModelProvider
+}
+
+var _ ent.Mutation = (*ModelProviderMutation)(nil)
+
+// modelproviderOption allows management of the mutation configuration using functional options.
+type modelproviderOption func(*ModelProviderMutation)
+
+// newModelProviderMutation creates new mutation for the ModelProvider entity.
+func newModelProviderMutation(c config, op Op, opts ...modelproviderOption) *ModelProviderMutation {
+	m := &ModelProviderMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeModelProvider,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withModelProviderID sets the ID field of the mutation.
+func withModelProviderID(id uuid.UUID) modelproviderOption {
+	return func(m *ModelProviderMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ModelProvider
+		)
+		m.oldValue = func(ctx context.Context) (*ModelProvider, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ModelProvider.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withModelProvider sets the old ModelProvider of the mutation.
+func withModelProvider(node *ModelProvider) modelproviderOption {
+	return func(m *ModelProviderMutation) {
+		m.oldValue = func(context.Context) (*ModelProvider, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ModelProviderMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ModelProviderMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("memory: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ModelProvider entities.
+func (m *ModelProviderMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ModelProviderMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ModelProviderMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ModelProvider.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ModelProviderMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ModelProviderMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ModelProvider entity.
+// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ModelProviderMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ModelProviderMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ModelProviderMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the ModelProvider entity.
+// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ModelProviderMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetName sets the "name" field.
+func (m *ModelProviderMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ModelProviderMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the ModelProvider entity.
+// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ModelProviderMutation) ResetName() {
+	m.name = nil
+}
+
+// SetProviderType sets the "provider_type" field.
+func (m *ModelProviderMutation) SetProviderType(tpt types.ModelProviderType) {
+	m.provider_type = &tpt
+}
+
+// ProviderType returns the value of the "provider_type" field in the mutation.
+func (m *ModelProviderMutation) ProviderType() (r types.ModelProviderType, exists bool) {
+	v := m.provider_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProviderType returns the old "provider_type" field's value of the ModelProvider entity.
+// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderMutation) OldProviderType(ctx context.Context) (v types.ModelProviderType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProviderType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProviderType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProviderType: %w", err)
+	}
+	return oldValue.ProviderType, nil
+}
+
+// ResetProviderType resets all changes to the "provider_type" field.
+func (m *ModelProviderMutation) ResetProviderType() {
+	m.provider_type = nil
+}
+
+// SetURL sets the "url" field.
+func (m *ModelProviderMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *ModelProviderMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the ModelProvider entity.
+// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ClearURL clears the value of the "url" field.
+func (m *ModelProviderMutation) ClearURL() {
+	m.url = nil
+	m.clearedFields[modelprovider.FieldURL] = struct{}{}
+}
+
+// URLCleared returns if the "url" field was cleared in this mutation.
+func (m *ModelProviderMutation) URLCleared() bool {
+	_, ok := m.clearedFields[modelprovider.FieldURL]
+	return ok
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *ModelProviderMutation) ResetURL() {
+	m.url = nil
+	delete(m.clearedFields, modelprovider.FieldURL)
+}
+
+// SetSecret sets the "secret" field.
+func (m *ModelProviderMutation) SetSecret(b []byte) {
+	m.secret = &b
+}
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *ModelProviderMutation) Secret() (r []byte, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecret returns the old "secret" field's value of the ModelProvider entity.
+// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderMutation) OldSecret(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ResetSecret resets all changes to the "secret" field.
+func (m *ModelProviderMutation) ResetSecret() {
+	m.secret = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *ModelProviderMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *ModelProviderMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the ModelProvider entity.
+// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *ModelProviderMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// AddModelIDs adds the "models" edge to the Model entity by ids.
+func (m *ModelProviderMutation) AddModelIDs(ids ...uuid.UUID) {
+	if m.models == nil {
+		m.models = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.models[ids[i]] = struct{}{}
+	}
+}
+
+// ClearModels clears the "models" edge to the Model entity.
+func (m *ModelProviderMutation) ClearModels() {
+	m.clearedmodels = true
+}
+
+// ModelsCleared reports if the "models" edge to the Model entity was cleared.
+func (m *ModelProviderMutation) ModelsCleared() bool {
+	return m.clearedmodels
+}
+
+// RemoveModelIDs removes the "models" edge to the Model entity by IDs.
+func (m *ModelProviderMutation) RemoveModelIDs(ids ...uuid.UUID) {
+	if m.removedmodels == nil {
+		m.removedmodels = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.models, ids[i])
+		m.removedmodels[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedModels returns the removed IDs of the "models" edge to the Model entity.
+func (m *ModelProviderMutation) RemovedModelsIDs() (ids []uuid.UUID) {
+	for id := range m.removedmodels {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ModelsIDs returns the "models" edge IDs in the mutation.
+func (m *ModelProviderMutation) ModelsIDs() (ids []uuid.UUID) {
+	for id := range m.models {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetModels resets all changes to the "models" edge.
+func (m *ModelProviderMutation) ResetModels() {
+	m.models = nil
+	m.clearedmodels = false
+	m.removedmodels = nil
+}
+
+// Where appends a list predicates to the ModelProviderMutation builder.
+func (m *ModelProviderMutation) Where(ps ...predicate.ModelProvider) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ModelProviderMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ModelProviderMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ModelProvider, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ModelProviderMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ModelProviderMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ModelProvider).
+func (m *ModelProviderMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ModelProviderMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.create_time != nil {
+		fields = append(fields, modelprovider.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, modelprovider.FieldUpdateTime)
+	}
+	if m.name != nil {
+		fields = append(fields, modelprovider.FieldName)
+	}
+	if m.provider_type != nil {
+		fields = append(fields, modelprovider.FieldProviderType)
+	}
+	if m.url != nil {
+		fields = append(fields, modelprovider.FieldURL)
+	}
+	if m.secret != nil {
+		fields = append(fields, modelprovider.FieldSecret)
+	}
+	if m.enabled != nil {
+		fields = append(fields, modelprovider.FieldEnabled)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ModelProviderMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case modelprovider.FieldCreateTime:
+		return m.CreateTime()
+	case modelprovider.FieldUpdateTime:
+		return m.UpdateTime()
+	case modelprovider.FieldName:
+		return m.Name()
+	case modelprovider.FieldProviderType:
+		return m.ProviderType()
+	case modelprovider.FieldURL:
+		return m.URL()
+	case modelprovider.FieldSecret:
+		return m.Secret()
+	case modelprovider.FieldEnabled:
+		return m.Enabled()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ModelProviderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case modelprovider.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case modelprovider.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case modelprovider.FieldName:
+		return m.OldName(ctx)
+	case modelprovider.FieldProviderType:
+		return m.OldProviderType(ctx)
+	case modelprovider.FieldURL:
+		return m.OldURL(ctx)
+	case modelprovider.FieldSecret:
+		return m.OldSecret(ctx)
+	case modelprovider.FieldEnabled:
+		return m.OldEnabled(ctx)
+	}
+	return nil, fmt.Errorf("unknown ModelProvider field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModelProviderMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case modelprovider.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case modelprovider.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case modelprovider.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case modelprovider.FieldProviderType:
+		v, ok := value.(types.ModelProviderType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProviderType(v)
+		return nil
+	case modelprovider.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case modelprovider.FieldSecret:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case modelprovider.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ModelProvider field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ModelProviderMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ModelProviderMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModelProviderMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ModelProvider numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ModelProviderMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(modelprovider.FieldURL) {
+		fields = append(fields, modelprovider.FieldURL)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ModelProviderMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ModelProviderMutation) ClearField(name string) error {
+	switch name {
+	case modelprovider.FieldURL:
+		m.ClearURL()
+		return nil
+	}
+	return fmt.Errorf("unknown ModelProvider nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ModelProviderMutation) ResetField(name string) error {
+	switch name {
+	case modelprovider.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case modelprovider.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case modelprovider.FieldName:
+		m.ResetName()
+		return nil
+	case modelprovider.FieldProviderType:
+		m.ResetProviderType()
+		return nil
+	case modelprovider.FieldURL:
+		m.ResetURL()
+		return nil
+	case modelprovider.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case modelprovider.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	}
+	return fmt.Errorf("unknown ModelProvider field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ModelProviderMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.models != nil {
+		edges = append(edges, modelprovider.EdgeModels)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ModelProviderMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case modelprovider.EdgeModels:
+		ids := make([]ent.Value, 0, len(m.models))
+		for id := range m.models {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ModelProviderMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedmodels != nil {
+		edges = append(edges, modelprovider.EdgeModels)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ModelProviderMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case modelprovider.EdgeModels:
+		ids := make([]ent.Value, 0, len(m.removedmodels))
+		for id := range m.removedmodels {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ModelProviderMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmodels {
+		edges = append(edges, modelprovider.EdgeModels)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ModelProviderMutation) EdgeCleared(name string) bool {
+	switch name {
+	case modelprovider.EdgeModels:
+		return m.clearedmodels
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ModelProviderMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ModelProvider unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ModelProviderMutation) ResetEdge(name string) error {
+	switch name {
+	case modelprovider.EdgeModels:
+		m.ResetModels()
+		return nil
+	}
+	return fmt.Errorf("unknown ModelProvider edge %s", name)
+}
+
+// ScheduleMutation represents an operation that mutates the Schedule nodes in the graph.
+type ScheduleMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	create_time       *time.Time
+	update_time       *time.Time
+	name              *string
+	cron_expression   *string
+	project_directory *string
+	prompt            *string
+	max_cost          *float64
+	addmax_cost       *float64
+	enabled           *bool
+	last_run_time     *time.Time
+	next_run_time     *time.Time
+	run_count         *int64
+	addrun_count      *int64
+	skipped_count     *int64
+	addskipped_count  *int64
+	last_task_id      *uuid.UUID
+	clearedFields     map[string]struct{}
+	agent             *uuid.UUID
+	clearedagent      bool
+	tasks             map[uuid.UUID]struct{}
+	removedtasks      map[uuid.UUID]struct{}
+	clearedtasks      bool
+	runs              map[uuid.UUID]struct{}
+	removedruns       map[uuid.UUID]struct{}
+	clearedruns       bool
+	done              bool
+	oldValue          func(context.Context) (*Schedule, error)
+	predicates        []predicate.Schedule
+}
+
+var _ ent.Mutation = (*ScheduleMutation)(nil)
+
+// scheduleOption allows management of the mutation configuration using functional options.
+type scheduleOption func(*ScheduleMutation)
+
+// newScheduleMutation creates new mutation for the Schedule entity.
+func newScheduleMutation(c config, op Op, opts ...scheduleOption) *ScheduleMutation {
+	m := &ScheduleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSchedule,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withScheduleID sets the ID field of the mutation.
+func withScheduleID(id uuid.UUID) scheduleOption {
+	return func(m *ScheduleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Schedule
+		)
+		m.oldValue = func(ctx context.Context) (*Schedule, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Schedule.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSchedule sets the old Schedule of the mutation.
+func withSchedule(node *Schedule) scheduleOption {
+	return func(m *ScheduleMutation) {
+		m.oldValue = func(context.Context) (*Schedule, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ScheduleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ScheduleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("memory: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Schedule entities.
+func (m *ScheduleMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ScheduleMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ScheduleMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Schedule.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ScheduleMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ScheduleMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ScheduleMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ScheduleMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ScheduleMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ScheduleMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetName sets the "name" field.
+func (m *ScheduleMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ScheduleMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ScheduleMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCronExpression sets the "cron_expression" field.
+func (m *ScheduleMutation) SetCronExpression(s string) {
+	m.cron_expression = &s
+}
+
+// CronExpression returns the value of the "cron_expression" field in the mutation.
+func (m *ScheduleMutation) CronExpression() (r string, exists bool) {
+	v := m.cron_expression
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCronExpression returns the old "cron_expression" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldCronExpression(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCronExpression is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCronExpression requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCronExpression: %w", err)
+	}
+	return oldValue.CronExpression, nil
+}
+
+// ResetCronExpression resets all changes to the "cron_expression" field.
+func (m *ScheduleMutation) ResetCronExpression() {
+	m.cron_expression = nil
+}
+
+// SetProjectDirectory sets the "project_directory" field.
+func (m *ScheduleMutation) SetProjectDirectory(s string) {
+	m.project_directory = &s
+}
+
+// ProjectDirectory returns the value of the "project_directory" field in the mutation.
+func (m *ScheduleMutation) ProjectDirectory() (r string, exists bool) {
+	v := m.project_directory
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProjectDirectory returns the old "project_directory" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldProjectDirectory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProjectDirectory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProjectDirectory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProjectDirectory: %w", err)
+	}
+	return oldValue.ProjectDirectory, nil
+}
+
+// ResetProjectDirectory resets all changes to the "project_directory" field.
+func (m *ScheduleMutation) ResetProjectDirectory() {
+	m.project_directory = nil
+}
+
+// SetPrompt sets the "prompt" field.
+func (m *ScheduleMutation) SetPrompt(s string) {
+	m.prompt = &s
+}
+
+// Prompt returns the value of the "prompt" field in the mutation.
+func (m *ScheduleMutation) Prompt() (r string, exists bool) {
+	v := m.prompt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrompt returns the old "prompt" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldPrompt(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrompt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrompt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrompt: %w", err)
+	}
+	return oldValue.Prompt, nil
+}
+
+// ResetPrompt resets all changes to the "prompt" field.
+func (m *ScheduleMutation) ResetPrompt() {
+	m.prompt = nil
+}
+
+// SetMaxCost sets the "max_cost" field.
+func (m *ScheduleMutation) SetMaxCost(f float64) {
+	m.max_cost = &f
+	m.addmax_cost = nil
+}
+
+// MaxCost returns the value of the "max_cost" field in the mutation.
+func (m *ScheduleMutation) MaxCost() (r float64, exists bool) {
+	v := m.max_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxCost returns the old "max_cost" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldMaxCost(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxCost: %w", err)
+	}
+	return oldValue.MaxCost, nil
+}
+
+// AddMaxCost adds f to the "max_cost" field.
+func (m *ScheduleMutation) AddMaxCost(f float64) {
+	if m.addmax_cost != nil {
+		*m.addmax_cost += f
+	} else {
+		m.addmax_cost = &f
+	}
+}
+
+// AddedMaxCost returns the value that was added to the "max_cost" field in this mutation.
+func (m *ScheduleMutation) AddedMaxCost() (r float64, exists bool) {
+	v := m.addmax_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxCost clears the value of the "max_cost" field.
+func (m *ScheduleMutation) ClearMaxCost() {
+	m.max_cost = nil
+	m.addmax_cost = nil
+	m.clearedFields[schedule.FieldMaxCost] = struct{}{}
+}
+
+// MaxCostCleared returns if the "max_cost" field was cleared in this mutation.
+func (m *ScheduleMutation) MaxCostCleared() bool {
+	_, ok := m.clearedFields[schedule.FieldMaxCost]
+	return ok
+}
+
+// ResetMaxCost resets all changes to the "max_cost" field.
+func (m *ScheduleMutation) ResetMaxCost() {
+	m.max_cost = nil
+	m.addmax_cost = nil
+	delete(m.clearedFields, schedule.FieldMaxCost)
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *ScheduleMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *ScheduleMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *ScheduleMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetLastRunTime sets the "last_run_time" field.
+func (m *ScheduleMutation) SetLastRunTime(t time.Time) {
+	m.last_run_time = &t
+}
+
+// LastRunTime returns the value of the "last_run_time" field in the mutation.
+func (m *ScheduleMutation) LastRunTime() (r time.Time, exists bool) {
+	v := m.last_run_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastRunTime returns the old "last_run_time" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldLastRunTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastRunTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastRunTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastRunTime: %w", err)
+	}
+	return oldValue.LastRunTime, nil
+}
+
+// ClearLastRunTime clears the value of the "last_run_time" field.
+func (m *ScheduleMutation) ClearLastRunTime() {
+	m.last_run_time = nil
+	m.clearedFields[schedule.FieldLastRunTime] = struct{}{}
+}
+
+// LastRunTimeCleared returns if the "last_run_time" field was cleared in this mutation.
+func (m *ScheduleMutation) LastRunTimeCleared() bool {
+	_, ok := m.clearedFields[schedule.FieldLastRunTime]
+	return ok
+}
+
+// ResetLastRunTime resets all changes to the "last_run_time" field.
+func (m *ScheduleMutation) ResetLastRunTime() {
+	m.last_run_time = nil
+	delete(m.clearedFields, schedule.FieldLastRunTime)
+}
+
+// SetNextRunTime sets the "next_run_time" field.
+func (m *ScheduleMutation) SetNextRunTime(t time.Time) {
+	m.next_run_time = &t
+}
+
+// NextRunTime returns the value of the "next_run_time" field in the mutation.
+func (m *ScheduleMutation) NextRunTime() (r time.Time, exists bool) {
+	v := m.next_run_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextRunTime returns the old "next_run_time" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldNextRunTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextRunTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextRunTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextRunTime: %w", err)
+	}
+	return oldValue.NextRunTime, nil
+}
+
+// ClearNextRunTime clears the value of the "next_run_time" field.
+func (m *ScheduleMutation) ClearNextRunTime() {
+	m.next_run_time = nil
+	m.clearedFields[schedule.FieldNextRunTime] = struct{}{}
+}
+
+// NextRunTimeCleared returns if the "next_run_time" field was cleared in this mutation.
+func (m *ScheduleMutation) NextRunTimeCleared() bool {
+	_, ok := m.clearedFields[schedule.FieldNextRunTime]
+	return ok
+}
+
+// ResetNextRunTime resets all changes to the "next_run_time" field.
+func (m *ScheduleMutation) ResetNextRunTime() {
+	m.next_run_time = nil
+	delete(m.clearedFields, schedule.FieldNextRunTime)
+}
+
+// SetRunCount sets the "run_count" field.
+func (m *ScheduleMutation) SetRunCount(i int64) {
+	m.run_count = &i
+	m.addrun_count = nil
+}
+
+// RunCount returns the value of the "run_count" field in the mutation.
+func (m *ScheduleMutation) RunCount() (r int64, exists bool) {
+	v := m.run_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunCount returns the old "run_count" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldRunCount(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunCount: %w", err)
+	}
+	return oldValue.RunCount, nil
+}
+
+// AddRunCount adds i to the "run_count" field.
+func (m *ScheduleMutation) AddRunCount(i int64) {
+	if m.addrun_count != nil {
+		*m.addrun_count += i
+	} else {
+		m.addrun_count = &i
+	}
+}
+
+// AddedRunCount returns the value that was added to the "run_count" field in this mutation.
+func (m *ScheduleMutation) AddedRunCount() (r int64, exists bool) {
+	v := m.addrun_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRunCount resets all changes to the "run_count" field.
+func (m *ScheduleMutation) ResetRunCount() {
+	m.run_count = nil
+	m.addrun_count = nil
+}
+
+// SetSkippedCount sets the "skipped_count" field.
+func (m *ScheduleMutation) SetSkippedCount(i int64) {
+	m.skipped_count = &i
+	m.addskipped_count = nil
+}
+
+// SkippedCount returns the value of the "skipped_count" field in the mutation.
+func (m *ScheduleMutation) SkippedCount() (r int64, exists bool) {
+	v := m.skipped_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSkippedCount returns the old "skipped_count" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldSkippedCount(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSkippedCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSkippedCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSkippedCount: %w", err)
+	}
+	return oldValue.SkippedCount, nil
+}
+
+// AddSkippedCount adds i to the "skipped_count" field.
+func (m *ScheduleMutation) AddSkippedCount(i int64) {
+	if m.addskipped_count != nil {
+		*m.addskipped_count += i
+	} else {
+		m.addskipped_count = &i
+	}
+}
+
+// AddedSkippedCount returns the value that was added to the "skipped_count" field in this mutation.
+func (m *ScheduleMutation) AddedSkippedCount() (r int64, exists bool) {
+	v := m.addskipped_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSkippedCount resets all changes to the "skipped_count" field.
+func (m *ScheduleMutation) ResetSkippedCount() {
+	m.skipped_count = nil
+	m.addskipped_count = nil
+}
+
+// SetLastTaskID sets the "last_task_id" field.
+func (m *ScheduleMutation) SetLastTaskID(u uuid.UUID) {
+	m.last_task_id = &u
+}
+
+// LastTaskID returns the value of the "last_task_id" field in the mutation.
+func (m *ScheduleMutation) LastTaskID() (r uuid.UUID, exists bool) {
+	v := m.last_task_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastTaskID returns the old "last_task_id" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldLastTaskID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastTaskID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastTaskID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastTaskID: %w", err)
+	}
+	return oldValue.LastTaskID, nil
+}
+
+// ClearLastTaskID clears the value of the "last_task_id" field.
+func (m *ScheduleMutation) ClearLastTaskID() {
+	m.last_task_id = nil
+	m.clearedFields[schedule.FieldLastTaskID] = struct{}{}
+}
+
+// LastTaskIDCleared returns if the "last_task_id" field was cleared in this mutation.
+func (m *ScheduleMutation) LastTaskIDCleared() bool {
+	_, ok := m.clearedFields[schedule.FieldLastTaskID]
+	return ok
+}
+
+// ResetLastTaskID resets all changes to the "last_task_id" field.
+func (m *ScheduleMutation) ResetLastTaskID() {
+	m.last_task_id = nil
+	delete(m.clearedFields, schedule.FieldLastTaskID)
+}
+
+// SetAgentID sets the "agent_id" field.
+func (m *ScheduleMutation) SetAgentID(u uuid.UUID) {
+	m.agent = &u
+}
+
+// AgentID returns the value of the "agent_id" field in the mutation.
+func (m *ScheduleMutation) AgentID() (r uuid.UUID, exists bool) {
+	v := m.agent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAgentID returns the old "agent_id" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldAgentID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAgentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAgentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAgentID: %w", err)
+	}
+	return oldValue.AgentID, nil
+}
+
+// ResetAgentID resets all changes to the "agent_id" field.
+func (m *ScheduleMutation) ResetAgentID() {
+	m.agent = nil
+}
+
+// ClearAgent clears the "agent" edge to the Agent entity.
+func (m *ScheduleMutation) ClearAgent() {
+	m.clearedagent = true
+	m.clearedFields[schedule.FieldAgentID] = struct{}{}
+}
+
+// AgentCleared reports if the "agent" edge to the Agent entity was cleared.
+func (m *ScheduleMutation) AgentCleared() bool {
+	return m.clearedagent
+}
+
+// AgentIDs returns the "agent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AgentID instead. It exists only for internal usage by the builders.
+func (m *ScheduleMutation) AgentIDs() (ids []uuid.UUID) {
+	if id := m.agent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAgent resets all changes to the "agent" edge.
+func (m *ScheduleMutation) ResetAgent() {
+	m.agent = nil
+	m.clearedagent = false
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by ids.
+func (m *ScheduleMutation) AddTaskIDs(ids ...uuid.UUID) {
+	if m.tasks == nil {
+		m.tasks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.tasks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTasks clears the "tasks" edge to the Task entity.
+func (m *ScheduleMutation) ClearTasks() {
+	m.clearedtasks = true
+}
+
+// TasksCleared reports if the "tasks" edge to the Task entity was cleared.
+func (m *ScheduleMutation) TasksCleared() bool {
+	return m.clearedtasks
+}
+
+// RemoveTaskIDs removes the "tasks" edge to the Task entity by IDs.
+func (m *ScheduleMutation) RemoveTaskIDs(ids ...uuid.UUID) {
+	if m.removedtasks == nil {
+		m.removedtasks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.tasks, ids[i])
+		m.removedtasks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTasks returns the removed IDs of the "tasks" edge to the Task entity.
+func (m *ScheduleMutation) RemovedTasksIDs() (ids []uuid.UUID) {
+	for id := range m.removedtasks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TasksIDs returns the "tasks" edge IDs in the mutation.
+func (m *ScheduleMutation) TasksIDs() (ids []uuid.UUID) {
+	for id := range m.tasks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTasks resets all changes to the "tasks" edge.
+func (m *ScheduleMutation) ResetTasks() {
+	m.tasks = nil
+	m.clearedtasks = false
+	m.removedtasks = nil
+}
+
+// AddRunIDs adds the "runs" edge to the ScheduleRun entity by ids.
+func (m *ScheduleMutation) AddRunIDs(ids ...uuid.UUID) {
+	if m.runs == nil {
+		m.runs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.runs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRuns clears the "runs" edge to the ScheduleRun entity.
+func (m *ScheduleMutation) ClearRuns() {
+	m.clearedruns = true
+}
+
+// RunsCleared reports if the "runs" edge to the ScheduleRun entity was cleared.
+func (m *ScheduleMutation) RunsCleared() bool {
+	return m.clearedruns
+}
+
+// RemoveRunIDs removes the "runs" edge to the ScheduleRun entity by IDs.
+func (m *ScheduleMutation) RemoveRunIDs(ids ...uuid.UUID) {
+	if m.removedruns == nil {
+		m.removedruns = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.runs, ids[i])
+		m.removedruns[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRuns returns the removed IDs of the "runs" edge to the ScheduleRun entity.
+func (m *ScheduleMutation) RemovedRunsIDs() (ids []uuid.UUID) {
+	for id := range m.removedruns {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RunsIDs returns the "runs" edge IDs in the mutation.
+func (m *ScheduleMutation) RunsIDs() (ids []uuid.UUID) {
+	for id := range m.runs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRuns resets all changes to the "runs" edge.
+func (m *ScheduleMutation) ResetRuns() {
+	m.runs = nil
+	m.clearedruns = false
+	m.removedruns = nil
+}
+
+// Where appends a list predicates to the ScheduleMutation builder.
+func (m *ScheduleMutation) Where(ps ...predicate.Schedule) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ScheduleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ScheduleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Schedule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ScheduleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ScheduleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Schedule).
+func (m *ScheduleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ScheduleMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.create_time != nil {
+		fields = append(fields, schedule.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, schedule.FieldUpdateTime)
+	}
+	if m.name != nil {
+		fields = append(fields, schedule.FieldName)
+	}
+	if m.cron_expression != nil {
+		fields = append(fields, schedule.FieldCronExpression)
+	}
+	if m.project_directory != nil {
+		fields = append(fields, schedule.FieldProjectDirectory)
+	}
+	if m.prompt != nil {
+		fields = append(fields, schedule.FieldPrompt)
+	}
+	if m.max_cost != nil {
+		fields = append(fields, schedule.FieldMaxCost)
+	}
+	if m.enabled != nil {
+		fields = append(fields, schedule.FieldEnabled)
+	}
+	if m.last_run_time != nil {
+		fields = append(fields, schedule.FieldLastRunTime)
+	}
+	if m.next_run_time != nil {
+		fields = append(fields, schedule.FieldNextRunTime)
+	}
+	if m.run_count != nil {
+		fields = append(fields, schedule.FieldRunCount)
+	}
+	if m.skipped_count != nil {
+		fields = append(fields, schedule.FieldSkippedCount)
+	}
+	if m.last_task_id != nil {
+		fields = append(fields, schedule.FieldLastTaskID)
+	}
+	if m.agent != nil {
+		fields = append(fields, schedule.FieldAgentID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ScheduleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case schedule.FieldCreateTime:
+		return m.CreateTime()
+	case schedule.FieldUpdateTime:
+		return m.UpdateTime()
+	case schedule.FieldName:
+		return m.Name()
+	case schedule.FieldCronExpression:
+		return m.CronExpression()
+	case schedule.FieldProjectDirectory:
+		return m.ProjectDirectory()
+	case schedule.FieldPrompt:
+		return m.Prompt()
+	case schedule.FieldMaxCost:
+		return m.MaxCost()
+	case schedule.FieldEnabled:
+		return m.Enabled()
+	case schedule.FieldLastRunTime:
+		return m.LastRunTime()
+	case schedule.FieldNextRunTime:
+		return m.NextRunTime()
+	case schedule.FieldRunCount:
+		return m.RunCount()
+	case schedule.FieldSkippedCount:
+		return m.SkippedCount()
+	case schedule.FieldLastTaskID:
+		return m.LastTaskID()
+	case schedule.FieldAgentID:
+		return m.AgentID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ScheduleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case schedule.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case schedule.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case schedule.FieldName:
+		return m.OldName(ctx)
+	case schedule.FieldCronExpression:
+		return m.OldCronExpression(ctx)
+	case schedule.FieldProjectDirectory:
+		return m.OldProjectDirectory(ctx)
+	case schedule.FieldPrompt:
+		return m.OldPrompt(ctx)
+	case schedule.FieldMaxCost:
+		return m.OldMaxCost(ctx)
+	case schedule.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case schedule.FieldLastRunTime:
+		return m.OldLastRunTime(ctx)
+	case schedule.FieldNextRunTime:
+		return m.OldNextRunTime(ctx)
+	case schedule.FieldRunCount:
+		return m.OldRunCount(ctx)
+	case schedule.FieldSkippedCount:
+		return m.OldSkippedCount(ctx)
+	case schedule.FieldLastTaskID:
+		return m.OldLastTaskID(ctx)
+	case schedule.FieldAgentID:
+		return m.OldAgentID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Schedule field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScheduleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case schedule.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case schedule.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case schedule.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case schedule.FieldCronExpression:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCronExpression(v)
+		return nil
+	case schedule.FieldProjectDirectory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProjectDirectory(v)
+		return nil
+	case schedule.FieldPrompt:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrompt(v)
+		return nil
+	case schedule.FieldMaxCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxCost(v)
+		return nil
+	case schedule.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case schedule.FieldLastRunTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastRunTime(v)
+		return nil
+	case schedule.FieldNextRunTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextRunTime(v)
+		return nil
+	case schedule.FieldRunCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunCount(v)
+		return nil
+	case schedule.FieldSkippedCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSkippedCount(v)
+		return nil
+	case schedule.FieldLastTaskID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastTaskID(v)
+		return nil
+	case schedule.FieldAgentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAgentID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Schedule field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ScheduleMutation) AddedFields() []string {
+	var fields []string
+	if m.addmax_cost != nil {
+		fields = append(fields, schedule.FieldMaxCost)
+	}
+	if m.addrun_count != nil {
+		fields = append(fields, schedule.FieldRunCount)
+	}
+	if m.addskipped_count != nil {
+		fields = append(fields, schedule.FieldSkippedCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ScheduleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case schedule.FieldMaxCost:
+		return m.AddedMaxCost()
+	case schedule.FieldRunCount:
+		return m.AddedRunCount()
+	case schedule.FieldSkippedCount:
+		return m.AddedSkippedCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScheduleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case schedule.FieldMaxCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxCost(v)
+		return nil
+	case schedule.FieldRunCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRunCount(v)
+		return nil
+	case schedule.FieldSkippedCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSkippedCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Schedule numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ScheduleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(schedule.FieldMaxCost) {
+		fields = append(fields, schedule.FieldMaxCost)
+	}
+	if m.FieldCleared(schedule.FieldLastRunTime) {
+		fields = append(fields, schedule.FieldLastRunTime)
+	}
+	if m.FieldCleared(schedule.FieldNextRunTime) {
+		fields = append(fields, schedule.FieldNextRunTime)
+	}
+	if m.FieldCleared(schedule.FieldLastTaskID) {
+		fields = append(fields, schedule.FieldLastTaskID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ScheduleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ScheduleMutation) ClearField(name string) error {
+	switch name {
+	case schedule.FieldMaxCost:
+		m.ClearMaxCost()
+		return nil
+	case schedule.FieldLastRunTime:
+		m.ClearLastRunTime()
+		return nil
+	case schedule.FieldNextRunTime:
+		m.ClearNextRunTime()
+		return nil
+	case schedule.FieldLastTaskID:
+		m.ClearLastTaskID()
+		return nil
+	}
+	return fmt.Errorf("unknown Schedule nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ScheduleMutation) ResetField(name string) error {
+	switch name {
+	case schedule.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case schedule.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case schedule.FieldName:
+		m.ResetName()
+		return nil
+	case schedule.FieldCronExpression:
+		m.ResetCronExpression()
+		return nil
+	case schedule.FieldProjectDirectory:
+		m.ResetProjectDirectory()
+		return nil
+	case schedule.FieldPrompt:
+		m.ResetPrompt()
+		return nil
+	case schedule.FieldMaxCost:
+		m.ResetMaxCost()
+		return nil
+	case schedule.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case schedule.FieldLastRunTime:
+		m.ResetLastRunTime()
+		return nil
+	case schedule.FieldNextRunTime:
+		m.ResetNextRunTime()
+		return nil
+	case schedule.FieldRunCount:
+		m.ResetRunCount()
+		return nil
+	case schedule.FieldSkippedCount:
+		m.ResetSkippedCount()
+		return nil
+	case schedule.FieldLastTaskID:
+		m.ResetLastTaskID()
+		return nil
+	case schedule.FieldAgentID:
+		m.ResetAgentID()
+		return nil
+	}
+	return fmt.Errorf("unknown Schedule field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ScheduleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.agent != nil {
+		edges = append(edges, schedule.EdgeAgent)
+	}
+	if m.tasks != nil {
+		edges = append(edges, schedule.EdgeTasks)
+	}
+	if m.runs != nil {
+		edges = append(edges, schedule.EdgeRuns)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ScheduleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case schedule.EdgeAgent:
+		if id := m.agent; id != nil {
+			return []ent.Value{*id}
+		}
+	case schedule.EdgeTasks:
+		ids := make([]ent.Value, 0, len(m.tasks))
+		for id := range m.tasks {
+			ids = append(ids, id)
+		}
+		return ids
+	case schedule.EdgeRuns:
+		ids := make([]ent.Value, 0, len(m.runs))
+		for id := range m.runs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ScheduleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedtasks != nil {
+		edges = append(edges, schedule.EdgeTasks)
+	}
+	if m.removedruns != nil {
+		edges = append(edges, schedule.EdgeRuns)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ScheduleMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case schedule.EdgeTasks:
+		ids := make([]ent.Value, 0, len(m.removedtasks))
+		for id := range m.removedtasks {
+			ids = append(ids, id)
+		}
+		return ids
+	case schedule.EdgeRuns:
+		ids := make([]ent.Value, 0, len(m.removedruns))
+		for id := range m.removedruns {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ScheduleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedagent {
+		edges = append(edges, schedule.EdgeAgent)
+	}
+	if m.clearedtasks {
+		edges = append(edges, schedule.EdgeTasks)
+	}
+	if m.clearedruns {
+		edges = append(edges, schedule.EdgeRuns)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ScheduleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case schedule.EdgeAgent:
+		return m.clearedagent
+	case schedule.EdgeTasks:
+		return m.clearedtasks
+	case schedule.EdgeRuns:
+		return m.clearedruns
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ScheduleMutation) ClearEdge(name string) error {
+	switch name {
+	case schedule.EdgeAgent:
+		m.ClearAgent()
+		return nil
+	}
+	return fmt.Errorf("unknown Schedule unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ScheduleMutation) ResetEdge(name string) error {
+	switch name {
+	case schedule.EdgeAgent:
+		m.ResetAgent()
+		return nil
+	case schedule.EdgeTasks:
+		m.ResetTasks()
+		return nil
+	case schedule.EdgeRuns:
+		m.ResetRuns()
+		return nil
+	}
+	return fmt.Errorf("unknown Schedule edge %s", name)
+}
+
+// ScheduleRunMutation represents an operation that mutates the ScheduleRun nodes in the graph.
+type ScheduleRunMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	create_time     *time.Time
+	update_time     *time.Time
+	status          *types.ScheduleRunStatus
+	reason          *string
+	scheduled_time  *time.Time
+	run_time        *time.Time
+	task_id         *uuid.UUID
+	clearedFields   map[string]struct{}
+	schedule        *uuid.UUID
+	clearedschedule bool
+	done            bool
+	oldValue        func(context.Context) (*ScheduleRun, error)
+	predicates      []predicate.ScheduleRun
+}
+
+var _ ent.Mutation = (*ScheduleRunMutation)(nil)
+
+// schedulerunOption allows management of the mutation configuration using functional options.
+type schedulerunOption func(*ScheduleRunMutation)
+
+// newScheduleRunMutation creates new mutation for the ScheduleRun entity.
+func newScheduleRunMutation(c config, op Op, opts ...schedulerunOption) *ScheduleRunMutation {
+	m := &ScheduleRunMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeScheduleRun,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withScheduleRunID sets the ID field of the mutation.
+func withScheduleRunID(id uuid.UUID) schedulerunOption {
+	return func(m *ScheduleRunMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ScheduleRun
+		)
+		m.oldValue = func(ctx context.Context) (*ScheduleRun, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ScheduleRun.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withScheduleRun sets the old ScheduleRun of the mutation.
+func withScheduleRun(node *ScheduleRun) schedulerunOption {
+	return func(m *ScheduleRunMutation) {
+		m.oldValue = func(context.Context) (*ScheduleRun, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ScheduleRunMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ScheduleRunMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("memory: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ScheduleRun entities.
+func (m *ScheduleRunMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ScheduleRunMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ScheduleRunMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ScheduleRun.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ScheduleRunMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ScheduleRunMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ScheduleRun entity.
+// If the ScheduleRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleRunMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ScheduleRunMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ScheduleRunMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ScheduleRunMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the ScheduleRun entity.
+// If the ScheduleRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleRunMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ScheduleRunMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ScheduleRunMutation) SetStatus(trs types.ScheduleRunStatus) {
+	m.status = &trs
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ScheduleRunMutation) Status() (r types.ScheduleRunStatus, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ScheduleRun entity.
+// If the ScheduleRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleRunMutation) OldStatus(ctx context.Context) (v types.ScheduleRunStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ScheduleRunMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *ScheduleRunMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *ScheduleRunMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the ScheduleRun entity.
+// If the ScheduleRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleRunMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ClearReason clears the value of the "reason" field.
+func (m *ScheduleRunMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[schedulerun.FieldReason] = struct{}{}
+}
+
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *ScheduleRunMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[schedulerun.FieldReason]
+	return ok
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *ScheduleRunMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, schedulerun.FieldReason)
+}
+
+// SetScheduledTime sets the "scheduled_time" field.
+func (m *ScheduleRunMutation) SetScheduledTime(t time.Time) {
+	m.scheduled_time = &t
+}
+
+// ScheduledTime returns the value of the "scheduled_time" field in the mutation.
+func (m *ScheduleRunMutation) ScheduledTime() (r time.Time, exists bool) {
+	v := m.scheduled_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScheduledTime returns the old "scheduled_time" field's value of the ScheduleRun entity.
+// If the ScheduleRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleRunMutation) OldScheduledTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScheduledTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScheduledTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScheduledTime: %w", err)
+	}
+	return oldValue.ScheduledTime, nil
+}
+
+// ResetScheduledTime resets all changes to the "scheduled_time" field.
+func (m *ScheduleRunMutation) ResetScheduledTime() {
+	m.scheduled_time = nil
+}
+
+// SetRunTime sets the "run_time" field.
+func (m *ScheduleRunMutation) SetRunTime(t time.Time) {
+	m.run_time = &t
+}
+
+// RunTime returns the value of the "run_time" field in the mutation.
+func (m *ScheduleRunMutation) RunTime() (r time.Time, exists bool) {
+	v := m.run_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunTime returns the old "run_time" field's value of the ScheduleRun entity.
+// If the ScheduleRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleRunMutation) OldRunTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunTime: %w", err)
+	}
+	return oldValue.RunTime, nil
+}
+
+// ResetRunTime resets all changes to the "run_time" field.
+func (m *ScheduleRunMutation) ResetRunTime() {
+	m.run_time = nil
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (m *ScheduleRunMutation) SetScheduleID(u uuid.UUID) {
+	m.schedule = &u
+}
+
+// ScheduleID returns the value of the "schedule_id" field in the mutation.
+func (m *ScheduleRunMutation) ScheduleID() (r uuid.UUID, exists bool) {
+	v := m.schedule
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScheduleID returns the old "schedule_id" field's value of the ScheduleRun entity.
+// If the ScheduleRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleRunMutation) OldScheduleID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScheduleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScheduleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScheduleID: %w", err)
+	}
+	return oldValue.ScheduleID, nil
+}
+
+// ResetScheduleID resets all changes to the "schedule_id" field.
+func (m *ScheduleRunMutation) ResetScheduleID() {
+	m.schedule = nil
+}
+
+// SetTaskID sets the "task_id" field.
+func (m *ScheduleRunMutation) SetTaskID(u uuid.UUID) {
+	m.task_id = &u
+}
+
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *ScheduleRunMutation) TaskID() (r uuid.UUID, exists bool) {
+	v := m.task_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaskID returns the old "task_id" field's value of the ScheduleRun entity.
+// If the ScheduleRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleRunMutation) OldTaskID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
+	}
+	return oldValue.TaskID, nil
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (m *ScheduleRunMutation) ClearTaskID() {
+	m.task_id = nil
+	m.clearedFields[schedulerun.FieldTaskID] = struct{}{}
+}
+
+// TaskIDCleared returns if the "task_id" field was cleared in this mutation.
+func (m *ScheduleRunMutation) TaskIDCleared() bool {
+	_, ok := m.clearedFields[schedulerun.FieldTaskID]
+	return ok
+}
+
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *ScheduleRunMutation) ResetTaskID() {
+	m.task_id = nil
+	delete(m.clearedFields, schedulerun.FieldTaskID)
+}
+
+// ClearSchedule clears the "schedule" edge to the Schedule entity.
+func (m *ScheduleRunMutation) ClearSchedule() {
+	m.clearedschedule = true
+	m.clearedFields[schedulerun.FieldScheduleID] = struct{}{}
+}
+
+// ScheduleCleared reports if the "schedule" edge to the Schedule entity was cleared.
+func (m *ScheduleRunMutation) ScheduleCleared() bool {
+	return m.clearedschedule
+}
+
+// ScheduleIDs returns the "schedule" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ScheduleID instead. It exists only for internal usage by the builders.
+func (m *ScheduleRunMutation) ScheduleIDs() (ids []uuid.UUID) {
+	if id := m.schedule; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSchedule resets all changes to the "schedule" edge.
+func (m *ScheduleRunMutation) ResetSchedule() {
+	m.schedule = nil
+	m.clearedschedule = false
+}
+
+// Where appends a list predicates to the ScheduleRunMutation builder.
+func (m *ScheduleRunMutation) Where(ps ...predicate.ScheduleRun) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ScheduleRunMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ScheduleRunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ScheduleRun, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ScheduleRunMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ScheduleRunMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ScheduleRun).
+func (m *ScheduleRunMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ScheduleRunMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.create_time != nil {
+		fields = append(fields, schedulerun.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, schedulerun.FieldUpdateTime)
+	}
+	if m.status != nil {
+		fields = append(fields, schedulerun.FieldStatus)
+	}
+	if m.reason != nil {
+		fields = append(fields, schedulerun.FieldReason)
+	}
+	if m.scheduled_time != nil {
+		fields = append(fields, schedulerun.FieldScheduledTime)
+	}
+	if m.run_time != nil {
+		fields = append(fields, schedulerun.FieldRunTime)
+	}
+	if m.schedule != nil {
+		fields = append(fields, schedulerun.FieldScheduleID)
+	}
+	if m.task_id != nil {
+		fields = append(fields, schedulerun.FieldTaskID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ScheduleRunMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case schedulerun.FieldCreateTime:
+		return m.CreateTime()
+	case schedulerun.FieldUpdateTime:
+		return m.UpdateTime()
+	case schedulerun.FieldStatus:
+		return m.Status()
+	case schedulerun.FieldReason:
+		return m.Reason()
+	case schedulerun.FieldScheduledTime:
+		return m.ScheduledTime()
+	case schedulerun.FieldRunTime:
+		return m.RunTime()
+	case schedulerun.FieldScheduleID:
+		return m.ScheduleID()
+	case schedulerun.FieldTaskID:
+		return m.TaskID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ScheduleRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case schedulerun.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case schedulerun.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case schedulerun.FieldStatus:
+		return m.OldStatus(ctx)
+	case schedulerun.FieldReason:
+		return m.OldReason(ctx)
+	case schedulerun.FieldScheduledTime:
+		return m.OldScheduledTime(ctx)
+	case schedulerun.FieldRunTime:
+		return m.OldRunTime(ctx)
+	case schedulerun.FieldScheduleID:
+		return m.OldScheduleID(ctx)
+	case schedulerun.FieldTaskID:
+		return m.OldTaskID(ctx)
+	}
+	return nil, fmt.Errorf("unknown ScheduleRun field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScheduleRunMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case schedulerun.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case schedulerun.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case schedulerun.FieldStatus:
+		v, ok := value.(types.ScheduleRunStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case schedulerun.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case schedulerun.FieldScheduledTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduledTime(v)
+		return nil
+	case schedulerun.FieldRunTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunTime(v)
+		return nil
+	case schedulerun.FieldScheduleID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduleID(v)
+		return nil
+	case schedulerun.FieldTaskID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ScheduleRun field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ScheduleRunMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ScheduleRunMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScheduleRunMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ScheduleRun numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ScheduleRunMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(schedulerun.FieldReason) {
+		fields = append(fields, schedulerun.FieldReason)
+	}
+	if m.FieldCleared(schedulerun.FieldTaskID) {
+		fields = append(fields, schedulerun.FieldTaskID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ScheduleRunMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ScheduleRunMutation) ClearField(name string) error {
+	switch name {
+	case schedulerun.FieldReason:
+		m.ClearReason()
+		return nil
+	case schedulerun.FieldTaskID:
+		m.ClearTaskID()
+		return nil
+	}
+	return fmt.Errorf("unknown ScheduleRun nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ScheduleRunMutation) ResetField(name string) error {
+	switch name {
+	case schedulerun.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case schedulerun.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case schedulerun.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case schedulerun.FieldReason:
+		m.ResetReason()
+		return nil
+	case schedulerun.FieldScheduledTime:
+		m.ResetScheduledTime()
+		return nil
+	case schedulerun.FieldRunTime:
+		m.ResetRunTime()
+		return nil
+	case schedulerun.FieldScheduleID:
+		m.ResetScheduleID()
+		return nil
+	case schedulerun.FieldTaskID:
+		m.ResetTaskID()
+		return nil
+	}
+	return fmt.Errorf("unknown ScheduleRun field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ScheduleRunMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.schedule != nil {
+		edges = append(edges, schedulerun.EdgeSchedule)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ScheduleRunMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case schedulerun.EdgeSchedule:
+		if id := m.schedule; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ScheduleRunMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ScheduleRunMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ScheduleRunMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedschedule {
+		edges = append(edges, schedulerun.EdgeSchedule)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ScheduleRunMutation) EdgeCleared(name string) bool {
+	switch name {
+	case schedulerun.EdgeSchedule:
+		return m.clearedschedule
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ScheduleRunMutation) ClearEdge(name string) error {
+	switch name {
+	case schedulerun.EdgeSchedule:
+		m.ClearSchedule()
+		return nil
+	}
+	return fmt.Errorf("unknown ScheduleRun unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ScheduleRunMutation) ResetEdge(name string) error {
+	switch name {
+	case schedulerun.EdgeSchedule:
+		m.ResetSchedule()
+		return nil
+	}
+	return fmt.Errorf("unknown ScheduleRun edge %s", name)
+}
+
+// TaskMutation represents an operation that mutates the Task nodes in the graph.
+type TaskMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	create_time             *time.Time
+	update_time             *time.Time
+	project_directory       *string
+	input_tokens            *int64
+	addinput_tokens         *int64
+	output_tokens           *int64
+	addoutput_tokens        *int64
+	cache_write_tokens      *int64
+	addcache_write_tokens   *int64
+	cache_read_tokens       *int64
+	addcache_read_tokens    *int64
+	cost                    *float64
+	addcost                 *float64
+	turns                   *int64
+	addturns                *int64
+	tool_uses               *map[string]int64
+	desired_phase           *types.TaskPhase
+	phase                   *types.TaskPhase
+	max_cost                *float64
+	addmax_cost             *float64
+	instruction_files       *[]string
+	appendinstruction_files []string
+	persistent_state        *bool
+	preview                 **types.TaskPreview
+	description             *string
+	parent_id               *uuid.UUID
+	clearedFields           map[string]struct{}
+	messages                map[uuid.UUID]struct{}
+	removedmessages         map[uuid.UUID]struct{}
+	clearedmessages         bool
+	agent                   *uuid.UUID
+	clearedagent            bool
+	schedule                *uuid.UUID
+	clearedschedule         bool
+	owner                   *uuid.UUID
+	clearedowner            bool
+	done                    bool
+	oldValue                func(context.Context) (*Task, error)
+	predicates              []predicate.Task
+}
+
+var _ ent.Mutation = (*TaskMutation)(nil)
+
+// taskOption allows management of the mutation configuration using functional options.
+type taskOption func(*TaskMutation)
+
+// newTaskMutation creates new mutation for the Task entity.
+func newTaskMutation(c config, op Op, opts ...taskOption) *TaskMutation {
+	m := &TaskMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTask,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTaskID sets the ID field of the mutation.
+func withTaskID(id uuid.UUID) taskOption {
+	return func(m *TaskMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Task
+		)
+		m.oldValue = func(ctx context.Context) (*Task, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Task.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTask sets the old Task of the mutation.
+func withTask(node *Task) taskOption {
+	return func(m *TaskMutation) {
+		m.oldValue = func(context.Context) (*Task, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TaskMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TaskMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("memory: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Task entities.
+func (m *TaskMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TaskMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TaskMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Task.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *TaskMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *TaskMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *TaskMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *TaskMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *TaskMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *TaskMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetProjectDirectory sets the "project_directory" field.
+func (m *TaskMutation) SetProjectDirectory(s string) {
+	m.project_directory = &s
+}
+
+// ProjectDirectory returns the value of the "project_directory" field in the mutation.
+func (m *TaskMutation) ProjectDirectory() (r string, exists bool) {
+	v := m.project_directory
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProjectDirectory returns the old "project_directory" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldProjectDirectory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProjectDirectory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProjectDirectory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProjectDirectory: %w", err)
+	}
+	return oldValue.ProjectDirectory, nil
+}
+
+// ClearProjectDirectory clears the value of the "project_directory" field.
+func (m *TaskMutation) ClearProjectDirectory() {
+	m.project_directory = nil
+	m.clearedFields[task.FieldProjectDirectory] = struct{}{}
+}
+
+// ProjectDirectoryCleared returns if the "project_directory" field was cleared in this mutation.
+func (m *TaskMutation) ProjectDirectoryCleared() bool {
+	_, ok := m.clearedFields[task.FieldProjectDirectory]
+	return ok
+}
+
+// ResetProjectDirectory resets all changes to the "project_directory" field.
+func (m *TaskMutation) ResetProjectDirectory() {
+	m.project_directory = nil
+	delete(m.clearedFields, task.FieldProjectDirectory)
+}
+
+// SetInputTokens sets the "input_tokens" field.
+func (m *TaskMutation) SetInputTokens(i int64) {
+	m.input_tokens = &i
+	m.addinput_tokens = nil
+}
+
+// InputTokens returns the value of the "input_tokens" field in the mutation.
+func (m *TaskMutation) InputTokens() (r int64, exists bool) {
+	v := m.input_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInputTokens returns the old "input_tokens" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldInputTokens(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInputTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInputTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInputTokens: %w", err)
+	}
+	return oldValue.InputTokens, nil
+}
+
+// AddInputTokens adds i to the "input_tokens" field.
+func (m *TaskMutation) AddInputTokens(i int64) {
+	if m.addinput_tokens != nil {
+		*m.addinput_tokens += i
+	} else {
+		m.addinput_tokens = &i
+	}
+}
+
+// AddedInputTokens returns the value that was added to the "input_tokens" field in this mutation.
+func (m *TaskMutation) AddedInputTokens() (r int64, exists bool) {
+	v := m.addinput_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearInputTokens clears the value of the "input_tokens" field.
+func (m *TaskMutation) ClearInputTokens() {
+	m.input_tokens = nil
+	m.addinput_tokens = nil
+	m.clearedFields[task.FieldInputTokens] = struct{}{}
+}
+
+// InputTokensCleared returns if the "input_tokens" field was cleared in this mutation.
+func (m *TaskMutation) InputTokensCleared() bool {
+	_, ok := m.clearedFields[task.FieldInputTokens]
+	return ok
+}
+
+// ResetInputTokens resets all changes to the "input_tokens" field.
+func (m *TaskMutation) ResetInputTokens() {
+	m.input_tokens = nil
+	m.addinput_tokens = nil
+	delete(m.clearedFields, task.FieldInputTokens)
+}
+
+// SetOutputTokens sets the "output_tokens" field.
+func (m *TaskMutation) SetOutputTokens(i int64) {
+	m.output_tokens = &i
+	m.addoutput_tokens = nil
+}
+
+// OutputTokens returns the value of the "output_tokens" field in the mutation.
+func (m *TaskMutation) OutputTokens() (r int64, exists bool) {
+	v := m.output_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOutputTokens returns the old "output_tokens" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldOutputTokens(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOutputTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOutputTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOutputTokens: %w", err)
+	}
+	return oldValue.OutputTokens, nil
+}
+
+// AddOutputTokens adds i to the "output_tokens" field.
+func (m *TaskMutation) AddOutputTokens(i int64) {
+	if m.addoutput_tokens != nil {
+		*m.addoutput_tokens += i
+	} else {
+		m.addoutput_tokens = &i
+	}
+}
+
+// AddedOutputTokens returns the value that was added to the "output_tokens" field in this mutation.
+func (m *TaskMutation) AddedOutputTokens() (r int64, exists bool) {
+	v := m.addoutput_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearOutputTokens clears the value of the "output_tokens" field.
+func (m *TaskMutation) ClearOutputTokens() {
+	m.output_tokens = nil
+	m.addoutput_tokens = nil
+	m.clearedFields[task.FieldOutputTokens] = struct{}{}
+}
+
+// OutputTokensCleared returns if the "output_tokens" field was cleared in this mutation.
+func (m *TaskMutation) OutputTokensCleared() bool {
+	_, ok := m.clearedFields[task.FieldOutputTokens]
+	return ok
+}
+
+// ResetOutputTokens resets all changes to the "output_tokens" field.
+func (m *TaskMutation) ResetOutputTokens() {
+	m.output_tokens = nil
+	m.addoutput_tokens = nil
+	delete(m.clearedFields, task.FieldOutputTokens)
+}
+
+// SetCacheWriteTokens sets the "cache_write_tokens" field.
+func (m *TaskMutation) SetCacheWriteTokens(i int64) {
+	m.cache_write_tokens = &i
+	m.addcache_write_tokens = nil
+}
+
+// CacheWriteTokens returns the value of the "cache_write_tokens" field in the mutation.
+func (m *TaskMutation) CacheWriteTokens() (r int64, exists bool) {
+	v := m.cache_write_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCacheWriteTokens returns the old "cache_write_tokens" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldCacheWriteTokens(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCacheWriteTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCacheWriteTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCacheWriteTokens: %w", err)
+	}
+	return oldValue.CacheWriteTokens, nil
+}
+
+// AddCacheWriteTokens adds i to the "cache_write_tokens" field.
+func (m *TaskMutation) AddCacheWriteTokens(i int64) {
+	if m.addcache_write_tokens != nil {
+		*m.addcache_write_tokens += i
+	} else {
+		m.addcache_write_tokens = &i
+	}
+}
+
+// AddedCacheWriteTokens returns the value that was added to the "cache_write_tokens" field in this mutation.
+func (m *TaskMutation) AddedCacheWriteTokens() (r int64, exists bool) {
+	v := m.addcache_write_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCacheWriteTokens clears the value of the "cache_write_tokens" field.
+func (m *TaskMutation) ClearCacheWriteTokens() {
+	m.cache_write_tokens = nil
+	m.addcache_write_tokens = nil
+	m.clearedFields[task.FieldCacheWriteTokens] = struct{}{}
+}
+
+// CacheWriteTokensCleared returns if the "cache_write_tokens" field was cleared in this mutation.
+func (m *TaskMutation) CacheWriteTokensCleared() bool {
+	_, ok := m.clearedFields[task.FieldCacheWriteTokens]
+	return ok
+}
+
+// ResetCacheWriteTokens resets all changes to the "cache_write_tokens" field.
+func (m *TaskMutation) ResetCacheWriteTokens() {
+	m.cache_write_tokens = nil
+	m.addcache_write_tokens = nil
+	delete(m.clearedFields, task.FieldCacheWriteTokens)
+}
+
+// SetCacheReadTokens sets the "cache_read_tokens" field.
+func (m *TaskMutation) SetCacheReadTokens(i int64) {
+	m.cache_read_tokens = &i
+	m.addcache_read_tokens = nil
+}
+
+// CacheReadTokens returns the value of the "cache_read_tokens" field in the mutation.
+func (m *TaskMutation) CacheReadTokens() (r int64, exists bool) {
+	v := m.cache_read_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCacheReadTokens returns the old "cache_read_tokens" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldCacheReadTokens(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCacheReadTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCacheReadTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCacheReadTokens: %w", err)
+	}
+	return oldValue.CacheReadTokens, nil
+}
+
+// AddCacheReadTokens adds i to the "cache_read_tokens" field.
+func (m *TaskMutation) AddCacheReadTokens(i int64) {
+	if m.addcache_read_tokens != nil {
+		*m.addcache_read_tokens += i
+	} else {
+		m.addcache_read_tokens = &i
+	}
+}
+
+// AddedCacheReadTokens returns the value that was added to the "cache_read_tokens" field in this mutation.
+func (m *TaskMutation) AddedCacheReadTokens() (r int64, exists bool) {
+	v := m.addcache_read_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCacheReadTokens clears the value of the "cache_read_tokens" field.
+func (m *TaskMutation) ClearCacheReadTokens() {
+	m.cache_read_tokens = nil
+	m.addcache_read_tokens = nil
+	m.clearedFields[task.FieldCacheReadTokens] = struct{}{}
+}
+
+// CacheReadTokensCleared returns if the "cache_read_tokens" field was cleared in this mutation.
+func (m *TaskMutation) CacheReadTokensCleared() bool {
+	_, ok := m.clearedFields[task.FieldCacheReadTokens]
+	return ok
+}
+
+// ResetCacheReadTokens resets all changes to the "cache_read_tokens" field.
+func (m *TaskMutation) ResetCacheReadTokens() {
+	m.cache_read_tokens = nil
+	m.addcache_read_tokens = nil
+	delete(m.clearedFields, task.FieldCacheReadTokens)
+}
+
+// SetCost sets the "cost" field.
+func (m *TaskMutation) SetCost(f float64) {
+	m.cost = &f
+	m.addcost = nil
+}
+
+// Cost returns the value of the "cost" field in the mutation.
+func (m *TaskMutation) Cost() (r float64, exists bool) {
+	v := m.cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCost returns the old "cost" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldCost(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCost: %w", err)
+	}
+	return oldValue.Cost, nil
+}
+
+// AddCost adds f to the "cost" field.
+func (m *TaskMutation) AddCost(f float64) {
+	if m.addcost != nil {
+		*m.addcost += f
+	} else {
+		m.addcost = &f
+	}
+}
+
+// AddedCost returns the value that was added to the "cost" field in this mutation.
+func (m *TaskMutation) AddedCost() (r float64, exists bool) {
+	v := m.addcost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCost clears the value of the "cost" field.
+func (m *TaskMutation) ClearCost() {
+	m.cost = nil
+	m.addcost = nil
+	m.clearedFields[task.FieldCost] = struct{}{}
+}
+
+// CostCleared returns if the "cost" field was cleared in this mutation.
+func (m *TaskMutation) CostCleared() bool {
+	_, ok := m.clearedFields[task.FieldCost]
+	return ok
+}
+
+// ResetCost resets all changes to the "cost" field.
+func (m *TaskMutation) ResetCost() {
+	m.cost = nil
+	m.addcost = nil
+	delete(m.clearedFields, task.FieldCost)
+}
+
+// SetTurns sets the "turns" field.
+func (m *TaskMutation) SetTurns(i int64) {
+	m.turns = &i
+	m.addturns = nil
+}
+
+// Turns returns the value of the "turns" field in the mutation.
+func (m *TaskMutation) Turns() (r int64, exists bool) {
+	v := m.turns
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTurns returns the old "turns" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldTurns(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTurns is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTurns requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTurns: %w", err)
+	}
+	return oldValue.Turns, nil
+}
+
+// AddTurns adds i to the "turns" field.
+func (m *TaskMutation) AddTurns(i int64) {
+	if m.addturns != nil {
+		*m.addturns += i
+	} else {
+		m.addturns = &i
+	}
+}
+
+// AddedTurns returns the value that was added to the "turns" field in this mutation.
+func (m *TaskMutation) AddedTurns() (r int64, exists bool) {
+	v := m.addturns
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTurns resets all changes to the "turns" field.
+func (m *TaskMutation) ResetTurns() {
+	m.turns = nil
+	m.addturns = nil
+}
+
+// SetToolUses sets the "tool_uses" field.
+func (m *TaskMutation) SetToolUses(value map[string]int64) {
+	m.tool_uses = &value
+}
+
+// ToolUses returns the value of the "tool_uses" field in the mutation.
+func (m *TaskMutation) ToolUses() (r map[string]int64, exists bool) {
+	v := m.tool_uses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToolUses returns the old "tool_uses" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldToolUses(ctx context.Context) (v map[string]int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToolUses is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToolUses requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToolUses: %w", err)
+	}
+	return oldValue.ToolUses, nil
+}
+
+// ResetToolUses resets all changes to the "tool_uses" field.
+func (m *TaskMutation) ResetToolUses() {
+	m.tool_uses = nil
+}
+
+// SetDesiredPhase sets the "desired_phase" field.
+func (m *TaskMutation) SetDesiredPhase(tp types.TaskPhase) {
+	m.desired_phase = &tp
+}
+
+// DesiredPhase returns the value of the "desired_phase" field in the mutation.
+func (m *TaskMutation) DesiredPhase() (r types.TaskPhase, exists bool) {
+	v := m.desired_phase
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDesiredPhase returns the old "desired_phase" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldDesiredPhase(ctx context.Context) (v types.TaskPhase, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDesiredPhase is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDesiredPhase requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDesiredPhase: %w", err)
+	}
+	return oldValue.DesiredPhase, nil
+}
+
+// ResetDesiredPhase resets all changes to the "desired_phase" field.
+func (m *TaskMutation) ResetDesiredPhase() {
+	m.desired_phase = nil
+}
+
+// SetPhase sets the "phase" field.
+func (m *TaskMutation) SetPhase(tp types.TaskPhase) {
+	m.phase = &tp
+}
+
+// Phase returns the value of the "phase" field in the mutation.
+func (m *TaskMutation) Phase() (r types.TaskPhase, exists bool) {
+	v := m.phase
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPhase returns the old "phase" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldPhase(ctx context.Context) (v types.TaskPhase, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPhase is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPhase requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhase: %w", err)
+	}
+	return oldValue.Phase, nil
+}
+
+// ResetPhase resets all changes to the "phase" field.
+func (m *TaskMutation) ResetPhase() {
+	m.phase = nil
+}
+
+// SetMaxCost sets the "max_cost" field.
+func (m *TaskMutation) SetMaxCost(f float64) {
+	m.max_cost = &f
+	m.addmax_cost = nil
+}
+
+// MaxCost returns the value of the "max_cost" field in the mutation.
+func (m *TaskMutation) MaxCost() (r float64, exists bool) {
+	v := m.max_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxCost returns the old "max_cost" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldMaxCost(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxCost: %w", err)
+	}
+	return oldValue.MaxCost, nil
+}
+
+// AddMaxCost adds f to the "max_cost" field.
+func (m *TaskMutation) AddMaxCost(f float64) {
+	if m.addmax_cost != nil {
+		*m.addmax_cost += f
+	} else {
+		m.addmax_cost = &f
+	}
+}
+
+// AddedMaxCost returns the value that was added to the "max_cost" field in this mutation.
+func (m *TaskMutation) AddedMaxCost() (r float64, exists bool) {
+	v := m.addmax_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxCost clears the value of the "max_cost" field.
+func (m *TaskMutation) ClearMaxCost() {
+	m.max_cost = nil
+	m.addmax_cost = nil
+	m.clearedFields[task.FieldMaxCost] = struct{}{}
+}
+
+// MaxCostCleared returns if the "max_cost" field was cleared in this mutation.
+func (m *TaskMutation) MaxCostCleared() bool {
+	_, ok := m.clearedFields[task.FieldMaxCost]
+	return ok
+}
+
+// ResetMaxCost resets all changes to the "max_cost" field.
+func (m *TaskMutation) ResetMaxCost() {
+	m.max_cost = nil
+	m.addmax_cost = nil
+	delete(m.clearedFields, task.FieldMaxCost)
+}
+
+// SetInstructionFiles sets the "instruction_files" field.
+func (m *TaskMutation) SetInstructionFiles(s []string) {
+	m.instruction_files = &s
+	m.appendinstruction_files = nil
+}
+
+// InstructionFiles returns the value of the "instruction_files" field in the mutation.
+func (m *TaskMutation) InstructionFiles() (r []string, exists bool) {
+	v := m.instruction_files
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInstructionFiles returns the old "instruction_files" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldInstructionFiles(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInstructionFiles is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInstructionFiles requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInstructionFiles: %w", err)
+	}
+	return oldValue.InstructionFiles, nil
+}
+
+// AppendInstructionFiles adds s to the "instruction_files" field.
+func (m *TaskMutation) AppendInstructionFiles(s []string) {
+	m.appendinstruction_files = append(m.appendinstruction_files, s...)
+}
+
+// AppendedInstructionFiles returns the list of values that were appended to the "instruction_files" field in this mutation.
+func (m *TaskMutation) AppendedInstructionFiles() ([]string, bool) {
+	if len(m.appendinstruction_files) == 0 {
+		return nil, false
+	}
+	return m.appendinstruction_files, true
+}
+
+// ClearInstructionFiles clears the value of the "instruction_files" field.
+func (m *TaskMutation) ClearInstructionFiles() {
+	m.instruction_files = nil
+	m.appendinstruction_files = nil
+	m.clearedFields[task.FieldInstructionFiles] = struct{}{}
+}
+
+// InstructionFilesCleared returns if the "instruction_files" field was cleared in this mutation.
+func (m *TaskMutation) InstructionFilesCleared() bool {
+	_, ok := m.clearedFields[task.FieldInstructionFiles]
+	return ok
+}
+
+// ResetInstructionFiles resets all changes to the "instruction_files" field.
+func (m *TaskMutation) ResetInstructionFiles() {
+	m.instruction_files = nil
+	m.appendinstruction_files = nil
+	delete(m.clearedFields, task.FieldInstructionFiles)
+}
+
+// SetPersistentState sets the "persistent_state" field.
+func (m *TaskMutation) SetPersistentState(b bool) {
+	m.persistent_state = &b
+}
+
+// PersistentState returns the value of the "persistent_state" field in the mutation.
+func (m *TaskMutation) PersistentState() (r bool, exists bool) {
+	v := m.persistent_state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPersistentState returns the old "persistent_state" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldPersistentState(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPersistentState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPersistentState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPersistentState: %w", err)
+	}
+	return oldValue.PersistentState, nil
+}
+
+// ResetPersistentState resets all changes to the "persistent_state" field.
+func (m *TaskMutation) ResetPersistentState() {
+	m.persistent_state = nil
+}
+
+// SetPreview sets the "preview" field.
+func (m *TaskMutation) SetPreview(tp *types.TaskPreview) {
+	m.preview = &tp
+}
+
+// Preview returns the value of the "preview" field in the mutation.
+func (m *TaskMutation) Preview() (r *types.TaskPreview, exists bool) {
+	v := m.preview
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreview returns the old "preview" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldPreview(ctx context.Context) (v *types.TaskPreview, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreview is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreview requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreview: %w", err)
+	}
+	return oldValue.Preview, nil
+}
+
+// ClearPreview clears the value of the "preview" field.
+func (m *TaskMutation) ClearPreview() {
+	m.preview = nil
+	m.clearedFields[task.FieldPreview] = struct{}{}
+}
+
+// PreviewCleared returns if the "preview" field was cleared in this mutation.
+func (m *TaskMutation) PreviewCleared() bool {
+	_, ok := m.clearedFields[task.FieldPreview]
+	return ok
+}
+
+// ResetPreview resets all changes to the "preview" field.
+func (m *TaskMutation) ResetPreview() {
+	m.preview = nil
+	delete(m.clearedFields, task.FieldPreview)
+}
+
+// SetDescription sets the "description" field.
+func (m *TaskMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *TaskMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *TaskMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[task.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *TaskMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[task.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *TaskMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, task.FieldDescription)
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *TaskMutation) SetParentID(u uuid.UUID) {
+	m.parent_id = &u
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *TaskMutation) ParentID() (r uuid.UUID, exists bool) {
+	v := m.parent_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldParentID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (m *TaskMutation) ClearParentID() {
+	m.parent_id = nil
+	m.clearedFields[task.FieldParentID] = struct{}{}
+}
+
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *TaskMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[task.FieldParentID]
+	return ok
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *TaskMutation) ResetParentID() {
+	m.parent_id = nil
+	delete(m.clearedFields, task.FieldParentID)
+}
+
+// SetAgentID sets the "agent_id" field.
+func (m *TaskMutation) SetAgentID(u uuid.UUID) {
+	m.agent = &u
+}
+
+// AgentID returns the value of the "agent_id" field in the mutation.
+func (m *TaskMutation) AgentID() (r uuid.UUID, exists bool) {
+	v := m.agent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAgentID returns the old "agent_id" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldAgentID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAgentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAgentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAgentID: %w", err)
+	}
+	return oldValue.AgentID, nil
+}
+
+// ClearAgentID clears the value of the "agent_id" field.
+func (m *TaskMutation) ClearAgentID() {
+	m.agent = nil
+	m.clearedFields[task.FieldAgentID] = struct{}{}
+}
+
+// AgentIDCleared returns if the "agent_id" field was cleared in this mutation.
+func (m *TaskMutation) AgentIDCleared() bool {
+	_, ok := m.clearedFields[task.FieldAgentID]
+	return ok
+}
+
+// ResetAgentID resets all changes to the "agent_id" field.
+func (m *TaskMutation) ResetAgentID() {
+	m.agent = nil
+	delete(m.clearedFields, task.FieldAgentID)
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (m *TaskMutation) SetScheduleID(u uuid.UUID) {
+	m.schedule = &u
+}
+
+// ScheduleID returns the value of the "schedule_id" field in the mutation.
+func (m *TaskMutation) ScheduleID() (r uuid.UUID, exists bool) {
+	v := m.schedule
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScheduleID returns the old "schedule_id" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldScheduleID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScheduleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScheduleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScheduleID: %w", err)
+	}
+	return oldValue.ScheduleID, nil
+}
+
+// ClearScheduleID clears the value of the "schedule_id" field.
+func (m *TaskMutation) ClearScheduleID() {
+	m.schedule = nil
+	m.clearedFields[task.FieldScheduleID] = struct{}{}
+}
+
+// ScheduleIDCleared returns if the "schedule_id" field was cleared in this mutation.
+func (m *TaskMutation) ScheduleIDCleared() bool {
+	_, ok := m.clearedFields[task.FieldScheduleID]
+	return ok
+}
+
+// ResetScheduleID resets all changes to the "schedule_id" field.
+func (m *TaskMutation) ResetScheduleID() {
+	m.schedule = nil
+	delete(m.clearedFields, task.FieldScheduleID)
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (m *TaskMutation) SetOwnerID(u uuid.UUID) {
+	m.owner = &u
+}
+
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *TaskMutation) OwnerID() (r uuid.UUID, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerID returns the old "owner_id" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldOwnerID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (m *TaskMutation) ClearOwnerID() {
+	m.owner = nil
+	m.clearedFields[task.FieldOwnerID] = struct{}{}
+}
+
+// OwnerIDCleared returns if the "owner_id" field was cleared in this mutation.
+func (m *TaskMutation) OwnerIDCleared() bool {
+	_, ok := m.clearedFields[task.FieldOwnerID]
+	return ok
+}
+
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *TaskMutation) ResetOwnerID() {
+	m.owner = nil
+	delete(m.clearedFields, task.FieldOwnerID)
+}
+
+// AddMessageIDs adds the "messages" edge to the Message entity by ids.
+func (m *TaskMutation) AddMessageIDs(ids ...uuid.UUID) {
+	if m.messages == nil {
+		m.messages = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.messages[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMessages clears the "messages" edge to the Message entity.
+func (m *TaskMutation) ClearMessages() {
+	m.clearedmessages = true
+}
+
+// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
+func (m *TaskMutation) MessagesCleared() bool {
+	return m.clearedmessages
+}
+
+// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
+func (m *TaskMutation) RemoveMessageIDs(ids ...uuid.UUID) {
+	if m.removedmessages == nil {
+		m.removedmessages = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.messages, ids[i])
+		m.removedmessages[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
+func (m *TaskMutation) RemovedMessagesIDs() (ids []uuid.UUID) {
+	for id := range m.removedmessages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MessagesIDs returns the "messages" edge IDs in the mutation.
+func (m *TaskMutation) MessagesIDs() (ids []uuid.UUID) {
+	for id := range m.messages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMessages resets all changes to the "messages" edge.
+func (m *TaskMutation) ResetMessages() {
+	m.messages = nil
+	m.clearedmessages = false
+	m.removedmessages = nil
+}
+
+// ClearAgent clears the "agent" edge to the Agent entity.
+func (m *TaskMutation) ClearAgent() {
+	m.clearedagent = true
+	m.clearedFields[task.FieldAgentID] = struct{}{}
+}
+
+// AgentCleared reports if the "agent" edge to the Agent entity was cleared.
+func (m *TaskMutation) AgentCleared() bool {
+	return m.AgentIDCleared() || m.clearedagent
+}
+
+// AgentIDs returns the "agent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AgentID instead. It exists only for internal usage by the builders.
+func (m *TaskMutation) AgentIDs() (ids []uuid.UUID) {
+	if id := m.agent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAgent resets all changes to the "agent" edge.
+func (m *TaskMutation) ResetAgent() {
+	m.agent = nil
+	m.clearedagent = false
+}
+
+// ClearSchedule clears the "schedule" edge to the Schedule entity.
+func (m *TaskMutation) ClearSchedule() {
+	m.clearedschedule = true
+	m.clearedFields[task.FieldScheduleID] = struct{}{}
+}
+
+// ScheduleCleared reports if the "schedule" edge to the Schedule entity was cleared.
+func (m *TaskMutation) ScheduleCleared() bool {
+	return m.ScheduleIDCleared() || m.clearedschedule
+}
+
+// ScheduleIDs returns the "schedule" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ScheduleID instead. It exists only for internal usage by the builders.
+func (m *TaskMutation) ScheduleIDs() (ids []uuid.UUID) {
+	if id := m.schedule; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSchedule resets all changes to the "schedule" edge.
+func (m *TaskMutation) ResetSchedule() {
+	m.schedule = nil
+	m.clearedschedule = false
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *TaskMutation) ClearOwner() {
+	m.clearedowner = true
+	m.clearedFields[task.FieldOwnerID] = struct{}{}
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *TaskMutation) OwnerCleared() bool {
+	return m.OwnerIDCleared() || m.clearedowner
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *TaskMutation) OwnerIDs() (ids []uuid.UUID) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *TaskMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// Where appends a list predicates to the TaskMutation builder.
+func (m *TaskMutation) Where(ps ...predicate.Task) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TaskMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TaskMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Task, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TaskMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TaskMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Task).
+func (m *TaskMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TaskMutation) Fields() []string {
+	fields := make([]string, 0, 21)
+	if m.create_time != nil {
+		fields = append(fields, task.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, task.FieldUpdateTime)
+	}
+	if m.project_directory != nil {
+		fields = append(fields, task.FieldProjectDirectory)
+	}
+	if m.input_tokens != nil {
+		fields = append(fields, task.FieldInputTokens)
+	}
+	if m.output_tokens != nil {
+		fields = append(fields, task.FieldOutputTokens)
+	}
+	if m.cache_write_tokens != nil {
+		fields = append(fields, task.FieldCacheWriteTokens)
+	}
+	if m.cache_read_tokens != nil {
+		fields = append(fields, task.FieldCacheReadTokens)
+	}
+	if m.cost != nil {
+		fields = append(fields, task.FieldCost)
+	}
+	if m.turns != nil {
+		fields = append(fields, task.FieldTurns)
+	}
+	if m.tool_uses != nil {
+		fields = append(fields, task.FieldToolUses)
+	}
+	if m.desired_phase != nil {
+		fields = append(fields, task.FieldDesiredPhase)
+	}
+	if m.phase != nil {
+		fields = append(fields, task.FieldPhase)
+	}
+	if m.max_cost != nil {
+		fields = append(fields, task.FieldMaxCost)
+	}
+	if m.instruction_files != nil {
+		fields = append(fields, task.FieldInstructionFiles)
+	}
+	if m.persistent_state != nil {
+		fields = append(fields, task.FieldPersistentState)
+	}
+	if m.preview != nil {
+		fields = append(fields, task.FieldPreview)
+	}
+	if m.description != nil {
+		fields = append(fields, task.FieldDescription)
+	}
+	if m.parent_id != nil {
+		fields = append(fields, task.FieldParentID)
+	}
+	if m.agent != nil {
+		fields = append(fields, task.FieldAgentID)
+	}
+	if m.schedule != nil {
+		fields = append(fields, task.FieldScheduleID)
+	}
+	if m.owner != nil {
+		fields = append(fields, task.FieldOwnerID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TaskMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case task.FieldCreateTime:
+		return m.CreateTime()
+	case task.FieldUpdateTime:
+		return m.UpdateTime()
+	case task.FieldProjectDirectory:
+		return m.ProjectDirectory()
+	case task.FieldInputTokens:
+		return m.InputTokens()
+	case task.FieldOutputTokens:
+		return m.OutputTokens()
+	case task.FieldCacheWriteTokens:
+		return m.CacheWriteTokens()
+	case task.FieldCacheReadTokens:
+		return m.CacheReadTokens()
+	case task.FieldCost:
+		return m.Cost()
+	case task.FieldTurns:
+		return m.Turns()
+	case task.FieldToolUses:
+		return m.ToolUses()
+	case task.FieldDesiredPhase:
+		return m.DesiredPhase()
+	case task.FieldPhase:
+		return m.Phase()
+	case task.FieldMaxCost:
+		return m.MaxCost()
+	case task.FieldInstructionFiles:
+		return m.InstructionFiles()
+	case task.FieldPersistentState:
+		return m.PersistentState()
+	case task.FieldPreview:
+		return m.Preview()
+	case task.FieldDescription:
+		return m.Description()
+	case task.FieldParentID:
+		return m.ParentID()
+	case task.FieldAgentID:
+		return m.AgentID()
+	case task.FieldScheduleID:
+		return m.ScheduleID()
+	case task.FieldOwnerID:
+		return m.OwnerID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case task.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case task.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case task.FieldProjectDirectory:
+		return m.OldProjectDirectory(ctx)
+	case task.FieldInputTokens:
+		return m.OldInputTokens(ctx)
+	case task.FieldOutputTokens:
+		return m.OldOutputTokens(ctx)
+	case task.FieldCacheWriteTokens:
+		return m.OldCacheWriteTokens(ctx)
+	case task.FieldCacheReadTokens:
+		return m.OldCacheReadTokens(ctx)
+	case task.FieldCost:
+		return m.OldCost(ctx)
+	case task.FieldTurns:
+		return m.OldTurns(ctx)
+	case task.FieldToolUses:
+		return m.OldToolUses(ctx)
+	case task.FieldDesiredPhase:
+		return m.OldDesiredPhase(ctx)
+	case task.FieldPhase:
+		return m.OldPhase(ctx)
+	case task.FieldMaxCost:
+		return m.OldMaxCost(ctx)
+	case task.FieldInstructionFiles:
+		return m.OldInstructionFiles(ctx)
+	case task.FieldPersistentState:
+		return m.OldPersistentState(ctx)
+	case task.FieldPreview:
+		return m.OldPreview(ctx)
+	case task.FieldDescription:
+		return m.OldDescription(ctx)
+	case task.FieldParentID:
+		return m.OldParentID(ctx)
+	case task.FieldAgentID:
+		return m.OldAgentID(ctx)
+	case task.FieldScheduleID:
+		return m.OldScheduleID(ctx)
+	case task.FieldOwnerID:
+		return m.OldOwnerID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Task field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case task.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case task.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case task.FieldProjectDirectory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProjectDirectory(v)
+		return nil
+	case task.FieldInputTokens:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInputTokens(v)
+		return nil
+	case task.FieldOutputTokens:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutputTokens(v)
+		return nil
+	case task.FieldCacheWriteTokens:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCacheWriteTokens(v)
+		return nil
+	case task.FieldCacheReadTokens:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCacheReadTokens(v)
+		return nil
+	case task.FieldCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCost(v)
+		return nil
+	case task.FieldTurns:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTurns(v)
+		return nil
+	case task.FieldToolUses:
+		v, ok := value.(map[string]int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToolUses(v)
+		return nil
+	case task.FieldDesiredPhase:
+		v, ok := value.(types.TaskPhase)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDesiredPhase(v)
+		return nil
+	case task.FieldPhase:
+		v, ok := value.(types.TaskPhase)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPhase(v)
+		return nil
+	case task.FieldMaxCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxCost(v)
+		return nil
+	case task.FieldInstructionFiles:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInstructionFiles(v)
+		return nil
+	case task.FieldPersistentState:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPersistentState(v)
+		return nil
+	case task.FieldPreview:
+		v, ok := value.(*types.TaskPreview)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreview(v)
+		return nil
+	case task.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case task.FieldParentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case task.FieldAgentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAgentID(v)
+		return nil
+	case task.FieldScheduleID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduleID(v)
+		return nil
+	case task.FieldOwnerID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Task field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TaskMutation) AddedFields() []string {
+	var fields []string
+	if m.addinput_tokens != nil {
+		fields = append(fields, task.FieldInputTokens)
+	}
+	if m.addoutput_tokens != nil {
+		fields = append(fields, task.FieldOutputTokens)
+	}
+	if m.addcache_write_tokens != nil {
+		fields = append(fields, task.FieldCacheWriteTokens)
+	}
+	if m.addcache_read_tokens != nil {
+		fields = append(fields, task.FieldCacheReadTokens)
+	}
+	if m.addcost != nil {
+		fields = append(fields, task.FieldCost)
+	}
+	if m.addturns != nil {
+		fields = append(fields, task.FieldTurns)
+	}
+	if m.addmax_cost != nil {
+		fields = append(fields, task.FieldMaxCost)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TaskMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case task.FieldInputTokens:
+		return m.AddedInputTokens()
+	case task.FieldOutputTokens:
+		return m.AddedOutputTokens()
+	case task.FieldCacheWriteTokens:
+		return m.AddedCacheWriteTokens()
+	case task.FieldCacheReadTokens:
+		return m.AddedCacheReadTokens()
+	case task.FieldCost:
+		return m.AddedCost()
+	case task.FieldTurns:
+		return m.AddedTurns()
+	case task.FieldMaxCost:
+		return m.AddedMaxCost()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case task.FieldInputTokens:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInputTokens(v)
+		return nil
+	case task.FieldOutputTokens:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOutputTokens(v)
+		return nil
+	case task.FieldCacheWriteTokens:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCacheWriteTokens(v)
+		return nil
+	case task.FieldCacheReadTokens:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCacheReadTokens(v)
+		return nil
+	case task.FieldCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCost(v)
+		return nil
+	case task.FieldTurns:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTurns(v)
+		return nil
+	case task.FieldMaxCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxCost(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Task numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TaskMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(task.FieldProjectDirectory) {
+		fields = append(fields, task.FieldProjectDirectory)
+	}
+	if m.FieldCleared(task.FieldInputTokens) {
+		fields = append(fields, task.FieldInputTokens)
+	}
+	if m.FieldCleared(task.FieldOutputTokens) {
+		fields = append(fields, task.FieldOutputTokens)
+	}
+	if m.FieldCleared(task.FieldCacheWriteTokens) {
+		fields = append(fields, task.FieldCacheWriteTokens)
+	}
+	if m.FieldCleared(task.FieldCacheReadTokens) {
+		fields = append(fields, task.FieldCacheReadTokens)
+	}
+	if m.FieldCleared(task.FieldCost) {
+		fields = append(fields, task.FieldCost)
+	}
+	if m.FieldCleared(task.FieldMaxCost) {
+		fields = append(fields, task.FieldMaxCost)
+	}
+	if m.FieldCleared(task.FieldInstructionFiles) {
+		fields = append(fields, task.FieldInstructionFiles)
+	}
+	if m.FieldCleared(task.FieldPreview) {
+		fields = append(fields, task.FieldPreview)
+	}
+	if m.FieldCleared(task.FieldDescription) {
+		fields = append(fields, task.FieldDescription)
+	}
+	if m.FieldCleared(task.FieldParentID) {
+		fields = append(fields, task.FieldParentID)
+	}
+	if m.FieldCleared(task.FieldAgentID) {
+		fields = append(fields, task.FieldAgentID)
+	}
+	if m.FieldCleared(task.FieldScheduleID) {
+		fields = append(fields, task.FieldScheduleID)
+	}
+	if m.FieldCleared(task.FieldOwnerID) {
+		fields = append(fields, task.FieldOwnerID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TaskMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TaskMutation) ClearField(name string) error {
+	switch name {
+	case task.FieldProjectDirectory:
+		m.ClearProjectDirectory()
+		return nil
+	case task.FieldInputTokens:
+		m.ClearInputTokens()
+		return nil
+	case task.FieldOutputTokens:
+		m.ClearOutputTokens()
+		return nil
+	case task.FieldCacheWriteTokens:
+		m.ClearCacheWriteTokens()
+		return nil
+	case task.FieldCacheReadTokens:
+		m.ClearCacheReadTokens()
+		return nil
+	case task.FieldCost:
+		m.ClearCost()
+		return nil
+	case task.FieldMaxCost:
+		m.ClearMaxCost()
+		return nil
+	case task.FieldInstructionFiles:
+		m.ClearInstructionFiles()
+		return nil
+	case task.FieldPreview:
+		m.ClearPreview()
+		return nil
+	case task.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case task.FieldParentID:
+		m.ClearParentID()
+		return nil
+	case task.FieldAgentID:
+		m.ClearAgentID()
+		return nil
+	case task.FieldScheduleID:
+		m.ClearScheduleID()
+		return nil
+	case task.FieldOwnerID:
+		m.ClearOwnerID()
+		return nil
+	}
+	return fmt.Errorf("unknown Task nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TaskMutation) ResetField(name string) error {
+	switch name {
+	case task.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case task.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case task.FieldProjectDirectory:
+		m.ResetProjectDirectory()
+		return nil
+	case task.FieldInputTokens:
+		m.ResetInputTokens()
+		return nil
+	case task.FieldOutputTokens:
+		m.ResetOutputTokens()
+		return nil
+	case task.FieldCacheWriteTokens:
+		m.ResetCacheWriteTokens()
+		return nil
+	case task.FieldCacheReadTokens:
+		m.ResetCacheReadTokens()
+		return nil
+	case task.FieldCost:
+		m.ResetCost()
+		return nil
+	case task.FieldTurns:
+		m.ResetTurns()
+		return nil
+	case task.FieldToolUses:
+		m.ResetToolUses()
+		return nil
+	case task.FieldDesiredPhase:
+		m.ResetDesiredPhase()
+		return nil
+	case task.FieldPhase:
+		m.ResetPhase()
+		return nil
+	case task.FieldMaxCost:
+		m.ResetMaxCost()
+		return nil
+	case task.FieldInstructionFiles:
+		m.ResetInstructionFiles()
+		return nil
+	case task.FieldPersistentState:
+		m.ResetPersistentState()
+		return nil
+	case task.FieldPreview:
+		m.ResetPreview()
+		return nil
+	case task.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case task.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case task.FieldAgentID:
+		m.ResetAgentID()
+		return nil
+	case task.FieldScheduleID:
+		m.ResetScheduleID()
+		return nil
+	case task.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
+	}
+	return fmt.Errorf("unknown Task field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TaskMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.messages != nil {
+		edges = append(edges, task.EdgeMessages)
+	}
+	if m.agent != nil {
+		edges = append(edges, task.EdgeAgent)
+	}
+	if m.schedule != nil {
+		edges = append(edges, task.EdgeSchedule)
+	}
+	if m.owner != nil {
+		edges = append(edges, task.EdgeOwner)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TaskMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case task.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.messages))
+		for id := range m.messages {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeAgent:
+		if id := m.agent; id != nil {
+			return []ent.Value{*id}
+		}
+	case task.EdgeSchedule:
+		if id := m.schedule; id != nil {
+			return []ent.Value{*id}
+		}
+	case task.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TaskMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedmessages != nil {
+		edges = append(edges, task.EdgeMessages)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TaskMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case task.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.removedmessages))
+		for id := range m.removedmessages {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TaskMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedmessages {
+		edges = append(edges, task.EdgeMessages)
+	}
+	if m.clearedagent {
+		edges = append(edges, task.EdgeAgent)
+	}
+	if m.clearedschedule {
+		edges = append(edges, task.EdgeSchedule)
+	}
+	if m.clearedowner {
+		edges = append(edges, task.EdgeOwner)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TaskMutation) EdgeCleared(name string) bool {
+	switch name {
+	case task.EdgeMessages:
+		return m.clearedmessages
+	case task.EdgeAgent:
+		return m.clearedagent
+	case task.EdgeSchedule:
+		return m.clearedschedule
+	case task.EdgeOwner:
+		return m.clearedowner
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TaskMutation) ClearEdge(name string) error {
+	switch name {
+	case task.EdgeAgent:
+		m.ClearAgent()
+		return nil
+	case task.EdgeSchedule:
+		m.ClearSchedule()
+		return nil
+	case task.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Task unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TaskMutation) ResetEdge(name string) error {
+	switch name {
+	case task.EdgeMessages:
+		m.ResetMessages()
+		return nil
+	case task.EdgeAgent:
+		m.ResetAgent()
+		return nil
+	case task.EdgeSchedule:
+		m.ResetSchedule()
+		return nil
+	case task.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Task edge %s", name)
+}
+
+// ToolMutation represents an operation that mutates the Tool nodes in the graph.
+type ToolMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	create_time        *time.Time
+	update_time        *time.Time
+	name               *string
+	description        *string
+	input_schema       *map[string]interface{}
+	kind               *types.ToolKind
+	command            *string
+	url                *string
+	headers            *map[string]string
+	source             *string
+	timeout_seconds    *int
+	addtimeout_seconds *int
+	enabled            *bool
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*Tool, error)
+	predicates         []predicate.Tool
+}
+
+var _ ent.Mutation = (*ToolMutation)(nil)
+
+// toolOption allows management of the mutation configuration using functional options.
+type toolOption func(*ToolMutation)
+
+// newToolMutation creates new mutation for the Tool entity.
+func newToolMutation(c config, op Op, opts ...toolOption) *ToolMutation {
+	m := &ToolMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTool,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withToolID sets the ID field of the mutation.
+func withToolID(id uuid.UUID) toolOption {
+	return func(m *ToolMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Tool
+		)
+		m.oldValue = func(ctx context.Context) (*Tool, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Tool.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTool sets the old Tool of the mutation.
+func withTool(node *Tool) toolOption {
+	return func(m *ToolMutation) {
+		m.oldValue = func(context.Context) (*Tool, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ToolMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ToolMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("memory: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Tool entities.
+func (m *ToolMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ToolMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ToolMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tool.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ToolMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ToolMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Tool entity.
+// If the Tool object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ToolMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ToolMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ToolMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ToolMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Tool entity.
+// If the Tool object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ToolMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ToolMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetName sets the "name" field.
+func (m *ToolMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ToolMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AgentsIDs returns the "agents" edge IDs in the mutation.
-func (m *ModelMutation) AgentsIDs() (ids []uuid.UUID) {
-	for id := range m.agents {
-		ids = append(ids, id)
+// OldName returns the old "name" field's value of the Tool entity.
+// If the Tool object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ToolMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
 }
 
-// ResetAgents resets all changes to the "agents" edge.
-func (m *ModelMutation) ResetAgents() {
-	m.agents = nil
-	m.clearedagents = false
-	m.removedagents = nil
+// ResetName resets all changes to the "name" field.
+func (m *ToolMutation) ResetName() {
+	m.name = nil
 }
 
-// ClearModelProvider clears the "model_provider" edge to the ModelProvider entity.
-func (m *ModelMutation) ClearModelProvider() {
-	m.clearedmodel_provider = true
-	m.clearedFields[model.FieldModelProviderID] = struct{}{}
+// SetDescription sets the "description" field.
+func (m *ToolMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// ModelProviderCleared reports if the "model_provider" edge to the ModelProvider entity was cleared.
-func (m *ModelMutation) ModelProviderCleared() bool {
-	return m.clearedmodel_provider
+// Description returns the value of the "description" field in the mutation.
+func (m *ToolMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ModelProviderIDs returns the "model_provider" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ModelProviderID instead. It exists only for internal usage by the builders.
-func (m *ModelMutation) ModelProviderIDs() (ids []uuid.UUID) {
-	if id := m.model_provider; id != nil {
-		ids = append(ids, *id)
+// OldDescription returns the old "description" field's value of the Tool entity.
+// If the Tool object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ToolMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
 }
 
-// ResetModelProvider resets all changes to the "model_provider" edge.
-func (m *ModelMutation) ResetModelProvider() {
-	m.model_provider = nil
-	m.clearedmodel_provider = false
+// ResetDescription resets all changes to the "description" field.
+func (m *ToolMutation) ResetDescription() {
+	m.description = nil
 }
 
-// AddMessageIDs adds the "messages" edge to the Message entity by ids.
-func (m *ModelMutation) AddMessageIDs(ids ...uuid.UUID) {
-	if m.messages == nil {
-		m.messages = make(map[uuid.UUID]struct{})
+// SetInputSchema sets the "input_schema" field.
+func (m *ToolMutation) SetInputSchema(value map[string]interface{}) {
+	m.input_schema = &value
+}
+
+// InputSchema returns the value of the "input_schema" field in the mutation.
+func (m *ToolMutation) InputSchema() (r map[string]interface{}, exists bool) {
+	v := m.input_schema
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.messages[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldInputSchema returns the old "input_schema" field's value of the Tool entity.
+// If the Tool object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ToolMutation) OldInputSchema(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInputSchema is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInputSchema requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInputSchema: %w", err)
+	}
+	return oldValue.InputSchema, nil
+}
+
+// ClearInputSchema clears the value of the "input_schema" field.
+func (m *ToolMutation) ClearInputSchema() {
+	m.input_schema = nil
+	m.clearedFields[tool.FieldInputSchema] = struct{}{}
+}
+
+// InputSchemaCleared returns if the "input_schema" field was cleared in this mutation.
+func (m *ToolMutation) InputSchemaCleared() bool {
+	_, ok := m.clearedFields[tool.FieldInputSchema]
+	return ok
+}
+
+// ResetInputSchema resets all changes to the "input_schema" field.
+func (m *ToolMutation) ResetInputSchema() {
+	m.input_schema = nil
+	delete(m.clearedFields, tool.FieldInputSchema)
+}
+
+// SetKind sets the "kind" field.
+func (m *ToolMutation) SetKind(tk types.ToolKind) {
+	m.kind = &tk
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *ToolMutation) Kind() (r types.ToolKind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the Tool entity.
+// If the Tool object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ToolMutation) OldKind(ctx context.Context) (v types.ToolKind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *ToolMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetCommand sets the "command" field.
+func (m *ToolMutation) SetCommand(s string) {
+	m.command = &s
+}
+
+// Command returns the value of the "command" field in the mutation.
+func (m *ToolMutation) Command() (r string, exists bool) {
+	v := m.command
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommand returns the old "command" field's value of the Tool entity.
+// If the Tool object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ToolMutation) OldCommand(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommand is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommand requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommand: %w", err)
+	}
+	return oldValue.Command, nil
+}
+
+// ClearCommand clears the value of the "command" field.
+func (m *ToolMutation) ClearCommand() {
+	m.command = nil
+	m.clearedFields[tool.FieldCommand] = struct{}{}
+}
+
+// CommandCleared returns if the "command" field was cleared in this mutation.
+func (m *ToolMutation) CommandCleared() bool {
+	_, ok := m.clearedFields[tool.FieldCommand]
+	return ok
+}
+
+// ResetCommand resets all changes to the "command" field.
+func (m *ToolMutation) ResetCommand() {
+	m.command = nil
+	delete(m.clearedFields, tool.FieldCommand)
+}
+
+// SetURL sets the "url" field.
+func (m *ToolMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *ToolMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the Tool entity.
+// If the Tool object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ToolMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ClearURL clears the value of the "url" field.
+func (m *ToolMutation) ClearURL() {
+	m.url = nil
+	m.clearedFields[tool.FieldURL] = struct{}{}
+}
+
+// URLCleared returns if the "url" field was cleared in this mutation.
+func (m *ToolMutation) URLCleared() bool {
+	_, ok := m.clearedFields[tool.FieldURL]
+	return ok
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *ToolMutation) ResetURL() {
+	m.url = nil
+	delete(m.clearedFields, tool.FieldURL)
+}
+
+// SetHeaders sets the "headers" field.
+func (m *ToolMutation) SetHeaders(value map[string]string) {
+	m.headers = &value
+}
+
+// Headers returns the value of the "headers" field in the mutation.
+func (m *ToolMutation) Headers() (r map[string]string, exists bool) {
+	v := m.headers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeaders returns the old "headers" field's value of the Tool entity.
+// If the Tool object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ToolMutation) OldHeaders(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeaders is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeaders requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeaders: %w", err)
+	}
+	return oldValue.Headers, nil
+}
+
+// ClearHeaders clears the value of the "headers" field.
+func (m *ToolMutation) ClearHeaders() {
+	m.headers = nil
+	m.clearedFields[tool.FieldHeaders] = struct{}{}
+}
+
+// HeadersCleared returns if the "headers" field was cleared in this mutation.
+func (m *ToolMutation) HeadersCleared() bool {
+	_, ok := m.clearedFields[tool.FieldHeaders]
+	return ok
+}
+
+// ResetHeaders resets all changes to the "headers" field.
+func (m *ToolMutation) ResetHeaders() {
+	m.headers = nil
+	delete(m.clearedFields, tool.FieldHeaders)
+}
+
+// SetSource sets the "source" field.
+func (m *ToolMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *ToolMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the Tool entity.
+// If the Tool object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ToolMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ClearSource clears the value of the "source" field.
+func (m *ToolMutation) ClearSource() {
+	m.source = nil
+	m.clearedFields[tool.FieldSource] = struct{}{}
+}
+
+// SourceCleared returns if the "source" field was cleared in this mutation.
+func (m *ToolMutation) SourceCleared() bool {
+	_, ok := m.clearedFields[tool.FieldSource]
+	return ok
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *ToolMutation) ResetSource() {
+	m.source = nil
+	delete(m.clearedFields, tool.FieldSource)
+}
+
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (m *ToolMutation) SetTimeoutSeconds(i int) {
+	m.timeout_seconds = &i
+	m.addtimeout_seconds = nil
+}
+
+// TimeoutSeconds returns the value of the "timeout_seconds" field in the mutation.
+func (m *ToolMutation) TimeoutSeconds() (r int, exists bool) {
+	v := m.timeout_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeoutSeconds returns the old "timeout_seconds" field's value of the Tool entity.
+// If the Tool object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ToolMutation) OldTimeoutSeconds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeoutSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeoutSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeoutSeconds: %w", err)
+	}
+	return oldValue.TimeoutSeconds, nil
+}
+
+// AddTimeoutSeconds adds i to the "timeout_seconds" field.
+func (m *ToolMutation) AddTimeoutSeconds(i int) {
+	if m.addtimeout_seconds != nil {
+		*m.addtimeout_seconds += i
+	} else {
+		m.addtimeout_seconds = &i
 	}
 }
 
-// ClearMessages clears the "messages" edge to the Message entity.
-func (m *ModelMutation) ClearMessages() {
-	m.clearedmessages = true
+// AddedTimeoutSeconds returns the value that was added to the "timeout_seconds" field in this mutation.
+func (m *ToolMutation) AddedTimeoutSeconds() (r int, exists bool) {
+	v := m.addtimeout_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
-func (m *ModelMutation) MessagesCleared() bool {
-	return m.clearedmessages
+// ResetTimeoutSeconds resets all changes to the "timeout_seconds" field.
+func (m *ToolMutation) ResetTimeoutSeconds() {
+	m.timeout_seconds = nil
+	m.addtimeout_seconds = nil
 }
 
-// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
-func (m *ModelMutation) RemoveMessageIDs(ids ...uuid.UUID) {
-	if m.removedmessages == nil {
-		m.removedmessages = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.messages, ids[i])
-		m.removedmessages[ids[i]] = struct{}{}
-	}
+// SetEnabled sets the "enabled" field.
+func (m *ToolMutation) SetEnabled(b bool) {
+	m.enabled = &b
 }
 
-// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
-func (m *ModelMutation) RemovedMessagesIDs() (ids []uuid.UUID) {
-	for id := range m.removedmessages {
-		ids = append(ids, id)
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *ToolMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// MessagesIDs returns the "messages" edge IDs in the mutation.
-func (m *ModelMutation) MessagesIDs() (ids []uuid.UUID) {
-	for id := range m.messages {
-		ids = append(ids, id)
+// OldEnabled returns the old "enabled" field's value of the Tool entity.
+// If the Tool object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ToolMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
 }
 
-// ResetMessages resets all changes to the "messages" edge.
-func (m *ModelMutation) ResetMessages() {
-	m.messages = nil
-	m.clearedmessages = false
-	m.removedmessages = nil
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *ToolMutation) ResetEnabled() {
+	m.enabled = nil
 }
 
-// Where appends a list predicates to the ModelMutation builder.
-func (m *ModelMutation) Where(ps ...predicate.Model) {
+// Where appends a list predicates to the ToolMutation builder.
+func (m *ToolMutation) Where(ps ...predicate.Tool) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ModelMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ToolMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ModelMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Model, len(ps))
+func (m *ToolMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tool, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2811,60 +10421,60 @@ func (m *ModelMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ModelMutation) Op() Op {
+func (m *ToolMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ModelMutation) SetOp(op Op) {
+func (m *ToolMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Model).
-func (m *ModelMutation) Type() string {
+// Type returns the node type of this mutation (Tool).
+func (m *ToolMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ModelMutation) Fields() []string {
+func (m *ToolMutation) Fields() []string {
 	fields := make([]string, 0, 12)
 	if m.create_time != nil {
-		fields = append(fields, model.FieldCreateTime)
+		fields = append(fields, tool.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, model.FieldUpdateTime)
+		fields = append(fields, tool.FieldUpdateTime)
 	}
 	if m.name != nil {
-		fields = append(fields, model.FieldName)
+		fields = append(fields, tool.FieldName)
 	}
-	if m.context_window != nil {
-		fields = append(fields, model.FieldContextWindow)
+	if m.description != nil {
+		fields = append(fields, tool.FieldDescription)
 	}
-	if m.capabilities != nil {
-		fields = append(fields, model.FieldCapabilities)
+	if m.input_schema != nil {
+		fields = append(fields, tool.FieldInputSchema)
 	}
-	if m.input_cost != nil {
-		fields = append(fields, model.FieldInputCost)
+	if m.kind != nil {
+		fields = append(fields, tool.FieldKind)
 	}
-	if m.output_cost != nil {
-		fields = append(fields, model.FieldOutputCost)
+	if m.command != nil {
+		fields = append(fields, tool.FieldCommand)
 	}
-	if m.cache_write_cost != nil {
-		fields = append(fields, model.FieldCacheWriteCost)
+	if m.url != nil {
+		fields = append(fields, tool.FieldURL)
 	}
-	if m.cache_read_cost != nil {
-		fields = append(fields, model.FieldCacheReadCost)
+	if m.headers != nil {
+		fields = append(fields, tool.FieldHeaders)
 	}
-	if m.enabled != nil {
-		fields = append(fields, model.FieldEnabled)
+	if m.source != nil {
+		fields = append(fields, tool.FieldSource)
 	}
-	if m.alias != nil {
-		fields = append(fields, model.FieldAlias)
+	if m.timeout_seconds != nil {
+		fields = append(fields, tool.FieldTimeoutSeconds)
 	}
-	if m.model_provider != nil {
-		fields = append(fields, model.FieldModelProviderID)
+	if m.enabled != nil {
+		fields = append(fields, tool.FieldEnabled)
 	}
 	return fields
 }
@@ -2872,32 +10482,32 @@ func (m *ModelMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ModelMutation) Field(name string) (ent.Value, bool) {
+func (m *ToolMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case model.FieldCreateTime:
+	case tool.FieldCreateTime:
 		return m.CreateTime()
-	case model.FieldUpdateTime:
+	case tool.FieldUpdateTime:
 		return m.UpdateTime()
-	case model.FieldName:
+	case tool.FieldName:
 		return m.Name()
-	case model.FieldContextWindow:
-		return m.ContextWindow()
-	case model.FieldCapabilities:
-		return m.Capabilities()
-	case model.FieldInputCost:
-		return m.InputCost()
-	case model.FieldOutputCost:
-		return m.OutputCost()
-	case model.FieldCacheWriteCost:
-		return m.CacheWriteCost()
-	case model.FieldCacheReadCost:
-		return m.CacheReadCost()
-	case model.FieldEnabled:
+	case tool.FieldDescription:
+		return m.Description()
+	case tool.FieldInputSchema:
+		return m.InputSchema()
+	case tool.FieldKind:
+		return m.Kind()
+	case tool.FieldCommand:
+		return m.Command()
+	case tool.FieldURL:
+		return m.URL()
+	case tool.FieldHeaders:
+		return m.Headers()
+	case tool.FieldSource:
+		return m.Source()
+	case tool.FieldTimeoutSeconds:
+		return m.TimeoutSeconds()
+	case tool.FieldEnabled:
 		return m.Enabled()
-	case model.FieldAlias:
-		return m.Alias()
-	case model.FieldModelProviderID:
-		return m.ModelProviderID()
 	}
 	return nil, false
 }
@@ -2905,422 +10515,312 @@ func (m *ModelMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ModelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ToolMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case model.FieldCreateTime:
+	case tool.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case model.FieldUpdateTime:
+	case tool.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case model.FieldName:
+	case tool.FieldName:
 		return m.OldName(ctx)
-	case model.FieldContextWindow:
-		return m.OldContextWindow(ctx)
-	case model.FieldCapabilities:
-		return m.OldCapabilities(ctx)
-	case model.FieldInputCost:
-		return m.OldInputCost(ctx)
-	case model.FieldOutputCost:
-		return m.OldOutputCost(ctx)
-	case model.FieldCacheWriteCost:
-		return m.OldCacheWriteCost(ctx)
-	case model.FieldCacheReadCost:
-		return m.OldCacheReadCost(ctx)
-	case model.FieldEnabled:
+	case tool.FieldDescription:
+		return m.OldDescription(ctx)
+	case tool.FieldInputSchema:
+		return m.OldInputSchema(ctx)
+	case tool.FieldKind:
+		return m.OldKind(ctx)
+	case tool.FieldCommand:
+		return m.OldCommand(ctx)
+	case tool.FieldURL:
+		return m.OldURL(ctx)
+	case tool.FieldHeaders:
+		return m.OldHeaders(ctx)
+	case tool.FieldSource:
+		return m.OldSource(ctx)
+	case tool.FieldTimeoutSeconds:
+		return m.OldTimeoutSeconds(ctx)
+	case tool.FieldEnabled:
 		return m.OldEnabled(ctx)
-	case model.FieldAlias:
-		return m.OldAlias(ctx)
-	case model.FieldModelProviderID:
-		return m.OldModelProviderID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Model field %s", name)
+	return nil, fmt.Errorf("unknown Tool field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ModelMutation) SetField(name string, value ent.Value) error {
+func (m *ToolMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case model.FieldCreateTime:
+	case tool.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case model.FieldUpdateTime:
+	case tool.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case model.FieldName:
+	case tool.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case model.FieldContextWindow:
-		v, ok := value.(int64)
+	case tool.FieldDescription:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContextWindow(v)
+		m.SetDescription(v)
 		return nil
-	case model.FieldCapabilities:
-		v, ok := value.([]types.ModelCapability)
+	case tool.FieldInputSchema:
+		v, ok := value.(map[string]interface{})
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCapabilities(v)
+		m.SetInputSchema(v)
 		return nil
-	case model.FieldInputCost:
-		v, ok := value.(float64)
+	case tool.FieldKind:
+		v, ok := value.(types.ToolKind)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetInputCost(v)
+		m.SetKind(v)
 		return nil
-	case model.FieldOutputCost:
-		v, ok := value.(float64)
+	case tool.FieldCommand:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOutputCost(v)
+		m.SetCommand(v)
 		return nil
-	case model.FieldCacheWriteCost:
-		v, ok := value.(float64)
+	case tool.FieldURL:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCacheWriteCost(v)
+		m.SetURL(v)
 		return nil
-	case model.FieldCacheReadCost:
-		v, ok := value.(float64)
+	case tool.FieldHeaders:
+		v, ok := value.(map[string]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCacheReadCost(v)
+		m.SetHeaders(v)
 		return nil
-	case model.FieldEnabled:
-		v, ok := value.(bool)
+	case tool.FieldSource:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEnabled(v)
+		m.SetSource(v)
 		return nil
-	case model.FieldAlias:
-		v, ok := value.(string)
+	case tool.FieldTimeoutSeconds:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAlias(v)
+		m.SetTimeoutSeconds(v)
 		return nil
-	case model.FieldModelProviderID:
-		v, ok := value.(uuid.UUID)
+	case tool.FieldEnabled:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetModelProviderID(v)
+		m.SetEnabled(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Model field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ModelMutation) AddedFields() []string {
-	var fields []string
-	if m.addcontext_window != nil {
-		fields = append(fields, model.FieldContextWindow)
-	}
-	if m.addinput_cost != nil {
-		fields = append(fields, model.FieldInputCost)
-	}
-	if m.addoutput_cost != nil {
-		fields = append(fields, model.FieldOutputCost)
-	}
-	if m.addcache_write_cost != nil {
-		fields = append(fields, model.FieldCacheWriteCost)
-	}
-	if m.addcache_read_cost != nil {
-		fields = append(fields, model.FieldCacheReadCost)
-	}
-	return fields
-}
-
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *ModelMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case model.FieldContextWindow:
-		return m.AddedContextWindow()
-	case model.FieldInputCost:
-		return m.AddedInputCost()
-	case model.FieldOutputCost:
-		return m.AddedOutputCost()
-	case model.FieldCacheWriteCost:
-		return m.AddedCacheWriteCost()
-	case model.FieldCacheReadCost:
-		return m.AddedCacheReadCost()
-	}
-	return nil, false
+	return fmt.Errorf("unknown Tool field %s", name)
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ModelMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case model.FieldContextWindow:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddContextWindow(v)
-		return nil
-	case model.FieldInputCost:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddInputCost(v)
-		return nil
-	case model.FieldOutputCost:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddOutputCost(v)
-		return nil
-	case model.FieldCacheWriteCost:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCacheWriteCost(v)
-		return nil
-	case model.FieldCacheReadCost:
-		v, ok := value.(float64)
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ToolMutation) AddedFields() []string {
+	var fields []string
+	if m.addtimeout_seconds != nil {
+		fields = append(fields, tool.FieldTimeoutSeconds)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ToolMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case tool.FieldTimeoutSeconds:
+		return m.AddedTimeoutSeconds()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ToolMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case tool.FieldTimeoutSeconds:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCacheReadCost(v)
+		m.AddTimeoutSeconds(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Model numeric field %s", name)
+	return fmt.Errorf("unknown Tool numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ModelMutation) ClearedFields() []string {
+func (m *ToolMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(model.FieldCapabilities) {
-		fields = append(fields, model.FieldCapabilities)
+	if m.FieldCleared(tool.FieldInputSchema) {
+		fields = append(fields, tool.FieldInputSchema)
 	}
-	if m.FieldCleared(model.FieldAlias) {
-		fields = append(fields, model.FieldAlias)
+	if m.FieldCleared(tool.FieldCommand) {
+		fields = append(fields, tool.FieldCommand)
+	}
+	if m.FieldCleared(tool.FieldURL) {
+		fields = append(fields, tool.FieldURL)
+	}
+	if m.FieldCleared(tool.FieldHeaders) {
+		fields = append(fields, tool.FieldHeaders)
+	}
+	if m.FieldCleared(tool.FieldSource) {
+		fields = append(fields, tool.FieldSource)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ModelMutation) FieldCleared(name string) bool {
+func (m *ToolMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ModelMutation) ClearField(name string) error {
+func (m *ToolMutation) ClearField(name string) error {
 	switch name {
-	case model.FieldCapabilities:
-		m.ClearCapabilities()
+	case tool.FieldInputSchema:
+		m.ClearInputSchema()
 		return nil
-	case model.FieldAlias:
-		m.ClearAlias()
+	case tool.FieldCommand:
+		m.ClearCommand()
+		return nil
+	case tool.FieldURL:
+		m.ClearURL()
+		return nil
+	case tool.FieldHeaders:
+		m.ClearHeaders()
+		return nil
+	case tool.FieldSource:
+		m.ClearSource()
 		return nil
 	}
-	return fmt.Errorf("unknown Model nullable field %s", name)
+	return fmt.Errorf("unknown Tool nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ModelMutation) ResetField(name string) error {
+func (m *ToolMutation) ResetField(name string) error {
 	switch name {
-	case model.FieldCreateTime:
+	case tool.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case model.FieldUpdateTime:
+	case tool.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case model.FieldName:
+	case tool.FieldName:
 		m.ResetName()
 		return nil
-	case model.FieldContextWindow:
-		m.ResetContextWindow()
+	case tool.FieldDescription:
+		m.ResetDescription()
 		return nil
-	case model.FieldCapabilities:
-		m.ResetCapabilities()
+	case tool.FieldInputSchema:
+		m.ResetInputSchema()
 		return nil
-	case model.FieldInputCost:
-		m.ResetInputCost()
+	case tool.FieldKind:
+		m.ResetKind()
 		return nil
-	case model.FieldOutputCost:
-		m.ResetOutputCost()
+	case tool.FieldCommand:
+		m.ResetCommand()
 		return nil
-	case model.FieldCacheWriteCost:
-		m.ResetCacheWriteCost()
+	case tool.FieldURL:
+		m.ResetURL()
 		return nil
-	case model.FieldCacheReadCost:
-		m.ResetCacheReadCost()
+	case tool.FieldHeaders:
+		m.ResetHeaders()
 		return nil
-	case model.FieldEnabled:
-		m.ResetEnabled()
+	case tool.FieldSource:
+		m.ResetSource()
 		return nil
-	case model.FieldAlias:
-		m.ResetAlias()
+	case tool.FieldTimeoutSeconds:
+		m.ResetTimeoutSeconds()
 		return nil
-	case model.FieldModelProviderID:
-		m.ResetModelProviderID()
+	case tool.FieldEnabled:
+		m.ResetEnabled()
 		return nil
 	}
-	return fmt.Errorf("unknown Model field %s", name)
+	return fmt.Errorf("unknown Tool field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ModelMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.agents != nil {
-		edges = append(edges, model.EdgeAgents)
-	}
-	if m.model_provider != nil {
-		edges = append(edges, model.EdgeModelProvider)
-	}
-	if m.messages != nil {
-		edges = append(edges, model.EdgeMessages)
-	}
+func (m *ToolMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ModelMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case model.EdgeAgents:
-		ids := make([]ent.Value, 0, len(m.agents))
-		for id := range m.agents {
-			ids = append(ids, id)
-		}
-		return ids
-	case model.EdgeModelProvider:
-		if id := m.model_provider; id != nil {
-			return []ent.Value{*id}
-		}
-	case model.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.messages))
-		for id := range m.messages {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ToolMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ModelMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedagents != nil {
-		edges = append(edges, model.EdgeAgents)
-	}
-	if m.removedmessages != nil {
-		edges = append(edges, model.EdgeMessages)
-	}
+func (m *ToolMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ModelMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case model.EdgeAgents:
-		ids := make([]ent.Value, 0, len(m.removedagents))
-		for id := range m.removedagents {
-			ids = append(ids, id)
-		}
-		return ids
-	case model.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.removedmessages))
-		for id := range m.removedmessages {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ToolMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ModelMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedagents {
-		edges = append(edges, model.EdgeAgents)
-	}
-	if m.clearedmodel_provider {
-		edges = append(edges, model.EdgeModelProvider)
-	}
-	if m.clearedmessages {
-		edges = append(edges, model.EdgeMessages)
-	}
+func (m *ToolMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ModelMutation) EdgeCleared(name string) bool {
-	switch name {
-	case model.EdgeAgents:
-		return m.clearedagents
-	case model.EdgeModelProvider:
-		return m.clearedmodel_provider
-	case model.EdgeMessages:
-		return m.clearedmessages
-	}
+func (m *ToolMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ModelMutation) ClearEdge(name string) error {
-	switch name {
-	case model.EdgeModelProvider:
-		m.ClearModelProvider()
-		return nil
-	}
-	return fmt.Errorf("unknown Model unique edge %s", name)
+func (m *ToolMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Tool unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ModelMutation) ResetEdge(name string) error {
-	switch name {
-	case model.EdgeAgents:
-		m.ResetAgents()
-		return nil
-	case model.EdgeModelProvider:
-		m.ResetModelProvider()
-		return nil
-	case model.EdgeMessages:
-		m.ResetMessages()
-		return nil
-	}
-	return fmt.Errorf("unknown Model edge %s", name)
+func (m *ToolMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Tool edge %s", name)
 }
 
-// ModelProviderMutation represents an operation that mutates the ModelProvider nodes in the graph.
-type ModelProviderMutation struct {
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
 	config
 	op            Op
 	typ           string
@@ -3328,30 +10828,33 @@ type ModelProviderMutation struct {
 	create_time   *time.Time
 	update_time   *time.Time
 	name          *string
-	provider_type *types.ModelProviderType
-	url           *string
-	secret        *[]byte
-	enabled       *bool
+	role          *types.UserRole
 	clearedFields map[string]struct{}
-	models        map[uuid.UUID]struct{}
-	removedmodels map[uuid.UUID]struct{}
-	clearedmodels bool
+	tokens        map[uuid.UUID]struct{}
+	removedtokens map[uuid.UUID]struct{}
+	clearedtokens bool
+	tasks         map[uuid.UUID]struct{}
+	removedtasks  map[uuid.UUID]struct{}
+	clearedtasks  bool
+	agents        map[uuid.UUID]struct{}
+	removedagents map[uuid.UUID]struct{}
+	clearedagents bool
 	done          bool
-	oldValue      func(context.Context) (*ModelProvider, error)
-	predicates    []predicate.ModelProvider
+	oldValue      func(context.Context) (*User, error)
+	predicates    []predicate.User
 }
 
-var _ ent.Mutation = (*ModelProviderMutation)(nil)
+var _ ent.Mutation = (*UserMutation)(nil)
 
-// modelproviderOption allows management of the mutation configuration using functional options.
-type modelproviderOption func(*ModelProviderMutation)
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
 
-// newModelProviderMutation creates new mutation for the ModelProvider entity.
-func newModelProviderMutation(c config, op Op, opts ...modelproviderOption) *ModelProviderMutation {
-	m := &ModelProviderMutation{
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeModelProvider,
+		typ:           TypeUser,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3360,20 +10863,20 @@ func newModelProviderMutation(c config, op Op, opts ...modelproviderOption) *Mod
 	return m
 }
 
-// withModelProviderID sets the ID field of the mutation.
-func withModelProviderID(id uuid.UUID) modelproviderOption {
-	return func(m *ModelProviderMutation) {
+// withUserID sets the ID field of the mutation.
+func withUserID(id uuid.UUID) userOption {
+	return func(m *UserMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ModelProvider
+			value *User
 		)
-		m.oldValue = func(ctx context.Context) (*ModelProvider, error) {
+		m.oldValue = func(ctx context.Context) (*User, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ModelProvider.Get(ctx, id)
+					value, err = m.Client().User.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3382,10 +10885,10 @@ func withModelProviderID(id uuid.UUID) modelproviderOption {
 	}
 }
 
-// withModelProvider sets the old ModelProvider of the mutation.
-func withModelProvider(node *ModelProvider) modelproviderOption {
-	return func(m *ModelProviderMutation) {
-		m.oldValue = func(context.Context) (*ModelProvider, error) {
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3394,7 +10897,7 @@ func withModelProvider(node *ModelProvider) modelproviderOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ModelProviderMutation) Client() *Client {
+func (m UserMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3402,7 +10905,7 @@ func (m ModelProviderMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ModelProviderMutation) Tx() (*Tx, error) {
+func (m UserMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("memory: mutation is not running in a transaction")
 	}
@@ -3412,14 +10915,14 @@ func (m ModelProviderMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ModelProvider entities.
-func (m *ModelProviderMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ModelProviderMutation) ID() (id uuid.UUID, exists bool) {
+func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3430,7 +10933,7 @@ func (m *ModelProviderMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ModelProviderMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3439,19 +10942,19 @@ func (m *ModelProviderMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ModelProvider.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *ModelProviderMutation) SetCreateTime(t time.Time) {
+func (m *UserMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *ModelProviderMutation) CreateTime() (r time.Time, exists bool) {
+func (m *UserMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -3459,10 +10962,10 @@ func (m *ModelProviderMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the ModelProvider entity.
-// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelProviderMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -3477,17 +10980,17 @@ func (m *ModelProviderMutation) OldCreateTime(ctx context.Context) (v time.Time,
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *ModelProviderMutation) ResetCreateTime() {
+func (m *UserMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *ModelProviderMutation) SetUpdateTime(t time.Time) {
+func (m *UserMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *ModelProviderMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *UserMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -3495,10 +10998,10 @@ func (m *ModelProviderMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the ModelProvider entity.
-// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelProviderMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -3513,17 +11016,17 @@ func (m *ModelProviderMutation) OldUpdateTime(ctx context.Context) (v time.Time,
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *ModelProviderMutation) ResetUpdateTime() {
+func (m *UserMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
 // SetName sets the "name" field.
-func (m *ModelProviderMutation) SetName(s string) {
+func (m *UserMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *ModelProviderMutation) Name() (r string, exists bool) {
+func (m *UserMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -3531,10 +11034,10 @@ func (m *ModelProviderMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the ModelProvider entity.
-// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelProviderMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -3549,230 +11052,217 @@ func (m *ModelProviderMutation) OldName(ctx context.Context) (v string, err erro
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *ModelProviderMutation) ResetName() {
+func (m *UserMutation) ResetName() {
 	m.name = nil
 }
 
-// SetProviderType sets the "provider_type" field.
-func (m *ModelProviderMutation) SetProviderType(tpt types.ModelProviderType) {
-	m.provider_type = &tpt
-}
-
-// ProviderType returns the value of the "provider_type" field in the mutation.
-func (m *ModelProviderMutation) ProviderType() (r types.ModelProviderType, exists bool) {
-	v := m.provider_type
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldProviderType returns the old "provider_type" field's value of the ModelProvider entity.
-// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelProviderMutation) OldProviderType(ctx context.Context) (v types.ModelProviderType, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProviderType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProviderType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProviderType: %w", err)
-	}
-	return oldValue.ProviderType, nil
-}
-
-// ResetProviderType resets all changes to the "provider_type" field.
-func (m *ModelProviderMutation) ResetProviderType() {
-	m.provider_type = nil
-}
-
-// SetURL sets the "url" field.
-func (m *ModelProviderMutation) SetURL(s string) {
-	m.url = &s
+// SetRole sets the "role" field.
+func (m *UserMutation) SetRole(tr types.UserRole) {
+	m.role = &tr
 }
-
-// URL returns the value of the "url" field in the mutation.
-func (m *ModelProviderMutation) URL() (r string, exists bool) {
-	v := m.url
+
+// Role returns the value of the "role" field in the mutation.
+func (m *UserMutation) Role() (r types.UserRole, exists bool) {
+	v := m.role
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldURL returns the old "url" field's value of the ModelProvider entity.
-// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldRole returns the old "role" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelProviderMutation) OldURL(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldRole(ctx context.Context) (v types.UserRole, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldURL requires an ID field in the mutation")
+		return v, errors.New("OldRole requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
 	}
-	return oldValue.URL, nil
+	return oldValue.Role, nil
 }
 
-// ClearURL clears the value of the "url" field.
-func (m *ModelProviderMutation) ClearURL() {
-	m.url = nil
-	m.clearedFields[modelprovider.FieldURL] = struct{}{}
+// ResetRole resets all changes to the "role" field.
+func (m *UserMutation) ResetRole() {
+	m.role = nil
 }
 
-// URLCleared returns if the "url" field was cleared in this mutation.
-func (m *ModelProviderMutation) URLCleared() bool {
-	_, ok := m.clearedFields[modelprovider.FieldURL]
-	return ok
+// AddTokenIDs adds the "tokens" edge to the AuthToken entity by ids.
+func (m *UserMutation) AddTokenIDs(ids ...uuid.UUID) {
+	if m.tokens == nil {
+		m.tokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.tokens[ids[i]] = struct{}{}
+	}
 }
 
-// ResetURL resets all changes to the "url" field.
-func (m *ModelProviderMutation) ResetURL() {
-	m.url = nil
-	delete(m.clearedFields, modelprovider.FieldURL)
+// ClearTokens clears the "tokens" edge to the AuthToken entity.
+func (m *UserMutation) ClearTokens() {
+	m.clearedtokens = true
 }
 
-// SetSecret sets the "secret" field.
-func (m *ModelProviderMutation) SetSecret(b []byte) {
-	m.secret = &b
+// TokensCleared reports if the "tokens" edge to the AuthToken entity was cleared.
+func (m *UserMutation) TokensCleared() bool {
+	return m.clearedtokens
 }
 
-// Secret returns the value of the "secret" field in the mutation.
-func (m *ModelProviderMutation) Secret() (r []byte, exists bool) {
-	v := m.secret
-	if v == nil {
-		return
+// RemoveTokenIDs removes the "tokens" edge to the AuthToken entity by IDs.
+func (m *UserMutation) RemoveTokenIDs(ids ...uuid.UUID) {
+	if m.removedtokens == nil {
+		m.removedtokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.tokens, ids[i])
+		m.removedtokens[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldSecret returns the old "secret" field's value of the ModelProvider entity.
-// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelProviderMutation) OldSecret(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+// RemovedTokens returns the removed IDs of the "tokens" edge to the AuthToken entity.
+func (m *UserMutation) RemovedTokensIDs() (ids []uuid.UUID) {
+	for id := range m.removedtokens {
+		ids = append(ids, id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSecret requires an ID field in the mutation")
+	return
+}
+
+// TokensIDs returns the "tokens" edge IDs in the mutation.
+func (m *UserMutation) TokensIDs() (ids []uuid.UUID) {
+	for id := range m.tokens {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	return
+}
+
+// ResetTokens resets all changes to the "tokens" edge.
+func (m *UserMutation) ResetTokens() {
+	m.tokens = nil
+	m.clearedtokens = false
+	m.removedtokens = nil
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by ids.
+func (m *UserMutation) AddTaskIDs(ids ...uuid.UUID) {
+	if m.tasks == nil {
+		m.tasks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.tasks[ids[i]] = struct{}{}
 	}
-	return oldValue.Secret, nil
 }
 
-// ResetSecret resets all changes to the "secret" field.
-func (m *ModelProviderMutation) ResetSecret() {
-	m.secret = nil
+// ClearTasks clears the "tasks" edge to the Task entity.
+func (m *UserMutation) ClearTasks() {
+	m.clearedtasks = true
 }
 
-// SetEnabled sets the "enabled" field.
-func (m *ModelProviderMutation) SetEnabled(b bool) {
-	m.enabled = &b
+// TasksCleared reports if the "tasks" edge to the Task entity was cleared.
+func (m *UserMutation) TasksCleared() bool {
+	return m.clearedtasks
 }
 
-// Enabled returns the value of the "enabled" field in the mutation.
-func (m *ModelProviderMutation) Enabled() (r bool, exists bool) {
-	v := m.enabled
-	if v == nil {
-		return
+// RemoveTaskIDs removes the "tasks" edge to the Task entity by IDs.
+func (m *UserMutation) RemoveTaskIDs(ids ...uuid.UUID) {
+	if m.removedtasks == nil {
+		m.removedtasks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.tasks, ids[i])
+		m.removedtasks[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldEnabled returns the old "enabled" field's value of the ModelProvider entity.
-// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelProviderMutation) OldEnabled(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnabled requires an ID field in the mutation")
+// RemovedTasks returns the removed IDs of the "tasks" edge to the Task entity.
+func (m *UserMutation) RemovedTasksIDs() (ids []uuid.UUID) {
+	for id := range m.removedtasks {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	return
+}
+
+// TasksIDs returns the "tasks" edge IDs in the mutation.
+func (m *UserMutation) TasksIDs() (ids []uuid.UUID) {
+	for id := range m.tasks {
+		ids = append(ids, id)
 	}
-	return oldValue.Enabled, nil
+	return
 }
 
-// ResetEnabled resets all changes to the "enabled" field.
-func (m *ModelProviderMutation) ResetEnabled() {
-	m.enabled = nil
+// ResetTasks resets all changes to the "tasks" edge.
+func (m *UserMutation) ResetTasks() {
+	m.tasks = nil
+	m.clearedtasks = false
+	m.removedtasks = nil
 }
 
-// AddModelIDs adds the "models" edge to the Model entity by ids.
-func (m *ModelProviderMutation) AddModelIDs(ids ...uuid.UUID) {
-	if m.models == nil {
-		m.models = make(map[uuid.UUID]struct{})
+// AddAgentIDs adds the "agents" edge to the Agent entity by ids.
+func (m *UserMutation) AddAgentIDs(ids ...uuid.UUID) {
+	if m.agents == nil {
+		m.agents = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.models[ids[i]] = struct{}{}
+		m.agents[ids[i]] = struct{}{}
 	}
 }
 
-// ClearModels clears the "models" edge to the Model entity.
-func (m *ModelProviderMutation) ClearModels() {
-	m.clearedmodels = true
+// ClearAgents clears the "agents" edge to the Agent entity.
+func (m *UserMutation) ClearAgents() {
+	m.clearedagents = true
 }
 
-// ModelsCleared reports if the "models" edge to the Model entity was cleared.
-func (m *ModelProviderMutation) ModelsCleared() bool {
-	return m.clearedmodels
+// AgentsCleared reports if the "agents" edge to the Agent entity was cleared.
+func (m *UserMutation) AgentsCleared() bool {
+	return m.clearedagents
 }
 
-// RemoveModelIDs removes the "models" edge to the Model entity by IDs.
-func (m *ModelProviderMutation) RemoveModelIDs(ids ...uuid.UUID) {
-	if m.removedmodels == nil {
-		m.removedmodels = make(map[uuid.UUID]struct{})
+// RemoveAgentIDs removes the "agents" edge to the Agent entity by IDs.
+func (m *UserMutation) RemoveAgentIDs(ids ...uuid.UUID) {
+	if m.removedagents == nil {
+		m.removedagents = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.models, ids[i])
-		m.removedmodels[ids[i]] = struct{}{}
+		delete(m.agents, ids[i])
+		m.removedagents[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedModels returns the removed IDs of the "models" edge to the Model entity.
-func (m *ModelProviderMutation) RemovedModelsIDs() (ids []uuid.UUID) {
-	for id := range m.removedmodels {
+// RemovedAgents returns the removed IDs of the "agents" edge to the Agent entity.
+func (m *UserMutation) RemovedAgentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedagents {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ModelsIDs returns the "models" edge IDs in the mutation.
-func (m *ModelProviderMutation) ModelsIDs() (ids []uuid.UUID) {
-	for id := range m.models {
+// AgentsIDs returns the "agents" edge IDs in the mutation.
+func (m *UserMutation) AgentsIDs() (ids []uuid.UUID) {
+	for id := range m.agents {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetModels resets all changes to the "models" edge.
-func (m *ModelProviderMutation) ResetModels() {
-	m.models = nil
-	m.clearedmodels = false
-	m.removedmodels = nil
+// ResetAgents resets all changes to the "agents" edge.
+func (m *UserMutation) ResetAgents() {
+	m.agents = nil
+	m.clearedagents = false
+	m.removedagents = nil
 }
 
-// Where appends a list predicates to the ModelProviderMutation builder.
-func (m *ModelProviderMutation) Where(ps ...predicate.ModelProvider) {
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ModelProviderMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ModelProviderMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ModelProvider, len(ps))
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3780,45 +11270,36 @@ func (m *ModelProviderMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ModelProviderMutation) Op() Op {
+func (m *UserMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ModelProviderMutation) SetOp(op Op) {
+func (m *UserMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ModelProvider).
-func (m *ModelProviderMutation) Type() string {
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ModelProviderMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 4)
 	if m.create_time != nil {
-		fields = append(fields, modelprovider.FieldCreateTime)
+		fields = append(fields, user.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, modelprovider.FieldUpdateTime)
+		fields = append(fields, user.FieldUpdateTime)
 	}
 	if m.name != nil {
-		fields = append(fields, modelprovider.FieldName)
-	}
-	if m.provider_type != nil {
-		fields = append(fields, modelprovider.FieldProviderType)
-	}
-	if m.url != nil {
-		fields = append(fields, modelprovider.FieldURL)
+		fields = append(fields, user.FieldName)
 	}
-	if m.secret != nil {
-		fields = append(fields, modelprovider.FieldSecret)
-	}
-	if m.enabled != nil {
-		fields = append(fields, modelprovider.FieldEnabled)
+	if m.role != nil {
+		fields = append(fields, user.FieldRole)
 	}
 	return fields
 }
@@ -3826,22 +11307,16 @@ func (m *ModelProviderMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ModelProviderMutation) Field(name string) (ent.Value, bool) {
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case modelprovider.FieldCreateTime:
+	case user.FieldCreateTime:
 		return m.CreateTime()
-	case modelprovider.FieldUpdateTime:
+	case user.FieldUpdateTime:
 		return m.UpdateTime()
-	case modelprovider.FieldName:
+	case user.FieldName:
 		return m.Name()
-	case modelprovider.FieldProviderType:
-		return m.ProviderType()
-	case modelprovider.FieldURL:
-		return m.URL()
-	case modelprovider.FieldSecret:
-		return m.Secret()
-	case modelprovider.FieldEnabled:
-		return m.Enabled()
+	case user.FieldRole:
+		return m.Role()
 	}
 	return nil, false
 }
@@ -3849,179 +11324,152 @@ func (m *ModelProviderMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ModelProviderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case modelprovider.FieldCreateTime:
+	case user.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case modelprovider.FieldUpdateTime:
+	case user.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case modelprovider.FieldName:
+	case user.FieldName:
 		return m.OldName(ctx)
-	case modelprovider.FieldProviderType:
-		return m.OldProviderType(ctx)
-	case modelprovider.FieldURL:
-		return m.OldURL(ctx)
-	case modelprovider.FieldSecret:
-		return m.OldSecret(ctx)
-	case modelprovider.FieldEnabled:
-		return m.OldEnabled(ctx)
+	case user.FieldRole:
+		return m.OldRole(ctx)
 	}
-	return nil, fmt.Errorf("unknown ModelProvider field %s", name)
+	return nil, fmt.Errorf("unknown User field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ModelProviderMutation) SetField(name string, value ent.Value) error {
+func (m *UserMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case modelprovider.FieldCreateTime:
+	case user.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case modelprovider.FieldUpdateTime:
+	case user.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case modelprovider.FieldName:
+	case user.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case modelprovider.FieldProviderType:
-		v, ok := value.(types.ModelProviderType)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProviderType(v)
-		return nil
-	case modelprovider.FieldURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetURL(v)
-		return nil
-	case modelprovider.FieldSecret:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSecret(v)
-		return nil
-	case modelprovider.FieldEnabled:
-		v, ok := value.(bool)
+	case user.FieldRole:
+		v, ok := value.(types.UserRole)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEnabled(v)
+		m.SetRole(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ModelProvider field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ModelProviderMutation) AddedFields() []string {
+func (m *UserMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ModelProviderMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ModelProviderMutation) AddField(name string, value ent.Value) error {
+func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown ModelProvider numeric field %s", name)
+	return fmt.Errorf("unknown User numeric field %s", name)
 }
-
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *ModelProviderMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(modelprovider.FieldURL) {
-		fields = append(fields, modelprovider.FieldURL)
-	}
-	return fields
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ModelProviderMutation) FieldCleared(name string) bool {
+func (m *UserMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ModelProviderMutation) ClearField(name string) error {
-	switch name {
-	case modelprovider.FieldURL:
-		m.ClearURL()
-		return nil
-	}
-	return fmt.Errorf("unknown ModelProvider nullable field %s", name)
+func (m *UserMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ModelProviderMutation) ResetField(name string) error {
+func (m *UserMutation) ResetField(name string) error {
 	switch name {
-	case modelprovider.FieldCreateTime:
+	case user.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case modelprovider.FieldUpdateTime:
+	case user.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case modelprovider.FieldName:
+	case user.FieldName:
 		m.ResetName()
 		return nil
-	case modelprovider.FieldProviderType:
-		m.ResetProviderType()
-		return nil
-	case modelprovider.FieldURL:
-		m.ResetURL()
-		return nil
-	case modelprovider.FieldSecret:
-		m.ResetSecret()
-		return nil
-	case modelprovider.FieldEnabled:
-		m.ResetEnabled()
+	case user.FieldRole:
+		m.ResetRole()
 		return nil
 	}
-	return fmt.Errorf("unknown ModelProvider field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ModelProviderMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.models != nil {
-		edges = append(edges, modelprovider.EdgeModels)
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.tokens != nil {
+		edges = append(edges, user.EdgeTokens)
+	}
+	if m.tasks != nil {
+		edges = append(edges, user.EdgeTasks)
+	}
+	if m.agents != nil {
+		edges = append(edges, user.EdgeAgents)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ModelProviderMutation) AddedIDs(name string) []ent.Value {
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case modelprovider.EdgeModels:
-		ids := make([]ent.Value, 0, len(m.models))
-		for id := range m.models {
+	case user.EdgeTokens:
+		ids := make([]ent.Value, 0, len(m.tokens))
+		for id := range m.tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeTasks:
+		ids := make([]ent.Value, 0, len(m.tasks))
+		for id := range m.tasks {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAgents:
+		ids := make([]ent.Value, 0, len(m.agents))
+		for id := range m.agents {
 			ids = append(ids, id)
 		}
 		return ids
@@ -4030,21 +11478,39 @@ func (m *ModelProviderMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ModelProviderMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedmodels != nil {
-		edges = append(edges, modelprovider.EdgeModels)
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedtokens != nil {
+		edges = append(edges, user.EdgeTokens)
+	}
+	if m.removedtasks != nil {
+		edges = append(edges, user.EdgeTasks)
+	}
+	if m.removedagents != nil {
+		edges = append(edges, user.EdgeAgents)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ModelProviderMutation) RemovedIDs(name string) []ent.Value {
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case modelprovider.EdgeModels:
-		ids := make([]ent.Value, 0, len(m.removedmodels))
-		for id := range m.removedmodels {
+	case user.EdgeTokens:
+		ids := make([]ent.Value, 0, len(m.removedtokens))
+		for id := range m.removedtokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeTasks:
+		ids := make([]ent.Value, 0, len(m.removedtasks))
+		for id := range m.removedtasks {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAgents:
+		ids := make([]ent.Value, 0, len(m.removedagents))
+		for id := range m.removedagents {
 			ids = append(ids, id)
 		}
 		return ids
@@ -4053,90 +11519,95 @@ func (m *ModelProviderMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ModelProviderMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedmodels {
-		edges = append(edges, modelprovider.EdgeModels)
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedtokens {
+		edges = append(edges, user.EdgeTokens)
+	}
+	if m.clearedtasks {
+		edges = append(edges, user.EdgeTasks)
+	}
+	if m.clearedagents {
+		edges = append(edges, user.EdgeAgents)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ModelProviderMutation) EdgeCleared(name string) bool {
+func (m *UserMutation) EdgeCleared(name string) bool {
 	switch name {
-	case modelprovider.EdgeModels:
-		return m.clearedmodels
+	case user.EdgeTokens:
+		return m.clearedtokens
+	case user.EdgeTasks:
+		return m.clearedtasks
+	case user.EdgeAgents:
+		return m.clearedagents
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ModelProviderMutation) ClearEdge(name string) error {
+func (m *UserMutation) ClearEdge(name string) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown ModelProvider unique edge %s", name)
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ModelProviderMutation) ResetEdge(name string) error {
+func (m *UserMutation) ResetEdge(name string) error {
 	switch name {
-	case modelprovider.EdgeModels:
-		m.ResetModels()
+	case user.EdgeTokens:
+		m.ResetTokens()
+		return nil
+	case user.EdgeTasks:
+		m.ResetTasks()
+		return nil
+	case user.EdgeAgents:
+		m.ResetAgents()
 		return nil
 	}
-	return fmt.Errorf("unknown ModelProvider edge %s", name)
+	return fmt.Errorf("unknown User edge %s", name)
 }
 
-// TaskMutation represents an operation that mutates the Task nodes in the graph.
-type TaskMutation struct {
+// WebhookMutation represents an operation that mutates the Webhook nodes in the graph.
+type WebhookMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *uuid.UUID
-	create_time           *time.Time
-	update_time           *time.Time
-	project_directory     *string
-	input_tokens          *int64
-	addinput_tokens       *int64
-	output_tokens         *int64
-	addoutput_tokens      *int64
-	cache_write_tokens    *int64
-	addcache_write_tokens *int64
-	cache_read_tokens     *int64
-	addcache_read_tokens  *int64
-	cost                  *float64
-	addcost               *float64
-	turns                 *int64
-	addturns              *int64
-	tool_uses             *map[string]int64
-	desired_phase         *types.TaskPhase
-	phase                 *types.TaskPhase
-	description           *string
-	clearedFields         map[string]struct{}
-	messages              map[uuid.UUID]struct{}
-	removedmessages       map[uuid.UUID]struct{}
-	clearedmessages       bool
-	agent                 *uuid.UUID
-	clearedagent          bool
-	done                  bool
-	oldValue              func(context.Context) (*Task, error)
-	predicates            []predicate.Task
-}
-
-var _ ent.Mutation = (*TaskMutation)(nil)
-
-// taskOption allows management of the mutation configuration using functional options.
-type taskOption func(*TaskMutation)
-
-// newTaskMutation creates new mutation for the Task entity.
-func newTaskMutation(c config, op Op, opts ...taskOption) *TaskMutation {
-	m := &TaskMutation{
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	create_time         *time.Time
+	update_time         *time.Time
+	name                *string
+	url                 *string
+	secret              *[]byte
+	events              *[]types.WebhookEventType
+	appendevents        []types.WebhookEventType
+	cost_threshold      *float64
+	addcost_threshold   *float64
+	enabled             *bool
+	clearedFields       map[string]struct{}
+	dead_letters        map[uuid.UUID]struct{}
+	removeddead_letters map[uuid.UUID]struct{}
+	cleareddead_letters bool
+	done                bool
+	oldValue            func(context.Context) (*Webhook, error)
+	predicates          []predicate.Webhook
+}
+
+var _ ent.Mutation = (*WebhookMutation)(nil)
+
+// webhookOption allows management of the mutation configuration using functional options.
+type webhookOption func(*WebhookMutation)
+
+// newWebhookMutation creates new mutation for the Webhook entity.
+func newWebhookMutation(c config, op Op, opts ...webhookOption) *WebhookMutation {
+	m := &WebhookMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTask,
+		typ:           TypeWebhook,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4145,20 +11616,20 @@ func newTaskMutation(c config, op Op, opts ...taskOption) *TaskMutation {
 	return m
 }
 
-// withTaskID sets the ID field of the mutation.
-func withTaskID(id uuid.UUID) taskOption {
-	return func(m *TaskMutation) {
+// withWebhookID sets the ID field of the mutation.
+func withWebhookID(id uuid.UUID) webhookOption {
+	return func(m *WebhookMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Task
+			value *Webhook
 		)
-		m.oldValue = func(ctx context.Context) (*Task, error) {
+		m.oldValue = func(ctx context.Context) (*Webhook, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Task.Get(ctx, id)
+					value, err = m.Client().Webhook.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4167,10 +11638,10 @@ func withTaskID(id uuid.UUID) taskOption {
 	}
 }
 
-// withTask sets the old Task of the mutation.
-func withTask(node *Task) taskOption {
-	return func(m *TaskMutation) {
-		m.oldValue = func(context.Context) (*Task, error) {
+// withWebhook sets the old Webhook of the mutation.
+func withWebhook(node *Webhook) webhookOption {
+	return func(m *WebhookMutation) {
+		m.oldValue = func(context.Context) (*Webhook, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4179,7 +11650,7 @@ func withTask(node *Task) taskOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TaskMutation) Client() *Client {
+func (m WebhookMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4187,7 +11658,7 @@ func (m TaskMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TaskMutation) Tx() (*Tx, error) {
+func (m WebhookMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("memory: mutation is not running in a transaction")
 	}
@@ -4197,14 +11668,14 @@ func (m TaskMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Task entities.
-func (m *TaskMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Webhook entities.
+func (m *WebhookMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TaskMutation) ID() (id uuid.UUID, exists bool) {
+func (m *WebhookMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4215,7 +11686,7 @@ func (m *TaskMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TaskMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *WebhookMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4224,835 +11695,1244 @@ func (m *TaskMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Task.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Webhook.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *TaskMutation) SetCreateTime(t time.Time) {
+func (m *WebhookMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
-// CreateTime returns the value of the "create_time" field in the mutation.
-func (m *TaskMutation) CreateTime() (r time.Time, exists bool) {
-	v := m.create_time
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *WebhookMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *WebhookMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *WebhookMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *WebhookMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *WebhookMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetName sets the "name" field.
+func (m *WebhookMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *WebhookMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *WebhookMutation) ResetName() {
+	m.name = nil
+}
+
+// SetURL sets the "url" field.
+func (m *WebhookMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *WebhookMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *WebhookMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetSecret sets the "secret" field.
+func (m *WebhookMutation) SetSecret(b []byte) {
+	m.secret = &b
+}
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *WebhookMutation) Secret() (r []byte, exists bool) {
+	v := m.secret
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldSecret returns the old "secret" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookMutation) OldSecret(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+		return v, errors.New("OldSecret requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
 	}
-	return oldValue.CreateTime, nil
+	return oldValue.Secret, nil
 }
 
-// ResetCreateTime resets all changes to the "create_time" field.
-func (m *TaskMutation) ResetCreateTime() {
-	m.create_time = nil
+// ResetSecret resets all changes to the "secret" field.
+func (m *WebhookMutation) ResetSecret() {
+	m.secret = nil
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (m *TaskMutation) SetUpdateTime(t time.Time) {
-	m.update_time = &t
+// SetEvents sets the "events" field.
+func (m *WebhookMutation) SetEvents(tet []types.WebhookEventType) {
+	m.events = &tet
+	m.appendevents = nil
 }
 
-// UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *TaskMutation) UpdateTime() (r time.Time, exists bool) {
-	v := m.update_time
+// Events returns the value of the "events" field in the mutation.
+func (m *WebhookMutation) Events() (r []types.WebhookEventType, exists bool) {
+	v := m.events
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldEvents returns the old "events" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookMutation) OldEvents(ctx context.Context) (v []types.WebhookEventType, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldEvents is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+		return v, errors.New("OldEvents requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldEvents: %w", err)
 	}
-	return oldValue.UpdateTime, nil
+	return oldValue.Events, nil
 }
 
-// ResetUpdateTime resets all changes to the "update_time" field.
-func (m *TaskMutation) ResetUpdateTime() {
-	m.update_time = nil
+// AppendEvents adds tet to the "events" field.
+func (m *WebhookMutation) AppendEvents(tet []types.WebhookEventType) {
+	m.appendevents = append(m.appendevents, tet...)
 }
 
-// SetProjectDirectory sets the "project_directory" field.
-func (m *TaskMutation) SetProjectDirectory(s string) {
-	m.project_directory = &s
+// AppendedEvents returns the list of values that were appended to the "events" field in this mutation.
+func (m *WebhookMutation) AppendedEvents() ([]types.WebhookEventType, bool) {
+	if len(m.appendevents) == 0 {
+		return nil, false
+	}
+	return m.appendevents, true
 }
 
-// ProjectDirectory returns the value of the "project_directory" field in the mutation.
-func (m *TaskMutation) ProjectDirectory() (r string, exists bool) {
-	v := m.project_directory
+// ResetEvents resets all changes to the "events" field.
+func (m *WebhookMutation) ResetEvents() {
+	m.events = nil
+	m.appendevents = nil
+}
+
+// SetCostThreshold sets the "cost_threshold" field.
+func (m *WebhookMutation) SetCostThreshold(f float64) {
+	m.cost_threshold = &f
+	m.addcost_threshold = nil
+}
+
+// CostThreshold returns the value of the "cost_threshold" field in the mutation.
+func (m *WebhookMutation) CostThreshold() (r float64, exists bool) {
+	v := m.cost_threshold
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProjectDirectory returns the old "project_directory" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldCostThreshold returns the old "cost_threshold" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldProjectDirectory(ctx context.Context) (v string, err error) {
+func (m *WebhookMutation) OldCostThreshold(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProjectDirectory is only allowed on UpdateOne operations")
+		return v, errors.New("OldCostThreshold is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProjectDirectory requires an ID field in the mutation")
+		return v, errors.New("OldCostThreshold requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProjectDirectory: %w", err)
+		return v, fmt.Errorf("querying old value for OldCostThreshold: %w", err)
 	}
-	return oldValue.ProjectDirectory, nil
+	return oldValue.CostThreshold, nil
 }
 
-// ClearProjectDirectory clears the value of the "project_directory" field.
-func (m *TaskMutation) ClearProjectDirectory() {
-	m.project_directory = nil
-	m.clearedFields[task.FieldProjectDirectory] = struct{}{}
+// AddCostThreshold adds f to the "cost_threshold" field.
+func (m *WebhookMutation) AddCostThreshold(f float64) {
+	if m.addcost_threshold != nil {
+		*m.addcost_threshold += f
+	} else {
+		m.addcost_threshold = &f
+	}
 }
 
-// ProjectDirectoryCleared returns if the "project_directory" field was cleared in this mutation.
-func (m *TaskMutation) ProjectDirectoryCleared() bool {
-	_, ok := m.clearedFields[task.FieldProjectDirectory]
+// AddedCostThreshold returns the value that was added to the "cost_threshold" field in this mutation.
+func (m *WebhookMutation) AddedCostThreshold() (r float64, exists bool) {
+	v := m.addcost_threshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCostThreshold clears the value of the "cost_threshold" field.
+func (m *WebhookMutation) ClearCostThreshold() {
+	m.cost_threshold = nil
+	m.addcost_threshold = nil
+	m.clearedFields[webhook.FieldCostThreshold] = struct{}{}
+}
+
+// CostThresholdCleared returns if the "cost_threshold" field was cleared in this mutation.
+func (m *WebhookMutation) CostThresholdCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldCostThreshold]
 	return ok
 }
 
-// ResetProjectDirectory resets all changes to the "project_directory" field.
-func (m *TaskMutation) ResetProjectDirectory() {
-	m.project_directory = nil
-	delete(m.clearedFields, task.FieldProjectDirectory)
+// ResetCostThreshold resets all changes to the "cost_threshold" field.
+func (m *WebhookMutation) ResetCostThreshold() {
+	m.cost_threshold = nil
+	m.addcost_threshold = nil
+	delete(m.clearedFields, webhook.FieldCostThreshold)
 }
 
-// SetInputTokens sets the "input_tokens" field.
-func (m *TaskMutation) SetInputTokens(i int64) {
-	m.input_tokens = &i
-	m.addinput_tokens = nil
+// SetEnabled sets the "enabled" field.
+func (m *WebhookMutation) SetEnabled(b bool) {
+	m.enabled = &b
 }
 
-// InputTokens returns the value of the "input_tokens" field in the mutation.
-func (m *TaskMutation) InputTokens() (r int64, exists bool) {
-	v := m.input_tokens
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *WebhookMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInputTokens returns the old "input_tokens" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldEnabled returns the old "enabled" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldInputTokens(ctx context.Context) (v int64, err error) {
+func (m *WebhookMutation) OldEnabled(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInputTokens is only allowed on UpdateOne operations")
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInputTokens requires an ID field in the mutation")
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInputTokens: %w", err)
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
 	}
-	return oldValue.InputTokens, nil
+	return oldValue.Enabled, nil
 }
 
-// AddInputTokens adds i to the "input_tokens" field.
-func (m *TaskMutation) AddInputTokens(i int64) {
-	if m.addinput_tokens != nil {
-		*m.addinput_tokens += i
-	} else {
-		m.addinput_tokens = &i
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *WebhookMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// AddDeadLetterIDs adds the "dead_letters" edge to the WebhookDeadLetter entity by ids.
+func (m *WebhookMutation) AddDeadLetterIDs(ids ...uuid.UUID) {
+	if m.dead_letters == nil {
+		m.dead_letters = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.dead_letters[ids[i]] = struct{}{}
 	}
 }
 
-// AddedInputTokens returns the value that was added to the "input_tokens" field in this mutation.
-func (m *TaskMutation) AddedInputTokens() (r int64, exists bool) {
-	v := m.addinput_tokens
-	if v == nil {
-		return
+// ClearDeadLetters clears the "dead_letters" edge to the WebhookDeadLetter entity.
+func (m *WebhookMutation) ClearDeadLetters() {
+	m.cleareddead_letters = true
+}
+
+// DeadLettersCleared reports if the "dead_letters" edge to the WebhookDeadLetter entity was cleared.
+func (m *WebhookMutation) DeadLettersCleared() bool {
+	return m.cleareddead_letters
+}
+
+// RemoveDeadLetterIDs removes the "dead_letters" edge to the WebhookDeadLetter entity by IDs.
+func (m *WebhookMutation) RemoveDeadLetterIDs(ids ...uuid.UUID) {
+	if m.removeddead_letters == nil {
+		m.removeddead_letters = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.dead_letters, ids[i])
+		m.removeddead_letters[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// ClearInputTokens clears the value of the "input_tokens" field.
-func (m *TaskMutation) ClearInputTokens() {
-	m.input_tokens = nil
-	m.addinput_tokens = nil
-	m.clearedFields[task.FieldInputTokens] = struct{}{}
+// RemovedDeadLetters returns the removed IDs of the "dead_letters" edge to the WebhookDeadLetter entity.
+func (m *WebhookMutation) RemovedDeadLettersIDs() (ids []uuid.UUID) {
+	for id := range m.removeddead_letters {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// InputTokensCleared returns if the "input_tokens" field was cleared in this mutation.
-func (m *TaskMutation) InputTokensCleared() bool {
-	_, ok := m.clearedFields[task.FieldInputTokens]
-	return ok
+// DeadLettersIDs returns the "dead_letters" edge IDs in the mutation.
+func (m *WebhookMutation) DeadLettersIDs() (ids []uuid.UUID) {
+	for id := range m.dead_letters {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ResetInputTokens resets all changes to the "input_tokens" field.
-func (m *TaskMutation) ResetInputTokens() {
-	m.input_tokens = nil
-	m.addinput_tokens = nil
-	delete(m.clearedFields, task.FieldInputTokens)
+// ResetDeadLetters resets all changes to the "dead_letters" edge.
+func (m *WebhookMutation) ResetDeadLetters() {
+	m.dead_letters = nil
+	m.cleareddead_letters = false
+	m.removeddead_letters = nil
 }
 
-// SetOutputTokens sets the "output_tokens" field.
-func (m *TaskMutation) SetOutputTokens(i int64) {
-	m.output_tokens = &i
-	m.addoutput_tokens = nil
+// Where appends a list predicates to the WebhookMutation builder.
+func (m *WebhookMutation) Where(ps ...predicate.Webhook) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// OutputTokens returns the value of the "output_tokens" field in the mutation.
-func (m *TaskMutation) OutputTokens() (r int64, exists bool) {
-	v := m.output_tokens
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the WebhookMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *WebhookMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Webhook, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *WebhookMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *WebhookMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Webhook).
+func (m *WebhookMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WebhookMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.create_time != nil {
+		fields = append(fields, webhook.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, webhook.FieldUpdateTime)
+	}
+	if m.name != nil {
+		fields = append(fields, webhook.FieldName)
+	}
+	if m.url != nil {
+		fields = append(fields, webhook.FieldURL)
+	}
+	if m.secret != nil {
+		fields = append(fields, webhook.FieldSecret)
+	}
+	if m.events != nil {
+		fields = append(fields, webhook.FieldEvents)
+	}
+	if m.cost_threshold != nil {
+		fields = append(fields, webhook.FieldCostThreshold)
+	}
+	if m.enabled != nil {
+		fields = append(fields, webhook.FieldEnabled)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WebhookMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case webhook.FieldCreateTime:
+		return m.CreateTime()
+	case webhook.FieldUpdateTime:
+		return m.UpdateTime()
+	case webhook.FieldName:
+		return m.Name()
+	case webhook.FieldURL:
+		return m.URL()
+	case webhook.FieldSecret:
+		return m.Secret()
+	case webhook.FieldEvents:
+		return m.Events()
+	case webhook.FieldCostThreshold:
+		return m.CostThreshold()
+	case webhook.FieldEnabled:
+		return m.Enabled()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WebhookMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case webhook.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case webhook.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case webhook.FieldName:
+		return m.OldName(ctx)
+	case webhook.FieldURL:
+		return m.OldURL(ctx)
+	case webhook.FieldSecret:
+		return m.OldSecret(ctx)
+	case webhook.FieldEvents:
+		return m.OldEvents(ctx)
+	case webhook.FieldCostThreshold:
+		return m.OldCostThreshold(ctx)
+	case webhook.FieldEnabled:
+		return m.OldEnabled(ctx)
+	}
+	return nil, fmt.Errorf("unknown Webhook field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WebhookMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case webhook.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case webhook.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case webhook.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case webhook.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case webhook.FieldSecret:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case webhook.FieldEvents:
+		v, ok := value.([]types.WebhookEventType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvents(v)
+		return nil
+	case webhook.FieldCostThreshold:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCostThreshold(v)
+		return nil
+	case webhook.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Webhook field %s", name)
 }
 
-// OldOutputTokens returns the old "output_tokens" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldOutputTokens(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOutputTokens is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOutputTokens requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOutputTokens: %w", err)
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WebhookMutation) AddedFields() []string {
+	var fields []string
+	if m.addcost_threshold != nil {
+		fields = append(fields, webhook.FieldCostThreshold)
 	}
-	return oldValue.OutputTokens, nil
+	return fields
 }
 
-// AddOutputTokens adds i to the "output_tokens" field.
-func (m *TaskMutation) AddOutputTokens(i int64) {
-	if m.addoutput_tokens != nil {
-		*m.addoutput_tokens += i
-	} else {
-		m.addoutput_tokens = &i
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WebhookMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case webhook.FieldCostThreshold:
+		return m.AddedCostThreshold()
 	}
+	return nil, false
 }
 
-// AddedOutputTokens returns the value that was added to the "output_tokens" field in this mutation.
-func (m *TaskMutation) AddedOutputTokens() (r int64, exists bool) {
-	v := m.addoutput_tokens
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WebhookMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case webhook.FieldCostThreshold:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCostThreshold(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Webhook numeric field %s", name)
 }
 
-// ClearOutputTokens clears the value of the "output_tokens" field.
-func (m *TaskMutation) ClearOutputTokens() {
-	m.output_tokens = nil
-	m.addoutput_tokens = nil
-	m.clearedFields[task.FieldOutputTokens] = struct{}{}
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WebhookMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(webhook.FieldCostThreshold) {
+		fields = append(fields, webhook.FieldCostThreshold)
+	}
+	return fields
 }
 
-// OutputTokensCleared returns if the "output_tokens" field was cleared in this mutation.
-func (m *TaskMutation) OutputTokensCleared() bool {
-	_, ok := m.clearedFields[task.FieldOutputTokens]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WebhookMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetOutputTokens resets all changes to the "output_tokens" field.
-func (m *TaskMutation) ResetOutputTokens() {
-	m.output_tokens = nil
-	m.addoutput_tokens = nil
-	delete(m.clearedFields, task.FieldOutputTokens)
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WebhookMutation) ClearField(name string) error {
+	switch name {
+	case webhook.FieldCostThreshold:
+		m.ClearCostThreshold()
+		return nil
+	}
+	return fmt.Errorf("unknown Webhook nullable field %s", name)
 }
 
-// SetCacheWriteTokens sets the "cache_write_tokens" field.
-func (m *TaskMutation) SetCacheWriteTokens(i int64) {
-	m.cache_write_tokens = &i
-	m.addcache_write_tokens = nil
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WebhookMutation) ResetField(name string) error {
+	switch name {
+	case webhook.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case webhook.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case webhook.FieldName:
+		m.ResetName()
+		return nil
+	case webhook.FieldURL:
+		m.ResetURL()
+		return nil
+	case webhook.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case webhook.FieldEvents:
+		m.ResetEvents()
+		return nil
+	case webhook.FieldCostThreshold:
+		m.ResetCostThreshold()
+		return nil
+	case webhook.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	}
+	return fmt.Errorf("unknown Webhook field %s", name)
 }
 
-// CacheWriteTokens returns the value of the "cache_write_tokens" field in the mutation.
-func (m *TaskMutation) CacheWriteTokens() (r int64, exists bool) {
-	v := m.cache_write_tokens
-	if v == nil {
-		return
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WebhookMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.dead_letters != nil {
+		edges = append(edges, webhook.EdgeDeadLetters)
 	}
-	return *v, true
+	return edges
 }
 
-// OldCacheWriteTokens returns the old "cache_write_tokens" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldCacheWriteTokens(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCacheWriteTokens is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCacheWriteTokens requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCacheWriteTokens: %w", err)
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WebhookMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case webhook.EdgeDeadLetters:
+		ids := make([]ent.Value, 0, len(m.dead_letters))
+		for id := range m.dead_letters {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return oldValue.CacheWriteTokens, nil
+	return nil
 }
 
-// AddCacheWriteTokens adds i to the "cache_write_tokens" field.
-func (m *TaskMutation) AddCacheWriteTokens(i int64) {
-	if m.addcache_write_tokens != nil {
-		*m.addcache_write_tokens += i
-	} else {
-		m.addcache_write_tokens = &i
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WebhookMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removeddead_letters != nil {
+		edges = append(edges, webhook.EdgeDeadLetters)
 	}
+	return edges
 }
 
-// AddedCacheWriteTokens returns the value that was added to the "cache_write_tokens" field in this mutation.
-func (m *TaskMutation) AddedCacheWriteTokens() (r int64, exists bool) {
-	v := m.addcache_write_tokens
-	if v == nil {
-		return
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WebhookMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case webhook.EdgeDeadLetters:
+		ids := make([]ent.Value, 0, len(m.removeddead_letters))
+		for id := range m.removeddead_letters {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return *v, true
+	return nil
 }
 
-// ClearCacheWriteTokens clears the value of the "cache_write_tokens" field.
-func (m *TaskMutation) ClearCacheWriteTokens() {
-	m.cache_write_tokens = nil
-	m.addcache_write_tokens = nil
-	m.clearedFields[task.FieldCacheWriteTokens] = struct{}{}
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WebhookMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddead_letters {
+		edges = append(edges, webhook.EdgeDeadLetters)
+	}
+	return edges
 }
 
-// CacheWriteTokensCleared returns if the "cache_write_tokens" field was cleared in this mutation.
-func (m *TaskMutation) CacheWriteTokensCleared() bool {
-	_, ok := m.clearedFields[task.FieldCacheWriteTokens]
-	return ok
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WebhookMutation) EdgeCleared(name string) bool {
+	switch name {
+	case webhook.EdgeDeadLetters:
+		return m.cleareddead_letters
+	}
+	return false
 }
 
-// ResetCacheWriteTokens resets all changes to the "cache_write_tokens" field.
-func (m *TaskMutation) ResetCacheWriteTokens() {
-	m.cache_write_tokens = nil
-	m.addcache_write_tokens = nil
-	delete(m.clearedFields, task.FieldCacheWriteTokens)
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WebhookMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Webhook unique edge %s", name)
 }
 
-// SetCacheReadTokens sets the "cache_read_tokens" field.
-func (m *TaskMutation) SetCacheReadTokens(i int64) {
-	m.cache_read_tokens = &i
-	m.addcache_read_tokens = nil
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WebhookMutation) ResetEdge(name string) error {
+	switch name {
+	case webhook.EdgeDeadLetters:
+		m.ResetDeadLetters()
+		return nil
+	}
+	return fmt.Errorf("unknown Webhook edge %s", name)
 }
 
-// CacheReadTokens returns the value of the "cache_read_tokens" field in the mutation.
-func (m *TaskMutation) CacheReadTokens() (r int64, exists bool) {
-	v := m.cache_read_tokens
-	if v == nil {
-		return
-	}
-	return *v, true
+// WebhookDeadLetterMutation represents an operation that mutates the WebhookDeadLetter nodes in the graph.
+type WebhookDeadLetterMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	create_time    *time.Time
+	update_time    *time.Time
+	event_type     *types.WebhookEventType
+	payload        *string
+	attempts       *int
+	addattempts    *int
+	last_error     *string
+	task_id        *uuid.UUID
+	clearedFields  map[string]struct{}
+	webhook        *uuid.UUID
+	clearedwebhook bool
+	done           bool
+	oldValue       func(context.Context) (*WebhookDeadLetter, error)
+	predicates     []predicate.WebhookDeadLetter
 }
 
-// OldCacheReadTokens returns the old "cache_read_tokens" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldCacheReadTokens(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCacheReadTokens is only allowed on UpdateOne operations")
+var _ ent.Mutation = (*WebhookDeadLetterMutation)(nil)
+
+// webhookdeadletterOption allows management of the mutation configuration using functional options.
+type webhookdeadletterOption func(*WebhookDeadLetterMutation)
+
+// newWebhookDeadLetterMutation creates new mutation for the WebhookDeadLetter entity.
+func newWebhookDeadLetterMutation(c config, op Op, opts ...webhookdeadletterOption) *WebhookDeadLetterMutation {
+	m := &WebhookDeadLetterMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWebhookDeadLetter,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCacheReadTokens requires an ID field in the mutation")
+	for _, opt := range opts {
+		opt(m)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCacheReadTokens: %w", err)
+	return m
+}
+
+// withWebhookDeadLetterID sets the ID field of the mutation.
+func withWebhookDeadLetterID(id uuid.UUID) webhookdeadletterOption {
+	return func(m *WebhookDeadLetterMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WebhookDeadLetter
+		)
+		m.oldValue = func(ctx context.Context) (*WebhookDeadLetter, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WebhookDeadLetter.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.CacheReadTokens, nil
 }
 
-// AddCacheReadTokens adds i to the "cache_read_tokens" field.
-func (m *TaskMutation) AddCacheReadTokens(i int64) {
-	if m.addcache_read_tokens != nil {
-		*m.addcache_read_tokens += i
-	} else {
-		m.addcache_read_tokens = &i
+// withWebhookDeadLetter sets the old WebhookDeadLetter of the mutation.
+func withWebhookDeadLetter(node *WebhookDeadLetter) webhookdeadletterOption {
+	return func(m *WebhookDeadLetterMutation) {
+		m.oldValue = func(context.Context) (*WebhookDeadLetter, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
 }
 
-// AddedCacheReadTokens returns the value that was added to the "cache_read_tokens" field in this mutation.
-func (m *TaskMutation) AddedCacheReadTokens() (r int64, exists bool) {
-	v := m.addcache_read_tokens
-	if v == nil {
-		return
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WebhookDeadLetterMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WebhookDeadLetterMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("memory: mutation is not running in a transaction")
 	}
-	return *v, true
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearCacheReadTokens clears the value of the "cache_read_tokens" field.
-func (m *TaskMutation) ClearCacheReadTokens() {
-	m.cache_read_tokens = nil
-	m.addcache_read_tokens = nil
-	m.clearedFields[task.FieldCacheReadTokens] = struct{}{}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of WebhookDeadLetter entities.
+func (m *WebhookDeadLetterMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// CacheReadTokensCleared returns if the "cache_read_tokens" field was cleared in this mutation.
-func (m *TaskMutation) CacheReadTokensCleared() bool {
-	_, ok := m.clearedFields[task.FieldCacheReadTokens]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WebhookDeadLetterMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetCacheReadTokens resets all changes to the "cache_read_tokens" field.
-func (m *TaskMutation) ResetCacheReadTokens() {
-	m.cache_read_tokens = nil
-	m.addcache_read_tokens = nil
-	delete(m.clearedFields, task.FieldCacheReadTokens)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WebhookDeadLetterMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WebhookDeadLetter.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetCost sets the "cost" field.
-func (m *TaskMutation) SetCost(f float64) {
-	m.cost = &f
-	m.addcost = nil
+// SetCreateTime sets the "create_time" field.
+func (m *WebhookDeadLetterMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
 }
 
-// Cost returns the value of the "cost" field in the mutation.
-func (m *TaskMutation) Cost() (r float64, exists bool) {
-	v := m.cost
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *WebhookDeadLetterMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCost returns the old "cost" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the WebhookDeadLetter entity.
+// If the WebhookDeadLetter object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldCost(ctx context.Context) (v float64, err error) {
+func (m *WebhookDeadLetterMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCost is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCost requires an ID field in the mutation")
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCost: %w", err)
-	}
-	return oldValue.Cost, nil
-}
-
-// AddCost adds f to the "cost" field.
-func (m *TaskMutation) AddCost(f float64) {
-	if m.addcost != nil {
-		*m.addcost += f
-	} else {
-		m.addcost = &f
-	}
-}
-
-// AddedCost returns the value that was added to the "cost" field in this mutation.
-func (m *TaskMutation) AddedCost() (r float64, exists bool) {
-	v := m.addcost
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
-	return *v, true
-}
-
-// ClearCost clears the value of the "cost" field.
-func (m *TaskMutation) ClearCost() {
-	m.cost = nil
-	m.addcost = nil
-	m.clearedFields[task.FieldCost] = struct{}{}
-}
-
-// CostCleared returns if the "cost" field was cleared in this mutation.
-func (m *TaskMutation) CostCleared() bool {
-	_, ok := m.clearedFields[task.FieldCost]
-	return ok
+	return oldValue.CreateTime, nil
 }
 
-// ResetCost resets all changes to the "cost" field.
-func (m *TaskMutation) ResetCost() {
-	m.cost = nil
-	m.addcost = nil
-	delete(m.clearedFields, task.FieldCost)
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *WebhookDeadLetterMutation) ResetCreateTime() {
+	m.create_time = nil
 }
 
-// SetTurns sets the "turns" field.
-func (m *TaskMutation) SetTurns(i int64) {
-	m.turns = &i
-	m.addturns = nil
+// SetUpdateTime sets the "update_time" field.
+func (m *WebhookDeadLetterMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
 }
 
-// Turns returns the value of the "turns" field in the mutation.
-func (m *TaskMutation) Turns() (r int64, exists bool) {
-	v := m.turns
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *WebhookDeadLetterMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTurns returns the old "turns" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the WebhookDeadLetter entity.
+// If the WebhookDeadLetter object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldTurns(ctx context.Context) (v int64, err error) {
+func (m *WebhookDeadLetterMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTurns is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTurns requires an ID field in the mutation")
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTurns: %w", err)
-	}
-	return oldValue.Turns, nil
-}
-
-// AddTurns adds i to the "turns" field.
-func (m *TaskMutation) AddTurns(i int64) {
-	if m.addturns != nil {
-		*m.addturns += i
-	} else {
-		m.addturns = &i
-	}
-}
-
-// AddedTurns returns the value that was added to the "turns" field in this mutation.
-func (m *TaskMutation) AddedTurns() (r int64, exists bool) {
-	v := m.addturns
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
 	}
-	return *v, true
+	return oldValue.UpdateTime, nil
 }
 
-// ResetTurns resets all changes to the "turns" field.
-func (m *TaskMutation) ResetTurns() {
-	m.turns = nil
-	m.addturns = nil
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *WebhookDeadLetterMutation) ResetUpdateTime() {
+	m.update_time = nil
 }
 
-// SetToolUses sets the "tool_uses" field.
-func (m *TaskMutation) SetToolUses(value map[string]int64) {
-	m.tool_uses = &value
+// SetEventType sets the "event_type" field.
+func (m *WebhookDeadLetterMutation) SetEventType(tet types.WebhookEventType) {
+	m.event_type = &tet
 }
 
-// ToolUses returns the value of the "tool_uses" field in the mutation.
-func (m *TaskMutation) ToolUses() (r map[string]int64, exists bool) {
-	v := m.tool_uses
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *WebhookDeadLetterMutation) EventType() (r types.WebhookEventType, exists bool) {
+	v := m.event_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldToolUses returns the old "tool_uses" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldEventType returns the old "event_type" field's value of the WebhookDeadLetter entity.
+// If the WebhookDeadLetter object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldToolUses(ctx context.Context) (v map[string]int64, err error) {
+func (m *WebhookDeadLetterMutation) OldEventType(ctx context.Context) (v types.WebhookEventType, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToolUses is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToolUses requires an ID field in the mutation")
+		return v, errors.New("OldEventType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToolUses: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
 	}
-	return oldValue.ToolUses, nil
+	return oldValue.EventType, nil
 }
 
-// ResetToolUses resets all changes to the "tool_uses" field.
-func (m *TaskMutation) ResetToolUses() {
-	m.tool_uses = nil
+// ResetEventType resets all changes to the "event_type" field.
+func (m *WebhookDeadLetterMutation) ResetEventType() {
+	m.event_type = nil
 }
 
-// SetDesiredPhase sets the "desired_phase" field.
-func (m *TaskMutation) SetDesiredPhase(tp types.TaskPhase) {
-	m.desired_phase = &tp
+// SetPayload sets the "payload" field.
+func (m *WebhookDeadLetterMutation) SetPayload(s string) {
+	m.payload = &s
 }
 
-// DesiredPhase returns the value of the "desired_phase" field in the mutation.
-func (m *TaskMutation) DesiredPhase() (r types.TaskPhase, exists bool) {
-	v := m.desired_phase
+// Payload returns the value of the "payload" field in the mutation.
+func (m *WebhookDeadLetterMutation) Payload() (r string, exists bool) {
+	v := m.payload
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDesiredPhase returns the old "desired_phase" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldPayload returns the old "payload" field's value of the WebhookDeadLetter entity.
+// If the WebhookDeadLetter object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldDesiredPhase(ctx context.Context) (v types.TaskPhase, err error) {
+func (m *WebhookDeadLetterMutation) OldPayload(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDesiredPhase is only allowed on UpdateOne operations")
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDesiredPhase requires an ID field in the mutation")
+		return v, errors.New("OldPayload requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDesiredPhase: %w", err)
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
 	}
-	return oldValue.DesiredPhase, nil
+	return oldValue.Payload, nil
 }
 
-// ResetDesiredPhase resets all changes to the "desired_phase" field.
-func (m *TaskMutation) ResetDesiredPhase() {
-	m.desired_phase = nil
+// ResetPayload resets all changes to the "payload" field.
+func (m *WebhookDeadLetterMutation) ResetPayload() {
+	m.payload = nil
 }
 
-// SetPhase sets the "phase" field.
-func (m *TaskMutation) SetPhase(tp types.TaskPhase) {
-	m.phase = &tp
+// SetAttempts sets the "attempts" field.
+func (m *WebhookDeadLetterMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
 }
 
-// Phase returns the value of the "phase" field in the mutation.
-func (m *TaskMutation) Phase() (r types.TaskPhase, exists bool) {
-	v := m.phase
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *WebhookDeadLetterMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPhase returns the old "phase" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldAttempts returns the old "attempts" field's value of the WebhookDeadLetter entity.
+// If the WebhookDeadLetter object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldPhase(ctx context.Context) (v types.TaskPhase, err error) {
+func (m *WebhookDeadLetterMutation) OldAttempts(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPhase is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPhase requires an ID field in the mutation")
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPhase: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
 	}
-	return oldValue.Phase, nil
+	return oldValue.Attempts, nil
 }
 
-// ResetPhase resets all changes to the "phase" field.
-func (m *TaskMutation) ResetPhase() {
-	m.phase = nil
+// AddAttempts adds i to the "attempts" field.
+func (m *WebhookDeadLetterMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
 }
 
-// SetDescription sets the "description" field.
-func (m *TaskMutation) SetDescription(s string) {
-	m.description = &s
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *WebhookDeadLetterMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *TaskMutation) Description() (r string, exists bool) {
-	v := m.description
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *WebhookDeadLetterMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetLastError sets the "last_error" field.
+func (m *WebhookDeadLetterMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *WebhookDeadLetterMutation) LastError() (r string, exists bool) {
+	v := m.last_error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldLastError returns the old "last_error" field's value of the WebhookDeadLetter entity.
+// If the WebhookDeadLetter object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *WebhookDeadLetterMutation) OldLastError(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldLastError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *TaskMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[task.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *TaskMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[task.FieldDescription]
-	return ok
-}
-
-// ResetDescription resets all changes to the "description" field.
-func (m *TaskMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, task.FieldDescription)
+	return oldValue.LastError, nil
 }
 
-// SetAgentID sets the "agent_id" field.
-func (m *TaskMutation) SetAgentID(u uuid.UUID) {
-	m.agent = &u
+// ResetLastError resets all changes to the "last_error" field.
+func (m *WebhookDeadLetterMutation) ResetLastError() {
+	m.last_error = nil
 }
 
-// AgentID returns the value of the "agent_id" field in the mutation.
-func (m *TaskMutation) AgentID() (r uuid.UUID, exists bool) {
-	v := m.agent
+// SetWebhookID sets the "webhook_id" field.
+func (m *WebhookDeadLetterMutation) SetWebhookID(u uuid.UUID) {
+	m.webhook = &u
+}
+
+// WebhookID returns the value of the "webhook_id" field in the mutation.
+func (m *WebhookDeadLetterMutation) WebhookID() (r uuid.UUID, exists bool) {
+	v := m.webhook
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAgentID returns the old "agent_id" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldWebhookID returns the old "webhook_id" field's value of the WebhookDeadLetter entity.
+// If the WebhookDeadLetter object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldAgentID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *WebhookDeadLetterMutation) OldWebhookID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAgentID is only allowed on UpdateOne operations")
+		return v, errors.New("OldWebhookID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAgentID requires an ID field in the mutation")
+		return v, errors.New("OldWebhookID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAgentID: %w", err)
+		return v, fmt.Errorf("querying old value for OldWebhookID: %w", err)
 	}
-	return oldValue.AgentID, nil
-}
-
-// ClearAgentID clears the value of the "agent_id" field.
-func (m *TaskMutation) ClearAgentID() {
-	m.agent = nil
-	m.clearedFields[task.FieldAgentID] = struct{}{}
+	return oldValue.WebhookID, nil
 }
 
-// AgentIDCleared returns if the "agent_id" field was cleared in this mutation.
-func (m *TaskMutation) AgentIDCleared() bool {
-	_, ok := m.clearedFields[task.FieldAgentID]
-	return ok
+// ResetWebhookID resets all changes to the "webhook_id" field.
+func (m *WebhookDeadLetterMutation) ResetWebhookID() {
+	m.webhook = nil
 }
 
-// ResetAgentID resets all changes to the "agent_id" field.
-func (m *TaskMutation) ResetAgentID() {
-	m.agent = nil
-	delete(m.clearedFields, task.FieldAgentID)
+// SetTaskID sets the "task_id" field.
+func (m *WebhookDeadLetterMutation) SetTaskID(u uuid.UUID) {
+	m.task_id = &u
 }
 
-// AddMessageIDs adds the "messages" edge to the Message entity by ids.
-func (m *TaskMutation) AddMessageIDs(ids ...uuid.UUID) {
-	if m.messages == nil {
-		m.messages = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.messages[ids[i]] = struct{}{}
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *WebhookDeadLetterMutation) TaskID() (r uuid.UUID, exists bool) {
+	v := m.task_id
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearMessages clears the "messages" edge to the Message entity.
-func (m *TaskMutation) ClearMessages() {
-	m.clearedmessages = true
-}
-
-// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
-func (m *TaskMutation) MessagesCleared() bool {
-	return m.clearedmessages
-}
-
-// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
-func (m *TaskMutation) RemoveMessageIDs(ids ...uuid.UUID) {
-	if m.removedmessages == nil {
-		m.removedmessages = make(map[uuid.UUID]struct{})
+// OldTaskID returns the old "task_id" field's value of the WebhookDeadLetter entity.
+// If the WebhookDeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeadLetterMutation) OldTaskID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.messages, ids[i])
-		m.removedmessages[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
 	}
+	return oldValue.TaskID, nil
 }
 
-// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
-func (m *TaskMutation) RemovedMessagesIDs() (ids []uuid.UUID) {
-	for id := range m.removedmessages {
-		ids = append(ids, id)
-	}
-	return
+// ClearTaskID clears the value of the "task_id" field.
+func (m *WebhookDeadLetterMutation) ClearTaskID() {
+	m.task_id = nil
+	m.clearedFields[webhookdeadletter.FieldTaskID] = struct{}{}
 }
 
-// MessagesIDs returns the "messages" edge IDs in the mutation.
-func (m *TaskMutation) MessagesIDs() (ids []uuid.UUID) {
-	for id := range m.messages {
-		ids = append(ids, id)
-	}
-	return
+// TaskIDCleared returns if the "task_id" field was cleared in this mutation.
+func (m *WebhookDeadLetterMutation) TaskIDCleared() bool {
+	_, ok := m.clearedFields[webhookdeadletter.FieldTaskID]
+	return ok
 }
 
-// ResetMessages resets all changes to the "messages" edge.
-func (m *TaskMutation) ResetMessages() {
-	m.messages = nil
-	m.clearedmessages = false
-	m.removedmessages = nil
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *WebhookDeadLetterMutation) ResetTaskID() {
+	m.task_id = nil
+	delete(m.clearedFields, webhookdeadletter.FieldTaskID)
 }
 
-// ClearAgent clears the "agent" edge to the Agent entity.
-func (m *TaskMutation) ClearAgent() {
-	m.clearedagent = true
-	m.clearedFields[task.FieldAgentID] = struct{}{}
+// ClearWebhook clears the "webhook" edge to the Webhook entity.
+func (m *WebhookDeadLetterMutation) ClearWebhook() {
+	m.clearedwebhook = true
+	m.clearedFields[webhookdeadletter.FieldWebhookID] = struct{}{}
 }
 
-// AgentCleared reports if the "agent" edge to the Agent entity was cleared.
-func (m *TaskMutation) AgentCleared() bool {
-	return m.AgentIDCleared() || m.clearedagent
+// WebhookCleared reports if the "webhook" edge to the Webhook entity was cleared.
+func (m *WebhookDeadLetterMutation) WebhookCleared() bool {
+	return m.clearedwebhook
 }
 
-// AgentIDs returns the "agent" edge IDs in the mutation.
+// WebhookIDs returns the "webhook" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// AgentID instead. It exists only for internal usage by the builders.
-func (m *TaskMutation) AgentIDs() (ids []uuid.UUID) {
-	if id := m.agent; id != nil {
+// WebhookID instead. It exists only for internal usage by the builders.
+func (m *WebhookDeadLetterMutation) WebhookIDs() (ids []uuid.UUID) {
+	if id := m.webhook; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetAgent resets all changes to the "agent" edge.
-func (m *TaskMutation) ResetAgent() {
-	m.agent = nil
-	m.clearedagent = false
+// ResetWebhook resets all changes to the "webhook" edge.
+func (m *WebhookDeadLetterMutation) ResetWebhook() {
+	m.webhook = nil
+	m.clearedwebhook = false
 }
 
-// Where appends a list predicates to the TaskMutation builder.
-func (m *TaskMutation) Where(ps ...predicate.Task) {
+// Where appends a list predicates to the WebhookDeadLetterMutation builder.
+func (m *WebhookDeadLetterMutation) Where(ps ...predicate.WebhookDeadLetter) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TaskMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookDeadLetterMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TaskMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Task, len(ps))
+func (m *WebhookDeadLetterMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookDeadLetter, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5060,66 +12940,48 @@ func (m *TaskMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TaskMutation) Op() Op {
+func (m *WebhookDeadLetterMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TaskMutation) SetOp(op Op) {
+func (m *WebhookDeadLetterMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Task).
-func (m *TaskMutation) Type() string {
+// Type returns the node type of this mutation (WebhookDeadLetter).
+func (m *WebhookDeadLetterMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TaskMutation) Fields() []string {
-	fields := make([]string, 0, 14)
+func (m *WebhookDeadLetterMutation) Fields() []string {
+	fields := make([]string, 0, 8)
 	if m.create_time != nil {
-		fields = append(fields, task.FieldCreateTime)
+		fields = append(fields, webhookdeadletter.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, task.FieldUpdateTime)
-	}
-	if m.project_directory != nil {
-		fields = append(fields, task.FieldProjectDirectory)
+		fields = append(fields, webhookdeadletter.FieldUpdateTime)
 	}
-	if m.input_tokens != nil {
-		fields = append(fields, task.FieldInputTokens)
-	}
-	if m.output_tokens != nil {
-		fields = append(fields, task.FieldOutputTokens)
-	}
-	if m.cache_write_tokens != nil {
-		fields = append(fields, task.FieldCacheWriteTokens)
-	}
-	if m.cache_read_tokens != nil {
-		fields = append(fields, task.FieldCacheReadTokens)
-	}
-	if m.cost != nil {
-		fields = append(fields, task.FieldCost)
-	}
-	if m.turns != nil {
-		fields = append(fields, task.FieldTurns)
+	if m.event_type != nil {
+		fields = append(fields, webhookdeadletter.FieldEventType)
 	}
-	if m.tool_uses != nil {
-		fields = append(fields, task.FieldToolUses)
+	if m.payload != nil {
+		fields = append(fields, webhookdeadletter.FieldPayload)
 	}
-	if m.desired_phase != nil {
-		fields = append(fields, task.FieldDesiredPhase)
+	if m.attempts != nil {
+		fields = append(fields, webhookdeadletter.FieldAttempts)
 	}
-	if m.phase != nil {
-		fields = append(fields, task.FieldPhase)
+	if m.last_error != nil {
+		fields = append(fields, webhookdeadletter.FieldLastError)
 	}
-	if m.description != nil {
-		fields = append(fields, task.FieldDescription)
+	if m.webhook != nil {
+		fields = append(fields, webhookdeadletter.FieldWebhookID)
 	}
-	if m.agent != nil {
-		fields = append(fields, task.FieldAgentID)
+	if m.task_id != nil {
+		fields = append(fields, webhookdeadletter.FieldTaskID)
 	}
 	return fields
 }
@@ -5127,205 +12989,124 @@ func (m *TaskMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TaskMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookDeadLetterMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case task.FieldCreateTime:
+	case webhookdeadletter.FieldCreateTime:
 		return m.CreateTime()
-	case task.FieldUpdateTime:
+	case webhookdeadletter.FieldUpdateTime:
 		return m.UpdateTime()
-	case task.FieldProjectDirectory:
-		return m.ProjectDirectory()
-	case task.FieldInputTokens:
-		return m.InputTokens()
-	case task.FieldOutputTokens:
-		return m.OutputTokens()
-	case task.FieldCacheWriteTokens:
-		return m.CacheWriteTokens()
-	case task.FieldCacheReadTokens:
-		return m.CacheReadTokens()
-	case task.FieldCost:
-		return m.Cost()
-	case task.FieldTurns:
-		return m.Turns()
-	case task.FieldToolUses:
-		return m.ToolUses()
-	case task.FieldDesiredPhase:
-		return m.DesiredPhase()
-	case task.FieldPhase:
-		return m.Phase()
-	case task.FieldDescription:
-		return m.Description()
-	case task.FieldAgentID:
-		return m.AgentID()
-	}
-	return nil, false
-}
-
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *TaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case task.FieldCreateTime:
-		return m.OldCreateTime(ctx)
-	case task.FieldUpdateTime:
-		return m.OldUpdateTime(ctx)
-	case task.FieldProjectDirectory:
-		return m.OldProjectDirectory(ctx)
-	case task.FieldInputTokens:
-		return m.OldInputTokens(ctx)
-	case task.FieldOutputTokens:
-		return m.OldOutputTokens(ctx)
-	case task.FieldCacheWriteTokens:
-		return m.OldCacheWriteTokens(ctx)
-	case task.FieldCacheReadTokens:
-		return m.OldCacheReadTokens(ctx)
-	case task.FieldCost:
-		return m.OldCost(ctx)
-	case task.FieldTurns:
-		return m.OldTurns(ctx)
-	case task.FieldToolUses:
-		return m.OldToolUses(ctx)
-	case task.FieldDesiredPhase:
-		return m.OldDesiredPhase(ctx)
-	case task.FieldPhase:
-		return m.OldPhase(ctx)
-	case task.FieldDescription:
-		return m.OldDescription(ctx)
-	case task.FieldAgentID:
-		return m.OldAgentID(ctx)
+	case webhookdeadletter.FieldEventType:
+		return m.EventType()
+	case webhookdeadletter.FieldPayload:
+		return m.Payload()
+	case webhookdeadletter.FieldAttempts:
+		return m.Attempts()
+	case webhookdeadletter.FieldLastError:
+		return m.LastError()
+	case webhookdeadletter.FieldWebhookID:
+		return m.WebhookID()
+	case webhookdeadletter.FieldTaskID:
+		return m.TaskID()
 	}
-	return nil, fmt.Errorf("unknown Task field %s", name)
-}
-
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *TaskMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case task.FieldCreateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreateTime(v)
-		return nil
-	case task.FieldUpdateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdateTime(v)
-		return nil
-	case task.FieldProjectDirectory:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProjectDirectory(v)
-		return nil
-	case task.FieldInputTokens:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetInputTokens(v)
-		return nil
-	case task.FieldOutputTokens:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOutputTokens(v)
-		return nil
-	case task.FieldCacheWriteTokens:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCacheWriteTokens(v)
-		return nil
-	case task.FieldCacheReadTokens:
-		v, ok := value.(int64)
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WebhookDeadLetterMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case webhookdeadletter.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case webhookdeadletter.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case webhookdeadletter.FieldEventType:
+		return m.OldEventType(ctx)
+	case webhookdeadletter.FieldPayload:
+		return m.OldPayload(ctx)
+	case webhookdeadletter.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case webhookdeadletter.FieldLastError:
+		return m.OldLastError(ctx)
+	case webhookdeadletter.FieldWebhookID:
+		return m.OldWebhookID(ctx)
+	case webhookdeadletter.FieldTaskID:
+		return m.OldTaskID(ctx)
+	}
+	return nil, fmt.Errorf("unknown WebhookDeadLetter field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WebhookDeadLetterMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case webhookdeadletter.FieldCreateTime:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCacheReadTokens(v)
+		m.SetCreateTime(v)
 		return nil
-	case task.FieldCost:
-		v, ok := value.(float64)
+	case webhookdeadletter.FieldUpdateTime:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCost(v)
+		m.SetUpdateTime(v)
 		return nil
-	case task.FieldTurns:
-		v, ok := value.(int64)
+	case webhookdeadletter.FieldEventType:
+		v, ok := value.(types.WebhookEventType)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTurns(v)
+		m.SetEventType(v)
 		return nil
-	case task.FieldToolUses:
-		v, ok := value.(map[string]int64)
+	case webhookdeadletter.FieldPayload:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetToolUses(v)
+		m.SetPayload(v)
 		return nil
-	case task.FieldDesiredPhase:
-		v, ok := value.(types.TaskPhase)
+	case webhookdeadletter.FieldAttempts:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDesiredPhase(v)
+		m.SetAttempts(v)
 		return nil
-	case task.FieldPhase:
-		v, ok := value.(types.TaskPhase)
+	case webhookdeadletter.FieldLastError:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPhase(v)
+		m.SetLastError(v)
 		return nil
-	case task.FieldDescription:
-		v, ok := value.(string)
+	case webhookdeadletter.FieldWebhookID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetWebhookID(v)
 		return nil
-	case task.FieldAgentID:
+	case webhookdeadletter.FieldTaskID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAgentID(v)
+		m.SetTaskID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Task field %s", name)
+	return fmt.Errorf("unknown WebhookDeadLetter field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TaskMutation) AddedFields() []string {
+func (m *WebhookDeadLetterMutation) AddedFields() []string {
 	var fields []string
-	if m.addinput_tokens != nil {
-		fields = append(fields, task.FieldInputTokens)
-	}
-	if m.addoutput_tokens != nil {
-		fields = append(fields, task.FieldOutputTokens)
-	}
-	if m.addcache_write_tokens != nil {
-		fields = append(fields, task.FieldCacheWriteTokens)
-	}
-	if m.addcache_read_tokens != nil {
-		fields = append(fields, task.FieldCacheReadTokens)
-	}
-	if m.addcost != nil {
-		fields = append(fields, task.FieldCost)
-	}
-	if m.addturns != nil {
-		fields = append(fields, task.FieldTurns)
+	if m.addattempts != nil {
+		fields = append(fields, webhookdeadletter.FieldAttempts)
 	}
 	return fields
 }
@@ -5333,20 +13114,10 @@ func (m *TaskMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TaskMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookDeadLetterMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case task.FieldInputTokens:
-		return m.AddedInputTokens()
-	case task.FieldOutputTokens:
-		return m.AddedOutputTokens()
-	case task.FieldCacheWriteTokens:
-		return m.AddedCacheWriteTokens()
-	case task.FieldCacheReadTokens:
-		return m.AddedCacheReadTokens()
-	case task.FieldCost:
-		return m.AddedCost()
-	case task.FieldTurns:
-		return m.AddedTurns()
+	case webhookdeadletter.FieldAttempts:
+		return m.AddedAttempts()
 	}
 	return nil, false
 }
@@ -5354,198 +13125,94 @@ func (m *TaskMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TaskMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookDeadLetterMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case task.FieldInputTokens:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddInputTokens(v)
-		return nil
-	case task.FieldOutputTokens:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddOutputTokens(v)
-		return nil
-	case task.FieldCacheWriteTokens:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCacheWriteTokens(v)
-		return nil
-	case task.FieldCacheReadTokens:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCacheReadTokens(v)
-		return nil
-	case task.FieldCost:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCost(v)
-		return nil
-	case task.FieldTurns:
-		v, ok := value.(int64)
+	case webhookdeadletter.FieldAttempts:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTurns(v)
+		m.AddAttempts(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Task numeric field %s", name)
+	return fmt.Errorf("unknown WebhookDeadLetter numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TaskMutation) ClearedFields() []string {
+func (m *WebhookDeadLetterMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(task.FieldProjectDirectory) {
-		fields = append(fields, task.FieldProjectDirectory)
-	}
-	if m.FieldCleared(task.FieldInputTokens) {
-		fields = append(fields, task.FieldInputTokens)
-	}
-	if m.FieldCleared(task.FieldOutputTokens) {
-		fields = append(fields, task.FieldOutputTokens)
-	}
-	if m.FieldCleared(task.FieldCacheWriteTokens) {
-		fields = append(fields, task.FieldCacheWriteTokens)
-	}
-	if m.FieldCleared(task.FieldCacheReadTokens) {
-		fields = append(fields, task.FieldCacheReadTokens)
-	}
-	if m.FieldCleared(task.FieldCost) {
-		fields = append(fields, task.FieldCost)
-	}
-	if m.FieldCleared(task.FieldDescription) {
-		fields = append(fields, task.FieldDescription)
-	}
-	if m.FieldCleared(task.FieldAgentID) {
-		fields = append(fields, task.FieldAgentID)
+	if m.FieldCleared(webhookdeadletter.FieldTaskID) {
+		fields = append(fields, webhookdeadletter.FieldTaskID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TaskMutation) FieldCleared(name string) bool {
+func (m *WebhookDeadLetterMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TaskMutation) ClearField(name string) error {
+func (m *WebhookDeadLetterMutation) ClearField(name string) error {
 	switch name {
-	case task.FieldProjectDirectory:
-		m.ClearProjectDirectory()
-		return nil
-	case task.FieldInputTokens:
-		m.ClearInputTokens()
-		return nil
-	case task.FieldOutputTokens:
-		m.ClearOutputTokens()
-		return nil
-	case task.FieldCacheWriteTokens:
-		m.ClearCacheWriteTokens()
-		return nil
-	case task.FieldCacheReadTokens:
-		m.ClearCacheReadTokens()
-		return nil
-	case task.FieldCost:
-		m.ClearCost()
-		return nil
-	case task.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case task.FieldAgentID:
-		m.ClearAgentID()
+	case webhookdeadletter.FieldTaskID:
+		m.ClearTaskID()
 		return nil
 	}
-	return fmt.Errorf("unknown Task nullable field %s", name)
+	return fmt.Errorf("unknown WebhookDeadLetter nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TaskMutation) ResetField(name string) error {
+func (m *WebhookDeadLetterMutation) ResetField(name string) error {
 	switch name {
-	case task.FieldCreateTime:
+	case webhookdeadletter.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case task.FieldUpdateTime:
+	case webhookdeadletter.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case task.FieldProjectDirectory:
-		m.ResetProjectDirectory()
-		return nil
-	case task.FieldInputTokens:
-		m.ResetInputTokens()
-		return nil
-	case task.FieldOutputTokens:
-		m.ResetOutputTokens()
-		return nil
-	case task.FieldCacheWriteTokens:
-		m.ResetCacheWriteTokens()
-		return nil
-	case task.FieldCacheReadTokens:
-		m.ResetCacheReadTokens()
-		return nil
-	case task.FieldCost:
-		m.ResetCost()
-		return nil
-	case task.FieldTurns:
-		m.ResetTurns()
+	case webhookdeadletter.FieldEventType:
+		m.ResetEventType()
 		return nil
-	case task.FieldToolUses:
-		m.ResetToolUses()
+	case webhookdeadletter.FieldPayload:
+		m.ResetPayload()
 		return nil
-	case task.FieldDesiredPhase:
-		m.ResetDesiredPhase()
+	case webhookdeadletter.FieldAttempts:
+		m.ResetAttempts()
 		return nil
-	case task.FieldPhase:
-		m.ResetPhase()
+	case webhookdeadletter.FieldLastError:
+		m.ResetLastError()
 		return nil
-	case task.FieldDescription:
-		m.ResetDescription()
+	case webhookdeadletter.FieldWebhookID:
+		m.ResetWebhookID()
 		return nil
-	case task.FieldAgentID:
-		m.ResetAgentID()
+	case webhookdeadletter.FieldTaskID:
+		m.ResetTaskID()
 		return nil
 	}
-	return fmt.Errorf("unknown Task field %s", name)
+	return fmt.Errorf("unknown WebhookDeadLetter field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TaskMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.messages != nil {
-		edges = append(edges, task.EdgeMessages)
-	}
-	if m.agent != nil {
-		edges = append(edges, task.EdgeAgent)
+func (m *WebhookDeadLetterMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.webhook != nil {
+		edges = append(edges, webhookdeadletter.EdgeWebhook)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TaskMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookDeadLetterMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case task.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.messages))
-		for id := range m.messages {
-			ids = append(ids, id)
-		}
-		return ids
-	case task.EdgeAgent:
-		if id := m.agent; id != nil {
+	case webhookdeadletter.EdgeWebhook:
+		if id := m.webhook; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -5553,73 +13220,54 @@ func (m *TaskMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TaskMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedmessages != nil {
-		edges = append(edges, task.EdgeMessages)
-	}
+func (m *WebhookDeadLetterMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TaskMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case task.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.removedmessages))
-		for id := range m.removedmessages {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *WebhookDeadLetterMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TaskMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedmessages {
-		edges = append(edges, task.EdgeMessages)
-	}
-	if m.clearedagent {
-		edges = append(edges, task.EdgeAgent)
+func (m *WebhookDeadLetterMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedwebhook {
+		edges = append(edges, webhookdeadletter.EdgeWebhook)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TaskMutation) EdgeCleared(name string) bool {
+func (m *WebhookDeadLetterMutation) EdgeCleared(name string) bool {
 	switch name {
-	case task.EdgeMessages:
-		return m.clearedmessages
-	case task.EdgeAgent:
-		return m.clearedagent
+	case webhookdeadletter.EdgeWebhook:
+		return m.clearedwebhook
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TaskMutation) ClearEdge(name string) error {
+func (m *WebhookDeadLetterMutation) ClearEdge(name string) error {
 	switch name {
-	case task.EdgeAgent:
-		m.ClearAgent()
+	case webhookdeadletter.EdgeWebhook:
+		m.ClearWebhook()
 		return nil
 	}
-	return fmt.Errorf("unknown Task unique edge %s", name)
+	return fmt.Errorf("unknown WebhookDeadLetter unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TaskMutation) ResetEdge(name string) error {
+func (m *WebhookDeadLetterMutation) ResetEdge(name string) error {
 	switch name {
-	case task.EdgeMessages:
-		m.ResetMessages()
-		return nil
-	case task.EdgeAgent:
-		m.ResetAgent()
+	case webhookdeadletter.EdgeWebhook:
+		m.ResetWebhook()
 		return nil
 	}
-	return fmt.Errorf("unknown Task edge %s", name)
+	return fmt.Errorf("unknown WebhookDeadLetter edge %s", name)
 }
@@ -9,6 +9,9 @@ import (
 // Agent is the predicate function for agent builders.
 type Agent func(*sql.Selector)
 
+// AuthToken is the predicate function for authtoken builders.
+type AuthToken func(*sql.Selector)
+
 // Message is the predicate function for message builders.
 type Message func(*sql.Selector)
 
@@ -18,5 +21,23 @@ type Model func(*sql.Selector)
 // ModelProvider is the predicate function for modelprovider builders.
 type ModelProvider func(*sql.Selector)
 
+// Schedule is the predicate function for schedule builders.
+type Schedule func(*sql.Selector)
+
+// ScheduleRun is the predicate function for schedulerun builders.
+type ScheduleRun func(*sql.Selector)
+
 // Task is the predicate function for task builders.
 type Task func(*sql.Selector)
+
+// Tool is the predicate function for tool builders.
+type Tool func(*sql.Selector)
+
+// User is the predicate function for user builders.
+type User func(*sql.Selector)
+
+// Webhook is the predicate function for webhook builders.
+type Webhook func(*sql.Selector)
+
+// WebhookDeadLetter is the predicate function for webhookdeadletter builders.
+type WebhookDeadLetter func(*sql.Selector)
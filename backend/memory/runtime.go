@@ -6,11 +6,18 @@ import (
 	"time"
 
 	"github.com/furisto/construct/backend/memory/agent"
+	"github.com/furisto/construct/backend/memory/authtoken"
 	"github.com/furisto/construct/backend/memory/message"
 	"github.com/furisto/construct/backend/memory/model"
 	"github.com/furisto/construct/backend/memory/modelprovider"
+	"github.com/furisto/construct/backend/memory/schedule"
+	"github.com/furisto/construct/backend/memory/schedulerun"
 	"github.com/furisto/construct/backend/memory/schema"
 	"github.com/furisto/construct/backend/memory/task"
+	"github.com/furisto/construct/backend/memory/tool"
+	"github.com/furisto/construct/backend/memory/user"
+	"github.com/furisto/construct/backend/memory/webhook"
+	"github.com/furisto/construct/backend/memory/webhookdeadletter"
 	"github.com/google/uuid"
 )
 
@@ -45,6 +52,33 @@ func init() {
 	agentDescID := agentFields[0].Descriptor()
 	// agent.DefaultID holds the default value on creation for the id field.
 	agent.DefaultID = agentDescID.Default.(func() uuid.UUID)
+	authtokenMixin := schema.AuthToken{}.Mixin()
+	authtokenMixinFields0 := authtokenMixin[0].Fields()
+	_ = authtokenMixinFields0
+	authtokenFields := schema.AuthToken{}.Fields()
+	_ = authtokenFields
+	// authtokenDescCreateTime is the schema descriptor for create_time field.
+	authtokenDescCreateTime := authtokenMixinFields0[0].Descriptor()
+	// authtoken.DefaultCreateTime holds the default value on creation for the create_time field.
+	authtoken.DefaultCreateTime = authtokenDescCreateTime.Default.(func() time.Time)
+	// authtokenDescUpdateTime is the schema descriptor for update_time field.
+	authtokenDescUpdateTime := authtokenMixinFields0[1].Descriptor()
+	// authtoken.DefaultUpdateTime holds the default value on creation for the update_time field.
+	authtoken.DefaultUpdateTime = authtokenDescUpdateTime.Default.(func() time.Time)
+	// authtoken.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	authtoken.UpdateDefaultUpdateTime = authtokenDescUpdateTime.UpdateDefault.(func() time.Time)
+	// authtokenDescName is the schema descriptor for name field.
+	authtokenDescName := authtokenFields[1].Descriptor()
+	// authtoken.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	authtoken.NameValidator = authtokenDescName.Validators[0].(func(string) error)
+	// authtokenDescTokenHash is the schema descriptor for token_hash field.
+	authtokenDescTokenHash := authtokenFields[2].Descriptor()
+	// authtoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	authtoken.TokenHashValidator = authtokenDescTokenHash.Validators[0].(func([]byte) error)
+	// authtokenDescID is the schema descriptor for id field.
+	authtokenDescID := authtokenFields[0].Descriptor()
+	// authtoken.DefaultID holds the default value on creation for the id field.
+	authtoken.DefaultID = authtokenDescID.Default.(func() uuid.UUID)
 	messageMixin := schema.Message{}.Mixin()
 	messageMixinFields0 := messageMixin[0].Fields()
 	_ = messageMixinFields0
@@ -142,6 +176,72 @@ func init() {
 	modelproviderDescID := modelproviderFields[0].Descriptor()
 	// modelprovider.DefaultID holds the default value on creation for the id field.
 	modelprovider.DefaultID = modelproviderDescID.Default.(func() uuid.UUID)
+	scheduleMixin := schema.Schedule{}.Mixin()
+	scheduleMixinFields0 := scheduleMixin[0].Fields()
+	_ = scheduleMixinFields0
+	scheduleFields := schema.Schedule{}.Fields()
+	_ = scheduleFields
+	// scheduleDescCreateTime is the schema descriptor for create_time field.
+	scheduleDescCreateTime := scheduleMixinFields0[0].Descriptor()
+	// schedule.DefaultCreateTime holds the default value on creation for the create_time field.
+	schedule.DefaultCreateTime = scheduleDescCreateTime.Default.(func() time.Time)
+	// scheduleDescUpdateTime is the schema descriptor for update_time field.
+	scheduleDescUpdateTime := scheduleMixinFields0[1].Descriptor()
+	// schedule.DefaultUpdateTime holds the default value on creation for the update_time field.
+	schedule.DefaultUpdateTime = scheduleDescUpdateTime.Default.(func() time.Time)
+	// schedule.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	schedule.UpdateDefaultUpdateTime = scheduleDescUpdateTime.UpdateDefault.(func() time.Time)
+	// scheduleDescName is the schema descriptor for name field.
+	scheduleDescName := scheduleFields[1].Descriptor()
+	// schedule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	schedule.NameValidator = scheduleDescName.Validators[0].(func(string) error)
+	// scheduleDescCronExpression is the schema descriptor for cron_expression field.
+	scheduleDescCronExpression := scheduleFields[2].Descriptor()
+	// schedule.CronExpressionValidator is a validator for the "cron_expression" field. It is called by the builders before save.
+	schedule.CronExpressionValidator = scheduleDescCronExpression.Validators[0].(func(string) error)
+	// scheduleDescProjectDirectory is the schema descriptor for project_directory field.
+	scheduleDescProjectDirectory := scheduleFields[3].Descriptor()
+	// schedule.ProjectDirectoryValidator is a validator for the "project_directory" field. It is called by the builders before save.
+	schedule.ProjectDirectoryValidator = scheduleDescProjectDirectory.Validators[0].(func(string) error)
+	// scheduleDescPrompt is the schema descriptor for prompt field.
+	scheduleDescPrompt := scheduleFields[4].Descriptor()
+	// schedule.PromptValidator is a validator for the "prompt" field. It is called by the builders before save.
+	schedule.PromptValidator = scheduleDescPrompt.Validators[0].(func(string) error)
+	// scheduleDescEnabled is the schema descriptor for enabled field.
+	scheduleDescEnabled := scheduleFields[6].Descriptor()
+	// schedule.DefaultEnabled holds the default value on creation for the enabled field.
+	schedule.DefaultEnabled = scheduleDescEnabled.Default.(bool)
+	// scheduleDescRunCount is the schema descriptor for run_count field.
+	scheduleDescRunCount := scheduleFields[9].Descriptor()
+	// schedule.DefaultRunCount holds the default value on creation for the run_count field.
+	schedule.DefaultRunCount = scheduleDescRunCount.Default.(int64)
+	// scheduleDescSkippedCount is the schema descriptor for skipped_count field.
+	scheduleDescSkippedCount := scheduleFields[10].Descriptor()
+	// schedule.DefaultSkippedCount holds the default value on creation for the skipped_count field.
+	schedule.DefaultSkippedCount = scheduleDescSkippedCount.Default.(int64)
+	// scheduleDescID is the schema descriptor for id field.
+	scheduleDescID := scheduleFields[0].Descriptor()
+	// schedule.DefaultID holds the default value on creation for the id field.
+	schedule.DefaultID = scheduleDescID.Default.(func() uuid.UUID)
+	schedulerunMixin := schema.ScheduleRun{}.Mixin()
+	schedulerunMixinFields0 := schedulerunMixin[0].Fields()
+	_ = schedulerunMixinFields0
+	schedulerunFields := schema.ScheduleRun{}.Fields()
+	_ = schedulerunFields
+	// schedulerunDescCreateTime is the schema descriptor for create_time field.
+	schedulerunDescCreateTime := schedulerunMixinFields0[0].Descriptor()
+	// schedulerun.DefaultCreateTime holds the default value on creation for the create_time field.
+	schedulerun.DefaultCreateTime = schedulerunDescCreateTime.Default.(func() time.Time)
+	// schedulerunDescUpdateTime is the schema descriptor for update_time field.
+	schedulerunDescUpdateTime := schedulerunMixinFields0[1].Descriptor()
+	// schedulerun.DefaultUpdateTime holds the default value on creation for the update_time field.
+	schedulerun.DefaultUpdateTime = schedulerunDescUpdateTime.Default.(func() time.Time)
+	// schedulerun.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	schedulerun.UpdateDefaultUpdateTime = schedulerunDescUpdateTime.UpdateDefault.(func() time.Time)
+	// schedulerunDescID is the schema descriptor for id field.
+	schedulerunDescID := schedulerunFields[0].Descriptor()
+	// schedulerun.DefaultID holds the default value on creation for the id field.
+	schedulerun.DefaultID = schedulerunDescID.Default.(func() uuid.UUID)
 	taskMixin := schema.Task{}.Mixin()
 	taskMixinFields0 := taskMixin[0].Fields()
 	_ = taskMixinFields0
@@ -165,8 +265,124 @@ func init() {
 	taskDescToolUses := taskFields[8].Descriptor()
 	// task.DefaultToolUses holds the default value on creation for the tool_uses field.
 	task.DefaultToolUses = taskDescToolUses.Default.(map[string]int64)
+	// taskDescPersistentState is the schema descriptor for persistent_state field.
+	taskDescPersistentState := taskFields[13].Descriptor()
+	// task.DefaultPersistentState holds the default value on creation for the persistent_state field.
+	task.DefaultPersistentState = taskDescPersistentState.Default.(bool)
 	// taskDescID is the schema descriptor for id field.
 	taskDescID := taskFields[0].Descriptor()
 	// task.DefaultID holds the default value on creation for the id field.
 	task.DefaultID = taskDescID.Default.(func() uuid.UUID)
+	toolMixin := schema.Tool{}.Mixin()
+	toolMixinFields0 := toolMixin[0].Fields()
+	_ = toolMixinFields0
+	toolFields := schema.Tool{}.Fields()
+	_ = toolFields
+	// toolDescCreateTime is the schema descriptor for create_time field.
+	toolDescCreateTime := toolMixinFields0[0].Descriptor()
+	// tool.DefaultCreateTime holds the default value on creation for the create_time field.
+	tool.DefaultCreateTime = toolDescCreateTime.Default.(func() time.Time)
+	// toolDescUpdateTime is the schema descriptor for update_time field.
+	toolDescUpdateTime := toolMixinFields0[1].Descriptor()
+	// tool.DefaultUpdateTime holds the default value on creation for the update_time field.
+	tool.DefaultUpdateTime = toolDescUpdateTime.Default.(func() time.Time)
+	// tool.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	tool.UpdateDefaultUpdateTime = toolDescUpdateTime.UpdateDefault.(func() time.Time)
+	// toolDescName is the schema descriptor for name field.
+	toolDescName := toolFields[1].Descriptor()
+	// tool.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tool.NameValidator = toolDescName.Validators[0].(func(string) error)
+	// toolDescDescription is the schema descriptor for description field.
+	toolDescDescription := toolFields[2].Descriptor()
+	// tool.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	tool.DescriptionValidator = toolDescDescription.Validators[0].(func(string) error)
+	// toolDescTimeoutSeconds is the schema descriptor for timeout_seconds field.
+	toolDescTimeoutSeconds := toolFields[9].Descriptor()
+	// tool.DefaultTimeoutSeconds holds the default value on creation for the timeout_seconds field.
+	tool.DefaultTimeoutSeconds = toolDescTimeoutSeconds.Default.(int)
+	// toolDescEnabled is the schema descriptor for enabled field.
+	toolDescEnabled := toolFields[10].Descriptor()
+	// tool.DefaultEnabled holds the default value on creation for the enabled field.
+	tool.DefaultEnabled = toolDescEnabled.Default.(bool)
+	// toolDescID is the schema descriptor for id field.
+	toolDescID := toolFields[0].Descriptor()
+	// tool.DefaultID holds the default value on creation for the id field.
+	tool.DefaultID = toolDescID.Default.(func() uuid.UUID)
+	userMixin := schema.User{}.Mixin()
+	userMixinFields0 := userMixin[0].Fields()
+	_ = userMixinFields0
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescCreateTime is the schema descriptor for create_time field.
+	userDescCreateTime := userMixinFields0[0].Descriptor()
+	// user.DefaultCreateTime holds the default value on creation for the create_time field.
+	user.DefaultCreateTime = userDescCreateTime.Default.(func() time.Time)
+	// userDescUpdateTime is the schema descriptor for update_time field.
+	userDescUpdateTime := userMixinFields0[1].Descriptor()
+	// user.DefaultUpdateTime holds the default value on creation for the update_time field.
+	user.DefaultUpdateTime = userDescUpdateTime.Default.(func() time.Time)
+	// user.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	user.UpdateDefaultUpdateTime = userDescUpdateTime.UpdateDefault.(func() time.Time)
+	// userDescName is the schema descriptor for name field.
+	userDescName := userFields[1].Descriptor()
+	// user.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	user.NameValidator = userDescName.Validators[0].(func(string) error)
+	// userDescID is the schema descriptor for id field.
+	userDescID := userFields[0].Descriptor()
+	// user.DefaultID holds the default value on creation for the id field.
+	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+	webhookMixin := schema.Webhook{}.Mixin()
+	webhookMixinFields0 := webhookMixin[0].Fields()
+	_ = webhookMixinFields0
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescCreateTime is the schema descriptor for create_time field.
+	webhookDescCreateTime := webhookMixinFields0[0].Descriptor()
+	// webhook.DefaultCreateTime holds the default value on creation for the create_time field.
+	webhook.DefaultCreateTime = webhookDescCreateTime.Default.(func() time.Time)
+	// webhookDescUpdateTime is the schema descriptor for update_time field.
+	webhookDescUpdateTime := webhookMixinFields0[1].Descriptor()
+	// webhook.DefaultUpdateTime holds the default value on creation for the update_time field.
+	webhook.DefaultUpdateTime = webhookDescUpdateTime.Default.(func() time.Time)
+	// webhook.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	webhook.UpdateDefaultUpdateTime = webhookDescUpdateTime.UpdateDefault.(func() time.Time)
+	// webhookDescName is the schema descriptor for name field.
+	webhookDescName := webhookFields[1].Descriptor()
+	// webhook.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	webhook.NameValidator = webhookDescName.Validators[0].(func(string) error)
+	// webhookDescURL is the schema descriptor for url field.
+	webhookDescURL := webhookFields[2].Descriptor()
+	// webhook.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhook.URLValidator = webhookDescURL.Validators[0].(func(string) error)
+	// webhookDescSecret is the schema descriptor for secret field.
+	webhookDescSecret := webhookFields[3].Descriptor()
+	// webhook.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhook.SecretValidator = webhookDescSecret.Validators[0].(func([]byte) error)
+	// webhookDescEnabled is the schema descriptor for enabled field.
+	webhookDescEnabled := webhookFields[6].Descriptor()
+	// webhook.DefaultEnabled holds the default value on creation for the enabled field.
+	webhook.DefaultEnabled = webhookDescEnabled.Default.(bool)
+	// webhookDescID is the schema descriptor for id field.
+	webhookDescID := webhookFields[0].Descriptor()
+	// webhook.DefaultID holds the default value on creation for the id field.
+	webhook.DefaultID = webhookDescID.Default.(func() uuid.UUID)
+	webhookdeadletterMixin := schema.WebhookDeadLetter{}.Mixin()
+	webhookdeadletterMixinFields0 := webhookdeadletterMixin[0].Fields()
+	_ = webhookdeadletterMixinFields0
+	webhookdeadletterFields := schema.WebhookDeadLetter{}.Fields()
+	_ = webhookdeadletterFields
+	// webhookdeadletterDescCreateTime is the schema descriptor for create_time field.
+	webhookdeadletterDescCreateTime := webhookdeadletterMixinFields0[0].Descriptor()
+	// webhookdeadletter.DefaultCreateTime holds the default value on creation for the create_time field.
+	webhookdeadletter.DefaultCreateTime = webhookdeadletterDescCreateTime.Default.(func() time.Time)
+	// webhookdeadletterDescUpdateTime is the schema descriptor for update_time field.
+	webhookdeadletterDescUpdateTime := webhookdeadletterMixinFields0[1].Descriptor()
+	// webhookdeadletter.DefaultUpdateTime holds the default value on creation for the update_time field.
+	webhookdeadletter.DefaultUpdateTime = webhookdeadletterDescUpdateTime.Default.(func() time.Time)
+	// webhookdeadletter.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	webhookdeadletter.UpdateDefaultUpdateTime = webhookdeadletterDescUpdateTime.UpdateDefault.(func() time.Time)
+	// webhookdeadletterDescID is the schema descriptor for id field.
+	webhookdeadletterDescID := webhookdeadletterFields[0].Descriptor()
+	// webhookdeadletter.DefaultID holds the default value on creation for the id field.
+	webhookdeadletter.DefaultID = webhookdeadletterDescID.Default.(func() uuid.UUID)
 }
@@ -0,0 +1,214 @@
+// Code generated by ent. DO NOT EDIT.
+
+package memory
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/furisto/construct/backend/memory/schedule"
+	"github.com/furisto/construct/backend/memory/schedulerun"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/uuid"
+)
+
+// ScheduleRun is the model entity for the ScheduleRun schema.
+type ScheduleRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Status holds the value of the "status" field.
+	Status types.ScheduleRunStatus `json:"status,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// ScheduledTime holds the value of the "scheduled_time" field.
+	ScheduledTime time.Time `json:"scheduled_time,omitempty"`
+	// RunTime holds the value of the "run_time" field.
+	RunTime time.Time `json:"run_time,omitempty"`
+	// ScheduleID holds the value of the "schedule_id" field.
+	ScheduleID uuid.UUID `json:"schedule_id,omitempty"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID uuid.UUID `json:"task_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ScheduleRunQuery when eager-loading is set.
+	Edges        ScheduleRunEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ScheduleRunEdges holds the relations/edges for other nodes in the graph.
+type ScheduleRunEdges struct {
+	// Schedule holds the value of the schedule edge.
+	Schedule *Schedule `json:"schedule,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ScheduleOrErr returns the Schedule value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScheduleRunEdges) ScheduleOrErr() (*Schedule, error) {
+	if e.Schedule != nil {
+		return e.Schedule, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: schedule.Label}
+	}
+	return nil, &NotLoadedError{edge: "schedule"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScheduleRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case schedulerun.FieldStatus, schedulerun.FieldReason:
+			values[i] = new(sql.NullString)
+		case schedulerun.FieldCreateTime, schedulerun.FieldUpdateTime, schedulerun.FieldScheduledTime, schedulerun.FieldRunTime:
+			values[i] = new(sql.NullTime)
+		case schedulerun.FieldID, schedulerun.FieldScheduleID, schedulerun.FieldTaskID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScheduleRun fields.
+func (sr *ScheduleRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case schedulerun.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				sr.ID = *value
+			}
+		case schedulerun.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				sr.CreateTime = value.Time
+			}
+		case schedulerun.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				sr.UpdateTime = value.Time
+			}
+		case schedulerun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				sr.Status = types.ScheduleRunStatus(value.String)
+			}
+		case schedulerun.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				sr.Reason = value.String
+			}
+		case schedulerun.FieldScheduledTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduled_time", values[i])
+			} else if value.Valid {
+				sr.ScheduledTime = value.Time
+			}
+		case schedulerun.FieldRunTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field run_time", values[i])
+			} else if value.Valid {
+				sr.RunTime = value.Time
+			}
+		case schedulerun.FieldScheduleID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field schedule_id", values[i])
+			} else if value != nil {
+				sr.ScheduleID = *value
+			}
+		case schedulerun.FieldTaskID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value != nil {
+				sr.TaskID = *value
+			}
+		default:
+			sr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ScheduleRun.
+// This includes values selected through modifiers, order, etc.
+func (sr *ScheduleRun) Value(name string) (ent.Value, error) {
+	return sr.selectValues.Get(name)
+}
+
+// QuerySchedule queries the "schedule" edge of the ScheduleRun entity.
+func (sr *ScheduleRun) QuerySchedule() *ScheduleQuery {
+	return NewScheduleRunClient(sr.config).QuerySchedule(sr)
+}
+
+// Update returns a builder for updating this ScheduleRun.
+// Note that you need to call ScheduleRun.Unwrap() before calling this method if this ScheduleRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sr *ScheduleRun) Update() *ScheduleRunUpdateOne {
+	return NewScheduleRunClient(sr.config).UpdateOne(sr)
+}
+
+// Unwrap unwraps the ScheduleRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sr *ScheduleRun) Unwrap() *ScheduleRun {
+	_tx, ok := sr.config.driver.(*txDriver)
+	if !ok {
+		panic("memory: ScheduleRun is not a transactional entity")
+	}
+	sr.config.driver = _tx.drv
+	return sr
+}
+
+// String implements the fmt.Stringer.
+func (sr *ScheduleRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScheduleRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sr.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(sr.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(sr.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", sr.Status))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(sr.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("scheduled_time=")
+	builder.WriteString(sr.ScheduledTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("run_time=")
+	builder.WriteString(sr.RunTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("schedule_id=")
+	builder.WriteString(fmt.Sprintf("%v", sr.ScheduleID))
+	builder.WriteString(", ")
+	builder.WriteString("task_id=")
+	builder.WriteString(fmt.Sprintf("%v", sr.TaskID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScheduleRuns is a parsable slice of ScheduleRun.
+type ScheduleRuns []*ScheduleRun
@@ -0,0 +1,367 @@
+// Code generated by ent. DO NOT EDIT.
+
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/furisto/construct/backend/memory/schedule"
+	"github.com/furisto/construct/backend/memory/schedulerun"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/uuid"
+)
+
+// ScheduleRunCreate is the builder for creating a ScheduleRun entity.
+type ScheduleRunCreate struct {
+	config
+	mutation *ScheduleRunMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (src *ScheduleRunCreate) SetCreateTime(t time.Time) *ScheduleRunCreate {
+	src.mutation.SetCreateTime(t)
+	return src
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (src *ScheduleRunCreate) SetNillableCreateTime(t *time.Time) *ScheduleRunCreate {
+	if t != nil {
+		src.SetCreateTime(*t)
+	}
+	return src
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (src *ScheduleRunCreate) SetUpdateTime(t time.Time) *ScheduleRunCreate {
+	src.mutation.SetUpdateTime(t)
+	return src
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (src *ScheduleRunCreate) SetNillableUpdateTime(t *time.Time) *ScheduleRunCreate {
+	if t != nil {
+		src.SetUpdateTime(*t)
+	}
+	return src
+}
+
+// SetStatus sets the "status" field.
+func (src *ScheduleRunCreate) SetStatus(trs types.ScheduleRunStatus) *ScheduleRunCreate {
+	src.mutation.SetStatus(trs)
+	return src
+}
+
+// SetReason sets the "reason" field.
+func (src *ScheduleRunCreate) SetReason(s string) *ScheduleRunCreate {
+	src.mutation.SetReason(s)
+	return src
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (src *ScheduleRunCreate) SetNillableReason(s *string) *ScheduleRunCreate {
+	if s != nil {
+		src.SetReason(*s)
+	}
+	return src
+}
+
+// SetScheduledTime sets the "scheduled_time" field.
+func (src *ScheduleRunCreate) SetScheduledTime(t time.Time) *ScheduleRunCreate {
+	src.mutation.SetScheduledTime(t)
+	return src
+}
+
+// SetRunTime sets the "run_time" field.
+func (src *ScheduleRunCreate) SetRunTime(t time.Time) *ScheduleRunCreate {
+	src.mutation.SetRunTime(t)
+	return src
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (src *ScheduleRunCreate) SetScheduleID(u uuid.UUID) *ScheduleRunCreate {
+	src.mutation.SetScheduleID(u)
+	return src
+}
+
+// SetTaskID sets the "task_id" field.
+func (src *ScheduleRunCreate) SetTaskID(u uuid.UUID) *ScheduleRunCreate {
+	src.mutation.SetTaskID(u)
+	return src
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (src *ScheduleRunCreate) SetNillableTaskID(u *uuid.UUID) *ScheduleRunCreate {
+	if u != nil {
+		src.SetTaskID(*u)
+	}
+	return src
+}
+
+// SetID sets the "id" field.
+func (src *ScheduleRunCreate) SetID(u uuid.UUID) *ScheduleRunCreate {
+	src.mutation.SetID(u)
+	return src
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (src *ScheduleRunCreate) SetNillableID(u *uuid.UUID) *ScheduleRunCreate {
+	if u != nil {
+		src.SetID(*u)
+	}
+	return src
+}
+
+// SetSchedule sets the "schedule" edge to the Schedule entity.
+func (src *ScheduleRunCreate) SetSchedule(s *Schedule) *ScheduleRunCreate {
+	return src.SetScheduleID(s.ID)
+}
+
+// Mutation returns the ScheduleRunMutation object of the builder.
+func (src *ScheduleRunCreate) Mutation() *ScheduleRunMutation {
+	return src.mutation
+}
+
+// Save creates the ScheduleRun in the database.
+func (src *ScheduleRunCreate) Save(ctx context.Context) (*ScheduleRun, error) {
+	src.defaults()
+	return withHooks(ctx, src.sqlSave, src.mutation, src.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (src *ScheduleRunCreate) SaveX(ctx context.Context) *ScheduleRun {
+	v, err := src.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (src *ScheduleRunCreate) Exec(ctx context.Context) error {
+	_, err := src.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (src *ScheduleRunCreate) ExecX(ctx context.Context) {
+	if err := src.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (src *ScheduleRunCreate) defaults() {
+	if _, ok := src.mutation.CreateTime(); !ok {
+		v := schedulerun.DefaultCreateTime()
+		src.mutation.SetCreateTime(v)
+	}
+	if _, ok := src.mutation.UpdateTime(); !ok {
+		v := schedulerun.DefaultUpdateTime()
+		src.mutation.SetUpdateTime(v)
+	}
+	if _, ok := src.mutation.ID(); !ok {
+		v := schedulerun.DefaultID()
+		src.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (src *ScheduleRunCreate) check() error {
+	if _, ok := src.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`memory: missing required field "ScheduleRun.create_time"`)}
+	}
+	if _, ok := src.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`memory: missing required field "ScheduleRun.update_time"`)}
+	}
+	if _, ok := src.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`memory: missing required field "ScheduleRun.status"`)}
+	}
+	if v, ok := src.mutation.Status(); ok {
+		if err := schedulerun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`memory: validator failed for field "ScheduleRun.status": %w`, err)}
+		}
+	}
+	if _, ok := src.mutation.ScheduledTime(); !ok {
+		return &ValidationError{Name: "scheduled_time", err: errors.New(`memory: missing required field "ScheduleRun.scheduled_time"`)}
+	}
+	if _, ok := src.mutation.RunTime(); !ok {
+		return &ValidationError{Name: "run_time", err: errors.New(`memory: missing required field "ScheduleRun.run_time"`)}
+	}
+	if _, ok := src.mutation.ScheduleID(); !ok {
+		return &ValidationError{Name: "schedule_id", err: errors.New(`memory: missing required field "ScheduleRun.schedule_id"`)}
+	}
+	if len(src.mutation.ScheduleIDs()) == 0 {
+		return &ValidationError{Name: "schedule", err: errors.New(`memory: missing required edge "ScheduleRun.schedule"`)}
+	}
+	return nil
+}
+
+func (src *ScheduleRunCreate) sqlSave(ctx context.Context) (*ScheduleRun, error) {
+	if err := src.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := src.createSpec()
+	if err := sqlgraph.CreateNode(ctx, src.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	src.mutation.id = &_node.ID
+	src.mutation.done = true
+	return _node, nil
+}
+
+func (src *ScheduleRunCreate) createSpec() (*ScheduleRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScheduleRun{config: src.config}
+		_spec = sqlgraph.NewCreateSpec(schedulerun.Table, sqlgraph.NewFieldSpec(schedulerun.FieldID, field.TypeUUID))
+	)
+	if id, ok := src.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := src.mutation.CreateTime(); ok {
+		_spec.SetField(schedulerun.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := src.mutation.UpdateTime(); ok {
+		_spec.SetField(schedulerun.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := src.mutation.Status(); ok {
+		_spec.SetField(schedulerun.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := src.mutation.Reason(); ok {
+		_spec.SetField(schedulerun.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := src.mutation.ScheduledTime(); ok {
+		_spec.SetField(schedulerun.FieldScheduledTime, field.TypeTime, value)
+		_node.ScheduledTime = value
+	}
+	if value, ok := src.mutation.RunTime(); ok {
+		_spec.SetField(schedulerun.FieldRunTime, field.TypeTime, value)
+		_node.RunTime = value
+	}
+	if value, ok := src.mutation.TaskID(); ok {
+		_spec.SetField(schedulerun.FieldTaskID, field.TypeUUID, value)
+		_node.TaskID = value
+	}
+	if nodes := src.mutation.ScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   schedulerun.ScheduleTable,
+			Columns: []string{schedulerun.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ScheduleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ScheduleRunCreateBulk is the builder for creating many ScheduleRun entities in bulk.
+type ScheduleRunCreateBulk struct {
+	config
+	err      error
+	builders []*ScheduleRunCreate
+}
+
+// Save creates the ScheduleRun entities in the database.
+func (srcb *ScheduleRunCreateBulk) Save(ctx context.Context) ([]*ScheduleRun, error) {
+	if srcb.err != nil {
+		return nil, srcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(srcb.builders))
+	nodes := make([]*ScheduleRun, len(srcb.builders))
+	mutators := make([]Mutator, len(srcb.builders))
+	for i := range srcb.builders {
+		func(i int, root context.Context) {
+			builder := srcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScheduleRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, srcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, srcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, srcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (srcb *ScheduleRunCreateBulk) SaveX(ctx context.Context) []*ScheduleRun {
+	v, err := srcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (srcb *ScheduleRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := srcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srcb *ScheduleRunCreateBulk) ExecX(ctx context.Context) {
+	if err := srcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
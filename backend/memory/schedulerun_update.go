@@ -0,0 +1,262 @@
+// Code generated by ent. DO NOT EDIT.
+
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/furisto/construct/backend/memory/predicate"
+	"github.com/furisto/construct/backend/memory/schedulerun"
+)
+
+// ScheduleRunUpdate is the builder for updating ScheduleRun entities.
+type ScheduleRunUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ScheduleRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ScheduleRunUpdate builder.
+func (sru *ScheduleRunUpdate) Where(ps ...predicate.ScheduleRun) *ScheduleRunUpdate {
+	sru.mutation.Where(ps...)
+	return sru
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (sru *ScheduleRunUpdate) SetUpdateTime(t time.Time) *ScheduleRunUpdate {
+	sru.mutation.SetUpdateTime(t)
+	return sru
+}
+
+// Mutation returns the ScheduleRunMutation object of the builder.
+func (sru *ScheduleRunUpdate) Mutation() *ScheduleRunMutation {
+	return sru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sru *ScheduleRunUpdate) Save(ctx context.Context) (int, error) {
+	sru.defaults()
+	return withHooks(ctx, sru.sqlSave, sru.mutation, sru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sru *ScheduleRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := sru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sru *ScheduleRunUpdate) Exec(ctx context.Context) error {
+	_, err := sru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sru *ScheduleRunUpdate) ExecX(ctx context.Context) {
+	if err := sru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sru *ScheduleRunUpdate) defaults() {
+	if _, ok := sru.mutation.UpdateTime(); !ok {
+		v := schedulerun.UpdateDefaultUpdateTime()
+		sru.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sru *ScheduleRunUpdate) check() error {
+	if sru.mutation.ScheduleCleared() && len(sru.mutation.ScheduleIDs()) > 0 {
+		return errors.New(`memory: clearing a required unique edge "ScheduleRun.schedule"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (sru *ScheduleRunUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ScheduleRunUpdate {
+	sru.modifiers = append(sru.modifiers, modifiers...)
+	return sru
+}
+
+func (sru *ScheduleRunUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(schedulerun.Table, schedulerun.Columns, sqlgraph.NewFieldSpec(schedulerun.FieldID, field.TypeUUID))
+	if ps := sru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sru.mutation.UpdateTime(); ok {
+		_spec.SetField(schedulerun.FieldUpdateTime, field.TypeTime, value)
+	}
+	if sru.mutation.ReasonCleared() {
+		_spec.ClearField(schedulerun.FieldReason, field.TypeString)
+	}
+	if sru.mutation.TaskIDCleared() {
+		_spec.ClearField(schedulerun.FieldTaskID, field.TypeUUID)
+	}
+	_spec.AddModifiers(sru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, sru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{schedulerun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sru.mutation.done = true
+	return n, nil
+}
+
+// ScheduleRunUpdateOne is the builder for updating a single ScheduleRun entity.
+type ScheduleRunUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ScheduleRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (sruo *ScheduleRunUpdateOne) SetUpdateTime(t time.Time) *ScheduleRunUpdateOne {
+	sruo.mutation.SetUpdateTime(t)
+	return sruo
+}
+
+// Mutation returns the ScheduleRunMutation object of the builder.
+func (sruo *ScheduleRunUpdateOne) Mutation() *ScheduleRunMutation {
+	return sruo.mutation
+}
+
+// Where appends a list predicates to the ScheduleRunUpdate builder.
+func (sruo *ScheduleRunUpdateOne) Where(ps ...predicate.ScheduleRun) *ScheduleRunUpdateOne {
+	sruo.mutation.Where(ps...)
+	return sruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sruo *ScheduleRunUpdateOne) Select(field string, fields ...string) *ScheduleRunUpdateOne {
+	sruo.fields = append([]string{field}, fields...)
+	return sruo
+}
+
+// Save executes the query and returns the updated ScheduleRun entity.
+func (sruo *ScheduleRunUpdateOne) Save(ctx context.Context) (*ScheduleRun, error) {
+	sruo.defaults()
+	return withHooks(ctx, sruo.sqlSave, sruo.mutation, sruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sruo *ScheduleRunUpdateOne) SaveX(ctx context.Context) *ScheduleRun {
+	node, err := sruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sruo *ScheduleRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := sruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sruo *ScheduleRunUpdateOne) ExecX(ctx context.Context) {
+	if err := sruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sruo *ScheduleRunUpdateOne) defaults() {
+	if _, ok := sruo.mutation.UpdateTime(); !ok {
+		v := schedulerun.UpdateDefaultUpdateTime()
+		sruo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sruo *ScheduleRunUpdateOne) check() error {
+	if sruo.mutation.ScheduleCleared() && len(sruo.mutation.ScheduleIDs()) > 0 {
+		return errors.New(`memory: clearing a required unique edge "ScheduleRun.schedule"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (sruo *ScheduleRunUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ScheduleRunUpdateOne {
+	sruo.modifiers = append(sruo.modifiers, modifiers...)
+	return sruo
+}
+
+func (sruo *ScheduleRunUpdateOne) sqlSave(ctx context.Context) (_node *ScheduleRun, err error) {
+	if err := sruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(schedulerun.Table, schedulerun.Columns, sqlgraph.NewFieldSpec(schedulerun.FieldID, field.TypeUUID))
+	id, ok := sruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`memory: missing "ScheduleRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, schedulerun.FieldID)
+		for _, f := range fields {
+			if !schedulerun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("memory: invalid field %q for query", f)}
+			}
+			if f != schedulerun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sruo.mutation.UpdateTime(); ok {
+		_spec.SetField(schedulerun.FieldUpdateTime, field.TypeTime, value)
+	}
+	if sruo.mutation.ReasonCleared() {
+		_spec.ClearField(schedulerun.FieldReason, field.TypeString)
+	}
+	if sruo.mutation.TaskIDCleared() {
+		_spec.ClearField(schedulerun.FieldTaskID, field.TypeUUID)
+	}
+	_spec.AddModifiers(sruo.modifiers...)
+	_node = &ScheduleRun{config: sruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{schedulerun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sruo.mutation.done = true
+	return _node, nil
+}
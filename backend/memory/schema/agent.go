@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
+	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/google/uuid"
 )
 
@@ -20,16 +21,22 @@ func (Agent) Fields() []ent.Field {
 		field.String("description").Optional(),
 		field.String("instructions"),
 		field.Bool("builtin").Default(false),
+		field.JSON("mcp_servers", []types.MCPServer{}).Optional(),
+		field.JSON("tool_set", &types.ToolSet{}).Optional(),
+		field.JSON("script_limits", &types.ScriptLimits{}).Optional(),
 
 		field.UUID("model_id", uuid.UUID{}).Optional(),
+		field.UUID("owner_id", uuid.UUID{}).Optional().Nillable(),
 	}
 }
 
 func (Agent) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("model", Model.Type).Field("model_id").Unique(),
+		edge.To("owner", User.Type).Field("owner_id").Unique(),
 		edge.From("tasks", Task.Type).Ref("agent"),
 		edge.From("messages", Message.Type).Ref("agent"),
+		edge.From("schedules", Schedule.Type).Ref("agent"),
 	}
 }
 
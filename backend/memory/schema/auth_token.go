@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+)
+
+type AuthToken struct {
+	ent.Schema
+}
+
+func (AuthToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique().Immutable(),
+		field.String("name").NotEmpty(),
+		field.Bytes("token_hash").NotEmpty().Sensitive().Immutable(),
+		field.Time("expires_at").Optional().Nillable(),
+		field.Time("last_used_at").Optional().Nillable(),
+
+		field.UUID("user_id", uuid.UUID{}),
+	}
+}
+
+func (AuthToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).Field("user_id").Unique().Required(),
+	}
+}
+
+func (AuthToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").
+			Unique(),
+		index.Fields("token_hash").
+			Unique(),
+	}
+}
+
+func (AuthToken) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
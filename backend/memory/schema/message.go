@@ -20,6 +20,7 @@ func (Message) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique().Immutable(),
 		field.Enum("source").GoType(types.MessageSource("")),
+		field.Enum("delivery").GoType(types.MessageDelivery("")).Default(string(types.MessageDeliveryQueue)),
 		field.JSON("content", &types.MessageContent{}),
 		field.JSON("usage", &types.MessageUsage{}).Optional(),
 		field.Time("processed_time").Optional(),
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+)
+
+type Schedule struct {
+	ent.Schema
+}
+
+func (Schedule) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique().Immutable(),
+		field.String("name").NotEmpty(),
+		field.String("cron_expression").NotEmpty(),
+		field.String("project_directory").NotEmpty(),
+		field.Text("prompt").NotEmpty(),
+		field.Float("max_cost").Optional(),
+		field.Bool("enabled").Default(true),
+		field.Time("last_run_time").Optional(),
+		field.Time("next_run_time").Optional(),
+		field.Int64("run_count").Default(0),
+		field.Int64("skipped_count").Default(0),
+		field.UUID("last_task_id", uuid.UUID{}).Optional(),
+
+		field.UUID("agent_id", uuid.UUID{}),
+	}
+}
+
+func (Schedule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("agent", Agent.Type).Field("agent_id").Unique().Required(),
+		edge.From("tasks", Task.Type).Ref("schedule"),
+		edge.From("runs", ScheduleRun.Type).Ref("schedule"),
+	}
+}
+
+func (Schedule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").
+			Unique(),
+		index.Fields("next_run_time"),
+	}
+}
+
+func (Schedule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
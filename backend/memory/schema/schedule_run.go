@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/uuid"
+)
+
+// ScheduleRun records every time a schedule fired, whether it started a task,
+// was skipped or failed. Runs are only appended, never changed.
+type ScheduleRun struct {
+	ent.Schema
+}
+
+func (ScheduleRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique().Immutable(),
+		field.Enum("status").GoType(types.ScheduleRunStatus("")).Immutable(),
+		field.Text("reason").Optional().Immutable(),
+		field.Time("scheduled_time").Immutable(),
+		field.Time("run_time").Immutable(),
+
+		field.UUID("schedule_id", uuid.UUID{}).Immutable(),
+		field.UUID("task_id", uuid.UUID{}).Optional().Immutable(),
+	}
+}
+
+func (ScheduleRun) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("schedule", Schedule.Type).Field("schedule_id").Unique().Required().Immutable().Annotations(
+			entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			},
+		),
+	}
+}
+
+func (ScheduleRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("schedule_id", "run_time"),
+	}
+}
+
+func (ScheduleRun) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
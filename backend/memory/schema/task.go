@@ -28,8 +28,16 @@ func (Task) Fields() []ent.Field {
 		field.Enum("desired_phase").GoType(types.TaskPhase("")).Default(string(types.TaskPhaseRunning)),
 		field.Enum("phase").GoType(types.TaskPhase("")).Default(string(types.TaskPhaseAwaiting)),
 
+		field.Float("max_cost").Optional(),
+		field.JSON("instruction_files", []string{}).Optional(),
+		field.Bool("persistent_state").Default(false),
+		field.JSON("preview", &types.TaskPreview{}).Optional(),
+
 		field.String("description").Optional(),
+		field.UUID("parent_id", uuid.UUID{}).Optional(),
 		field.UUID("agent_id", uuid.UUID{}).Optional(),
+		field.UUID("schedule_id", uuid.UUID{}).Optional(),
+		field.UUID("owner_id", uuid.UUID{}).Optional().Nillable(),
 	}
 }
 
@@ -37,6 +45,8 @@ func (Task) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("messages", Message.Type).Ref("task"),
 		edge.To("agent", Agent.Type).Field("agent_id").Unique(),
+		edge.To("schedule", Schedule.Type).Field("schedule_id").Unique(),
+		edge.To("owner", User.Type).Field("owner_id").Unique(),
 	}
 }
 
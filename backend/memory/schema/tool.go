@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/uuid"
+)
+
+// Tool is a user defined CodeAct tool. Depending on its kind a call runs a
+// command, posts to an HTTP endpoint or evaluates a JavaScript module.
+type Tool struct {
+	ent.Schema
+}
+
+func (Tool) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique().Immutable(),
+		field.String("name").NotEmpty(),
+		field.Text("description").NotEmpty(),
+		field.JSON("input_schema", map[string]any{}).Optional(),
+		field.Enum("kind").GoType(types.ToolKind("")),
+		field.Text("command").Optional(),
+		field.String("url").Optional(),
+		field.JSON("headers", map[string]string{}).Optional().Sensitive(),
+		field.Text("source").Optional(),
+		field.Int("timeout_seconds").Default(30),
+		field.Bool("enabled").Default(true),
+	}
+}
+
+func (Tool) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").
+			Unique(),
+	}
+}
+
+func (Tool) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
@@ -0,0 +1,24 @@
+package types
+
+type MCPTransport string
+
+const (
+	MCPTransportStdio MCPTransport = "stdio"
+	MCPTransportHTTP  MCPTransport = "http"
+)
+
+// MCPServer configures a Model Context Protocol server whose tools are
+// available to an agent.
+type MCPServer struct {
+	Name      string       `json:"name"`
+	Transport MCPTransport `json:"transport"`
+
+	// Command, Args and Env start a server with the stdio transport.
+	Command string            `json:"command,omitempty"`
+	Args    []string          `json:"args,omitempty"`
+	Env     map[string]string `json:"env,omitempty"`
+
+	// URL and Headers address a server with the streamable HTTP transport.
+	URL     string            `json:"url,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+}
@@ -35,6 +35,26 @@ func (r MessageSource) Values() []string {
 	}
 }
 
+// MessageDelivery controls how a user message is handled when it arrives while
+// the task is still working on a previous message.
+type MessageDelivery string
+
+const (
+	// MessageDeliveryQueue processes the message after all work that is already
+	// in flight, in the order user messages were received.
+	MessageDeliveryQueue MessageDelivery = "queue"
+	// MessageDeliverySteer interrupts the current model invocation or tool execution
+	// and processes the message before any queued follow-ups.
+	MessageDeliverySteer MessageDelivery = "steer"
+)
+
+func (d MessageDelivery) Values() []string {
+	return []string{
+		string(MessageDeliveryQueue),
+		string(MessageDeliverySteer),
+	}
+}
+
 type MessageUsage struct {
 	InputTokens      int64   `json:"input_tokens"`
 	OutputTokens     int64   `json:"output_tokens"`
@@ -0,0 +1,22 @@
+package types
+
+// ScheduleRunStatus is the outcome of a schedule firing.
+type ScheduleRunStatus string
+
+const (
+	// ScheduleRunStatusStarted means a task was created for the run.
+	ScheduleRunStatusStarted ScheduleRunStatus = "started"
+	// ScheduleRunStatusSkipped means the task of the previous run was still active.
+	ScheduleRunStatusSkipped ScheduleRunStatus = "skipped"
+	// ScheduleRunStatusFailed means the run could not be started, e.g. because
+	// the prompt template is invalid.
+	ScheduleRunStatusFailed ScheduleRunStatus = "failed"
+)
+
+func (s ScheduleRunStatus) Values() []string {
+	return []string{
+		string(ScheduleRunStatusStarted),
+		string(ScheduleRunStatusSkipped),
+		string(ScheduleRunStatusFailed),
+	}
+}
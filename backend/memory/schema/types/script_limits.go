@@ -0,0 +1,14 @@
+package types
+
+// ScriptLimits restricts the resources a single CodeAct script of an agent
+// may use. Zero values fall back to the defaults of the interpreter.
+type ScriptLimits struct {
+	// TimeoutSeconds is the maximum wall-clock time of a script.
+	TimeoutSeconds int `json:"timeout_seconds,omitempty"`
+	// MaxToolCalls is the maximum number of tool calls of a script.
+	MaxToolCalls int `json:"max_tool_calls,omitempty"`
+	// MaxOutputBytes is the maximum size of the console output of a script.
+	MaxOutputBytes int `json:"max_output_bytes,omitempty"`
+	// MaxToolResultBytes is the maximum size of a single tool result.
+	MaxToolResultBytes int `json:"max_tool_result_bytes,omitempty"`
+}
@@ -0,0 +1,17 @@
+package types
+
+// TaskPreview holds the file changes of a task that runs in preview mode. The
+// changes are staged instead of written to the project directory until they
+// are applied.
+type TaskPreview struct {
+	Files []PreviewFile `json:"files,omitempty"`
+}
+
+// PreviewFile is the staged content of a file. BaseHash is the hash of the
+// content the file had on disk when it was first staged, empty if it did not
+// exist. The preview is only applied if the files still have that content.
+type PreviewFile struct {
+	Path     string `json:"path"`
+	Content  string `json:"content"`
+	BaseHash string `json:"base_hash,omitempty"`
+}
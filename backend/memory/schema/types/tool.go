@@ -0,0 +1,17 @@
+package types
+
+type ToolKind string
+
+const (
+	ToolKindCommand    ToolKind = "command"
+	ToolKindHTTP       ToolKind = "http"
+	ToolKindJavaScript ToolKind = "javascript"
+)
+
+func (k ToolKind) Values() []string {
+	return []string{
+		string(ToolKindCommand),
+		string(ToolKindHTTP),
+		string(ToolKindJavaScript),
+	}
+}
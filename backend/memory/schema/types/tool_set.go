@@ -0,0 +1,10 @@
+package types
+
+// ToolSet restricts the tools available to an agent.
+type ToolSet struct {
+	// AllowedTools are the names of the tools the agent may call. A '*'
+	// matches any sequence of characters. Empty allows all tools.
+	AllowedTools []string `json:"allowed_tools,omitempty"`
+	// ReadOnly denies tools that modify files or run commands.
+	ReadOnly bool `json:"read_only,omitempty"`
+}
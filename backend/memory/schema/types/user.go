@@ -0,0 +1,21 @@
+package types
+
+type UserRole string
+
+const (
+	// UserRoleAdmin manages model providers, models, builtin agents and every
+	// other user's resources.
+	UserRoleAdmin UserRole = "admin"
+	// UserRoleMember creates agents and tasks and works on the ones it owns.
+	UserRoleMember UserRole = "member"
+	// UserRoleViewer reads tasks and their transcripts without changing them.
+	UserRoleViewer UserRole = "viewer"
+)
+
+func (r UserRole) Values() []string {
+	return []string{
+		string(UserRoleAdmin),
+		string(UserRoleMember),
+		string(UserRoleViewer),
+	}
+}
@@ -0,0 +1,19 @@
+package types
+
+type WebhookEventType string
+
+const (
+	WebhookEventTaskPhaseChanged  WebhookEventType = "task.phase_changed"
+	WebhookEventTaskResponse      WebhookEventType = "task.response"
+	WebhookEventTaskError         WebhookEventType = "task.error"
+	WebhookEventTaskCostThreshold WebhookEventType = "task.cost_threshold"
+)
+
+func (t WebhookEventType) Values() []string {
+	return []string{
+		string(WebhookEventTaskPhaseChanged),
+		string(WebhookEventTaskResponse),
+		string(WebhookEventTaskError),
+		string(WebhookEventTaskCostThreshold),
+	}
+}
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/uuid"
+)
+
+type Webhook struct {
+	ent.Schema
+}
+
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique().Immutable(),
+		field.String("name").NotEmpty(),
+		field.String("url").NotEmpty(),
+		field.Bytes("secret").NotEmpty().Sensitive(),
+		field.JSON("events", []types.WebhookEventType{}),
+		field.Float("cost_threshold").Optional(),
+		field.Bool("enabled").Default(true),
+	}
+}
+
+func (Webhook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("dead_letters", WebhookDeadLetter.Type).Ref("webhook"),
+	}
+}
+
+func (Webhook) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").
+			Unique(),
+	}
+}
+
+func (Webhook) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/uuid"
+)
+
+// WebhookDeadLetter records a webhook delivery that failed on every attempt.
+type WebhookDeadLetter struct {
+	ent.Schema
+}
+
+func (WebhookDeadLetter) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique().Immutable(),
+		field.Enum("event_type").GoType(types.WebhookEventType("")),
+		field.Text("payload"),
+		field.Int("attempts"),
+		field.Text("last_error"),
+
+		field.UUID("webhook_id", uuid.UUID{}),
+		field.UUID("task_id", uuid.UUID{}).Optional(),
+	}
+}
+
+func (WebhookDeadLetter) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("webhook", Webhook.Type).Field("webhook_id").Unique().Required().Annotations(
+			entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			},
+		),
+	}
+}
+
+func (WebhookDeadLetter) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("webhook_id"),
+	}
+}
+
+func (WebhookDeadLetter) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
@@ -11,8 +11,10 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/furisto/construct/backend/memory/agent"
+	"github.com/furisto/construct/backend/memory/schedule"
 	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/furisto/construct/backend/memory/task"
+	"github.com/furisto/construct/backend/memory/user"
 	"github.com/google/uuid"
 )
 
@@ -45,10 +47,24 @@ type Task struct {
 	DesiredPhase types.TaskPhase `json:"desired_phase,omitempty"`
 	// Phase holds the value of the "phase" field.
 	Phase types.TaskPhase `json:"phase,omitempty"`
+	// MaxCost holds the value of the "max_cost" field.
+	MaxCost float64 `json:"max_cost,omitempty"`
+	// InstructionFiles holds the value of the "instruction_files" field.
+	InstructionFiles []string `json:"instruction_files,omitempty"`
+	// PersistentState holds the value of the "persistent_state" field.
+	PersistentState bool `json:"persistent_state,omitempty"`
+	// Preview holds the value of the "preview" field.
+	Preview *types.TaskPreview `json:"preview,omitempty"`
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty"`
+	// ParentID holds the value of the "parent_id" field.
+	ParentID uuid.UUID `json:"parent_id,omitempty"`
 	// AgentID holds the value of the "agent_id" field.
 	AgentID uuid.UUID `json:"agent_id,omitempty"`
+	// ScheduleID holds the value of the "schedule_id" field.
+	ScheduleID uuid.UUID `json:"schedule_id,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID *uuid.UUID `json:"owner_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TaskQuery when eager-loading is set.
 	Edges        TaskEdges `json:"edges"`
@@ -61,9 +77,13 @@ type TaskEdges struct {
 	Messages []*Message `json:"messages,omitempty"`
 	// Agent holds the value of the agent edge.
 	Agent *Agent `json:"agent,omitempty"`
+	// Schedule holds the value of the schedule edge.
+	Schedule *Schedule `json:"schedule,omitempty"`
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
 }
 
 // MessagesOrErr returns the Messages value or an error if the edge
@@ -86,14 +106,40 @@ func (e TaskEdges) AgentOrErr() (*Agent, error) {
 	return nil, &NotLoadedError{edge: "agent"}
 }
 
+// ScheduleOrErr returns the Schedule value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskEdges) ScheduleOrErr() (*Schedule, error) {
+	if e.Schedule != nil {
+		return e.Schedule, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: schedule.Label}
+	}
+	return nil, &NotLoadedError{edge: "schedule"}
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Task) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case task.FieldToolUses:
+		case task.FieldOwnerID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case task.FieldToolUses, task.FieldInstructionFiles, task.FieldPreview:
 			values[i] = new([]byte)
-		case task.FieldCost:
+		case task.FieldPersistentState:
+			values[i] = new(sql.NullBool)
+		case task.FieldCost, task.FieldMaxCost:
 			values[i] = new(sql.NullFloat64)
 		case task.FieldInputTokens, task.FieldOutputTokens, task.FieldCacheWriteTokens, task.FieldCacheReadTokens, task.FieldTurns:
 			values[i] = new(sql.NullInt64)
@@ -101,7 +147,7 @@ func (*Task) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullString)
 		case task.FieldCreateTime, task.FieldUpdateTime:
 			values[i] = new(sql.NullTime)
-		case task.FieldID, task.FieldAgentID:
+		case task.FieldID, task.FieldParentID, task.FieldAgentID, task.FieldScheduleID:
 			values[i] = new(uuid.UUID)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -198,18 +244,65 @@ func (t *Task) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				t.Phase = types.TaskPhase(value.String)
 			}
+		case task.FieldMaxCost:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_cost", values[i])
+			} else if value.Valid {
+				t.MaxCost = value.Float64
+			}
+		case task.FieldInstructionFiles:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field instruction_files", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &t.InstructionFiles); err != nil {
+					return fmt.Errorf("unmarshal field instruction_files: %w", err)
+				}
+			}
+		case task.FieldPersistentState:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field persistent_state", values[i])
+			} else if value.Valid {
+				t.PersistentState = value.Bool
+			}
+		case task.FieldPreview:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field preview", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &t.Preview); err != nil {
+					return fmt.Errorf("unmarshal field preview: %w", err)
+				}
+			}
 		case task.FieldDescription:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field description", values[i])
 			} else if value.Valid {
 				t.Description = value.String
 			}
+		case task.FieldParentID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value != nil {
+				t.ParentID = *value
+			}
 		case task.FieldAgentID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field agent_id", values[i])
 			} else if value != nil {
 				t.AgentID = *value
 			}
+		case task.FieldScheduleID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field schedule_id", values[i])
+			} else if value != nil {
+				t.ScheduleID = *value
+			}
+		case task.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				t.OwnerID = new(uuid.UUID)
+				*t.OwnerID = *value.S.(*uuid.UUID)
+			}
 		default:
 			t.selectValues.Set(columns[i], values[i])
 		}
@@ -233,6 +326,16 @@ func (t *Task) QueryAgent() *AgentQuery {
 	return NewTaskClient(t.config).QueryAgent(t)
 }
 
+// QuerySchedule queries the "schedule" edge of the Task entity.
+func (t *Task) QuerySchedule() *ScheduleQuery {
+	return NewTaskClient(t.config).QuerySchedule(t)
+}
+
+// QueryOwner queries the "owner" edge of the Task entity.
+func (t *Task) QueryOwner() *UserQuery {
+	return NewTaskClient(t.config).QueryOwner(t)
+}
+
 // Update returns a builder for updating this Task.
 // Note that you need to call Task.Unwrap() before calling this method if this Task
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -292,11 +395,34 @@ func (t *Task) String() string {
 	builder.WriteString("phase=")
 	builder.WriteString(fmt.Sprintf("%v", t.Phase))
 	builder.WriteString(", ")
+	builder.WriteString("max_cost=")
+	builder.WriteString(fmt.Sprintf("%v", t.MaxCost))
+	builder.WriteString(", ")
+	builder.WriteString("instruction_files=")
+	builder.WriteString(fmt.Sprintf("%v", t.InstructionFiles))
+	builder.WriteString(", ")
+	builder.WriteString("persistent_state=")
+	builder.WriteString(fmt.Sprintf("%v", t.PersistentState))
+	builder.WriteString(", ")
+	builder.WriteString("preview=")
+	builder.WriteString(fmt.Sprintf("%v", t.Preview))
+	builder.WriteString(", ")
 	builder.WriteString("description=")
 	builder.WriteString(t.Description)
 	builder.WriteString(", ")
+	builder.WriteString("parent_id=")
+	builder.WriteString(fmt.Sprintf("%v", t.ParentID))
+	builder.WriteString(", ")
 	builder.WriteString("agent_id=")
 	builder.WriteString(fmt.Sprintf("%v", t.AgentID))
+	builder.WriteString(", ")
+	builder.WriteString("schedule_id=")
+	builder.WriteString(fmt.Sprintf("%v", t.ScheduleID))
+	builder.WriteString(", ")
+	if v := t.OwnerID; v != nil {
+		builder.WriteString("owner_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
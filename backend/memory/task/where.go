@@ -102,16 +102,41 @@ func Turns(v int64) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldTurns, v))
 }
 
+// MaxCost applies equality check predicate on the "max_cost" field. It's identical to MaxCostEQ.
+func MaxCost(v float64) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldMaxCost, v))
+}
+
+// PersistentState applies equality check predicate on the "persistent_state" field. It's identical to PersistentStateEQ.
+func PersistentState(v bool) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldPersistentState, v))
+}
+
 // Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
 func Description(v string) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldDescription, v))
 }
 
+// ParentID applies equality check predicate on the "parent_id" field. It's identical to ParentIDEQ.
+func ParentID(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldParentID, v))
+}
+
 // AgentID applies equality check predicate on the "agent_id" field. It's identical to AgentIDEQ.
 func AgentID(v uuid.UUID) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldAgentID, v))
 }
 
+// ScheduleID applies equality check predicate on the "schedule_id" field. It's identical to ScheduleIDEQ.
+func ScheduleID(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldScheduleID, v))
+}
+
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldOwnerID, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldCreateTime, v))
@@ -617,6 +642,86 @@ func PhaseNotIn(vs ...types.TaskPhase) predicate.Task {
 	return predicate.Task(sql.FieldNotIn(FieldPhase, v...))
 }
 
+// MaxCostEQ applies the EQ predicate on the "max_cost" field.
+func MaxCostEQ(v float64) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldMaxCost, v))
+}
+
+// MaxCostNEQ applies the NEQ predicate on the "max_cost" field.
+func MaxCostNEQ(v float64) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldMaxCost, v))
+}
+
+// MaxCostIn applies the In predicate on the "max_cost" field.
+func MaxCostIn(vs ...float64) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldMaxCost, vs...))
+}
+
+// MaxCostNotIn applies the NotIn predicate on the "max_cost" field.
+func MaxCostNotIn(vs ...float64) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldMaxCost, vs...))
+}
+
+// MaxCostGT applies the GT predicate on the "max_cost" field.
+func MaxCostGT(v float64) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldMaxCost, v))
+}
+
+// MaxCostGTE applies the GTE predicate on the "max_cost" field.
+func MaxCostGTE(v float64) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldMaxCost, v))
+}
+
+// MaxCostLT applies the LT predicate on the "max_cost" field.
+func MaxCostLT(v float64) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldMaxCost, v))
+}
+
+// MaxCostLTE applies the LTE predicate on the "max_cost" field.
+func MaxCostLTE(v float64) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldMaxCost, v))
+}
+
+// MaxCostIsNil applies the IsNil predicate on the "max_cost" field.
+func MaxCostIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldMaxCost))
+}
+
+// MaxCostNotNil applies the NotNil predicate on the "max_cost" field.
+func MaxCostNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldMaxCost))
+}
+
+// InstructionFilesIsNil applies the IsNil predicate on the "instruction_files" field.
+func InstructionFilesIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldInstructionFiles))
+}
+
+// InstructionFilesNotNil applies the NotNil predicate on the "instruction_files" field.
+func InstructionFilesNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldInstructionFiles))
+}
+
+// PersistentStateEQ applies the EQ predicate on the "persistent_state" field.
+func PersistentStateEQ(v bool) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldPersistentState, v))
+}
+
+// PersistentStateNEQ applies the NEQ predicate on the "persistent_state" field.
+func PersistentStateNEQ(v bool) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldPersistentState, v))
+}
+
+// PreviewIsNil applies the IsNil predicate on the "preview" field.
+func PreviewIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldPreview))
+}
+
+// PreviewNotNil applies the NotNil predicate on the "preview" field.
+func PreviewNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldPreview))
+}
+
 // DescriptionEQ applies the EQ predicate on the "description" field.
 func DescriptionEQ(v string) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldDescription, v))
@@ -692,6 +797,56 @@ func DescriptionContainsFold(v string) predicate.Task {
 	return predicate.Task(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// ParentIDEQ applies the EQ predicate on the "parent_id" field.
+func ParentIDEQ(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldParentID, v))
+}
+
+// ParentIDNEQ applies the NEQ predicate on the "parent_id" field.
+func ParentIDNEQ(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldParentID, v))
+}
+
+// ParentIDIn applies the In predicate on the "parent_id" field.
+func ParentIDIn(vs ...uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldParentID, vs...))
+}
+
+// ParentIDNotIn applies the NotIn predicate on the "parent_id" field.
+func ParentIDNotIn(vs ...uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldParentID, vs...))
+}
+
+// ParentIDGT applies the GT predicate on the "parent_id" field.
+func ParentIDGT(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldParentID, v))
+}
+
+// ParentIDGTE applies the GTE predicate on the "parent_id" field.
+func ParentIDGTE(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldParentID, v))
+}
+
+// ParentIDLT applies the LT predicate on the "parent_id" field.
+func ParentIDLT(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldParentID, v))
+}
+
+// ParentIDLTE applies the LTE predicate on the "parent_id" field.
+func ParentIDLTE(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldParentID, v))
+}
+
+// ParentIDIsNil applies the IsNil predicate on the "parent_id" field.
+func ParentIDIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldParentID))
+}
+
+// ParentIDNotNil applies the NotNil predicate on the "parent_id" field.
+func ParentIDNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldParentID))
+}
+
 // AgentIDEQ applies the EQ predicate on the "agent_id" field.
 func AgentIDEQ(v uuid.UUID) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldAgentID, v))
@@ -722,6 +877,66 @@ func AgentIDNotNil() predicate.Task {
 	return predicate.Task(sql.FieldNotNull(FieldAgentID))
 }
 
+// ScheduleIDEQ applies the EQ predicate on the "schedule_id" field.
+func ScheduleIDEQ(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldScheduleID, v))
+}
+
+// ScheduleIDNEQ applies the NEQ predicate on the "schedule_id" field.
+func ScheduleIDNEQ(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldScheduleID, v))
+}
+
+// ScheduleIDIn applies the In predicate on the "schedule_id" field.
+func ScheduleIDIn(vs ...uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldScheduleID, vs...))
+}
+
+// ScheduleIDNotIn applies the NotIn predicate on the "schedule_id" field.
+func ScheduleIDNotIn(vs ...uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldScheduleID, vs...))
+}
+
+// ScheduleIDIsNil applies the IsNil predicate on the "schedule_id" field.
+func ScheduleIDIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldScheduleID))
+}
+
+// ScheduleIDNotNil applies the NotNil predicate on the "schedule_id" field.
+func ScheduleIDNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldScheduleID))
+}
+
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldOwnerID, v))
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDIsNil applies the IsNil predicate on the "owner_id" field.
+func OwnerIDIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldOwnerID))
+}
+
+// OwnerIDNotNil applies the NotNil predicate on the "owner_id" field.
+func OwnerIDNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldOwnerID))
+}
+
 // HasMessages applies the HasEdge predicate on the "messages" edge.
 func HasMessages() predicate.Task {
 	return predicate.Task(func(s *sql.Selector) {
@@ -768,6 +983,52 @@ func HasAgentWith(preds ...predicate.Agent) predicate.Task {
 	})
 }
 
+// HasSchedule applies the HasEdge predicate on the "schedule" edge.
+func HasSchedule() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ScheduleTable, ScheduleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasScheduleWith applies the HasEdge predicate on the "schedule" edge with a given conditions (other predicates).
+func HasScheduleWith(preds ...predicate.Schedule) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newScheduleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOwner applies the HasEdge predicate on the "owner" edge.
+func HasOwner() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, OwnerTable, OwnerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOwnerWith applies the HasEdge predicate on the "owner" edge with a given conditions (other predicates).
+func HasOwnerWith(preds ...predicate.User) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newOwnerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Task) predicate.Task {
 	return predicate.Task(sql.AndPredicates(predicates...))
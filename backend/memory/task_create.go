@@ -12,8 +12,10 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/furisto/construct/backend/memory/agent"
 	"github.com/furisto/construct/backend/memory/message"
+	"github.com/furisto/construct/backend/memory/schedule"
 	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/furisto/construct/backend/memory/task"
+	"github.com/furisto/construct/backend/memory/user"
 	"github.com/google/uuid"
 )
 
@@ -184,6 +186,46 @@ func (tc *TaskCreate) SetNillablePhase(tp *types.TaskPhase) *TaskCreate {
 	return tc
 }
 
+// SetMaxCost sets the "max_cost" field.
+func (tc *TaskCreate) SetMaxCost(f float64) *TaskCreate {
+	tc.mutation.SetMaxCost(f)
+	return tc
+}
+
+// SetNillableMaxCost sets the "max_cost" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableMaxCost(f *float64) *TaskCreate {
+	if f != nil {
+		tc.SetMaxCost(*f)
+	}
+	return tc
+}
+
+// SetInstructionFiles sets the "instruction_files" field.
+func (tc *TaskCreate) SetInstructionFiles(s []string) *TaskCreate {
+	tc.mutation.SetInstructionFiles(s)
+	return tc
+}
+
+// SetPersistentState sets the "persistent_state" field.
+func (tc *TaskCreate) SetPersistentState(b bool) *TaskCreate {
+	tc.mutation.SetPersistentState(b)
+	return tc
+}
+
+// SetNillablePersistentState sets the "persistent_state" field if the given value is not nil.
+func (tc *TaskCreate) SetNillablePersistentState(b *bool) *TaskCreate {
+	if b != nil {
+		tc.SetPersistentState(*b)
+	}
+	return tc
+}
+
+// SetPreview sets the "preview" field.
+func (tc *TaskCreate) SetPreview(tp *types.TaskPreview) *TaskCreate {
+	tc.mutation.SetPreview(tp)
+	return tc
+}
+
 // SetDescription sets the "description" field.
 func (tc *TaskCreate) SetDescription(s string) *TaskCreate {
 	tc.mutation.SetDescription(s)
@@ -198,6 +240,20 @@ func (tc *TaskCreate) SetNillableDescription(s *string) *TaskCreate {
 	return tc
 }
 
+// SetParentID sets the "parent_id" field.
+func (tc *TaskCreate) SetParentID(u uuid.UUID) *TaskCreate {
+	tc.mutation.SetParentID(u)
+	return tc
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableParentID(u *uuid.UUID) *TaskCreate {
+	if u != nil {
+		tc.SetParentID(*u)
+	}
+	return tc
+}
+
 // SetAgentID sets the "agent_id" field.
 func (tc *TaskCreate) SetAgentID(u uuid.UUID) *TaskCreate {
 	tc.mutation.SetAgentID(u)
@@ -212,6 +268,34 @@ func (tc *TaskCreate) SetNillableAgentID(u *uuid.UUID) *TaskCreate {
 	return tc
 }
 
+// SetScheduleID sets the "schedule_id" field.
+func (tc *TaskCreate) SetScheduleID(u uuid.UUID) *TaskCreate {
+	tc.mutation.SetScheduleID(u)
+	return tc
+}
+
+// SetNillableScheduleID sets the "schedule_id" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableScheduleID(u *uuid.UUID) *TaskCreate {
+	if u != nil {
+		tc.SetScheduleID(*u)
+	}
+	return tc
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (tc *TaskCreate) SetOwnerID(u uuid.UUID) *TaskCreate {
+	tc.mutation.SetOwnerID(u)
+	return tc
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableOwnerID(u *uuid.UUID) *TaskCreate {
+	if u != nil {
+		tc.SetOwnerID(*u)
+	}
+	return tc
+}
+
 // SetID sets the "id" field.
 func (tc *TaskCreate) SetID(u uuid.UUID) *TaskCreate {
 	tc.mutation.SetID(u)
@@ -246,6 +330,16 @@ func (tc *TaskCreate) SetAgent(a *Agent) *TaskCreate {
 	return tc.SetAgentID(a.ID)
 }
 
+// SetSchedule sets the "schedule" edge to the Schedule entity.
+func (tc *TaskCreate) SetSchedule(s *Schedule) *TaskCreate {
+	return tc.SetScheduleID(s.ID)
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (tc *TaskCreate) SetOwner(u *User) *TaskCreate {
+	return tc.SetOwnerID(u.ID)
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (tc *TaskCreate) Mutation() *TaskMutation {
 	return tc.mutation
@@ -305,6 +399,10 @@ func (tc *TaskCreate) defaults() {
 		v := task.DefaultPhase
 		tc.mutation.SetPhase(v)
 	}
+	if _, ok := tc.mutation.PersistentState(); !ok {
+		v := task.DefaultPersistentState
+		tc.mutation.SetPersistentState(v)
+	}
 	if _, ok := tc.mutation.ID(); !ok {
 		v := task.DefaultID()
 		tc.mutation.SetID(v)
@@ -341,6 +439,9 @@ func (tc *TaskCreate) check() error {
 			return &ValidationError{Name: "phase", err: fmt.Errorf(`memory: validator failed for field "Task.phase": %w`, err)}
 		}
 	}
+	if _, ok := tc.mutation.PersistentState(); !ok {
+		return &ValidationError{Name: "persistent_state", err: errors.New(`memory: missing required field "Task.persistent_state"`)}
+	}
 	return nil
 }
 
@@ -424,10 +525,30 @@ func (tc *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		_spec.SetField(task.FieldPhase, field.TypeEnum, value)
 		_node.Phase = value
 	}
+	if value, ok := tc.mutation.MaxCost(); ok {
+		_spec.SetField(task.FieldMaxCost, field.TypeFloat64, value)
+		_node.MaxCost = value
+	}
+	if value, ok := tc.mutation.InstructionFiles(); ok {
+		_spec.SetField(task.FieldInstructionFiles, field.TypeJSON, value)
+		_node.InstructionFiles = value
+	}
+	if value, ok := tc.mutation.PersistentState(); ok {
+		_spec.SetField(task.FieldPersistentState, field.TypeBool, value)
+		_node.PersistentState = value
+	}
+	if value, ok := tc.mutation.Preview(); ok {
+		_spec.SetField(task.FieldPreview, field.TypeJSON, value)
+		_node.Preview = value
+	}
 	if value, ok := tc.mutation.Description(); ok {
 		_spec.SetField(task.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := tc.mutation.ParentID(); ok {
+		_spec.SetField(task.FieldParentID, field.TypeUUID, value)
+		_node.ParentID = value
+	}
 	if nodes := tc.mutation.MessagesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -461,6 +582,40 @@ func (tc *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		_node.AgentID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := tc.mutation.ScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   task.ScheduleTable,
+			Columns: []string{task.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ScheduleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   task.OwnerTable,
+			Columns: []string{task.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OwnerID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
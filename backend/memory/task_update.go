@@ -10,12 +10,15 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/furisto/construct/backend/memory/agent"
 	"github.com/furisto/construct/backend/memory/message"
 	"github.com/furisto/construct/backend/memory/predicate"
+	"github.com/furisto/construct/backend/memory/schedule"
 	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/furisto/construct/backend/memory/task"
+	"github.com/furisto/construct/backend/memory/user"
 	"github.com/google/uuid"
 )
 
@@ -249,6 +252,77 @@ func (tu *TaskUpdate) SetNillablePhase(tp *types.TaskPhase) *TaskUpdate {
 	return tu
 }
 
+// SetMaxCost sets the "max_cost" field.
+func (tu *TaskUpdate) SetMaxCost(f float64) *TaskUpdate {
+	tu.mutation.ResetMaxCost()
+	tu.mutation.SetMaxCost(f)
+	return tu
+}
+
+// SetNillableMaxCost sets the "max_cost" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableMaxCost(f *float64) *TaskUpdate {
+	if f != nil {
+		tu.SetMaxCost(*f)
+	}
+	return tu
+}
+
+// AddMaxCost adds f to the "max_cost" field.
+func (tu *TaskUpdate) AddMaxCost(f float64) *TaskUpdate {
+	tu.mutation.AddMaxCost(f)
+	return tu
+}
+
+// ClearMaxCost clears the value of the "max_cost" field.
+func (tu *TaskUpdate) ClearMaxCost() *TaskUpdate {
+	tu.mutation.ClearMaxCost()
+	return tu
+}
+
+// SetInstructionFiles sets the "instruction_files" field.
+func (tu *TaskUpdate) SetInstructionFiles(s []string) *TaskUpdate {
+	tu.mutation.SetInstructionFiles(s)
+	return tu
+}
+
+// AppendInstructionFiles appends s to the "instruction_files" field.
+func (tu *TaskUpdate) AppendInstructionFiles(s []string) *TaskUpdate {
+	tu.mutation.AppendInstructionFiles(s)
+	return tu
+}
+
+// ClearInstructionFiles clears the value of the "instruction_files" field.
+func (tu *TaskUpdate) ClearInstructionFiles() *TaskUpdate {
+	tu.mutation.ClearInstructionFiles()
+	return tu
+}
+
+// SetPersistentState sets the "persistent_state" field.
+func (tu *TaskUpdate) SetPersistentState(b bool) *TaskUpdate {
+	tu.mutation.SetPersistentState(b)
+	return tu
+}
+
+// SetNillablePersistentState sets the "persistent_state" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillablePersistentState(b *bool) *TaskUpdate {
+	if b != nil {
+		tu.SetPersistentState(*b)
+	}
+	return tu
+}
+
+// SetPreview sets the "preview" field.
+func (tu *TaskUpdate) SetPreview(tp *types.TaskPreview) *TaskUpdate {
+	tu.mutation.SetPreview(tp)
+	return tu
+}
+
+// ClearPreview clears the value of the "preview" field.
+func (tu *TaskUpdate) ClearPreview() *TaskUpdate {
+	tu.mutation.ClearPreview()
+	return tu
+}
+
 // SetDescription sets the "description" field.
 func (tu *TaskUpdate) SetDescription(s string) *TaskUpdate {
 	tu.mutation.SetDescription(s)
@@ -269,6 +343,26 @@ func (tu *TaskUpdate) ClearDescription() *TaskUpdate {
 	return tu
 }
 
+// SetParentID sets the "parent_id" field.
+func (tu *TaskUpdate) SetParentID(u uuid.UUID) *TaskUpdate {
+	tu.mutation.SetParentID(u)
+	return tu
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableParentID(u *uuid.UUID) *TaskUpdate {
+	if u != nil {
+		tu.SetParentID(*u)
+	}
+	return tu
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (tu *TaskUpdate) ClearParentID() *TaskUpdate {
+	tu.mutation.ClearParentID()
+	return tu
+}
+
 // SetAgentID sets the "agent_id" field.
 func (tu *TaskUpdate) SetAgentID(u uuid.UUID) *TaskUpdate {
 	tu.mutation.SetAgentID(u)
@@ -289,6 +383,46 @@ func (tu *TaskUpdate) ClearAgentID() *TaskUpdate {
 	return tu
 }
 
+// SetScheduleID sets the "schedule_id" field.
+func (tu *TaskUpdate) SetScheduleID(u uuid.UUID) *TaskUpdate {
+	tu.mutation.SetScheduleID(u)
+	return tu
+}
+
+// SetNillableScheduleID sets the "schedule_id" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableScheduleID(u *uuid.UUID) *TaskUpdate {
+	if u != nil {
+		tu.SetScheduleID(*u)
+	}
+	return tu
+}
+
+// ClearScheduleID clears the value of the "schedule_id" field.
+func (tu *TaskUpdate) ClearScheduleID() *TaskUpdate {
+	tu.mutation.ClearScheduleID()
+	return tu
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (tu *TaskUpdate) SetOwnerID(u uuid.UUID) *TaskUpdate {
+	tu.mutation.SetOwnerID(u)
+	return tu
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableOwnerID(u *uuid.UUID) *TaskUpdate {
+	if u != nil {
+		tu.SetOwnerID(*u)
+	}
+	return tu
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (tu *TaskUpdate) ClearOwnerID() *TaskUpdate {
+	tu.mutation.ClearOwnerID()
+	return tu
+}
+
 // AddMessageIDs adds the "messages" edge to the Message entity by IDs.
 func (tu *TaskUpdate) AddMessageIDs(ids ...uuid.UUID) *TaskUpdate {
 	tu.mutation.AddMessageIDs(ids...)
@@ -309,6 +443,16 @@ func (tu *TaskUpdate) SetAgent(a *Agent) *TaskUpdate {
 	return tu.SetAgentID(a.ID)
 }
 
+// SetSchedule sets the "schedule" edge to the Schedule entity.
+func (tu *TaskUpdate) SetSchedule(s *Schedule) *TaskUpdate {
+	return tu.SetScheduleID(s.ID)
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (tu *TaskUpdate) SetOwner(u *User) *TaskUpdate {
+	return tu.SetOwnerID(u.ID)
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (tu *TaskUpdate) Mutation() *TaskMutation {
 	return tu.mutation
@@ -341,6 +485,18 @@ func (tu *TaskUpdate) ClearAgent() *TaskUpdate {
 	return tu
 }
 
+// ClearSchedule clears the "schedule" edge to the Schedule entity.
+func (tu *TaskUpdate) ClearSchedule() *TaskUpdate {
+	tu.mutation.ClearSchedule()
+	return tu
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (tu *TaskUpdate) ClearOwner() *TaskUpdate {
+	tu.mutation.ClearOwner()
+	return tu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (tu *TaskUpdate) Save(ctx context.Context) (int, error) {
 	tu.defaults()
@@ -479,12 +635,47 @@ func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := tu.mutation.Phase(); ok {
 		_spec.SetField(task.FieldPhase, field.TypeEnum, value)
 	}
+	if value, ok := tu.mutation.MaxCost(); ok {
+		_spec.SetField(task.FieldMaxCost, field.TypeFloat64, value)
+	}
+	if value, ok := tu.mutation.AddedMaxCost(); ok {
+		_spec.AddField(task.FieldMaxCost, field.TypeFloat64, value)
+	}
+	if tu.mutation.MaxCostCleared() {
+		_spec.ClearField(task.FieldMaxCost, field.TypeFloat64)
+	}
+	if value, ok := tu.mutation.InstructionFiles(); ok {
+		_spec.SetField(task.FieldInstructionFiles, field.TypeJSON, value)
+	}
+	if value, ok := tu.mutation.AppendedInstructionFiles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, task.FieldInstructionFiles, value)
+		})
+	}
+	if tu.mutation.InstructionFilesCleared() {
+		_spec.ClearField(task.FieldInstructionFiles, field.TypeJSON)
+	}
+	if value, ok := tu.mutation.PersistentState(); ok {
+		_spec.SetField(task.FieldPersistentState, field.TypeBool, value)
+	}
+	if value, ok := tu.mutation.Preview(); ok {
+		_spec.SetField(task.FieldPreview, field.TypeJSON, value)
+	}
+	if tu.mutation.PreviewCleared() {
+		_spec.ClearField(task.FieldPreview, field.TypeJSON)
+	}
 	if value, ok := tu.mutation.Description(); ok {
 		_spec.SetField(task.FieldDescription, field.TypeString, value)
 	}
 	if tu.mutation.DescriptionCleared() {
 		_spec.ClearField(task.FieldDescription, field.TypeString)
 	}
+	if value, ok := tu.mutation.ParentID(); ok {
+		_spec.SetField(task.FieldParentID, field.TypeUUID, value)
+	}
+	if tu.mutation.ParentIDCleared() {
+		_spec.ClearField(task.FieldParentID, field.TypeUUID)
+	}
 	if tu.mutation.MessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -559,6 +750,64 @@ func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tu.mutation.ScheduleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   task.ScheduleTable,
+			Columns: []string{task.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.ScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   task.ScheduleTable,
+			Columns: []string{task.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   task.OwnerTable,
+			Columns: []string{task.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   task.OwnerTable,
+			Columns: []string{task.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(tu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -797,6 +1046,77 @@ func (tuo *TaskUpdateOne) SetNillablePhase(tp *types.TaskPhase) *TaskUpdateOne {
 	return tuo
 }
 
+// SetMaxCost sets the "max_cost" field.
+func (tuo *TaskUpdateOne) SetMaxCost(f float64) *TaskUpdateOne {
+	tuo.mutation.ResetMaxCost()
+	tuo.mutation.SetMaxCost(f)
+	return tuo
+}
+
+// SetNillableMaxCost sets the "max_cost" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableMaxCost(f *float64) *TaskUpdateOne {
+	if f != nil {
+		tuo.SetMaxCost(*f)
+	}
+	return tuo
+}
+
+// AddMaxCost adds f to the "max_cost" field.
+func (tuo *TaskUpdateOne) AddMaxCost(f float64) *TaskUpdateOne {
+	tuo.mutation.AddMaxCost(f)
+	return tuo
+}
+
+// ClearMaxCost clears the value of the "max_cost" field.
+func (tuo *TaskUpdateOne) ClearMaxCost() *TaskUpdateOne {
+	tuo.mutation.ClearMaxCost()
+	return tuo
+}
+
+// SetInstructionFiles sets the "instruction_files" field.
+func (tuo *TaskUpdateOne) SetInstructionFiles(s []string) *TaskUpdateOne {
+	tuo.mutation.SetInstructionFiles(s)
+	return tuo
+}
+
+// AppendInstructionFiles appends s to the "instruction_files" field.
+func (tuo *TaskUpdateOne) AppendInstructionFiles(s []string) *TaskUpdateOne {
+	tuo.mutation.AppendInstructionFiles(s)
+	return tuo
+}
+
+// ClearInstructionFiles clears the value of the "instruction_files" field.
+func (tuo *TaskUpdateOne) ClearInstructionFiles() *TaskUpdateOne {
+	tuo.mutation.ClearInstructionFiles()
+	return tuo
+}
+
+// SetPersistentState sets the "persistent_state" field.
+func (tuo *TaskUpdateOne) SetPersistentState(b bool) *TaskUpdateOne {
+	tuo.mutation.SetPersistentState(b)
+	return tuo
+}
+
+// SetNillablePersistentState sets the "persistent_state" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillablePersistentState(b *bool) *TaskUpdateOne {
+	if b != nil {
+		tuo.SetPersistentState(*b)
+	}
+	return tuo
+}
+
+// SetPreview sets the "preview" field.
+func (tuo *TaskUpdateOne) SetPreview(tp *types.TaskPreview) *TaskUpdateOne {
+	tuo.mutation.SetPreview(tp)
+	return tuo
+}
+
+// ClearPreview clears the value of the "preview" field.
+func (tuo *TaskUpdateOne) ClearPreview() *TaskUpdateOne {
+	tuo.mutation.ClearPreview()
+	return tuo
+}
+
 // SetDescription sets the "description" field.
 func (tuo *TaskUpdateOne) SetDescription(s string) *TaskUpdateOne {
 	tuo.mutation.SetDescription(s)
@@ -817,6 +1137,26 @@ func (tuo *TaskUpdateOne) ClearDescription() *TaskUpdateOne {
 	return tuo
 }
 
+// SetParentID sets the "parent_id" field.
+func (tuo *TaskUpdateOne) SetParentID(u uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.SetParentID(u)
+	return tuo
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableParentID(u *uuid.UUID) *TaskUpdateOne {
+	if u != nil {
+		tuo.SetParentID(*u)
+	}
+	return tuo
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (tuo *TaskUpdateOne) ClearParentID() *TaskUpdateOne {
+	tuo.mutation.ClearParentID()
+	return tuo
+}
+
 // SetAgentID sets the "agent_id" field.
 func (tuo *TaskUpdateOne) SetAgentID(u uuid.UUID) *TaskUpdateOne {
 	tuo.mutation.SetAgentID(u)
@@ -837,6 +1177,46 @@ func (tuo *TaskUpdateOne) ClearAgentID() *TaskUpdateOne {
 	return tuo
 }
 
+// SetScheduleID sets the "schedule_id" field.
+func (tuo *TaskUpdateOne) SetScheduleID(u uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.SetScheduleID(u)
+	return tuo
+}
+
+// SetNillableScheduleID sets the "schedule_id" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableScheduleID(u *uuid.UUID) *TaskUpdateOne {
+	if u != nil {
+		tuo.SetScheduleID(*u)
+	}
+	return tuo
+}
+
+// ClearScheduleID clears the value of the "schedule_id" field.
+func (tuo *TaskUpdateOne) ClearScheduleID() *TaskUpdateOne {
+	tuo.mutation.ClearScheduleID()
+	return tuo
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (tuo *TaskUpdateOne) SetOwnerID(u uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.SetOwnerID(u)
+	return tuo
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableOwnerID(u *uuid.UUID) *TaskUpdateOne {
+	if u != nil {
+		tuo.SetOwnerID(*u)
+	}
+	return tuo
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (tuo *TaskUpdateOne) ClearOwnerID() *TaskUpdateOne {
+	tuo.mutation.ClearOwnerID()
+	return tuo
+}
+
 // AddMessageIDs adds the "messages" edge to the Message entity by IDs.
 func (tuo *TaskUpdateOne) AddMessageIDs(ids ...uuid.UUID) *TaskUpdateOne {
 	tuo.mutation.AddMessageIDs(ids...)
@@ -857,6 +1237,16 @@ func (tuo *TaskUpdateOne) SetAgent(a *Agent) *TaskUpdateOne {
 	return tuo.SetAgentID(a.ID)
 }
 
+// SetSchedule sets the "schedule" edge to the Schedule entity.
+func (tuo *TaskUpdateOne) SetSchedule(s *Schedule) *TaskUpdateOne {
+	return tuo.SetScheduleID(s.ID)
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (tuo *TaskUpdateOne) SetOwner(u *User) *TaskUpdateOne {
+	return tuo.SetOwnerID(u.ID)
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (tuo *TaskUpdateOne) Mutation() *TaskMutation {
 	return tuo.mutation
@@ -889,6 +1279,18 @@ func (tuo *TaskUpdateOne) ClearAgent() *TaskUpdateOne {
 	return tuo
 }
 
+// ClearSchedule clears the "schedule" edge to the Schedule entity.
+func (tuo *TaskUpdateOne) ClearSchedule() *TaskUpdateOne {
+	tuo.mutation.ClearSchedule()
+	return tuo
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (tuo *TaskUpdateOne) ClearOwner() *TaskUpdateOne {
+	tuo.mutation.ClearOwner()
+	return tuo
+}
+
 // Where appends a list predicates to the TaskUpdate builder.
 func (tuo *TaskUpdateOne) Where(ps ...predicate.Task) *TaskUpdateOne {
 	tuo.mutation.Where(ps...)
@@ -1057,12 +1459,47 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 	if value, ok := tuo.mutation.Phase(); ok {
 		_spec.SetField(task.FieldPhase, field.TypeEnum, value)
 	}
+	if value, ok := tuo.mutation.MaxCost(); ok {
+		_spec.SetField(task.FieldMaxCost, field.TypeFloat64, value)
+	}
+	if value, ok := tuo.mutation.AddedMaxCost(); ok {
+		_spec.AddField(task.FieldMaxCost, field.TypeFloat64, value)
+	}
+	if tuo.mutation.MaxCostCleared() {
+		_spec.ClearField(task.FieldMaxCost, field.TypeFloat64)
+	}
+	if value, ok := tuo.mutation.InstructionFiles(); ok {
+		_spec.SetField(task.FieldInstructionFiles, field.TypeJSON, value)
+	}
+	if value, ok := tuo.mutation.AppendedInstructionFiles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, task.FieldInstructionFiles, value)
+		})
+	}
+	if tuo.mutation.InstructionFilesCleared() {
+		_spec.ClearField(task.FieldInstructionFiles, field.TypeJSON)
+	}
+	if value, ok := tuo.mutation.PersistentState(); ok {
+		_spec.SetField(task.FieldPersistentState, field.TypeBool, value)
+	}
+	if value, ok := tuo.mutation.Preview(); ok {
+		_spec.SetField(task.FieldPreview, field.TypeJSON, value)
+	}
+	if tuo.mutation.PreviewCleared() {
+		_spec.ClearField(task.FieldPreview, field.TypeJSON)
+	}
 	if value, ok := tuo.mutation.Description(); ok {
 		_spec.SetField(task.FieldDescription, field.TypeString, value)
 	}
 	if tuo.mutation.DescriptionCleared() {
 		_spec.ClearField(task.FieldDescription, field.TypeString)
 	}
+	if value, ok := tuo.mutation.ParentID(); ok {
+		_spec.SetField(task.FieldParentID, field.TypeUUID, value)
+	}
+	if tuo.mutation.ParentIDCleared() {
+		_spec.ClearField(task.FieldParentID, field.TypeUUID)
+	}
 	if tuo.mutation.MessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1137,6 +1574,64 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tuo.mutation.ScheduleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   task.ScheduleTable,
+			Columns: []string{task.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.ScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   task.ScheduleTable,
+			Columns: []string{task.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   task.OwnerTable,
+			Columns: []string{task.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   task.OwnerTable,
+			Columns: []string{task.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(tuo.modifiers...)
 	_node = &Task{config: tuo.config}
 	_spec.Assign = _node.assignValues
@@ -3,6 +3,7 @@ package test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/furisto/construct/backend/memory"
 	"github.com/furisto/construct/backend/memory/schema/types"
@@ -225,7 +226,10 @@ type TaskBuilder struct {
 	*entityBuilder
 	taskID uuid.UUID
 
-	agentID uuid.UUID
+	agentID    uuid.UUID
+	scheduleID uuid.UUID
+	parentID   uuid.UUID
+	preview    *types.TaskPreview
 }
 
 func NewTaskBuilder(t *testing.T, id uuid.UUID, db *memory.Client, agent *memory.Agent) *TaskBuilder {
@@ -241,11 +245,39 @@ func (b *TaskBuilder) WithID(id uuid.UUID) *TaskBuilder {
 	return b
 }
 
+func (b *TaskBuilder) WithSchedule(schedule *memory.Schedule) *TaskBuilder {
+	b.scheduleID = schedule.ID
+	return b
+}
+
+func (b *TaskBuilder) WithParent(parent *memory.Task) *TaskBuilder {
+	b.parentID = parent.ID
+	return b
+}
+
+func (b *TaskBuilder) WithPreview(preview *types.TaskPreview) *TaskBuilder {
+	b.preview = preview
+	return b
+}
+
 func (b *TaskBuilder) Build(ctx context.Context) *memory.Task {
-	task, err := b.db.Task.Create().
+	create := b.db.Task.Create().
 		SetID(b.taskID).
-		SetAgentID(b.agentID).
-		Save(ctx)
+		SetAgentID(b.agentID)
+
+	if b.scheduleID != uuid.Nil {
+		create = create.SetScheduleID(b.scheduleID)
+	}
+
+	if b.parentID != uuid.Nil {
+		create = create.SetParentID(b.parentID)
+	}
+
+	if b.preview != nil {
+		create = create.SetPreview(b.preview)
+	}
+
+	task, err := create.Save(ctx)
 
 	if err != nil {
 		b.t.Fatalf("failed to create task: %v", err)
@@ -254,6 +286,123 @@ func (b *TaskBuilder) Build(ctx context.Context) *memory.Task {
 	return task
 }
 
+type ScheduleBuilder struct {
+	*entityBuilder
+	scheduleID uuid.UUID
+
+	agentID        uuid.UUID
+	name           string
+	cronExpression string
+	workspace      string
+	prompt         string
+	enabled        bool
+}
+
+func NewScheduleBuilder(t *testing.T, id uuid.UUID, db *memory.Client, agent *memory.Agent) *ScheduleBuilder {
+	return &ScheduleBuilder{
+		entityBuilder:  newEntityBuilder(t, db),
+		scheduleID:     id,
+		agentID:        agent.ID,
+		name:           "nightly-review",
+		cronExpression: "0 2 * * *",
+		workspace:      "/workspace/project",
+		prompt:         "Review the changes of the last day",
+		enabled:        true,
+	}
+}
+
+func (b *ScheduleBuilder) WithName(name string) *ScheduleBuilder {
+	b.name = name
+	return b
+}
+
+func (b *ScheduleBuilder) WithEnabled(enabled bool) *ScheduleBuilder {
+	b.enabled = enabled
+	return b
+}
+
+func (b *ScheduleBuilder) Build(ctx context.Context) *memory.Schedule {
+	schedule, err := b.db.Schedule.Create().
+		SetID(b.scheduleID).
+		SetAgentID(b.agentID).
+		SetName(b.name).
+		SetCronExpression(b.cronExpression).
+		SetProjectDirectory(b.workspace).
+		SetPrompt(b.prompt).
+		SetEnabled(b.enabled).
+		Save(ctx)
+
+	if err != nil {
+		b.t.Fatalf("failed to create schedule: %v", err)
+	}
+
+	return schedule
+}
+
+type ScheduleRunBuilder struct {
+	*entityBuilder
+	runID uuid.UUID
+
+	scheduleID    uuid.UUID
+	status        types.ScheduleRunStatus
+	reason        string
+	scheduledTime time.Time
+	runTime       time.Time
+	taskID        uuid.UUID
+}
+
+func NewScheduleRunBuilder(t *testing.T, id uuid.UUID, db *memory.Client, schedule *memory.Schedule) *ScheduleRunBuilder {
+	runTime := time.Date(2026, 3, 14, 2, 0, 0, 0, time.UTC)
+	return &ScheduleRunBuilder{
+		entityBuilder: newEntityBuilder(t, db),
+		runID:         id,
+		scheduleID:    schedule.ID,
+		status:        types.ScheduleRunStatusStarted,
+		scheduledTime: runTime,
+		runTime:       runTime,
+	}
+}
+
+func (b *ScheduleRunBuilder) WithSkipped(reason string) *ScheduleRunBuilder {
+	b.status = types.ScheduleRunStatusSkipped
+	b.reason = reason
+	return b
+}
+
+func (b *ScheduleRunBuilder) WithRunTime(runTime time.Time) *ScheduleRunBuilder {
+	b.scheduledTime = runTime
+	b.runTime = runTime
+	return b
+}
+
+func (b *ScheduleRunBuilder) WithTask(task *memory.Task) *ScheduleRunBuilder {
+	b.taskID = task.ID
+	return b
+}
+
+func (b *ScheduleRunBuilder) Build(ctx context.Context) *memory.ScheduleRun {
+	create := b.db.ScheduleRun.Create().
+		SetID(b.runID).
+		SetScheduleID(b.scheduleID).
+		SetStatus(b.status).
+		SetScheduledTime(b.scheduledTime).
+		SetRunTime(b.runTime)
+
+	if b.reason != "" {
+		create = create.SetReason(b.reason)
+	}
+	if b.taskID != uuid.Nil {
+		create = create.SetTaskID(b.taskID)
+	}
+
+	run, err := create.Save(ctx)
+	if err != nil {
+		b.t.Fatalf("failed to create schedule run: %v", err)
+	}
+
+	return run
+}
+
 type MessageBuilder struct {
 	*entityBuilder
 	messageID uuid.UUID
@@ -264,6 +413,9 @@ type MessageBuilder struct {
 	modelID uuid.UUID
 	source  types.MessageSource
 	content *types.MessageContent
+	usage   *types.MessageUsage
+
+	processedTime time.Time
 }
 
 func NewMessageBuilder(t *testing.T, id uuid.UUID, db *memory.Client, task *memory.Task) *MessageBuilder {
@@ -297,6 +449,21 @@ func (b *MessageBuilder) WithContent(content *types.MessageContent) *MessageBuil
 	return b
 }
 
+func (b *MessageBuilder) WithSource(source types.MessageSource) *MessageBuilder {
+	b.source = source
+	return b
+}
+
+func (b *MessageBuilder) WithUsage(usage *types.MessageUsage) *MessageBuilder {
+	b.usage = usage
+	return b
+}
+
+func (b *MessageBuilder) WithProcessedTime(processedTime time.Time) *MessageBuilder {
+	b.processedTime = processedTime
+	return b
+}
+
 func (b *MessageBuilder) WithID(id uuid.UUID) *MessageBuilder {
 	b.messageID = id
 	return b
@@ -317,6 +484,14 @@ func (b *MessageBuilder) Build(ctx context.Context) *memory.Message {
 		create.SetModelID(b.modelID)
 	}
 
+	if b.usage != nil {
+		create.SetUsage(b.usage)
+	}
+
+	if !b.processedTime.IsZero() {
+		create.SetProcessedTime(b.processedTime)
+	}
+
 	message, err := create.Save(ctx)
 
 	if err != nil {
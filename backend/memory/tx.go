@@ -14,14 +14,28 @@ type Tx struct {
 	config
 	// Agent is the client for interacting with the Agent builders.
 	Agent *AgentClient
+	// AuthToken is the client for interacting with the AuthToken builders.
+	AuthToken *AuthTokenClient
 	// Message is the client for interacting with the Message builders.
 	Message *MessageClient
 	// Model is the client for interacting with the Model builders.
 	Model *ModelClient
 	// ModelProvider is the client for interacting with the ModelProvider builders.
 	ModelProvider *ModelProviderClient
+	// Schedule is the client for interacting with the Schedule builders.
+	Schedule *ScheduleClient
+	// ScheduleRun is the client for interacting with the ScheduleRun builders.
+	ScheduleRun *ScheduleRunClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
+	// Tool is the client for interacting with the Tool builders.
+	Tool *ToolClient
+	// User is the client for interacting with the User builders.
+	User *UserClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDeadLetter is the client for interacting with the WebhookDeadLetter builders.
+	WebhookDeadLetter *WebhookDeadLetterClient
 
 	// lazily loaded.
 	client     *Client
@@ -154,10 +168,17 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Agent = NewAgentClient(tx.config)
+	tx.AuthToken = NewAuthTokenClient(tx.config)
 	tx.Message = NewMessageClient(tx.config)
 	tx.Model = NewModelClient(tx.config)
 	tx.ModelProvider = NewModelProviderClient(tx.config)
+	tx.Schedule = NewScheduleClient(tx.config)
+	tx.ScheduleRun = NewScheduleRunClient(tx.config)
 	tx.Task = NewTaskClient(tx.config)
+	tx.Tool = NewToolClient(tx.config)
+	tx.User = NewUserClient(tx.config)
+	tx.Webhook = NewWebhookClient(tx.config)
+	tx.WebhookDeadLetter = NewWebhookDeadLetterClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
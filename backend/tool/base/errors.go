@@ -19,6 +19,7 @@ const (
 	Internal
 	None
 	InvalidInput
+	FileModified
 )
 
 func (e ErrorCode) String() string {
@@ -43,6 +44,8 @@ func (e ErrorCode) String() string {
 		return "Internal error"
 	case InvalidInput:
 		return "Invalid argument"
+	case FileModified:
+		return "File was modified since it was last read"
 	}
 	return ""
 }
@@ -87,6 +90,11 @@ func (e ErrorCode) Suggestion() []string {
 		return []string{
 			"An internal error occurred. This is a bug with the tool itself. Try to work around it.",
 		}
+	case FileModified:
+		return []string{
+			"The user or another process changed the file while you were working on it. Do not overwrite their changes.",
+			"Read the file again with read_file and redo your change against its current content.",
+		}
 	}
 	return []string{}
 }
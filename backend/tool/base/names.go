@@ -1,16 +1,67 @@
 package base
 
 const (
-	ToolNameCodeInterpreter = "code_interpreter"
-	ToolNameEditFile        = "edit_file"
-	ToolNameSubmitReport    = "submit_report"
-	ToolNameCreateFile      = "create_file"
-	ToolNameReadFile        = "read_file"
-	ToolNameExecuteCommand  = "execute_command"
-	ToolNameFindFile        = "find_file"
-	ToolNameHandoff         = "handoff"
-	ToolNameListFiles       = "list_files"
-	ToolNameGrep            = "grep"
-	ToolNamePrint           = "print"
-	ToolNameAskUser         = "ask_user"
+	ToolNameCodeInterpreter  = "code_interpreter"
+	ToolNameEditFile         = "edit_file"
+	ToolNameSubmitReport     = "submit_report"
+	ToolNameCreateFile       = "create_file"
+	ToolNameReadFile         = "read_file"
+	ToolNameExecuteCommand   = "execute_command"
+	ToolNameFindFile         = "find_file"
+	ToolNameHandoff          = "handoff"
+	ToolNameListFiles        = "list_files"
+	ToolNameGrep             = "grep"
+	ToolNamePrint            = "print"
+	ToolNameAskUser          = "ask_user"
+	ToolNameDelegate         = "delegate"
+	ToolNameRunParallel      = "run_parallel"
+	ToolNameResetState       = "reset_state"
+	ToolNameBeginTransaction = "begin_transaction"
+	ToolNameDeleteFile       = "delete_file"
+	ToolNameMoveFile         = "move_file"
+	ToolNameCopyFile         = "copy_file"
+	ToolNameApplyPatch       = "apply_patch"
+	ToolNameGitStatus        = "git.status"
+	ToolNameGitDiff          = "git.diff"
+	ToolNameGitLog           = "git.log"
+	ToolNameGitShow          = "git.show"
+	ToolNameGitBlame         = "git.blame"
+	ToolNameGitBranch        = "git.branch"
+	ToolNameGitStash         = "git.stash"
+	ToolNameGitCommit        = "git.commit"
+	ToolNameRunTests         = "run_tests"
 )
+
+// BuiltinToolNames are the names of the builtin CodeAct functions. Custom
+// tools may not use them.
+var BuiltinToolNames = []string{
+	ToolNameCodeInterpreter,
+	ToolNameEditFile,
+	ToolNameSubmitReport,
+	ToolNameCreateFile,
+	ToolNameReadFile,
+	ToolNameExecuteCommand,
+	ToolNameFindFile,
+	ToolNameHandoff,
+	ToolNameListFiles,
+	ToolNameGrep,
+	ToolNamePrint,
+	ToolNameAskUser,
+	ToolNameDelegate,
+	ToolNameRunParallel,
+	ToolNameResetState,
+	ToolNameBeginTransaction,
+	ToolNameDeleteFile,
+	ToolNameMoveFile,
+	ToolNameCopyFile,
+	ToolNameApplyPatch,
+	ToolNameGitStatus,
+	ToolNameGitDiff,
+	ToolNameGitLog,
+	ToolNameGitShow,
+	ToolNameGitBlame,
+	ToolNameGitBranch,
+	ToolNameGitStash,
+	ToolNameGitCommit,
+	ToolNameRunTests,
+}
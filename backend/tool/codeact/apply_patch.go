@@ -0,0 +1,156 @@
+package codeact
+
+import (
+	"fmt"
+
+	"github.com/grafana/sobek"
+
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/furisto/construct/backend/tool/filesystem"
+)
+
+const applyPatchDescription = `
+## Description
+Applies a unified diff that can change, create and delete several files at once. Hunks that do not match exactly are searched near their expected position, then with whitespace differences ignored and finally with up to two context lines ignored at each end. The patch is applied completely or not at all: if any hunk cannot be applied no file is changed.
+
+## Parameters
+- **patch** (string, required): A unified diff. Every file starts with a "--- old path" and "+++ new path" header followed by its hunks. Use /dev/null as the old path to create a file and as the new path to delete it. Relative paths and the a/ and b/ prefixes of git are resolved against the project directory.
+- **options** (object, optional):
+  - **dry_run** (boolean): Check where the hunks apply and return the changes without writing them. Defaults to false.
+
+## Expected Output
+Returns the changed files with a patch preview of each file and the position every hunk was applied at:
+%[1]s
+{
+  "files": [
+    {
+      "path": "/workspace/project/src/app.js",
+      "action": "modified", // "created", "modified" or "deleted"
+      "patch_info": {
+        "patch": "--- app.js\n+++ app.js\n@@ -1,3 +1,3 @@\n...",
+        "lines_added": 1,
+        "lines_removed": 1
+      },
+      "hunks": [
+        { "hunk": 1, "applied": true, "line": 12, "offset": 2, "fuzzy": false }
+      ]
+    }
+  ]
+}
+%[1]s
+If a hunk cannot be applied it throws an exception listing the failed hunks. With dry_run the failures are reported in the result instead, with "applied": false and an "error" for each failed hunk.
+
+## IMPORTANT USAGE NOTES
+- **Prefer edit_file for small changes**: Use apply_patch for changes that span many places or files
+- **Exact context lines**: Copy context and removed lines from read_file output without the line numbers. Start context lines with a space, removed lines with - and added lines with +
+- **Line numbers are hints**: The line numbers of the @@ headers only decide where the search starts, a bare @@ without numbers is accepted
+- **Check the offsets**: A large offset or a fuzzy match can mean the hunk applied at an unexpected place. Use dry_run when unsure
+- **Deleting files**: Patches that delete files cannot be applied during a transaction or in preview mode
+- **Changes by the user**: If a file of the patch changed since you last read it, apply_patch throws "File was modified since it was last read" and changes no file. Read the file again and recreate the patch against its current content
+
+## Usage Examples
+
+### Rename a function and its caller
+%[1]s
+apply_patch(` + "`" + `--- a/src/math.js
++++ b/src/math.js
+@@ -1,3 +1,3 @@
+-function add(a, b) {
++function sum(a, b) {
+   return a + b;
+ }
+--- a/src/index.js
++++ b/src/index.js
+@@ -4,1 +4,1 @@
+-console.log(add(1, 2));
++console.log(sum(1, 2));
+` + "`" + `);
+%[1]s
+
+### Check a patch before applying it
+%[1]s
+const check = apply_patch(patch, { dry_run: true });
+const failed = check.files.flatMap(f => f.hunks.filter(h => !h.applied).map(h => f.path + ": " + h.error));
+if (failed.length > 0) {
+  print(failed.join("\n"));
+} else {
+  apply_patch(patch);
+}
+%[1]s
+`
+
+func NewApplyPatchTool() Tool {
+	return NewOnDemandTool(
+		base.ToolNameApplyPatch,
+		fmt.Sprintf(applyPatchDescription, "```"),
+		applyPatchInput,
+		applyPatchHandler,
+	)
+}
+
+func applyPatchInput(session *Session, args []sobek.Value) (any, error) {
+	if len(args) < 1 || len(args) > 2 || !isString(args[0]) {
+		return nil, base.NewCustomError(base.InvalidInput.String(), applyPatchSuggestions)
+	}
+
+	input := &filesystem.ApplyPatchInput{
+		Patch:            args[0].String(),
+		WorkingDirectory: session.Task.ProjectDirectory,
+	}
+
+	if len(args) == 2 && !sobek.IsUndefined(args[1]) && !sobek.IsNull(args[1]) {
+		options, ok := args[1].Export().(map[string]any)
+		if !ok {
+			return nil, base.NewCustomError(base.InvalidInput.String(), applyPatchSuggestions)
+		}
+		if dryRun, ok := options["dry_run"]; ok {
+			if input.DryRun, ok = dryRun.(bool); !ok {
+				return nil, base.NewCustomError(base.InvalidInput.String(), applyPatchSuggestions)
+			}
+		}
+	}
+
+	return input, nil
+}
+
+func applyPatchHandler(session *Session) func(call sobek.FunctionCall) sobek.Value {
+	return func(call sobek.FunctionCall) sobek.Value {
+		input, err := applyPatchInput(session, call.Arguments)
+		if err != nil {
+			session.Throw(err)
+		}
+
+		patchInput := input.(*filesystem.ApplyPatchInput)
+		if !patchInput.DryRun && filesystem.PatchDeletesFiles(patchInput.Patch) {
+			rejectInTransaction(session, base.ToolNameApplyPatch, "Apply the parts of the patch that delete files in a separate script before or after the transaction")
+		}
+
+		if !patchInput.DryRun {
+			for _, path := range filesystem.PatchPaths(patchInput) {
+				if err := session.Reads.Check(session.FS, path); err != nil {
+					session.Throw(err)
+				}
+			}
+		}
+
+		result, err := filesystem.ApplyPatch(session.FS, patchInput)
+		if err != nil {
+			session.Throw(err)
+		}
+		if !result.DryRun {
+			for _, file := range result.Files {
+				session.Reads.Record(file.Path, file.Fingerprint)
+			}
+		}
+
+		SetValue(session, "result", result)
+		return session.VM.ToValue(result)
+	}
+}
+
+var applyPatchSuggestions = []string{
+	"Ensure that you provide the correct input arguments as specified in the tool description",
+	"- **patch** (string, required): A unified diff with a --- and +++ header for every file",
+	"- **options** (object, optional): { dry_run: true } to check the patch without writing files",
+	"For example: apply_patch('--- a/src/app.js\\n+++ b/src/app.js\\n@@ -1 +1 @@\\n-let a = 1;\\n+let a = 2;\\n')",
+}
@@ -6,6 +6,8 @@ import (
 	"io"
 
 	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/tool/communication"
+	"github.com/furisto/construct/backend/tool/filesystem"
 	"github.com/furisto/construct/shared"
 	"github.com/google/uuid"
 	"github.com/grafana/sobek"
@@ -22,6 +24,9 @@ type Session struct {
 	FS            afero.Fs
 	Memory        *memory.Client
 	CommandRunner shared.CommandRunner
+	// Reads tracks the files the task has seen to detect files that were
+	// changed by someone else before they are written.
+	Reads *filesystem.ReadTracker
 
 	CurrentTool string
 	values      map[string]any
@@ -71,6 +76,18 @@ func UnsetValue(s *Session, key string) {
 type Task struct {
 	ID               uuid.UUID
 	ProjectDirectory string
+	Policy           *Policy
+	// InstructionFiles are the instruction files already included in the
+	// conversation of the task.
+	InstructionFiles []string
+	// Delegator runs the tasks the task delegates to other agents. It is nil
+	// for tasks that may not delegate.
+	Delegator communication.Delegator
+	// PersistentState keeps the JavaScript runtime of the task between
+	// interpreter calls.
+	PersistentState bool
+	// Limits restricts the resources of a script. Nil uses DefaultLimits.
+	Limits *Limits
 }
 
 type CodeActToolHandler func(session *Session) func(call sobek.FunctionCall) sobek.Value
@@ -0,0 +1,97 @@
+package codeact
+
+import (
+	"fmt"
+
+	"github.com/grafana/sobek"
+
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/furisto/construct/backend/tool/filesystem"
+)
+
+const copyFileDescription = `
+## Description
+Copies a single file. Missing parent directories of the destination are created and the file mode of the source is kept. Use it instead of running cp with execute_command, so the copy is validated and recorded as a file operation.
+
+## Parameters
+- **source** (string, required): Absolute path to the file to copy.
+- **destination** (string, required): Absolute path of the copy, including the file name.
+- **overwrite** (boolean, optional): Replace an existing file at the destination. Defaults to false. If the destination changed since you last read it, the call throws "File was modified since it was last read".
+
+## Expected Output
+Returns whether an existing file at the destination was replaced:
+%[1]s
+{
+  "overwritten": false
+}
+%[1]s
+If the source does not exist, the destination already exists and overwrite is false, or either path is a directory, it throws an exception describing the issue.
+
+## IMPORTANT USAGE NOTES
+- **Files only**: Directories cannot be copied with this tool. Copy the files of a directory one by one
+- **Transactions**: After begin_transaction the copy is staged like a file created with create_file
+- **Always use absolute paths**: Always use absolute paths starting with "/"
+
+## Usage Examples
+
+### Create a configuration from a template
+%[1]s
+copy_file("/workspace/project/.env.example", "/workspace/project/.env");
+edit_file("/workspace/project/.env", [{ old: "DEBUG=false", new: "DEBUG=true" }]);
+%[1]s
+`
+
+func NewCopyFileTool() Tool {
+	return NewOnDemandTool(
+		base.ToolNameCopyFile,
+		fmt.Sprintf(copyFileDescription, "```"),
+		copyFileInput,
+		copyFileHandler,
+	)
+}
+
+func copyFileInput(session *Session, args []sobek.Value) (any, error) {
+	source, destination, overwrite, ok := transferArguments(args)
+	if !ok {
+		return nil, base.NewCustomError(base.InvalidInput.String(), copyFileSuggestions)
+	}
+
+	return &filesystem.CopyFileInput{
+		Source:      source,
+		Destination: destination,
+		Overwrite:   overwrite,
+	}, nil
+}
+
+func copyFileHandler(session *Session) func(call sobek.FunctionCall) sobek.Value {
+	return func(call sobek.FunctionCall) sobek.Value {
+		input, err := copyFileInput(session, call.Arguments)
+		if err != nil {
+			session.Throw(err)
+		}
+
+		copyInput := input.(*filesystem.CopyFileInput)
+		if copyInput.Overwrite {
+			if err := session.Reads.Check(session.FS, copyInput.Destination); err != nil {
+				session.Throw(err)
+			}
+		}
+
+		result, err := filesystem.CopyFile(session.FS, copyInput)
+		if err != nil {
+			session.Throw(err)
+		}
+		session.Reads.Record(copyInput.Destination, result.Fingerprint)
+
+		SetValue(session, "result", result)
+		return session.VM.ToValue(result)
+	}
+}
+
+var copyFileSuggestions = []string{
+	"Ensure that you provide the correct input arguments as specified in the tool description",
+	"- **source** (string, required): Absolute path to the file to copy",
+	"- **destination** (string, required): Absolute path of the copy, including the file name",
+	"- **overwrite** (boolean, optional): Replace an existing file at the destination",
+	"For example: copy_file('/project/.env.example', '/project/.env')",
+}
@@ -35,6 +35,7 @@ Example output if the file was overwritten:
 create_file("/workspace/project/components/Header.jsx", "import React from 'react';...")
 %[1]s
 - **Preserve existing structure if overwriting**: If you intend to modify an existing file, it's best practice to first use the 'read_file' tool to understand its current structure and content. Then, when calling create_file, provide the complete new content for the file, incorporating your changes while ensuring the overall desired structure is maintained in the content you provide
+- **Changes by the user**: If you overwrite a file that changed since you last read it, e.g. because the user edited it, create_file throws "File was modified since it was last read" and leaves the file untouched. Read the file again and include the user's changes in the new content
 - **Verify file structure first**: Before creating a file, ensure you understand the project's file organization
 %[1]s
 // First list the directory to understand structure
@@ -150,10 +151,16 @@ func createFileHandler(session *Session) func(call sobek.FunctionCall) sobek.Val
 			session.Throw(err)
 		}
 
+		path := input.(*filesystem.CreateFileInput).Path
+		if err := session.Reads.Check(session.FS, path); err != nil {
+			session.Throw(err)
+		}
+
 		result, err := filesystem.CreateFile(session.FS, input.(*filesystem.CreateFileInput))
 		if err != nil {
 			session.Throw(err)
 		}
+		session.Reads.Record(path, result.Fingerprint)
 
 		SetValue(session, "result", result)
 		return session.VM.ToValue(result)
@@ -0,0 +1,100 @@
+package codeact
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/grafana/sobek"
+
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/furisto/construct/backend/tool/custom"
+)
+
+const customToolDescription = `
+## Description
+%[2]s
+
+## Parameters
+Call %[3]s with a single object argument that matches the following JSON schema:
+%[1]sjson
+%[4]s
+%[1]s
+
+## Expected Output
+Returns the output of the tool. JSON output is returned as an object, any other output as a string.
+If the tool fails an error is thrown.
+
+## Usage Examples
+%[1]s
+const result = %[3]s({ /* arguments */ });
+print(result);
+%[1]s
+`
+
+type customTool struct {
+	definition  *custom.Definition
+	description string
+}
+
+// NewCustomTool exposes a user defined tool to CodeAct scripts. Calls flow
+// through the interceptors like the calls of builtin tools.
+func NewCustomTool(definition *custom.Definition) Tool {
+	schema := definition.InputSchema
+	if schema == nil {
+		schema = map[string]any{"type": "object"}
+	}
+	encoded, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		encoded = []byte(`{"type": "object"}`)
+	}
+
+	return &customTool{
+		definition:  definition,
+		description: fmt.Sprintf(customToolDescription, "```", definition.Description, definition.Name, encoded),
+	}
+}
+
+func (t *customTool) Name() string {
+	return t.definition.Name
+}
+
+func (t *customTool) Description() string {
+	return t.description
+}
+
+func (t *customTool) Input(session *Session, args []sobek.Value) (any, error) {
+	input := &custom.CallInput{
+		Tool:             t.definition.Name,
+		Arguments:        map[string]any{},
+		WorkingDirectory: session.Task.ProjectDirectory,
+	}
+
+	if len(args) == 0 || sobek.IsUndefined(args[0]) || sobek.IsNull(args[0]) {
+		return input, nil
+	}
+
+	arguments, ok := args[0].Export().(map[string]any)
+	if !ok {
+		return nil, base.NewError(base.InvalidInput, "arguments", "must be an object")
+	}
+	input.Arguments = arguments
+
+	return input, nil
+}
+
+func (t *customTool) ToolHandler(session *Session) func(call sobek.FunctionCall) sobek.Value {
+	return func(call sobek.FunctionCall) sobek.Value {
+		rawInput, err := t.Input(session, call.Arguments)
+		if err != nil {
+			session.Throw(err)
+		}
+
+		result, err := custom.Call(session.Context, t.definition, rawInput.(*custom.CallInput))
+		if err != nil {
+			session.Throw(err)
+		}
+
+		SetValue(session, "result", result)
+		return session.VM.ToValue(result.Output)
+	}
+}
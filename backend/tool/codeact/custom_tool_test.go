@@ -0,0 +1,105 @@
+package codeact
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/spf13/afero"
+
+	"github.com/furisto/construct/backend/tool/custom"
+)
+
+func TestCustomTool(t *testing.T) {
+	tool := NewCustomTool(&custom.Definition{
+		Name:        "preview_url",
+		Description: "Returns the preview URL of a branch.",
+		InputSchema: map[string]any{
+			"type":       "object",
+			"properties": map[string]any{"branch": map[string]any{"type": "string"}},
+			"required":   []any{"branch"},
+		},
+		Kind:   custom.KindJavaScript,
+		Source: "module.exports = function(args) { return 'https://' + args.branch + '.preview.example.com'; };",
+	})
+
+	tests := []struct {
+		Name          string
+		Script        string
+		Policy        *Policy
+		Output        string
+		Error         string
+		FunctionCalls []FunctionCall
+	}{
+		{
+			Name:   "call is recorded",
+			Script: `print(preview_url({ branch: "feature" }));`,
+			Output: "https://feature.preview.example.com\n",
+			FunctionCalls: []FunctionCall{
+				{
+					ToolName: "preview_url",
+					Input: FunctionCallInput{CustomTool: &custom.CallInput{
+						Tool:             "preview_url",
+						Arguments:        map[string]any{"branch": "feature"},
+						WorkingDirectory: "/project",
+					}},
+					Output: FunctionCallOutput{CustomTool: &custom.CallResult{Output: "https://feature.preview.example.com"}},
+				},
+			},
+		},
+		{
+			Name:   "invalid arguments",
+			Script: `preview_url({ branch: 1 });`,
+			Error:  "invalid arguments for custom tool",
+		},
+		{
+			Name:   "denied in read-only mode",
+			Script: `preview_url({ branch: "feature" });`,
+			Policy: &Policy{ReadOnly: true},
+			Error:  "the project configuration only permits read access",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			interpreter := NewInterpreter(
+				[]Tool{NewPrintTool()},
+				[]Interceptor{InterceptorFunc(PolicyInterceptor), InterceptorFunc(DurableFunctionInterceptor), InterceptorFunc(ResetTemporarySessionValuesInterceptor)},
+			).WithTools(tool)
+
+			input, err := json.Marshal(InterpreterInput{Script: test.Script})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			output, err := interpreter.Interpret(context.Background(), afero.NewMemMapFs(), input, &Task{
+				ID:               uuid.New(),
+				ProjectDirectory: "/project",
+				Policy:           test.Policy,
+			})
+			if test.Error != "" {
+				if err == nil || !strings.Contains(err.Error(), test.Error) {
+					t.Fatalf("expected error containing %q, got %v", test.Error, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if output.ConsoleOutput != test.Output {
+				t.Errorf("expected output %q, got %q", test.Output, output.ConsoleOutput)
+			}
+			if diff := cmp.Diff(test.FunctionCalls, output.FunctionCalls); diff != "" {
+				t.Errorf("function calls mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	if !strings.Contains(tool.Description(), `"required": [`) {
+		t.Errorf("expected the input schema in the description, got %s", tool.Description())
+	}
+}
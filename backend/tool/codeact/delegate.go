@@ -0,0 +1,114 @@
+package codeact
+
+import (
+	"fmt"
+
+	"github.com/grafana/sobek"
+
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/furisto/construct/backend/tool/communication"
+)
+
+const delegateDescription = `
+## Description
+Delegates a self-contained piece of work to another agent and waits for its answer. The agent works on a new task with its own conversation history and budget, and the call returns the report the agent submitted when it finished. Unlike handoff, you stay in charge of the current task and can continue with the result.
+
+## Parameters
+- **agent** (string, required): The name or ID of the agent that should do the work.
+- **instructions** (string, required): The instructions for the agent. The agent does not see your conversation, so include every detail it needs: the goal, relevant files, constraints and the expected result.
+- **options** (object, optional): Additional settings for the delegated task:
+  - **max_cost** (number, optional): The budget of the delegated task in USD. Defaults to the remaining budget of the current task.
+
+## Expected Output
+Returns an object with the report of the delegated task:
+%[1]s
+{
+  "task_id": "The ID of the delegated task",
+  "agent": "The name of the agent that did the work",
+  "summary": "What the agent accomplished",
+  "completed": true, // whether the agent completed the work
+  "deliverables": ["The files, features or outputs the agent produced"],
+  "next_steps": "Follow-up actions the agent suggests",
+  "cost": 0.12 // the cost of the delegated task in USD
+}
+%[1]s
+
+If the agent does not exist, the delegated task fails, or it is suspended before it finishes, the tool throws an exception describing the issue.
+
+## IMPORTANT USAGE NOTES
+- **Blocking**: The call returns only after the delegated task has finished. Delegate work that takes substantial effort, not single tool calls
+- **No nesting**: Delegated tasks cannot delegate work themselves
+- **Verify results**: The report is written by the other agent. Check important claims before you rely on them
+
+## When to use
+- **Specialized work**: When a part of the task is better handled by an agent with other instructions or tools, e.g. a reviewer or a read-only planner
+- **Isolated investigations**: When researching a question would fill your conversation with details you do not need afterwards
+
+## Usage Examples
+
+### Asking a planner for a design
+%[1]s
+const plan = delegate("plan", "Design a caching layer for the HTTP client in /workspace/project/client. List the files that have to change and the risks of the approach.");
+print(plan.summary);
+print(plan.deliverables.join("\n"));
+%[1]s
+
+### Limiting the budget of a review
+%[1]s
+const review = delegate("reviewer", "Review the changes in /workspace/project/server/auth.go for security issues.", { max_cost: 0.5 });
+if (!review.completed) {
+  print(%[2]sReview incomplete: ${review.next_steps}%[2]s);
+}
+%[1]s
+`
+
+func NewDelegateTool() Tool {
+	return NewOnDemandTool(
+		base.ToolNameDelegate,
+		fmt.Sprintf(delegateDescription, "```", "`"),
+		delegateInput,
+		delegateHandler,
+	)
+}
+
+func delegateInput(session *Session, args []sobek.Value) (any, error) {
+	if len(args) < 2 {
+		return nil, NewCustomError("delegate requires at least 2 arguments", []string{
+			"- **agent** (string, required): The name or ID of the agent that should do the work",
+			"- **instructions** (string, required): The instructions for the agent",
+			"- **options** (object, optional): Settings like max_cost for the delegated task",
+		})
+	}
+
+	input := &communication.DelegateInput{
+		Agent:        args[0].String(),
+		Instructions: args[1].String(),
+	}
+
+	if len(args) >= 3 && !sobek.IsUndefined(args[2]) && !sobek.IsNull(args[2]) {
+		options := args[2].ToObject(session.VM)
+		if maxCost := options.Get("max_cost"); maxCost != nil && !sobek.IsUndefined(maxCost) && !sobek.IsNull(maxCost) {
+			input.MaxCost = maxCost.ToFloat()
+		}
+	}
+
+	return input, nil
+}
+
+func delegateHandler(session *Session) func(call sobek.FunctionCall) sobek.Value {
+	return func(call sobek.FunctionCall) sobek.Value {
+		rawInput, err := delegateInput(session, call.Arguments)
+		if err != nil {
+			session.Throw(err)
+		}
+		input := rawInput.(*communication.DelegateInput)
+
+		result, err := communication.Delegate(session.Context, session.Task.Delegator, session.Task.ID, input)
+		if err != nil {
+			session.Throw(err)
+		}
+
+		SetValue(session, "result", result)
+		return session.VM.ToValue(result)
+	}
+}
@@ -0,0 +1,164 @@
+package codeact
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/spf13/afero"
+
+	"github.com/furisto/construct/backend/tool/communication"
+)
+
+type stubDelegator struct {
+	parentID uuid.UUID
+	input    *communication.DelegateInput
+}
+
+func (d *stubDelegator) Delegate(ctx context.Context, parentID uuid.UUID, input *communication.DelegateInput) (*communication.DelegateResult, error) {
+	d.parentID = parentID
+	d.input = input
+	return &communication.DelegateResult{
+		TaskID:       "child",
+		Agent:        input.Agent,
+		Summary:      "done",
+		Completed:    true,
+		Deliverables: []string{"plan.md"},
+	}, nil
+}
+
+func (d *stubDelegator) RemainingBudget(ctx context.Context, parentID uuid.UUID) (float64, error) {
+	return 0, nil
+}
+
+func TestDelegateTool(t *testing.T) {
+	taskID := uuid.New()
+
+	tests := []struct {
+		Name          string
+		Script        string
+		Delegator     bool
+		Policy        *Policy
+		Output        string
+		Error         string
+		Input         *communication.DelegateInput
+		FunctionCalls []FunctionCall
+	}{
+		{
+			Name:      "report is returned",
+			Script:    `const r = delegate("plan", "design the cache", { max_cost: 0.5 }); print(r.agent, r.summary, r.completed, r.deliverables[0]);`,
+			Delegator: true,
+			Output:    "plan done true plan.md\n",
+			Input:     &communication.DelegateInput{Agent: "plan", Instructions: "design the cache", MaxCost: 0.5},
+			FunctionCalls: []FunctionCall{
+				{
+					ToolName: "delegate",
+					Input:    FunctionCallInput{Delegate: &communication.DelegateInput{Agent: "plan", Instructions: "design the cache", MaxCost: 0.5}},
+					Output: FunctionCallOutput{Delegate: &communication.DelegateResult{
+						TaskID:       "child",
+						Agent:        "plan",
+						Summary:      "done",
+						Completed:    true,
+						Deliverables: []string{"plan.md"},
+					}},
+				},
+			},
+		},
+		{
+			Name:      "options are optional",
+			Script:    `delegate("plan", "design the cache");`,
+			Delegator: true,
+			Input:     &communication.DelegateInput{Agent: "plan", Instructions: "design the cache"},
+		},
+		{
+			Name:      "instructions are required",
+			Script:    `delegate("plan", "");`,
+			Delegator: true,
+			Error:     "instructions are required",
+		},
+		{
+			Name:      "negative budget is rejected",
+			Script:    `delegate("plan", "design the cache", { max_cost: -1 });`,
+			Delegator: true,
+			Error:     "max_cost must not be negative",
+		},
+		{
+			Name:   "delegated tasks cannot delegate",
+			Script: `delegate("plan", "design the cache");`,
+			Error:  "delegation is not available in this task",
+		},
+		{
+			Name:      "run parallel returns the results of the group",
+			Script:    `const g = run_parallel([{ agent: "plan", instructions: "design the cache", max_cost: 0.5 }], { concurrency: 2 }); print(g.finished, g.failed, g.results[0].result.summary);`,
+			Delegator: true,
+			Output:    "1 0 done\n",
+			Input:     &communication.DelegateInput{Agent: "plan", Instructions: "design the cache", MaxCost: 0.5},
+		},
+		{
+			Name:      "run parallel requires an array",
+			Script:    `run_parallel({ agent: "plan", instructions: "design the cache" });`,
+			Delegator: true,
+			Error:     "tasks must be an array",
+		},
+		{
+			Name:      "denied for read-only agents",
+			Script:    `delegate("coder", "implement the cache");`,
+			Delegator: true,
+			Policy:    &Policy{AgentReadOnly: true},
+			Error:     "this agent only permits read access",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			interpreter := NewInterpreter(
+				[]Tool{NewPrintTool(), NewDelegateTool(), NewRunParallelTool()},
+				[]Interceptor{InterceptorFunc(PolicyInterceptor), InterceptorFunc(DurableFunctionInterceptor), InterceptorFunc(ResetTemporarySessionValuesInterceptor)},
+			)
+
+			input, err := json.Marshal(InterpreterInput{Script: test.Script})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			task := &Task{
+				ID:               taskID,
+				ProjectDirectory: "/project",
+				Policy:           test.Policy,
+			}
+			delegator := &stubDelegator{}
+			if test.Delegator {
+				task.Delegator = delegator
+			}
+
+			output, err := interpreter.Interpret(context.Background(), afero.NewMemMapFs(), input, task)
+			if test.Error != "" {
+				if err == nil || !strings.Contains(err.Error(), test.Error) {
+					t.Fatalf("expected error containing %q, got %v", test.Error, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if output.ConsoleOutput != test.Output {
+				t.Errorf("expected output %q, got %q", test.Output, output.ConsoleOutput)
+			}
+			if diff := cmp.Diff(test.Input, delegator.input); diff != "" {
+				t.Errorf("delegate input mismatch (-want +got):\n%s", diff)
+			}
+			if delegator.parentID != taskID {
+				t.Errorf("expected parent task %s, got %s", taskID, delegator.parentID)
+			}
+			if test.FunctionCalls != nil {
+				if diff := cmp.Diff(test.FunctionCalls, output.FunctionCalls); diff != "" {
+					t.Errorf("function calls mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
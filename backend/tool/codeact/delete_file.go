@@ -0,0 +1,88 @@
+package codeact
+
+import (
+	"fmt"
+
+	"github.com/grafana/sobek"
+
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/furisto/construct/backend/tool/filesystem"
+)
+
+const deleteFileDescription = `
+## Description
+Deletes a single file. Use it instead of running rm with execute_command, so the deletion is validated and recorded as a file operation.
+
+## Parameters
+- **path** (string, required): Absolute path to the file to delete (e.g., "/workspace/project/src/legacy.js").
+
+## Expected Output
+Returns an object with the path of the deleted file:
+%[1]s
+{
+  "path": "/workspace/project/src/legacy.js"
+}
+%[1]s
+If the file does not exist or the path is a directory, it throws an exception describing the issue.
+
+## IMPORTANT USAGE NOTES
+- **Files only**: Directories cannot be deleted with this tool. Delete the files of a directory one by one
+- **Not undoable**: The file is removed immediately. Make sure no other file still references it
+- **No transactions**: delete_file cannot be called after begin_transaction because the deletion cannot be staged
+- **Always use absolute paths**: Always use absolute paths starting with "/"
+
+## Usage Examples
+
+### Remove an obsolete file after moving its code
+%[1]s
+const references = grep({ query: "legacy", path: "/workspace/project/src" });
+if (references.total_matches === 0) {
+  delete_file("/workspace/project/src/legacy.js");
+}
+%[1]s
+`
+
+func NewDeleteFileTool() Tool {
+	return NewOnDemandTool(
+		base.ToolNameDeleteFile,
+		fmt.Sprintf(deleteFileDescription, "```"),
+		deleteFileInput,
+		deleteFileHandler,
+	)
+}
+
+func deleteFileInput(session *Session, args []sobek.Value) (any, error) {
+	if len(args) != 1 || !isString(args[0]) {
+		return nil, base.NewCustomError(base.InvalidInput.String(), deleteFileSuggestions)
+	}
+
+	return &filesystem.DeleteFileInput{
+		Path: args[0].String(),
+	}, nil
+}
+
+func deleteFileHandler(session *Session) func(call sobek.FunctionCall) sobek.Value {
+	return func(call sobek.FunctionCall) sobek.Value {
+		rejectInTransaction(session, base.ToolNameDeleteFile, "Delete the file in a separate script before or after the transaction")
+
+		input, err := deleteFileInput(session, call.Arguments)
+		if err != nil {
+			session.Throw(err)
+		}
+
+		result, err := filesystem.DeleteFile(session.FS, input.(*filesystem.DeleteFileInput))
+		if err != nil {
+			session.Throw(err)
+		}
+		session.Reads.Forget(result.Path)
+
+		SetValue(session, "result", result)
+		return session.VM.ToValue(result)
+	}
+}
+
+var deleteFileSuggestions = []string{
+	"Ensure that you provide the correct input arguments as specified in the tool description",
+	"- **path** (string, required): Absolute path to the file to delete",
+	"For example: delete_file('/project/src/legacy.js')",
+}
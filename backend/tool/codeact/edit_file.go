@@ -69,6 +69,7 @@ indicates that the edit may not have been applied as expected. Each error contai
 - **File path validation**: Always use absolute paths (starting with "/")
 - **Escape sequences**: You need to ensure that the "old" and "new" text are properly escaped to match the file content exactly e.g if the file contains "Starting Agent Runtime...\\n", you need to ensure that you match that in the old text.
 - **Ensuring that the edit has been applied successfully**: You need to ensure that the edit is successful by checking the return value of the edit_file tool. If the edit is not successful, you need to review the validation_errors and conflict_warnings and fix them before retrying the edit.
+- **Changes by the user**: The user may edit files while you work on them. If the file changed since you last read it, edit_file throws "File was modified since it was last read" and leaves the file untouched. Read the file again and redo the edit against its current content, do not overwrite the user's changes
 
 ## When to use
 - Refactoring code (changing variables, updating functions)
@@ -174,10 +175,15 @@ func editFileHandler(session *Session) func(call sobek.FunctionCall) sobek.Value
 			}))
 		}
 
+		if err := session.Reads.Check(session.FS, input.Path); err != nil {
+			session.Throw(err)
+		}
+
 		result, err := filesystem.EditFile(session.FS, input)
 		if err != nil {
 			session.Throw(err)
 		}
+		session.Reads.Record(input.Path, result.Fingerprint)
 
 		SetValue(session, "result", result)
 		return session.VM.ToValue(result)
@@ -16,6 +16,7 @@ const (
 	Internal           = base.Internal
 	None               = base.None
 	InvalidArgument    = base.InvalidInput
+	FileModified       = base.FileModified
 )
 
 const GenericSuggestion = base.GenericSuggestion
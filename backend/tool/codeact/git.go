@@ -0,0 +1,243 @@
+package codeact
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/grafana/sobek"
+
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/furisto/construct/backend/tool/git"
+)
+
+const gitToolsDescription = `
+## Description
+The git functions inspect and change the git repository of the project directory and return structured results instead of terminal output. Prefer them over running git with execute_command.
+
+## Functions
+- **git.status()**: Returns { branch, commit, upstream, ahead, behind, clean, files }. Every file has a path and, depending on its state, original_path (renames), staged and unstaged (added, modified, deleted, renamed, copied or type_changed), untracked or conflicted.
+- **git.diff(options)**: Returns { files, insertions, deletions, patch, truncated }. Every file has path, old_path (renames), insertions, deletions and binary. Options:
+  - staged (boolean): Compare the index with HEAD instead of the working tree with the index
+  - from, to (string): Compare two revisions, or the working tree with from if to is omitted
+  - paths (string[]): Limit the diff to these paths
+  - stat_only (boolean): Omit the patch
+- **git.log(options)**: Returns { commits }, newest first. Every commit has hash, short_hash, author, email, date, parents, subject, body and trailers. Options: ref (revision or range, default HEAD), count (default 10, at most 100), paths, author, since (e.g. "2 weeks ago").
+- **git.show(rev, options)**: Returns { commit, files, insertions, deletions, patch, truncated } for a commit, HEAD by default. Options: stat_only.
+- **git.blame(path, options)**: Returns { path, lines }. Every line has line, hash, author, date, summary and content. Uncommitted lines have an all zero hash. Options: start_line, end_line, rev.
+- **git.branch(options)**: Returns { current, branches } after performing at most one of the actions. Every branch has name, commit, subject, upstream, tracking (e.g. "ahead 1, behind 2") and current. Options:
+  - create (string): Create a branch, starting at from (default HEAD). Set switch to check it out
+  - checkout (string): Switch to an existing branch, fails if uncommitted changes would be overwritten
+  - delete (string): Delete a merged branch, force deletes an unmerged branch
+- **git.stash(options)**: Returns { entries } after performing the action. Every entry has index, ref and message. Options: action (list, push, pop, apply or drop; default list), message and include_untracked for push, index for pop, apply and drop (default 0).
+- **git.commit(message, options)**: Commits the staged changes and returns { commit, branch, files, insertions, deletions }. Options:
+  - paths (string[]): Stage these paths including deletions before committing
+  - all (boolean): Stage all changes of tracked files before committing
+  - trailers (string[] or object): Trailers appended to the message, e.g. ["Refs: #42"] or { "Refs": "#42" }
+  - amend (boolean): Replace the last commit, the message may be empty to keep the previous one
+  - allow_empty (boolean): Create a commit without changes
+
+Failed operations throw an error that contains the output of git.
+
+## IMPORTANT USAGE NOTES
+- **Destructive operations**: Force deleting branches and dropping stash entries are refused unless the project configuration permits destructive git operations. The same applies to %[2]sgit reset --hard%[2]s, %[2]sgit clean -f%[2]s, force pushes and similar commands run with execute_command. Never try to work around the restriction, ask the user instead.
+- **Transactions and preview mode**: Operations that change the repository are not allowed inside a transaction or in preview mode.
+- **Large patches**: Patches larger than 256 KB are truncated and truncated is set. Use stat_only first and diff individual paths.
+
+## Committing
+When the user asks you to create a git commit, follow these steps carefully.
+
+### Safety Requirements
+- Never force push, reset or clean without explicit user instruction
+- Warn if staging files >50MB, suggest Git LFS or .gitignore
+- Check for sensitive patterns: %[2]s.env%[2]s, secrets, keys, passwords in filenames and content
+- Mention when committing binary files
+
+### Analysis Workflow
+1. **Assess repository state**: git.status() and git.diff({ stat_only: true }) as well as git.diff({ staged: true, stat_only: true })
+2. **Analyze changes**: Read the patches, categorize the change type (feature, fix, refactor, docs, test)
+3. **Determine motivation**: Why were these changes made?
+4. **Security scan**: Check the staged files for sensitive information
+5. **Check repository style**: Review the recent commits with git.log({ count: 6 }) for message patterns
+
+### Commit Message Rules
+- Focus on "why" not "what", explain the purpose, not just the actions
+- Use specific verbs: add (new), fix (bugs), update (enhance existing), refactor, remove
+- Avoid generic terms like "Update" without context
+- Match the existing style of the repository (capitalization, tense, format, trailers)
+- Add yourself as a co-author with the trailer %[2]sCo-authored-by: construct-agent <noreply@construct.sh>%[2]s
+
+### Error Handling
+- **No changes**: Show the status, suggest staging files
+- **Merge conflicts**: Guide the user through the resolution before committing
+- **Hook failures**: Show the output of the error, retry once if the hooks modified files, otherwise ask how to proceed
+- **Large or sensitive files**: Require explicit confirmation
+
+### Key Success Factors
+- Craft meaningful messages that reflect the actual changes and their purpose
+- The commit message should closely match the style of the repository
+- After you have created the commit, do not explain to the user why the commit message follows these rules
+
+## Usage Examples
+%[1]s
+// Turn 1: analyze the repository
+const status = git.status();
+print(status.branch, status.files.map(f => f.path + " " + (f.staged || "") + "/" + (f.unstaged || (f.untracked ? "untracked" : ""))));
+
+const staged = git.diff({ staged: true });
+print(staged.files, staged.patch);
+
+const { commits } = git.log({ count: 6 });
+for (const c of commits) {
+  print(c.short_hash, c.subject, c.trailers);
+}
+%[1]s
+
+%[1]s
+// Turn 2: commit
+const result = git.commit("Prefix read_file output with line numbers\n\nLine numbers let edits reference exact locations.", {
+  paths: ["backend/tool/filesystem/read.go"],
+  trailers: ["Co-authored-by: construct-agent <noreply@construct.sh>"],
+});
+print(result.commit.short_hash, result.commit.subject, result.files);
+%[1]s
+
+%[1]s
+// Who changed a function and why
+const blame = git.blame("/project/src/server.go", { start_line: 40, end_line: 60 });
+const hashes = [...new Set(blame.lines.map(l => l.hash))];
+for (const hash of hashes) {
+  print(git.show(hash, { stat_only: true }).commit.subject);
+}
+%[1]s
+`
+
+// gitOperations are the functions of the git tool family. Positional is the
+// option that may be passed as the first argument.
+var gitOperations = []struct {
+	name       string
+	positional string
+	newInput   func(dir string) git.Input
+}{
+	{base.ToolNameGitStatus, "", func(dir string) git.Input { return &git.StatusInput{WorkingDirectory: dir} }},
+	{base.ToolNameGitDiff, "", func(dir string) git.Input { return &git.DiffInput{WorkingDirectory: dir} }},
+	{base.ToolNameGitLog, "", func(dir string) git.Input { return &git.LogInput{WorkingDirectory: dir} }},
+	{base.ToolNameGitShow, "rev", func(dir string) git.Input { return &git.ShowInput{WorkingDirectory: dir} }},
+	{base.ToolNameGitBlame, "path", func(dir string) git.Input { return &git.BlameInput{WorkingDirectory: dir} }},
+	{base.ToolNameGitBranch, "", func(dir string) git.Input { return &git.BranchInput{WorkingDirectory: dir} }},
+	{base.ToolNameGitStash, "", func(dir string) git.Input { return &git.StashInput{WorkingDirectory: dir} }},
+	{base.ToolNameGitCommit, "message", func(dir string) git.Input { return &git.CommitInput{WorkingDirectory: dir} }},
+}
+
+// NewGitTools returns the functions of the git tool family. The description
+// of the first function documents all of them.
+func NewGitTools() []Tool {
+	tools := make([]Tool, 0, len(gitOperations))
+	for i, operation := range gitOperations {
+		description := "See git.status."
+		if i == 0 {
+			description = fmt.Sprintf(gitToolsDescription, "```", "`")
+		}
+
+		newInput, positional := operation.newInput, operation.positional
+		input := func(session *Session, args []sobek.Value) (any, error) {
+			return gitInput(session, positional, newInput, args)
+		}
+		tools = append(tools, NewOnDemandTool(operation.name, description, input, gitHandler(operation.name, input)))
+	}
+	return tools
+}
+
+// gitInput decodes the options object, and the positional option if the
+// operation has one, into the input of the operation. Unknown options are
+// rejected so that misspelled options do not silently change the result.
+func gitInput(session *Session, positional string, newInput func(dir string) git.Input, args []sobek.Value) (any, error) {
+	maxArgs := 1
+	if positional != "" {
+		maxArgs = 2
+	}
+	if len(args) > maxArgs {
+		return nil, base.NewCustomError(base.InvalidInput.String(), gitSuggestions)
+	}
+
+	options := map[string]any{}
+	if positional != "" && len(args) > 0 && isString(args[0]) {
+		options[positional] = args[0].String()
+		args = args[1:]
+	}
+	if len(args) > 1 {
+		return nil, base.NewCustomError(base.InvalidInput.String(), gitSuggestions)
+	}
+
+	input := newInput(session.Task.ProjectDirectory)
+	if err := decodeOptions(options, args, input); err != nil {
+		return nil, base.NewCustomError(base.InvalidInput.String(), gitSuggestions, "error", err.Error())
+	}
+	return input, nil
+}
+
+// decodeOptions merges the options object, if one was passed, into options
+// and decodes them into target by their json tags. Unknown options are
+// rejected.
+func decodeOptions(options map[string]any, args []sobek.Value, target any) error {
+	if len(args) > 0 && !sobek.IsUndefined(args[0]) && !sobek.IsNull(args[0]) {
+		exported, ok := args[0].Export().(map[string]any)
+		if !ok {
+			return errors.New("options must be an object")
+		}
+		for key, value := range exported {
+			options[key] = value
+		}
+	}
+
+	encoded, err := json.Marshal(options)
+	if err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(target)
+}
+
+func gitHandler(name string, decode func(session *Session, args []sobek.Value) (any, error)) CodeActToolHandler {
+	return func(session *Session) func(call sobek.FunctionCall) sobek.Value {
+		return func(call sobek.FunctionCall) sobek.Value {
+			rawInput, err := decode(session, call.Arguments)
+			if err != nil {
+				session.Throw(err)
+			}
+			input := rawInput.(git.Input)
+
+			if input.Modifies() {
+				rejectInTransaction(session, name, "Git does not see the changes staged by the transaction. Run the operation in a separate script after this one completed")
+			}
+			if reason := input.Destructive(); reason != "" && !session.Task.Policy.DestructiveGitAllowed() {
+				session.Throw(destructiveGitError(name, reason))
+			}
+
+			output, err := git.Run(session.Context, input)
+			if err != nil {
+				session.Throw(err)
+			}
+
+			SetValue(session, "result", &git.CallResult{Operation: input.Operation(), Output: output})
+			return session.VM.ToValue(output)
+		}
+	}
+}
+
+func destructiveGitError(toolName, reason string) error {
+	return base.NewCustomError("the operation is not permitted because "+reason, []string{
+		"Look for a way to reach the goal without discarding work.",
+		"Ask the user to perform the operation or to set permissions.destructive_git in their configuration.",
+	}, "tool", toolName)
+}
+
+var gitSuggestions = []string{
+	"Ensure that you provide the correct input arguments as specified in the tool description",
+	"- **options** (object, optional): The options of the operation, unknown options are rejected",
+	"git.show, git.blame and git.commit take the revision, path or message as the first argument",
+	"For example: git.diff({ staged: true, stat_only: true }) or git.commit('Fix typo', { paths: ['README.md'] })",
+}
@@ -0,0 +1,97 @@
+package codeact
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/spf13/afero"
+)
+
+func TestGitTools(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	t.Setenv("GIT_CONFIG_GLOBAL", os.DevNull)
+	t.Setenv("GIT_CONFIG_NOSYSTEM", "1")
+	t.Setenv("GIT_AUTHOR_NAME", "Ada")
+	t.Setenv("GIT_AUTHOR_EMAIL", "ada@example.com")
+	t.Setenv("GIT_COMMITTER_NAME", "Ada")
+	t.Setenv("GIT_COMMITTER_EMAIL", "ada@example.com")
+
+	dir := t.TempDir()
+	if output, err := exec.Command("git", "-C", dir, "init", "--quiet", "--initial-branch", "main").CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v: %s", err, output)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		Name   string
+		Script string
+		Output []string
+		Error  string
+	}{
+		{
+			Name: "commit with trailers",
+			Script: `const status = git.status();
+print(status.files.map(f => f.path + ":" + f.untracked).join(","));
+const result = git.commit("Add main package", { paths: ["main.go"], trailers: { "Refs": "#1" } });
+print(result.commit.subject, result.branch, result.files.length);
+print(git.log({ count: 1 }).commits[0].trailers.join(","));`,
+			Output: []string{"main.go:true", "Add main package main 1", "Refs: #1"},
+		},
+		{
+			Name:   "unknown options are rejected",
+			Script: `git.diff({ cached: true });`,
+			Error:  `unknown field "cached"`,
+		},
+		{
+			Name:   "destructive operations are refused",
+			Script: `git.branch({ delete: "main", force: true });`,
+			Error:  "the operation is not permitted",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			interpreter := NewInterpreter(
+				append([]Tool{NewPrintTool()}, NewGitTools()...),
+				[]Interceptor{InterceptorFunc(PolicyInterceptor), InterceptorFunc(DurableFunctionInterceptor), InterceptorFunc(ResetTemporarySessionValuesInterceptor)},
+			)
+			input, err := json.Marshal(InterpreterInput{Script: test.Script})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			output, err := interpreter.Interpret(context.Background(), afero.NewOsFs(), input, &Task{ID: uuid.New(), ProjectDirectory: dir})
+			if test.Error != "" {
+				if err == nil || !strings.Contains(err.Error(), test.Error) {
+					t.Fatalf("expected error containing %q, got %v", test.Error, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for _, expected := range test.Output {
+				if !strings.Contains(output.ConsoleOutput, expected) {
+					t.Errorf("expected output containing %q, got %q", expected, output.ConsoleOutput)
+				}
+			}
+
+			for _, call := range output.FunctionCalls {
+				if call.Input.Git == nil || call.Output.Git == nil || "git."+call.Input.Git.Operation != call.ToolName {
+					t.Errorf("expected the call of %s to be recorded, got %+v", call.ToolName, call)
+				}
+			}
+		})
+	}
+}
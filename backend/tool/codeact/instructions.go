@@ -0,0 +1,58 @@
+package codeact
+
+import (
+	"fmt"
+	"log/slog"
+	"path/filepath"
+
+	"github.com/grafana/sobek"
+
+	"github.com/furisto/construct/backend/instruction"
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/furisto/construct/backend/tool/filesystem"
+)
+
+// NestedInstructionInterceptor prints the AGENTS.md files of subdirectories
+// the first time the agent reads or lists files inside them. Included files are
+// recorded in the "instruction_files" session value.
+func NestedInstructionInterceptor(session *Session, tool Tool, inner func(sobek.FunctionCall) sobek.Value) func(sobek.FunctionCall) sobek.Value {
+	return func(call sobek.FunctionCall) sobek.Value {
+		if tool.Name() != base.ToolNameReadFile && tool.Name() != base.ToolNameListFiles {
+			return inner(call)
+		}
+
+		result := inner(call)
+
+		var dir string
+		input, err := tool.Input(session, call.Arguments)
+		if err != nil {
+			return result
+		}
+		switch input := input.(type) {
+		case *filesystem.ReadFileInput:
+			dir = filepath.Dir(input.Path)
+		case *filesystem.ListFilesInput:
+			dir = input.Path
+		}
+
+		included, _ := GetValue[[]string](session, "instruction_files")
+		loaded := make(map[string]bool, len(session.Task.InstructionFiles)+len(included))
+		for _, path := range append(session.Task.InstructionFiles, included...) {
+			loaded[path] = true
+		}
+
+		files, err := instruction.ForPath(session.FS, session.Task.ProjectDirectory, dir, loaded)
+		if err != nil {
+			slog.Warn("failed to load nested instruction files", "error", err, "dir", dir)
+			return result
+		}
+		if len(files) == 0 {
+			return result
+		}
+
+		fmt.Fprintf(session.System, "%s\n", instruction.Format(files))
+		SetValue(session, "instruction_files", append(included, instruction.Paths(files)...))
+
+		return result
+	}
+}
@@ -0,0 +1,73 @@
+package codeact
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/spf13/afero"
+)
+
+func TestNestedInstructionInterceptor(t *testing.T) {
+	tests := []struct {
+		Name             string
+		Script           string
+		Loaded           []string
+		Output           string
+		InstructionFiles []string
+	}{
+		{
+			Name: "included once",
+			Script: `read_file("/project/web/app.js");
+list_files("/project/web", false);`,
+			Output:           "## Instructions from /project/web/AGENTS.md\nUse tabs.\n",
+			InstructionFiles: []string{"/project/web/AGENTS.md"},
+		},
+		{
+			Name:   "already loaded",
+			Script: `read_file("/project/web/app.js");`,
+			Loaded: []string{"/project/web/AGENTS.md"},
+		},
+		{
+			Name:   "project directory is skipped",
+			Script: `read_file("/project/main.go");`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			afero.WriteFile(fs, "/project/AGENTS.md", []byte("Root instructions."), 0644)
+			afero.WriteFile(fs, "/project/main.go", []byte("package main"), 0644)
+			afero.WriteFile(fs, "/project/web/AGENTS.md", []byte("Use tabs.\n"), 0644)
+			afero.WriteFile(fs, "/project/web/app.js", []byte("console.log()"), 0644)
+
+			interpreter := NewInterpreter(
+				[]Tool{NewReadFileTool(), NewListFilesTool()},
+				[]Interceptor{InterceptorFunc(NestedInstructionInterceptor)},
+			)
+			input, err := json.Marshal(InterpreterInput{Script: test.Script})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			output, err := interpreter.Interpret(context.Background(), fs, input, &Task{
+				ID:               uuid.New(),
+				ProjectDirectory: "/project",
+				InstructionFiles: test.Loaded,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if output.ConsoleOutput != test.Output {
+				t.Errorf("expected output %q, got %q", test.Output, output.ConsoleOutput)
+			}
+			if diff := cmp.Diff(test.InstructionFiles, output.InstructionFiles); diff != "" {
+				t.Errorf("instruction files mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -1,14 +1,19 @@
 package codeact
 
 import (
+	"encoding/json"
 	"log/slog"
 	"time"
 
 	v1 "github.com/furisto/construct/api/go/v1"
 	"github.com/furisto/construct/backend/tool/base"
 	"github.com/furisto/construct/backend/tool/communication"
+	"github.com/furisto/construct/backend/tool/custom"
 	"github.com/furisto/construct/backend/tool/filesystem"
+	"github.com/furisto/construct/backend/tool/git"
+	"github.com/furisto/construct/backend/tool/mcp"
 	"github.com/furisto/construct/backend/tool/system"
+	"github.com/furisto/construct/backend/tool/testrunner"
 	"github.com/furisto/construct/shared"
 	"github.com/google/uuid"
 	"github.com/grafana/sobek"
@@ -42,6 +47,16 @@ type FunctionCallInput struct {
 	SubmitReport   *communication.SubmitReportInput `json:"submit_report,omitempty"`
 	AskUser        *communication.AskUserInput      `json:"ask_user,omitempty"`
 	Handoff        *communication.HandoffInput      `json:"handoff,omitempty"`
+	CustomTool     *custom.CallInput                `json:"custom_tool,omitempty"`
+	MCPTool        *mcp.CallInput                   `json:"mcp_tool,omitempty"`
+	Delegate       *communication.DelegateInput     `json:"delegate,omitempty"`
+	RunParallel    *communication.RunParallelInput  `json:"run_parallel,omitempty"`
+	DeleteFile     *filesystem.DeleteFileInput      `json:"delete_file,omitempty"`
+	MoveFile       *filesystem.MoveFileInput        `json:"move_file,omitempty"`
+	CopyFile       *filesystem.CopyFileInput        `json:"copy_file,omitempty"`
+	ApplyPatch     *filesystem.ApplyPatchInput      `json:"apply_patch,omitempty"`
+	Git            *git.CallInput                   `json:"git,omitempty"`
+	RunTests       *testrunner.RunTestsInput        `json:"run_tests,omitempty"`
 }
 
 type FunctionCallOutput struct {
@@ -54,6 +69,16 @@ type FunctionCallOutput struct {
 	ReadFile       *filesystem.ReadFileResult        `json:"read_file,omitempty"`
 	SubmitReport   *communication.SubmitReportResult `json:"submit_report,omitempty"`
 	AskUser        *communication.AskUserResult      `json:"ask_user,omitempty"`
+	CustomTool     *custom.CallResult                `json:"custom_tool,omitempty"`
+	MCPTool        *mcp.CallResult                   `json:"mcp_tool,omitempty"`
+	Delegate       *communication.DelegateResult     `json:"delegate,omitempty"`
+	RunParallel    *communication.RunParallelResult  `json:"run_parallel,omitempty"`
+	DeleteFile     *filesystem.DeleteFileResult      `json:"delete_file,omitempty"`
+	MoveFile       *filesystem.MoveFileResult        `json:"move_file,omitempty"`
+	CopyFile       *filesystem.CopyFileResult        `json:"copy_file,omitempty"`
+	ApplyPatch     *filesystem.ApplyPatchResult      `json:"apply_patch,omitempty"`
+	Git            *git.CallResult                   `json:"git,omitempty"`
+	RunTests       *testrunner.RunTestsResult        `json:"run_tests,omitempty"`
 }
 
 type FunctionCall struct {
@@ -119,7 +144,47 @@ func convertToFunctionCallInput(toolName string, input any) FunctionCallInput {
 		if v, ok := input.(*communication.HandoffInput); ok {
 			result.Handoff = v
 		}
+	case base.ToolNameDelegate:
+		if v, ok := input.(*communication.DelegateInput); ok {
+			result.Delegate = v
+		}
+	case base.ToolNameRunParallel:
+		if v, ok := input.(*communication.RunParallelInput); ok {
+			result.RunParallel = v
+		}
+	case base.ToolNameDeleteFile:
+		if v, ok := input.(*filesystem.DeleteFileInput); ok {
+			result.DeleteFile = v
+		}
+	case base.ToolNameMoveFile:
+		if v, ok := input.(*filesystem.MoveFileInput); ok {
+			result.MoveFile = v
+		}
+	case base.ToolNameCopyFile:
+		if v, ok := input.(*filesystem.CopyFileInput); ok {
+			result.CopyFile = v
+		}
+	case base.ToolNameApplyPatch:
+		if v, ok := input.(*filesystem.ApplyPatchInput); ok {
+			result.ApplyPatch = v
+		}
+	case base.ToolNameRunTests:
+		if v, ok := input.(*testrunner.RunTestsInput); ok {
+			result.RunTests = v
+		}
 	default:
+		if v, ok := input.(*custom.CallInput); ok {
+			result.CustomTool = v
+			break
+		}
+		if v, ok := input.(*mcp.CallInput); ok {
+			result.MCPTool = v
+			break
+		}
+		if v, ok := input.(git.Input); ok {
+			result.Git = &git.CallInput{Operation: v.Operation(), Arguments: v}
+			break
+		}
 		slog.Error("unknown tool name", "tool_name", toolName)
 	}
 
@@ -166,16 +231,62 @@ func convertToFunctionCallOutput(toolName string, output any) FunctionCallOutput
 		if v, ok := output.(*communication.AskUserResult); ok {
 			result.AskUser = v
 		}
+	case base.ToolNameDelegate:
+		if v, ok := output.(*communication.DelegateResult); ok {
+			result.Delegate = v
+		}
+	case base.ToolNameRunParallel:
+		if v, ok := output.(*communication.RunParallelResult); ok {
+			result.RunParallel = v
+		}
+	case base.ToolNameDeleteFile:
+		if v, ok := output.(*filesystem.DeleteFileResult); ok {
+			result.DeleteFile = v
+		}
+	case base.ToolNameMoveFile:
+		if v, ok := output.(*filesystem.MoveFileResult); ok {
+			result.MoveFile = v
+		}
+	case base.ToolNameCopyFile:
+		if v, ok := output.(*filesystem.CopyFileResult); ok {
+			result.CopyFile = v
+		}
+	case base.ToolNameApplyPatch:
+		if v, ok := output.(*filesystem.ApplyPatchResult); ok {
+			result.ApplyPatch = v
+		}
+	case base.ToolNameRunTests:
+		if v, ok := output.(*testrunner.RunTestsResult); ok {
+			result.RunTests = v
+		}
 	default:
+		if v, ok := output.(*custom.CallResult); ok {
+			result.CustomTool = v
+			break
+		}
+		if v, ok := output.(*mcp.CallResult); ok {
+			result.MCPTool = v
+			break
+		}
+		if v, ok := output.(*git.CallResult); ok {
+			result.Git = v
+			break
+		}
 		slog.Error("unknown tool name", "tool_name", toolName)
 	}
 
 	return result
 }
 
+// isSilentTool reports whether calls to the tool are neither recorded nor
+// published, because they have no result of their own.
+func isSilentTool(name string) bool {
+	return name == base.ToolNamePrint || name == base.ToolNameResetState || name == base.ToolNameBeginTransaction
+}
+
 func DurableFunctionInterceptor(session *Session, tool Tool, inner func(sobek.FunctionCall) sobek.Value) func(sobek.FunctionCall) sobek.Value {
 	return func(call sobek.FunctionCall) sobek.Value {
-		if tool.Name() != base.ToolNamePrint {
+		if !isSilentTool(tool.Name()) {
 			callState, ok := GetValue[*FunctionCallState](session, "function_call_state")
 			if !ok {
 				callState = NewFunctionCallState()
@@ -216,7 +327,7 @@ func ToolStatisticsInterceptor(session *Session, tool Tool, inner func(sobek.Fun
 		if !ok {
 			toolStats = make(map[string]int64)
 		}
-		if tool.Name() != base.ToolNamePrint {
+		if !isSilentTool(tool.Name()) {
 			toolStats[tool.Name()]++
 			SetValue(session, "tool_stats", toolStats)
 		}
@@ -243,7 +354,7 @@ func NewToolEventPublisher(eventHub EventHub) *ToolEventPublisher {
 
 func (p *ToolEventPublisher) Intercept(session *Session, tool Tool, inner func(sobek.FunctionCall) sobek.Value) func(sobek.FunctionCall) sobek.Value {
 	return func(call sobek.FunctionCall) sobek.Value {
-		if tool.Name() != base.ToolNamePrint {
+		if !isSilentTool(tool.Name()) {
 			toolCall, err := convertArgumentsToProtoToolCall(tool, call.Arguments, session)
 			if err != nil {
 				slog.Error("failed to convert arguments to proto tool call", "error", err)
@@ -326,6 +437,35 @@ func convertArgumentsToProtoToolCall(tooCall Tool, arguments []sobek.Value, sess
 				Diffs: diffs,
 			},
 		}
+	case *filesystem.DeleteFileInput:
+		toolCall.Input = &v1.ToolCall_DeleteFile{
+			DeleteFile: &v1.ToolCall_DeleteFileInput{
+				Path: input.Path,
+			},
+		}
+	case *filesystem.MoveFileInput:
+		toolCall.Input = &v1.ToolCall_MoveFile{
+			MoveFile: &v1.ToolCall_MoveFileInput{
+				Source:      input.Source,
+				Destination: input.Destination,
+				Overwrite:   input.Overwrite,
+			},
+		}
+	case *filesystem.CopyFileInput:
+		toolCall.Input = &v1.ToolCall_CopyFile{
+			CopyFile: &v1.ToolCall_CopyFileInput{
+				Source:      input.Source,
+				Destination: input.Destination,
+				Overwrite:   input.Overwrite,
+			},
+		}
+	case *filesystem.ApplyPatchInput:
+		toolCall.Input = &v1.ToolCall_ApplyPatch{
+			ApplyPatch: &v1.ToolCall_ApplyPatchInput{
+				Patch:  input.Patch,
+				DryRun: input.DryRun,
+			},
+		}
 	case *system.ExecuteCommandInput:
 		toolCall.Input = &v1.ToolCall_ExecuteCommand{
 			ExecuteCommand: &v1.ToolCall_ExecuteCommandInput{
@@ -395,6 +535,59 @@ func convertArgumentsToProtoToolCall(tooCall Tool, arguments []sobek.Value, sess
 				NextSteps:    input.NextSteps,
 			},
 		}
+	case *communication.DelegateInput:
+		toolCall.Input = &v1.ToolCall_Delegate{
+			Delegate: ConvertDelegateInputToProto(input),
+		}
+	case *communication.RunParallelInput:
+		toolCall.Input = &v1.ToolCall_RunParallel{
+			RunParallel: ConvertRunParallelInputToProto(input),
+		}
+	case *custom.CallInput:
+		arguments, err := json.Marshal(input.Arguments)
+		if err != nil {
+			return nil, err
+		}
+		toolCall.Input = &v1.ToolCall_CustomTool{
+			CustomTool: &v1.ToolCall_CustomToolInput{
+				Arguments: string(arguments),
+			},
+		}
+	case *mcp.CallInput:
+		arguments, err := json.Marshal(input.Arguments)
+		if err != nil {
+			return nil, err
+		}
+		toolCall.Input = &v1.ToolCall_McpTool{
+			McpTool: &v1.ToolCall_McpToolInput{
+				Server:    input.Server,
+				Tool:      input.Tool,
+				Arguments: string(arguments),
+			},
+		}
+	case *testrunner.RunTestsInput:
+		toolCall.Input = &v1.ToolCall_RunTests{
+			RunTests: &v1.ToolCall_RunTestsInput{
+				Framework:     input.Framework,
+				Path:          input.Path,
+				Tests:         input.Tests,
+				Filter:        input.Filter,
+				Command:       input.Command,
+				Report:        input.Report,
+				IncludePassed: input.IncludePassed,
+			},
+		}
+	case git.Input:
+		arguments, err := json.Marshal(input)
+		if err != nil {
+			return nil, err
+		}
+		toolCall.Input = &v1.ToolCall_Git{
+			Git: &v1.ToolCall_GitInput{
+				Operation: input.Operation(),
+				Arguments: string(arguments),
+			},
+		}
 	default:
 		return nil, shared.Errorf(shared.ErrorSourceSystem, "unknown tool input type: %T", input)
 	}
@@ -433,6 +626,55 @@ func convertResultToProtoToolResult(toolName string, result any) (*v1.MessagePar
 		toolResult.Result = &v1.ToolResult_EditFile{
 			EditFile: editResult,
 		}
+	case *filesystem.DeleteFileResult:
+		toolResult.Result = &v1.ToolResult_DeleteFile{
+			DeleteFile: &v1.ToolResult_DeleteFileResult{
+				Path: result.Path,
+			},
+		}
+	case *filesystem.MoveFileResult:
+		toolResult.Result = &v1.ToolResult_MoveFile{
+			MoveFile: &v1.ToolResult_MoveFileResult{
+				Overwritten: result.Overwritten,
+			},
+		}
+	case *filesystem.CopyFileResult:
+		toolResult.Result = &v1.ToolResult_CopyFile{
+			CopyFile: &v1.ToolResult_CopyFileResult{
+				Overwritten: result.Overwritten,
+			},
+		}
+	case *filesystem.ApplyPatchResult:
+		patchResult := &v1.ToolResult_ApplyPatchResult{
+			DryRun: result.DryRun,
+		}
+		for _, file := range result.Files {
+			patchedFile := &v1.ToolResult_ApplyPatchResult_PatchedFile{
+				Path:   file.Path,
+				Action: file.Action,
+			}
+			if file.PatchInfo.Patch != "" {
+				patchedFile.PatchInfo = &v1.ToolResult_EditFileResult_PatchInfo{
+					Patch:        file.PatchInfo.Patch,
+					LinesAdded:   int32(file.PatchInfo.LinesAdded),
+					LinesRemoved: int32(file.PatchInfo.LinesRemoved),
+				}
+			}
+			for _, hunk := range file.Hunks {
+				patchedFile.Hunks = append(patchedFile.Hunks, &v1.ToolResult_ApplyPatchResult_HunkResult{
+					Hunk:    int32(hunk.Hunk),
+					Applied: hunk.Applied,
+					Line:    int32(hunk.Line),
+					Offset:  int32(hunk.Offset),
+					Fuzzy:   hunk.Fuzzy,
+					Error:   hunk.Error,
+				})
+			}
+			patchResult.Files = append(patchResult.Files, patchedFile)
+		}
+		toolResult.Result = &v1.ToolResult_ApplyPatch{
+			ApplyPatch: patchResult,
+		}
 	case *system.ExecuteCommandResult:
 		toolResult.Result = &v1.ToolResult_ExecuteCommand{
 			ExecuteCommand: &v1.ToolResult_ExecuteCommandResult{
@@ -496,6 +738,73 @@ func convertResultToProtoToolResult(toolName string, result any) (*v1.MessagePar
 				NextSteps:    result.NextSteps,
 			},
 		}
+	case *communication.DelegateResult:
+		toolResult.Result = &v1.ToolResult_Delegate{
+			Delegate: ConvertDelegateResultToProto(result),
+		}
+	case *communication.RunParallelResult:
+		toolResult.Result = &v1.ToolResult_RunParallel{
+			RunParallel: ConvertRunParallelResultToProto(result),
+		}
+	case *custom.CallResult:
+		output, err := json.Marshal(result.Output)
+		if err != nil {
+			return nil, err
+		}
+		toolResult.Result = &v1.ToolResult_CustomTool{
+			CustomTool: &v1.ToolResult_CustomToolResult{
+				Output: string(output),
+			},
+		}
+	case *mcp.CallResult:
+		output, err := json.Marshal(result.Output)
+		if err != nil {
+			return nil, err
+		}
+		toolResult.Result = &v1.ToolResult_McpTool{
+			McpTool: &v1.ToolResult_McpToolResult{
+				Output: string(output),
+			},
+		}
+	case *testrunner.RunTestsResult:
+		testsResult := &v1.ToolResult_RunTestsResult{
+			Framework: result.Framework,
+			Command:   result.Command,
+			Success:   result.Success,
+			Passed:    int32(result.Passed),
+			Failed:    int32(result.Failed),
+			Skipped:   int32(result.Skipped),
+			Duration:  result.Duration,
+			Truncated: result.Truncated,
+			Output:    result.Output,
+			TimedOut:  result.TimedOut,
+		}
+		for _, test := range result.Tests {
+			testsResult.Tests = append(testsResult.Tests, &v1.ToolResult_RunTestsResult_TestCase{
+				Name:     test.Name,
+				Suite:    test.Suite,
+				Status:   test.Status,
+				Duration: test.Duration,
+				Message:  test.Message,
+				File:     test.File,
+				Line:     int32(test.Line),
+				Output:   test.Output,
+			})
+		}
+		toolResult.Result = &v1.ToolResult_RunTests{
+			RunTests: testsResult,
+		}
+	case *git.CallResult:
+		output, err := json.Marshal(result.Output)
+		if err != nil {
+			return nil, err
+		}
+		toolResult.Result = &v1.ToolResult_Git{
+			Git: &v1.ToolResult_GitResult{
+				Operation: result.Operation,
+				Output:    string(output),
+			},
+		}
 	case nil:
 		// Some tools like handoff don't return a result, only an error
 		return nil, nil
@@ -509,3 +818,56 @@ func convertResultToProtoToolResult(toolName string, result any) (*v1.MessagePar
 		},
 	}, nil
 }
+
+func ConvertDelegateInputToProto(input *communication.DelegateInput) *v1.ToolCall_DelegateInput {
+	return &v1.ToolCall_DelegateInput{
+		Agent:        input.Agent,
+		Instructions: input.Instructions,
+		MaxCost:      input.MaxCost,
+	}
+}
+
+func ConvertDelegateResultToProto(result *communication.DelegateResult) *v1.ToolResult_DelegateResult {
+	return &v1.ToolResult_DelegateResult{
+		TaskId:       result.TaskID,
+		Agent:        result.Agent,
+		Summary:      result.Summary,
+		Completed:    result.Completed,
+		Deliverables: result.Deliverables,
+		NextSteps:    result.NextSteps,
+		Cost:         result.Cost,
+	}
+}
+
+func ConvertRunParallelInputToProto(input *communication.RunParallelInput) *v1.ToolCall_RunParallelInput {
+	tasks := make([]*v1.ToolCall_DelegateInput, 0, len(input.Tasks))
+	for _, task := range input.Tasks {
+		tasks = append(tasks, ConvertDelegateInputToProto(task))
+	}
+
+	return &v1.ToolCall_RunParallelInput{
+		Tasks:       tasks,
+		Concurrency: int32(input.Concurrency),
+	}
+}
+
+func ConvertRunParallelResultToProto(result *communication.RunParallelResult) *v1.ToolResult_RunParallelResult {
+	results := make([]*v1.ToolResult_RunParallelResult_TaskResult, 0, len(result.Results))
+	for _, r := range result.Results {
+		taskResult := &v1.ToolResult_RunParallelResult_TaskResult{
+			Agent: r.Agent,
+			Error: r.Error,
+		}
+		if r.Result != nil {
+			taskResult.Result = ConvertDelegateResultToProto(r.Result)
+		}
+		results = append(results, taskResult)
+	}
+
+	return &v1.ToolResult_RunParallelResult{
+		Results:  results,
+		Finished: int32(result.Finished),
+		Failed:   int32(result.Failed),
+		Cost:     result.Cost,
+	}
+}
@@ -1,10 +1,13 @@
 package codeact
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"log/slog"
+	"slices"
 	"strings"
 	"time"
 
@@ -19,14 +22,17 @@ type InterpreterInput struct {
 }
 
 type InterpreterOutput struct {
-	ConsoleOutput string           `json:"console_output"`
-	FunctionCalls []FunctionCall   `json:"function_calls"`
-	ToolStats     map[string]int64 `json:"tool_stats"`
+	ConsoleOutput    string           `json:"console_output"`
+	FunctionCalls    []FunctionCall   `json:"function_calls"`
+	ToolStats        map[string]int64 `json:"tool_stats"`
+	InstructionFiles []string         `json:"instruction_files,omitempty"`
 }
 
 type Interpreter struct {
 	Tools        []Tool
 	Interceptors []Interceptor
+	States       *StateStore
+	Reads        *ReadStore
 
 	inputSchema map[string]any
 }
@@ -46,10 +52,24 @@ func NewInterpreter(tools []Tool, interceptors []Interceptor) *Interpreter {
 	return &Interpreter{
 		Tools:        tools,
 		Interceptors: interceptors,
+		States:       NewStateStore(DefaultStateIdleTimeout, DefaultStateMaxSize),
+		Reads:        NewReadStore(DefaultReadIdleTimeout),
 		inputSchema:  inputSchema,
 	}
 }
 
+// WithTools returns a copy of the interpreter that additionally exposes the
+// given tools. The receiver is not modified.
+func (c *Interpreter) WithTools(tools ...Tool) *Interpreter {
+	if len(tools) == 0 {
+		return c
+	}
+
+	extended := *c
+	extended.Tools = append(slices.Clone(c.Tools), tools...)
+	return &extended
+}
+
 func (c *Interpreter) Name() string {
 	return "code_interpreter"
 }
@@ -86,32 +106,72 @@ func (c *Interpreter) Interpret(ctx context.Context, fsys afero.Fs, input json.R
 		"script_lines", scriptLines,
 	)
 
-	vm := sobek.New()
-	vm.SetFieldNameMapper(sobek.TagFieldNameMapper("json", true))
+	limits := task.Limits.withDefaults()
+	scriptCtx, cancel := context.WithTimeout(ctx, limits.Timeout)
+	defer cancel()
+
+	var (
+		vm     *sobek.Runtime
+		state  *taskState
+		stdout = newOutputBuffer(limits.MaxOutputBytes)
+	)
+	script, lineOffset := ensureStrictMode(args.Script)
+	if task.PersistentState {
+		var notice string
+		state, notice = c.States.acquire(task.ID)
+		defer c.States.release(state)
+
+		if notice != "" {
+			fmt.Fprintln(stdout, notice)
+		}
+
+		vm = state.vm
+		vm.ClearInterrupt()
+		script, lineOffset = scopeScript(args.Script)
+	} else {
+		vm = newRuntime()
+	}
 
-	var stdout bytes.Buffer
-	session := NewSession(ctx, task, vm, &stdout, &stdout, fsys, &shared.DefaultCommandRunner{})
+	session := NewSession(scriptCtx, task, vm, stdout, stdout, fsys, &shared.DefaultCommandRunner{})
+	session.Reads = c.Reads.Tracker(task.ID)
 
 	for _, tool := range c.Tools {
-		vm.Set(tool.Name(), c.intercept(session, tool, tool.ToolHandler(session)))
+		setTool(vm, tool.Name(), c.intercept(session, tool, c.limit(session, tool, limits, tool.ToolHandler(session))))
+	}
+
+	if diagnostics := c.validateScript(vm, args.Script, task.Policy); len(diagnostics) > 0 {
+		err = validationError(diagnostics)
+		logger.Info("script rejected before execution", "diagnostics", len(diagnostics))
+		return &InterpreterOutput{
+			ConsoleOutput: stdout.String(),
+			ToolStats:     make(map[string]int64),
+		}, err
 	}
 
 	done := make(chan error)
 	go func() {
 		select {
-		case <-ctx.Done():
+		case <-scriptCtx.Done():
 			vm.Interrupt("execution cancelled")
 		case <-done:
 		}
 	}()
 
-	_, err = vm.RunString(ensureStrictMode(args.Script))
+	_, err = vm.RunString(script)
 	close(done)
 
 	if err != nil {
-		err = c.handleScriptError(err)
+		err = c.handleScriptError(err, lineOffset)
+		if errors.Is(scriptCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+			err = timeoutError(limits)
+		}
 		logger.Error("script execution failed", "error", err)
 	}
+	err = finishTransaction(session, err, stdout)
+
+	if state != nil {
+		c.retainState(session, state, stdout)
+	}
 
 	callState, ok := GetValue[*FunctionCallState](session, "function_call_state")
 	if !ok {
@@ -123,6 +183,8 @@ func (c *Interpreter) Interpret(ctx context.Context, fsys afero.Fs, input json.R
 		toolStats = make(map[string]int64)
 	}
 
+	instructionFiles, _ := GetValue[[]string](session, "instruction_files")
+
 	consoleOutput := stdout.String()
 	logger.Info("script execution completed",
 		"duration_ms", time.Since(interpretStart).Milliseconds(),
@@ -132,13 +194,43 @@ func (c *Interpreter) Interpret(ctx context.Context, fsys afero.Fs, input json.R
 	)
 
 	return &InterpreterOutput{
-		ConsoleOutput: consoleOutput,
-		FunctionCalls: callState.Calls,
-		ToolStats:     toolStats,
+		ConsoleOutput:    consoleOutput,
+		FunctionCalls:    callState.Calls,
+		ToolStats:        toolStats,
+		InstructionFiles: instructionFiles,
 	}, err
 }
 
-func (c *Interpreter) handleScriptError(err error) error {
+// retainState evicts the state of the task if the script asked for a reset or
+// if the state grew beyond the size limit.
+func (c *Interpreter) retainState(session *Session, state *taskState, stdout io.Writer) {
+	if reset, _ := GetValue[bool](session, "reset_state"); reset {
+		c.States.Evict(session.Task.ID, "")
+		return
+	}
+
+	if c.States.MaxSize == 0 {
+		return
+	}
+
+	var reason string
+	size, cyclic := state.size(c.Tools, c.States.MaxSize)
+	switch {
+	case cyclic:
+		reason = fmt.Sprintf("it holds cyclic references, which count as exceeding the limit of %d bytes", c.States.MaxSize)
+	case size > c.States.MaxSize:
+		reason = fmt.Sprintf("it grew beyond the limit of %d bytes", c.States.MaxSize)
+	}
+	if reason != "" {
+		c.States.Evict(session.Task.ID, "")
+		fmt.Fprintf(stdout, "Note: the JavaScript state of this task was reset because %s. Keep only the data you need in global variables.\n", reason)
+	}
+}
+
+// handleScriptError returns the Go error a tool threw, or the JavaScript
+// exception with the position where it was thrown. Positions are reported
+// relative to the script of the model rather than the wrapped script that ran.
+func (c *Interpreter) handleScriptError(err error, lineOffset int) error {
 	exception, ok := err.(*sobek.Exception)
 	if !ok {
 		return err
@@ -148,6 +240,19 @@ func (c *Interpreter) handleScriptError(err error) error {
 		return exception.Unwrap()
 	}
 
+	for _, frame := range exception.Stack() {
+		position := frame.Position()
+		if position.Line == 0 {
+			continue
+		}
+
+		location := fmt.Sprintf("<eval>:%d:%d", max(position.Line-lineOffset, 1), position.Column)
+		if name := frame.FuncName(); name != "<anonymous>" {
+			location = fmt.Sprintf("%s (%s)", name, location)
+		}
+		return fmt.Errorf("%s at %s", exception.Value(), location)
+	}
+
 	return err
 }
 
@@ -159,10 +264,41 @@ func (c *Interpreter) intercept(session *Session, toolName Tool, inner func(sobe
 	return wrapped
 }
 
-func ensureStrictMode(script string) string {
+// setTool defines the tool as a global function. Dotted names such as
+// mcp.github.create_issue create the intermediate objects.
+func setTool(vm *sobek.Runtime, name string, handler func(sobek.FunctionCall) sobek.Value) {
+	parts := strings.Split(name, ".")
+	if len(parts) == 1 {
+		vm.Set(name, handler)
+		return
+	}
+
+	parent := vm.GlobalObject()
+	for _, part := range parts[:len(parts)-1] {
+		next := parent.Get(part)
+		if next == nil || sobek.IsUndefined(next) || sobek.IsNull(next) {
+			object := vm.NewObject()
+			parent.Set(part, object)
+			parent = object
+			continue
+		}
+		parent = next.ToObject(vm)
+	}
+	parent.Set(parts[len(parts)-1], handler)
+}
+
+// scopeScript runs the script in a block so that let and const declarations
+// do not leak into the persistent state. Redeclaring them in the next script
+// would fail otherwise. It also returns the number of lines inserted before the
+// script.
+func scopeScript(script string) (string, int) {
+	return "'use strict';\n{\n" + script + "\n}", 2
+}
+
+func ensureStrictMode(script string) (string, int) {
 	if strings.HasPrefix(script, "use strict;") {
-		return script
+		return script, 0
 	}
 
-	return "'use strict';\n" + script
+	return "'use strict';\n" + script, 1
 }
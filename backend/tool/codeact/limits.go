@@ -0,0 +1,253 @@
+package codeact
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/grafana/sobek"
+)
+
+// Limits restricts the resources a single script may use. Zero values fall
+// back to the corresponding value of DefaultLimits.
+type Limits struct {
+	Timeout            time.Duration
+	MaxToolCalls       int
+	MaxOutputBytes     int
+	MaxToolResultBytes int
+}
+
+var DefaultLimits = Limits{
+	Timeout:            30 * time.Minute,
+	MaxToolCalls:       500,
+	MaxOutputBytes:     64 << 10,
+	MaxToolResultBytes: 1 << 20,
+}
+
+func (l *Limits) withDefaults() Limits {
+	limits := DefaultLimits
+	if l == nil {
+		return limits
+	}
+
+	if l.Timeout > 0 {
+		limits.Timeout = l.Timeout
+	}
+	if l.MaxToolCalls > 0 {
+		limits.MaxToolCalls = l.MaxToolCalls
+	}
+	if l.MaxOutputBytes > 0 {
+		limits.MaxOutputBytes = l.MaxOutputBytes
+	}
+	if l.MaxToolResultBytes > 0 {
+		limits.MaxToolResultBytes = l.MaxToolResultBytes
+	}
+	return limits
+}
+
+func timeoutError(limits Limits) error {
+	return NewCustomError(fmt.Sprintf("script exceeded the time limit of %s and was stopped", limits.Timeout), []string{
+		"Split the work into several smaller scripts",
+		"Check loops for missing or unreachable termination conditions",
+	})
+}
+
+// limit enforces the tool call and result size limits. It wraps the tool
+// handler before the interceptors so that only the truncated result is
+// recorded and published.
+func (c *Interpreter) limit(session *Session, tool Tool, limits Limits, inner func(sobek.FunctionCall) sobek.Value) func(sobek.FunctionCall) sobek.Value {
+	if isSilentTool(tool.Name()) {
+		return inner
+	}
+
+	return func(call sobek.FunctionCall) sobek.Value {
+		calls, _ := GetValue[int](session, "tool_calls")
+		if calls >= limits.MaxToolCalls {
+			session.Throw(NewCustomError(fmt.Sprintf("script exceeded the limit of %d tool calls", limits.MaxToolCalls), []string{
+				"Split the work into several scripts and continue where this one stopped",
+				"Narrow down the inputs first, e.g. with grep or find_file, instead of calling a tool for every candidate",
+			}))
+		}
+		SetValue(session, "tool_calls", calls+1)
+
+		result := inner(call)
+
+		raw, ok := GetValue[any](session, "result")
+		if !ok || raw == nil {
+			return result
+		}
+
+		encoded, err := json.Marshal(raw)
+		if err != nil || len(encoded) <= limits.MaxToolResultBytes {
+			return result
+		}
+
+		truncated, ok := truncateResult(encoded, limits.MaxToolResultBytes)
+		if !ok {
+			UnsetValue(session, "result")
+			session.Throw(NewCustomError(fmt.Sprintf("the result of %s has %d bytes, more than the limit of %d bytes", tool.Name(), len(encoded), limits.MaxToolResultBytes), []string{
+				"Request a smaller part of the data, e.g. a line range with the start_line and end_line options of read_file",
+				"Use a more specific pattern or path with grep and find_file",
+				"Redirect the output of long running commands to a file and search it with grep",
+			}))
+		}
+
+		SetValue(session, "result", truncated)
+		return session.VM.ToValue(truncated)
+	}
+}
+
+// truncationNoteKey is added to truncated object results to tell the agent
+// which values were shortened.
+const truncationNoteKey = "truncation_note"
+
+// minTruncatedString is the length below which strings are kept, so that
+// short values such as paths and states survive truncation.
+const minTruncatedString = 64
+
+// truncateResult shortens the largest strings and lists of an encoded tool
+// result until it fits into the limit. Numbers, booleans and short strings,
+// e.g. the exit code of a command, are kept as they are. Shortened strings end
+// with a marker, and object results get a note naming the shortened fields.
+// It reports false if the result cannot be made small enough.
+func truncateResult(encoded []byte, limit int) (any, bool) {
+	var value any
+	if err := json.Unmarshal(encoded, &value); err != nil {
+		return nil, false
+	}
+
+	size := len(encoded)
+	var paths []string
+	for {
+		result := value
+		if object, ok := value.(map[string]any); ok && len(paths) > 0 {
+			result = withTruncationNote(object, paths, size, limit)
+		}
+
+		current, err := json.Marshal(result)
+		if err != nil {
+			return nil, false
+		}
+		if len(current) <= limit {
+			return result, len(paths) > 0
+		}
+
+		var largest truncationCandidate
+		findLargest(value, "", func(v any) { value = v }, &largest)
+		if largest.set == nil {
+			return nil, false
+		}
+		largest.shrink(len(current) - limit)
+		if !slices.Contains(paths, largest.path) {
+			paths = append(paths, largest.path)
+		}
+	}
+}
+
+func withTruncationNote(object map[string]any, paths []string, size, limit int) map[string]any {
+	result := make(map[string]any, len(object)+1)
+	maps.Copy(result, object)
+	result[truncationNoteKey] = fmt.Sprintf("The result had %d bytes, more than the limit of %d bytes, so %s were shortened. Request a smaller part of the data if you need the rest.",
+		size, limit, strings.Join(paths, ", "))
+	return result
+}
+
+// truncationCandidate is a string or list within a tool result that can be
+// shortened.
+type truncationCandidate struct {
+	path  string
+	size  int
+	value any
+	set   func(any)
+}
+
+func findLargest(value any, path string, set func(any), largest *truncationCandidate) {
+	switch v := value.(type) {
+	case string:
+		if len(v) >= minTruncatedString && len(v) > largest.size {
+			*largest = truncationCandidate{path: path, size: len(v), value: v, set: set}
+		}
+	case []any:
+		if encoded, err := json.Marshal(v); err == nil && len(v) > 1 && len(encoded) > largest.size {
+			*largest = truncationCandidate{path: path, size: len(encoded), value: v, set: set}
+		}
+		for i, item := range v {
+			findLargest(item, fmt.Sprintf("%s[%d]", path, i), func(n any) { v[i] = n }, largest)
+		}
+	case map[string]any:
+		for key, item := range v {
+			itemPath := key
+			if path != "" {
+				itemPath = path + "." + key
+			}
+			findLargest(item, itemPath, func(n any) { v[key] = n }, largest)
+		}
+	}
+}
+
+// shrink removes at least excess bytes from the candidate. Strings keep their
+// beginning followed by a marker, lists keep their first items.
+func (c *truncationCandidate) shrink(excess int) {
+	switch v := c.value.(type) {
+	case string:
+		marker := "\n[... %d bytes truncated ...]"
+		keep := max(0, len(v)-excess-len(marker)-10)
+		for keep > 0 && !utf8.RuneStart(v[keep]) {
+			keep--
+		}
+		c.set(v[:keep] + fmt.Sprintf(marker, len(v)-keep))
+	case []any:
+		average := max(1, c.size/len(v))
+		remove := min(len(v)-1, max(1, (excess+average-1)/average))
+		c.set(v[:len(v)-remove])
+	}
+}
+
+// outputBuffer keeps the beginning and the end of the console output within
+// the limit. The middle of longer output is replaced by a marker.
+type outputBuffer struct {
+	limit int
+	head  []byte
+	tail  []byte
+	total int
+}
+
+func newOutputBuffer(limit int) *outputBuffer {
+	return &outputBuffer{limit: limit}
+}
+
+func (b *outputBuffer) Write(p []byte) (int, error) {
+	written := len(p)
+	b.total += written
+
+	headSize := b.limit / 2
+	if len(b.head) < headSize {
+		n := min(headSize-len(b.head), len(p))
+		b.head = append(b.head, p[:n]...)
+		p = p[n:]
+	}
+
+	tailSize := b.limit - headSize
+	b.tail = append(b.tail, p...)
+	if len(b.tail) > 2*tailSize {
+		b.tail = append(b.tail[:0], b.tail[len(b.tail)-tailSize:]...)
+	}
+
+	return written, nil
+}
+
+func (b *outputBuffer) String() string {
+	if b.total <= b.limit {
+		return string(b.head) + string(b.tail)
+	}
+
+	tailSize := b.limit - len(b.head)
+	tail := b.tail[len(b.tail)-tailSize:]
+	truncated := b.total - len(b.head) - len(tail)
+	return fmt.Sprintf("%s\n\n[... %d bytes truncated, the output exceeded the limit of %d bytes. Print only what you need or write large results to a file ...]\n\n%s",
+		b.head, truncated, b.limit, tail)
+}
@@ -0,0 +1,155 @@
+package codeact
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/afero"
+)
+
+func TestLimits(t *testing.T) {
+	tests := []struct {
+		Name   string
+		Script string
+		Limits *Limits
+		Output string
+		Error  string
+	}{
+		{
+			Name:   "endless loop is stopped",
+			Script: `while (true) {}`,
+			Limits: &Limits{Timeout: 50 * time.Millisecond},
+			Error:  "script exceeded the time limit of 50ms and was stopped",
+		},
+		{
+			Name:   "tool calls are limited",
+			Script: `for (let i = 0; i < 5; i++) { read_file("/project/log.txt"); }`,
+			Limits: &Limits{MaxToolCalls: 3},
+			Error:  "script exceeded the limit of 3 tool calls",
+		},
+		{
+			Name:   "print does not count as tool call",
+			Script: `read_file("/project/log.txt"); for (let i = 0; i < 5; i++) { print(i); }`,
+			Limits: &Limits{MaxToolCalls: 1},
+			Output: "0\n1\n2\n3\n4\n",
+		},
+		{
+			Name:   "large tool results are truncated",
+			Script: `const result = read_file("/project/log.txt"); print(result.path, result.content.length < 200, result.content.endsWith("bytes truncated ...]"), result.truncation_note.includes("content were shortened"));`,
+			Limits: &Limits{MaxToolResultBytes: 400},
+			Output: "/project/log.txt true true true\n",
+		},
+		{
+			Name:   "tool results that cannot be truncated are rejected",
+			Script: `read_file("/project/log.txt");`,
+			Limits: &Limits{MaxToolResultBytes: 16},
+			Error:  "the result of read_file has",
+		},
+		{
+			Name:   "console output is truncated in the middle",
+			Script: `print("a".repeat(50)); print("b".repeat(50));`,
+			Limits: &Limits{MaxOutputBytes: 20},
+			Output: "aaaaaaaaaa\n\n[... 82 bytes truncated, the output exceeded the limit of 20 bytes. Print only what you need or write large results to a file ...]\n\nbbbbbbbbb\n",
+		},
+		{
+			Name:   "defaults apply without limits",
+			Script: `print(read_file("/project/log.txt").content.length > 0);`,
+			Output: "true\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			if err := afero.WriteFile(fs, "/project/log.txt", []byte(strings.Repeat("log line\n", 100)), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			interpreter := NewInterpreter(
+				[]Tool{NewPrintTool(), NewReadFileTool()},
+				[]Interceptor{InterceptorFunc(DurableFunctionInterceptor), InterceptorFunc(ResetTemporarySessionValuesInterceptor)},
+			)
+
+			input, err := json.Marshal(InterpreterInput{Script: test.Script})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			task := &Task{ID: uuid.New(), ProjectDirectory: "/project", Limits: test.Limits}
+			output, err := interpreter.Interpret(context.Background(), fs, input, task)
+			if test.Error != "" {
+				if err == nil || !strings.Contains(err.Error(), test.Error) {
+					t.Fatalf("expected error containing %q, got %v", test.Error, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if output.ConsoleOutput != test.Output {
+				t.Errorf("expected output %q, got %q", test.Output, output.ConsoleOutput)
+			}
+		})
+	}
+}
+
+func TestTruncateResult(t *testing.T) {
+	tests := []struct {
+		Name   string
+		Result any
+		Limit  int
+		Check  func(t *testing.T, truncated map[string]any)
+	}{
+		{
+			Name:   "scalar fields are kept",
+			Result: map[string]any{"exit_code": 2, "success": false, "output": strings.Repeat("x", 2000)},
+			Limit:  500,
+			Check: func(t *testing.T, truncated map[string]any) {
+				if truncated["exit_code"] != float64(2) || truncated["success"] != false {
+					t.Errorf("expected scalar fields to be kept, got %v", truncated)
+				}
+				if !strings.HasSuffix(truncated["output"].(string), "bytes truncated ...]") {
+					t.Errorf("expected a truncation marker, got %q", truncated["output"])
+				}
+			},
+		},
+		{
+			Name:   "lists are shortened",
+			Result: map[string]any{"matches": slices.Repeat([]string{"match"}, 100), "total": 100},
+			Limit:  500,
+			Check: func(t *testing.T, truncated map[string]any) {
+				matches := truncated["matches"].([]any)
+				if len(matches) == 0 || len(matches) == 100 || truncated["total"] != float64(100) {
+					t.Errorf("expected the matches to be shortened, got %d", len(matches))
+				}
+				if !strings.Contains(truncated[truncationNoteKey].(string), "matches were shortened") {
+					t.Errorf("expected a note about the matches, got %q", truncated[truncationNoteKey])
+				}
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			encoded, err := json.Marshal(test.Result)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			truncated, ok := truncateResult(encoded, test.Limit)
+			if !ok {
+				t.Fatal("expected the result to be truncated")
+			}
+			if size, _ := json.Marshal(truncated); len(size) > test.Limit {
+				t.Errorf("expected at most %d bytes, got %d", test.Limit, len(size))
+			}
+			test.Check(t, truncated.(map[string]any))
+		})
+	}
+}
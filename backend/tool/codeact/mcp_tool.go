@@ -0,0 +1,287 @@
+package codeact
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/grafana/sobek"
+
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/furisto/construct/backend/tool/mcp"
+)
+
+const mcpToolDescription = `
+## Description
+%[2]s
+This tool is provided by the MCP server %[5]s.
+
+## Parameters
+Call %[3]s with a single object argument that matches the following JSON schema:
+%[1]sjson
+%[4]s
+%[1]s
+
+## Expected Output
+Returns the output of the tool. Structured and JSON output is returned as an object, any other output as a string.
+If the tool fails an error is thrown.
+
+## Usage Examples
+%[1]s
+const result = %[3]s({ /* arguments */ });
+print(result);
+%[1]s
+`
+
+var invalidIdentifierChars = regexp.MustCompile(`[^A-Za-z0-9_$]`)
+
+// MCPToolName returns the name under which a tool of an MCP server is exposed
+// to scripts. Characters that are not valid in JavaScript identifiers are
+// replaced with underscores.
+func MCPToolName(server, tool string) string {
+	return "mcp." + server + "." + invalidIdentifierChars.ReplaceAllString(tool, "_")
+}
+
+type mcpTool struct {
+	session     *mcp.Session
+	tool        mcp.Tool
+	name        string
+	description string
+}
+
+// NewMCPTool exposes a tool of an MCP server to CodeAct scripts as
+// mcp.<server>.<tool>(args).
+func NewMCPTool(session *mcp.Session, tool mcp.Tool) Tool {
+	schema := tool.InputSchema
+	if schema == nil {
+		schema = map[string]any{"type": "object"}
+	}
+	encoded, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		encoded = []byte(`{"type": "object"}`)
+	}
+
+	description := tool.Description
+	if description == "" {
+		description = tool.Title
+	}
+
+	name := MCPToolName(session.Server().Name, tool.Name)
+	return &mcpTool{
+		session:     session,
+		tool:        tool,
+		name:        name,
+		description: fmt.Sprintf(mcpToolDescription, "```", description, name, encoded, session.Server().Name),
+	}
+}
+
+func (t *mcpTool) Name() string {
+	return t.name
+}
+
+func (t *mcpTool) Description() string {
+	return t.description
+}
+
+// ReadOnly reports whether the server declared that the tool does not modify
+// its environment.
+func (t *mcpTool) ReadOnly() bool {
+	return t.tool.Annotations.ReadOnlyHint
+}
+
+func (t *mcpTool) Input(session *Session, args []sobek.Value) (any, error) {
+	input := &mcp.CallInput{
+		Server:    t.session.Server().Name,
+		Tool:      t.tool.Name,
+		Arguments: map[string]any{},
+	}
+
+	if len(args) == 0 || sobek.IsUndefined(args[0]) || sobek.IsNull(args[0]) {
+		return input, nil
+	}
+
+	arguments, ok := args[0].Export().(map[string]any)
+	if !ok {
+		return nil, base.NewError(base.InvalidInput, "arguments", "must be an object")
+	}
+	input.Arguments = arguments
+
+	return input, nil
+}
+
+func (t *mcpTool) ToolHandler(session *Session) func(call sobek.FunctionCall) sobek.Value {
+	return func(call sobek.FunctionCall) sobek.Value {
+		rawInput, err := t.Input(session, call.Arguments)
+		if err != nil {
+			session.Throw(err)
+		}
+		input := rawInput.(*mcp.CallInput)
+
+		result, err := t.session.CallTool(session.Context, input.Tool, input.Arguments)
+		if err != nil {
+			session.Throw(err)
+		}
+
+		SetValue(session, "result", result)
+		return session.VM.ToValue(result.Output)
+	}
+}
+
+const (
+	mcpMethodListResources = "resources/list"
+	mcpMethodReadResource  = "resources/read"
+	mcpMethodListPrompts   = "prompts/list"
+	mcpMethodGetPrompt     = "prompts/get"
+)
+
+// MCPReservedServerNames cannot be used as server names because they are the
+// names of the resource and prompt functions in the mcp namespace.
+var MCPReservedServerNames = []string{"list_resources", "read_resource", "list_prompts", "get_prompt"}
+
+const mcpResourceToolsDescription = `
+## Description
+Functions to access the resources and prompts of the MCP servers %[2]s.
+
+## Functions
+- mcp.list_resources(server): Returns the resources of the server as a list of objects with uri, name, description and mimeType.
+- mcp.read_resource(server, uri): Returns the contents of a resource as a list of objects with uri, mimeType and text or a base64 encoded blob.
+- mcp.list_prompts(server): Returns the prompt templates of the server with their arguments.
+- mcp.get_prompt(server, name, args): Renders a prompt template with an object of string arguments and returns its messages.
+
+## Usage Examples
+%[1]s
+const resources = mcp.list_resources("docs");
+const contents = mcp.read_resource("docs", resources[0].uri);
+print(contents[0].text);
+%[1]s
+`
+
+type mcpResourceTool struct {
+	name        string
+	method      string
+	sessions    map[string]*mcp.Session
+	description string
+}
+
+// NewMCPResourceTools returns the functions that list and read the resources
+// and prompts of the given sessions. The description of the first function
+// documents all of them.
+func NewMCPResourceTools(sessions []*mcp.Session) []Tool {
+	byName := make(map[string]*mcp.Session, len(sessions))
+	var names []string
+	for _, session := range sessions {
+		if session.SupportsResources() || session.SupportsPrompts() {
+			byName[session.Server().Name] = session
+			names = append(names, session.Server().Name)
+		}
+	}
+	if len(byName) == 0 {
+		return nil
+	}
+
+	description := fmt.Sprintf(mcpResourceToolsDescription, "```", fmt.Sprint(names))
+	tools := make([]Tool, 0, len(MCPReservedServerNames))
+	for i, method := range []string{mcpMethodListResources, mcpMethodReadResource, mcpMethodListPrompts, mcpMethodGetPrompt} {
+		tool := &mcpResourceTool{
+			name:     "mcp." + MCPReservedServerNames[i],
+			method:   method,
+			sessions: byName,
+		}
+		tool.description = "See mcp.list_resources."
+		if i == 0 {
+			tool.description = description
+		}
+		tools = append(tools, tool)
+	}
+	return tools
+}
+
+func (t *mcpResourceTool) Name() string {
+	return t.name
+}
+
+func (t *mcpResourceTool) Description() string {
+	return t.description
+}
+
+func (t *mcpResourceTool) Input(session *Session, args []sobek.Value) (any, error) {
+	if len(args) == 0 {
+		return nil, base.NewError(base.InvalidInput, "server", "is required")
+	}
+
+	input := &mcp.CallInput{
+		Server:    args[0].String(),
+		Tool:      t.method,
+		Arguments: map[string]any{},
+	}
+
+	switch t.method {
+	case mcpMethodReadResource:
+		if len(args) < 2 {
+			return nil, base.NewError(base.InvalidInput, "uri", "is required")
+		}
+		input.Arguments["uri"] = args[1].String()
+	case mcpMethodGetPrompt:
+		if len(args) < 2 {
+			return nil, base.NewError(base.InvalidInput, "name", "is required")
+		}
+		input.Arguments["name"] = args[1].String()
+		if len(args) > 2 && !sobek.IsUndefined(args[2]) && !sobek.IsNull(args[2]) {
+			arguments, ok := args[2].Export().(map[string]any)
+			if !ok {
+				return nil, base.NewError(base.InvalidInput, "args", "must be an object")
+			}
+			input.Arguments["arguments"] = arguments
+		}
+	}
+
+	return input, nil
+}
+
+func (t *mcpResourceTool) ToolHandler(session *Session) func(call sobek.FunctionCall) sobek.Value {
+	return func(call sobek.FunctionCall) sobek.Value {
+		rawInput, err := t.Input(session, call.Arguments)
+		if err != nil {
+			session.Throw(err)
+		}
+		input := rawInput.(*mcp.CallInput)
+
+		server, ok := t.sessions[input.Server]
+		if !ok {
+			session.Throw(base.NewError(base.InvalidInput, "server", fmt.Sprintf("unknown MCP server %s", input.Server)))
+		}
+
+		var output any
+		switch t.method {
+		case mcpMethodListResources:
+			output, err = server.Resources(session.Context)
+		case mcpMethodReadResource:
+			output, err = server.ReadResource(session.Context, input.Arguments["uri"].(string))
+		case mcpMethodListPrompts:
+			output, err = server.Prompts(session.Context)
+		case mcpMethodGetPrompt:
+			arguments := map[string]string{}
+			if raw, ok := input.Arguments["arguments"].(map[string]any); ok {
+				for key, value := range raw {
+					arguments[key] = fmt.Sprint(value)
+				}
+			}
+			output, err = server.GetPrompt(session.Context, input.Arguments["name"].(string), arguments)
+		}
+		if err != nil {
+			session.Throw(err)
+		}
+
+		result := &mcp.CallResult{Output: output}
+		SetValue(session, "result", result)
+
+		// Round trip through JSON so that scripts see the protocol field names.
+		encoded, err := json.Marshal(output)
+		if err != nil {
+			session.Throw(err)
+		}
+		var decoded any
+		json.Unmarshal(encoded, &decoded)
+		return session.VM.ToValue(decoded)
+	}
+}
@@ -0,0 +1,128 @@
+package codeact
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/spf13/afero"
+
+	"github.com/furisto/construct/backend/tool/mcp"
+	"github.com/furisto/construct/backend/tool/mcp/mcptest"
+)
+
+func TestMCPTool(t *testing.T) {
+	server := httptest.NewServer(&mcptest.HTTPHandler{})
+	defer server.Close()
+
+	ctx := context.Background()
+	session, err := mcp.Connect(ctx, mcp.Server{Name: "stub", Transport: mcp.TransportHTTP, URL: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer session.Close()
+
+	mcpTools, err := session.Tools(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tools := NewMCPResourceTools([]*mcp.Session{session})
+	for _, tool := range mcpTools {
+		tools = append(tools, NewMCPTool(session, tool))
+	}
+
+	tests := []struct {
+		Name          string
+		Script        string
+		Policy        *Policy
+		Output        string
+		Error         string
+		FunctionCalls []FunctionCall
+	}{
+		{
+			Name:   "call is recorded",
+			Script: `print(mcp.stub.add({ a: 1, b: 2 }).sum);`,
+			Output: "3\n",
+			FunctionCalls: []FunctionCall{
+				{
+					ToolName: "mcp.stub.add",
+					Input: FunctionCallInput{MCPTool: &mcp.CallInput{
+						Server:    "stub",
+						Tool:      "add",
+						Arguments: map[string]any{"a": int64(1), "b": int64(2)},
+					}},
+					Output: FunctionCallOutput{MCPTool: &mcp.CallResult{Output: map[string]any{"sum": float64(3)}}},
+				},
+			},
+		},
+		{
+			Name:   "tool error is thrown",
+			Script: `mcp.stub.fail({});`,
+			Error:  "mcp tool failed",
+		},
+		{
+			Name:   "resources can be read",
+			Script: `print(mcp.read_resource("stub", mcp.list_resources("stub")[0].uri)[0].text);`,
+			Output: "Hello from the stub\n",
+		},
+		{
+			Name:   "prompts can be rendered",
+			Script: `print(mcp.get_prompt("stub", "greet", { name: "Ada" })[0].content.text);`,
+			Output: "Say hello to Ada\n",
+		},
+		{
+			Name:   "read-only tools are allowed in read-only mode",
+			Script: `print(mcp.stub.echo({ message: "hi" }).message);`,
+			Policy: &Policy{ReadOnly: true},
+			Output: "hi\n",
+		},
+		{
+			Name:   "other tools are denied in read-only mode",
+			Script: `mcp.stub.add({ a: 1, b: 2 });`,
+			Policy: &Policy{ReadOnly: true},
+			Error:  "the project configuration only permits read access",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			interpreter := NewInterpreter(
+				[]Tool{NewPrintTool()},
+				[]Interceptor{InterceptorFunc(PolicyInterceptor), InterceptorFunc(DurableFunctionInterceptor), InterceptorFunc(ResetTemporarySessionValuesInterceptor)},
+			).WithTools(tools...)
+
+			input, err := json.Marshal(InterpreterInput{Script: test.Script})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			output, err := interpreter.Interpret(ctx, afero.NewMemMapFs(), input, &Task{
+				ID:               uuid.New(),
+				ProjectDirectory: "/project",
+				Policy:           test.Policy,
+			})
+			if test.Error != "" {
+				if err == nil || !strings.Contains(err.Error(), test.Error) {
+					t.Fatalf("expected error containing %q, got %v", test.Error, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if output.ConsoleOutput != test.Output {
+				t.Errorf("expected output %q, got %q", test.Output, output.ConsoleOutput)
+			}
+			if test.FunctionCalls != nil {
+				if diff := cmp.Diff(test.FunctionCalls, output.FunctionCalls); diff != "" {
+					t.Errorf("function calls mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
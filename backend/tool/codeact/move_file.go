@@ -0,0 +1,130 @@
+package codeact
+
+import (
+	"fmt"
+
+	"github.com/grafana/sobek"
+
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/furisto/construct/backend/tool/filesystem"
+)
+
+const moveFileDescription = `
+## Description
+Moves or renames a single file. Missing parent directories of the destination are created. Use it instead of running mv with execute_command, so the move is validated and recorded as a file operation.
+
+## Parameters
+- **source** (string, required): Absolute path to the file to move.
+- **destination** (string, required): Absolute path the file is moved to, including the file name.
+- **overwrite** (boolean, optional): Replace an existing file at the destination. Defaults to false. If the destination changed since you last read it, the call throws "File was modified since it was last read".
+
+## Expected Output
+Returns whether an existing file at the destination was replaced:
+%[1]s
+{
+  "overwritten": false
+}
+%[1]s
+If the source does not exist, the destination already exists and overwrite is false, or either path is a directory, it throws an exception describing the issue.
+
+## IMPORTANT USAGE NOTES
+- **Files only**: Directories cannot be moved with this tool. Move the files of a directory one by one
+- **Update references**: Moving a file does not update imports or other references to it. Use grep and edit_file to update them
+- **No transactions**: move_file cannot be called after begin_transaction because the move cannot be staged
+- **Always use absolute paths**: Always use absolute paths starting with "/"
+
+## Usage Examples
+
+### Rename a file
+%[1]s
+move_file("/workspace/project/src/utils.js", "/workspace/project/src/helpers.js");
+%[1]s
+
+### Move a file into a new directory, replacing an existing file
+%[1]s
+move_file("/workspace/project/config.json", "/workspace/project/config/default.json", true);
+%[1]s
+`
+
+func NewMoveFileTool() Tool {
+	return NewOnDemandTool(
+		base.ToolNameMoveFile,
+		fmt.Sprintf(moveFileDescription, "```"),
+		moveFileInput,
+		moveFileHandler,
+	)
+}
+
+func moveFileInput(session *Session, args []sobek.Value) (any, error) {
+	source, destination, overwrite, ok := transferArguments(args)
+	if !ok {
+		return nil, base.NewCustomError(base.InvalidInput.String(), moveFileSuggestions)
+	}
+
+	return &filesystem.MoveFileInput{
+		Source:      source,
+		Destination: destination,
+		Overwrite:   overwrite,
+	}, nil
+}
+
+func moveFileHandler(session *Session) func(call sobek.FunctionCall) sobek.Value {
+	return func(call sobek.FunctionCall) sobek.Value {
+		rejectInTransaction(session, base.ToolNameMoveFile, "Move the file in a separate script before or after the transaction")
+
+		input, err := moveFileInput(session, call.Arguments)
+		if err != nil {
+			session.Throw(err)
+		}
+
+		moveInput := input.(*filesystem.MoveFileInput)
+		if moveInput.Overwrite {
+			if err := session.Reads.Check(session.FS, moveInput.Destination); err != nil {
+				session.Throw(err)
+			}
+		}
+
+		result, err := filesystem.MoveFile(session.FS, moveInput)
+		if err != nil {
+			session.Throw(err)
+		}
+		session.Reads.Move(moveInput.Source, moveInput.Destination)
+
+		SetValue(session, "result", result)
+		return session.VM.ToValue(result)
+	}
+}
+
+// transferArguments parses the source, destination and optional overwrite
+// flag of move_file and copy_file.
+func transferArguments(args []sobek.Value) (string, string, bool, bool) {
+	if len(args) < 2 || len(args) > 3 || !isString(args[0]) || !isString(args[1]) {
+		return "", "", false, false
+	}
+
+	var overwrite bool
+	if len(args) == 3 && !sobek.IsUndefined(args[2]) && !sobek.IsNull(args[2]) {
+		if _, ok := args[2].Export().(bool); !ok {
+			return "", "", false, false
+		}
+		overwrite = args[2].ToBoolean()
+	}
+
+	return args[0].String(), args[1].String(), overwrite, true
+}
+
+func isString(value sobek.Value) bool {
+	if value == nil || sobek.IsUndefined(value) || sobek.IsNull(value) {
+		return false
+	}
+	_, ok := value.Export().(string)
+	return ok
+}
+
+var moveFileSuggestions = []string{
+	"Ensure that you provide the correct input arguments as specified in the tool description",
+	"- **source** (string, required): Absolute path to the file to move",
+	"- **destination** (string, required): Absolute path the file is moved to, including the file name",
+	"- **overwrite** (boolean, optional): Replace an existing file at the destination",
+	"For example: move_file('/project/src/utils.js', '/project/src/helpers.js')",
+}
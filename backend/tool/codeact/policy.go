@@ -0,0 +1,409 @@
+package codeact
+
+import (
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/grafana/sobek"
+
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/furisto/construct/backend/tool/filesystem"
+	"github.com/furisto/construct/backend/tool/git"
+	"github.com/furisto/construct/backend/tool/system"
+)
+
+// Policy restricts what the tools of a session may do. It is derived from the
+// project configuration of the task and the tool set of the agent. A nil
+// policy allows everything.
+type Policy struct {
+	// ReadOnly denies tools that modify files or run commands as well as
+	// custom tools and MCP tools that are not annotated as read-only.
+	ReadOnly bool
+	// AgentReadOnly has the same effect as ReadOnly but is imposed by the
+	// tool set of the agent instead of the project configuration.
+	AgentReadOnly bool
+	// AllowedTools limits the agent to tools matching one of the patterns.
+	// A '*' matches any sequence of characters. Empty allows all tools.
+	AllowedTools []string
+	// AllowedCommands limits execute_command to commands matching one of the
+	// patterns. A '*' matches any sequence of characters. Nil allows all
+	// commands, an empty list none.
+	AllowedCommands []string
+	// Ignore hides matching paths from list_files, find_file and grep.
+	// Patterns are relative to the project directory; patterns without a
+	// slash match a file or directory name at any depth.
+	Ignore []string
+	// Preview denies tools whose changes cannot be staged for review and
+	// limits execute_command to read-only commands. The file changes
+	// themselves are staged by the file system the task runs on.
+	Preview bool
+	// AllowDestructiveGit permits git operations that discard work, such as
+	// reset --hard, force pushes or deleting unmerged branches.
+	AllowDestructiveGit bool
+}
+
+var commandSeparators = regexp.MustCompile(`&&|\|\||[;|&\n]`)
+
+// redirections write or read files the allowed patterns do not mention.
+// Duplicating stderr to stdout is harmless and commonly used.
+var redirections = regexp.MustCompile(`[<>]`)
+
+// programFlags are options that make a command run a program given as their
+// argument. A '*' of an allowed pattern does not match them, e.g. "go test *"
+// does not allow "go test -exec 'rm -rf build' ./...".
+var programFlags = regexp.MustCompile(`(^|\s)(-exec|-execdir|-ok|-okdir|-toolexec|--exec|--pre)(=|\s|$)`)
+
+// previewCommands are the commands a task in preview mode may run. They only
+// read the project directory, so running them does not bypass the review.
+var previewCommands = []string{
+	"ls", "ls *", "cat *", "head *", "tail *", "wc *", "pwd", "tree", "tree *",
+	"grep *", "rg *", "git status", "git status *", "git diff", "git diff *", "git log", "git log *", "git show *",
+}
+
+// previewWritingFlags are options of the preview commands that write files
+// or run other programs. Git accepts abbreviated long options.
+var previewWritingFlags = []*regexp.Regexp{
+	regexp.MustCompile(`^rg\s(.*\s)?--pre(=|\s|$)`),
+	regexp.MustCompile(`^git\s(.*\s)?--out(p(u(t)?)?)?(=|\s|$)`),
+	regexp.MustCompile(`^tree\s(.*\s)?-[a-zA-Z]*o`),
+}
+
+// alwaysAllowedTools cannot be removed by a tool set because scripts cannot
+// report results or complete the task without them.
+var alwaysAllowedTools = []string{base.ToolNamePrint, base.ToolNameSubmitReport}
+
+// ToolAllowed reports whether the tool set of the agent permits the tool.
+func (p *Policy) ToolAllowed(name string) bool {
+	if p == nil || len(p.AllowedTools) == 0 || slices.Contains(alwaysAllowedTools, name) {
+		return true
+	}
+
+	for _, pattern := range p.AllowedTools {
+		if matchesPattern(pattern, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// ToolEnabled reports whether the tool can be called at all. Disabled tools
+// are not documented in the system prompt.
+func (p *Policy) ToolEnabled(tool Tool) bool {
+	if p == nil {
+		return true
+	}
+	return p.ToolAllowed(tool.Name()) && !(p.readOnly() && modifies(tool)) && !(p.Preview && !stageable(tool))
+}
+
+// DestructiveGitAllowed reports whether git operations that discard work may
+// run.
+func (p *Policy) DestructiveGitAllowed() bool {
+	return p != nil && p.AllowDestructiveGit
+}
+
+func (p *Policy) readOnly() bool {
+	return p.ReadOnly || p.AgentReadOnly
+}
+
+// modifies reports whether the tool may change files or the environment.
+// Custom tools may run arbitrary commands or call external services, so they
+// count as modifying. Delegated tasks are not bound by the read-only
+// restriction of their parent, so delegating counts as modifying as well.
+func modifies(tool Tool) bool {
+	switch t := tool.(type) {
+	case *customTool:
+		return true
+	case *mcpTool:
+		return !t.ReadOnly()
+	}
+
+	switch tool.Name() {
+	case base.ToolNameCreateFile, base.ToolNameEditFile, base.ToolNameDeleteFile, base.ToolNameMoveFile, base.ToolNameCopyFile,
+		base.ToolNameApplyPatch, base.ToolNameExecuteCommand, base.ToolNameRunTests, base.ToolNameDelegate, base.ToolNameRunParallel, base.ToolNameGitCommit:
+		return true
+	}
+	return false
+}
+
+// stageable reports whether the tool may be called in preview mode. Written
+// files are staged and commands are checked individually, while deletions,
+// moves and the effects of other modifying tools cannot be held back. Patches
+// are checked individually for deleted files.
+func stageable(tool Tool) bool {
+	switch tool.Name() {
+	case base.ToolNameCreateFile, base.ToolNameEditFile, base.ToolNameCopyFile, base.ToolNameApplyPatch, base.ToolNameExecuteCommand:
+		return true
+	}
+	return !modifies(tool)
+}
+
+// previewCommandAllowed reports whether the command only reads and may
+// therefore run in preview mode. Redirections and options that write files
+// or run programs are rejected because they would write past the staging.
+func previewCommandAllowed(command string) bool {
+	if strings.Contains(command, ">") {
+		return false
+	}
+	for _, part := range commandSeparators.Split(command, -1) {
+		for _, flag := range previewWritingFlags {
+			if flag.MatchString(strings.TrimSpace(part)) {
+				return false
+			}
+		}
+	}
+	return (&Policy{AllowedCommands: previewCommands}).CommandAllowed(command)
+}
+
+// CommandAllowed reports whether every command of a possibly chained command
+// line matches one of the allowed patterns. Command and process substitution
+// are rejected because their contents cannot be checked, redirections because
+// they write or read arbitrary files.
+func (p *Policy) CommandAllowed(command string) bool {
+	if p.AllowedCommands == nil {
+		return true
+	}
+
+	command = strings.ReplaceAll(command, "2>&1", "")
+	if strings.Contains(command, "$(") || strings.Contains(command, "`") || redirections.MatchString(command) {
+		return false
+	}
+
+	for _, part := range commandSeparators.Split(command, -1) {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		if !p.matchesAllowedCommand(part) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (p *Policy) matchesAllowedCommand(command string) bool {
+	runsProgram := programFlags.MatchString(command)
+	for _, pattern := range p.AllowedCommands {
+		if runsProgram && !programFlags.MatchString(pattern) {
+			continue
+		}
+		if matchesPattern(pattern, command) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesPattern reports whether s matches the pattern, in which a '*'
+// matches any sequence of characters.
+func matchesPattern(pattern, s string) bool {
+	expr := "^" + strings.ReplaceAll(regexp.QuoteMeta(strings.TrimSpace(pattern)), `\*`, ".*") + "$"
+	matched, _ := regexp.MatchString(expr, s)
+	return matched
+}
+
+// Ignored reports whether path matches one of the ignore patterns. Relative
+// paths are interpreted relative to the project directory.
+func (p *Policy) Ignored(projectDirectory, path string) bool {
+	if len(p.Ignore) == 0 {
+		return false
+	}
+
+	rel := path
+	if filepath.IsAbs(path) {
+		var err error
+		rel, err = filepath.Rel(projectDirectory, path)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return false
+		}
+	}
+	segments := strings.Split(filepath.ToSlash(filepath.Clean(rel)), "/")
+
+	for _, pattern := range p.Ignore {
+		pattern = strings.Trim(filepath.ToSlash(pattern), "/")
+		if pattern == "" {
+			continue
+		}
+		anchored := strings.Contains(pattern, "/")
+
+		for i := range segments {
+			candidate := segments[i]
+			if anchored {
+				candidate = strings.Join(segments[:i+1], "/")
+			}
+			if matched, _ := doublestar.Match(pattern, candidate); matched {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// PolicyInterceptor enforces the policy of the session's task. It must be
+// registered before interceptors that record results so that they observe the
+// filtered output.
+func PolicyInterceptor(session *Session, tool Tool, inner func(sobek.FunctionCall) sobek.Value) func(sobek.FunctionCall) sobek.Value {
+	return func(call sobek.FunctionCall) sobek.Value {
+		policy := session.Task.Policy
+		// The validator only sees literal commands, commands built at runtime
+		// are checked here. This applies without a policy as well.
+		if tool.Name() == base.ToolNameExecuteCommand {
+			input, err := tool.Input(session, call.Arguments)
+			if err != nil {
+				session.Throw(err)
+			}
+			if command, ok := input.(*system.ExecuteCommandInput); ok {
+				if reason := destructiveCommandReason(policy, command.Command); reason != "" {
+					session.Throw(destructiveCommandError(command.Command, reason))
+				}
+			}
+		}
+		if policy == nil {
+			return inner(call)
+		}
+
+		if !policy.ToolAllowed(tool.Name()) {
+			session.Throw(base.NewCustomError("the tool is not enabled for this agent", []string{
+				"Only call the tools that are documented in your instructions.",
+				"Hand the task off to an agent that has the tool enabled if it is required.",
+			}, "tool", tool.Name()))
+		}
+		if policy.readOnly() && modifies(tool) {
+			session.Throw(readOnlyError(policy, tool.Name()))
+		}
+		if policy.Preview && !stageable(tool) {
+			session.Throw(previewError(tool.Name()))
+		}
+
+		switch tool.Name() {
+		case base.ToolNameExecuteCommand:
+			input, err := tool.Input(session, call.Arguments)
+			if err != nil {
+				session.Throw(err)
+			}
+			if command, ok := input.(*system.ExecuteCommandInput); ok && !policy.CommandAllowed(command.Command) {
+				session.Throw(commandNotAllowedError(policy, command.Command))
+			}
+			if command, ok := input.(*system.ExecuteCommandInput); ok && policy.Preview && !previewCommandAllowed(command.Command) {
+				session.Throw(base.NewCustomError("command is not allowed in preview mode", []string{
+					"Only run commands that read the project directory, e.g. ls, cat, grep, git status or git diff.",
+					"Describe the commands the user should run after the changes were applied.",
+				}, "command", command.Command))
+			}
+		case base.ToolNameApplyPatch:
+			input, err := tool.Input(session, call.Arguments)
+			if err != nil {
+				session.Throw(err)
+			}
+			if patch, ok := input.(*filesystem.ApplyPatchInput); ok && policy.Preview && !patch.DryRun && filesystem.PatchDeletesFiles(patch.Patch) {
+				session.Throw(base.NewCustomError("patches that delete files are not allowed in preview mode", []string{
+					"Remove the sections that delete files from the patch, only changed and created files are staged.",
+					"Describe the files the user should delete after the changes were applied.",
+				}, "tool", tool.Name()))
+			}
+		case base.ToolNameGitBranch, base.ToolNameGitStash:
+			input, err := tool.Input(session, call.Arguments)
+			if err != nil {
+				session.Throw(err)
+			}
+			if operation, ok := input.(git.Input); ok && operation.Modifies() {
+				if policy.readOnly() {
+					session.Throw(readOnlyError(policy, tool.Name()))
+				}
+				if policy.Preview {
+					session.Throw(previewError(tool.Name()))
+				}
+			}
+		case base.ToolNameListFiles, base.ToolNameFindFile, base.ToolNameGrep:
+			result := inner(call)
+			raw, ok := GetValue[any](session, "result")
+			if !ok || !filterIgnored(policy, session.Task.ProjectDirectory, raw) {
+				return result
+			}
+			return session.VM.ToValue(raw)
+		}
+
+		return inner(call)
+	}
+}
+
+func readOnlyError(policy *Policy, toolName string) error {
+	return base.NewCustomError(readOnlyMessage(policy), []string{
+		"Use read-only tools such as read_file, list_files, find_file and grep.",
+		"Describe the required changes to the user instead of applying them.",
+	}, "tool", toolName)
+}
+
+func commandNotAllowedError(policy *Policy, command string) error {
+	return base.NewCustomError("command is not allowed by the project configuration", []string{
+		"Only run commands matching one of the allowed patterns.",
+		"Ask the user to add the command to permissions.commands of their configuration if it is required.",
+	}, "command", command, "allowed", strings.Join(policy.AllowedCommands, ", "))
+}
+
+func destructiveCommandError(command, reason string) error {
+	return base.NewCustomError("command is not permitted because it "+reason, []string{
+		"Look for a way to reach the goal without discarding work.",
+		"Ask the user to run the command or to set permissions.destructive_git in their configuration if it is a git command.",
+	}, "command", command)
+}
+
+func previewError(toolName string) error {
+	return base.NewCustomError(previewMessage, []string{
+		"Make the changes with create_file, edit_file and copy_file, they are staged for review.",
+		"Describe the remaining steps to the user instead of performing them.",
+	}, "tool", toolName)
+}
+
+const previewMessage = "the task runs in preview mode, only file changes can be staged for review"
+
+func readOnlyMessage(policy *Policy) string {
+	if !policy.ReadOnly {
+		return "this agent only permits read access"
+	}
+	return "the project configuration only permits read access"
+}
+
+// filterIgnored removes ignored paths from the result in place and reports
+// whether the result was changed.
+func filterIgnored(policy *Policy, projectDirectory string, raw any) bool {
+	switch result := raw.(type) {
+	case *filesystem.ListFilesResult:
+		entries := result.Entries[:0]
+		for _, entry := range result.Entries {
+			if !policy.Ignored(projectDirectory, entry.Name) {
+				entries = append(entries, entry)
+			}
+		}
+		changed := len(entries) != len(result.Entries)
+		result.Entries = entries
+		return changed
+	case *filesystem.FindFileResult:
+		files := result.Files[:0]
+		for _, file := range result.Files {
+			if !policy.Ignored(projectDirectory, file) {
+				files = append(files, file)
+			}
+		}
+		removed := len(result.Files) - len(files)
+		result.Files = files
+		result.TotalFiles -= removed
+		return removed > 0
+	case *filesystem.GrepResult:
+		matches := result.Matches[:0]
+		for _, match := range result.Matches {
+			if !policy.Ignored(projectDirectory, match.FilePath) {
+				matches = append(matches, match)
+			}
+		}
+		removed := len(result.Matches) - len(matches)
+		result.Matches = matches
+		result.TotalMatches -= removed
+		return removed > 0
+	}
+	return false
+}
@@ -0,0 +1,302 @@
+package codeact
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/spf13/afero"
+)
+
+func TestPolicyCommandAllowed(t *testing.T) {
+	policy := &Policy{AllowedCommands: []string{"go test *", "make", "git status", "cat *"}}
+
+	tests := []struct {
+		Command string
+		Allowed bool
+	}{
+		{Command: "go test ./...", Allowed: true},
+		{Command: "make", Allowed: true},
+		{Command: "git status && go test ./pkg", Allowed: true},
+		{Command: "make install", Allowed: false},
+		{Command: "go test ./... && rm -rf /", Allowed: false},
+		{Command: "go test $(rm -rf /)", Allowed: false},
+		{Command: "git status | sh", Allowed: false},
+		{Command: "cat main.go", Allowed: true},
+		{Command: "cat <(rm -rf build)", Allowed: false},
+		{Command: "go test ./... 2> >(rm -rf build)", Allowed: false},
+		{Command: "go test ./... > /etc/x", Allowed: false},
+		{Command: "go test ./... >> /etc/x", Allowed: false},
+		{Command: "cat < /etc/shadow", Allowed: false},
+		{Command: "go test ./... &> /etc/x", Allowed: false},
+		{Command: "go test ./... 2>&1", Allowed: true},
+		{Command: "go test -exec 'rm -rf /tmp/x' ./...", Allowed: false},
+		{Command: "go test -toolexec=./evil ./...", Allowed: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Command, func(t *testing.T) {
+			if allowed := policy.CommandAllowed(test.Command); allowed != test.Allowed {
+				t.Errorf("CommandAllowed(%q) = %v, want %v", test.Command, allowed, test.Allowed)
+			}
+		})
+	}
+
+	if !(&Policy{}).CommandAllowed("rm -rf build") {
+		t.Error("expected no allowlist to allow every command")
+	}
+	if (&Policy{AllowedCommands: []string{}}).CommandAllowed("go test ./...") {
+		t.Error("expected an empty allowlist to deny every command")
+	}
+}
+
+func TestPreviewCommandAllowed(t *testing.T) {
+	tests := []struct {
+		Command string
+		Allowed bool
+	}{
+		{Command: "git diff", Allowed: true},
+		{Command: "grep -rn TODO src && wc -l main.go", Allowed: true},
+		{Command: "cat main.go > copy.go", Allowed: false},
+		{Command: "cat $(rm -rf build)", Allowed: false},
+		{Command: "go test ./...", Allowed: false},
+		{Command: "git commit -m wip", Allowed: false},
+		{Command: "git log --oneline -5", Allowed: true},
+		{Command: "rg --pre ./script.sh TODO", Allowed: false},
+		{Command: "rg --pre=./script.sh TODO", Allowed: false},
+		{Command: "git diff --output=patch.diff", Allowed: false},
+		{Command: "git log -p --output patch.diff", Allowed: false},
+		{Command: "git show --outp=patch.diff HEAD", Allowed: false},
+		{Command: "tree -o tree.txt", Allowed: false},
+		{Command: "tree -L 2 -ao tree.txt", Allowed: false},
+		{Command: "tree -L 2", Allowed: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Command, func(t *testing.T) {
+			if allowed := previewCommandAllowed(test.Command); allowed != test.Allowed {
+				t.Errorf("previewCommandAllowed(%q) = %v, want %v", test.Command, allowed, test.Allowed)
+			}
+		})
+	}
+}
+
+func TestPolicyIgnored(t *testing.T) {
+	policy := &Policy{Ignore: []string{"node_modules", "build/**", "*.min.js"}}
+
+	tests := []struct {
+		Path    string
+		Ignored bool
+	}{
+		{Path: "/project/node_modules/react/index.js", Ignored: true},
+		{Path: "/project/web/node_modules", Ignored: true},
+		{Path: "/project/build/out/app", Ignored: true},
+		{Path: "/project/web/app.min.js", Ignored: true},
+		{Path: "web/app.min.js", Ignored: true},
+		{Path: "/project/src/build/main.go", Ignored: false},
+		{Path: "/project/src/app.js", Ignored: false},
+		{Path: "/elsewhere/node_modules/x", Ignored: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Path, func(t *testing.T) {
+			if ignored := policy.Ignored("/project", test.Path); ignored != test.Ignored {
+				t.Errorf("Ignored(%q) = %v, want %v", test.Path, ignored, test.Ignored)
+			}
+		})
+	}
+}
+
+func TestPolicyToolEnabled(t *testing.T) {
+	tools := []Tool{NewCreateFileTool(), NewExecuteCommandTool(), NewListFilesTool(), NewReadFileTool(), NewPrintTool(), NewCopyFileTool(), NewMoveFileTool()}
+
+	tests := []struct {
+		Name    string
+		Policy  *Policy
+		Enabled string
+	}{
+		{Name: "no policy", Enabled: "create_file,execute_command,list_files,read_file,print,copy_file,move_file"},
+		{Name: "read-only", Policy: &Policy{AgentReadOnly: true}, Enabled: "list_files,read_file,print"},
+		{Name: "allowlist", Policy: &Policy{AllowedTools: []string{"read_file", "execute_*"}}, Enabled: "execute_command,read_file,print"},
+		{Name: "allowlist and read-only", Policy: &Policy{ReadOnly: true, AllowedTools: []string{"read_file", "execute_*"}}, Enabled: "read_file,print"},
+		{Name: "preview", Policy: &Policy{Preview: true}, Enabled: "create_file,execute_command,list_files,read_file,print,copy_file"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var enabled []string
+			for _, tool := range tools {
+				if test.Policy.ToolEnabled(tool) {
+					enabled = append(enabled, tool.Name())
+				}
+			}
+			if strings.Join(enabled, ",") != test.Enabled {
+				t.Errorf("expected enabled tools %s, got %s", test.Enabled, strings.Join(enabled, ","))
+			}
+		})
+	}
+}
+
+func TestPolicyInterceptor(t *testing.T) {
+	tests := []struct {
+		Name    string
+		Script  string
+		Policy  *Policy
+		Output  string
+		Error   string
+		Created bool
+	}{
+		{
+			Name:    "no policy",
+			Script:  `create_file("/project/a.txt", "content");`,
+			Created: true,
+		},
+		{
+			Name:   "read-only denies writes",
+			Script: `create_file("/project/a.txt", "content");`,
+			Policy: &Policy{ReadOnly: true},
+			Error:  "the project configuration only permits read access",
+		},
+		{
+			Name:   "read-only denies commands",
+			Script: `execute_command("echo hello");`,
+			Policy: &Policy{ReadOnly: true},
+			Error:  "the project configuration only permits read access",
+		},
+		{
+			Name:   "command not in allowlist",
+			Script: `execute_command("echo hello");`,
+			Policy: &Policy{AllowedCommands: []string{"go test *"}},
+			Error:  "command is not allowed by the project configuration",
+		},
+		{
+			Name:   "agent read-only denies writes",
+			Script: `create_file("/project/a.txt", "content");`,
+			Policy: &Policy{AgentReadOnly: true},
+			Error:  "this agent only permits read access",
+		},
+		{
+			Name:   "tool not in allowlist",
+			Script: `execute_command("echo hello");`,
+			Policy: &Policy{AllowedTools: []string{"list_files", "create_*"}},
+			Error:  "the tool is not enabled for this agent",
+		},
+		{
+			Name:    "tool matches allowlist pattern",
+			Script:  `create_file("/project/a.txt", "content");`,
+			Policy:  &Policy{AllowedTools: []string{"list_files", "create_*"}},
+			Created: true,
+		},
+		{
+			Name:    "preview permits writes",
+			Script:  `create_file("/project/a.txt", "content");`,
+			Policy:  &Policy{Preview: true},
+			Created: true,
+		},
+		{
+			Name:   "preview denies other commands",
+			Script: `execute_command("go generate ./...");`,
+			Policy: &Policy{Preview: true},
+			Error:  "command is not allowed in preview mode",
+		},
+		{
+			Name:   "preview denies redirections",
+			Script: `execute_command("cat /project/main.go > /project/a.txt");`,
+			Policy: &Policy{Preview: true},
+			Error:  "command is not allowed in preview mode",
+		},
+		{
+			Name:   "preview denies patches deleting files",
+			Script: "apply_patch(`--- a/main.go\n+++ /dev/null\n@@ -1 +0,0 @@\n-package main\n`);",
+			Policy: &Policy{Preview: true},
+			Error:  "patches that delete files are not allowed in preview mode",
+		},
+		{
+			Name:   "read-only denies commits",
+			Script: `git.commit("Add a");`,
+			Policy: &Policy{ReadOnly: true},
+			Error:  "the project configuration only permits read access",
+		},
+		{
+			Name:   "preview denies creating branches",
+			Script: `git.branch({ create: "feature" });`,
+			Policy: &Policy{Preview: true},
+			Error:  "the task runs in preview mode",
+		},
+		{
+			Name:   "destructive git operations are refused without a policy",
+			Script: `git.stash({ action: "drop" });`,
+			Error:  "the operation is not permitted because dropping a stash entry discards its changes",
+		},
+		{
+			Name:   "destructive git operations are refused by default",
+			Script: `git.branch({ delete: "feature", force: true });`,
+			Policy: &Policy{AllowedCommands: []string{"go test *"}},
+			Error:  "the operation is not permitted because force deleting a branch discards its unmerged commits",
+		},
+		{
+			Name:   "destructive commands built at runtime are refused",
+			Script: `const c = "git reset --hard HEAD"; execute_command(c);`,
+			Error:  "command is not permitted because it discards uncommitted changes",
+		},
+		{
+			Name:   "destructive commands concatenated at runtime are refused",
+			Script: `execute_command("git reset " + "--hard HEAD");`,
+			Policy: &Policy{AllowedCommands: []string{"git *"}},
+			Error:  "command is not permitted because it discards uncommitted changes",
+		},
+		{
+			Name: "ignored entries are hidden",
+			Script: `const result = list_files("/project", false);
+print(result.entries.map(e => e.n).sort().join(","));`,
+			Policy: &Policy{Ignore: []string{"vendor"}},
+			Output: "/project/main.go\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			if err := afero.WriteFile(fs, "/project/main.go", []byte("package main"), 0644); err != nil {
+				t.Fatal(err)
+			}
+			if err := fs.MkdirAll("/project/vendor", 0755); err != nil {
+				t.Fatal(err)
+			}
+
+			interpreter := NewInterpreter(
+				append([]Tool{NewCreateFileTool(), NewExecuteCommandTool(), NewListFilesTool(), NewPrintTool(), NewApplyPatchTool()}, NewGitTools()...),
+				[]Interceptor{InterceptorFunc(PolicyInterceptor)},
+			)
+			input, err := json.Marshal(InterpreterInput{Script: test.Script})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			output, err := interpreter.Interpret(context.Background(), fs, input, &Task{
+				ID:               uuid.New(),
+				ProjectDirectory: "/project",
+				Policy:           test.Policy,
+			})
+
+			if test.Error != "" {
+				if err == nil || !strings.Contains(err.Error(), test.Error) {
+					t.Fatalf("expected error containing %q, got %v", test.Error, err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if test.Output != "" && output.ConsoleOutput != test.Output {
+				t.Errorf("expected output %q, got %q", test.Output, output.ConsoleOutput)
+			}
+
+			created, _ := afero.Exists(fs, "/project/a.txt")
+			if created != test.Created {
+				t.Errorf("expected file created to be %v, got %v", test.Created, created)
+			}
+		})
+	}
+}
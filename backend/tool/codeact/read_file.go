@@ -38,6 +38,7 @@ When reading a specific line range, the content will include context comments:
 - **Path format**: Always use absolute paths starting with "/". For example: /workspace/project/package.json"
 - **Line numbers**: Line numbers are 1-based. start_line=1 means the first line of the file
 - **Range validation**: start_line must be <= end_line, and both must be positive integers
+- **Current content**: Reading a file records its content. edit_file and create_file refuse to write a file that was changed by someone else since your last read, so read it again before retrying
 
 ## When to use
 - **Code analysis**: When you need to understand existing code structure, imports, or implementations
@@ -134,6 +135,7 @@ func readFileHandler(session *Session) func(call sobek.FunctionCall) sobek.Value
 		if err != nil {
 			session.Throw(err)
 		}
+		session.Reads.Record(input.Path, result.Fingerprint)
 
 		SetValue(session, "result", result)
 		return session.VM.ToValue(result)
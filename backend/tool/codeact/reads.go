@@ -0,0 +1,63 @@
+package codeact
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/furisto/construct/backend/tool/filesystem"
+)
+
+const DefaultReadIdleTimeout = 24 * time.Hour
+
+// ReadStore keeps the file fingerprints of tasks between interpreter calls, so
+// that a file read in one script and edited in a later one is still checked
+// for changes made in between. Trackers of tasks that were idle for longer
+// than the idle timeout are discarded, their files are then written without
+// a check until they are read again.
+type ReadStore struct {
+	IdleTimeout time.Duration
+
+	mu       sync.Mutex
+	trackers map[uuid.UUID]*readTracker
+}
+
+type readTracker struct {
+	tracker  *filesystem.ReadTracker
+	lastUsed time.Time
+}
+
+func NewReadStore(idleTimeout time.Duration) *ReadStore {
+	return &ReadStore{
+		IdleTimeout: idleTimeout,
+		trackers:    make(map[uuid.UUID]*readTracker),
+	}
+}
+
+// Tracker returns the read tracker of the task, creating it if necessary.
+func (s *ReadStore) Tracker(taskID uuid.UUID) *filesystem.ReadTracker {
+	if s == nil {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if s.IdleTimeout > 0 {
+		for id, tracker := range s.trackers {
+			if now.Sub(tracker.lastUsed) > s.IdleTimeout {
+				delete(s.trackers, id)
+			}
+		}
+	}
+
+	tracker, ok := s.trackers[taskID]
+	if !ok {
+		tracker = &readTracker{tracker: filesystem.NewReadTracker()}
+		s.trackers[taskID] = tracker
+	}
+	tracker.lastUsed = now
+	return tracker.tracker
+}
@@ -0,0 +1,154 @@
+package codeact
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/spf13/afero"
+)
+
+func TestStaleReadProtection(t *testing.T) {
+	tests := []struct {
+		Name    string
+		Scripts []string
+		// Change is written to /project/a.txt after the first script,
+		// like an edit of the user in their editor.
+		Change  string
+		Content string
+		Error   string
+	}{
+		{
+			Name: "edit after a change of the user is rejected",
+			Scripts: []string{
+				`read_file("/project/a.txt");`,
+				`edit_file("/project/a.txt", [{ old: "alpha", new: "beta" }]);`,
+			},
+			Change:  "alpha\ngamma\n",
+			Content: "alpha\ngamma\n",
+			Error:   "File was modified since it was last read",
+		},
+		{
+			Name: "create after a change of the user is rejected",
+			Scripts: []string{
+				`read_file("/project/a.txt");`,
+				`create_file("/project/a.txt", "replaced\n");`,
+			},
+			Change:  "alpha\ngamma\n",
+			Content: "alpha\ngamma\n",
+			Error:   "File was modified since it was last read",
+		},
+		{
+			Name: "patch after a change of the user is rejected",
+			Scripts: []string{
+				`read_file("/project/a.txt");`,
+				"apply_patch(`--- /project/a.txt\n+++ /project/a.txt\n@@ -1 +1,2 @@\n alpha\n+beta\n`);",
+			},
+			Change:  "alpha\ngamma\n",
+			Content: "alpha\ngamma\n",
+			Error:   "File was modified since it was last read",
+		},
+		{
+			Name: "overwriting copy after a change of the user is rejected",
+			Scripts: []string{
+				`read_file("/project/a.txt");`,
+				`copy_file("/project/b.txt", "/project/a.txt", true);`,
+			},
+			Change:  "alpha\ngamma\n",
+			Content: "alpha\ngamma\n",
+			Error:   "File was modified since it was last read",
+		},
+		{
+			Name: "overwriting move after a change of the user is rejected",
+			Scripts: []string{
+				`read_file("/project/a.txt");`,
+				`move_file("/project/b.txt", "/project/a.txt", true);`,
+			},
+			Change:  "alpha\ngamma\n",
+			Content: "alpha\ngamma\n",
+			Error:   "File was modified since it was last read",
+		},
+		{
+			Name: "edit after reading the file again succeeds",
+			Scripts: []string{
+				`read_file("/project/a.txt");`,
+				`read_file("/project/a.txt");
+edit_file("/project/a.txt", [{ old: "gamma", new: "delta" }]);`,
+			},
+			Change:  "alpha\ngamma\n",
+			Content: "alpha\ndelta\n",
+		},
+		{
+			Name: "own edits do not conflict",
+			Scripts: []string{
+				`read_file("/project/a.txt");
+edit_file("/project/a.txt", [{ old: "alpha", new: "beta" }]);`,
+				`edit_file("/project/a.txt", [{ old: "beta", new: "gamma" }]);`,
+			},
+			Content: "gamma\n",
+		},
+		{
+			Name: "files that were never read are not checked",
+			Scripts: []string{
+				`print("hello");`,
+				`edit_file("/project/a.txt", [{ old: "gamma", new: "delta" }]);`,
+			},
+			Change:  "alpha\ngamma\n",
+			Content: "alpha\ndelta\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			if err := afero.WriteFile(fs, "/project/a.txt", []byte("alpha\n"), 0644); err != nil {
+				t.Fatal(err)
+			}
+			if err := afero.WriteFile(fs, "/project/b.txt", []byte("copy\n"), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			interpreter := NewInterpreter(
+				[]Tool{NewCreateFileTool(), NewEditFileTool(), NewReadFileTool(), NewPrintTool(), NewApplyPatchTool(), NewCopyFileTool(), NewMoveFileTool()},
+				nil,
+			)
+			task := &Task{ID: uuid.New(), ProjectDirectory: "/project"}
+
+			var err error
+			for i, script := range test.Scripts {
+				if i == 1 && test.Change != "" {
+					if err := afero.WriteFile(fs, "/project/a.txt", []byte(test.Change), 0644); err != nil {
+						t.Fatal(err)
+					}
+				}
+
+				input, marshalErr := json.Marshal(InterpreterInput{Script: script})
+				if marshalErr != nil {
+					t.Fatal(marshalErr)
+				}
+				_, err = interpreter.Interpret(context.Background(), fs, input, task)
+				if err != nil && i < len(test.Scripts)-1 {
+					t.Fatalf("unexpected error in script %d: %v", i+1, err)
+				}
+			}
+
+			if test.Error != "" {
+				if err == nil || !strings.Contains(err.Error(), test.Error) {
+					t.Fatalf("expected error containing %q, got %v", test.Error, err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			content, readErr := afero.ReadFile(fs, "/project/a.txt")
+			if readErr != nil {
+				t.Fatal(readErr)
+			}
+			if string(content) != test.Content {
+				t.Errorf("expected content %q, got %q", test.Content, content)
+			}
+		})
+	}
+}
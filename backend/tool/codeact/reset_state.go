@@ -0,0 +1,64 @@
+package codeact
+
+import (
+	"fmt"
+
+	"github.com/grafana/sobek"
+
+	"github.com/furisto/construct/backend/tool/base"
+)
+
+const resetStateDescription = `
+## Description
+Discards the JavaScript state of this task. This task keeps global variables between code_interpreter calls: values assigned with var or to globalThis in one script are still available in the next one, while let and const declarations only live as long as the script. Call reset_state when the stored values are outdated or no longer needed. The state is discarded after the current script finished, so the current script can still use it.
+
+## Parameters
+None.
+
+## Expected Output
+Returns undefined.
+
+## IMPORTANT USAGE NOTES
+- **State is not guaranteed**: The state is also reset when the task is suspended, after a longer break or when it grows too large. You are told when this happens. Check that a variable is defined before you rely on it
+- **Keep it small**: The size of the state is limited. Keep the results you need again, such as file lists or search results, not the content of every file you read
+
+## Usage Examples
+
+### Reusing a search result
+%[1]s
+// first call
+var goFiles = find_file("*.go", "/workspace/project").files;
+print(goFiles.length);
+
+// a later call
+if (typeof goFiles === "undefined") {
+  var goFiles = find_file("*.go", "/workspace/project").files;
+}
+print(goFiles.filter(f => f.endsWith("_test.go")));
+%[1]s
+
+### Starting over
+%[1]s
+reset_state();
+%[1]s
+`
+
+func NewResetStateTool() Tool {
+	return NewOnDemandTool(
+		base.ToolNameResetState,
+		fmt.Sprintf(resetStateDescription, "```"),
+		resetStateInput,
+		resetStateHandler,
+	)
+}
+
+func resetStateInput(session *Session, args []sobek.Value) (any, error) {
+	return nil, nil
+}
+
+func resetStateHandler(session *Session) func(call sobek.FunctionCall) sobek.Value {
+	return func(call sobek.FunctionCall) sobek.Value {
+		SetValue(session, "reset_state", true)
+		return sobek.Undefined()
+	}
+}
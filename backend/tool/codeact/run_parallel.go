@@ -0,0 +1,143 @@
+package codeact
+
+import (
+	"fmt"
+
+	"github.com/grafana/sobek"
+
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/furisto/construct/backend/tool/communication"
+)
+
+const runParallelDescription = `
+## Description
+Delegates several self-contained pieces of work to agents at the same time and waits until all of them are done. Every task runs as its own delegated task with its own conversation history and budget, exactly like a call to delegate. Use it to fan out independent work, e.g. reviewing each package of a project.
+
+## Parameters
+- **tasks** (array, required): The tasks to run. Each task is an object with:
+  - **agent** (string, required): The name or ID of the agent that should do the work.
+  - **instructions** (string, required): The instructions for the agent, including every detail it needs. The agent does not see your conversation.
+  - **max_cost** (number, optional): The budget of the task in USD. Tasks without a budget share the remaining budget of the current task evenly.
+- **options** (object, optional): Settings for the group:
+  - **concurrency** (number, optional): How many tasks run at the same time, between 1 and %[3]d. Defaults to %[4]d.
+
+## Expected Output
+Returns an object with the results in the order of the tasks:
+%[1]s
+{
+  "results": [
+    {
+      "agent": "The agent of the task",
+      "result": { "task_id": "...", "summary": "...", "completed": true, "deliverables": [], "next_steps": "...", "cost": 0.12 },
+      "error": "Set instead of result if the task failed"
+    }
+  ],
+  "finished": 11, // number of tasks that returned a result
+  "failed": 1,    // number of tasks that failed
+  "cost": 1.32    // total cost of the finished tasks in USD
+}
+%[1]s
+
+A failing task does not stop the other tasks. The tool only throws if the input is invalid or the current task is stopped, in which case the running tasks are suspended.
+
+## IMPORTANT USAGE NOTES
+- **Independent work only**: The tasks run at the same time and cannot see each other's results. Split dependent work into consecutive calls
+- **No conflicting edits**: Tasks share the project directory. Do not let several tasks modify the same files
+- **No nesting**: Delegated tasks cannot delegate work themselves
+
+## Usage Examples
+
+### Reviewing packages in parallel
+%[1]s
+const packages = ["api", "auth", "storage"];
+const group = run_parallel(packages.map(name => ({
+  agent: "reviewer",
+  instructions: %[2]sReview the package /workspace/project/${name} for bugs and report your findings.%[2]s,
+})), { concurrency: 3 });
+
+group.results.forEach((r, i) => {
+  if (r.error) {
+    print(%[2]s${packages[i]} failed: ${r.error}%[2]s);
+  } else {
+    print(%[2]s${packages[i]}: ${r.result.summary}%[2]s);
+  }
+});
+print(%[2]sTotal cost: $${group.cost.toFixed(2)}%[2]s);
+%[1]s
+`
+
+func NewRunParallelTool() Tool {
+	return NewOnDemandTool(
+		base.ToolNameRunParallel,
+		fmt.Sprintf(runParallelDescription, "```", "`", communication.MaxParallelConcurrency, communication.DefaultParallelConcurrency),
+		runParallelInput,
+		runParallelHandler,
+	)
+}
+
+func runParallelInput(session *Session, args []sobek.Value) (any, error) {
+	if len(args) < 1 || sobek.IsUndefined(args[0]) || sobek.IsNull(args[0]) {
+		return nil, NewCustomError("run_parallel requires at least 1 argument", []string{
+			"- **tasks** (array, required): The tasks to run, each with an agent and instructions",
+			"- **options** (object, optional): Settings like concurrency for the group",
+		})
+	}
+
+	tasks := args[0].ToObject(session.VM)
+	if tasks.ClassName() != "Array" {
+		return nil, NewCustomError("tasks must be an array", []string{
+			"Pass an array of objects like { agent: \"reviewer\", instructions: \"...\" }",
+		})
+	}
+
+	input := &communication.RunParallelInput{}
+	length := int(tasks.Get("length").ToInteger())
+	for i := range length {
+		item := tasks.Get(fmt.Sprintf("%d", i))
+		if item == nil || sobek.IsUndefined(item) || sobek.IsNull(item) {
+			return nil, NewCustomError(fmt.Sprintf("task %d must be an object", i), []string{
+				"Pass an array of objects like { agent: \"reviewer\", instructions: \"...\" }",
+			})
+		}
+
+		obj := item.ToObject(session.VM)
+		task := &communication.DelegateInput{}
+		if agent := obj.Get("agent"); agent != nil && !sobek.IsUndefined(agent) && !sobek.IsNull(agent) {
+			task.Agent = agent.String()
+		}
+		if instructions := obj.Get("instructions"); instructions != nil && !sobek.IsUndefined(instructions) && !sobek.IsNull(instructions) {
+			task.Instructions = instructions.String()
+		}
+		if maxCost := obj.Get("max_cost"); maxCost != nil && !sobek.IsUndefined(maxCost) && !sobek.IsNull(maxCost) {
+			task.MaxCost = maxCost.ToFloat()
+		}
+		input.Tasks = append(input.Tasks, task)
+	}
+
+	if len(args) >= 2 && !sobek.IsUndefined(args[1]) && !sobek.IsNull(args[1]) {
+		options := args[1].ToObject(session.VM)
+		if concurrency := options.Get("concurrency"); concurrency != nil && !sobek.IsUndefined(concurrency) && !sobek.IsNull(concurrency) {
+			input.Concurrency = int(concurrency.ToInteger())
+		}
+	}
+
+	return input, nil
+}
+
+func runParallelHandler(session *Session) func(call sobek.FunctionCall) sobek.Value {
+	return func(call sobek.FunctionCall) sobek.Value {
+		rawInput, err := runParallelInput(session, call.Arguments)
+		if err != nil {
+			session.Throw(err)
+		}
+		input := rawInput.(*communication.RunParallelInput)
+
+		result, err := communication.RunParallel(session.Context, session.Task.Delegator, session.Task.ID, input)
+		if err != nil {
+			session.Throw(err)
+		}
+
+		SetValue(session, "result", result)
+		return session.VM.ToValue(result)
+	}
+}
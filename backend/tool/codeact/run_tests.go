@@ -0,0 +1,156 @@
+package codeact
+
+import (
+	"fmt"
+	"os/exec"
+
+	"github.com/grafana/sobek"
+
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/furisto/construct/backend/tool/testrunner"
+)
+
+const runTestsDescription = `
+## Description
+The run_tests tool runs the test suite of the project, or a part of it, and returns the passed, failed and skipped tests as structured results with failure messages and locations. Use it instead of running the tests with execute_command, reading raw test output to find a failing assertion wastes a lot of context.
+
+## Parameters
+- **options** (object, optional): Unknown options are rejected.
+  - **framework** (string): go, pytest, jest, vitest or junit. Detected from go.mod, the pytest configuration and package.json if omitted
+  - **path** (string): The directory to run the tests in, relative to the project directory. Defaults to the project directory
+  - **tests** (string[]): Packages, files or directories to run instead of the whole suite, e.g. ["./internal/store/..."] or ["tests/test_api.py"]
+  - **filter** (string): Only run tests whose name matches the pattern (go -run, pytest -k, jest and vitest --testNamePattern)
+  - **command** (string) and **report** (string): For other frameworks, the command to run and the JUnit XML reports it writes, a glob is allowed. Implies framework junit
+  - **include_passed** (boolean): List the passed tests as well. By default they are only counted
+  - **timeout** (number): Timeout in seconds, defaults to 600
+
+## Expected Output
+Returns an object describing the run:
+%[1]s
+{
+  "framework": "go",
+  "command": "go test -json ./...",
+  "success": false,       // true if the command succeeded and no test failed
+  "passed": 41,
+  "failed": 1,
+  "skipped": 2,
+  "duration": 3.2,        // seconds
+  "tests": [              // failures first, then skipped and, if requested, passed tests
+    {
+      "name": "TestParse/empty_input",
+      "suite": "example.com/app/parser",  // package, file or class
+      "status": "failed",                 // passed, failed or skipped
+      "message": "expected error, got nil",
+      "file": "parser_test.go",
+      "line": 42,
+      "output": "..."                     // the end of the output of the test
+    }
+  ],
+  "truncated": false,     // true if more than 200 tests would have been listed
+  "output": "...",        // only if the run failed without a failing test, e.g. build errors
+  "timed_out": false
+}
+%[1]s
+
+## IMPORTANT USAGE NOTES
+- **Failing tests are results**: The tool only throws if the tests could not be run or their results could not be read. Check success, failed and output
+- **Build failures**: A package, file or suite that could not be built or loaded is listed as a failed test without a name, its output contains the errors
+- **Narrow down**: After a failure, run only the affected tests with tests and filter instead of the whole suite
+- **Output limits**: Messages are cut to 1 KB, the output of a test to its last 4 KB and the output of the run to its last 16 KB
+- **Commands**: The tests run like execute_command and are subject to the same command restrictions. They cannot run during a transaction or in preview mode
+
+## Usage Examples
+%[1]s
+const result = run_tests();
+print(result.passed, "passed,", result.failed, "failed");
+for (const test of result.tests.filter(t => t.status === "failed")) {
+  print(%[2]s${test.suite} ${test.name} ${test.file}:${test.line}\n${test.message}%[2]s);
+}
+if (!result.success && result.failed === 0) {
+  print(result.output);
+}
+%[1]s
+
+%[1]s
+// Rerun a single failing test
+run_tests({ tests: ["./parser/..."], filter: "^TestParse$/empty_input" });
+run_tests({ tests: ["tests/test_api.py"], filter: "test_post" });
+%[1]s
+
+%[1]s
+// Maven writes JUnit XML reports
+run_tests({ command: "mvn -q test", report: "target/surefire-reports/*.xml" });
+%[1]s
+`
+
+// NewRunTestsTool returns the run_tests tool. Available reports whether a
+// toolchain is installed and decides which frameworks are detected, it
+// defaults to looking the executable up in the PATH.
+func NewRunTestsTool(available func(name string) bool) Tool {
+	if available == nil {
+		available = func(name string) bool {
+			_, err := exec.LookPath(name)
+			return err == nil
+		}
+	}
+
+	return NewOnDemandTool(
+		base.ToolNameRunTests,
+		fmt.Sprintf(runTestsDescription, "```", "`"),
+		runTestsInput,
+		runTestsHandler(available),
+	)
+}
+
+func runTestsInput(session *Session, args []sobek.Value) (any, error) {
+	if len(args) > 1 {
+		return nil, base.NewCustomError(base.InvalidInput.String(), runTestsSuggestions)
+	}
+
+	input := &testrunner.RunTestsInput{}
+	if err := decodeOptions(map[string]any{}, args, input); err != nil {
+		return nil, base.NewCustomError(base.InvalidInput.String(), runTestsSuggestions, "error", err.Error())
+	}
+	input.WorkingDirectory = session.Task.ProjectDirectory
+	return input, nil
+}
+
+func runTestsHandler(available func(name string) bool) CodeActToolHandler {
+	return func(session *Session) func(call sobek.FunctionCall) sobek.Value {
+		return func(call sobek.FunctionCall) sobek.Value {
+			rejectInTransaction(session, base.ToolNameRunTests, "Tests do not see the changes staged by the transaction. Run them in a separate script after this one completed")
+
+			rawInput, err := runTestsInput(session, call.Arguments)
+			if err != nil {
+				session.Throw(err)
+			}
+			input := rawInput.(*testrunner.RunTestsInput)
+
+			// The command is only known after the framework was detected, so
+			// it is checked here instead of in the policy interceptor.
+			allow := func(command string) error {
+				if reason := destructiveCommandReason(session.Task.Policy, command); reason != "" {
+					return destructiveCommandError(command, reason)
+				}
+				if policy := session.Task.Policy; policy != nil && !policy.CommandAllowed(command) {
+					return commandNotAllowedError(policy, command)
+				}
+				return nil
+			}
+
+			result, err := testrunner.RunTests(session.Context, input, available, allow)
+			if err != nil {
+				session.Throw(err)
+			}
+
+			SetValue(session, "result", result)
+			return session.VM.ToValue(result)
+		}
+	}
+}
+
+var runTestsSuggestions = []string{
+	"Ensure that you provide the correct input arguments as specified in the tool description",
+	"- **options** (object, optional): framework, path, tests, filter, command, report, include_passed and timeout, unknown options are rejected",
+	"For example: run_tests({ tests: ['./parser/...'], filter: 'TestParse' })",
+}
@@ -0,0 +1,126 @@
+package codeact
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/spf13/afero"
+)
+
+func TestRunTestsTool(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go is not installed")
+	}
+	t.Setenv("GOFLAGS", "")
+	t.Setenv("GOWORK", "off")
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod":  "module example.com/calc\n\ngo 1.21\n",
+		"calc.go": "package calc\n\nfunc Add(a, b int) int { return a - b }\n",
+		"calc_test.go": `package calc
+
+import "testing"
+
+func TestAdd(t *testing.T) {
+	if got := Add(1, 2); got != 3 {
+		t.Errorf("Add(1, 2) = %d, want 3", got)
+	}
+}
+`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		Name   string
+		Script string
+		Policy *Policy
+		Output []string
+		Error  string
+	}{
+		{
+			Name: "failures are reported with their location",
+			Script: `const result = run_tests();
+print(result.framework, result.success, result.passed, result.failed);
+const failure = result.tests[0];
+print(failure.name + " " + failure.file + ":" + failure.line + " " + failure.message);`,
+			Output: []string{"go false 0 1", "TestAdd calc_test.go:7 Add(1, 2) = -1, want 3"},
+		},
+		{
+			Name:   "unknown options are rejected",
+			Script: `run_tests({ pattern: "TestAdd" });`,
+			Error:  `unknown field "pattern"`,
+		},
+		{
+			Name:   "commands that are not allowed are refused",
+			Script: `run_tests();`,
+			Policy: &Policy{AllowedCommands: []string{"npm test"}},
+			Error:  "command is not allowed by the project configuration",
+		},
+		{
+			Name:   "allowed commands run",
+			Script: `print(run_tests({ filter: "TestAdd" }).failed);`,
+			Policy: &Policy{AllowedCommands: []string{"go test *"}},
+			Output: []string{"1"},
+		},
+		{
+			Name:   "destructive junit commands are refused",
+			Script: `run_tests({ command: "git clean -fd", report: "report.xml" });`,
+			Policy: &Policy{AllowedCommands: []string{"git *"}},
+			Error:  "command is not permitted because it deletes untracked files",
+		},
+		{
+			Name:   "read-only tasks cannot run tests",
+			Script: `run_tests();`,
+			Policy: &Policy{ReadOnly: true},
+			Error:  "the project configuration only permits read access",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			interpreter := NewInterpreter(
+				[]Tool{NewPrintTool(), NewRunTestsTool(nil)},
+				[]Interceptor{InterceptorFunc(PolicyInterceptor), InterceptorFunc(DurableFunctionInterceptor), InterceptorFunc(ResetTemporarySessionValuesInterceptor)},
+			)
+			input, err := json.Marshal(InterpreterInput{Script: test.Script})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			task := &Task{ID: uuid.New(), ProjectDirectory: dir, Policy: test.Policy}
+			output, err := interpreter.Interpret(context.Background(), afero.NewOsFs(), input, task)
+			if test.Error != "" {
+				if err == nil || !strings.Contains(err.Error(), test.Error) {
+					t.Fatalf("expected error containing %q, got %v", test.Error, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for _, expected := range test.Output {
+				if !strings.Contains(output.ConsoleOutput, expected) {
+					t.Errorf("expected output containing %q, got %q", expected, output.ConsoleOutput)
+				}
+			}
+
+			for _, call := range output.FunctionCalls {
+				if call.ToolName == "run_tests" && (call.Input.RunTests == nil || call.Output.RunTests == nil) {
+					t.Errorf("expected the call to be recorded, got %+v", call)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package codeact
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/grafana/sobek"
+)
+
+const (
+	DefaultStateIdleTimeout = 30 * time.Minute
+	DefaultStateMaxSize     = 8 << 20
+)
+
+// StateStore keeps the JavaScript runtimes of tasks with persistent state
+// between interpreter calls. A runtime is evicted when the task is idle for
+// longer than the idle timeout, when the task is suspended or when its state
+// grows beyond the size limit. Evicted state is not rebuilt, the next call
+// starts with an empty runtime and a notice telling the agent why.
+type StateStore struct {
+	IdleTimeout time.Duration
+	// MaxSize is the maximum size of the state in bytes, estimated from the
+	// values of the global variables the scripts defined.
+	MaxSize int
+
+	mu     sync.Mutex
+	states map[uuid.UUID]*taskState
+	resets map[uuid.UUID]string
+}
+
+type taskState struct {
+	mu       sync.Mutex
+	vm       *sobek.Runtime
+	builtins map[string]struct{}
+	lastUsed time.Time
+	inUse    bool
+}
+
+func NewStateStore(idleTimeout time.Duration, maxSize int) *StateStore {
+	return &StateStore{
+		IdleTimeout: idleTimeout,
+		MaxSize:     maxSize,
+		states:      make(map[uuid.UUID]*taskState),
+		resets:      make(map[uuid.UUID]string),
+	}
+}
+
+// acquire returns the runtime of the task and locks it until release is
+// called. If the previous state of the task was evicted, the returned notice
+// explains why.
+func (s *StateStore) acquire(taskID uuid.UUID) (*taskState, string) {
+	s.mu.Lock()
+	s.evictIdle(time.Now())
+
+	state, ok := s.states[taskID]
+	if !ok {
+		vm := newRuntime()
+		state = &taskState{
+			vm:       vm,
+			builtins: globalKeys(vm),
+		}
+		s.states[taskID] = state
+	}
+	state.inUse = true
+
+	var notice string
+	if reason, ok := s.resets[taskID]; ok {
+		notice = fmt.Sprintf("Note: the JavaScript state of this task was reset because %s. Variables defined by earlier scripts no longer exist.", reason)
+		delete(s.resets, taskID)
+	}
+	s.mu.Unlock()
+
+	state.mu.Lock()
+	return state, notice
+}
+
+func (s *StateStore) release(state *taskState) {
+	s.mu.Lock()
+	state.inUse = false
+	state.lastUsed = time.Now()
+	s.mu.Unlock()
+
+	state.mu.Unlock()
+}
+
+// Evict discards the state of the task. A non-empty reason is reported to the
+// agent on the next call.
+func (s *StateStore) Evict(taskID uuid.UUID, reason string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.evict(taskID, reason)
+}
+
+func (s *StateStore) evict(taskID uuid.UUID, reason string) {
+	if _, ok := s.states[taskID]; !ok {
+		return
+	}
+
+	delete(s.states, taskID)
+	if reason != "" {
+		s.resets[taskID] = reason
+	}
+}
+
+func (s *StateStore) evictIdle(now time.Time) {
+	if s.IdleTimeout == 0 {
+		return
+	}
+
+	for taskID, state := range s.states {
+		if !state.inUse && now.Sub(state.lastUsed) > s.IdleTimeout {
+			s.evict(taskID, fmt.Sprintf("it was not used for %s", s.IdleTimeout))
+		}
+	}
+}
+
+// size estimates the memory retained by the global variables that were
+// defined by scripts. It walks their values, including the entries of maps
+// and sets, and counts objects that are referenced more than once a single
+// time. The walk stops as soon as the size exceeds limit. Cyclic values
+// cannot be measured and are reported as exceeding the limit.
+func (s *taskState) size(tools []Tool, limit int) (size int, cyclic bool) {
+	reserved := make(map[string]struct{}, len(tools))
+	for _, tool := range tools {
+		reserved[strings.Split(tool.Name(), ".")[0]] = struct{}{}
+	}
+
+	m := &stateMeter{
+		vm:    s.vm,
+		limit: limit,
+		path:  make(map[*sobek.Object]struct{}),
+		seen:  make(map[*sobek.Object]struct{}),
+	}
+	global := s.vm.GlobalObject()
+	// getters, proxies and iterators run script code that may throw
+	s.vm.Try(func() {
+		for _, key := range global.Keys() {
+			if _, ok := s.builtins[key]; ok {
+				continue
+			}
+			if _, ok := reserved[key]; ok {
+				continue
+			}
+			m.walk(global.Get(key))
+			if m.cyclic || m.size > limit {
+				return
+			}
+		}
+	})
+	return m.size, m.cyclic
+}
+
+var (
+	mapExportType         = reflect.TypeOf([][2]any{})
+	listExportType        = reflect.TypeOf([]any{})
+	arrayBufferExportType = reflect.TypeOf(sobek.ArrayBuffer{})
+)
+
+type stateMeter struct {
+	vm     *sobek.Runtime
+	limit  int
+	size   int
+	cyclic bool
+	// path holds the objects that are being walked, seen the objects that
+	// were walked completely.
+	path map[*sobek.Object]struct{}
+	seen map[*sobek.Object]struct{}
+}
+
+func (m *stateMeter) walk(value sobek.Value) {
+	if m.cyclic || m.size > m.limit {
+		return
+	}
+
+	obj, ok := value.(*sobek.Object)
+	switch {
+	case value == nil || sobek.IsUndefined(value):
+		return
+	case sobek.IsNull(value):
+		m.size += 4
+		return
+	case sobek.IsString(value):
+		m.size += len(value.String()) + 2
+		return
+	case !ok:
+		m.size += len(value.String())
+		return
+	}
+
+	if _, ok := m.path[obj]; ok {
+		m.cyclic = true
+		return
+	}
+	if _, ok := m.seen[obj]; ok {
+		return
+	}
+	if _, ok := sobek.AssertFunction(obj); ok {
+		return
+	}
+
+	m.path[obj] = struct{}{}
+	defer func() {
+		delete(m.path, obj)
+		m.seen[obj] = struct{}{}
+	}()
+
+	// maps and sets report their class as Object and are told apart by the
+	// type they export to
+	switch exportType := obj.ExportType(); {
+	case exportType == mapExportType, exportType == listExportType && obj.ClassName() != "Array":
+		m.walkEntries(obj)
+	case exportType == arrayBufferExportType:
+		m.size += len(obj.Export().(sobek.ArrayBuffer).Bytes())
+	default:
+		for _, key := range obj.Keys() {
+			m.size += len(key) + 3
+			m.walk(obj.Get(key))
+			if m.cyclic || m.size > m.limit {
+				return
+			}
+		}
+	}
+}
+
+// walkEntries walks the entries of a map or set with its iterator.
+func (m *stateMeter) walkEntries(obj *sobek.Object) {
+	iterate, ok := sobek.AssertFunction(obj.GetSymbol(sobek.SymIterator))
+	if !ok {
+		return
+	}
+	iterator, err := iterate(obj)
+	if err != nil {
+		return
+	}
+	next, ok := sobek.AssertFunction(iterator.ToObject(m.vm).Get("next"))
+	if !ok {
+		return
+	}
+
+	for !m.cyclic && m.size <= m.limit {
+		result, err := next(iterator)
+		if err != nil {
+			return
+		}
+		entry := result.ToObject(m.vm)
+		if entry.Get("done").ToBoolean() {
+			return
+		}
+		m.size++
+		m.walk(entry.Get("value"))
+	}
+}
+
+func newRuntime() *sobek.Runtime {
+	vm := sobek.New()
+	vm.SetFieldNameMapper(sobek.TagFieldNameMapper("json", true))
+	return vm
+}
+
+func globalKeys(vm *sobek.Runtime) map[string]struct{} {
+	keys := make(map[string]struct{})
+	for _, key := range vm.GlobalObject().Keys() {
+		keys[key] = struct{}{}
+	}
+	return keys
+}
@@ -0,0 +1,161 @@
+package codeact
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/spf13/afero"
+)
+
+func TestPersistentState(t *testing.T) {
+	type call struct {
+		Script string
+		Output string
+		Error  string
+	}
+
+	tests := []struct {
+		Name       string
+		Persistent bool
+		MaxSize    int
+		Evict      string
+		Calls      []call
+	}{
+		{
+			Name:       "globals persist between calls",
+			Persistent: true,
+			Calls: []call{
+				{Script: `var files = ["a.go", "b.go"]; globalThis.count = 2;`},
+				{Script: `print(files.length, count);`, Output: "2 2\n"},
+			},
+		},
+		{
+			Name:       "let and const are scoped to the script",
+			Persistent: true,
+			Calls: []call{
+				{Script: `const result = 1; let other = 2;`},
+				{Script: `const result = 3; print(result, typeof other);`, Output: "3 undefined\n"},
+			},
+		},
+		{
+			Name: "state is not kept without opt-in",
+			Calls: []call{
+				{Script: `var files = ["a.go"];`},
+				{Script: `print(typeof files);`, Output: "undefined\n"},
+			},
+		},
+		{
+			Name:       "reset_state discards the state after the script",
+			Persistent: true,
+			Calls: []call{
+				{Script: `var files = ["a.go"]; reset_state(); print(files.length);`, Output: "1\n"},
+				{Script: `print(typeof files);`, Output: "undefined\n"},
+			},
+		},
+		{
+			Name:       "eviction is reported on the next call",
+			Persistent: true,
+			Evict:      "the task was suspended",
+			Calls: []call{
+				{Script: `var files = ["a.go"];`},
+				{Script: `print(typeof files);`, Output: "Note: the JavaScript state of this task was reset because the task was suspended. Variables defined by earlier scripts no longer exist.\nundefined\n"},
+			},
+		},
+		{
+			Name:       "state beyond the size limit is reset",
+			Persistent: true,
+			MaxSize:    16,
+			Calls: []call{
+				{Script: `var content = "a".repeat(100);`, Output: "Note: the JavaScript state of this task was reset because it grew beyond the limit of 16 bytes. Keep only the data you need in global variables.\n"},
+				{Script: `print(typeof content);`, Output: "undefined\n"},
+			},
+		},
+		{
+			Name:       "entries of maps count towards the size limit",
+			Persistent: true,
+			MaxSize:    1000,
+			Calls: []call{
+				{Script: `var index = new Map(); for (let i = 0; i < 100; i++) index.set("file" + i, "x".repeat(20));`, Output: "Note: the JavaScript state of this task was reset because it grew beyond the limit of 1000 bytes. Keep only the data you need in global variables.\n"},
+				{Script: `print(typeof index);`, Output: "undefined\n"},
+			},
+		},
+		{
+			Name:       "cyclic state is reset",
+			Persistent: true,
+			MaxSize:    1000,
+			Calls: []call{
+				{Script: `var node = { name: "a" }; node.self = node;`, Output: "Note: the JavaScript state of this task was reset because it holds cyclic references, which count as exceeding the limit of 1000 bytes. Keep only the data you need in global variables.\n"},
+				{Script: `print(typeof node);`, Output: "undefined\n"},
+			},
+		},
+		{
+			Name:       "shared values within the size limit are kept",
+			Persistent: true,
+			MaxSize:    1000,
+			Calls: []call{
+				{Script: `var file = { path: "a.go" }; var files = new Set([file]); var byName = { a: file, b: file };`},
+				{Script: `print(files.size, byName.b.path);`, Output: "1 a.go\n"},
+			},
+		},
+		{
+			Name:       "state survives failing scripts",
+			Persistent: true,
+			Calls: []call{
+				{Script: `var files = ["a.go"]; throw new Error("boom");`, Error: "boom"},
+				{Script: `print(files[0]);`, Output: "a.go\n"},
+			},
+		},
+		{
+			Name:       "runtime errors report the line of the script",
+			Persistent: true,
+			Calls: []call{
+				{Script: "var files = [];\nfiles[0].path;", Error: "at <eval>:2:"},
+				{Script: "function first() {\n  return files[0].path;\n}\nfirst();", Error: "first (<eval>:2:"},
+			},
+		},
+		{
+			Name: "runtime errors report the line of the script without state",
+			Calls: []call{
+				{Script: "var files = [];\nfiles[0].path;", Error: "at <eval>:2:"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			interpreter := NewInterpreter([]Tool{NewPrintTool(), NewResetStateTool()}, nil)
+			if test.MaxSize != 0 {
+				interpreter.States.MaxSize = test.MaxSize
+			}
+
+			task := &Task{ID: uuid.New(), ProjectDirectory: "/project", PersistentState: test.Persistent}
+			for i, call := range test.Calls {
+				if i > 0 && test.Evict != "" {
+					interpreter.States.Evict(task.ID, test.Evict)
+				}
+
+				input, err := json.Marshal(InterpreterInput{Script: call.Script})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				output, err := interpreter.Interpret(context.Background(), afero.NewMemMapFs(), input, task)
+				if call.Error != "" {
+					if err == nil || !strings.Contains(err.Error(), call.Error) {
+						t.Fatalf("call %d: expected error containing %q, got %v", i, call.Error, err)
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatalf("call %d: unexpected error: %v", i, err)
+				}
+				if output.ConsoleOutput != call.Output {
+					t.Errorf("call %d: expected output %q, got %q", i, call.Output, output.ConsoleOutput)
+				}
+			}
+		})
+	}
+}
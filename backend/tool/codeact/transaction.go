@@ -0,0 +1,118 @@
+package codeact
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/grafana/sobek"
+
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/furisto/construct/backend/tool/filesystem"
+)
+
+const beginTransactionDescription = `
+## Description
+Stages all file changes of the rest of the script instead of writing them immediately. The changes made with create_file, edit_file, copy_file and apply_patch are kept in memory and written to disk together when the script completes without an uncaught error. If the script fails, none of the changes are written. Use it for changes that span several files and only make sense as a whole, e.g. renaming a function and updating all of its callers.
+
+## Parameters
+None.
+
+## Expected Output
+Returns undefined. When the script completes, the diff of the written files is added to the output. If the script fails, the output lists the discarded files instead.
+
+## IMPORTANT USAGE NOTES
+- **Reads see staged changes**: read_file, list_files and find_file return the staged content. grep searches the files on disk and does not see staged changes
+- **No commands**: execute_command, run_tests and git operations that change the repository, such as git.commit, cannot be called during a transaction because they would not see the staged changes. Run them in a separate script after the transaction was written
+- **No deletions or moves**: delete_file and move_file cannot be staged and cannot be called during a transaction, and neither can apply_patch with a patch that deletes files. copy_file is staged like create_file
+- **Catching errors**: An error you catch with try/catch does not discard the transaction. Rethrow it if the changes should not be written
+- **One script**: A transaction always ends with the script. Calling begin_transaction again in the same script has no effect
+
+## Usage Examples
+
+### Renaming a function across files
+%[1]s
+begin_transaction();
+edit_file("/workspace/project/src/math.js", [{ old: "function sum(", new: "function add(" }]);
+for (const file of ["/workspace/project/src/app.js", "/workspace/project/src/report.js"]) {
+  edit_file(file, [{ old: "sum(", new: "add(" }]);
+}
+%[1]s
+`
+
+func NewBeginTransactionTool() Tool {
+	return NewOnDemandTool(
+		base.ToolNameBeginTransaction,
+		fmt.Sprintf(beginTransactionDescription, "```"),
+		beginTransactionInput,
+		beginTransactionHandler,
+	)
+}
+
+func beginTransactionInput(session *Session, args []sobek.Value) (any, error) {
+	return nil, nil
+}
+
+func beginTransactionHandler(session *Session) func(call sobek.FunctionCall) sobek.Value {
+	return func(call sobek.FunctionCall) sobek.Value {
+		if _, ok := GetValue[*filesystem.Overlay](session, "transaction"); ok {
+			return sobek.Undefined()
+		}
+
+		overlay := filesystem.NewOverlay(session.FS)
+		session.FS = overlay.Fs()
+		SetValue(session, "transaction", overlay)
+		SetValue(session, "transaction_reads", session.Reads.Snapshot())
+		return sobek.Undefined()
+	}
+}
+
+// finishTransaction writes or discards the changes staged by the script,
+// depending on whether the script failed, and reports the outcome to the
+// agent.
+func finishTransaction(session *Session, scriptErr error, stdout io.Writer) error {
+	overlay, ok := GetValue[*filesystem.Overlay](session, "transaction")
+	if !ok {
+		return scriptErr
+	}
+	session.FS = overlay.Base()
+
+	if scriptErr != nil {
+		// the fingerprints of the staged files describe content that is
+		// never written, the ones recorded before the transaction still
+		// describe the files on disk
+		files, _ := overlay.Files()
+		reads, _ := GetValue[map[string]filesystem.Fingerprint](session, "transaction_reads")
+		session.Reads.Restore(reads, files)
+		if len(files) > 0 {
+			fmt.Fprintf(stdout, "\nTransaction discarded because the script failed, no changes were written to: %s\n", strings.Join(files, ", "))
+		}
+		return scriptErr
+	}
+
+	changes, err := overlay.Commit()
+	if err != nil {
+		return NewCustomError(fmt.Sprintf("the transaction could not be written: %s", err), []string{
+			"Check the current content of the files before you retry the changes",
+		})
+	}
+
+	if len(changes) == 0 {
+		fmt.Fprintln(stdout, "\nTransaction completed without changes.")
+		return nil
+	}
+	fmt.Fprintf(stdout, "\nTransaction written:\n%s", filesystem.Patch(changes))
+	return nil
+}
+
+// rejectInTransaction throws if the script started a transaction, for tools
+// whose effects cannot be staged.
+func rejectInTransaction(session *Session, toolName string, suggestion string) {
+	if _, ok := GetValue[*filesystem.Overlay](session, "transaction"); !ok {
+		return
+	}
+
+	session.Throw(NewCustomError(fmt.Sprintf("%s cannot be called during a transaction", toolName), []string{
+		suggestion,
+	}, "tool", toolName))
+}
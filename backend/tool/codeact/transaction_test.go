@@ -0,0 +1,230 @@
+package codeact
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/spf13/afero"
+)
+
+func TestTransaction(t *testing.T) {
+	tests := []struct {
+		Name   string
+		Script string
+		Files  map[string]string
+		Output []string
+		Error  string
+	}{
+		{
+			Name: "changes are written when the script completes",
+			Script: `begin_transaction();
+edit_file("/project/a.txt", [{ old: "alpha", new: "beta" }]);
+create_file("/project/sub/b.txt", "new file");
+print(read_file("/project/a.txt").content);`,
+			Files: map[string]string{
+				"/project/a.txt":     "beta\n",
+				"/project/sub/b.txt": "new file",
+				"/project/c.txt":     "unchanged\n",
+			},
+			Output: []string{"Transaction written:", "-alpha", "+beta", "+new file"},
+		},
+		{
+			Name: "changes are discarded when the script fails",
+			Script: `begin_transaction();
+edit_file("/project/a.txt", [{ old: "alpha", new: "beta" }]);
+create_file("/project/sub/b.txt", "new file");
+edit_file("/project/c.txt", [{ old: "missing", new: "changed" }]);`,
+			Files: map[string]string{
+				"/project/a.txt": "alpha\n",
+				"/project/c.txt": "unchanged\n",
+			},
+			Output: []string{"Transaction discarded because the script failed, no changes were written to: /project/a.txt, /project/sub/b.txt"},
+			Error:  "old text not found in file",
+		},
+		{
+			Name: "caught errors do not discard the transaction",
+			Script: `begin_transaction();
+edit_file("/project/a.txt", [{ old: "alpha", new: "beta" }]);
+try {
+	edit_file("/project/c.txt", [{ old: "missing", new: "changed" }]);
+} catch (e) {
+	print("ignored");
+}`,
+			Files: map[string]string{
+				"/project/a.txt": "beta\n",
+				"/project/c.txt": "unchanged\n",
+			},
+			Output: []string{"ignored", "Transaction written:"},
+		},
+		{
+			Name: "commands are rejected",
+			Script: `begin_transaction();
+create_file("/project/sub/b.txt", "new file");
+execute_command("ls");`,
+			Files: map[string]string{
+				"/project/a.txt": "alpha\n",
+			},
+			Error: "execute_command cannot be called during a transaction",
+		},
+		{
+			Name: "commits are rejected",
+			Script: `begin_transaction();
+create_file("/project/sub/b.txt", "new file");
+git.commit("Add b", { paths: ["sub/b.txt"] });`,
+			Files: map[string]string{
+				"/project/a.txt": "alpha\n",
+			},
+			Error: "git.commit cannot be called during a transaction",
+		},
+		{
+			Name: "copies are staged",
+			Script: `begin_transaction();
+copy_file("/project/a.txt", "/project/sub/b.txt");`,
+			Files: map[string]string{
+				"/project/a.txt":     "alpha\n",
+				"/project/sub/b.txt": "alpha\n",
+			},
+			Output: []string{"Transaction written:", "+alpha"},
+		},
+		{
+			Name: "deletions are rejected",
+			Script: `begin_transaction();
+delete_file("/project/a.txt");`,
+			Files: map[string]string{
+				"/project/a.txt": "alpha\n",
+			},
+			Error: "delete_file cannot be called during a transaction",
+		},
+		{
+			Name: "moves are rejected",
+			Script: `begin_transaction();
+move_file("/project/a.txt", "/project/sub/b.txt");`,
+			Files: map[string]string{
+				"/project/a.txt": "alpha\n",
+			},
+			Error: "move_file cannot be called during a transaction",
+		},
+		{
+			Name: "patches are staged",
+			Script: "begin_transaction();\n" +
+				"apply_patch(`--- a/a.txt\n+++ b/a.txt\n@@ -1 +1 @@\n-alpha\n+beta\n--- /dev/null\n+++ b/sub/b.txt\n@@ -0,0 +1 @@\n+new file\n`);",
+			Files: map[string]string{
+				"/project/a.txt":     "beta\n",
+				"/project/sub/b.txt": "new file\n",
+			},
+			Output: []string{"Transaction written:", "-alpha", "+beta", "+new file"},
+		},
+		{
+			Name: "patches deleting files are rejected",
+			Script: "begin_transaction();\n" +
+				"apply_patch(`--- a/a.txt\n+++ /dev/null\n@@ -1 +0,0 @@\n-alpha\n`);",
+			Files: map[string]string{
+				"/project/a.txt": "alpha\n",
+			},
+			Error: "apply_patch cannot be called during a transaction",
+		},
+		{
+			Name: "without a transaction changes are written immediately",
+			Script: `edit_file("/project/a.txt", [{ old: "alpha", new: "beta" }]);
+edit_file("/project/c.txt", [{ old: "missing", new: "changed" }]);`,
+			Files: map[string]string{
+				"/project/a.txt": "beta\n",
+				"/project/c.txt": "unchanged\n",
+			},
+			Error: "old text not found in file",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			for path, content := range map[string]string{"/project/a.txt": "alpha\n", "/project/c.txt": "unchanged\n"} {
+				if err := afero.WriteFile(fs, path, []byte(content), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			interpreter := NewInterpreter(
+				append([]Tool{NewBeginTransactionTool(), NewCreateFileTool(), NewEditFileTool(), NewReadFileTool(), NewExecuteCommandTool(), NewPrintTool(),
+					NewDeleteFileTool(), NewMoveFileTool(), NewCopyFileTool(), NewApplyPatchTool()}, NewGitTools()...),
+				nil,
+			)
+			input, err := json.Marshal(InterpreterInput{Script: test.Script})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			output, err := interpreter.Interpret(context.Background(), fs, input, &Task{ID: uuid.New(), ProjectDirectory: "/project"})
+			if test.Error != "" {
+				if err == nil || !strings.Contains(err.Error(), test.Error) {
+					t.Fatalf("expected error containing %q, got %v", test.Error, err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for _, expected := range test.Output {
+				if !strings.Contains(output.ConsoleOutput, expected) {
+					t.Errorf("expected output containing %q, got %q", expected, output.ConsoleOutput)
+				}
+			}
+
+			for path, expected := range test.Files {
+				content, err := afero.ReadFile(fs, path)
+				if err != nil {
+					t.Fatalf("failed to read %s: %v", path, err)
+				}
+				if string(content) != expected {
+					t.Errorf("expected %s to contain %q, got %q", path, expected, content)
+				}
+			}
+			if _, ok := test.Files["/project/sub/b.txt"]; !ok {
+				if exists, _ := afero.Exists(fs, "/project/sub/b.txt"); exists {
+					t.Error("expected /project/sub/b.txt not to be written")
+				}
+			}
+		})
+	}
+}
+
+func TestDiscardedTransactionKeepsReads(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	if err := afero.WriteFile(fs, "/project/a.txt", []byte("alpha\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	interpreter := NewInterpreter(
+		[]Tool{NewBeginTransactionTool(), NewEditFileTool(), NewReadFileTool()},
+		nil,
+	)
+	task := &Task{ID: uuid.New(), ProjectDirectory: "/project"}
+	interpret := func(script string) error {
+		input, err := json.Marshal(InterpreterInput{Script: script})
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = interpreter.Interpret(context.Background(), fs, input, task)
+		return err
+	}
+
+	err := interpret(`read_file("/project/a.txt");
+begin_transaction();
+edit_file("/project/a.txt", [{ old: "alpha", new: "beta" }]);
+throw new Error("abort");`)
+	if err == nil || !strings.Contains(err.Error(), "abort") {
+		t.Fatalf("expected the script to fail, got %v", err)
+	}
+
+	// the read before the transaction still protects the file from changes
+	// the agent has not seen
+	if err := afero.WriteFile(fs, "/project/a.txt", []byte("alpha\ngamma\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	err = interpret(`edit_file("/project/a.txt", [{ old: "gamma", new: "delta" }]);`)
+	if err == nil || !strings.Contains(err.Error(), "File was modified since it was last read") {
+		t.Fatalf("expected the edit to be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,431 @@
+package codeact
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/grafana/sobek"
+	"github.com/grafana/sobek/ast"
+	"github.com/grafana/sobek/file"
+	"github.com/grafana/sobek/parser"
+	"github.com/grafana/sobek/token"
+
+	"github.com/furisto/construct/backend/tool/base"
+)
+
+// Diagnostic is a problem found in a script before it was executed. Lines and
+// columns start at 1 and refer to the script as written by the agent.
+type Diagnostic struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("line %d, column %d: %s", d.Line, d.Column, d.Message)
+}
+
+// destructiveCommands are commands that are rejected regardless of the policy
+// because their damage cannot be undone.
+var destructiveCommands = []struct {
+	pattern *regexp.Regexp
+	reason  string
+}{
+	{regexp.MustCompile(`\brm\s+(-\S+\s+)*-[a-zA-Z]*[rR][a-zA-Z]*\s+(-\S+\s+)*("|')?(/|/\*|~|~/|~/\*|\$HOME|\$HOME/\*)("|')?(\s|;|&|\||$)`), "recursively deletes the root or the home directory"},
+	{regexp.MustCompile(`\bmkfs(\.\w+)?\s`), "formats a file system"},
+	{regexp.MustCompile(`\bdd\s.*\bof=/dev/`), "overwrites a device"},
+	{regexp.MustCompile(`:\(\)\s*\{\s*:\s*\|\s*:\s*&\s*\}\s*;\s*:`), "is a fork bomb"},
+}
+
+// gitSubcommand matches git followed by its global options, such as -C <path>
+// or -c <name>=<value>, and the subcommand.
+func gitSubcommand(subcommand string) string {
+	return `\bgit(\s+(-[Cc]\s+\S+|-[a-zA-Z]|--\S+))*\s+` + subcommand
+}
+
+// destructiveGitCommands are git commands that discard work. They are
+// rejected unless the policy permits destructive git operations.
+var destructiveGitCommands = []struct {
+	pattern *regexp.Regexp
+	reason  string
+}{
+	{regexp.MustCompile(gitSubcommand(`push\s(.*\s)?(--force|-[a-zA-Z]*f[a-zA-Z]*|\+\S+)(\s|$)`)), "overwrites the history of the remote repository, use --force-with-lease instead"},
+	{regexp.MustCompile(gitSubcommand(`reset\s(.*\s)?--hard(\s|$)`)), "discards uncommitted changes"},
+	{regexp.MustCompile(gitSubcommand(`checkout\s(.*\s)?(--|--force|-[a-zA-Z]*f[a-zA-Z]*)(\s|$)`)), "discards uncommitted changes"},
+	{regexp.MustCompile(gitSubcommand(`clean\s(.*\s)?(--force|-[a-zA-Z]*f)`)), "deletes untracked files"},
+	{regexp.MustCompile(gitSubcommand(`branch\s(.*\s)?(-[a-zA-Z]*D|-[a-zA-Z]*d[a-zA-Z]*f|-[a-zA-Z]*f[a-zA-Z]*d)[a-zA-Z]*(\s|$)`)), "deletes a branch that may not be merged"},
+	{regexp.MustCompile(gitSubcommand(`branch\s(.*\s)?(--delete|-d)\s(.*\s)?(--force|-f)(\s|$)`)), "deletes a branch that may not be merged"},
+	{regexp.MustCompile(gitSubcommand(`branch\s(.*\s)?(--force|-f)\s(.*\s)?(--delete|-d)(\s|$)`)), "deletes a branch that may not be merged"},
+	{regexp.MustCompile(gitSubcommand(`stash\s+(drop|clear)(\s|$)`)), "discards stashed changes"},
+}
+
+// destructiveCommandReason returns why the command may not run, or an empty
+// string if it may. Destructive git commands are permitted if the policy
+// allows them.
+func destructiveCommandReason(policy *Policy, command string) string {
+	for _, destructive := range destructiveCommands {
+		if destructive.pattern.MatchString(command) {
+			return destructive.reason
+		}
+	}
+	if !policy.DestructiveGitAllowed() {
+		for _, destructive := range destructiveGitCommands {
+			if destructive.pattern.MatchString(command) {
+				return destructive.reason
+			}
+		}
+	}
+	return ""
+}
+
+// validateScript parses the script and checks it against the tools defined in
+// the runtime and the policy of the task without executing anything. Scripts
+// that fail here would otherwise stop halfway, possibly after some of their
+// changes were already applied.
+func (c *Interpreter) validateScript(vm *sobek.Runtime, script string, policy *Policy) []Diagnostic {
+	program, err := parser.ParseFile(nil, "", script, 0)
+	if err != nil {
+		return syntaxDiagnostics(err)
+	}
+
+	v := &validator{
+		vm:       vm,
+		file:     program.File,
+		policy:   policy,
+		tools:    make(map[string]Tool, len(c.Tools)),
+		declared: make(map[string]struct{}),
+	}
+	for _, tool := range c.Tools {
+		v.tools[tool.Name()] = tool
+	}
+
+	for _, statement := range program.Body {
+		inspect(statement, v.declare)
+	}
+	for _, statement := range program.Body {
+		inspect(statement, v.check)
+	}
+
+	return v.diagnostics
+}
+
+func syntaxDiagnostics(err error) []Diagnostic {
+	var list parser.ErrorList
+	if errors.As(err, &list) {
+		diagnostics := make([]Diagnostic, 0, len(list))
+		for _, e := range list {
+			diagnostics = append(diagnostics, Diagnostic{
+				Line:    e.Position.Line,
+				Column:  e.Position.Column,
+				Message: "syntax error: " + e.Message,
+			})
+		}
+		return diagnostics
+	}
+
+	return []Diagnostic{{Line: 1, Column: 1, Message: "syntax error: " + err.Error()}}
+}
+
+func validationError(diagnostics []Diagnostic) error {
+	var message strings.Builder
+	message.WriteString("the script was not executed because it contains errors:")
+	for _, diagnostic := range diagnostics {
+		message.WriteString("\n- ")
+		message.WriteString(diagnostic.String())
+	}
+
+	return NewCustomError(message.String(), []string{
+		"Fix all reported errors and run the script again. Nothing was executed, so there are no partial changes to undo",
+		"Only call the functions that are documented in your instructions",
+	})
+}
+
+type validator struct {
+	vm          *sobek.Runtime
+	file        *file.File
+	policy      *Policy
+	tools       map[string]Tool
+	declared    map[string]struct{}
+	diagnostics []Diagnostic
+}
+
+// declare collects the names the script declares. Scopes are not taken into
+// account, a name declared anywhere counts as declared everywhere. This can
+// miss errors but never rejects a valid script.
+func (v *validator) declare(node ast.Node) bool {
+	switch n := node.(type) {
+	case *ast.Binding:
+		v.declareTarget(n.Target)
+	case *ast.ForDeclaration:
+		v.declareTarget(n.Target)
+	case *ast.CatchStatement:
+		v.declareTarget(n.Parameter)
+	case *ast.ParameterList:
+		v.declareTarget(n.Rest)
+	case *ast.FunctionLiteral:
+		v.declareTarget(n.Name)
+	case *ast.ClassLiteral:
+		v.declareTarget(n.Name)
+	case *ast.LabelledStatement:
+		v.declareTarget(n.Label)
+	case *ast.AssignExpression:
+		// assigning to a property of globalThis defines a global variable
+		if name, ok := globalProperty(n.Left); ok {
+			v.declared[name] = struct{}{}
+		}
+	}
+	return true
+}
+
+// globalProperty returns the name of the property if the expression is
+// globalThis.name or globalThis["name"].
+func globalProperty(expression ast.Expression) (string, bool) {
+	switch e := expression.(type) {
+	case *ast.DotExpression:
+		if left, ok := e.Left.(*ast.Identifier); ok && left.Name == "globalThis" {
+			return e.Identifier.Name.String(), true
+		}
+	case *ast.BracketExpression:
+		left, ok := e.Left.(*ast.Identifier)
+		member, isString := e.Member.(*ast.StringLiteral)
+		if ok && isString && left.Name == "globalThis" {
+			return member.Value.String(), true
+		}
+	}
+	return "", false
+}
+
+func (v *validator) declareTarget(target ast.Node) {
+	if target == nil || reflect.ValueOf(target).IsNil() {
+		return
+	}
+
+	inspect(target, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.Identifier:
+			v.declared[n.Name.String()] = struct{}{}
+		case *ast.PropertyShort:
+			v.declared[n.Name.Name.String()] = struct{}{}
+		}
+		return true
+	})
+}
+
+func (v *validator) check(node ast.Node) bool {
+	switch n := node.(type) {
+	case *ast.UnaryExpression:
+		// typeof is the way to test whether a global exists
+		if _, ok := n.Operand.(*ast.Identifier); ok && n.Operator == token.TYPEOF {
+			return false
+		}
+	case *ast.MetaProperty:
+		return false
+	case *ast.Identifier:
+		v.checkReference(n)
+	case *ast.CallExpression:
+		v.checkCall(n)
+	}
+	return true
+}
+
+func (v *validator) checkReference(identifier *ast.Identifier) {
+	name := identifier.Name.String()
+	if v.isDeclared(name) || name == "arguments" {
+		return
+	}
+
+	message := fmt.Sprintf("%s is not defined", name)
+	if suggestion := v.closestTool(name); suggestion != "" {
+		message += fmt.Sprintf(", did you mean %s?", suggestion)
+	}
+	v.report(identifier.Idx0(), message)
+}
+
+func (v *validator) isDeclared(name string) bool {
+	if _, ok := v.declared[name]; ok {
+		return true
+	}
+	return v.vm.Get(name) != nil
+}
+
+// checkCall reports calls of tools that do not exist within a namespace such
+// as mcp.github and calls that the policy would reject.
+func (v *validator) checkCall(call *ast.CallExpression) {
+	name, ok := calleeName(call.Callee)
+	if !ok {
+		return
+	}
+
+	root := strings.Split(name, ".")[0]
+	if _, ok := v.declared[root]; ok {
+		return
+	}
+
+	tool, ok := v.tools[name]
+	if !ok {
+		if strings.Contains(name, ".") && v.isNamespace(root) {
+			message := fmt.Sprintf("%s is not defined", name)
+			if suggestion := v.closestTool(name); suggestion != "" {
+				message += fmt.Sprintf(", did you mean %s?", suggestion)
+			}
+			v.report(call.Idx0(), message)
+		}
+		return
+	}
+
+	if v.policy != nil {
+		if !v.policy.ToolAllowed(name) {
+			v.report(call.Idx0(), fmt.Sprintf("%s: the tool is not enabled for this agent", name))
+			return
+		}
+		if v.policy.readOnly() && modifies(tool) {
+			v.report(call.Idx0(), fmt.Sprintf("%s: %s", name, readOnlyMessage(v.policy)))
+			return
+		}
+		if v.policy.Preview && !stageable(tool) {
+			v.report(call.Idx0(), fmt.Sprintf("%s: %s", name, previewMessage))
+			return
+		}
+	}
+
+	if name == base.ToolNameExecuteCommand && len(call.ArgumentList) > 0 {
+		command, ok := stringLiteral(call.ArgumentList[0])
+		if !ok {
+			return
+		}
+		if reason := destructiveCommandReason(v.policy, command); reason != "" {
+			v.report(call.Idx0(), fmt.Sprintf("%q: command is not permitted because it %s", command, reason))
+			return
+		}
+		if v.policy != nil && !v.policy.CommandAllowed(command) {
+			v.report(call.Idx0(), fmt.Sprintf("%q: command is not allowed by the project configuration", command))
+			return
+		}
+		if v.policy != nil && v.policy.Preview && !previewCommandAllowed(command) {
+			v.report(call.Idx0(), fmt.Sprintf("%q: command is not allowed in preview mode", command))
+		}
+	}
+}
+
+func (v *validator) isNamespace(root string) bool {
+	for name := range v.tools {
+		if strings.HasPrefix(name, root+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// closestTool returns the name of the tool that is most similar to name if it
+// is close enough to be a likely typo.
+func (v *validator) closestTool(name string) string {
+	var (
+		closest string
+		best    = len(name)/3 + 1
+	)
+	for candidate := range v.tools {
+		distance := editDistance(name, candidate)
+		if distance < best || (distance == best && closest != "" && candidate < closest) {
+			closest, best = candidate, distance
+		}
+	}
+	return closest
+}
+
+func (v *validator) report(idx file.Idx, message string) {
+	position := v.file.Position(int(idx) - v.file.Base())
+	v.diagnostics = append(v.diagnostics, Diagnostic{
+		Line:    position.Line,
+		Column:  position.Column,
+		Message: message,
+	})
+}
+
+// calleeName returns the dotted name of a callee such as read_file or
+// mcp.github.create_issue.
+func calleeName(callee ast.Expression) (string, bool) {
+	switch c := callee.(type) {
+	case *ast.Identifier:
+		return c.Name.String(), true
+	case *ast.DotExpression:
+		left, ok := calleeName(c.Left)
+		if !ok {
+			return "", false
+		}
+		return left + "." + c.Identifier.Name.String(), true
+	}
+	return "", false
+}
+
+func stringLiteral(expression ast.Expression) (string, bool) {
+	switch e := expression.(type) {
+	case *ast.StringLiteral:
+		return e.Value.String(), true
+	case *ast.TemplateLiteral:
+		if e.Tag != nil || len(e.Expressions) > 0 || len(e.Elements) != 1 {
+			return "", false
+		}
+		return e.Elements[0].Parsed.String(), true
+	}
+	return "", false
+}
+
+// inspect traverses the syntax tree in depth-first order and calls visit for
+// every node. The children of a node are skipped if visit returns false.
+// Declaration lists are skipped because they repeat nodes of the body.
+func inspect(node ast.Node, visit func(ast.Node) bool) {
+	inspectValue(reflect.ValueOf(node), visit)
+}
+
+func inspectValue(value reflect.Value, visit func(ast.Node) bool) {
+	switch value.Kind() {
+	case reflect.Interface:
+		if !value.IsNil() {
+			inspectValue(value.Elem(), visit)
+		}
+	case reflect.Pointer:
+		if value.IsNil() {
+			return
+		}
+		if node, ok := value.Interface().(ast.Node); ok && !visit(node) {
+			return
+		}
+		inspectValue(value.Elem(), visit)
+	case reflect.Struct:
+		for i := range value.NumField() {
+			field := value.Type().Field(i)
+			if !field.IsExported() || field.Name == "DeclarationList" {
+				continue
+			}
+			inspectValue(value.Field(i), visit)
+		}
+	case reflect.Slice:
+		for i := range value.Len() {
+			inspectValue(value.Index(i), visit)
+		}
+	}
+}
+
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
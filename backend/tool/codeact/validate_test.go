@@ -0,0 +1,186 @@
+package codeact
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/spf13/afero"
+)
+
+func TestValidateScript(t *testing.T) {
+	tests := []struct {
+		Name   string
+		Script string
+		Policy *Policy
+		Errors []string
+	}{
+		{
+			Name: "valid script",
+			Script: `const { entries } = list_files("/project", false);
+const names = [];
+outer: for (const entry of entries) {
+	for (let i = 0; i < 2; i++) {
+		if (i > 0) continue outer;
+	}
+	names.push(entry.name);
+}
+class Counter {
+	count = 0;
+	increment() { return ++this.count; }
+}
+function total(...values) { return values.length + arguments.length; }
+const summary = { names, total: total(1, 2), counter: new Counter(), at: new Date(), max: Math.max(...[1, 2]) };
+if (typeof undefinedHelper === "undefined") {
+	try { JSON.parse("{"); } catch (err) { print(err.message); }
+}
+print(JSON.stringify(summary));
+create_file("/project/a.txt", ` + "`content`" + `);`,
+		},
+		{
+			Name:   "globals assigned to globalThis",
+			Script: "globalThis.files = [];\nglobalThis[\"count\"] = 0;\ncreate_file(\"/project/a.txt\", String(files.length + count));",
+		},
+		{
+			Name:   "syntax error",
+			Script: "create_file(\"/project/a.txt\", \"content\");\nif (true {\n",
+			Errors: []string{"line 2, column 10: syntax error"},
+		},
+		{
+			Name:   "misspelled tool",
+			Script: "create_file(\"/project/a.txt\", \"content\");\nreed_file(\"/project/a.txt\");",
+			Errors: []string{"line 2, column 1: reed_file is not defined, did you mean read_file?"},
+		},
+		{
+			Name:   "undefined variable",
+			Script: "create_file(\"/project/a.txt\", content);",
+			Errors: []string{"line 1, column 31: content is not defined"},
+		},
+		{
+			Name:   "multiple errors",
+			Script: "lst_files(\"/project\");\ncreate_file(\"/project/a.txt\", content);",
+			Errors: []string{"line 1, column 1: lst_files is not defined, did you mean list_files?", "line 2, column 31: content is not defined"},
+		},
+		{
+			Name:   "denied tool",
+			Script: "read_file(\"/project/main.go\");\ncreate_file(\"/project/a.txt\", \"content\");",
+			Policy: &Policy{ReadOnly: true},
+			Errors: []string{"line 2, column 1: create_file: the project configuration only permits read access"},
+		},
+		{
+			Name:   "destructive command",
+			Script: "create_file(\"/project/a.txt\", \"content\");\nexecute_command(\"rm -rf ~/\");",
+			Errors: []string{`line 2, column 1: "rm -rf ~/": command is not permitted because it recursively deletes the root or the home directory`},
+		},
+		{
+			Name:   "force push",
+			Script: "create_file(\"/project/a.txt\", \"content\");\nexecute_command(`git push -f origin main`);",
+			Errors: []string{"command is not permitted because it overwrites the history of the remote repository"},
+		},
+		{
+			Name:   "hard reset",
+			Script: "create_file(\"/project/a.txt\", \"content\");\nexecute_command(\"git reset --hard HEAD~1\");",
+			Errors: []string{`line 2, column 1: "git reset --hard HEAD~1": command is not permitted because it discards uncommitted changes`},
+		},
+		{
+			Name:   "destructive git command permitted by the policy",
+			Script: "create_file(\"/project/a.txt\", \"content\");\nif (false) execute_command(\"git clean -fd\");",
+			Policy: &Policy{AllowDestructiveGit: true},
+		},
+		{
+			Name:   "command not in allowlist",
+			Script: "create_file(\"/project/a.txt\", \"content\");\nexecute_command(\"make install\");",
+			Policy: &Policy{AllowedCommands: []string{"go test *"}},
+			Errors: []string{`line 2, column 1: "make install": command is not allowed by the project configuration`},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			if err := afero.WriteFile(fs, "/project/main.go", []byte("package main"), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			interpreter := NewInterpreter(
+				[]Tool{NewCreateFileTool(), NewReadFileTool(), NewExecuteCommandTool(), NewListFilesTool(), NewPrintTool()},
+				[]Interceptor{InterceptorFunc(PolicyInterceptor)},
+			)
+			input, err := json.Marshal(InterpreterInput{Script: test.Script})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = interpreter.Interpret(context.Background(), fs, input, &Task{
+				ID:               uuid.New(),
+				ProjectDirectory: "/project",
+				Policy:           test.Policy,
+			})
+
+			created, _ := afero.Exists(fs, "/project/a.txt")
+			if len(test.Errors) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if !created {
+					t.Error("expected the script to be executed")
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected the script to be rejected")
+			}
+			for _, expected := range test.Errors {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("expected error containing %q, got %v", expected, err)
+				}
+			}
+			if created {
+				t.Error("expected no part of the script to be executed")
+			}
+		})
+	}
+}
+
+func TestDestructiveCommandReason(t *testing.T) {
+	tests := []struct {
+		Command     string
+		Destructive bool
+	}{
+		{Command: "git reset --hard HEAD~1", Destructive: true},
+		{Command: "git -C /repo reset --hard HEAD~1", Destructive: true},
+		{Command: "git --no-pager reset --hard", Destructive: true},
+		{Command: "git -c user.name=x push -f", Destructive: true},
+		{Command: "git push origin +main", Destructive: true},
+		{Command: "git push -uf origin main", Destructive: true},
+		{Command: "git push --force-with-lease origin main", Destructive: false},
+		{Command: "git push -u origin main", Destructive: false},
+		{Command: "git branch -D feature", Destructive: true},
+		{Command: "git branch --delete --force feature", Destructive: true},
+		{Command: "git branch -f -d feature", Destructive: true},
+		{Command: "git branch -df feature", Destructive: true},
+		{Command: "git branch -d feature", Destructive: false},
+		{Command: "git checkout -- .", Destructive: true},
+		{Command: "git checkout -f main", Destructive: true},
+		{Command: "git checkout -b feature", Destructive: false},
+		{Command: "git -C /repo clean -fd", Destructive: true},
+		{Command: "git clean -n", Destructive: false},
+		{Command: "git stash drop", Destructive: true},
+		{Command: "git status", Destructive: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Command, func(t *testing.T) {
+			reason := destructiveCommandReason(nil, test.Command)
+			if destructive := reason != ""; destructive != test.Destructive {
+				t.Errorf("destructiveCommandReason(%q) = %q, want destructive %v", test.Command, reason, test.Destructive)
+			}
+			if reason := destructiveCommandReason(&Policy{AllowDestructiveGit: true}, test.Command); reason != "" {
+				t.Errorf("expected the policy to permit %q, got %q", test.Command, reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package communication
+
+import (
+	"context"
+
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/google/uuid"
+)
+
+type DelegateInput struct {
+	Agent        string  `json:"agent"`
+	Instructions string  `json:"instructions"`
+	MaxCost      float64 `json:"max_cost,omitempty"`
+}
+
+type DelegateResult struct {
+	TaskID       string   `json:"task_id"`
+	Agent        string   `json:"agent"`
+	Summary      string   `json:"summary"`
+	Completed    bool     `json:"completed"`
+	Deliverables []string `json:"deliverables"`
+	NextSteps    string   `json:"next_steps"`
+	Cost         float64  `json:"cost"`
+}
+
+// Delegator runs child tasks of the given parent task to completion and
+// returns the reports the children submitted.
+type Delegator interface {
+	Delegate(ctx context.Context, parentID uuid.UUID, input *DelegateInput) (*DelegateResult, error)
+	// RemainingBudget returns the budget the parent can still hand to child
+	// tasks. Zero means the parent has no budget.
+	RemainingBudget(ctx context.Context, parentID uuid.UUID) (float64, error)
+}
+
+func Delegate(ctx context.Context, delegator Delegator, parentID uuid.UUID, input *DelegateInput) (*DelegateResult, error) {
+	if delegator == nil {
+		return nil, base.NewCustomError("delegation is not available in this task", []string{
+			"Delegated tasks cannot delegate work themselves",
+			"Complete the work yourself with the other available tools",
+		})
+	}
+
+	if input.Agent == "" {
+		return nil, base.NewCustomError("agent is required", []string{
+			"Provide the name of the agent the work should be delegated to",
+		})
+	}
+
+	if input.Instructions == "" {
+		return nil, base.NewCustomError("instructions are required", []string{
+			"Describe the work the agent should do, including all context it needs",
+			"The agent does not see your conversation, only the instructions",
+		})
+	}
+
+	if input.MaxCost < 0 {
+		return nil, base.NewCustomError("max_cost must not be negative", []string{
+			"Omit max_cost to use the remaining budget of this task",
+		})
+	}
+
+	return delegator.Delegate(ctx, parentID, input)
+}
@@ -0,0 +1,160 @@
+package communication
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultParallelConcurrency = 4
+	MaxParallelConcurrency     = 16
+)
+
+type RunParallelInput struct {
+	Tasks       []*DelegateInput `json:"tasks"`
+	Concurrency int              `json:"concurrency,omitempty"`
+}
+
+type RunParallelResult struct {
+	Results  []*ParallelTaskResult `json:"results"`
+	Finished int                   `json:"finished"`
+	Failed   int                   `json:"failed"`
+	Cost     float64               `json:"cost"`
+}
+
+// ParallelTaskResult is the outcome of one task of a group. Either Result or
+// Error is set.
+type ParallelTaskResult struct {
+	Agent  string          `json:"agent"`
+	Result *DelegateResult `json:"result,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// RunParallel delegates the tasks concurrently and waits for all of them. A
+// failing task does not stop the others, its error is part of the result.
+// Tasks without a budget share the remaining budget of the parent evenly.
+func RunParallel(ctx context.Context, delegator Delegator, parentID uuid.UUID, input *RunParallelInput) (*RunParallelResult, error) {
+	if delegator == nil {
+		return nil, base.NewCustomError("delegation is not available in this task", []string{
+			"Delegated tasks cannot delegate work themselves",
+			"Complete the work yourself with the other available tools",
+		})
+	}
+
+	if len(input.Tasks) == 0 {
+		return nil, base.NewCustomError("tasks are required", []string{
+			"Provide an array of tasks, each with an agent and instructions",
+		})
+	}
+
+	concurrency := input.Concurrency
+	if concurrency == 0 {
+		concurrency = DefaultParallelConcurrency
+	}
+	if concurrency < 1 || concurrency > MaxParallelConcurrency {
+		return nil, base.NewCustomError(fmt.Sprintf("concurrency must be between 1 and %d", MaxParallelConcurrency), []string{
+			fmt.Sprintf("Omit concurrency to run %d tasks at a time", DefaultParallelConcurrency),
+		})
+	}
+
+	var (
+		assigned   float64
+		unassigned int
+	)
+	for i, task := range input.Tasks {
+		if task.Agent == "" || task.Instructions == "" {
+			return nil, base.NewCustomError(fmt.Sprintf("task %d requires an agent and instructions", i), []string{
+				"Every task needs the name of an agent and the instructions for it",
+			})
+		}
+		if task.MaxCost < 0 {
+			return nil, base.NewCustomError(fmt.Sprintf("max_cost of task %d must not be negative", i), []string{
+				"Omit max_cost to share the remaining budget of this task evenly",
+			})
+		}
+		if task.MaxCost > 0 {
+			assigned += task.MaxCost
+		} else {
+			unassigned++
+		}
+	}
+
+	remaining, err := delegator.RemainingBudget(ctx, parentID)
+	if err != nil {
+		return nil, err
+	}
+	if remaining > 0 && unassigned > 0 {
+		if assigned >= remaining {
+			return nil, base.NewCustomError("the budgets of the tasks exceed the remaining budget of this task", []string{
+				fmt.Sprintf("The remaining budget is $%.2f", remaining),
+				"Lower the max_cost of the tasks or omit it to share the remaining budget evenly",
+			})
+		}
+		share := (remaining - assigned) / float64(unassigned)
+		for _, task := range input.Tasks {
+			if task.MaxCost == 0 {
+				task.MaxCost = share
+			}
+		}
+	}
+
+	results := make([]*ParallelTaskResult, len(input.Tasks))
+	semaphore := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, task := range input.Tasks {
+		results[i] = &ParallelTaskResult{Agent: task.Agent}
+
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return nil, ctx.Err()
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			result, err := delegator.Delegate(ctx, parentID, task)
+			if err != nil {
+				results[i].Error = errorMessage(err)
+				return
+			}
+			results[i].Result = result
+		}()
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	group := &RunParallelResult{Results: results}
+	for _, result := range results {
+		if result.Result == nil {
+			group.Failed++
+			continue
+		}
+		group.Finished++
+		group.Cost += result.Result.Cost
+	}
+
+	return group, nil
+}
+
+// errorMessage drops the suggestions of tool errors, they are meant for the
+// agent that made the call and not for the caller of the group.
+func errorMessage(err error) string {
+	var toolErr *base.ToolError
+	if errors.As(err, &toolErr) {
+		return toolErr.Message
+	}
+	return err.Error()
+}
@@ -0,0 +1,177 @@
+package communication
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+type fakeDelegator struct {
+	remaining float64
+
+	mu       sync.Mutex
+	budgets  map[string]float64
+	running  atomic.Int32
+	maxSeen  atomic.Int32
+	release  chan struct{}
+	failWith map[string]error
+}
+
+func (d *fakeDelegator) Delegate(ctx context.Context, parentID uuid.UUID, input *DelegateInput) (*DelegateResult, error) {
+	running := d.running.Add(1)
+	defer d.running.Add(-1)
+	for {
+		seen := d.maxSeen.Load()
+		if running <= seen || d.maxSeen.CompareAndSwap(seen, running) {
+			break
+		}
+	}
+
+	d.mu.Lock()
+	if d.budgets == nil {
+		d.budgets = make(map[string]float64)
+	}
+	d.budgets[input.Instructions] = input.MaxCost
+	d.mu.Unlock()
+
+	if d.release != nil {
+		select {
+		case <-d.release:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if err := d.failWith[input.Instructions]; err != nil {
+		return nil, err
+	}
+	return &DelegateResult{TaskID: input.Instructions, Agent: input.Agent, Completed: true, Cost: 0.5}, nil
+}
+
+func (d *fakeDelegator) RemainingBudget(ctx context.Context, parentID uuid.UUID) (float64, error) {
+	return d.remaining, nil
+}
+
+func TestRunParallel(t *testing.T) {
+	t.Parallel()
+
+	t.Run("results are collected in order", func(t *testing.T) {
+		delegator := &fakeDelegator{failWith: map[string]error{
+			"b": base.NewCustomError("agent missing does not exist", []string{"Check the agent name"}),
+		}}
+
+		result, err := RunParallel(context.Background(), delegator, uuid.New(), &RunParallelInput{
+			Tasks: []*DelegateInput{
+				{Agent: "coder", Instructions: "a"},
+				{Agent: "missing", Instructions: "b"},
+				{Agent: "coder", Instructions: "c"},
+			},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		expected := &RunParallelResult{
+			Results: []*ParallelTaskResult{
+				{Agent: "coder", Result: &DelegateResult{TaskID: "a", Agent: "coder", Completed: true, Cost: 0.5}},
+				{Agent: "missing", Error: "agent missing does not exist"},
+				{Agent: "coder", Result: &DelegateResult{TaskID: "c", Agent: "coder", Completed: true, Cost: 0.5}},
+			},
+			Finished: 2,
+			Failed:   1,
+			Cost:     1,
+		}
+		if diff := cmp.Diff(expected, result); diff != "" {
+			t.Errorf("result mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("remaining budget is shared evenly", func(t *testing.T) {
+		delegator := &fakeDelegator{remaining: 3}
+
+		_, err := RunParallel(context.Background(), delegator, uuid.New(), &RunParallelInput{
+			Tasks: []*DelegateInput{
+				{Agent: "coder", Instructions: "a", MaxCost: 1},
+				{Agent: "coder", Instructions: "b"},
+				{Agent: "coder", Instructions: "c"},
+			},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		expected := map[string]float64{"a": 1, "b": 1, "c": 1}
+		if diff := cmp.Diff(expected, delegator.budgets); diff != "" {
+			t.Errorf("budget mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("assigned budgets exceeding the remaining budget are rejected", func(t *testing.T) {
+		delegator := &fakeDelegator{remaining: 1}
+
+		_, err := RunParallel(context.Background(), delegator, uuid.New(), &RunParallelInput{
+			Tasks: []*DelegateInput{
+				{Agent: "coder", Instructions: "a", MaxCost: 1},
+				{Agent: "coder", Instructions: "b"},
+			},
+		})
+		if err == nil {
+			t.Fatal("expected error")
+		}
+	})
+
+	t.Run("concurrency is capped", func(t *testing.T) {
+		delegator := &fakeDelegator{release: make(chan struct{})}
+
+		var tasks []*DelegateInput
+		for i := range 6 {
+			tasks = append(tasks, &DelegateInput{Agent: "coder", Instructions: fmt.Sprint(i)})
+		}
+
+		done := make(chan error, 1)
+		go func() {
+			_, err := RunParallel(context.Background(), delegator, uuid.New(), &RunParallelInput{Tasks: tasks, Concurrency: 2})
+			done <- err
+		}()
+
+		for range tasks {
+			delegator.release <- struct{}{}
+		}
+		if err := <-done; err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if seen := delegator.maxSeen.Load(); seen > 2 {
+			t.Errorf("expected at most 2 concurrent tasks, got %d", seen)
+		}
+	})
+
+	t.Run("cancellation stops the group", func(t *testing.T) {
+		delegator := &fakeDelegator{release: make(chan struct{})}
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := RunParallel(ctx, delegator, uuid.New(), &RunParallelInput{
+			Tasks: []*DelegateInput{{Agent: "coder", Instructions: "a"}},
+		})
+		if err != context.Canceled {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+	})
+
+	t.Run("invalid concurrency is rejected", func(t *testing.T) {
+		_, err := RunParallel(context.Background(), &fakeDelegator{}, uuid.New(), &RunParallelInput{
+			Tasks:       []*DelegateInput{{Agent: "coder", Instructions: "a"}},
+			Concurrency: MaxParallelConcurrency + 1,
+		})
+		if err == nil {
+			t.Fatal("expected error")
+		}
+	})
+}
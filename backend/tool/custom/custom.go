@@ -0,0 +1,303 @@
+package custom
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/grafana/sobek"
+
+	"github.com/furisto/construct/backend/tool/base"
+)
+
+type Kind string
+
+const (
+	KindCommand    Kind = "command"
+	KindHTTP       Kind = "http"
+	KindJavaScript Kind = "javascript"
+)
+
+const (
+	// DefaultTimeout applies to definitions without a timeout.
+	DefaultTimeout = 30 * time.Second
+	// MaxOutputSize is the number of bytes read from the output of a command
+	// or the body of an HTTP response.
+	MaxOutputSize = 1024 * 1024
+)
+
+// Definition describes a user defined tool and how a call is executed.
+type Definition struct {
+	Name        string
+	Description string
+	// InputSchema is the JSON schema of the argument object. A nil schema
+	// accepts any object.
+	InputSchema map[string]any
+	Kind        Kind
+	// Command is run with /bin/sh for KindCommand.
+	Command string
+	// URL and Headers describe the endpoint for KindHTTP.
+	URL     string
+	Headers map[string]string
+	// Source is the JavaScript module for KindJavaScript.
+	Source  string
+	Timeout time.Duration
+}
+
+type CallInput struct {
+	Tool             string         `json:"tool"`
+	Arguments        map[string]any `json:"arguments"`
+	WorkingDirectory string         `json:"-"`
+}
+
+type CallResult struct {
+	Output any `json:"output"`
+}
+
+// Call executes the definition with the given arguments. Command tools receive
+// the arguments as JSON on stdin, HTTP tools as the JSON body of a POST request
+// and JavaScript tools as the argument of the exported function. Output that is
+// valid JSON is decoded, any other output is returned as a string.
+func Call(ctx context.Context, def *Definition, input *CallInput) (*CallResult, error) {
+	if err := ValidateArguments(def.InputSchema, input.Arguments); err != nil {
+		return nil, err
+	}
+
+	timeout := def.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var (
+		output any
+		err    error
+	)
+	switch def.Kind {
+	case KindCommand:
+		output, err = callCommand(ctx, def, input)
+	case KindHTTP:
+		output, err = callHTTP(ctx, def, input)
+	case KindJavaScript:
+		output, err = callJavaScript(ctx, def, input)
+	default:
+		return nil, base.NewCustomError("unsupported custom tool kind", []string{
+			"This is a configuration error of the tool. Tell the user that the tool could not be called.",
+		}, "tool", def.Name, "kind", def.Kind)
+	}
+
+	if ctx.Err() == context.DeadlineExceeded {
+		return nil, base.NewCustomError("custom tool timed out", []string{
+			"Call the tool with arguments that require less work or continue without it.",
+		}, "tool", def.Name, "timeout", timeout.String())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &CallResult{Output: output}, nil
+}
+
+func callCommand(ctx context.Context, def *Definition, input *CallInput) (any, error) {
+	arguments, err := json.Marshal(input.Arguments)
+	if err != nil {
+		return nil, base.NewError(base.InvalidInput, "arguments", err.Error())
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "/bin/sh", "-c", def.Command)
+	cmd.Dir = input.WorkingDirectory
+	// Child processes may keep the output pipes open after the shell was
+	// killed on timeout.
+	cmd.WaitDelay = time.Second
+	cmd.Stdin = bytes.NewReader(arguments)
+	cmd.Stdout = &limitedWriter{w: &stdout, remaining: MaxOutputSize}
+	cmd.Stderr = &limitedWriter{w: &stderr, remaining: MaxOutputSize}
+	cmd.Env = append(os.Environ(),
+		"CONSTRUCT_TOOL_NAME="+def.Name,
+		"CONSTRUCT_PROJECT_DIRECTORY="+input.WorkingDirectory,
+	)
+
+	if err := cmd.Run(); err != nil {
+		return nil, base.NewCustomError("custom tool failed", []string{
+			"Check the error output of the tool for the cause of the failure.",
+			"Verify that the arguments match the parameters of the tool.",
+		}, "tool", def.Name, "error", err, "stderr", stderr.String(), "stdout", stdout.String())
+	}
+
+	return parseOutput(stdout.Bytes()), nil
+}
+
+func callHTTP(ctx context.Context, def *Definition, input *CallInput) (any, error) {
+	arguments, err := json.Marshal(input.Arguments)
+	if err != nil {
+		return nil, base.NewError(base.InvalidInput, "arguments", err.Error())
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, def.URL, bytes.NewReader(arguments))
+	if err != nil {
+		return nil, base.NewError(base.Internal, "tool", def.Name, "error", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "construct-tool")
+	req.Header.Set("X-Construct-Tool", def.Name)
+	for key, value := range def.Headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, base.NewCustomError("custom tool failed", []string{
+			"The endpoint of the tool is not reachable. Retry later or continue without the tool.",
+		}, "tool", def.Name, "error", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, MaxOutputSize))
+	if err != nil {
+		return nil, base.NewCustomError("custom tool failed", []string{
+			"Retry the call or continue without the tool.",
+		}, "tool", def.Name, "error", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, base.NewCustomError("custom tool failed", []string{
+			"Check the response of the endpoint for the cause of the failure.",
+			"Verify that the arguments match the parameters of the tool.",
+		}, "tool", def.Name, "status", resp.StatusCode, "response", string(body))
+	}
+
+	return parseOutput(body), nil
+}
+
+func callJavaScript(ctx context.Context, def *Definition, input *CallInput) (any, error) {
+	program, err := CompileModule(def.Name, def.Source)
+	if err != nil {
+		return nil, base.NewCustomError("custom tool failed", []string{
+			"This is a configuration error of the tool. Tell the user that the tool could not be called.",
+		}, "tool", def.Name, "error", err)
+	}
+
+	vm := sobek.New()
+	vm.SetFieldNameMapper(sobek.TagFieldNameMapper("json", true))
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			vm.Interrupt(ctx.Err())
+		case <-done:
+		}
+	}()
+
+	output, err := runModule(vm, program, input.Arguments)
+	if err != nil {
+		return nil, base.NewCustomError("custom tool failed", []string{
+			"Check the error for the cause of the failure.",
+			"Verify that the arguments match the parameters of the tool.",
+		}, "tool", def.Name, "error", err)
+	}
+
+	return output, nil
+}
+
+// CompileModule compiles the source of a JavaScript tool. The module exports
+// the function that handles a call by assigning it to module.exports.
+func CompileModule(name, source string) (*sobek.Program, error) {
+	return sobek.Compile(name, "(function(module, exports) {\n"+source+"\n})", true)
+}
+
+func runModule(vm *sobek.Runtime, program *sobek.Program, arguments map[string]any) (any, error) {
+	wrapper, err := vm.RunProgram(program)
+	if err != nil {
+		return nil, err
+	}
+	load, ok := sobek.AssertFunction(wrapper)
+	if !ok {
+		return nil, errors.New("module did not compile to a function")
+	}
+
+	module := vm.NewObject()
+	exports := vm.NewObject()
+	if err := module.Set("exports", exports); err != nil {
+		return nil, err
+	}
+	if _, err := load(sobek.Undefined(), module, exports); err != nil {
+		return nil, err
+	}
+
+	handler, ok := sobek.AssertFunction(module.Get("exports"))
+	if !ok {
+		return nil, errors.New("module.exports must be a function")
+	}
+
+	result, err := handler(sobek.Undefined(), vm.ToValue(arguments))
+	if err != nil {
+		return nil, err
+	}
+
+	return normalize(result.Export())
+}
+
+// normalize round trips the value through JSON so that the output of every
+// kind of tool has the same shape.
+func normalize(value any) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("tool output is not serializable: %w", err)
+	}
+
+	var decoded any
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+func parseOutput(output []byte) any {
+	trimmed := bytes.TrimSpace(output)
+	if len(trimmed) == 0 {
+		return nil
+	}
+
+	var decoded any
+	if json.Valid(trimmed) && json.Unmarshal(trimmed, &decoded) == nil {
+		return decoded
+	}
+	return strings.TrimRight(string(output), "\n")
+}
+
+type limitedWriter struct {
+	w         io.Writer
+	remaining int
+}
+
+func (l *limitedWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	if l.remaining <= 0 {
+		return n, nil
+	}
+	if len(p) > l.remaining {
+		p = p[:l.remaining]
+	}
+	written, err := l.w.Write(p)
+	l.remaining -= written
+	if err != nil {
+		return written, err
+	}
+	return n, nil
+}
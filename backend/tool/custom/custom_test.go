@@ -0,0 +1,208 @@
+package custom
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+type callTestInput struct {
+	Definition *Definition
+	Arguments  map[string]any
+}
+
+func TestCall(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte("unauthorized"))
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		var arguments map[string]any
+		json.Unmarshal(body, &arguments)
+		json.NewEncoder(w).Encode(map[string]any{"ticket": arguments["id"], "status": "open"})
+	}))
+	defer server.Close()
+
+	schema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"id": map[string]any{"type": "string"},
+		},
+		"required": []any{"id"},
+	}
+
+	setup := &base.ToolTestSetup[*callTestInput, *CallResult]{
+		Call: func(ctx context.Context, services *base.ToolTestServices, input *callTestInput) (*CallResult, error) {
+			return Call(ctx, input.Definition, &CallInput{Tool: input.Definition.Name, Arguments: input.Arguments})
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreFields(base.ToolError{}, "Suggestions", "Details"),
+		},
+	}
+
+	setup.RunToolTests(t, []base.ToolTestScenario[*callTestInput, *CallResult]{
+		{
+			Name: "command receives arguments on stdin",
+			TestInput: &callTestInput{
+				Definition: &Definition{Name: "echo_args", Kind: KindCommand, Command: "cat"},
+				Arguments:  map[string]any{"branch": "main"},
+			},
+			Expected: base.ToolTestExpectation[*CallResult]{
+				Result: &CallResult{Output: map[string]any{"branch": "main"}},
+			},
+		},
+		{
+			Name: "command text output",
+			TestInput: &callTestInput{
+				Definition: &Definition{Name: "greet", Kind: KindCommand, Command: "echo hello; echo world"},
+			},
+			Expected: base.ToolTestExpectation[*CallResult]{
+				Result: &CallResult{Output: "hello\nworld"},
+			},
+		},
+		{
+			Name: "command fails",
+			TestInput: &callTestInput{
+				Definition: &Definition{Name: "fail", Kind: KindCommand, Command: "echo broken >&2; exit 3"},
+			},
+			Expected: base.ToolTestExpectation[*CallResult]{
+				Error: &base.ToolError{Message: "custom tool failed"},
+			},
+		},
+		{
+			Name: "command times out",
+			TestInput: &callTestInput{
+				Definition: &Definition{Name: "slow", Kind: KindCommand, Command: "sleep 5", Timeout: 50 * time.Millisecond},
+			},
+			Expected: base.ToolTestExpectation[*CallResult]{
+				Error: &base.ToolError{Message: "custom tool timed out"},
+			},
+		},
+		{
+			Name: "http posts arguments",
+			TestInput: &callTestInput{
+				Definition: &Definition{
+					Name:        "ticket",
+					Kind:        KindHTTP,
+					URL:         server.URL,
+					Headers:     map[string]string{"Authorization": "Bearer secret"},
+					InputSchema: schema,
+				},
+				Arguments: map[string]any{"id": "OPS-1"},
+			},
+			Expected: base.ToolTestExpectation[*CallResult]{
+				Result: &CallResult{Output: map[string]any{"ticket": "OPS-1", "status": "open"}},
+			},
+		},
+		{
+			Name: "http error status",
+			TestInput: &callTestInput{
+				Definition: &Definition{Name: "ticket", Kind: KindHTTP, URL: server.URL},
+			},
+			Expected: base.ToolTestExpectation[*CallResult]{
+				Error: &base.ToolError{Message: "custom tool failed"},
+			},
+		},
+		{
+			Name: "javascript module",
+			TestInput: &callTestInput{
+				Definition: &Definition{
+					Name:   "preview_url",
+					Kind:   KindJavaScript,
+					Source: "module.exports = function(args) { return { url: 'https://' + args.branch + '.preview.example.com', count: 2 }; };",
+				},
+				Arguments: map[string]any{"branch": "feature"},
+			},
+			Expected: base.ToolTestExpectation[*CallResult]{
+				Result: &CallResult{Output: map[string]any{"url": "https://feature.preview.example.com", "count": float64(2)}},
+			},
+		},
+		{
+			Name: "javascript without exported function",
+			TestInput: &callTestInput{
+				Definition: &Definition{Name: "broken", Kind: KindJavaScript, Source: "const x = 1;"},
+			},
+			Expected: base.ToolTestExpectation[*CallResult]{
+				Error: &base.ToolError{Message: "custom tool failed"},
+			},
+		},
+		{
+			Name: "missing required argument",
+			TestInput: &callTestInput{
+				Definition: &Definition{Name: "ticket", Kind: KindHTTP, URL: server.URL, InputSchema: schema},
+				Arguments:  map[string]any{},
+			},
+			Expected: base.ToolTestExpectation[*CallResult]{
+				Error: &base.ToolError{Message: "invalid arguments for custom tool"},
+			},
+		},
+	})
+}
+
+func TestValidateArguments(t *testing.T) {
+	t.Parallel()
+
+	schema, err := ParseSchema(`{
+		"type": "object",
+		"properties": {
+			"id": {"type": "string"},
+			"count": {"type": "integer"},
+			"ratio": {"type": ["number", "null"]}
+		},
+		"required": ["id"],
+		"additionalProperties": false
+	}`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		Name      string
+		Arguments map[string]any
+		Error     string
+	}{
+		{Name: "valid", Arguments: map[string]any{"id": "a", "count": int64(2), "ratio": 0.5}},
+		{Name: "integer accepted as number", Arguments: map[string]any{"id": "a", "ratio": int64(1)}},
+		{Name: "missing required", Arguments: map[string]any{}, Error: `missing required property "id"`},
+		{Name: "wrong type", Arguments: map[string]any{"id": "a", "count": "two"}, Error: `property "count" must be of type integer, got string`},
+		{Name: "unknown property", Arguments: map[string]any{"id": "a", "extra": true}, Error: `unknown property "extra"`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			err := ValidateArguments(schema, test.Arguments)
+			if test.Error == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			toolErr, ok := err.(*base.ToolError)
+			if !ok {
+				t.Fatalf("expected tool error, got %v", err)
+			}
+			if toolErr.Details["error"] != test.Error {
+				t.Errorf("expected error %q, got %q", test.Error, toolErr.Details["error"])
+			}
+		})
+	}
+
+	for _, raw := range []string{`[]`, `{"type": "string"}`, `{"required": [1]}`} {
+		if _, err := ParseSchema(raw); err == nil {
+			t.Errorf("expected error for schema %s", raw)
+		}
+	}
+}
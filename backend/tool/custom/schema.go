@@ -0,0 +1,150 @@
+package custom
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/furisto/construct/backend/tool/base"
+)
+
+// ParseSchema decodes the JSON schema of a tool's argument object. An empty
+// schema accepts any object.
+func ParseSchema(raw string) (map[string]any, error) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+
+	var schema map[string]any
+	if err := json.Unmarshal([]byte(raw), &schema); err != nil {
+		return nil, fmt.Errorf("input schema must be a JSON object: %w", err)
+	}
+
+	if typ, ok := schema["type"]; ok && typ != "object" {
+		return nil, fmt.Errorf("input schema must describe an object, got type %v", typ)
+	}
+	if properties, ok := schema["properties"]; ok {
+		if _, ok := properties.(map[string]any); !ok {
+			return nil, fmt.Errorf("properties of the input schema must be an object")
+		}
+	}
+	if required, ok := schema["required"]; ok {
+		if _, ok := stringList(required); !ok {
+			return nil, fmt.Errorf("required of the input schema must be a list of property names")
+		}
+	}
+
+	return schema, nil
+}
+
+// ValidateArguments checks the top level of the argument object against the
+// schema: required properties, the types of known properties and, if
+// additionalProperties is false, unknown properties. Nested values are passed
+// to the tool unchecked.
+func ValidateArguments(schema map[string]any, arguments map[string]any) error {
+	if schema == nil {
+		return nil
+	}
+
+	properties, _ := schema["properties"].(map[string]any)
+	required, _ := stringList(schema["required"])
+	for _, name := range required {
+		if _, ok := arguments[name]; !ok {
+			return invalidArguments(fmt.Sprintf("missing required property %q", name))
+		}
+	}
+
+	names := make([]string, 0, len(arguments))
+	for name := range arguments {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		property, known := properties[name].(map[string]any)
+		if !known {
+			if additional, ok := schema["additionalProperties"].(bool); ok && !additional {
+				return invalidArguments(fmt.Sprintf("unknown property %q", name))
+			}
+			continue
+		}
+
+		types, ok := stringList(property["type"])
+		if !ok || len(types) == 0 {
+			continue
+		}
+		if actual := jsonType(arguments[name]); !typeMatches(types, actual) {
+			return invalidArguments(fmt.Sprintf("property %q must be of type %s, got %s", name, strings.Join(types, " or "), actual))
+		}
+	}
+
+	return nil
+}
+
+func invalidArguments(message string) error {
+	return base.NewCustomError("invalid arguments for custom tool", []string{
+		"Call the tool with a single object that matches the JSON schema in the tool description.",
+	}, "error", message)
+}
+
+func typeMatches(types []string, actual string) bool {
+	if slices.Contains(types, actual) {
+		return true
+	}
+	return actual == "integer" && slices.Contains(types, "number")
+}
+
+func jsonType(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case int, int32, int64:
+		return "integer"
+	case float32:
+		return numberType(float64(v))
+	case float64:
+		return numberType(v)
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+func numberType(v float64) string {
+	if v == math.Trunc(v) && !math.IsInf(v, 0) {
+		return "integer"
+	}
+	return "number"
+}
+
+func stringList(value any) ([]string, bool) {
+	switch v := value.(type) {
+	case nil:
+		return nil, true
+	case string:
+		return []string{v}, true
+	case []string:
+		return v, true
+	case []any:
+		result := make([]string, 0, len(v))
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, false
+			}
+			result = append(result, s)
+		}
+		return result, true
+	}
+	return nil, false
+}
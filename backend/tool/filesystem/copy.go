@@ -0,0 +1,49 @@
+package filesystem
+
+import (
+	"io/fs"
+	"log/slog"
+
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/spf13/afero"
+)
+
+type CopyFileInput struct {
+	Source      string `json:"source"`
+	Destination string `json:"destination"`
+	Overwrite   bool   `json:"overwrite,omitempty"`
+}
+
+type CopyFileResult struct {
+	Overwritten bool `json:"overwritten"`
+	// Fingerprint identifies the content written to the destination.
+	Fingerprint *Fingerprint `json:"-"`
+}
+
+func CopyFile(fsys afero.Fs, input *CopyFileInput) (*CopyFileResult, error) {
+	overwritten, err := prepareTransfer(fsys, input.Source, input.Destination, input.Overwrite)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := afero.ReadFile(fsys, input.Source)
+	if err != nil {
+		slog.Error("failed to read file", "path", input.Source, "error", err)
+		return nil, base.NewError(base.GenericFileError, "path", input.Source, "error", err.Error())
+	}
+
+	mode := fs.FileMode(0644)
+	if stat, err := fsys.Stat(input.Source); err == nil {
+		mode = stat.Mode().Perm()
+	}
+
+	if err := afero.WriteFile(fsys, input.Destination, content, mode); err != nil {
+		slog.Error("failed to write file", "path", input.Destination, "size_bytes", len(content), "error", err)
+		return nil, base.NewCustomError("error writing file", []string{
+			"Ensure that you have the permission to write to the destination",
+		}, "path", input.Destination, "error", err)
+	}
+
+	slog.Info("file copied", "source", input.Source, "destination", input.Destination, "overwritten", overwritten)
+	return &CopyFileResult{Overwritten: overwritten, Fingerprint: NewFingerprint(nil, content)}, nil
+}
@@ -0,0 +1,75 @@
+package filesystem
+
+import (
+	"context"
+	"testing"
+
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestCopyFile(t *testing.T) {
+	setup := &base.ToolTestSetup[*CopyFileInput, *CopyFileResult]{
+		Call: func(ctx context.Context, services *base.ToolTestServices, input *CopyFileInput) (*CopyFileResult, error) {
+			return CopyFile(services.FS, input)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreFields(CopyFileResult{}, "Fingerprint"),
+			cmpopts.IgnoreFields(base.ToolError{}, "Suggestions"),
+		},
+	}
+
+	setup.RunToolTests(t, []base.ToolTestScenario[*CopyFileInput, *CopyFileResult]{
+		{
+			Name:            "successful copy",
+			TestInput:       &CopyFileInput{Source: "/workspace/old.txt", Destination: "/workspace/src/copy.txt"},
+			SeedFilesystem:  seedTransferTestFilesystem,
+			QueryFilesystem: queryWorkspaceFiles,
+			Expected: base.ToolTestExpectation[*CopyFileResult]{
+				Result: &CopyFileResult{Overwritten: false},
+				Filesystem: map[string]string{
+					"/workspace/old.txt":      "old content",
+					"/workspace/src/copy.txt": "old content",
+					"/workspace/existing.txt": "existing content",
+				},
+			},
+		},
+		{
+			Name:            "successful copy overwriting destination",
+			TestInput:       &CopyFileInput{Source: "/workspace/old.txt", Destination: "/workspace/existing.txt", Overwrite: true},
+			SeedFilesystem:  seedTransferTestFilesystem,
+			QueryFilesystem: queryWorkspaceFiles,
+			Expected: base.ToolTestExpectation[*CopyFileResult]{
+				Result: &CopyFileResult{Overwritten: true},
+				Filesystem: map[string]string{
+					"/workspace/old.txt":      "old content",
+					"/workspace/existing.txt": "old content",
+				},
+			},
+		},
+		{
+			Name:           "destination exists",
+			TestInput:      &CopyFileInput{Source: "/workspace/old.txt", Destination: "/workspace/existing.txt"},
+			SeedFilesystem: seedTransferTestFilesystem,
+			Expected: base.ToolTestExpectation[*CopyFileResult]{
+				Error: base.NewCustomError("destination already exists", nil, "path", "/workspace/existing.txt"),
+			},
+		},
+		{
+			Name:           "source is directory",
+			TestInput:      &CopyFileInput{Source: "/workspace/src", Destination: "/workspace/lib"},
+			SeedFilesystem: seedTransferTestFilesystem,
+			Expected: base.ToolTestExpectation[*CopyFileResult]{
+				Error: base.NewError(base.PathIsDirectory, "path", "/workspace/src"),
+			},
+		},
+		{
+			Name:      "relative source",
+			TestInput: &CopyFileInput{Source: "old.txt", Destination: "/workspace/new.txt"},
+			Expected: base.ToolTestExpectation[*CopyFileResult]{
+				Error: base.NewError(base.PathIsNotAbsolute, "path", "old.txt"),
+			},
+		},
+	})
+}
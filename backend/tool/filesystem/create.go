@@ -15,6 +15,8 @@ type CreateFileInput struct {
 
 type CreateFileResult struct {
 	Overwritten bool `json:"overwritten"`
+	// Fingerprint identifies the written content.
+	Fingerprint *Fingerprint `json:"-"`
 }
 
 func CreateFile(fsys afero.Fs, input *CreateFileInput) (*CreateFileResult, error) {
@@ -49,5 +51,5 @@ func CreateFile(fsys afero.Fs, input *CreateFileInput) (*CreateFileResult, error
 	}
 
 	slog.Info("file written", "path", path, "overwritten", existed, "size_bytes", len(input.Content))
-	return &CreateFileResult{Overwritten: existed}, nil
+	return &CreateFileResult{Overwritten: existed, Fingerprint: NewFingerprint(nil, []byte(input.Content))}, nil
 }
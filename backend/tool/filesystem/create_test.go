@@ -16,6 +16,7 @@ func TestCreateFile(t *testing.T) {
 			return CreateFile(services.FS, input)
 		},
 		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreFields(CreateFileResult{}, "Fingerprint"),
 			cmpopts.IgnoreFields(base.ToolError{}, "Suggestions"),
 		},
 	}
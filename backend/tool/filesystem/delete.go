@@ -0,0 +1,58 @@
+package filesystem
+
+import (
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/spf13/afero"
+)
+
+type DeleteFileInput struct {
+	Path string `json:"path"`
+}
+
+type DeleteFileResult struct {
+	Path string `json:"path"`
+}
+
+func DeleteFile(fsys afero.Fs, input *DeleteFileInput) (*DeleteFileResult, error) {
+	if !filepath.IsAbs(input.Path) {
+		return nil, base.NewError(base.PathIsNotAbsolute, "path", input.Path)
+	}
+	path := input.Path
+
+	stat, err := fsys.Stat(path)
+	if err != nil {
+		return nil, statError(path, err)
+	}
+	if stat.IsDir() {
+		return nil, base.NewError(base.PathIsDirectory, "path", path)
+	}
+
+	if err := fsys.Remove(path); err != nil {
+		slog.Error("failed to delete file", "path", path, "error", err)
+		if os.IsPermission(err) {
+			return nil, base.NewError(base.PermissionDenied, "path", path)
+		}
+		return nil, base.NewCustomError("error deleting file", []string{
+			"Ensure that you have the permission to delete the file",
+		}, "path", path, "error", err)
+	}
+
+	slog.Info("file deleted", "path", path)
+	return &DeleteFileResult{Path: path}, nil
+}
+
+// statError maps the error of a failed stat to a tool error.
+func statError(path string, err error) error {
+	if os.IsNotExist(err) {
+		return base.NewError(base.FileNotFound, "path", path)
+	}
+	if os.IsPermission(err) {
+		return base.NewError(base.PermissionDenied, "path", path)
+	}
+	slog.Error("failed to stat file", "path", path, "error", err)
+	return base.NewError(base.CannotStatFile, "path", path)
+}
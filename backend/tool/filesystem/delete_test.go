@@ -0,0 +1,83 @@
+package filesystem
+
+import (
+	"context"
+	"io/fs"
+	"testing"
+
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/spf13/afero"
+)
+
+func TestDeleteFile(t *testing.T) {
+	setup := &base.ToolTestSetup[*DeleteFileInput, *DeleteFileResult]{
+		Call: func(ctx context.Context, services *base.ToolTestServices, input *DeleteFileInput) (*DeleteFileResult, error) {
+			return DeleteFile(services.FS, input)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreFields(base.ToolError{}, "Suggestions"),
+		},
+	}
+
+	setup.RunToolTests(t, []base.ToolTestScenario[*DeleteFileInput, *DeleteFileResult]{
+		{
+			Name:            "successful deletion",
+			TestInput:       &DeleteFileInput{Path: "/workspace/old.txt"},
+			SeedFilesystem:  seedTransferTestFilesystem,
+			QueryFilesystem: queryWorkspaceFiles,
+			Expected: base.ToolTestExpectation[*DeleteFileResult]{
+				Result: &DeleteFileResult{Path: "/workspace/old.txt"},
+				Filesystem: map[string]string{
+					"/workspace/existing.txt": "existing content",
+				},
+			},
+		},
+		{
+			Name:           "file not found",
+			TestInput:      &DeleteFileInput{Path: "/workspace/missing.txt"},
+			SeedFilesystem: seedTransferTestFilesystem,
+			Expected: base.ToolTestExpectation[*DeleteFileResult]{
+				Error: base.NewError(base.FileNotFound, "path", "/workspace/missing.txt"),
+			},
+		},
+		{
+			Name:           "path is directory",
+			TestInput:      &DeleteFileInput{Path: "/workspace/src"},
+			SeedFilesystem: seedTransferTestFilesystem,
+			Expected: base.ToolTestExpectation[*DeleteFileResult]{
+				Error: base.NewError(base.PathIsDirectory, "path", "/workspace/src"),
+			},
+		},
+		{
+			Name:      "relative path",
+			TestInput: &DeleteFileInput{Path: "old.txt"},
+			Expected: base.ToolTestExpectation[*DeleteFileResult]{
+				Error: base.NewError(base.PathIsNotAbsolute, "path", "old.txt"),
+			},
+		},
+	})
+}
+
+func seedTransferTestFilesystem(ctx context.Context, fs afero.Fs) {
+	fs.MkdirAll("/workspace/src", 0755)
+	afero.WriteFile(fs, "/workspace/old.txt", []byte("old content"), 0644)
+	afero.WriteFile(fs, "/workspace/existing.txt", []byte("existing content"), 0644)
+}
+
+func queryWorkspaceFiles(fsys afero.Fs) (any, error) {
+	files := make(map[string]string)
+	err := afero.Walk(fsys, "/workspace", func(path string, info fs.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		content, err := afero.ReadFile(fsys, path)
+		if err != nil {
+			return err
+		}
+		files[path] = string(content)
+		return nil
+	})
+	return files, err
+}
@@ -56,6 +56,8 @@ type EditFileResult struct {
 	ValidationErrors     []DiffValidationError `json:"validation_errors,omitempty"`
 	ConflictWarnings     []ConflictWarning     `json:"conflict_warnings,omitempty"`
 	PatchInfo            PatchInfo             `json:"patch_info,omitempty"`
+	// Fingerprint identifies the content of the file after the edit.
+	Fingerprint *Fingerprint `json:"-"`
 }
 
 func EditFile(fsys afero.Fs, input *EditFileInput) (*EditFileResult, error) {
@@ -102,6 +104,7 @@ func EditFile(fsys afero.Fs, input *EditFileInput) (*EditFileResult, error) {
 	}
 
 	var patchInfo PatchInfo
+	fingerprint := NewFingerprint(stat, content)
 	if newContent != originalContent {
 		filename := filepath.Base(path)
 		patchInfo.Patch = diff.GeneratePatch(filename, originalContent, newContent)
@@ -114,6 +117,7 @@ func EditFile(fsys afero.Fs, input *EditFileInput) (*EditFileResult, error) {
 				"Verify that you have the permission to write to the file",
 			}, "path", path, "error", err)
 		}
+		fingerprint = NewFingerprint(nil, []byte(newContent))
 
 		slog.Debug("file edits applied", "path", path, "replacements", replacementsMade, "expected", expectedReplacements, "lines_added", patchInfo.LinesAdded, "lines_removed", patchInfo.LinesRemoved)
 	} else {
@@ -132,6 +136,7 @@ func EditFile(fsys afero.Fs, input *EditFileInput) (*EditFileResult, error) {
 		ExpectedReplacements: expectedReplacements,
 		ConflictWarnings:     conflictWarnings,
 		PatchInfo:            patchInfo,
+		Fingerprint:          fingerprint,
 	}, nil
 }
 
@@ -17,6 +17,7 @@ func TestEditFile(t *testing.T) {
 			return EditFile(services.FS, input)
 		},
 		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreFields(EditFileResult{}, "Fingerprint"),
 			cmpopts.IgnoreFields(EditFileResult{}, "PatchInfo"),
 			cmpopts.IgnoreFields(base.ToolError{}, "Suggestions"),
 		},
@@ -0,0 +1,189 @@
+package filesystem
+
+import (
+	"crypto/sha256"
+	"maps"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/spf13/afero"
+
+	"github.com/furisto/construct/backend/tool/base"
+)
+
+// Fingerprint identifies the content of a file at the time the agent last saw
+// it, either by reading or by writing it.
+type Fingerprint struct {
+	ModTime time.Time
+	Size    int64
+	Hash    [sha256.Size]byte
+	SeenAt  time.Time
+}
+
+// ReadTracker records the fingerprints of the files a task has seen so that
+// writes can detect files that were changed by someone else in the meantime,
+// e.g. by the user in their editor. The zero value is not usable, a nil
+// tracker records nothing and reports no conflicts.
+type ReadTracker struct {
+	mu    sync.Mutex
+	files map[string]Fingerprint
+}
+
+func NewReadTracker() *ReadTracker {
+	return &ReadTracker{
+		files: make(map[string]Fingerprint),
+	}
+}
+
+// NewFingerprint returns the fingerprint of the content a tool read or wrote.
+// Info must have been obtained before the content was read, so that a change
+// in between is detected. Without it Check always compares the content, which
+// is required for written files because a change after the write would have
+// the same modification time as the recorded write.
+func NewFingerprint(info os.FileInfo, content []byte) *Fingerprint {
+	fingerprint := &Fingerprint{
+		Size:   int64(len(content)),
+		Hash:   sha256.Sum256(content),
+		SeenAt: time.Now(),
+	}
+	if info != nil {
+		fingerprint.ModTime = info.ModTime()
+	}
+	return fingerprint
+}
+
+// Record stores the fingerprint of the content the agent has seen. The file is
+// not read again, a change made after the agent saw it would be recorded as
+// seen. A nil fingerprint forgets the file.
+func (t *ReadTracker) Record(path string, fingerprint *Fingerprint) {
+	if t == nil {
+		return
+	}
+
+	path = filepath.Clean(path)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if fingerprint == nil {
+		delete(t.files, path)
+		return
+	}
+	t.files[path] = *fingerprint
+}
+
+// Move transfers the fingerprint of a moved file to its destination.
+func (t *ReadTracker) Move(source, destination string) {
+	if t == nil {
+		return
+	}
+
+	source, destination = filepath.Clean(source), filepath.Clean(destination)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	fingerprint, ok := t.files[source]
+	delete(t.files, source)
+	delete(t.files, destination)
+	if ok {
+		t.files[destination] = fingerprint
+	}
+}
+
+func (t *ReadTracker) Forget(path string) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.files, filepath.Clean(path))
+}
+
+// Snapshot returns a copy of the recorded fingerprints, so that they can be
+// restored when changes are discarded.
+func (t *ReadTracker) Snapshot() map[string]Fingerprint {
+	if t == nil {
+		return nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return maps.Clone(t.files)
+}
+
+// Restore resets the fingerprints of the paths to the ones in the snapshot.
+// Paths that are not part of the snapshot are forgotten.
+func (t *ReadTracker) Restore(snapshot map[string]Fingerprint, paths []string) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, path := range paths {
+		path = filepath.Clean(path)
+		if fingerprint, ok := snapshot[path]; ok {
+			t.files[path] = fingerprint
+		} else {
+			delete(t.files, path)
+		}
+	}
+}
+
+// Check returns a FileModified error if the file changed since it was last
+// recorded. Files that were never recorded or no longer exist are not
+// reported, writing them cannot overwrite changes the agent has not seen.
+func (t *ReadTracker) Check(fsys afero.Fs, path string) error {
+	if t == nil {
+		return nil
+	}
+
+	path = filepath.Clean(path)
+	t.mu.Lock()
+	recorded, ok := t.files[path]
+	t.mu.Unlock()
+	if !ok {
+		return nil
+	}
+
+	info, err := fsys.Stat(path)
+	if err != nil || info.IsDir() {
+		return nil
+	}
+	if !recorded.ModTime.IsZero() && info.ModTime().Equal(recorded.ModTime) && info.Size() == recorded.Size {
+		return nil
+	}
+
+	current, err := fingerprintFile(fsys, path)
+	if err != nil || current.Hash == recorded.Hash {
+		return nil
+	}
+
+	return base.NewError(base.FileModified,
+		"path", path,
+		"last_read", recorded.SeenAt.Format(time.RFC3339),
+		"modified", current.ModTime.Format(time.RFC3339),
+	)
+}
+
+func fingerprintFile(fsys afero.Fs, path string) (Fingerprint, error) {
+	info, err := fsys.Stat(path)
+	if err != nil {
+		return Fingerprint{}, err
+	}
+	if info.IsDir() {
+		return Fingerprint{}, os.ErrInvalid
+	}
+
+	content, err := afero.ReadFile(fsys, path)
+	if err != nil {
+		return Fingerprint{}, err
+	}
+
+	return Fingerprint{
+		ModTime: info.ModTime(),
+		Size:    info.Size(),
+		Hash:    sha256.Sum256(content),
+		SeenAt:  time.Now(),
+	}, nil
+}
@@ -0,0 +1,118 @@
+package filesystem
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/spf13/afero"
+)
+
+func TestReadTracker(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Record   bool
+		Change   func(fsys afero.Fs)
+		Conflict bool
+	}{
+		{
+			Name:   "unchanged file",
+			Record: true,
+		},
+		{
+			Name:   "file changed since it was read",
+			Record: true,
+			Change: func(fsys afero.Fs) {
+				afero.WriteFile(fsys, "/workspace/main.go", []byte("package app\n"), 0644)
+			},
+			Conflict: true,
+		},
+		{
+			Name:   "file touched without changing its content",
+			Record: true,
+			Change: func(fsys afero.Fs) {
+				fsys.Chtimes("/workspace/main.go", time.Now(), time.Now().Add(time.Hour))
+			},
+		},
+		{
+			Name:   "file deleted since it was read",
+			Record: true,
+			Change: func(fsys afero.Fs) {
+				fsys.Remove("/workspace/main.go")
+			},
+		},
+		{
+			Name: "file never read",
+			Change: func(fsys afero.Fs) {
+				afero.WriteFile(fsys, "/workspace/main.go", []byte("package app\n"), 0644)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			fsys := afero.NewMemMapFs()
+			if err := afero.WriteFile(fsys, "/workspace/main.go", []byte("package main\n"), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			tracker := NewReadTracker()
+			if test.Record {
+				tracker.Record("/workspace/./main.go", read(fsys))
+			}
+			if test.Change != nil {
+				test.Change(fsys)
+			}
+
+			err := tracker.Check(fsys, "/workspace/main.go")
+			var toolErr *base.ToolError
+			if conflict := errors.As(err, &toolErr) && toolErr.Message == base.FileModified.String(); conflict != test.Conflict {
+				t.Fatalf("expected conflict %v, got error %v", test.Conflict, err)
+			}
+
+			tracker.Record("/workspace/main.go", read(fsys))
+			if err := tracker.Check(fsys, "/workspace/main.go"); err != nil {
+				t.Errorf("expected no conflict after the file was read again, got %v", err)
+			}
+		})
+	}
+}
+
+func TestReadTrackerRecordsSeenContent(t *testing.T) {
+	fsys := afero.NewMemMapFs()
+	if err := afero.WriteFile(fsys, "/workspace/main.go", []byte("package main\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// The file changes after the agent read it but before the read is
+	// recorded, the change must not be recorded as seen.
+	tracker := NewReadTracker()
+	fingerprint := read(fsys)
+	afero.WriteFile(fsys, "/workspace/main.go", []byte("package app\n"), 0644)
+	tracker.Record("/workspace/main.go", fingerprint)
+	if err := tracker.Check(fsys, "/workspace/main.go"); err == nil {
+		t.Error("expected a conflict for a change made before the read was recorded")
+	}
+
+	// Written content is compared by its hash, a change after the write is
+	// detected even if it keeps the size and modification time.
+	written := []byte("package one\n")
+	afero.WriteFile(fsys, "/workspace/main.go", written, 0644)
+	tracker.Record("/workspace/main.go", NewFingerprint(nil, written))
+	info, _ := fsys.Stat("/workspace/main.go")
+	afero.WriteFile(fsys, "/workspace/main.go", []byte("package two\n"), 0644)
+	fsys.Chtimes("/workspace/main.go", info.ModTime(), info.ModTime())
+	if err := tracker.Check(fsys, "/workspace/main.go"); err == nil {
+		t.Error("expected a conflict for a change made after the write")
+	}
+}
+
+// read returns the fingerprint of reading main.go, nil if it does not exist.
+func read(fsys afero.Fs) *Fingerprint {
+	result, err := ReadFile(fsys, &ReadFileInput{Path: "/workspace/main.go"})
+	if err != nil {
+		return nil
+	}
+	return result.Fingerprint
+}
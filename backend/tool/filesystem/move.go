@@ -0,0 +1,87 @@
+package filesystem
+
+import (
+	"log/slog"
+	"path/filepath"
+
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/spf13/afero"
+)
+
+type MoveFileInput struct {
+	Source      string `json:"source"`
+	Destination string `json:"destination"`
+	Overwrite   bool   `json:"overwrite,omitempty"`
+}
+
+type MoveFileResult struct {
+	Overwritten bool `json:"overwritten"`
+}
+
+func MoveFile(fsys afero.Fs, input *MoveFileInput) (*MoveFileResult, error) {
+	overwritten, err := prepareTransfer(fsys, input.Source, input.Destination, input.Overwrite)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := fsys.Rename(input.Source, input.Destination); err != nil {
+		slog.Error("failed to move file", "source", input.Source, "destination", input.Destination, "error", err)
+		return nil, base.NewCustomError("error moving file", []string{
+			"Ensure that you have the permission to write to the source and the destination directory",
+		}, "source", input.Source, "destination", input.Destination, "error", err)
+	}
+
+	slog.Info("file moved", "source", input.Source, "destination", input.Destination, "overwritten", overwritten)
+	return &MoveFileResult{Overwritten: overwritten}, nil
+}
+
+// prepareTransfer validates the paths of a move or copy and creates the parent
+// directory of the destination. It reports whether an existing destination
+// file will be replaced.
+func prepareTransfer(fsys afero.Fs, source, destination string, overwrite bool) (bool, error) {
+	if !filepath.IsAbs(source) {
+		return false, base.NewError(base.PathIsNotAbsolute, "path", source)
+	}
+	if !filepath.IsAbs(destination) {
+		return false, base.NewError(base.PathIsNotAbsolute, "path", destination)
+	}
+
+	stat, err := fsys.Stat(source)
+	if err != nil {
+		return false, statError(source, err)
+	}
+	if stat.IsDir() {
+		return false, base.NewError(base.PathIsDirectory, "path", source)
+	}
+
+	if filepath.Clean(source) == filepath.Clean(destination) {
+		return false, base.NewCustomError("source and destination are the same file", []string{
+			"Provide a destination that differs from the source",
+		}, "path", source)
+	}
+
+	var existed bool
+	if stat, err := fsys.Stat(destination); err == nil {
+		if stat.IsDir() {
+			return false, base.NewCustomError("destination is a directory", []string{
+				"Provide the full path of the destination file including its name, e.g. /workspace/project/new/name.go",
+			}, "path", destination)
+		}
+		if !overwrite {
+			return false, base.NewCustomError("destination already exists", []string{
+				"Pass true as the third argument to replace the existing file",
+				"Choose a different destination",
+			}, "path", destination)
+		}
+		existed = true
+	}
+
+	if err := fsys.MkdirAll(filepath.Dir(destination), 0755); err != nil {
+		slog.Error("failed to create parent directory", "path", destination, "error", err)
+		return false, base.NewCustomError("could not create the parent directory", []string{
+			"Verify that you have the permissions to create the parent directories",
+		}, "path", destination, "error", err)
+	}
+
+	return existed, nil
+}
@@ -0,0 +1,88 @@
+package filesystem
+
+import (
+	"context"
+	"testing"
+
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestMoveFile(t *testing.T) {
+	setup := &base.ToolTestSetup[*MoveFileInput, *MoveFileResult]{
+		Call: func(ctx context.Context, services *base.ToolTestServices, input *MoveFileInput) (*MoveFileResult, error) {
+			return MoveFile(services.FS, input)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreFields(base.ToolError{}, "Suggestions"),
+		},
+	}
+
+	setup.RunToolTests(t, []base.ToolTestScenario[*MoveFileInput, *MoveFileResult]{
+		{
+			Name:            "successful move into new directory",
+			TestInput:       &MoveFileInput{Source: "/workspace/old.txt", Destination: "/workspace/archive/new.txt"},
+			SeedFilesystem:  seedTransferTestFilesystem,
+			QueryFilesystem: queryWorkspaceFiles,
+			Expected: base.ToolTestExpectation[*MoveFileResult]{
+				Result: &MoveFileResult{Overwritten: false},
+				Filesystem: map[string]string{
+					"/workspace/archive/new.txt": "old content",
+					"/workspace/existing.txt":    "existing content",
+				},
+			},
+		},
+		{
+			Name:            "successful move overwriting destination",
+			TestInput:       &MoveFileInput{Source: "/workspace/old.txt", Destination: "/workspace/existing.txt", Overwrite: true},
+			SeedFilesystem:  seedTransferTestFilesystem,
+			QueryFilesystem: queryWorkspaceFiles,
+			Expected: base.ToolTestExpectation[*MoveFileResult]{
+				Result: &MoveFileResult{Overwritten: true},
+				Filesystem: map[string]string{
+					"/workspace/existing.txt": "old content",
+				},
+			},
+		},
+		{
+			Name:           "destination exists",
+			TestInput:      &MoveFileInput{Source: "/workspace/old.txt", Destination: "/workspace/existing.txt"},
+			SeedFilesystem: seedTransferTestFilesystem,
+			Expected: base.ToolTestExpectation[*MoveFileResult]{
+				Error: base.NewCustomError("destination already exists", nil, "path", "/workspace/existing.txt"),
+			},
+		},
+		{
+			Name:           "destination is directory",
+			TestInput:      &MoveFileInput{Source: "/workspace/old.txt", Destination: "/workspace/src"},
+			SeedFilesystem: seedTransferTestFilesystem,
+			Expected: base.ToolTestExpectation[*MoveFileResult]{
+				Error: base.NewCustomError("destination is a directory", nil, "path", "/workspace/src"),
+			},
+		},
+		{
+			Name:           "source and destination are the same",
+			TestInput:      &MoveFileInput{Source: "/workspace/old.txt", Destination: "/workspace/./old.txt", Overwrite: true},
+			SeedFilesystem: seedTransferTestFilesystem,
+			Expected: base.ToolTestExpectation[*MoveFileResult]{
+				Error: base.NewCustomError("source and destination are the same file", nil, "path", "/workspace/old.txt"),
+			},
+		},
+		{
+			Name:           "source not found",
+			TestInput:      &MoveFileInput{Source: "/workspace/missing.txt", Destination: "/workspace/new.txt"},
+			SeedFilesystem: seedTransferTestFilesystem,
+			Expected: base.ToolTestExpectation[*MoveFileResult]{
+				Error: base.NewError(base.FileNotFound, "path", "/workspace/missing.txt"),
+			},
+		},
+		{
+			Name:      "relative destination",
+			TestInput: &MoveFileInput{Source: "/workspace/old.txt", Destination: "new.txt"},
+			Expected: base.ToolTestExpectation[*MoveFileResult]{
+				Error: base.NewError(base.PathIsNotAbsolute, "path", "new.txt"),
+			},
+		},
+	})
+}
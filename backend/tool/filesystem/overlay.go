@@ -0,0 +1,194 @@
+package filesystem
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	diff "github.com/sourcegraph/go-diff-patch"
+	"github.com/spf13/afero"
+)
+
+// Overlay stages writes in memory on top of a base file system. Reads of
+// files that were not written fall through to the base file system, which is
+// never modified until the overlay is committed.
+type Overlay struct {
+	base   afero.Fs
+	staged afero.Fs
+	fs     afero.Fs
+}
+
+func NewOverlay(base afero.Fs) *Overlay {
+	staged := afero.NewMemMapFs()
+	return &Overlay{
+		base:   base,
+		staged: staged,
+		fs:     afero.NewCopyOnWriteFs(afero.NewReadOnlyFs(base), staged),
+	}
+}
+
+// Fs returns the file system that reads through the overlay and stages all
+// writes.
+func (o *Overlay) Fs() afero.Fs {
+	return o.fs
+}
+
+// Base returns the underlying file system.
+func (o *Overlay) Base() afero.Fs {
+	return o.base
+}
+
+// Stage writes content to the overlay without touching the base file system.
+func (o *Overlay) Stage(path, content string) error {
+	if err := o.staged.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return afero.WriteFile(o.staged, path, []byte(content), 0644)
+}
+
+// Files returns the paths of the staged files in lexical order.
+func (o *Overlay) Files() ([]string, error) {
+	var files []string
+	err := afero.Walk(o.staged, string(filepath.Separator), func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.Mode().IsRegular() {
+			files = append(files, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.Sort(files)
+	return files, nil
+}
+
+// Content returns the staged content of all files by path.
+func (o *Overlay) Content() (map[string]string, error) {
+	files, err := o.Files()
+	if err != nil {
+		return nil, err
+	}
+
+	content := make(map[string]string, len(files))
+	for _, path := range files {
+		data, err := afero.ReadFile(o.staged, path)
+		if err != nil {
+			return nil, err
+		}
+		content[path] = string(data)
+	}
+	return content, nil
+}
+
+// OverlayChange is a staged file whose content differs from the base file
+// system.
+type OverlayChange struct {
+	Path    string
+	Created bool
+	Patch   string
+}
+
+// Changes compares the staged files with the base file system. Staged files
+// that match their current content are omitted.
+func (o *Overlay) Changes() ([]OverlayChange, error) {
+	files, err := o.Files()
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []OverlayChange
+	for _, path := range files {
+		content, err := afero.ReadFile(o.staged, path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read staged file %s: %w", path, err)
+		}
+
+		original, err := afero.ReadFile(o.base, path)
+		created := errors.Is(err, os.ErrNotExist)
+		if err != nil && !created {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		if !created && string(original) == string(content) {
+			continue
+		}
+
+		changes = append(changes, OverlayChange{
+			Path:    path,
+			Created: created,
+			Patch:   diff.GeneratePatch(path, string(original), string(content)),
+		})
+	}
+	return changes, nil
+}
+
+// Commit writes the changed files to the base file system. All files are
+// first written next to their destination and then renamed, so a failure
+// while writing leaves the original files untouched.
+func (o *Overlay) Commit() ([]OverlayChange, error) {
+	changes, err := o.Changes()
+	if err != nil {
+		return nil, err
+	}
+
+	temporary := make(map[string]string, len(changes))
+	cleanup := func() {
+		for _, tmp := range temporary {
+			o.base.Remove(tmp)
+		}
+	}
+
+	for _, change := range changes {
+		content, err := afero.ReadFile(o.staged, change.Path)
+		if err != nil {
+			cleanup()
+			return nil, fmt.Errorf("failed to read staged file %s: %w", change.Path, err)
+		}
+
+		mode := fs.FileMode(0644)
+		if info, err := o.base.Stat(change.Path); err == nil {
+			mode = info.Mode().Perm()
+		}
+
+		if err := o.base.MkdirAll(filepath.Dir(change.Path), 0755); err != nil {
+			cleanup()
+			return nil, fmt.Errorf("failed to create the parent directory of %s: %w", change.Path, err)
+		}
+
+		tmp := change.Path + ".construct-tmp"
+		if err := afero.WriteFile(o.base, tmp, content, mode); err != nil {
+			o.base.Remove(tmp)
+			cleanup()
+			return nil, fmt.Errorf("failed to write %s: %w", change.Path, err)
+		}
+		temporary[change.Path] = tmp
+	}
+
+	var written []string
+	for _, change := range changes {
+		if err := o.base.Rename(temporary[change.Path], change.Path); err != nil {
+			cleanup()
+			return nil, fmt.Errorf("failed to replace %s, only %d of %d files were written (%s): %w", change.Path, len(written), len(changes), strings.Join(written, ", "), err)
+		}
+		delete(temporary, change.Path)
+		written = append(written, change.Path)
+	}
+
+	return changes, nil
+}
+
+// Patch joins the patches of the changes into a single unified diff.
+func Patch(changes []OverlayChange) string {
+	var patch strings.Builder
+	for _, change := range changes {
+		patch.WriteString(change.Patch)
+	}
+	return patch.String()
+}
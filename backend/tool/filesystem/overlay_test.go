@@ -0,0 +1,96 @@
+package filesystem
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/afero"
+)
+
+func TestOverlay(t *testing.T) {
+	base := afero.NewMemMapFs()
+	if err := afero.WriteFile(base, "/workspace/main.go", []byte("func foo() {}\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := afero.WriteFile(base, "/workspace/go.mod", []byte("module example\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	overlay := NewOverlay(base)
+	if err := afero.WriteFile(overlay.Fs(), "/workspace/main.go", []byte("func bar() {}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := overlay.Stage("/workspace/docs/README.md", "# Example\n"); err != nil {
+		t.Fatal(err)
+	}
+	if err := overlay.Stage("/workspace/go.mod", "module example\n"); err != nil {
+		t.Fatal(err)
+	}
+
+	content, err := afero.ReadFile(base, "/workspace/main.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "func foo() {}\n" {
+		t.Errorf("expected the base file system to be unchanged before the commit, got %q", content)
+	}
+
+	content, err = afero.ReadFile(overlay.Fs(), "/workspace/main.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "func bar() {}\n" {
+		t.Errorf("expected reads to see the staged content, got %q", content)
+	}
+
+	files, err := overlay.Files()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"/workspace/docs/README.md", "/workspace/go.mod", "/workspace/main.go"}, files); diff != "" {
+		t.Errorf("Files() mismatch (-want +got):\n%s", diff)
+	}
+
+	changes, err := overlay.Commit()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var changed []string
+	for _, change := range changes {
+		changed = append(changed, change.Path)
+		if created := change.Path == "/workspace/docs/README.md"; change.Created != created {
+			t.Errorf("expected %s to have created %v, got %v", change.Path, created, change.Created)
+		}
+	}
+	if diff := cmp.Diff([]string{"/workspace/docs/README.md", "/workspace/main.go"}, changed); diff != "" {
+		t.Errorf("expected unchanged files to be omitted (-want +got):\n%s", diff)
+	}
+
+	expected := map[string]string{
+		"/workspace/main.go":        "func bar() {}\n",
+		"/workspace/docs/README.md": "# Example\n",
+		"/workspace/go.mod":         "module example\n",
+	}
+	for path, want := range expected {
+		content, err := afero.ReadFile(base, path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(content) != want {
+			t.Errorf("expected %s to contain %q after the commit, got %q", path, want, content)
+		}
+	}
+
+	info, err := base.Stat("/workspace/main.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("expected the commit to keep the file mode 0600, got %v", info.Mode().Perm())
+	}
+
+	if exists, _ := afero.Exists(base, "/workspace/main.go.construct-tmp"); exists {
+		t.Error("expected the temporary file to be renamed")
+	}
+}
@@ -0,0 +1,608 @@
+package filesystem
+
+import (
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	diff "github.com/sourcegraph/go-diff-patch"
+	"github.com/spf13/afero"
+
+	"github.com/furisto/construct/backend/tool/base"
+)
+
+type ApplyPatchInput struct {
+	Patch  string `json:"patch"`
+	DryRun bool   `json:"dry_run,omitempty"`
+	// WorkingDirectory resolves relative paths in the patch.
+	WorkingDirectory string `json:"-"`
+}
+
+type ApplyPatchResult struct {
+	DryRun bool          `json:"dry_run,omitempty"`
+	Files  []PatchedFile `json:"files"`
+}
+
+const (
+	PatchActionCreated  = "created"
+	PatchActionModified = "modified"
+	PatchActionDeleted  = "deleted"
+)
+
+type PatchedFile struct {
+	Path      string       `json:"path"`
+	Action    string       `json:"action"`
+	PatchInfo PatchInfo    `json:"patch_info"`
+	Hunks     []HunkResult `json:"hunks"`
+	// Fingerprint identifies the written content. It is nil for dry runs and
+	// deleted files.
+	Fingerprint *Fingerprint `json:"-"`
+}
+
+// HunkResult reports where a hunk was applied or why it could not be applied.
+// Line is the 1-based line of the original file the hunk starts at and Offset
+// its distance from the line given in the hunk header.
+type HunkResult struct {
+	Hunk    int    `json:"hunk"`
+	Applied bool   `json:"applied"`
+	Line    int    `json:"line,omitempty"`
+	Offset  int    `json:"offset,omitempty"`
+	Fuzzy   bool   `json:"fuzzy,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// FilePatch is the section of a unified diff that changes one file. OldPath is
+// empty for created files and NewPath is empty for deleted files.
+type FilePatch struct {
+	OldPath string
+	NewPath string
+	Hunks   []Hunk
+}
+
+func (p *FilePatch) Path() string {
+	if p.NewPath != "" {
+		return p.NewPath
+	}
+	return p.OldPath
+}
+
+// Hunk is a contiguous change of a file. OldStart is 0 if the header did not
+// contain line numbers.
+type Hunk struct {
+	OldStart     int
+	OldCount     int
+	Lines        []HunkLine
+	OldNoNewline bool
+	NewNoNewline bool
+}
+
+// HunkLine is a line of a hunk. Op is ' ' for context, '-' for removed and '+'
+// for added lines.
+type HunkLine struct {
+	Op   byte
+	Text string
+}
+
+// maxFuzz is the number of context lines that may be ignored at each end of a
+// hunk if it does not match otherwise.
+const maxFuzz = 2
+
+var hunkHeader = regexp.MustCompile(`^@@ -(\d+)(?:,(\d+))? \+(\d+)(?:,(\d+))? @@`)
+
+// ParsePatch parses a unified diff. Text outside of the file sections, such as
+// git headers or code fences, is ignored. Hunk headers without line numbers
+// are accepted and the line counts of the headers are not enforced.
+func ParsePatch(patch string) ([]FilePatch, error) {
+	lines := strings.Split(strings.ReplaceAll(patch, "\r\n", "\n"), "\n")
+
+	var patches []FilePatch
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		switch {
+		case isFileHeader(lines, i):
+			patches = append(patches, FilePatch{
+				OldPath: patchPath(line[4:]),
+				NewPath: patchPath(lines[i+1][4:]),
+			})
+			i += 2
+		case strings.HasPrefix(line, "@@"):
+			if len(patches) == 0 {
+				return nil, fmt.Errorf("line %d: hunk without a preceding --- and +++ file header", i+1)
+			}
+			hunk, next, err := parseHunk(lines, i)
+			if err != nil {
+				return nil, err
+			}
+			current := &patches[len(patches)-1]
+			current.Hunks = append(current.Hunks, hunk)
+			i = next
+		default:
+			i++
+		}
+	}
+
+	if len(patches) == 0 {
+		return nil, fmt.Errorf("the patch does not contain any file sections starting with --- and +++")
+	}
+	for _, p := range patches {
+		if p.OldPath == "" && p.NewPath == "" {
+			return nil, fmt.Errorf("a file section has /dev/null as both the old and the new path")
+		}
+		if len(p.Hunks) == 0 {
+			return nil, fmt.Errorf("the section of %s does not contain any hunks", p.Path())
+		}
+	}
+	return patches, nil
+}
+
+func isFileHeader(lines []string, i int) bool {
+	return strings.HasPrefix(lines[i], "--- ") && i+1 < len(lines) && strings.HasPrefix(lines[i+1], "+++ ")
+}
+
+func parseHunk(lines []string, start int) (Hunk, int, error) {
+	hunk := Hunk{OldCount: -1}
+	if match := hunkHeader.FindStringSubmatch(lines[start]); match != nil {
+		hunk.OldStart, _ = strconv.Atoi(match[1])
+		hunk.OldCount = 1
+		if match[2] != "" {
+			hunk.OldCount, _ = strconv.Atoi(match[2])
+		}
+	}
+
+	i := start + 1
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		if strings.HasPrefix(line, "@@") || strings.HasPrefix(line, "diff --git ") || isFileHeader(lines, i) {
+			break
+		}
+
+		switch {
+		case line == "":
+			hunk.Lines = append(hunk.Lines, HunkLine{Op: ' '})
+		case line[0] == ' ' || line[0] == '-' || line[0] == '+':
+			hunk.Lines = append(hunk.Lines, HunkLine{Op: line[0], Text: line[1:]})
+		case line[0] == '\\':
+			if len(hunk.Lines) == 0 {
+				continue
+			}
+			switch hunk.Lines[len(hunk.Lines)-1].Op {
+			case '-':
+				hunk.OldNoNewline = true
+			case '+':
+				hunk.NewNoNewline = true
+			default:
+				hunk.OldNoNewline, hunk.NewNoNewline = true, true
+			}
+		case strings.HasPrefix(line, "```"):
+			return hunk, i + 1, nil
+		default:
+			return Hunk{}, 0, fmt.Errorf("line %d: %q is not a valid hunk line, every line must start with a space, - or +", i+1, line)
+		}
+	}
+
+	// Empty lines at the end of a hunk are usually separators rather than
+	// context whose leading space was stripped.
+	for hunk.OldCount >= 0 && len(hunk.Lines) > 0 {
+		last := hunk.Lines[len(hunk.Lines)-1]
+		if last.Op != ' ' || last.Text != "" || countOld(hunk.Lines) <= hunk.OldCount {
+			break
+		}
+		hunk.Lines = hunk.Lines[:len(hunk.Lines)-1]
+	}
+
+	if len(hunk.Lines) == 0 {
+		return Hunk{}, 0, fmt.Errorf("line %d: the hunk does not contain any lines", start+1)
+	}
+	return hunk, i, nil
+}
+
+func countOld(lines []HunkLine) int {
+	var count int
+	for _, line := range lines {
+		if line.Op != '+' {
+			count++
+		}
+	}
+	return count
+}
+
+// patchPath strips timestamps, quotes and the a/ and b/ prefixes of git from
+// a path of a file header.
+func patchPath(header string) string {
+	path, _, _ := strings.Cut(header, "\t")
+	path = strings.Trim(strings.TrimSpace(path), `"`)
+	if path == "/dev/null" {
+		return ""
+	}
+	if strings.HasPrefix(path, "a/") || strings.HasPrefix(path, "b/") {
+		path = path[2:]
+	}
+	return path
+}
+
+// PatchDeletesFiles reports whether the patch deletes any file. Invalid
+// patches do not delete files.
+func PatchDeletesFiles(patch string) bool {
+	patches, err := ParsePatch(patch)
+	if err != nil {
+		return false
+	}
+	for _, p := range patches {
+		if p.NewPath == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// PatchPaths returns the absolute paths of the files the patch changes.
+// Invalid patches and paths that cannot be resolved are skipped, ApplyPatch
+// reports them.
+func PatchPaths(input *ApplyPatchInput) []string {
+	patches, err := ParsePatch(input.Patch)
+	if err != nil {
+		return nil
+	}
+
+	paths := make([]string, 0, len(patches))
+	for _, p := range patches {
+		if path, err := resolvePatchPath(p.Path(), input.WorkingDirectory); err == nil {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+type patchedContent struct {
+	file    PatchedFile
+	content string
+	mode    fs.FileMode
+}
+
+func ApplyPatch(fsys afero.Fs, input *ApplyPatchInput) (*ApplyPatchResult, error) {
+	patches, err := ParsePatch(input.Patch)
+	if err != nil {
+		return nil, base.NewCustomError(fmt.Sprintf("invalid patch: %s", err), []string{
+			"Provide a unified diff with a --- and +++ header for every file followed by its @@ hunks",
+			"Start context lines with a space, removed lines with - and added lines with +",
+		})
+	}
+
+	var (
+		results  []patchedContent
+		failures []string
+		seen     = make(map[string]struct{}, len(patches))
+	)
+	for _, p := range patches {
+		if p.OldPath != "" && p.NewPath != "" && p.OldPath != p.NewPath {
+			return nil, base.NewCustomError("renaming files with a patch is not supported", []string{
+				"Rename the file with move_file and patch it under its new name",
+			}, "old_path", p.OldPath, "new_path", p.NewPath)
+		}
+
+		path, err := resolvePatchPath(p.Path(), input.WorkingDirectory)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[path]; ok {
+			return nil, base.NewCustomError("the patch changes a file in more than one section", []string{
+				"Combine all hunks of a file into a single section",
+			}, "path", path)
+		}
+		seen[path] = struct{}{}
+
+		result, err := patchFile(fsys, path, p)
+		if err != nil {
+			return nil, err
+		}
+		for _, hunk := range result.file.Hunks {
+			if !hunk.Applied {
+				failures = append(failures, fmt.Sprintf("%s, hunk %d: %s", path, hunk.Hunk, hunk.Error))
+			}
+		}
+		results = append(results, result)
+	}
+
+	files := make([]PatchedFile, 0, len(results))
+	for _, result := range results {
+		files = append(files, result.file)
+	}
+
+	if input.DryRun {
+		return &ApplyPatchResult{DryRun: true, Files: files}, nil
+	}
+
+	if len(failures) > 0 {
+		slog.Warn("patch not applied", "failed_hunks", len(failures))
+		return nil, base.NewCustomError(fmt.Sprintf("%d hunks could not be applied, no files were changed:\n- %s", len(failures), strings.Join(failures, "\n- ")), []string{
+			"Read the current content of the files and recreate the failed hunks with the exact context lines",
+			"Hunks that applied are not reported as errors, resend them together with the fixed hunks",
+		})
+	}
+
+	for i, result := range results {
+		if err := writePatchedFile(fsys, result); err != nil {
+			return nil, err
+		}
+		if result.file.Action != PatchActionDeleted {
+			files[i].Fingerprint = NewFingerprint(nil, []byte(result.content))
+		}
+	}
+
+	slog.Info("patch applied", "files", len(files))
+	return &ApplyPatchResult{Files: files}, nil
+}
+
+func resolvePatchPath(path, workingDirectory string) (string, error) {
+	if filepath.IsAbs(path) {
+		return filepath.Clean(path), nil
+	}
+	if workingDirectory == "" {
+		return "", base.NewError(base.PathIsNotAbsolute, "path", path)
+	}
+	return filepath.Join(workingDirectory, path), nil
+}
+
+func patchFile(fsys afero.Fs, path string, p FilePatch) (patchedContent, error) {
+	result := patchedContent{
+		file: PatchedFile{Path: path, Action: PatchActionModified},
+		mode: 0644,
+	}
+
+	var original string
+	stat, err := fsys.Stat(path)
+	switch {
+	case err == nil && stat.IsDir():
+		return result, base.NewError(base.PathIsDirectory, "path", path)
+	case err == nil:
+		content, err := afero.ReadFile(fsys, path)
+		if err != nil {
+			slog.Error("failed to read file for patching", "path", path, "error", err)
+			return result, base.NewCustomError("error reading file", []string{
+				"Verify that you have the permission to read the file",
+			}, "path", path, "error", err)
+		}
+		original = string(content)
+		result.mode = stat.Mode()
+	case !os.IsNotExist(err):
+		return result, base.NewCustomError("error accessing file", []string{
+			"Verify that you have the permission to access the file",
+		}, "path", path, "error", err)
+	}
+	exists := err == nil
+
+	switch {
+	case p.OldPath == "":
+		result.file.Action = PatchActionCreated
+		if exists {
+			result.file.Hunks = failHunks(p.Hunks, "the file already exists, patch it instead of creating it")
+			return result, nil
+		}
+	case !exists:
+		result.file.Hunks = failHunks(p.Hunks, "the file does not exist")
+		return result, nil
+	case p.NewPath == "":
+		result.file.Action = PatchActionDeleted
+	}
+
+	content, hunks := applyHunks(original, p.Hunks)
+	result.file.Hunks = hunks
+	failed := slices.ContainsFunc(hunks, func(h HunkResult) bool { return !h.Applied })
+	if result.file.Action == PatchActionDeleted && content != "" && !failed {
+		result.file.Hunks = failHunks(p.Hunks, "the hunks do not remove every line of the file, so it cannot be deleted")
+	}
+	result.content = content
+
+	if content != original || result.file.Action != PatchActionModified {
+		result.file.PatchInfo.Patch = diff.GeneratePatch(filepath.Base(path), original, content)
+		result.file.PatchInfo.LinesAdded, result.file.PatchInfo.LinesRemoved = parseDiffStats(result.file.PatchInfo.Patch)
+	}
+	return result, nil
+}
+
+func failHunks(hunks []Hunk, reason string) []HunkResult {
+	results := make([]HunkResult, 0, len(hunks))
+	for i := range hunks {
+		results = append(results, HunkResult{Hunk: i + 1, Error: reason})
+	}
+	return results
+}
+
+func writePatchedFile(fsys afero.Fs, result patchedContent) error {
+	path := result.file.Path
+	if result.file.Action == PatchActionDeleted {
+		if err := fsys.Remove(path); err != nil {
+			slog.Error("failed to delete file", "path", path, "error", err)
+			return base.NewCustomError("error deleting file", []string{
+				"Ensure that you have the permission to delete the file",
+			}, "path", path, "error", err)
+		}
+		return nil
+	}
+
+	if err := fsys.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		slog.Error("failed to create parent directory", "path", path, "error", err)
+		return base.NewCustomError("could not create the parent directory", []string{
+			"Verify that you have the permissions to create the parent directories",
+		}, "path", path, "error", err)
+	}
+
+	if err := afero.WriteFile(fsys, path, []byte(result.content), result.mode); err != nil {
+		slog.Error("failed to write patched file", "path", path, "error", err)
+		return base.NewCustomError("error writing file", []string{
+			"Verify that you have the permission to write to the file",
+		}, "path", path, "error", err)
+	}
+	return nil
+}
+
+// applyHunks applies the hunks in order. A hunk is searched near the line of
+// its header first, then with whitespace differences ignored and finally with
+// up to maxFuzz context lines ignored at each end. Hunks that are not found
+// are reported and skipped.
+func applyHunks(content string, hunks []Hunk) (string, []HunkResult) {
+	crlf := strings.Contains(content, "\r\n")
+	if crlf {
+		content = strings.ReplaceAll(content, "\r\n", "\n")
+	}
+
+	var lines []string
+	if content != "" {
+		lines = strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+	}
+	endsWithNewline := content == "" || strings.HasSuffix(content, "\n")
+
+	var (
+		out     []string
+		results = make([]HunkResult, 0, len(hunks))
+		pos     int
+		offset  int
+	)
+	for i, hunk := range hunks {
+		expected := pos
+		if hunk.OldStart > 0 {
+			expected = hunk.OldStart - 1 + offset
+			if hunk.OldCount == 0 {
+				expected = hunk.OldStart + offset
+			}
+		}
+		expected = min(max(expected, pos), len(lines))
+
+		at, front, back, fuzzy := locateHunk(lines, pos, expected, hunk.Lines)
+		if at < 0 {
+			results = append(results, HunkResult{
+				Hunk:  i + 1,
+				Error: "the context and removed lines were not found in the file",
+			})
+			continue
+		}
+
+		out = append(out, lines[pos:at]...)
+		current := at
+		for _, line := range hunk.Lines[front : len(hunk.Lines)-back] {
+			switch line.Op {
+			case ' ':
+				out = append(out, lines[current])
+				current++
+			case '-':
+				current++
+			case '+':
+				out = append(out, line.Text)
+			}
+		}
+		pos = current
+
+		if back == 0 && current == len(lines) {
+			if hunk.NewNoNewline {
+				endsWithNewline = false
+			} else if hunk.OldNoNewline {
+				endsWithNewline = true
+			}
+		}
+
+		result := HunkResult{Hunk: i + 1, Applied: true, Line: at - front + 1, Fuzzy: fuzzy}
+		if hunk.OldStart > 0 {
+			start := hunk.OldStart - 1
+			if hunk.OldCount == 0 {
+				start = hunk.OldStart
+			}
+			result.Offset = at - front - start
+			offset = result.Offset
+		}
+		results = append(results, result)
+	}
+	out = append(out, lines[pos:]...)
+
+	patched := strings.Join(out, "\n")
+	if len(out) > 0 && endsWithNewline {
+		patched += "\n"
+	}
+	if crlf {
+		patched = strings.ReplaceAll(patched, "\n", "\r\n")
+	}
+	return patched, results
+}
+
+// locateHunk returns the index of the line the hunk applies at after front
+// leading and back trailing context lines were dropped, or -1.
+func locateHunk(lines []string, from, expected int, hunk []HunkLine) (at, front, back int, fuzzy bool) {
+	leading, trailing := contextLines(hunk)
+	for fuzz := 0; fuzz <= maxFuzz; fuzz++ {
+		front, back := min(fuzz, leading), min(fuzz, trailing)
+		if fuzz > 0 && front == min(fuzz-1, leading) && back == min(fuzz-1, trailing) {
+			// no further context lines to drop
+			break
+		}
+		if front+back >= len(hunk) {
+			break
+		}
+
+		var old []string
+		for _, line := range hunk[front : len(hunk)-back] {
+			if line.Op != '+' {
+				old = append(old, line.Text)
+			}
+		}
+		if fuzz > 0 && len(old) == 0 {
+			// an insertion is only placed next to at least one matched line
+			break
+		}
+
+		for _, normalize := range []bool{false, true} {
+			if at := searchLines(lines, from, expected+front, old, normalize); at >= 0 {
+				return at, front, back, fuzz > 0 || normalize
+			}
+		}
+	}
+	return -1, 0, 0, false
+}
+
+func contextLines(hunk []HunkLine) (leading, trailing int) {
+	for leading < len(hunk) && hunk[leading].Op == ' ' {
+		leading++
+	}
+	for trailing < len(hunk)-leading && hunk[len(hunk)-1-trailing].Op == ' ' {
+		trailing++
+	}
+	return leading, trailing
+}
+
+// searchLines finds old in lines at or after from, starting at expected and
+// moving outwards.
+func searchLines(lines []string, from, expected int, old []string, normalize bool) int {
+	last := len(lines) - len(old)
+	if last < from {
+		return -1
+	}
+	if len(old) == 0 {
+		return min(max(expected, from), len(lines))
+	}
+
+	expected = min(max(expected, from), last)
+	for distance := 0; expected-distance >= from || expected+distance <= last; distance++ {
+		for _, candidate := range []int{expected - distance, expected + distance} {
+			if candidate >= from && candidate <= last && linesMatch(lines[candidate:candidate+len(old)], old, normalize) {
+				return candidate
+			}
+		}
+	}
+	return -1
+}
+
+func linesMatch(lines, old []string, normalize bool) bool {
+	for i := range old {
+		if lines[i] == old[i] {
+			continue
+		}
+		if !normalize || strings.Join(strings.Fields(lines[i]), " ") != strings.Join(strings.Fields(old[i]), " ") {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,365 @@
+package filesystem
+
+import (
+	"context"
+	"testing"
+
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/spf13/afero"
+)
+
+func TestApplyPatch(t *testing.T) {
+	setup := &base.ToolTestSetup[*ApplyPatchInput, *ApplyPatchResult]{
+		Call: func(ctx context.Context, services *base.ToolTestServices, input *ApplyPatchInput) (*ApplyPatchResult, error) {
+			return ApplyPatch(services.FS, input)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreFields(PatchedFile{}, "Fingerprint"),
+			cmpopts.IgnoreFields(base.ToolError{}, "Suggestions", "Message"),
+			cmpopts.IgnoreFields(PatchInfo{}, "Patch"),
+		},
+	}
+
+	setup.RunToolTests(t, []base.ToolTestScenario[*ApplyPatchInput, *ApplyPatchResult]{
+		{
+			Name: "modify file",
+			TestInput: &ApplyPatchInput{Patch: `--- /workspace/main.go
++++ /workspace/main.go
+@@ -1,5 +1,5 @@
+ package main
+
+ func main() {
+-	println("hello")
++	println("hello, world")
+ }
+`},
+			SeedFilesystem:  seedPatchTestFilesystem,
+			QueryFilesystem: queryWorkspaceFiles,
+			Expected: base.ToolTestExpectation[*ApplyPatchResult]{
+				Result: &ApplyPatchResult{Files: []PatchedFile{{
+					Path:      "/workspace/main.go",
+					Action:    PatchActionModified,
+					PatchInfo: PatchInfo{LinesAdded: 1, LinesRemoved: 1},
+					Hunks:     []HunkResult{{Hunk: 1, Applied: true, Line: 1}},
+				}}},
+				Filesystem: map[string]string{
+					"/workspace/main.go":   "package main\n\nfunc main() {\n\tprintln(\"hello, world\")\n}\n",
+					"/workspace/notes.txt": "one\ntwo\nthree\nfour\nfive\nsix\nseven\neight\nnine\nten\n",
+				},
+			},
+		},
+		{
+			Name: "multiple files with creation and deletion",
+			TestInput: &ApplyPatchInput{
+				WorkingDirectory: "/workspace",
+				Patch: `diff --git a/notes.txt b/notes.txt
+index 3b18e51..a1b2c3d 100644
+--- a/notes.txt
++++ b/notes.txt
+@@ -1,3 +1,3 @@
+-one
++uno
+ two
+ three
+@@ -8,3 +8,4 @@
+ eight
+ nine
+ ten
++eleven
+--- /dev/null
++++ b/docs/README.md
+@@ -0,0 +1,2 @@
++# Example
++Some documentation.
+--- a/main.go
++++ /dev/null
+@@ -1,5 +0,0 @@
+-package main
+-
+-func main() {
+-	println("hello")
+-}
+`},
+			SeedFilesystem:  seedPatchTestFilesystem,
+			QueryFilesystem: queryWorkspaceFiles,
+			Expected: base.ToolTestExpectation[*ApplyPatchResult]{
+				Result: &ApplyPatchResult{Files: []PatchedFile{
+					{
+						Path:      "/workspace/notes.txt",
+						Action:    PatchActionModified,
+						PatchInfo: PatchInfo{LinesAdded: 2, LinesRemoved: 1},
+						Hunks: []HunkResult{
+							{Hunk: 1, Applied: true, Line: 1},
+							{Hunk: 2, Applied: true, Line: 8},
+						},
+					},
+					{
+						Path:      "/workspace/docs/README.md",
+						Action:    PatchActionCreated,
+						PatchInfo: PatchInfo{LinesAdded: 2},
+						Hunks:     []HunkResult{{Hunk: 1, Applied: true, Line: 1}},
+					},
+					{
+						Path:      "/workspace/main.go",
+						Action:    PatchActionDeleted,
+						PatchInfo: PatchInfo{LinesRemoved: 5},
+						Hunks:     []HunkResult{{Hunk: 1, Applied: true, Line: 1}},
+					},
+				}},
+				Filesystem: map[string]string{
+					"/workspace/notes.txt":      "uno\ntwo\nthree\nfour\nfive\nsix\nseven\neight\nnine\nten\neleven\n",
+					"/workspace/docs/README.md": "# Example\nSome documentation.\n",
+				},
+			},
+		},
+		{
+			Name: "hunk at offset",
+			TestInput: &ApplyPatchInput{Patch: `--- /workspace/notes.txt
++++ /workspace/notes.txt
+@@ -2,3 +2,3 @@
+ five
+-six
++SIX
+ seven
+`},
+			SeedFilesystem: seedPatchTestFilesystem,
+			Expected: base.ToolTestExpectation[*ApplyPatchResult]{
+				Result: &ApplyPatchResult{Files: []PatchedFile{{
+					Path:      "/workspace/notes.txt",
+					Action:    PatchActionModified,
+					PatchInfo: PatchInfo{LinesAdded: 1, LinesRemoved: 1},
+					Hunks:     []HunkResult{{Hunk: 1, Applied: true, Line: 5, Offset: 3}},
+				}}},
+			},
+		},
+		{
+			Name: "fuzzy match",
+			TestInput: &ApplyPatchInput{Patch: `--- /workspace/main.go
++++ /workspace/main.go
+@@
+ func main() {
+-    println("hello")
++    println("bye")
+ }
+ // trailing context that does not exist
+`},
+			SeedFilesystem:  seedPatchTestFilesystem,
+			QueryFilesystem: queryWorkspaceFiles,
+			Expected: base.ToolTestExpectation[*ApplyPatchResult]{
+				Result: &ApplyPatchResult{Files: []PatchedFile{{
+					Path:      "/workspace/main.go",
+					Action:    PatchActionModified,
+					PatchInfo: PatchInfo{LinesAdded: 1, LinesRemoved: 1},
+					Hunks:     []HunkResult{{Hunk: 1, Applied: true, Line: 3, Fuzzy: true}},
+				}}},
+				Filesystem: map[string]string{
+					"/workspace/main.go":   "package main\n\nfunc main() {\n    println(\"bye\")\n}\n",
+					"/workspace/notes.txt": "one\ntwo\nthree\nfour\nfive\nsix\nseven\neight\nnine\nten\n",
+				},
+			},
+		},
+		{
+			Name: "failed hunk leaves files unchanged",
+			TestInput: &ApplyPatchInput{Patch: `--- /workspace/notes.txt
++++ /workspace/notes.txt
+@@ -1,2 +1,2 @@
+-one
++uno
+ two
+@@ -5,2 +5,2 @@
+-missing
++present
+ six
+`},
+			SeedFilesystem:  seedPatchTestFilesystem,
+			QueryFilesystem: queryWorkspaceFiles,
+			Expected: base.ToolTestExpectation[*ApplyPatchResult]{
+				Error: &base.ToolError{Details: map[string]any{}},
+				Filesystem: map[string]string{
+					"/workspace/main.go":   "package main\n\nfunc main() {\n\tprintln(\"hello\")\n}\n",
+					"/workspace/notes.txt": "one\ntwo\nthree\nfour\nfive\nsix\nseven\neight\nnine\nten\n",
+				},
+			},
+		},
+		{
+			Name: "dry run reports failed hunks",
+			TestInput: &ApplyPatchInput{DryRun: true, Patch: `--- /workspace/notes.txt
++++ /workspace/notes.txt
+@@ -1,2 +1,2 @@
+-one
++uno
+ two
+@@ -5,2 +5,2 @@
+-missing
++present
+ six
+`},
+			SeedFilesystem:  seedPatchTestFilesystem,
+			QueryFilesystem: queryWorkspaceFiles,
+			Expected: base.ToolTestExpectation[*ApplyPatchResult]{
+				Result: &ApplyPatchResult{DryRun: true, Files: []PatchedFile{{
+					Path:      "/workspace/notes.txt",
+					Action:    PatchActionModified,
+					PatchInfo: PatchInfo{LinesAdded: 1, LinesRemoved: 1},
+					Hunks: []HunkResult{
+						{Hunk: 1, Applied: true, Line: 1},
+						{Hunk: 2, Error: "the context and removed lines were not found in the file"},
+					},
+				}}},
+				Filesystem: map[string]string{
+					"/workspace/main.go":   "package main\n\nfunc main() {\n\tprintln(\"hello\")\n}\n",
+					"/workspace/notes.txt": "one\ntwo\nthree\nfour\nfive\nsix\nseven\neight\nnine\nten\n",
+				},
+			},
+		},
+		{
+			Name: "insertion without matching context",
+			TestInput: &ApplyPatchInput{DryRun: true, Patch: `--- /workspace/notes.txt
++++ /workspace/notes.txt
+@@ -3,2 +3,3 @@
+ missing
++inserted
+ absent
+`},
+			SeedFilesystem: seedPatchTestFilesystem,
+			Expected: base.ToolTestExpectation[*ApplyPatchResult]{
+				Result: &ApplyPatchResult{DryRun: true, Files: []PatchedFile{{
+					Path:   "/workspace/notes.txt",
+					Action: PatchActionModified,
+					Hunks:  []HunkResult{{Hunk: 1, Error: "the context and removed lines were not found in the file"}},
+				}}},
+			},
+		},
+		{
+			Name: "deleting a file that keeps lines",
+			TestInput: &ApplyPatchInput{Patch: `--- /workspace/notes.txt
++++ /dev/null
+@@ -1,2 +0,0 @@
+-one
+-two
+`},
+			SeedFilesystem:  seedPatchTestFilesystem,
+			QueryFilesystem: queryWorkspaceFiles,
+			Expected: base.ToolTestExpectation[*ApplyPatchResult]{
+				Error: &base.ToolError{Details: map[string]any{}},
+				Filesystem: map[string]string{
+					"/workspace/main.go":   "package main\n\nfunc main() {\n\tprintln(\"hello\")\n}\n",
+					"/workspace/notes.txt": "one\ntwo\nthree\nfour\nfive\nsix\nseven\neight\nnine\nten\n",
+				},
+			},
+		},
+		{
+			Name: "create existing file",
+			TestInput: &ApplyPatchInput{DryRun: true, Patch: `--- /dev/null
++++ /workspace/main.go
+@@ -0,0 +1 @@
++package main
+`},
+			SeedFilesystem: seedPatchTestFilesystem,
+			Expected: base.ToolTestExpectation[*ApplyPatchResult]{
+				Result: &ApplyPatchResult{DryRun: true, Files: []PatchedFile{{
+					Path:   "/workspace/main.go",
+					Action: PatchActionCreated,
+					Hunks:  []HunkResult{{Hunk: 1, Error: "the file already exists, patch it instead of creating it"}},
+				}}},
+			},
+		},
+		{
+			Name: "relative path without working directory",
+			TestInput: &ApplyPatchInput{Patch: `--- a/main.go
++++ b/main.go
+@@ -1 +1 @@
+-package main
++package app
+`},
+			Expected: base.ToolTestExpectation[*ApplyPatchResult]{
+				Error: base.NewError(base.PathIsNotAbsolute, "path", "main.go"),
+			},
+		},
+		{
+			Name:      "invalid patch",
+			TestInput: &ApplyPatchInput{Patch: "replace hello with bye"},
+			Expected: base.ToolTestExpectation[*ApplyPatchResult]{
+				Error: &base.ToolError{Details: map[string]any{}},
+			},
+		},
+	})
+}
+
+func TestApplyHunks(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		patch    string
+		expected string
+	}{
+		{
+			name:    "crlf line endings",
+			content: "a\r\nb\r\nc\r\n",
+			patch: `--- /f
++++ /f
+@@ -1,3 +1,3 @@
+ a
+-b
++B
+ c
+`,
+			expected: "a\r\nB\r\nc\r\n",
+		},
+		{
+			name:    "no newline at end of file",
+			content: "a\nb",
+			patch: `--- /f
++++ /f
+@@ -1,2 +1,2 @@
+ a
+-b
+\ No newline at end of file
++b
+`,
+			expected: "a\nb\n",
+		},
+		{
+			name:    "insertion after line",
+			content: "a\nb\nc\n",
+			patch: `--- /f
++++ /f
+@@ -2,0 +3 @@
++inserted
+`,
+			expected: "a\nb\ninserted\nc\n",
+		},
+		{
+			name:     "empty context line without leading space",
+			content:  "a\n\nb\n",
+			patch:    "--- /f\n+++ /f\n@@ -1,3 +1,3 @@\n a\n\n-b\n+B\n\n",
+			expected: "a\n\nB\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patches, err := ParsePatch(tt.patch)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			patched, results := applyHunks(tt.content, patches[0].Hunks)
+			for _, result := range results {
+				if !result.Applied {
+					t.Fatalf("hunk %d was not applied: %s", result.Hunk, result.Error)
+				}
+			}
+			if patched != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, patched)
+			}
+		})
+	}
+}
+
+func seedPatchTestFilesystem(ctx context.Context, fs afero.Fs) {
+	afero.WriteFile(fs, "/workspace/main.go", []byte("package main\n\nfunc main() {\n\tprintln(\"hello\")\n}\n"), 0644)
+	afero.WriteFile(fs, "/workspace/notes.txt", []byte("one\ntwo\nthree\nfour\nfive\nsix\nseven\neight\nnine\nten\n"), 0644)
+}
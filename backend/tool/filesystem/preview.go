@@ -0,0 +1,111 @@
+package filesystem
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/afero"
+
+	"github.com/furisto/construct/backend/memory/schema/types"
+)
+
+// PreviewOverlay stages the files of a task in preview mode on top of the
+// base file system, so the scripts of the task read their own changes.
+func PreviewOverlay(base afero.Fs, preview *types.TaskPreview) (*Overlay, error) {
+	overlay := NewOverlay(base)
+	for _, file := range preview.Files {
+		if err := overlay.Stage(file.Path, file.Content); err != nil {
+			return nil, fmt.Errorf("failed to stage %s: %w", file.Path, err)
+		}
+	}
+	return overlay, nil
+}
+
+// PreviewFiles returns the files staged by the overlay in lexical order.
+// Files that were part of the previous preview keep their base hash, the
+// base hash of files that were staged since is taken from the base file
+// system.
+func PreviewFiles(overlay *Overlay, previous *types.TaskPreview) ([]types.PreviewFile, error) {
+	paths, err := overlay.Files()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the staged files: %w", err)
+	}
+
+	baseHashes := make(map[string]string)
+	if previous != nil {
+		for _, file := range previous.Files {
+			baseHashes[file.Path] = file.BaseHash
+		}
+	}
+
+	files := make([]types.PreviewFile, 0, len(paths))
+	for _, path := range paths {
+		content, err := afero.ReadFile(overlay.staged, path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read staged file %s: %w", path, err)
+		}
+
+		baseHash, ok := baseHashes[path]
+		if !ok {
+			if baseHash, err = fileHash(overlay.Base(), path); err != nil {
+				return nil, err
+			}
+		}
+		files = append(files, types.PreviewFile{Path: path, Content: string(content), BaseHash: baseHash})
+	}
+	return files, nil
+}
+
+// PreviewConflictError lists the files that changed on disk since they were
+// staged by a preview.
+type PreviewConflictError struct {
+	Paths []string
+}
+
+func (e *PreviewConflictError) Error() string {
+	return fmt.Sprintf("%s changed since the preview was created", strings.Join(e.Paths, ", "))
+}
+
+// CheckPreviewBase returns a PreviewConflictError if files of the preview no
+// longer have the content they had on the base file system when they were
+// staged.
+func CheckPreviewBase(base afero.Fs, preview *types.TaskPreview) error {
+	var conflicts []string
+	for _, file := range preview.Files {
+		hash, err := fileHash(base, file.Path)
+		if err != nil {
+			return err
+		}
+		if hash != file.BaseHash {
+			conflicts = append(conflicts, file.Path)
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return &PreviewConflictError{Paths: conflicts}
+	}
+	return nil
+}
+
+// HashContent returns the hex encoded SHA-256 hash of the content.
+func HashContent(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// fileHash returns the hash of the content of the file, or an empty string
+// if it does not exist.
+func fileHash(fsys afero.Fs, path string) (string, error) {
+	content, err := afero.ReadFile(fsys, path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return HashContent(content), nil
+}
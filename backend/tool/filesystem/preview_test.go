@@ -0,0 +1,68 @@
+package filesystem
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/afero"
+
+	"github.com/furisto/construct/backend/memory/schema/types"
+)
+
+func TestPreviewBase(t *testing.T) {
+	base := afero.NewMemMapFs()
+	if err := afero.WriteFile(base, "/workspace/main.go", []byte("func foo() {}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	overlay := NewOverlay(base)
+	if err := afero.WriteFile(overlay.Fs(), "/workspace/main.go", []byte("func bar() {}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := overlay.Stage("/workspace/README.md", "# Example\n"); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := PreviewFiles(overlay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []types.PreviewFile{
+		{Path: "/workspace/README.md", Content: "# Example\n"},
+		{Path: "/workspace/main.go", Content: "func bar() {}\n", BaseHash: HashContent([]byte("func foo() {}\n"))},
+	}
+	if diff := cmp.Diff(expected, files); diff != "" {
+		t.Errorf("PreviewFiles() mismatch (-want +got):\n%s", diff)
+	}
+	preview := &types.TaskPreview{Files: files}
+
+	if err := CheckPreviewBase(base, preview); err != nil {
+		t.Errorf("expected an unchanged base to pass, got %v", err)
+	}
+
+	// the user edits the file while the preview is pending
+	if err := afero.WriteFile(base, "/workspace/main.go", []byte("func baz() {}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	restored, err := PreviewOverlay(base, preview)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err = PreviewFiles(restored, preview)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(expected, files); diff != "" {
+		t.Errorf("expected the base hashes of the previous preview to be kept (-want +got):\n%s", diff)
+	}
+
+	var conflict *PreviewConflictError
+	if err := CheckPreviewBase(base, preview); !errors.As(err, &conflict) {
+		t.Fatalf("expected a conflict, got %v", err)
+	}
+	if diff := cmp.Diff([]string{"/workspace/main.go"}, conflict.Paths); diff != "" {
+		t.Errorf("conflicting paths mismatch (-want +got):\n%s", diff)
+	}
+}
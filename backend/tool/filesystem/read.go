@@ -2,7 +2,9 @@ package filesystem
 
 import (
 	"bufio"
+	"bytes"
 	"fmt"
+	"io"
 	"log/slog"
 	"os"
 	"path/filepath"
@@ -54,6 +56,9 @@ func (input *ReadFileInput) Validate() error {
 type ReadFileResult struct {
 	Path    string `json:"path"`
 	Content string `json:"content"`
+	// Fingerprint identifies the whole content that was read, even if only a
+	// range of lines was returned.
+	Fingerprint *Fingerprint `json:"-"`
 }
 
 func ReadFile(fsys afero.Fs, input *ReadFileInput) (*ReadFileResult, error) {
@@ -90,17 +95,26 @@ func ReadFile(fsys afero.Fs, input *ReadFileInput) (*ReadFileResult, error) {
 	}
 	defer file.Close()
 
+	content, err := io.ReadAll(file)
+	if err != nil {
+		slog.Error("failed to read file", "path", path, "error", err)
+		return nil, base.NewCustomError("error reading file", []string{
+			"Verify that you have the permission to read the file",
+		}, "path", path, "error", err)
+	}
+
 	// All reading uses range logic (entire file is just range from 1 to end)
-	result, err := readFileRange(path, file, input.StartLine, input.EndLine)
+	result, err := readFileRange(path, bytes.NewReader(content), input.StartLine, input.EndLine)
 	if err != nil {
 		return nil, err
 	}
+	result.Fingerprint = NewFingerprint(stat, content)
 
 	slog.Debug("file read successfully", "path", path, "content_size", len(result.Content), "has_range", input.StartLine != nil || input.EndLine != nil)
 	return result, nil
 }
 
-func readFileRange(path string, file afero.File, startLine, endLine *int) (*ReadFileResult, error) {
+func readFileRange(path string, file io.Reader, startLine, endLine *int) (*ReadFileResult, error) {
 	scanner := bufio.NewScanner(file)
 	var builder strings.Builder
 
@@ -19,6 +19,7 @@ func TestReadFile(t *testing.T) {
 			return ReadFile(services.FS, input)
 		},
 		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreFields(ReadFileResult{}, "Fingerprint"),
 			cmpopts.IgnoreFields(base.ToolError{}, "Suggestions"),
 		},
 	}
@@ -0,0 +1,118 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type BlameInput struct {
+	Path      string `json:"path"`
+	StartLine int    `json:"start_line,omitempty"`
+	EndLine   int    `json:"end_line,omitempty"`
+	// Rev blames the file as of a revision instead of the working tree.
+	Rev string `json:"rev,omitempty"`
+
+	WorkingDirectory string `json:"-"`
+}
+
+func (*BlameInput) Operation() string   { return "blame" }
+func (*BlameInput) Modifies() bool      { return false }
+func (*BlameInput) Destructive() string { return "" }
+
+type BlameResult struct {
+	Path  string      `json:"path"`
+	Lines []BlameLine `json:"lines"`
+}
+
+// BlameLine is a line of the file and the commit that last changed it. Lines
+// that are not committed yet have an all zero hash.
+type BlameLine struct {
+	Line    int    `json:"line"`
+	Hash    string `json:"hash"`
+	Author  string `json:"author"`
+	Date    string `json:"date"`
+	Summary string `json:"summary"`
+	Content string `json:"content"`
+}
+
+func Blame(ctx context.Context, input *BlameInput) (*BlameResult, error) {
+	if input.Path == "" {
+		return nil, invalidInput("path is required")
+	}
+	if input.StartLine < 0 || input.EndLine < 0 || (input.EndLine > 0 && input.EndLine < input.StartLine) {
+		return nil, invalidInput("start_line and end_line must be a valid line range")
+	}
+	if err := checkRevision("rev", input.Rev); err != nil {
+		return nil, err
+	}
+
+	args := []string{"blame", "--porcelain"}
+	if input.StartLine > 0 || input.EndLine > 0 {
+		start := max(input.StartLine, 1)
+		if input.EndLine > 0 {
+			args = append(args, fmt.Sprintf("-L%d,%d", start, input.EndLine))
+		} else {
+			args = append(args, fmt.Sprintf("-L%d,", start))
+		}
+	}
+	if input.Rev != "" {
+		args = append(args, input.Rev)
+	}
+	args = append(args, "--", input.Path)
+
+	output, err := run(ctx, input.WorkingDirectory, "", args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BlameResult{Path: input.Path, Lines: parseBlame(output)}, nil
+}
+
+// parseBlame parses the output of git blame --porcelain. The commit headers
+// are only printed for the first line of each commit and are remembered for
+// the following lines.
+func parseBlame(output string) []BlameLine {
+	type commitInfo struct {
+		author, date, summary string
+	}
+
+	commits := map[string]*commitInfo{}
+	lines := []BlameLine{}
+
+	var current *BlameLine
+	for _, line := range strings.Split(output, "\n") {
+		if current == nil {
+			fields := strings.Fields(line)
+			if len(fields) < 3 {
+				continue
+			}
+			current = &BlameLine{Hash: fields[0], Line: atoi(fields[2])}
+			if commits[current.Hash] == nil {
+				commits[current.Hash] = &commitInfo{}
+			}
+			continue
+		}
+
+		info := commits[current.Hash]
+		switch {
+		case strings.HasPrefix(line, "\t"):
+			current.Content = line[1:]
+			current.Author, current.Date, current.Summary = info.author, info.date, info.summary
+			lines = append(lines, *current)
+			current = nil
+		case strings.HasPrefix(line, "author "):
+			info.author = strings.TrimPrefix(line, "author ")
+		case strings.HasPrefix(line, "author-time "):
+			if seconds, err := strconv.ParseInt(strings.TrimPrefix(line, "author-time "), 10, 64); err == nil {
+				info.date = time.Unix(seconds, 0).UTC().Format(time.RFC3339)
+			}
+		case strings.HasPrefix(line, "summary "):
+			info.summary = strings.TrimPrefix(line, "summary ")
+		}
+	}
+
+	return lines
+}
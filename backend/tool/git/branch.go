@@ -0,0 +1,131 @@
+package git
+
+import (
+	"context"
+	"strings"
+)
+
+// BranchInput lists the local branches if no action is set. Create, Checkout
+// and Delete are mutually exclusive.
+type BranchInput struct {
+	Create string `json:"create,omitempty"`
+	// From is the start point of a created branch, HEAD by default.
+	From string `json:"from,omitempty"`
+	// Switch checks out the created branch.
+	Switch   bool   `json:"switch,omitempty"`
+	Checkout string `json:"checkout,omitempty"`
+	Delete   string `json:"delete,omitempty"`
+	// Force deletes a branch even if it is not merged.
+	Force bool `json:"force,omitempty"`
+
+	WorkingDirectory string `json:"-"`
+}
+
+func (*BranchInput) Operation() string { return "branch" }
+
+func (i *BranchInput) Modifies() bool {
+	return i.Create != "" || i.Checkout != "" || i.Delete != ""
+}
+
+func (i *BranchInput) Destructive() string {
+	if i.Delete != "" && i.Force {
+		return "force deleting a branch discards its unmerged commits"
+	}
+	return ""
+}
+
+type BranchResult struct {
+	Current  string   `json:"current,omitempty"`
+	Branches []Branch `json:"branches"`
+}
+
+type Branch struct {
+	Name     string `json:"name"`
+	Commit   string `json:"commit"`
+	Subject  string `json:"subject"`
+	Upstream string `json:"upstream,omitempty"`
+	// Tracking describes the relation to the upstream, e.g. "ahead 1, behind 2"
+	// or "gone".
+	Tracking string `json:"tracking,omitempty"`
+	Current  bool   `json:"current,omitempty"`
+}
+
+func Branches(ctx context.Context, input *BranchInput) (*BranchResult, error) {
+	actions := 0
+	for _, name := range []string{input.Create, input.Checkout, input.Delete} {
+		if name == "" {
+			continue
+		}
+		actions++
+		if err := checkRevision("branch name", name); err != nil {
+			return nil, err
+		}
+	}
+	if actions > 1 {
+		return nil, invalidInput("only one of create, checkout and delete can be set")
+	}
+	if err := checkRevision("from", input.From); err != nil {
+		return nil, err
+	}
+	if input.From != "" && input.Create == "" {
+		return nil, invalidInput("from requires create")
+	}
+
+	var err error
+	switch {
+	case input.Create != "":
+		args := []string{"branch", input.Create}
+		if input.Switch {
+			args = []string{"switch", "-c", input.Create}
+		}
+		if input.From != "" {
+			args = append(args, input.From)
+		}
+		_, err = run(ctx, input.WorkingDirectory, "", args...)
+	case input.Checkout != "":
+		_, err = run(ctx, input.WorkingDirectory, "", "switch", input.Checkout)
+	case input.Delete != "":
+		flag := "-d"
+		if input.Force {
+			flag = "-D"
+		}
+		_, err = run(ctx, input.WorkingDirectory, "", "branch", flag, input.Delete)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := run(ctx, input.WorkingDirectory, "", "for-each-ref",
+		"--format=%(refname:short)%1f%(objectname:short)%1f%(contents:subject)%1f%(upstream:short)%1f%(upstream:track,nobracket)%1f%(HEAD)",
+		"refs/heads",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseBranches(output), nil
+}
+
+func parseBranches(output string) *BranchResult {
+	result := &BranchResult{Branches: []Branch{}}
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Split(line, "\x1f")
+		if len(fields) != 6 {
+			continue
+		}
+
+		branch := Branch{
+			Name:     fields[0],
+			Commit:   fields[1],
+			Subject:  fields[2],
+			Upstream: fields[3],
+			Tracking: fields[4],
+			Current:  fields[5] == "*",
+		}
+		if branch.Current {
+			result.Current = branch.Name
+		}
+		result.Branches = append(result.Branches, branch)
+	}
+	return result
+}
@@ -0,0 +1,91 @@
+package git
+
+import (
+	"context"
+	"strings"
+)
+
+type CommitInput struct {
+	Message  string   `json:"message"`
+	Trailers Trailers `json:"trailers,omitempty"`
+	// All stages all changes of tracked files before committing.
+	All bool `json:"all,omitempty"`
+	// Paths are staged before committing, including deletions.
+	Paths      []string `json:"paths,omitempty"`
+	Amend      bool     `json:"amend,omitempty"`
+	AllowEmpty bool     `json:"allow_empty,omitempty"`
+
+	WorkingDirectory string `json:"-"`
+}
+
+func (*CommitInput) Operation() string   { return "commit" }
+func (*CommitInput) Modifies() bool      { return true }
+func (*CommitInput) Destructive() string { return "" }
+
+type CommitResult struct {
+	Commit     Commit     `json:"commit"`
+	Branch     string     `json:"branch,omitempty"`
+	Files      []DiffFile `json:"files"`
+	Insertions int        `json:"insertions"`
+	Deletions  int        `json:"deletions"`
+}
+
+func CreateCommit(ctx context.Context, input *CommitInput) (*CommitResult, error) {
+	if strings.TrimSpace(input.Message) == "" && !input.Amend {
+		return nil, invalidInput("message is required")
+	}
+	for _, trailer := range input.Trailers {
+		if key, value, ok := strings.Cut(trailer, ":"); !ok || strings.TrimSpace(key) == "" || strings.TrimSpace(value) == "" {
+			return nil, invalidInput("trailers must have the form \"Key: value\", got " + trailer)
+		}
+	}
+
+	if len(input.Paths) > 0 {
+		args := append([]string{"add", "--all", "--"}, input.Paths...)
+		if _, err := run(ctx, input.WorkingDirectory, "", args...); err != nil {
+			return nil, err
+		}
+	}
+
+	args := []string{"commit", "--quiet"}
+	if input.All {
+		args = append(args, "--all")
+	}
+	if input.Amend {
+		args = append(args, "--amend")
+	}
+	if input.AllowEmpty {
+		args = append(args, "--allow-empty")
+	}
+
+	message := input.Message
+	if strings.TrimSpace(message) == "" {
+		args = append(args, "--no-edit")
+	} else {
+		args = append(args, "--file", "-")
+	}
+	for _, trailer := range input.Trailers {
+		args = append(args, "--trailer", trailer)
+	}
+
+	if _, err := run(ctx, input.WorkingDirectory, message, args...); err != nil {
+		return nil, err
+	}
+
+	shown, err := Show(ctx, &ShowInput{StatOnly: true, WorkingDirectory: input.WorkingDirectory})
+	if err != nil {
+		return nil, err
+	}
+	branch, err := run(ctx, input.WorkingDirectory, "", "branch", "--show-current")
+	if err != nil {
+		return nil, err
+	}
+
+	return &CommitResult{
+		Commit:     shown.Commit,
+		Branch:     strings.TrimSpace(branch),
+		Files:      shown.Files,
+		Insertions: shown.Insertions,
+		Deletions:  shown.Deletions,
+	}, nil
+}
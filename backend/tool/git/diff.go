@@ -0,0 +1,124 @@
+package git
+
+import (
+	"context"
+	"strings"
+)
+
+type DiffInput struct {
+	// Staged compares the index with HEAD instead of the working tree with
+	// the index.
+	Staged bool `json:"staged,omitempty"`
+	// From and To compare two revisions. If only From is set the working
+	// tree is compared with it.
+	From     string   `json:"from,omitempty"`
+	To       string   `json:"to,omitempty"`
+	Paths    []string `json:"paths,omitempty"`
+	StatOnly bool     `json:"stat_only,omitempty"`
+
+	WorkingDirectory string `json:"-"`
+}
+
+func (*DiffInput) Operation() string   { return "diff" }
+func (*DiffInput) Modifies() bool      { return false }
+func (*DiffInput) Destructive() string { return "" }
+
+type DiffResult struct {
+	Files      []DiffFile `json:"files"`
+	Insertions int        `json:"insertions"`
+	Deletions  int        `json:"deletions"`
+	Patch      string     `json:"patch,omitempty"`
+	Truncated  bool       `json:"truncated,omitempty"`
+}
+
+type DiffFile struct {
+	Path string `json:"path"`
+	// OldPath is set for renamed files.
+	OldPath    string `json:"old_path,omitempty"`
+	Insertions int    `json:"insertions"`
+	Deletions  int    `json:"deletions"`
+	Binary     bool   `json:"binary,omitempty"`
+}
+
+func Diff(ctx context.Context, input *DiffInput) (*DiffResult, error) {
+	if input.To != "" && input.From == "" {
+		return nil, invalidInput("to requires from")
+	}
+	if input.Staged && input.To != "" {
+		return nil, invalidInput("staged cannot be combined with to")
+	}
+
+	if err := checkRevision("from", input.From); err != nil {
+		return nil, err
+	}
+	if err := checkRevision("to", input.To); err != nil {
+		return nil, err
+	}
+
+	args := []string{"diff", "-M"}
+	if input.Staged {
+		args = append(args, "--cached")
+	}
+	if input.From != "" {
+		args = append(args, input.From)
+	}
+	if input.To != "" {
+		args = append(args, input.To)
+	}
+
+	paths := []string{"--"}
+	paths = append(paths, input.Paths...)
+
+	stat, err := run(ctx, input.WorkingDirectory, "", append(append(args, "--numstat", "-z"), paths...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DiffResult{Files: parseNumstat(stat)}
+	for _, file := range result.Files {
+		result.Insertions += file.Insertions
+		result.Deletions += file.Deletions
+	}
+
+	if input.StatOnly || len(result.Files) == 0 {
+		return result, nil
+	}
+
+	patch, err := run(ctx, input.WorkingDirectory, "", append(args, paths...)...)
+	if err != nil {
+		return nil, err
+	}
+	result.Patch, result.Truncated = truncatePatch(patch)
+
+	return result, nil
+}
+
+// parseNumstat parses the output of git diff --numstat -z. Renamed files are
+// reported with an empty path followed by the old and the new path.
+func parseNumstat(output string) []DiffFile {
+	files := []DiffFile{}
+
+	records := strings.Split(output, "\x00")
+	for i := 0; i < len(records); i++ {
+		// With --numstat -z records of log and show start with a newline.
+		fields := strings.SplitN(strings.TrimLeft(records[i], "\n"), "\t", 3)
+		if len(fields) != 3 {
+			continue
+		}
+
+		file := DiffFile{
+			Insertions: atoi(fields[0]),
+			Deletions:  atoi(fields[1]),
+			Binary:     fields[0] == "-" && fields[1] == "-",
+			Path:       fields[2],
+		}
+		if file.Path == "" && i+2 < len(records) {
+			file.OldPath = records[i+1]
+			file.Path = records[i+2]
+			i += 2
+		}
+		files = append(files, file)
+	}
+
+	return files
+}
@@ -0,0 +1,169 @@
+// Package git runs git in the project directory of a task and parses its
+// machine readable output into structured results.
+package git
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/furisto/construct/backend/tool/base"
+)
+
+// MaxPatchBytes is the size a patch returned by diff and show is truncated to.
+const MaxPatchBytes = 256 << 10
+
+// Input is the input of an operation of the git tool family.
+type Input interface {
+	// Operation is the name of the operation, e.g. status or commit.
+	Operation() string
+	// Modifies reports whether the operation changes the repository or the
+	// working tree.
+	Modifies() bool
+	// Destructive returns why the operation discards work that cannot be
+	// recovered, or an empty string if it does not.
+	Destructive() string
+}
+
+// CallInput records a call of an operation. Arguments is the typed input of
+// the operation.
+type CallInput struct {
+	Operation string `json:"operation"`
+	Arguments any    `json:"arguments"`
+}
+
+// CallResult records the result of an operation.
+type CallResult struct {
+	Operation string `json:"operation"`
+	Output    any    `json:"output"`
+}
+
+// Run runs the operation of the input.
+func Run(ctx context.Context, input Input) (any, error) {
+	switch input := input.(type) {
+	case *StatusInput:
+		return Status(ctx, input)
+	case *DiffInput:
+		return Diff(ctx, input)
+	case *LogInput:
+		return Log(ctx, input)
+	case *ShowInput:
+		return Show(ctx, input)
+	case *BlameInput:
+		return Blame(ctx, input)
+	case *BranchInput:
+		return Branches(ctx, input)
+	case *StashInput:
+		return Stash(ctx, input)
+	case *CommitInput:
+		return CreateCommit(ctx, input)
+	}
+	return nil, invalidInput(fmt.Sprintf("unknown git operation %s", input.Operation()))
+}
+
+// Trailers are commit message trailers such as "Co-authored-by: name <mail>".
+// They decode from a list of "Key: value" strings or from an object mapping
+// keys to values.
+type Trailers []string
+
+func (t *Trailers) UnmarshalJSON(data []byte) error {
+	var list []string
+	if err := json.Unmarshal(data, &list); err == nil {
+		*t = list
+		return nil
+	}
+
+	var object map[string]string
+	if err := json.Unmarshal(data, &object); err != nil {
+		return fmt.Errorf("trailers must be a list of \"Key: value\" strings or an object of strings")
+	}
+
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	trailers := make([]string, 0, len(keys))
+	for _, key := range keys {
+		trailers = append(trailers, key+": "+object[key])
+	}
+	*t = trailers
+	return nil
+}
+
+// run executes git in dir and returns its standard output. Optional locks are
+// disabled so that read-only operations do not interfere with git commands the
+// user runs at the same time.
+func run(ctx context.Context, dir string, stdin string, args ...string) (string, error) {
+	if dir == "" {
+		return "", base.NewCustomError("the git tools require a project directory", []string{
+			"Use execute_command to run git in a specific directory",
+		})
+	}
+
+	cmd := exec.CommandContext(ctx, "git", append([]string{"--no-pager", "-c", "color.ui=never", "-c", "core.quotepath=off"}, args...)...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0", "GIT_OPTIONAL_LOCKS=0", "LC_ALL=C")
+	if stdin != "" {
+		cmd.Stdin = strings.NewReader(stdin)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return "", base.NewCustomError("git is not installed", []string{
+				"Ask the user to install git",
+			})
+		}
+
+		output := strings.TrimSpace(strings.TrimSpace(stderr.String()) + "\n" + strings.TrimSpace(stdout.String()))
+		return "", base.NewCustomError(fmt.Sprintf("git %s failed", args[0]), []string{
+			"Read the output of git to find out why the operation failed",
+			"Check the state of the repository with git.status()",
+		}, "command", "git "+strings.Join(args, " "), "output", output)
+	}
+
+	return stdout.String(), nil
+}
+
+func invalidInput(message string) error {
+	return base.NewCustomError(fmt.Sprintf("%s: %s", base.InvalidInput.String(), message), []string{
+		"Ensure that you provide the correct input arguments as specified in the tool description",
+	})
+}
+
+// truncatePatch cuts the patch at the last line that fits into MaxPatchBytes.
+func truncatePatch(patch string) (string, bool) {
+	if len(patch) <= MaxPatchBytes {
+		return patch, false
+	}
+
+	cut := strings.LastIndexByte(patch[:MaxPatchBytes], '\n')
+	if cut < 0 {
+		cut = MaxPatchBytes
+	}
+	return patch[:cut+1], true
+}
+
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+// checkRevision rejects revisions that git would interpret as options.
+func checkRevision(field, rev string) error {
+	if strings.HasPrefix(rev, "-") {
+		return invalidInput(field + " must not start with a dash")
+	}
+	return nil
+}
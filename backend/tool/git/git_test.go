@@ -0,0 +1,330 @@
+package git
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// newRepository creates a repository with a single commit containing main.go
+// and README.md.
+func newRepository(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	t.Setenv("GIT_CONFIG_GLOBAL", os.DevNull)
+	t.Setenv("GIT_CONFIG_NOSYSTEM", "1")
+	t.Setenv("GIT_AUTHOR_NAME", "Ada")
+	t.Setenv("GIT_AUTHOR_EMAIL", "ada@example.com")
+	t.Setenv("GIT_COMMITTER_NAME", "Ada")
+	t.Setenv("GIT_COMMITTER_EMAIL", "ada@example.com")
+
+	dir := t.TempDir()
+	git(t, dir, "init", "--quiet", "--initial-branch", "main")
+	writeFile(t, dir, "main.go", "package main\n\nfunc main() {}\n")
+	writeFile(t, dir, "README.md", "# app\n")
+	git(t, dir, "add", ".")
+	git(t, dir, "commit", "--quiet", "-m", "Initial commit")
+	return dir
+}
+
+func git(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	output, err := run(context.Background(), dir, "", args...)
+	if err != nil {
+		t.Fatalf("git %s: %v", strings.Join(args, " "), err)
+	}
+	return output
+}
+
+func writeFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+var ignoreCommitIdentity = cmpopts.IgnoreFields(Commit{}, "Hash", "ShortHash", "Date", "Parents")
+
+func TestStatus(t *testing.T) {
+	dir := newRepository(t)
+	writeFile(t, dir, "main.go", "package main\n\nfunc main() { println() }\n")
+	writeFile(t, dir, "notes.txt", "todo\n")
+	git(t, dir, "mv", "README.md", "README")
+
+	result, err := Status(context.Background(), &StatusInput{WorkingDirectory: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &StatusResult{
+		Branch: "main",
+		Files: []StatusFile{
+			{Path: "README", OriginalPath: "README.md", Staged: "renamed"},
+			{Path: "main.go", Unstaged: "modified"},
+			{Path: "notes.txt", Untracked: true},
+		},
+	}
+	if diff := cmp.Diff(expected, result, cmpopts.IgnoreFields(StatusResult{}, "Commit")); diff != "" {
+		t.Errorf("status mismatch (-want +got):\n%s", diff)
+	}
+	if result.Commit == "" {
+		t.Error("expected the commit of HEAD")
+	}
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Input    DiffInput
+		Expected *DiffResult
+		Patch    string
+	}{
+		{
+			Name:  "working tree",
+			Input: DiffInput{},
+			Expected: &DiffResult{
+				Files:      []DiffFile{{Path: "main.go", Insertions: 1, Deletions: 1}},
+				Insertions: 1,
+				Deletions:  1,
+			},
+			Patch: "+func main() { println() }",
+		},
+		{
+			Name:  "staged",
+			Input: DiffInput{Staged: true},
+			Expected: &DiffResult{
+				Files:      []DiffFile{{Path: "docs.md", OldPath: "README.md"}},
+				Insertions: 0,
+				Deletions:  0,
+			},
+			Patch: "rename to docs.md",
+		},
+		{
+			Name:  "stat only",
+			Input: DiffInput{From: "HEAD", StatOnly: true},
+			Expected: &DiffResult{
+				Files: []DiffFile{
+					{Path: "docs.md", OldPath: "README.md"},
+					{Path: "main.go", Insertions: 1, Deletions: 1},
+				},
+				Insertions: 1,
+				Deletions:  1,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			dir := newRepository(t)
+			writeFile(t, dir, "main.go", "package main\n\nfunc main() { println() }\n")
+			git(t, dir, "mv", "README.md", "docs.md")
+
+			test.Input.WorkingDirectory = dir
+			result, err := Diff(context.Background(), &test.Input)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(test.Expected, result, cmpopts.IgnoreFields(DiffResult{}, "Patch")); diff != "" {
+				t.Errorf("diff mismatch (-want +got):\n%s", diff)
+			}
+			if !strings.Contains(result.Patch, test.Patch) || (test.Patch == "" && result.Patch != "") {
+				t.Errorf("expected patch containing %q, got %q", test.Patch, result.Patch)
+			}
+		})
+	}
+}
+
+func TestCommitAndLog(t *testing.T) {
+	dir := newRepository(t)
+	writeFile(t, dir, "main.go", "package main\n\nfunc main() { println() }\n")
+	writeFile(t, dir, "notes.txt", "todo\n")
+
+	committed, err := CreateCommit(context.Background(), &CommitInput{
+		Message:          "Print on start\n\nThe output shows that the program runs.",
+		Trailers:         Trailers{"Refs: #42"},
+		Paths:            []string{"main.go"},
+		WorkingDirectory: dir,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedCommit := Commit{
+		Author:   "Ada",
+		Email:    "ada@example.com",
+		Subject:  "Print on start",
+		Body:     "The output shows that the program runs.",
+		Trailers: []string{"Refs: #42"},
+	}
+	expected := &CommitResult{
+		Commit:     expectedCommit,
+		Branch:     "main",
+		Files:      []DiffFile{{Path: "main.go", Insertions: 1, Deletions: 1}},
+		Insertions: 1,
+		Deletions:  1,
+	}
+	if diff := cmp.Diff(expected, committed, ignoreCommitIdentity); diff != "" {
+		t.Errorf("commit mismatch (-want +got):\n%s", diff)
+	}
+
+	status, err := Status(context.Background(), &StatusInput{WorkingDirectory: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(status.Files) != 1 || status.Files[0].Path != "notes.txt" {
+		t.Errorf("expected only notes.txt to remain uncommitted, got %+v", status.Files)
+	}
+
+	log, err := Log(context.Background(), &LogInput{Count: 5, WorkingDirectory: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedLog := &LogResult{Commits: []Commit{
+		expectedCommit,
+		{Author: "Ada", Email: "ada@example.com", Subject: "Initial commit"},
+	}}
+	if diff := cmp.Diff(expectedLog, log, ignoreCommitIdentity); diff != "" {
+		t.Errorf("log mismatch (-want +got):\n%s", diff)
+	}
+	if log.Commits[0].Hash != committed.Commit.Hash || log.Commits[0].Parents[0] != log.Commits[1].Hash {
+		t.Errorf("expected the log to start with the new commit, got %+v", log.Commits)
+	}
+
+	shown, err := Show(context.Background(), &ShowInput{Rev: committed.Commit.ShortHash, WorkingDirectory: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if shown.Commit.Hash != committed.Commit.Hash || !strings.Contains(shown.Patch, "+func main() { println() }") {
+		t.Errorf("unexpected show result %+v", shown)
+	}
+}
+
+func TestCommitErrors(t *testing.T) {
+	dir := newRepository(t)
+
+	_, err := CreateCommit(context.Background(), &CommitInput{Message: " ", WorkingDirectory: dir})
+	if err == nil || !strings.Contains(err.Error(), base.InvalidInput.String()) {
+		t.Errorf("expected invalid input for an empty message, got %v", err)
+	}
+
+	_, err = CreateCommit(context.Background(), &CommitInput{Message: "Nothing", WorkingDirectory: dir})
+	if err == nil || !strings.Contains(err.Error(), "git commit failed") {
+		t.Errorf("expected git commit to fail without changes, got %v", err)
+	}
+}
+
+func TestBlame(t *testing.T) {
+	dir := newRepository(t)
+	writeFile(t, dir, "main.go", "package main\n\nfunc main() { println() }\n")
+
+	result, err := Blame(context.Background(), &BlameInput{Path: "main.go", StartLine: 2, WorkingDirectory: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &BlameResult{Path: "main.go", Lines: []BlameLine{
+		{Line: 2, Author: "Ada", Summary: "Initial commit"},
+		{Line: 3, Author: "Not Committed Yet", Summary: "Version of main.go from main.go", Content: "func main() { println() }"},
+	}}
+	if diff := cmp.Diff(expected, result, cmpopts.IgnoreFields(BlameLine{}, "Hash", "Date")); diff != "" {
+		t.Errorf("blame mismatch (-want +got):\n%s", diff)
+	}
+	if result.Lines[0].Hash == result.Lines[1].Hash || result.Lines[1].Hash != strings.Repeat("0", 40) {
+		t.Error("expected the uncommitted line to have the zero hash")
+	}
+}
+
+func TestBranchesAndStash(t *testing.T) {
+	dir := newRepository(t)
+
+	result, err := Branches(context.Background(), &BranchInput{Create: "feature", Switch: true, WorkingDirectory: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &BranchResult{Current: "feature", Branches: []Branch{
+		{Name: "feature", Subject: "Initial commit", Current: true},
+		{Name: "main", Subject: "Initial commit"},
+	}}
+	if diff := cmp.Diff(expected, result, cmpopts.IgnoreFields(Branch{}, "Commit")); diff != "" {
+		t.Errorf("branch mismatch (-want +got):\n%s", diff)
+	}
+
+	writeFile(t, dir, "main.go", "package main\n")
+	stashes, err := Stash(context.Background(), &StashInput{Action: StashPush, Message: "wip", WorkingDirectory: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stashes.Entries) != 1 || stashes.Entries[0].Ref != "stash@{0}" || !strings.Contains(stashes.Entries[0].Message, "wip") {
+		t.Errorf("unexpected stash entries %+v", stashes.Entries)
+	}
+
+	if _, err := Branches(context.Background(), &BranchInput{Checkout: "main", WorkingDirectory: dir}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Stash(context.Background(), &StashInput{Action: StashPop, WorkingDirectory: dir}); err != nil {
+		t.Fatal(err)
+	}
+	status, err := Status(context.Background(), &StatusInput{WorkingDirectory: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Branch != "main" || len(status.Files) != 1 || status.Files[0].Unstaged != "modified" {
+		t.Errorf("expected the stashed change on main, got %+v", status)
+	}
+
+	_, err = Branches(context.Background(), &BranchInput{Create: "a", Delete: "b", WorkingDirectory: dir})
+	if err == nil || !strings.Contains(err.Error(), base.InvalidInput.String()) {
+		t.Errorf("expected invalid input for multiple actions, got %v", err)
+	}
+}
+
+func TestDestructive(t *testing.T) {
+	tests := []struct {
+		Input       Input
+		Modifies    bool
+		Destructive bool
+	}{
+		{Input: &StatusInput{}},
+		{Input: &BranchInput{}},
+		{Input: &BranchInput{Delete: "feature"}, Modifies: true},
+		{Input: &BranchInput{Delete: "feature", Force: true}, Modifies: true, Destructive: true},
+		{Input: &StashInput{Action: StashList}},
+		{Input: &StashInput{Action: StashPush}, Modifies: true},
+		{Input: &StashInput{Action: StashDrop}, Modifies: true, Destructive: true},
+		{Input: &CommitInput{}, Modifies: true},
+	}
+
+	for _, test := range tests {
+		if test.Input.Modifies() != test.Modifies || (test.Input.Destructive() != "") != test.Destructive {
+			t.Errorf("%s %+v: expected modifies %v and destructive %v", test.Input.Operation(), test.Input, test.Modifies, test.Destructive)
+		}
+	}
+}
+
+func TestTrailers(t *testing.T) {
+	var input CommitInput
+	for data, expected := range map[string]Trailers{
+		`{"trailers": ["Refs: #1"]}`:                   {"Refs: #1"},
+		`{"trailers": {"Refs": "#1", "Closes": "#2"}}`: {"Closes: #2", "Refs: #1"},
+	} {
+		input.Trailers = nil
+		if err := json.Unmarshal([]byte(data), &input); err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(expected, input.Trailers); diff != "" {
+			t.Errorf("trailers mismatch for %s (-want +got):\n%s", data, diff)
+		}
+	}
+}
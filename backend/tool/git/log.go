@@ -0,0 +1,129 @@
+package git
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+const (
+	DefaultLogCount = 10
+	MaxLogCount     = 100
+)
+
+// commitFormat separates the fields of a commit with the unit separator and
+// commits with the record separator, neither appears in commit messages.
+const commitFormat = "--format=%H%x1f%h%x1f%an%x1f%ae%x1f%aI%x1f%P%x1f%s%x1f%b%x1f%(trailers:only,unfold)%x1e"
+
+type LogInput struct {
+	// Ref is the revision or range to list, HEAD by default.
+	Ref    string   `json:"ref,omitempty"`
+	Count  int      `json:"count,omitempty"`
+	Paths  []string `json:"paths,omitempty"`
+	Author string   `json:"author,omitempty"`
+	Since  string   `json:"since,omitempty"`
+
+	WorkingDirectory string `json:"-"`
+}
+
+func (*LogInput) Operation() string   { return "log" }
+func (*LogInput) Modifies() bool      { return false }
+func (*LogInput) Destructive() string { return "" }
+
+type LogResult struct {
+	Commits []Commit `json:"commits"`
+}
+
+type Commit struct {
+	Hash      string   `json:"hash"`
+	ShortHash string   `json:"short_hash"`
+	Author    string   `json:"author"`
+	Email     string   `json:"email"`
+	Date      string   `json:"date"`
+	Parents   []string `json:"parents,omitempty"`
+	Subject   string   `json:"subject"`
+	Body      string   `json:"body,omitempty"`
+	Trailers  []string `json:"trailers,omitempty"`
+}
+
+func Log(ctx context.Context, input *LogInput) (*LogResult, error) {
+	if err := checkRevision("ref", input.Ref); err != nil {
+		return nil, err
+	}
+
+	count := input.Count
+	if count <= 0 {
+		count = DefaultLogCount
+	}
+	count = min(count, MaxLogCount)
+
+	args := []string{"log", commitFormat, "-n", strconv.Itoa(count)}
+	if input.Author != "" {
+		args = append(args, "--author="+input.Author)
+	}
+	if input.Since != "" {
+		args = append(args, "--since="+input.Since)
+	}
+	if input.Ref != "" {
+		args = append(args, input.Ref)
+	}
+	args = append(args, "--")
+	args = append(args, input.Paths...)
+
+	output, err := run(ctx, input.WorkingDirectory, "", args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LogResult{Commits: parseCommits(output)}, nil
+}
+
+func parseCommits(output string) []Commit {
+	commits := []Commit{}
+	for _, record := range strings.Split(output, "\x1e") {
+		fields := strings.Split(strings.TrimLeft(record, "\n"), "\x1f")
+		if len(fields) != 9 {
+			continue
+		}
+
+		commit := Commit{
+			Hash:      fields[0],
+			ShortHash: fields[1],
+			Author:    fields[2],
+			Email:     fields[3],
+			Date:      fields[4],
+			Parents:   strings.Fields(fields[5]),
+			Subject:   fields[6],
+		}
+
+		var trailers []string
+		for _, line := range strings.Split(fields[8], "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				trailers = append(trailers, line)
+			}
+		}
+		commit.Trailers = trailers
+		commit.Body = strings.TrimSpace(trimTrailers(fields[7], trailers))
+
+		commits = append(commits, commit)
+	}
+	return commits
+}
+
+// trimTrailers removes the trailer block from the end of the body, the
+// trailers are reported separately.
+func trimTrailers(body string, trailers []string) string {
+	if len(trailers) == 0 {
+		return body
+	}
+
+	lines := strings.Split(strings.TrimRight(body, "\n"), "\n")
+	end := len(lines)
+	for end > 0 && lines[end-1] != "" {
+		end--
+	}
+	if end == len(lines) {
+		return body
+	}
+	return strings.Join(lines[:end], "\n")
+}
@@ -0,0 +1,69 @@
+package git
+
+import (
+	"context"
+	"strings"
+)
+
+type ShowInput struct {
+	// Rev is the commit to show, HEAD by default.
+	Rev      string `json:"rev,omitempty"`
+	StatOnly bool   `json:"stat_only,omitempty"`
+
+	WorkingDirectory string `json:"-"`
+}
+
+func (*ShowInput) Operation() string   { return "show" }
+func (*ShowInput) Modifies() bool      { return false }
+func (*ShowInput) Destructive() string { return "" }
+
+type ShowResult struct {
+	Commit     Commit     `json:"commit"`
+	Files      []DiffFile `json:"files"`
+	Insertions int        `json:"insertions"`
+	Deletions  int        `json:"deletions"`
+	Patch      string     `json:"patch,omitempty"`
+	Truncated  bool       `json:"truncated,omitempty"`
+}
+
+func Show(ctx context.Context, input *ShowInput) (*ShowResult, error) {
+	rev := input.Rev
+	if rev == "" {
+		rev = "HEAD"
+	}
+	if err := checkRevision("rev", rev); err != nil {
+		return nil, err
+	}
+
+	output, err := run(ctx, input.WorkingDirectory, "", "show", "-s", commitFormat, rev, "--")
+	if err != nil {
+		return nil, err
+	}
+	commits := parseCommits(output)
+	if len(commits) == 0 {
+		return nil, invalidInput(rev + " is not a commit")
+	}
+
+	stat, err := run(ctx, input.WorkingDirectory, "", "show", "--format=", "-M", "--numstat", "-z", rev, "--")
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ShowResult{Commit: commits[0], Files: parseNumstat(stat)}
+	for _, file := range result.Files {
+		result.Insertions += file.Insertions
+		result.Deletions += file.Deletions
+	}
+
+	if input.StatOnly || len(result.Files) == 0 {
+		return result, nil
+	}
+
+	patch, err := run(ctx, input.WorkingDirectory, "", "show", "--format=", "-M", rev, "--")
+	if err != nil {
+		return nil, err
+	}
+	result.Patch, result.Truncated = truncatePatch(strings.TrimLeft(patch, "\n"))
+
+	return result, nil
+}
@@ -0,0 +1,104 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+const (
+	StashList  = "list"
+	StashPush  = "push"
+	StashPop   = "pop"
+	StashApply = "apply"
+	StashDrop  = "drop"
+)
+
+type StashInput struct {
+	// Action is one of list, push, pop, apply or drop, list by default.
+	Action  string `json:"action,omitempty"`
+	Message string `json:"message,omitempty"`
+	// Index selects the entry for pop, apply and drop, 0 is the latest.
+	Index            int  `json:"index,omitempty"`
+	IncludeUntracked bool `json:"include_untracked,omitempty"`
+
+	WorkingDirectory string `json:"-"`
+}
+
+func (*StashInput) Operation() string { return "stash" }
+
+func (i *StashInput) Modifies() bool {
+	return i.Action != "" && i.Action != StashList
+}
+
+func (i *StashInput) Destructive() string {
+	if i.Action == StashDrop {
+		return "dropping a stash entry discards its changes"
+	}
+	return ""
+}
+
+type StashResult struct {
+	Entries []StashEntry `json:"entries"`
+}
+
+type StashEntry struct {
+	Index   int    `json:"index"`
+	Ref     string `json:"ref"`
+	Message string `json:"message"`
+}
+
+func Stash(ctx context.Context, input *StashInput) (*StashResult, error) {
+	if input.Index < 0 {
+		return nil, invalidInput("index must not be negative")
+	}
+
+	ref := fmt.Sprintf("stash@{%d}", input.Index)
+
+	var err error
+	switch input.Action {
+	case "", StashList:
+	case StashPush:
+		args := []string{"stash", "push"}
+		if input.Message != "" {
+			args = append(args, "--message", input.Message)
+		}
+		if input.IncludeUntracked {
+			args = append(args, "--include-untracked")
+		}
+		_, err = run(ctx, input.WorkingDirectory, "", args...)
+	case StashPop, StashApply, StashDrop:
+		_, err = run(ctx, input.WorkingDirectory, "", "stash", input.Action, ref)
+	default:
+		return nil, invalidInput(fmt.Sprintf("unknown stash action %q, expected one of list, push, pop, apply or drop", input.Action))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := run(ctx, input.WorkingDirectory, "", "stash", "list", "--format=%gd%x1f%gs")
+	if err != nil {
+		return nil, err
+	}
+
+	return parseStashes(output), nil
+}
+
+func parseStashes(output string) *StashResult {
+	result := &StashResult{Entries: []StashEntry{}}
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.SplitN(line, "\x1f", 2)
+		if len(fields) != 2 {
+			continue
+		}
+
+		var index int
+		fmt.Sscanf(fields[0], "stash@{%d}", &index)
+		result.Entries = append(result.Entries, StashEntry{
+			Index:   index,
+			Ref:     fields[0],
+			Message: fields[1],
+		})
+	}
+	return result
+}
@@ -0,0 +1,125 @@
+package git
+
+import (
+	"context"
+	"strings"
+)
+
+type StatusInput struct {
+	WorkingDirectory string `json:"-"`
+}
+
+func (*StatusInput) Operation() string   { return "status" }
+func (*StatusInput) Modifies() bool      { return false }
+func (*StatusInput) Destructive() string { return "" }
+
+type StatusResult struct {
+	// Branch is empty if HEAD is detached.
+	Branch string `json:"branch,omitempty"`
+	// Commit is empty before the first commit.
+	Commit   string       `json:"commit,omitempty"`
+	Upstream string       `json:"upstream,omitempty"`
+	Ahead    int          `json:"ahead,omitempty"`
+	Behind   int          `json:"behind,omitempty"`
+	Clean    bool         `json:"clean"`
+	Files    []StatusFile `json:"files"`
+}
+
+// StatusFile is a changed file. Staged and Unstaged are one of added,
+// modified, deleted, renamed, copied or type_changed, or empty if the file
+// has no changes in the index or the working tree respectively.
+type StatusFile struct {
+	Path         string `json:"path"`
+	OriginalPath string `json:"original_path,omitempty"`
+	Staged       string `json:"staged,omitempty"`
+	Unstaged     string `json:"unstaged,omitempty"`
+	Untracked    bool   `json:"untracked,omitempty"`
+	Conflicted   bool   `json:"conflicted,omitempty"`
+}
+
+func Status(ctx context.Context, input *StatusInput) (*StatusResult, error) {
+	output, err := run(ctx, input.WorkingDirectory, "", "status", "--porcelain=v2", "--branch", "-z")
+	if err != nil {
+		return nil, err
+	}
+	return parseStatus(output), nil
+}
+
+// parseStatus parses the output of git status --porcelain=v2 --branch -z.
+func parseStatus(output string) *StatusResult {
+	result := &StatusResult{Files: []StatusFile{}}
+
+	records := strings.Split(output, "\x00")
+	for i := 0; i < len(records); i++ {
+		record := records[i]
+		switch {
+		case strings.HasPrefix(record, "# branch.oid "):
+			if oid := strings.TrimPrefix(record, "# branch.oid "); oid != "(initial)" {
+				result.Commit = oid
+			}
+		case strings.HasPrefix(record, "# branch.head "):
+			if head := strings.TrimPrefix(record, "# branch.head "); head != "(detached)" {
+				result.Branch = head
+			}
+		case strings.HasPrefix(record, "# branch.upstream "):
+			result.Upstream = strings.TrimPrefix(record, "# branch.upstream ")
+		case strings.HasPrefix(record, "# branch.ab "):
+			fields := strings.Fields(strings.TrimPrefix(record, "# branch.ab "))
+			if len(fields) == 2 {
+				result.Ahead = atoi(strings.TrimPrefix(fields[0], "+"))
+				result.Behind = atoi(strings.TrimPrefix(fields[1], "-"))
+			}
+		case strings.HasPrefix(record, "1 "):
+			fields := strings.SplitN(record, " ", 9)
+			if len(fields) == 9 {
+				result.Files = append(result.Files, changedFile(fields[1], fields[8]))
+			}
+		case strings.HasPrefix(record, "2 "):
+			fields := strings.SplitN(record, " ", 10)
+			if len(fields) == 10 {
+				file := changedFile(fields[1], fields[9])
+				if i+1 < len(records) {
+					i++
+					file.OriginalPath = records[i]
+				}
+				result.Files = append(result.Files, file)
+			}
+		case strings.HasPrefix(record, "u "):
+			fields := strings.SplitN(record, " ", 11)
+			if len(fields) == 11 {
+				result.Files = append(result.Files, StatusFile{Path: fields[10], Conflicted: true})
+			}
+		case strings.HasPrefix(record, "? "):
+			result.Files = append(result.Files, StatusFile{Path: strings.TrimPrefix(record, "? "), Untracked: true})
+		}
+	}
+
+	result.Clean = len(result.Files) == 0
+	return result
+}
+
+func changedFile(xy, path string) StatusFile {
+	return StatusFile{
+		Path:     path,
+		Staged:   changeKind(xy[0]),
+		Unstaged: changeKind(xy[1]),
+	}
+}
+
+func changeKind(code byte) string {
+	switch code {
+	case 'A':
+		return "added"
+	case 'M':
+		return "modified"
+	case 'D':
+		return "deleted"
+	case 'R':
+		return "renamed"
+	case 'C':
+		return "copied"
+	case 'T':
+		return "type_changed"
+	}
+	return ""
+}
@@ -0,0 +1,20 @@
+package mcp
+
+import "time"
+
+// SetRestartDelay changes the delay before the first restart of a crashed
+// server.
+func (m *Manager) SetRestartDelay(delay time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.restartDelay = delay
+}
+
+// ExpireSessions makes all sessions look idle for longer than IdleTimeout.
+func (m *Manager) ExpireSessions() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, entry := range m.sessions {
+		entry.lastUsed = time.Now().Add(-IdleTimeout)
+	}
+}
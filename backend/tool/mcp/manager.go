@@ -0,0 +1,172 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+const (
+	// IdleTimeout is how long a session may go unused before it is closed.
+	IdleTimeout = 10 * time.Minute
+	// restartDelay is the delay before the first restart of a crashed
+	// server. It doubles with every further crash up to maxRestartDelay.
+	restartDelay    = time.Second
+	maxRestartDelay = time.Minute
+	// stableRuntime is how long a server has to run before earlier crashes
+	// are forgotten.
+	stableRuntime = time.Minute
+)
+
+// Manager owns the sessions of the daemon. Sessions are shared by all tasks
+// that use a server with the same configuration. Servers are started on first
+// use, restarted with exponential backoff when they exit, and closed when no
+// task has used them for IdleTimeout.
+type Manager struct {
+	mu       sync.Mutex
+	sessions map[string]*managedSession
+	closed   bool
+	logger   *slog.Logger
+
+	restartDelay time.Duration
+}
+
+type managedSession struct {
+	mu        sync.Mutex
+	session   *Session
+	startedAt time.Time
+	crashes   int
+
+	// users counts the callers that acquired the session and did not release
+	// it yet, sessions with users are never closed as idle. Users and
+	// lastUsed are guarded by the mutex of the manager.
+	users    int
+	lastUsed time.Time
+}
+
+func NewManager() *Manager {
+	return &Manager{
+		sessions: make(map[string]*managedSession),
+		logger:   slog.With("component", "mcp_manager"),
+
+		restartDelay: restartDelay,
+	}
+}
+
+// Session returns a running session for the server. The caller must call
+// release once it no longer uses the session, the session is not closed as
+// idle before.
+func (m *Manager) Session(ctx context.Context, server Server) (session *Session, release func(), err error) {
+	key, err := json.Marshal(server)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		return nil, nil, errors.New("mcp manager is closed")
+	}
+	m.closeIdle()
+	entry, ok := m.sessions[string(key)]
+	if !ok {
+		entry = &managedSession{}
+		m.sessions[string(key)] = entry
+	}
+	entry.users++
+	entry.lastUsed = time.Now()
+	m.mu.Unlock()
+
+	releaseEntry := sync.OnceFunc(func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		entry.users--
+		entry.lastUsed = time.Now()
+	})
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+	defer func() {
+		if err != nil {
+			releaseEntry()
+		}
+	}()
+
+	if entry.session != nil {
+		select {
+		case <-entry.session.Done():
+			m.logger.Warn("mcp server exited", "server", server.Name, "error", entry.session.Err())
+			if time.Since(entry.startedAt) >= stableRuntime {
+				entry.crashes = 0
+			}
+			entry.crashes++
+			entry.session = nil
+		default:
+			return entry.session, releaseEntry, nil
+		}
+	}
+
+	if entry.crashes > 0 {
+		delay := min(m.restartDelay<<min(entry.crashes-1, 6), maxRestartDelay)
+		if wait := time.Until(entry.startedAt.Add(delay)); wait > 0 {
+			return nil, nil, fmt.Errorf("MCP server %s exited and is restarted in %s", server.Name, wait.Round(time.Second))
+		}
+	}
+
+	entry.startedAt = time.Now()
+	session, err = Connect(ctx, server)
+	if err != nil {
+		entry.crashes++
+		return nil, nil, err
+	}
+	entry.session = session
+
+	m.logger.Info("mcp server started", "server", server.Name, "transport", server.Transport, "restarts", entry.crashes)
+	return session, releaseEntry, nil
+}
+
+// closeIdle closes sessions that no caller holds and that were last released
+// IdleTimeout ago. The caller must hold m.mu.
+func (m *Manager) closeIdle() {
+	for key, entry := range m.sessions {
+		if !entry.mu.TryLock() {
+			continue
+		}
+		if entry.users == 0 && time.Since(entry.lastUsed) >= IdleTimeout {
+			if entry.session != nil {
+				entry.session.Close()
+			}
+			delete(m.sessions, key)
+		}
+		entry.mu.Unlock()
+	}
+}
+
+// Close stops all servers. Session fails afterwards.
+func (m *Manager) Close() error {
+	m.mu.Lock()
+	m.closed = true
+	sessions := m.sessions
+	m.sessions = make(map[string]*managedSession)
+	m.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, entry := range sessions {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			entry.mu.Lock()
+			defer entry.mu.Unlock()
+			if entry.session != nil {
+				entry.session.Close()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return nil
+}
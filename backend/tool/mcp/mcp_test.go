@@ -0,0 +1,248 @@
+package mcp_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/furisto/construct/backend/tool/mcp"
+	"github.com/furisto/construct/backend/tool/mcp/mcptest"
+)
+
+func TestMain(m *testing.M) {
+	mcptest.RunStub()
+	os.Exit(m.Run())
+}
+
+func stdioServer() mcp.Server {
+	command, args, env := mcptest.Command()
+	return mcp.Server{
+		Name:      "stub",
+		Transport: mcp.TransportStdio,
+		Command:   command,
+		Args:      args,
+		Env:       env,
+	}
+}
+
+func TestSessionStdio(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	session, err := mcp.Connect(ctx, stdioServer())
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	defer session.Close()
+
+	if session.Instructions() != "Use echo to test the connection." {
+		t.Errorf("unexpected instructions: %q", session.Instructions())
+	}
+
+	tools, err := session.Tools(ctx)
+	if err != nil {
+		t.Fatalf("failed to list tools: %v", err)
+	}
+	var names []string
+	for _, tool := range tools {
+		names = append(names, tool.Name)
+	}
+	if diff := cmp.Diff([]string{"echo", "add", "fail", "crash"}, names); diff != "" {
+		t.Errorf("tools mismatch (-want +got):\n%s", diff)
+	}
+	if !tools[0].Annotations.ReadOnlyHint {
+		t.Errorf("expected echo to be read-only")
+	}
+
+	result, err := session.CallTool(ctx, "echo", map[string]any{"message": "hi"})
+	if err != nil {
+		t.Fatalf("failed to call echo: %v", err)
+	}
+	if diff := cmp.Diff(map[string]any{"message": "hi"}, result.Output); diff != "" {
+		t.Errorf("echo output mismatch (-want +got):\n%s", diff)
+	}
+
+	result, err = session.CallTool(ctx, "add", map[string]any{"a": 1, "b": 2})
+	if err != nil {
+		t.Fatalf("failed to call add: %v", err)
+	}
+	if diff := cmp.Diff(map[string]any{"sum": float64(3)}, result.Output); diff != "" {
+		t.Errorf("add output mismatch (-want +got):\n%s", diff)
+	}
+
+	_, err = session.CallTool(ctx, "fail", nil)
+	if err == nil || !strings.Contains(err.Error(), "mcp tool failed") {
+		t.Errorf("expected tool error, got %v", err)
+	}
+
+	contents, err := session.ReadResource(ctx, "memo://greeting")
+	if err != nil {
+		t.Fatalf("failed to read resource: %v", err)
+	}
+	if diff := cmp.Diff([]mcp.ResourceContents{{URI: "memo://greeting", MimeType: "text/plain", Text: "Hello from the stub"}}, contents); diff != "" {
+		t.Errorf("resource mismatch (-want +got):\n%s", diff)
+	}
+
+	messages, err := session.GetPrompt(ctx, "greet", map[string]string{"name": "Ada"})
+	if err != nil {
+		t.Fatalf("failed to get prompt: %v", err)
+	}
+	if diff := cmp.Diff([]mcp.PromptMessage{{Role: "user", Content: mcp.Content{Type: "text", Text: "Say hello to Ada"}}}, messages); diff != "" {
+		t.Errorf("prompt mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestSessionHTTP(t *testing.T) {
+	t.Setenv("STUB_TOKEN", "s3cret")
+
+	handler := &mcptest.HTTPHandler{RequiredHeaders: map[string]string{"Authorization": "Bearer s3cret"}}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	ctx := context.Background()
+	session, err := mcp.Connect(ctx, mcp.Server{
+		Name:      "stub",
+		Transport: mcp.TransportHTTP,
+		URL:       server.URL,
+		Headers:   map[string]string{"Authorization": "Bearer ${STUB_TOKEN}"},
+	})
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+
+	result, err := session.CallTool(ctx, "echo", map[string]any{"message": "over http"})
+	if err != nil {
+		t.Fatalf("failed to call echo: %v", err)
+	}
+	if diff := cmp.Diff(map[string]any{"message": "over http"}, result.Output); diff != "" {
+		t.Errorf("echo output mismatch (-want +got):\n%s", diff)
+	}
+
+	resources, err := session.Resources(ctx)
+	if err != nil {
+		t.Fatalf("failed to list resources: %v", err)
+	}
+	if len(resources) != 1 || resources[0].URI != "memo://greeting" {
+		t.Errorf("unexpected resources: %v", resources)
+	}
+
+	session.Close()
+	if handler.Deleted.Load() != 1 {
+		t.Errorf("expected the session to be deleted on close")
+	}
+}
+
+func TestSessionHTTPUnauthorized(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(&mcptest.HTTPHandler{RequiredHeaders: map[string]string{"Authorization": "Bearer s3cret"}})
+	defer server.Close()
+
+	_, err := mcp.Connect(context.Background(), mcp.Server{Name: "stub", Transport: mcp.TransportHTTP, URL: server.URL})
+	if err == nil || !strings.Contains(err.Error(), "failed to initialize MCP server stub: server responded with 401 Unauthorized") {
+		t.Errorf("expected unauthorized error, got %v", err)
+	}
+}
+
+func TestManagerRestartsCrashedServer(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	manager := mcp.NewManager()
+	defer manager.Close()
+
+	session, release, err := manager.Session(ctx, stdioServer())
+	if err != nil {
+		t.Fatalf("failed to start server: %v", err)
+	}
+	defer release()
+
+	same, releaseSame, err := manager.Session(ctx, stdioServer())
+	if err != nil || same != session {
+		t.Fatalf("expected the running session to be reused")
+	}
+	releaseSame()
+
+	_, err = session.CallTool(ctx, "crash", nil)
+	if err == nil || !strings.Contains(err.Error(), "crashing as requested") {
+		t.Fatalf("expected the crash to be reported with the error output, got %v", err)
+	}
+
+	select {
+	case <-session.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatalf("server did not exit")
+	}
+
+	_, _, err = manager.Session(ctx, stdioServer())
+	if err == nil || !strings.Contains(err.Error(), "MCP server stub exited and is restarted in") {
+		t.Fatalf("expected the restart to be delayed, got %v", err)
+	}
+
+	manager.SetRestartDelay(0)
+
+	restarted, releaseRestarted, err := manager.Session(ctx, stdioServer())
+	if err != nil {
+		t.Fatalf("failed to restart server: %v", err)
+	}
+	defer releaseRestarted()
+	if restarted == session {
+		t.Fatalf("expected a new session")
+	}
+	if _, err := restarted.CallTool(ctx, "echo", nil); err != nil {
+		t.Errorf("failed to call restarted server: %v", err)
+	}
+}
+
+func TestManagerKeepsAcquiredSessions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	manager := mcp.NewManager()
+	defer manager.Close()
+
+	other := stdioServer()
+	other.Name = "other"
+
+	session, release, err := manager.Session(ctx, stdioServer())
+	if err != nil {
+		t.Fatalf("failed to start server: %v", err)
+	}
+
+	// A script that uses the session for longer than the idle timeout must
+	// not lose it to another task starting a server.
+	manager.ExpireSessions()
+	_, releaseOther, err := manager.Session(ctx, other)
+	if err != nil {
+		t.Fatalf("failed to start server: %v", err)
+	}
+	releaseOther()
+
+	select {
+	case <-session.Done():
+		t.Fatal("expected the acquired session to stay open")
+	default:
+	}
+	if _, err := session.CallTool(ctx, "echo", nil); err != nil {
+		t.Fatalf("failed to call acquired session: %v", err)
+	}
+
+	release()
+	manager.ExpireSessions()
+	_, releaseOther, err = manager.Session(ctx, other)
+	if err != nil {
+		t.Fatalf("failed to start server: %v", err)
+	}
+	releaseOther()
+
+	select {
+	case <-session.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the released session to be closed once it was idle")
+	}
+}
@@ -0,0 +1,224 @@
+// Package mcptest provides a minimal Model Context Protocol server for tests.
+// It serves the same tools, resources and prompts over stdio and streamable
+// HTTP.
+package mcptest
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync/atomic"
+
+	"github.com/furisto/construct/backend/tool/mcp"
+)
+
+// stubEnv marks the test binary as the stdio stub server.
+const stubEnv = "CONSTRUCT_MCP_STUB"
+
+// RunStub serves the stub over stdin and stdout and exits if the process was
+// started by Command. It must be called from TestMain before the tests run.
+func RunStub() {
+	if os.Getenv(stubEnv) != "1" {
+		return
+	}
+
+	scanner := bufio.NewScanner(os.Stdin)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	encoder := json.NewEncoder(os.Stdout)
+	for scanner.Scan() {
+		var req mcp.Message
+		if err := json.Unmarshal(scanner.Bytes(), &req); err != nil {
+			continue
+		}
+		if !req.IsRequest() {
+			continue
+		}
+		encoder.Encode(respond(&req))
+	}
+	os.Exit(0)
+}
+
+// Command returns the command that starts the stub as a stdio server. The
+// test binary re-executes itself and RunStub takes over.
+func Command() (string, []string, map[string]string) {
+	executable, err := os.Executable()
+	if err != nil {
+		panic(err)
+	}
+	return executable, nil, map[string]string{stubEnv: "1"}
+}
+
+// HTTPHandler serves the stub with the streamable HTTP transport. Responses
+// to tool calls are sent as server-sent events, all others as JSON.
+type HTTPHandler struct {
+	// RequiredHeaders are checked on every request. Requests that lack one
+	// are rejected with 401 Unauthorized.
+	RequiredHeaders map[string]string
+	// Deleted counts the sessions the client terminated.
+	Deleted atomic.Int32
+}
+
+func (h *HTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	for key, value := range h.RequiredHeaders {
+		if r.Header.Get(key) != value {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+	}
+
+	switch r.Method {
+	case http.MethodDelete:
+		h.Deleted.Add(1)
+		w.WriteHeader(http.StatusOK)
+		return
+	case http.MethodPost:
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req mcp.Message
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !req.IsRequest() {
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	if req.Method == "initialize" {
+		w.Header().Set("Mcp-Session-Id", "stub-session")
+	} else if r.Header.Get("Mcp-Session-Id") != "stub-session" {
+		http.Error(w, "missing session", http.StatusBadRequest)
+		return
+	}
+
+	encoded, err := json.Marshal(respond(&req))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if req.Method == "tools/call" {
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprintf(w, "event: message\ndata: {\"jsonrpc\":\"2.0\",\"method\":\"notifications/progress\"}\n\n")
+		fmt.Fprintf(w, "event: message\ndata: %s\n\n", encoded)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(encoded)
+}
+
+var objectSchema = map[string]any{"type": "object"}
+
+func respond(req *mcp.Message) *mcp.Response {
+	resp := &mcp.Response{JSONRPC: "2.0", ID: req.ID}
+
+	var params struct {
+		Name      string         `json:"name"`
+		URI       string         `json:"uri"`
+		Arguments map[string]any `json:"arguments"`
+	}
+	if len(req.Params) > 0 {
+		json.Unmarshal(req.Params, &params)
+	}
+
+	switch req.Method {
+	case "initialize":
+		resp.Result = map[string]any{
+			"protocolVersion": "2025-06-18",
+			"capabilities": map[string]any{
+				"tools":     map[string]any{},
+				"resources": map[string]any{},
+				"prompts":   map[string]any{},
+			},
+			"serverInfo":   map[string]any{"name": "stub", "version": "1.0.0"},
+			"instructions": "Use echo to test the connection.",
+		}
+	case "tools/list":
+		resp.Result = map[string]any{
+			"tools": []map[string]any{
+				{"name": "echo", "description": "Returns its arguments.", "inputSchema": objectSchema, "annotations": map[string]any{"readOnlyHint": true}},
+				{"name": "add", "description": "Adds a and b.", "inputSchema": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"a": map[string]any{"type": "number"},
+						"b": map[string]any{"type": "number"},
+					},
+					"required": []string{"a", "b"},
+				}},
+				{"name": "fail", "description": "Always fails.", "inputSchema": objectSchema},
+				{"name": "crash", "description": "Terminates the stdio server.", "inputSchema": objectSchema},
+			},
+		}
+	case "tools/call":
+		resp.Result = callTool(params.Name, params.Arguments)
+	case "resources/list":
+		resp.Result = map[string]any{
+			"resources": []map[string]any{
+				{"uri": "memo://greeting", "name": "greeting", "mimeType": "text/plain"},
+			},
+		}
+	case "resources/read":
+		if params.URI != "memo://greeting" {
+			resp.Error = &mcp.RPCError{Code: -32002, Message: "resource not found"}
+			break
+		}
+		resp.Result = map[string]any{
+			"contents": []map[string]any{
+				{"uri": params.URI, "mimeType": "text/plain", "text": "Hello from the stub"},
+			},
+		}
+	case "prompts/list":
+		resp.Result = map[string]any{
+			"prompts": []map[string]any{
+				{"name": "greet", "description": "Greets someone.", "arguments": []map[string]any{{"name": "name", "required": true}}},
+			},
+		}
+	case "prompts/get":
+		resp.Result = map[string]any{
+			"messages": []map[string]any{
+				{"role": "user", "content": map[string]any{"type": "text", "text": fmt.Sprintf("Say hello to %v", params.Arguments["name"])}},
+			},
+		}
+	case "ping":
+		resp.Result = map[string]any{}
+	default:
+		resp.Error = &mcp.RPCError{Code: mcp.ErrorCodeMethodNotFound, Message: "method not found"}
+	}
+
+	return resp
+}
+
+// callTool returns echo as structured content and add as JSON text so that
+// both result forms are exercised.
+func callTool(name string, arguments map[string]any) map[string]any {
+	switch name {
+	case "echo":
+		if arguments == nil {
+			arguments = map[string]any{}
+		}
+		return map[string]any{
+			"content":           []map[string]any{{"type": "text", "text": "echoed"}},
+			"structuredContent": arguments,
+		}
+	case "add":
+		a, _ := arguments["a"].(float64)
+		b, _ := arguments["b"].(float64)
+		return map[string]any{
+			"content": []map[string]any{{"type": "text", "text": fmt.Sprintf(`{"sum": %v}`, a+b)}},
+		}
+	case "crash":
+		fmt.Fprintln(os.Stderr, "crashing as requested")
+		os.Exit(1)
+	}
+
+	return map[string]any{
+		"content": []map[string]any{{"type": "text", "text": "the tool failed as requested"}},
+		"isError": true,
+	}
+}
@@ -0,0 +1,335 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/furisto/construct/backend/tool/base"
+)
+
+type Transport string
+
+const (
+	TransportStdio Transport = "stdio"
+	TransportHTTP  Transport = "http"
+)
+
+// Server describes how to reach a Model Context Protocol server.
+type Server struct {
+	Name      string            `json:"name"`
+	Transport Transport         `json:"transport"`
+	Command   string            `json:"command,omitempty"`
+	Args      []string          `json:"args,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+	URL       string            `json:"url,omitempty"`
+	Headers   map[string]string `json:"headers,omitempty"`
+}
+
+// DefaultCallTimeout bounds requests whose context has no deadline.
+const DefaultCallTimeout = 5 * time.Minute
+
+// CallInput is the input of a request to a server. Tool is the name of the
+// tool or, for resources and prompts, the MCP method.
+type CallInput struct {
+	Server    string         `json:"server"`
+	Tool      string         `json:"tool"`
+	Arguments map[string]any `json:"arguments"`
+}
+
+type CallResult struct {
+	Output any `json:"output"`
+}
+
+// Session is an initialized connection to a server.
+type Session struct {
+	server    Server
+	transport transport
+	info      InitializeResult
+	nextID    atomic.Int64
+
+	mu    sync.Mutex
+	tools []Tool
+}
+
+// Connect starts or connects to the server and performs the initialization
+// handshake.
+func Connect(ctx context.Context, server Server) (*Session, error) {
+	var (
+		t   transport
+		err error
+	)
+	switch server.Transport {
+	case TransportStdio:
+		t, err = startStdio(server)
+	case TransportHTTP:
+		t = newHTTPTransport(server)
+	default:
+		err = fmt.Errorf("unsupported transport %q", server.Transport)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Session{
+		server:    server,
+		transport: t,
+	}
+
+	err = s.call(ctx, "initialize", InitializeParams{
+		ProtocolVersion: ProtocolVersion,
+		Capabilities:    map[string]any{},
+		ClientInfo:      Implementation{Name: "construct", Version: "1.0.0"},
+	}, &s.info)
+	if err == nil {
+		err = t.notify(ctx, &Request{JSONRPC: "2.0", Method: "notifications/initialized"})
+	}
+	if err != nil {
+		t.close()
+		return nil, fmt.Errorf("failed to initialize MCP server %s: %w", server.Name, err)
+	}
+
+	return s, nil
+}
+
+func (s *Session) Server() Server {
+	return s.server
+}
+
+// Instructions are the usage hints the server returned during initialization.
+func (s *Session) Instructions() string {
+	return s.info.Instructions
+}
+
+func (s *Session) SupportsResources() bool {
+	return s.info.Capabilities.Resources != nil
+}
+
+func (s *Session) SupportsPrompts() bool {
+	return s.info.Capabilities.Prompts != nil
+}
+
+// Done is closed when the connection to the server was lost, e.g. because the
+// server process exited.
+func (s *Session) Done() <-chan struct{} {
+	return s.transport.done()
+}
+
+// Err explains why the connection was lost.
+func (s *Session) Err() error {
+	return s.transport.err()
+}
+
+func (s *Session) Close() error {
+	return s.transport.close()
+}
+
+func (s *Session) call(ctx context.Context, method string, params any, result any) error {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, DefaultCallTimeout)
+		defer cancel()
+	}
+
+	id := s.nextID.Add(1)
+	raw, err := s.transport.roundTrip(ctx, &Request{
+		JSONRPC: "2.0",
+		ID:      &id,
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		return err
+	}
+
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(raw, result)
+}
+
+// Tools returns the tools of the server. They are discovered once per session.
+func (s *Session) Tools(ctx context.Context) ([]Tool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.tools != nil {
+		return s.tools, nil
+	}
+	if s.info.Capabilities.Tools == nil {
+		s.tools = []Tool{}
+		return s.tools, nil
+	}
+
+	tools := []Tool{}
+	cursor := ""
+	for {
+		var result ListToolsResult
+		if err := s.call(ctx, "tools/list", cursorParams(cursor), &result); err != nil {
+			return nil, fmt.Errorf("failed to list tools of MCP server %s: %w", s.server.Name, err)
+		}
+		tools = append(tools, result.Tools...)
+		if result.NextCursor == "" {
+			break
+		}
+		cursor = result.NextCursor
+	}
+
+	s.tools = tools
+	return tools, nil
+}
+
+// CallTool calls a tool of the server. Structured content is returned as is,
+// text content is decoded if it is valid JSON. A result that the server marked
+// as an error is returned as an error.
+func (s *Session) CallTool(ctx context.Context, name string, arguments map[string]any) (*CallResult, error) {
+	if arguments == nil {
+		arguments = map[string]any{}
+	}
+
+	encoded, err := json.Marshal(arguments)
+	if err != nil {
+		return nil, base.NewCustomError(base.InvalidInput.String(), []string{
+			"Pass only values that can be encoded as JSON as arguments.",
+		}, "server", s.server.Name, "tool", name, "error", err.Error())
+	}
+
+	var result CallToolResult
+	err = s.call(ctx, "tools/call", CallToolParams{Name: name, Arguments: encoded}, &result)
+	if err != nil {
+		return nil, s.requestError(name, err)
+	}
+
+	if result.IsError {
+		return nil, base.NewCustomError("mcp tool failed", []string{
+			"Check the error message of the tool for the cause of the failure.",
+			"Verify that the arguments match the parameters of the tool.",
+		}, "server", s.server.Name, "tool", name, "error", contentText(result.Content))
+	}
+
+	if result.StructuredContent != nil {
+		return &CallResult{Output: result.StructuredContent}, nil
+	}
+	return &CallResult{Output: contentOutput(result.Content)}, nil
+}
+
+func (s *Session) Resources(ctx context.Context) ([]Resource, error) {
+	resources := []Resource{}
+	cursor := ""
+	for {
+		var result ListResourcesResult
+		if err := s.call(ctx, "resources/list", cursorParams(cursor), &result); err != nil {
+			return nil, s.requestError("resources/list", err)
+		}
+		resources = append(resources, result.Resources...)
+		if result.NextCursor == "" {
+			return resources, nil
+		}
+		cursor = result.NextCursor
+	}
+}
+
+func (s *Session) ReadResource(ctx context.Context, uri string) ([]ResourceContents, error) {
+	var result ReadResourceResult
+	if err := s.call(ctx, "resources/read", map[string]any{"uri": uri}, &result); err != nil {
+		return nil, s.requestError("resources/read", err)
+	}
+	return result.Contents, nil
+}
+
+func (s *Session) Prompts(ctx context.Context) ([]Prompt, error) {
+	prompts := []Prompt{}
+	cursor := ""
+	for {
+		var result ListPromptsResult
+		if err := s.call(ctx, "prompts/list", cursorParams(cursor), &result); err != nil {
+			return nil, s.requestError("prompts/list", err)
+		}
+		prompts = append(prompts, result.Prompts...)
+		if result.NextCursor == "" {
+			return prompts, nil
+		}
+		cursor = result.NextCursor
+	}
+}
+
+// GetPrompt renders a prompt of the server with the given arguments.
+func (s *Session) GetPrompt(ctx context.Context, name string, arguments map[string]string) ([]PromptMessage, error) {
+	var result GetPromptResult
+	err := s.call(ctx, "prompts/get", map[string]any{"name": name, "arguments": arguments}, &result)
+	if err != nil {
+		return nil, s.requestError("prompts/get", err)
+	}
+	return result.Messages, nil
+}
+
+func (s *Session) requestError(target string, err error) error {
+	if errors.Is(err, context.Canceled) {
+		return err
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return base.NewCustomError("mcp request timed out", []string{
+			"Call the tool with arguments that require less work or continue without it.",
+		}, "server", s.server.Name, "tool", target)
+	}
+
+	return base.NewCustomError("mcp request failed", []string{
+		"The MCP server could not handle the request. If the server exited it is restarted on the next turn.",
+		"Continue without the tool if the error persists.",
+	}, "server", s.server.Name, "tool", target, "error", err)
+}
+
+func cursorParams(cursor string) map[string]any {
+	if cursor == "" {
+		return nil
+	}
+	return map[string]any{"cursor": cursor}
+}
+
+func contentText(content []Content) string {
+	texts := make([]string, 0, len(content))
+	for _, item := range content {
+		if item.Type == "text" {
+			texts = append(texts, item.Text)
+		}
+	}
+	return strings.Join(texts, "\n")
+}
+
+// contentOutput converts the content of a tool result to a value for the
+// script. A single text item is decoded if it is JSON, multiple items are
+// returned as a list. Binary content is replaced by a description because it
+// cannot be used in a script.
+func contentOutput(content []Content) any {
+	values := make([]any, 0, len(content))
+	for _, item := range content {
+		switch item.Type {
+		case "text":
+			var decoded any
+			if err := json.Unmarshal([]byte(item.Text), &decoded); err == nil {
+				values = append(values, decoded)
+			} else {
+				values = append(values, item.Text)
+			}
+		case "resource":
+			values = append(values, item.Resource)
+		default:
+			values = append(values, map[string]any{"type": item.Type, "mimeType": item.MimeType})
+		}
+	}
+
+	switch len(values) {
+	case 0:
+		return nil
+	case 1:
+		return values[0]
+	default:
+		return values
+	}
+}
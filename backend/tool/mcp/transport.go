@@ -0,0 +1,457 @@
+package mcp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// maxMessageSize is the largest JSON-RPC message accepted from a server.
+	maxMessageSize = 16 * 1024 * 1024
+	// stderrTailSize is the amount of error output of a stdio server that is
+	// kept to explain why it exited.
+	stderrTailSize = 4 * 1024
+	// shutdownGracePeriod is how long a stdio server may take to exit after
+	// its stdin was closed before it is killed.
+	shutdownGracePeriod = 2 * time.Second
+)
+
+// transport exchanges JSON-RPC messages with a server.
+type transport interface {
+	// roundTrip sends a request and returns the result of its response.
+	roundTrip(ctx context.Context, req *Request) (json.RawMessage, error)
+	// notify sends a notification, which has no response.
+	notify(ctx context.Context, req *Request) error
+	// done is closed when the connection to the server was lost.
+	done() <-chan struct{}
+	// err explains why the connection was lost.
+	err() error
+	close() error
+}
+
+var envReference = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// expandEnv replaces ${VAR} references with the value of the variable in the
+// environment of the daemon. Other occurrences of $ are kept as they are.
+func expandEnv(value string) string {
+	return envReference.ReplaceAllStringFunc(value, func(ref string) string {
+		return os.Getenv(ref[2 : len(ref)-1])
+	})
+}
+
+type stdioTransport struct {
+	cmd   *exec.Cmd
+	stdin io.WriteCloser
+
+	writeMu sync.Mutex
+
+	mu      sync.Mutex
+	pending map[int64]chan *Message
+	stderr  *tailBuffer
+
+	exited  chan struct{}
+	exitErr error
+}
+
+func startStdio(server Server) (*stdioTransport, error) {
+	cmd := exec.Command(server.Command, server.Args...)
+	cmd.Env = os.Environ()
+	for key, value := range server.Env {
+		cmd.Env = append(cmd.Env, key+"="+expandEnv(value))
+	}
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	t := &stdioTransport{
+		cmd:     cmd,
+		stdin:   stdin,
+		pending: make(map[int64]chan *Message),
+		stderr:  &tailBuffer{limit: stderrTailSize},
+		exited:  make(chan struct{}),
+	}
+	cmd.Stderr = t.stderr
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start %s: %w", server.Command, err)
+	}
+
+	go t.readLoop(stdout)
+	return t, nil
+}
+
+// readLoop dispatches the messages of the server until its stdout is closed
+// and then reaps the process. Wait must not be called before all output was
+// read because it closes the pipe.
+func (t *stdioTransport) readLoop(stdout io.Reader) {
+	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(make([]byte, 64*1024), maxMessageSize)
+
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var msg Message
+		if err := json.Unmarshal(line, &msg); err != nil {
+			continue
+		}
+
+		switch {
+		case msg.IsResponse():
+			t.deliver(&msg)
+		case msg.IsRequest():
+			t.answer(&msg)
+		}
+	}
+
+	err := t.cmd.Wait()
+	if err == nil {
+		err = errors.New("server exited")
+	}
+	if stderr := strings.TrimSpace(t.stderr.String()); stderr != "" {
+		err = fmt.Errorf("%w: %s", err, stderr)
+	}
+
+	t.mu.Lock()
+	t.exitErr = err
+	t.mu.Unlock()
+	close(t.exited)
+}
+
+func (t *stdioTransport) deliver(msg *Message) {
+	id, err := strconv.ParseInt(string(msg.ID), 10, 64)
+	if err != nil {
+		return
+	}
+
+	t.mu.Lock()
+	ch, ok := t.pending[id]
+	delete(t.pending, id)
+	t.mu.Unlock()
+
+	if ok {
+		ch <- msg
+	}
+}
+
+// answer responds to requests of the server. Only ping is supported because
+// the client does not offer any capabilities.
+func (t *stdioTransport) answer(msg *Message) {
+	response := &Response{JSONRPC: "2.0", ID: msg.ID}
+	if msg.Method == "ping" {
+		response.Result = struct{}{}
+	} else {
+		response.Error = &RPCError{Code: ErrorCodeMethodNotFound, Message: "method not found"}
+	}
+
+	t.write(response)
+}
+
+func (t *stdioTransport) write(v any) error {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	t.writeMu.Lock()
+	defer t.writeMu.Unlock()
+	_, err = t.stdin.Write(append(encoded, '\n'))
+	return err
+}
+
+func (t *stdioTransport) roundTrip(ctx context.Context, req *Request) (json.RawMessage, error) {
+	ch := make(chan *Message, 1)
+	t.mu.Lock()
+	t.pending[*req.ID] = ch
+	t.mu.Unlock()
+
+	defer func() {
+		t.mu.Lock()
+		delete(t.pending, *req.ID)
+		t.mu.Unlock()
+	}()
+
+	if err := t.write(req); err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", t.errOr(err))
+	}
+
+	select {
+	case msg := <-ch:
+		if msg.Error != nil {
+			return nil, msg.Error
+		}
+		return msg.Result, nil
+	case <-ctx.Done():
+		t.notify(context.Background(), &Request{
+			JSONRPC: "2.0",
+			Method:  "notifications/cancelled",
+			Params:  map[string]any{"requestId": *req.ID},
+		})
+		return nil, ctx.Err()
+	case <-t.exited:
+		return nil, t.err()
+	}
+}
+
+func (t *stdioTransport) notify(ctx context.Context, req *Request) error {
+	return t.write(req)
+}
+
+func (t *stdioTransport) done() <-chan struct{} {
+	return t.exited
+}
+
+func (t *stdioTransport) err() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.exitErr
+}
+
+func (t *stdioTransport) errOr(err error) error {
+	select {
+	case <-t.exited:
+		return t.err()
+	default:
+		return err
+	}
+}
+
+// close asks the server to exit by closing its stdin and kills it if it does
+// not exit within the grace period.
+func (t *stdioTransport) close() error {
+	t.stdin.Close()
+
+	select {
+	case <-t.exited:
+	case <-time.After(shutdownGracePeriod):
+		t.cmd.Process.Kill()
+		<-t.exited
+	}
+	return nil
+}
+
+// tailBuffer keeps the last limit bytes written to it.
+type tailBuffer struct {
+	mu    sync.Mutex
+	buf   []byte
+	limit int
+}
+
+func (b *tailBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.buf = append(b.buf, p...)
+	if len(b.buf) > b.limit {
+		b.buf = b.buf[len(b.buf)-b.limit:]
+	}
+	return len(p), nil
+}
+
+func (b *tailBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return string(b.buf)
+}
+
+type httpTransport struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+
+	mu        sync.Mutex
+	sessionID string
+
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func newHTTPTransport(server Server) *httpTransport {
+	headers := make(map[string]string, len(server.Headers))
+	for key, value := range server.Headers {
+		headers[key] = expandEnv(value)
+	}
+
+	return &httpTransport{
+		url:     server.URL,
+		headers: headers,
+		client:  &http.Client{},
+		closed:  make(chan struct{}),
+	}
+}
+
+func (t *httpTransport) newRequest(ctx context.Context, method string, body io.Reader) (*http.Request, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, method, t.url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range t.headers {
+		httpReq.Header.Set(key, value)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json, text/event-stream")
+	httpReq.Header.Set("MCP-Protocol-Version", ProtocolVersion)
+
+	t.mu.Lock()
+	if t.sessionID != "" {
+		httpReq.Header.Set("Mcp-Session-Id", t.sessionID)
+	}
+	t.mu.Unlock()
+
+	return httpReq, nil
+}
+
+func (t *httpTransport) post(ctx context.Context, req *Request) (*http.Response, error) {
+	encoded, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq, err := t.newRequest(ctx, http.MethodPost, bytes.NewReader(encoded))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if sessionID := resp.Header.Get("Mcp-Session-Id"); sessionID != "" {
+		t.mu.Lock()
+		t.sessionID = sessionID
+		t.mu.Unlock()
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, stderrTailSize))
+		return nil, fmt.Errorf("server responded with %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	return resp, nil
+}
+
+func (t *httpTransport) roundTrip(ctx context.Context, req *Request) (json.RawMessage, error) {
+	resp, err := t.post(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var msg *Message
+	if strings.HasPrefix(resp.Header.Get("Content-Type"), "text/event-stream") {
+		msg, err = readEventStream(resp.Body, *req.ID)
+	} else {
+		msg = &Message{}
+		err = json.NewDecoder(io.LimitReader(resp.Body, maxMessageSize)).Decode(msg)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if msg.Error != nil {
+		return nil, msg.Error
+	}
+	return msg.Result, nil
+}
+
+// readEventStream reads server-sent events until the response to the request
+// with the given ID arrives. Requests and notifications of the server that
+// precede the response are skipped.
+func readEventStream(body io.Reader, id int64) (*Message, error) {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 64*1024), maxMessageSize)
+
+	var data strings.Builder
+	for {
+		more := scanner.Scan()
+		line := scanner.Text()
+
+		if more && line != "" {
+			if value, ok := strings.CutPrefix(line, "data:"); ok {
+				data.WriteString(strings.TrimPrefix(value, " "))
+			}
+			continue
+		}
+
+		if data.Len() > 0 {
+			var msg Message
+			if err := json.Unmarshal([]byte(data.String()), &msg); err == nil && msg.IsResponse() && string(msg.ID) == strconv.FormatInt(id, 10) {
+				return &msg, nil
+			}
+			data.Reset()
+		}
+
+		if !more {
+			if err := scanner.Err(); err != nil {
+				return nil, err
+			}
+			return nil, errors.New("event stream ended without a response")
+		}
+	}
+}
+
+func (t *httpTransport) notify(ctx context.Context, req *Request) error {
+	resp, err := t.post(ctx, req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (t *httpTransport) done() <-chan struct{} {
+	return t.closed
+}
+
+func (t *httpTransport) err() error {
+	return errors.New("connection closed")
+}
+
+// close terminates the session on the server if it assigned one.
+func (t *httpTransport) close() error {
+	t.closeOnce.Do(func() {
+		close(t.closed)
+
+		t.mu.Lock()
+		sessionID := t.sessionID
+		t.mu.Unlock()
+		if sessionID == "" {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownGracePeriod)
+		defer cancel()
+		httpReq, err := t.newRequest(ctx, http.MethodDelete, nil)
+		if err != nil {
+			return
+		}
+		if resp, err := t.client.Do(httpReq); err == nil {
+			resp.Body.Close()
+		}
+	})
+	return nil
+}
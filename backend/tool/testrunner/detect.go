@@ -0,0 +1,87 @@
+package testrunner
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/furisto/construct/backend/tool/base"
+)
+
+// pytestMarkers are files whose presence, or whose mention of pytest,
+// indicates a pytest suite.
+var pytestMarkers = []struct {
+	file    string
+	mention bool
+}{
+	{"pytest.ini", false},
+	{"conftest.py", false},
+	{"pyproject.toml", true},
+	{"setup.cfg", true},
+	{"tox.ini", true},
+}
+
+// Detect returns the test framework of the project in dir. Frameworks whose
+// toolchain is not available are skipped.
+func Detect(dir string, available Available) (string, error) {
+	if fileExists(filepath.Join(dir, "go.mod")) && available("go") {
+		return FrameworkGo, nil
+	}
+
+	if pkg, err := readPackageJSON(dir); err == nil && (available("node") || available("npx")) {
+		switch {
+		case pkg.depends("vitest"):
+			return FrameworkVitest, nil
+		case pkg.depends("jest") || pkg.Jest != nil || strings.Contains(pkg.Scripts["test"], "jest"):
+			return FrameworkJest, nil
+		}
+	}
+
+	if available("pytest") || available("python3") {
+		for _, marker := range pytestMarkers {
+			content, err := os.ReadFile(filepath.Join(dir, marker.file))
+			if err == nil && (!marker.mention || strings.Contains(string(content), "pytest")) {
+				return FrameworkPytest, nil
+			}
+		}
+	}
+
+	return "", base.NewCustomError("no supported test framework found", []string{
+		"Go modules, pytest, jest and vitest are detected from go.mod, the pytest configuration and package.json",
+		"Set path to the directory of the project if it is not the working directory",
+		"Set framework if the toolchain is installed but was not detected",
+		"Set command and report to run other frameworks that write JUnit XML reports",
+	}, "path", dir)
+}
+
+type packageJSON struct {
+	Scripts         map[string]string `json:"scripts"`
+	Dependencies    map[string]string `json:"dependencies"`
+	DevDependencies map[string]string `json:"devDependencies"`
+	Jest            json.RawMessage   `json:"jest"`
+}
+
+func (p *packageJSON) depends(name string) bool {
+	_, ok := p.Dependencies[name]
+	_, dev := p.DevDependencies[name]
+	return ok || dev
+}
+
+func readPackageJSON(dir string) (*packageJSON, error) {
+	content, err := os.ReadFile(filepath.Join(dir, "package.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	var pkg packageJSON
+	if err := json.Unmarshal(content, &pkg); err != nil {
+		return nil, err
+	}
+	return &pkg, nil
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
@@ -0,0 +1,156 @@
+package testrunner
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/furisto/construct/backend/tool/base"
+)
+
+// run is the command of a test framework and the parser of its results.
+type run struct {
+	args    []string
+	command string
+	// parse returns the tests and the output of the run that is not part of
+	// a test, e.g. build errors.
+	parse func(stdout []byte, start time.Time) ([]TestCase, string, error)
+}
+
+func newRun(framework string, input *RunTestsInput, dir, report string, available Available) (*run, error) {
+	if framework != FrameworkJUnit && (input.Command != "" || input.Report != "") {
+		return nil, invalidInput("command and report are only supported by the junit framework")
+	}
+
+	var args []string
+	var parse func(stdout []byte, start time.Time) ([]TestCase, string, error)
+
+	switch framework {
+	case FrameworkGo:
+		args = []string{"go", "test", "-json"}
+		if input.Filter != "" {
+			args = append(args, "-run", input.Filter)
+		}
+		packages := input.Tests
+		if len(packages) == 0 {
+			packages = []string{"./..."}
+		}
+		args = append(args, packages...)
+		parse = func(stdout []byte, _ time.Time) ([]TestCase, string, error) {
+			return parseGoTest(stdout)
+		}
+
+	case FrameworkPytest:
+		args = []string{"pytest"}
+		if !available("pytest") {
+			args = []string{"python3", "-m", "pytest"}
+		}
+		file := filepath.Join(report, "junit.xml")
+		args = append(args, "-q", "-p", "no:cacheprovider", "--junitxml="+file)
+		if input.Filter != "" {
+			args = append(args, "-k", input.Filter)
+		}
+		args = append(args, input.Tests...)
+		parse = reportParser(file)
+
+	case FrameworkJest:
+		file := filepath.Join(report, "jest.json")
+		args = append(nodeBinary(dir, "jest", available), "--json", "--outputFile="+file, "--ci")
+		if input.Filter != "" {
+			args = append(args, "--testNamePattern", input.Filter)
+		}
+		args = append(args, input.Tests...)
+		parse = func(stdout []byte, _ time.Time) ([]TestCase, string, error) {
+			content, err := os.ReadFile(file)
+			if err != nil {
+				return nil, string(stdout), err
+			}
+			tests, err := parseJest(content)
+			return tests, string(stdout), err
+		}
+
+	case FrameworkVitest:
+		file := filepath.Join(report, "junit.xml")
+		args = append(nodeBinary(dir, "vitest", available), "run", "--reporter=junit", "--outputFile="+file)
+		if input.Filter != "" {
+			args = append(args, "--testNamePattern", input.Filter)
+		}
+		args = append(args, input.Tests...)
+		parse = reportParser(file)
+
+	case FrameworkJUnit:
+		if input.Command == "" || input.Report == "" {
+			return nil, invalidInput("the junit framework requires command and report")
+		}
+		if input.Filter != "" || len(input.Tests) > 0 {
+			return nil, invalidInput("the junit framework does not support tests and filter, select the tests in the command")
+		}
+		args = []string{"/bin/sh", "-c", input.Command}
+		pattern := input.Report
+		if !filepath.IsAbs(pattern) {
+			pattern = filepath.Join(dir, pattern)
+		}
+		parse = reportParser(pattern)
+
+	default:
+		return nil, base.NewCustomError(fmt.Sprintf("unsupported test framework %q", framework), []string{
+			fmt.Sprintf("Supported frameworks are %s, %s, %s, %s and %s", FrameworkGo, FrameworkPytest, FrameworkJest, FrameworkVitest, FrameworkJUnit),
+		})
+	}
+
+	command := strings.Join(args, " ")
+	if framework == FrameworkJUnit {
+		command = input.Command
+	}
+	return &run{args: args, command: command, parse: parse}, nil
+}
+
+// nodeBinary prefers the binary installed in node_modules of the project
+// over a global installation and npx.
+func nodeBinary(dir, name string, available Available) []string {
+	local := filepath.Join("node_modules", ".bin", name)
+	if fileExists(filepath.Join(dir, local)) {
+		return []string{local}
+	}
+	if available(name) {
+		return []string{name}
+	}
+	return []string{"npx", "--no-install", name}
+}
+
+// reportParser reads the JUnit XML reports matching the pattern. Reports
+// that were not written by the run are ignored, build tools keep the reports
+// of tests that did not run. The output of the run is stdout.
+func reportParser(pattern string) func(stdout []byte, start time.Time) ([]TestCase, string, error) {
+	return func(stdout []byte, start time.Time) ([]TestCase, string, error) {
+		files, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, string(stdout), err
+		}
+
+		var tests []TestCase
+		var read int
+		for _, file := range files {
+			info, err := os.Stat(file)
+			if err != nil || info.IsDir() || info.ModTime().Before(start) {
+				continue
+			}
+			content, err := os.ReadFile(file)
+			if err != nil {
+				return nil, string(stdout), err
+			}
+			parsed, err := parseJUnit(content)
+			if err != nil {
+				return nil, string(stdout), fmt.Errorf("%s: %w", file, err)
+			}
+			tests = append(tests, parsed...)
+			read++
+		}
+		if read == 0 {
+			return nil, string(stdout), fmt.Errorf("the run wrote no report matching %s", pattern)
+		}
+		return tests, string(stdout), nil
+	}
+}
@@ -0,0 +1,178 @@
+package testrunner
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// goTestEvent is an event of go test -json, see go doc test2json.
+type goTestEvent struct {
+	Action      string
+	Package     string
+	ImportPath  string
+	Test        string
+	Elapsed     float64
+	Output      string
+	FailedBuild string
+}
+
+var goStatuses = map[string]string{"pass": StatusPassed, "fail": StatusFailed, "skip": StatusSkipped}
+
+// goLocation matches the location testing.T prefixes log messages and
+// failures with.
+var goLocation = regexp.MustCompile(`^\s+([\w./\\-]+\.go):(\d+): ?(.*)$`)
+
+// parseGoTest parses the output of go test -json. Packages that failed without
+// a failing test, e.g. because they did not build or a test panicked outside
+// of a test function, are reported as a failed test without a name. Lines that
+// are not events, older Go versions print build errors as text, are returned
+// as the output of the run.
+func parseGoTest(stdout []byte) ([]TestCase, string, error) {
+	type key struct{ pkg, test string }
+	var tests []*TestCase
+	byKey := map[key]*TestCase{}
+	outputs := map[key]*strings.Builder{}
+	buildOutput := map[string]*strings.Builder{}
+	var buildPaths []string
+	reportedBuilds := map[string]bool{}
+	var plain strings.Builder
+
+	scanner := bufio.NewScanner(bytes.NewReader(stdout))
+	scanner.Buffer(make([]byte, 0, 64<<10), 16<<20)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		var event goTestEvent
+		if len(line) == 0 || line[0] != '{' || json.Unmarshal(line, &event) != nil {
+			plain.Write(line)
+			plain.WriteByte('\n')
+			continue
+		}
+
+		k := key{event.Package, event.Test}
+		switch event.Action {
+		case "build-output":
+			if buildOutput[event.ImportPath] == nil {
+				buildOutput[event.ImportPath] = &strings.Builder{}
+				buildPaths = append(buildPaths, event.ImportPath)
+			}
+			buildOutput[event.ImportPath].WriteString(event.Output)
+		case "output":
+			if event.Test != "" && isGoTestFraming(event.Output) {
+				continue
+			}
+			if outputs[k] == nil {
+				outputs[k] = &strings.Builder{}
+			}
+			outputs[k].WriteString(event.Output)
+		case "pass", "fail", "skip":
+			if event.Test == "" && event.Action != "fail" {
+				continue
+			}
+			test := byKey[k]
+			if test == nil {
+				test = &TestCase{Name: event.Test, Suite: event.Package}
+				byKey[k] = test
+				tests = append(tests, test)
+			}
+			test.Status = goStatuses[event.Action]
+			test.Duration = event.Elapsed
+			if event.Test == "" {
+				test.Message = "package failed"
+				if event.FailedBuild != "" {
+					test.Message = "build failed"
+					if output := buildOutput[event.FailedBuild]; output != nil {
+						test.Output = output.String()
+						reportedBuilds[event.FailedBuild] = true
+					}
+				}
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, plain.String(), err
+	}
+
+	// A failing subtest fails its parents, they are omitted to list every
+	// failure once. Packages are omitted if one of their tests failed.
+	failedParents := map[key]bool{}
+	for _, test := range tests {
+		if test.Status != StatusFailed {
+			continue
+		}
+		if test.Name != "" {
+			failedParents[key{test.Suite, ""}] = true
+		}
+		for name := test.Name; strings.Contains(name, "/"); {
+			name = name[:strings.LastIndex(name, "/")]
+			failedParents[key{test.Suite, name}] = true
+		}
+	}
+
+	var result []TestCase
+	for _, test := range tests {
+		k := key{test.Suite, test.Name}
+		if test.Status == StatusFailed && failedParents[k] {
+			continue
+		}
+		if output := outputs[k]; output != nil && test.Output == "" {
+			test.Output = output.String()
+		}
+		if test.Name != "" && test.Status != StatusPassed {
+			test.File, test.Line, test.Message = goFailure(test.Output)
+		}
+		result = append(result, *test)
+	}
+
+	for _, importPath := range buildPaths {
+		if !reportedBuilds[importPath] {
+			plain.WriteString(buildOutput[importPath].String())
+		}
+	}
+
+	return result, plain.String(), nil
+}
+
+// goFailure returns the location and the message of the first message that
+// was logged with a location, including its continuation lines, or the panic
+// of the test.
+func goFailure(output string) (string, int, string) {
+	lines := strings.Split(output, "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(line, "panic: ") {
+			return "", 0, line
+		}
+		match := goLocation.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		number, _ := strconv.Atoi(match[2])
+		indent := len(line) - len(strings.TrimLeft(line, " \t"))
+		message := []string{match[3]}
+		for _, next := range lines[i+1:] {
+			nextIndent := len(next) - len(strings.TrimLeft(next, " \t"))
+			if strings.TrimSpace(next) == "" || nextIndent <= indent || goLocation.MatchString(next) {
+				break
+			}
+			message = append(message, strings.TrimSpace(next))
+		}
+		return match[1], number, strings.TrimSpace(strings.Join(message, "\n"))
+	}
+	return "", 0, ""
+}
+
+// isGoTestFraming reports whether the line is one of the lines go test prints
+// around the output of a test.
+func isGoTestFraming(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	for _, prefix := range []string{"=== RUN", "=== PAUSE", "=== CONT", "=== NAME", "--- PASS", "--- FAIL", "--- SKIP"} {
+		if strings.HasPrefix(trimmed, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+package testrunner
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type jestReport struct {
+	TestResults []struct {
+		Name             string `json:"name"`
+		Status           string `json:"status"`
+		Message          string `json:"message"`
+		AssertionResults []struct {
+			FullName        string   `json:"fullName"`
+			Status          string   `json:"status"`
+			Duration        float64  `json:"duration"`
+			FailureMessages []string `json:"failureMessages"`
+		} `json:"assertionResults"`
+	} `json:"testResults"`
+}
+
+var (
+	ansiEscape = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+	// jestLocation matches the locations of a stack trace, e.g. "at
+	// Object.<anonymous> (/project/src/sum.test.js:8:19)".
+	jestLocation = regexp.MustCompile(`\(?([^\s()]+):(\d+):\d+\)?`)
+)
+
+// parseJest parses the report jest writes with --json. Test files that failed
+// to run, e.g. because of a syntax error, are reported as a failed test
+// without a name.
+func parseJest(content []byte) ([]TestCase, error) {
+	var report jestReport
+	if err := json.Unmarshal(content, &report); err != nil {
+		return nil, err
+	}
+
+	var tests []TestCase
+	for _, file := range report.TestResults {
+		if file.Status == "failed" && len(file.AssertionResults) == 0 {
+			message := ansiEscape.ReplaceAllString(file.Message, "")
+			tests = append(tests, TestCase{
+				Suite:   file.Name,
+				Status:  StatusFailed,
+				Message: firstLine(message),
+				Output:  message,
+			})
+			continue
+		}
+
+		for _, assertion := range file.AssertionResults {
+			test := TestCase{
+				Name:     assertion.FullName,
+				Suite:    file.Name,
+				Duration: assertion.Duration / 1000,
+			}
+			switch assertion.Status {
+			case "passed":
+				test.Status = StatusPassed
+			case "failed":
+				test.Status = StatusFailed
+			default:
+				// pending, skipped, todo and disabled
+				test.Status = StatusSkipped
+			}
+
+			if len(assertion.FailureMessages) > 0 {
+				failure := ansiEscape.ReplaceAllString(strings.Join(assertion.FailureMessages, "\n"), "")
+				test.Output = failure
+				test.Message = jestMessage(failure)
+				test.File, test.Line = jestFailureLocation(failure, file.Name)
+			}
+			tests = append(tests, test)
+		}
+	}
+	return tests, nil
+}
+
+// jestMessage returns the failure message without the stack trace.
+func jestMessage(failure string) string {
+	var lines []string
+	for _, line := range strings.Split(failure, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "at ") {
+			break
+		}
+		lines = append(lines, line)
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// jestFailureLocation returns the first location of the stack trace in the
+// test file, otherwise the first location outside of dependencies.
+func jestFailureLocation(failure, file string) (string, int) {
+	var fallback []string
+	for _, match := range jestLocation.FindAllStringSubmatch(failure, -1) {
+		if strings.Contains(match[1], "node_modules") || strings.HasPrefix(match[1], "node:") {
+			continue
+		}
+		if match[1] == file {
+			line, _ := strconv.Atoi(match[2])
+			return match[1], line
+		}
+		if fallback == nil {
+			fallback = match
+		}
+	}
+
+	if fallback == nil {
+		return "", 0
+	}
+	line, _ := strconv.Atoi(fallback[2])
+	return fallback[1], line
+}
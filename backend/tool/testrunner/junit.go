@@ -0,0 +1,152 @@
+package testrunner
+
+import (
+	"encoding/xml"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type junitRoot struct {
+	XMLName xml.Name
+	junitSuite
+}
+
+type junitSuite struct {
+	Name   string       `xml:"name,attr"`
+	File   string       `xml:"file,attr"`
+	Suites []junitSuite `xml:"testsuite"`
+	Cases  []junitCase  `xml:"testcase"`
+}
+
+type junitCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	File      string        `xml:"file,attr"`
+	Time      string        `xml:"time,attr"`
+	Failures  []junitResult `xml:"failure"`
+	Errors    []junitResult `xml:"error"`
+	Skipped   *junitResult  `xml:"skipped"`
+	SystemOut string        `xml:"system-out"`
+	SystemErr string        `xml:"system-err"`
+}
+
+type junitResult struct {
+	Message string `xml:"message,attr"`
+	Body    string `xml:",chardata"`
+}
+
+// junitLocation matches file:line locations in failure messages and stack
+// traces, e.g. "tests/test_api.py:12: AssertionError", "at Object.<anonymous>
+// (src/sum.test.ts:8:19)" or "at com.example.AppTest.sum(AppTest.java:14)".
+var junitLocation = regexp.MustCompile(`([\w./\\@-]+\.[A-Za-z]+):(\d+)`)
+
+// parseJUnit parses a JUnit XML report. The root is either a testsuites
+// element or a single testsuite, suites may be nested.
+func parseJUnit(content []byte) ([]TestCase, error) {
+	var root junitRoot
+	if err := xml.Unmarshal(content, &root); err != nil {
+		return nil, err
+	}
+	if root.XMLName.Local != "testsuites" && root.XMLName.Local != "testsuite" {
+		return nil, fmt.Errorf("unexpected root element %s", root.XMLName.Local)
+	}
+	return appendJUnitSuite(nil, root.junitSuite, ""), nil
+}
+
+func appendJUnitSuite(tests []TestCase, suite junitSuite, file string) []TestCase {
+	if suite.File != "" {
+		file = suite.File
+	}
+
+	for _, c := range suite.Cases {
+		test := TestCase{
+			Name:   c.Name,
+			Suite:  c.ClassName,
+			Status: StatusPassed,
+			File:   c.File,
+		}
+		if test.Suite == "" {
+			test.Suite = suite.Name
+		}
+		if test.File == "" {
+			test.File = file
+		}
+		test.Duration, _ = strconv.ParseFloat(strings.ReplaceAll(c.Time, ",", ""), 64)
+
+		var result *junitResult
+		switch {
+		case len(c.Failures) > 0:
+			test.Status, result = StatusFailed, &c.Failures[0]
+		case len(c.Errors) > 0:
+			test.Status, result = StatusFailed, &c.Errors[0]
+		case c.Skipped != nil:
+			test.Status, result = StatusSkipped, c.Skipped
+		}
+
+		if result != nil {
+			test.Message = strings.TrimSpace(result.Message)
+			if test.Message == "" {
+				test.Message = firstLine(result.Body)
+			}
+			if test.Status == StatusFailed {
+				test.Output = strings.TrimSpace(strings.Join([]string{result.Body, c.SystemOut, c.SystemErr}, "\n"))
+				if file, line := junitFailureLocation(result.Body, test.File, test.Suite); file != "" {
+					test.File, test.Line = file, line
+				}
+			}
+		}
+
+		tests = append(tests, test)
+	}
+
+	for _, nested := range suite.Suites {
+		tests = appendJUnitSuite(tests, nested, file)
+	}
+	return tests
+}
+
+// junitFailureLocation returns the location in the failure that most likely
+// belongs to the test: a location in the file of the test or its class,
+// otherwise the first location outside of dependencies.
+func junitFailureLocation(body, file, class string) (string, int) {
+	names := map[string]bool{}
+	if file != "" {
+		names[filepath.Base(file)] = true
+	}
+	if class != "" {
+		names[class[strings.LastIndex(class, ".")+1:]] = true
+	}
+
+	var fallback []string
+	for _, match := range junitLocation.FindAllStringSubmatch(body, -1) {
+		if strings.Contains(match[1], "node_modules") || strings.Contains(match[1], "site-packages") {
+			continue
+		}
+		base := filepath.Base(match[1])
+		if names[base] || names[strings.TrimSuffix(base, filepath.Ext(base))] {
+			line, _ := strconv.Atoi(match[2])
+			return match[1], line
+		}
+		if fallback == nil {
+			fallback = match
+		}
+	}
+
+	if fallback == nil {
+		return "", 0
+	}
+	line, _ := strconv.Atoi(fallback[2])
+	return fallback[1], line
+}
+
+func firstLine(s string) string {
+	for _, line := range strings.Split(s, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			return line
+		}
+	}
+	return ""
+}
@@ -0,0 +1,252 @@
+// Package testrunner runs the test suite of a project with its own toolchain
+// and parses the machine readable output of the test framework into a list of
+// passed, failed and skipped tests.
+package testrunner
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/furisto/construct/backend/tool/base"
+)
+
+const (
+	FrameworkGo     = "go"
+	FrameworkPytest = "pytest"
+	FrameworkJest   = "jest"
+	FrameworkVitest = "vitest"
+	// FrameworkJUnit runs a custom command and reads the JUnit XML reports
+	// it writes, e.g. for Maven, Gradle or PHPUnit.
+	FrameworkJUnit = "junit"
+)
+
+const (
+	StatusPassed  = "passed"
+	StatusFailed  = "failed"
+	StatusSkipped = "skipped"
+)
+
+const (
+	DefaultTimeout = 10 * time.Minute
+	// MaxListedTests is the number of tests listed in a result. Failures are
+	// listed first, so they are only cut if there are more failures.
+	MaxListedTests = 200
+	// MaxMessage and MaxTestOutput are the sizes the failure message and the
+	// output of a single test are cut to.
+	MaxMessage    = 1 << 10
+	MaxTestOutput = 4 << 10
+	// MaxOutput is the size the output of the run is cut to if it is
+	// reported, e.g. for build errors.
+	MaxOutput = 16 << 10
+)
+
+type RunTestsInput struct {
+	// Framework is detected from the project files if empty.
+	Framework string `json:"framework,omitempty"`
+	// Path is the directory the tests run in, relative to the working
+	// directory. Empty runs in the working directory.
+	Path string `json:"path,omitempty"`
+	// Tests selects packages, files or directories to run instead of the
+	// whole suite.
+	Tests []string `json:"tests,omitempty"`
+	// Filter runs only tests whose name matches the pattern.
+	Filter string `json:"filter,omitempty"`
+	// Command and Report are the command and the JUnit XML report files, a
+	// glob is allowed, of the junit framework.
+	Command       string `json:"command,omitempty"`
+	Report        string `json:"report,omitempty"`
+	IncludePassed bool   `json:"include_passed,omitempty"`
+	// Timeout is the timeout of the run in seconds.
+	Timeout int `json:"timeout,omitempty"`
+
+	WorkingDirectory string `json:"-"`
+}
+
+type RunTestsResult struct {
+	Framework string `json:"framework"`
+	Command   string `json:"command"`
+	// Success is true if the command succeeded and no test failed.
+	Success  bool    `json:"success"`
+	Passed   int     `json:"passed"`
+	Failed   int     `json:"failed"`
+	Skipped  int     `json:"skipped"`
+	Duration float64 `json:"duration"`
+	// Tests lists the failed tests first, then the skipped tests and, if
+	// requested, the passed tests.
+	Tests     []TestCase `json:"tests"`
+	Truncated bool       `json:"truncated,omitempty"`
+	// Output is the end of the output of the run. It is only set if the run
+	// failed without a failing test, e.g. because the build failed.
+	Output   string `json:"output,omitempty"`
+	TimedOut bool   `json:"timed_out,omitempty"`
+}
+
+type TestCase struct {
+	Name string `json:"name"`
+	// Suite is the package, file or class of the test.
+	Suite    string  `json:"suite,omitempty"`
+	Status   string  `json:"status"`
+	Duration float64 `json:"duration,omitempty"`
+	// Message is the failure message, File and Line its location if the
+	// framework reports one.
+	Message string `json:"message,omitempty"`
+	File    string `json:"file,omitempty"`
+	Line    int    `json:"line,omitempty"`
+	Output  string `json:"output,omitempty"`
+}
+
+// Available reports whether an executable is installed.
+type Available func(name string) bool
+
+// RunTests detects the framework if necessary, runs the tests and parses
+// their results. Failing tests are not an error, they are reported in the
+// result. Allow is called with the command line before it runs and aborts
+// the run if it returns an error.
+func RunTests(ctx context.Context, input *RunTestsInput, available Available, allow func(command string) error) (*RunTestsResult, error) {
+	dir := input.WorkingDirectory
+	if input.Path != "" {
+		dir = input.Path
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(input.WorkingDirectory, dir)
+		}
+	}
+	if dir == "" {
+		return nil, invalidInput("path is required if the task has no project directory")
+	}
+
+	framework := input.Framework
+	if framework == "" {
+		if input.Command != "" {
+			framework = FrameworkJUnit
+		} else {
+			var err error
+			if framework, err = Detect(dir, available); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	report, err := os.MkdirTemp("", "construct-tests-")
+	if err != nil {
+		return nil, base.NewCustomError("failed to create a directory for the test report", nil, "error", err.Error())
+	}
+	defer os.RemoveAll(report)
+
+	run, err := newRun(framework, input, dir, report, available)
+	if err != nil {
+		return nil, err
+	}
+	if err := allow(run.command); err != nil {
+		return nil, err
+	}
+
+	timeout := DefaultTimeout
+	if input.Timeout > 0 {
+		timeout = time.Duration(input.Timeout) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, run.args[0], run.args[1:]...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "CI=true", "NO_COLOR=1", "FORCE_COLOR=0")
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	start := time.Now()
+	runErr := cmd.Run()
+	duration := time.Since(start)
+
+	var exitErr *exec.ExitError
+	if runErr != nil && !errors.As(runErr, &exitErr) {
+		return nil, base.NewCustomError(fmt.Sprintf("failed to run %s", run.args[0]), []string{
+			"Check that the test framework is installed",
+			"Set framework to run a different test framework",
+		}, "command", run.command, "error", runErr.Error())
+	}
+
+	tests, output, err := run.parse(stdout.Bytes(), start.Truncate(time.Second))
+	if err != nil && runErr == nil {
+		return nil, base.NewCustomError("failed to parse the test results", []string{
+			"Run the tests with execute_command to inspect the output",
+		}, "command", run.command, "error", err.Error())
+	}
+
+	result := summarize(tests, input.IncludePassed)
+	result.Framework = framework
+	result.Command = run.command
+	result.Duration = duration.Round(time.Millisecond).Seconds()
+	result.TimedOut = ctx.Err() == context.DeadlineExceeded
+	result.Success = runErr == nil && result.Failed == 0
+	if !result.Success && result.Failed == 0 {
+		result.Output = tail(strings.TrimSpace(output+"\n"+stderr.String()), MaxOutput)
+	}
+
+	return result, nil
+}
+
+// summarize counts the tests and orders them failures first, cutting the
+// list and the output of every test to their limits.
+func summarize(tests []TestCase, includePassed bool) *RunTestsResult {
+	result := &RunTestsResult{Tests: []TestCase{}}
+
+	var failed, skipped, passed []TestCase
+	for _, test := range tests {
+		test.Output = tail(strings.TrimSpace(test.Output), MaxTestOutput)
+		if len(test.Message) > MaxMessage {
+			test.Message = test.Message[:MaxMessage] + "..."
+		}
+		switch test.Status {
+		case StatusFailed:
+			result.Failed++
+			failed = append(failed, test)
+		case StatusSkipped:
+			result.Skipped++
+			skipped = append(skipped, test)
+		case StatusPassed:
+			result.Passed++
+			if includePassed {
+				test.Output = ""
+				passed = append(passed, test)
+			}
+		}
+	}
+
+	for _, group := range [][]TestCase{failed, skipped, passed} {
+		for _, test := range group {
+			if len(result.Tests) == MaxListedTests {
+				result.Truncated = true
+				return result
+			}
+			result.Tests = append(result.Tests, test)
+		}
+	}
+	return result
+}
+
+// tail returns the end of s that fits into limit bytes, starting at a line.
+func tail(s string, limit int) string {
+	if len(s) <= limit {
+		return s
+	}
+
+	s = s[len(s)-limit:]
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		s = s[i+1:]
+	}
+	return "...\n" + s
+}
+
+func invalidInput(message string) error {
+	return base.NewCustomError(fmt.Sprintf("%s: %s", base.InvalidInput.String(), message), []string{
+		"Ensure that you provide the correct input arguments as specified in the tool description",
+	})
+}
@@ -0,0 +1,361 @@
+package testrunner
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func writeFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func allAvailable(string) bool { return true }
+
+func TestParseGoTest(t *testing.T) {
+	stdout := `{"Action":"start","Package":"example.com/calc"}
+{"Action":"run","Package":"example.com/calc","Test":"TestAdd"}
+{"Action":"output","Package":"example.com/calc","Test":"TestAdd","Output":"=== RUN   TestAdd\n"}
+{"Action":"output","Package":"example.com/calc","Test":"TestAdd","Output":"--- PASS: TestAdd (0.00s)\n"}
+{"Action":"pass","Package":"example.com/calc","Test":"TestAdd","Elapsed":0.01}
+{"Action":"run","Package":"example.com/calc","Test":"TestDiv"}
+{"Action":"run","Package":"example.com/calc","Test":"TestDiv/by_zero"}
+{"Action":"output","Package":"example.com/calc","Test":"TestDiv/by_zero","Output":"    calc_test.go:21: unexpected result\n"}
+{"Action":"output","Package":"example.com/calc","Test":"TestDiv/by_zero","Output":"        got:  1\n"}
+{"Action":"output","Package":"example.com/calc","Test":"TestDiv/by_zero","Output":"        want: 0\n"}
+{"Action":"output","Package":"example.com/calc","Test":"TestDiv/by_zero","Output":"    --- FAIL: TestDiv/by_zero (0.00s)\n"}
+{"Action":"fail","Package":"example.com/calc","Test":"TestDiv/by_zero","Elapsed":0}
+{"Action":"fail","Package":"example.com/calc","Test":"TestDiv","Elapsed":0}
+{"Action":"run","Package":"example.com/calc","Test":"TestMul"}
+{"Action":"output","Package":"example.com/calc","Test":"TestMul","Output":"    calc_test.go:30: not implemented\n"}
+{"Action":"skip","Package":"example.com/calc","Test":"TestMul","Elapsed":0}
+{"Action":"output","Package":"example.com/calc","Output":"FAIL\n"}
+{"Action":"fail","Package":"example.com/calc","Elapsed":0.02}
+{"ImportPath":"example.com/broken [example.com/broken.test]","Action":"build-output","Output":"# example.com/broken\n"}
+{"ImportPath":"example.com/broken [example.com/broken.test]","Action":"build-output","Output":"broken/broken.go:3:1: syntax error\n"}
+{"ImportPath":"example.com/broken [example.com/broken.test]","Action":"build-fail"}
+{"Action":"start","Package":"example.com/broken"}
+{"Action":"output","Package":"example.com/broken","Output":"FAIL\texample.com/broken [build failed]\n"}
+{"Action":"fail","Package":"example.com/broken","Elapsed":0,"FailedBuild":"example.com/broken [example.com/broken.test]"}
+go: warning: "./missing" matched no packages
+`
+
+	tests, output, err := parseGoTest([]byte(stdout))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []TestCase{
+		{Name: "TestAdd", Suite: "example.com/calc", Status: StatusPassed, Duration: 0.01},
+		{
+			Name:    "TestDiv/by_zero",
+			Suite:   "example.com/calc",
+			Status:  StatusFailed,
+			File:    "calc_test.go",
+			Line:    21,
+			Message: "unexpected result\ngot:  1\nwant: 0",
+			Output:  "    calc_test.go:21: unexpected result\n        got:  1\n        want: 0\n",
+		},
+		{
+			Name:    "TestMul",
+			Suite:   "example.com/calc",
+			Status:  StatusSkipped,
+			File:    "calc_test.go",
+			Line:    30,
+			Message: "not implemented",
+			Output:  "    calc_test.go:30: not implemented\n",
+		},
+		{
+			Suite:   "example.com/broken",
+			Status:  StatusFailed,
+			Message: "build failed",
+			Output:  "# example.com/broken\nbroken/broken.go:3:1: syntax error\n",
+		},
+	}
+	if diff := cmp.Diff(expected, tests); diff != "" {
+		t.Errorf("tests mismatch (-want +got):\n%s", diff)
+	}
+	if output != "go: warning: \"./missing\" matched no packages\n" {
+		t.Errorf("unexpected output %q", output)
+	}
+}
+
+func TestParseJUnit(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Report   string
+		Expected []TestCase
+	}{
+		{
+			Name: "pytest",
+			Report: `<?xml version="1.0" encoding="utf-8"?>
+<testsuites><testsuite name="pytest" tests="3" failures="1" skipped="1">
+<testcase classname="tests.test_api" name="test_get" time="0.002" />
+<testcase classname="tests.test_api" name="test_post" time="0.010"><failure message="assert 404 == 201">def test_post():
+&gt;       assert post().status == 201
+E       assert 404 == 201
+
+tests/test_api.py:12: AssertionError</failure></testcase>
+<testcase classname="tests.test_api" name="test_delete" time="0.000"><skipped type="pytest.skip" message="not supported">tests/test_api.py:15: not supported</skipped></testcase>
+</testsuite></testsuites>`,
+			Expected: []TestCase{
+				{Name: "test_get", Suite: "tests.test_api", Status: StatusPassed, Duration: 0.002},
+				{
+					Name:     "test_post",
+					Suite:    "tests.test_api",
+					Status:   StatusFailed,
+					Duration: 0.01,
+					Message:  "assert 404 == 201",
+					File:     "tests/test_api.py",
+					Line:     12,
+					Output:   "def test_post():\n>       assert post().status == 201\nE       assert 404 == 201\n\ntests/test_api.py:12: AssertionError",
+				},
+				{Name: "test_delete", Suite: "tests.test_api", Status: StatusSkipped, Message: "not supported"},
+			},
+		},
+		{
+			Name: "surefire",
+			Report: `<testsuite name="com.example.AppTest" tests="1" errors="1">
+<testcase name="sum" classname="com.example.AppTest" time="0.05"><error message="expected: &lt;3&gt; but was: &lt;4&gt;" type="org.opentest4j.AssertionFailedError">org.opentest4j.AssertionFailedError: expected: &lt;3&gt; but was: &lt;4&gt;
+	at org.junit.jupiter.api.AssertionUtils.fail(AssertionUtils.java:55)
+	at com.example.AppTest.sum(AppTest.java:14)
+</error><system-out>computing</system-out></testcase>
+</testsuite>`,
+			Expected: []TestCase{
+				{
+					Name:     "sum",
+					Suite:    "com.example.AppTest",
+					Status:   StatusFailed,
+					Duration: 0.05,
+					Message:  "expected: <3> but was: <4>",
+					File:     "AppTest.java",
+					Line:     14,
+					Output:   "org.opentest4j.AssertionFailedError: expected: <3> but was: <4>\n\tat org.junit.jupiter.api.AssertionUtils.fail(AssertionUtils.java:55)\n\tat com.example.AppTest.sum(AppTest.java:14)\n\ncomputing",
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			actual, err := parseJUnit([]byte(test.Report))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.Expected, actual); diff != "" {
+				t.Errorf("tests mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseJest(t *testing.T) {
+	report := `{
+  "numFailedTests": 1,
+  "testResults": [
+    {
+      "name": "/project/src/sum.test.js",
+      "status": "failed",
+      "message": "",
+      "assertionResults": [
+        {"fullName": "sum adds numbers", "status": "passed", "duration": 3, "failureMessages": []},
+        {"fullName": "sum handles negatives", "status": "failed", "duration": 5, "failureMessages": [
+          "Error: \u001b[2mexpect(\u001b[22mreceived\u001b[2m).toBe(expected)\u001b[22m\n\nExpected: -1\nReceived: 1\n    at Object.toBe (/project/node_modules/expect/build/index.js:10:3)\n    at Object.<anonymous> (/project/src/sum.test.js:8:19)"
+        ]},
+        {"fullName": "sum handles floats", "status": "pending", "duration": null, "failureMessages": []}
+      ]
+    },
+    {
+      "name": "/project/src/broken.test.js",
+      "status": "failed",
+      "message": "  ● Test suite failed to run\n\n    SyntaxError: Unexpected token",
+      "assertionResults": []
+    }
+  ]
+}`
+
+	tests, err := parseJest([]byte(report))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []TestCase{
+		{Name: "sum adds numbers", Suite: "/project/src/sum.test.js", Status: StatusPassed, Duration: 0.003},
+		{
+			Name:     "sum handles negatives",
+			Suite:    "/project/src/sum.test.js",
+			Status:   StatusFailed,
+			Duration: 0.005,
+			Message:  "Error: expect(received).toBe(expected)\n\nExpected: -1\nReceived: 1",
+			File:     "/project/src/sum.test.js",
+			Line:     8,
+		},
+		{Name: "sum handles floats", Suite: "/project/src/sum.test.js", Status: StatusSkipped},
+		{
+			Suite:   "/project/src/broken.test.js",
+			Status:  StatusFailed,
+			Message: "● Test suite failed to run",
+			Output:  "  ● Test suite failed to run\n\n    SyntaxError: Unexpected token",
+		},
+	}
+	if diff := cmp.Diff(expected, tests, cmpopts.IgnoreFields(TestCase{}, "Output"), cmpopts.EquateApprox(0, 1e-9)); diff != "" {
+		t.Errorf("tests mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		Name      string
+		Files     map[string]string
+		Available []string
+		Expected  string
+		Error     string
+	}{
+		{
+			Name:      "go module",
+			Files:     map[string]string{"go.mod": "module example.com/app\n"},
+			Available: []string{"go"},
+			Expected:  FrameworkGo,
+		},
+		{
+			Name:      "go module without toolchain",
+			Files:     map[string]string{"go.mod": "module example.com/app\n"},
+			Available: []string{"node"},
+			Error:     "no supported test framework found",
+		},
+		{
+			Name:      "jest",
+			Files:     map[string]string{"package.json": `{"scripts": {"test": "jest --coverage"}}`},
+			Available: []string{"node"},
+			Expected:  FrameworkJest,
+		},
+		{
+			Name:      "vitest",
+			Files:     map[string]string{"package.json": `{"devDependencies": {"vitest": "^2.0.0", "jest": "^29.0.0"}}`},
+			Available: []string{"node"},
+			Expected:  FrameworkVitest,
+		},
+		{
+			Name:      "pytest configured in pyproject.toml",
+			Files:     map[string]string{"pyproject.toml": "[tool.pytest.ini_options]\ntestpaths = [\"tests\"]\n"},
+			Available: []string{"python3"},
+			Expected:  FrameworkPytest,
+		},
+		{
+			Name:      "pyproject.toml without pytest",
+			Files:     map[string]string{"pyproject.toml": "[project]\nname = \"app\"\n"},
+			Available: []string{"python3"},
+			Error:     "no supported test framework found",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range test.Files {
+				writeFile(t, dir, name, content)
+			}
+
+			framework, err := Detect(dir, func(name string) bool {
+				for _, available := range test.Available {
+					if name == available {
+						return true
+					}
+				}
+				return false
+			})
+			if test.Error != "" {
+				if err == nil || !strings.Contains(err.Error(), test.Error) {
+					t.Fatalf("expected error containing %q, got %v", test.Error, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if framework != test.Expected {
+				t.Errorf("expected %s, got %s", test.Expected, framework)
+			}
+		})
+	}
+}
+
+func TestRunTests(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go is not installed")
+	}
+	t.Setenv("GOFLAGS", "")
+	t.Setenv("GOWORK", "off")
+
+	dir := t.TempDir()
+	writeFile(t, dir, "go.mod", "module example.com/calc\n\ngo 1.21\n")
+	writeFile(t, dir, "calc.go", "package calc\n\nfunc Add(a, b int) int { return a - b }\n")
+	writeFile(t, dir, "calc_test.go", `package calc
+
+import "testing"
+
+func TestAdd(t *testing.T) {
+	if got := Add(1, 2); got != 3 {
+		t.Errorf("Add(1, 2) = %d, want 3", got)
+	}
+}
+
+func TestZero(t *testing.T) {
+	if got := Add(0, 0); got != 0 {
+		t.Errorf("Add(0, 0) = %d, want 0", got)
+	}
+}
+`)
+
+	var command string
+	result, err := RunTests(context.Background(), &RunTestsInput{WorkingDirectory: dir}, allAvailable, func(c string) error {
+		command = c
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &RunTestsResult{
+		Framework: FrameworkGo,
+		Command:   "go test -json ./...",
+		Passed:    1,
+		Failed:    1,
+		Tests: []TestCase{{
+			Name:    "TestAdd",
+			Suite:   "example.com/calc",
+			Status:  StatusFailed,
+			File:    "calc_test.go",
+			Line:    7,
+			Message: "Add(1, 2) = -1, want 3",
+			Output:  "calc_test.go:7: Add(1, 2) = -1, want 3",
+		}},
+	}
+	if diff := cmp.Diff(expected, result, cmpopts.IgnoreFields(RunTestsResult{}, "Duration"), cmpopts.IgnoreFields(TestCase{}, "Duration")); diff != "" {
+		t.Errorf("result mismatch (-want +got):\n%s", diff)
+	}
+	if command != expected.Command {
+		t.Errorf("expected the command %q to be checked, got %q", expected.Command, command)
+	}
+
+	writeFile(t, dir, "calc.go", "package calc\n\nfunc Add(a, b int) int { return a + }\n")
+	result, err = RunTests(context.Background(), &RunTestsInput{WorkingDirectory: dir, Filter: "TestZero"}, allAvailable, func(string) error { return nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Success || result.Failed != 1 || !strings.Contains(result.Tests[0].Message+result.Tests[0].Output+result.Output, "syntax error") {
+		t.Errorf("expected the build failure to be reported, got %+v", result)
+	}
+}
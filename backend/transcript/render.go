@@ -0,0 +1,99 @@
+package transcript
+
+import (
+	_ "embed"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"strings"
+	texttemplate "text/template"
+)
+
+type Format string
+
+const (
+	FormatMarkdown Format = "markdown"
+	FormatHTML     Format = "html"
+)
+
+func (f Format) ContentType() string {
+	switch f {
+	case FormatHTML:
+		return "text/html; charset=utf-8"
+	default:
+		return "text/markdown; charset=utf-8"
+	}
+}
+
+//go:embed markdown.tmpl
+var markdownTemplate string
+
+//go:embed html.tmpl
+var htmlTemplate string
+
+var funcs = map[string]any{
+	"fence":     fence,
+	"cost":      formatCost,
+	"diffLines": diffLines,
+}
+
+var (
+	markdown = texttemplate.Must(texttemplate.New("markdown").Funcs(funcs).Parse(markdownTemplate))
+	html     = htmltemplate.Must(htmltemplate.New("html").Funcs(funcs).Parse(htmlTemplate))
+)
+
+// Render writes the transcript to w in the requested format.
+func Render(w io.Writer, transcript *Transcript, format Format) error {
+	switch format {
+	case FormatMarkdown:
+		return markdown.Execute(w, transcript)
+	case FormatHTML:
+		return html.Execute(w, transcript)
+	default:
+		return fmt.Errorf("unsupported transcript format: %s", format)
+	}
+}
+
+// fence returns a markdown code fence that is longer than any run of backticks
+// in content so that scripts containing fenced blocks themselves render correctly.
+func fence(content string) string {
+	longest, current := 0, 0
+	for _, r := range content {
+		if r == '`' {
+			current++
+			longest = max(longest, current)
+		} else {
+			current = 0
+		}
+	}
+
+	return strings.Repeat("`", max(3, longest+1))
+}
+
+func formatCost(cost float64) string {
+	return fmt.Sprintf("$%.4f", cost)
+}
+
+type diffLine struct {
+	Class string
+	Text  string
+}
+
+func diffLines(patch string) []diffLine {
+	var lines []diffLine
+	for _, line := range strings.Split(strings.TrimRight(patch, "\n"), "\n") {
+		class := "context"
+		switch {
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+			class = "file"
+		case strings.HasPrefix(line, "@@"):
+			class = "hunk"
+		case strings.HasPrefix(line, "+"):
+			class = "add"
+		case strings.HasPrefix(line, "-"):
+			class = "del"
+		}
+		lines = append(lines, diffLine{Class: class, Text: line})
+	}
+	return lines
+}
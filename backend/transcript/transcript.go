@@ -0,0 +1,230 @@
+package transcript
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/model"
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/furisto/construct/backend/tool/codeact"
+)
+
+// Transcript is a format independent view of a task conversation that is used to
+// render markdown and HTML documents.
+type Transcript struct {
+	TaskID      string
+	Description string
+	Workspace   string
+	AgentName   string
+	Turns       []Turn
+	Usage       Usage
+	ToolUses    map[string]int64
+}
+
+type Role string
+
+const (
+	RoleUser      Role = "User"
+	RoleAssistant Role = "Assistant"
+	RoleTool      Role = "Tool results"
+)
+
+// Class returns an identifier for the role that is safe to use in HTML attributes.
+func (r Role) Class() string {
+	switch r {
+	case RoleUser:
+		return "user"
+	case RoleAssistant:
+		return "assistant"
+	default:
+		return "tool"
+	}
+}
+
+type Turn struct {
+	Index   int
+	Role    Role
+	Text    []string
+	Scripts []string
+	Results []ScriptResult
+	Usage   *Usage
+}
+
+type ScriptResult struct {
+	Output        string
+	Error         string
+	FunctionCalls []FunctionCall
+}
+
+type FunctionCall struct {
+	Index    int
+	ToolName string
+	Input    string
+	Output   string
+	Patch    string
+}
+
+type Usage struct {
+	InputTokens      int64
+	OutputTokens     int64
+	CacheWriteTokens int64
+	CacheReadTokens  int64
+	Cost             float64
+}
+
+// Build converts a task and its messages, ordered by creation time, into a transcript.
+func Build(task *memory.Task, agentName string, messages []*memory.Message) (*Transcript, error) {
+	transcript := &Transcript{
+		TaskID:      task.ID.String(),
+		Description: task.Description,
+		Workspace:   task.ProjectDirectory,
+		AgentName:   agentName,
+		ToolUses:    task.ToolUses,
+		Usage: Usage{
+			InputTokens:      task.InputTokens,
+			OutputTokens:     task.OutputTokens,
+			CacheWriteTokens: task.CacheWriteTokens,
+			CacheReadTokens:  task.CacheReadTokens,
+			Cost:             task.Cost,
+		},
+	}
+
+	for _, message := range messages {
+		turn, err := buildTurn(message)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render message %s: %w", message.ID, err)
+		}
+
+		if turn.empty() {
+			continue
+		}
+
+		turn.Index = len(transcript.Turns) + 1
+		transcript.Turns = append(transcript.Turns, *turn)
+	}
+
+	return transcript, nil
+}
+
+func buildTurn(message *memory.Message) (*Turn, error) {
+	turn := &Turn{}
+
+	switch message.Source {
+	case types.MessageSourceUser:
+		turn.Role = RoleUser
+	case types.MessageSourceAssistant:
+		turn.Role = RoleAssistant
+	default:
+		turn.Role = RoleTool
+	}
+
+	if message.Usage != nil {
+		turn.Usage = &Usage{
+			InputTokens:      message.Usage.InputTokens,
+			OutputTokens:     message.Usage.OutputTokens,
+			CacheWriteTokens: message.Usage.CacheWriteTokens,
+			CacheReadTokens:  message.Usage.CacheReadTokens,
+			Cost:             message.Usage.Cost,
+		}
+	}
+
+	if message.Content == nil {
+		return turn, nil
+	}
+
+	for _, block := range message.Content.Blocks {
+		switch block.Kind {
+		case types.MessageBlockKindText:
+			turn.Text = append(turn.Text, block.Payload)
+
+		case types.MessageBlockKindCodeInterpreterCall:
+			var toolCall model.ToolCallBlock
+			err := json.Unmarshal([]byte(block.Payload), &toolCall)
+			if err != nil {
+				return nil, fmt.Errorf("failed to unmarshal code interpreter call: %w", err)
+			}
+
+			var input codeact.InterpreterInput
+			err = json.Unmarshal(toolCall.Args, &input)
+			if err != nil {
+				return nil, fmt.Errorf("failed to unmarshal code interpreter args: %w", err)
+			}
+			turn.Scripts = append(turn.Scripts, input.Script)
+
+		case types.MessageBlockKindCodeInterpreterResult:
+			var result codeact.InterpreterToolResult
+			err := json.Unmarshal([]byte(block.Payload), &result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to unmarshal code interpreter result: %w", err)
+			}
+
+			scriptResult := ScriptResult{
+				Output: strings.TrimRight(result.Output, "\n"),
+				Error:  strings.TrimRight(result.Error, "\n"),
+			}
+			for _, call := range result.FunctionCalls {
+				scriptResult.FunctionCalls = append(scriptResult.FunctionCalls, buildFunctionCall(call))
+			}
+			turn.Results = append(turn.Results, scriptResult)
+		}
+	}
+
+	return turn, nil
+}
+
+func buildFunctionCall(call codeact.FunctionCall) FunctionCall {
+	functionCall := FunctionCall{
+		Index:    call.Index + 1,
+		ToolName: call.ToolName,
+		Input:    unwrapJSON(call.Input),
+	}
+
+	if call.ToolName == base.ToolNameEditFile && call.Output.EditFile != nil {
+		functionCall.Patch = strings.TrimRight(call.Output.EditFile.PatchInfo.Patch, "\n")
+		return functionCall
+	}
+
+	if call.ToolName == base.ToolNameApplyPatch && call.Output.ApplyPatch != nil && !call.Output.ApplyPatch.DryRun {
+		var patches []string
+		for _, file := range call.Output.ApplyPatch.Files {
+			patches = append(patches, strings.TrimRight(file.PatchInfo.Patch, "\n"))
+		}
+		functionCall.Patch = strings.Join(patches, "\n")
+		return functionCall
+	}
+
+	functionCall.Output = unwrapJSON(call.Output)
+	return functionCall
+}
+
+// unwrapJSON marshals the single populated field of a FunctionCallInput or
+// FunctionCallOutput, dropping the wrapper object keyed by tool name.
+func unwrapJSON(value any) string {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return ""
+	}
+
+	for _, field := range fields {
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, field, "", "  "); err != nil {
+			return string(field)
+		}
+		return buf.String()
+	}
+
+	return ""
+}
+
+func (t *Turn) empty() bool {
+	return len(t.Text) == 0 && len(t.Scripts) == 0 && len(t.Results) == 0
+}
@@ -0,0 +1,364 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/furisto/construct/backend/event"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	memory_webhook "github.com/furisto/construct/backend/memory/webhook"
+	"github.com/furisto/construct/backend/secret"
+	"github.com/google/uuid"
+)
+
+type DispatcherOptions struct {
+	HTTPClient     *http.Client
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+func DefaultDispatcherOptions() *DispatcherOptions {
+	return &DispatcherOptions{
+		HTTPClient:     &http.Client{Timeout: 10 * time.Second},
+		MaxAttempts:    5,
+		InitialBackoff: time.Second,
+		MaxBackoff:     time.Minute,
+	}
+}
+
+type DispatcherOption func(*DispatcherOptions)
+
+func WithHTTPClient(client *http.Client) DispatcherOption {
+	return func(o *DispatcherOptions) {
+		o.HTTPClient = client
+	}
+}
+
+func WithRetry(maxAttempts int, initialBackoff, maxBackoff time.Duration) DispatcherOption {
+	return func(o *DispatcherOptions) {
+		o.MaxAttempts = maxAttempts
+		o.InitialBackoff = initialBackoff
+		o.MaxBackoff = maxBackoff
+	}
+}
+
+// Dispatcher delivers task events from the event bus to the webhooks that
+// subscribed to them. The enabled webhooks are cached until a webhook changes.
+// Every webhook has its own queue, so that its deliveries are sent one after
+// the other in the order the dispatcher received the events, and a slow
+// receiver only delays its own deliveries. Deliveries that fail on every
+// attempt are stored as dead letters.
+type Dispatcher struct {
+	memory     *memory.Client
+	encryption *secret.Encryption
+	bus        *event.Bus
+	options    *DispatcherOptions
+	logger     *slog.Logger
+
+	ctx context.Context
+	wg  sync.WaitGroup
+
+	mu         sync.Mutex
+	webhooks   []*memory.Webhook
+	loaded     bool
+	generation uint64
+	queues     map[uuid.UUID]*deliveryQueue
+}
+
+// deliveryQueue holds the pending deliveries of a webhook. A worker is running
+// while the queue is not empty.
+type deliveryQueue struct {
+	pending []delivery
+}
+
+type delivery struct {
+	webhook *memory.Webhook
+	taskID  uuid.UUID
+	payload Payload
+}
+
+func NewDispatcher(memory *memory.Client, encryption *secret.Encryption, bus *event.Bus, opts ...DispatcherOption) *Dispatcher {
+	options := DefaultDispatcherOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	return &Dispatcher{
+		memory:     memory,
+		encryption: encryption,
+		bus:        bus,
+		options:    options,
+		logger:     slog.With("component", "webhook_dispatcher"),
+		queues:     make(map[uuid.UUID]*deliveryQueue),
+	}
+}
+
+func (d *Dispatcher) Run(ctx context.Context) error {
+	d.ctx = ctx
+
+	subscriptions := []*event.Subscription{
+		event.Subscribe(d.bus, func(_ context.Context, e event.WebhookChangedEvent) {
+			d.invalidate()
+		}, nil),
+		event.Subscribe(d.bus, func(_ context.Context, e event.TaskPhaseChangedEvent) {
+			d.dispatch(types.WebhookEventTaskPhaseChanged, e.TaskID, PhaseChangedData{
+				Phase:         e.Phase,
+				PreviousPhase: e.PreviousPhase,
+			}, nil)
+		}, nil),
+		event.Subscribe(d.bus, func(_ context.Context, e event.TaskResponseEvent) {
+			d.dispatch(types.WebhookEventTaskResponse, e.TaskID, ResponseData{
+				MessageID: e.MessageID.String(),
+				Content:   e.Content,
+			}, nil)
+		}, nil),
+		event.Subscribe(d.bus, func(_ context.Context, e event.TaskErrorEvent) {
+			d.dispatch(types.WebhookEventTaskError, e.TaskID, ErrorData{
+				Error: e.Error,
+			}, nil)
+		}, nil),
+		event.Subscribe(d.bus, func(_ context.Context, e event.TaskCostEvent) {
+			d.dispatch(types.WebhookEventTaskCostThreshold, e.TaskID, nil, func(w *memory.Webhook) any {
+				// only notify once, when the cost crosses the threshold
+				if w.CostThreshold <= 0 || e.PreviousCost >= w.CostThreshold || e.Cost < w.CostThreshold {
+					return nil
+				}
+				return CostThresholdData{
+					Cost:      e.Cost,
+					Threshold: w.CostThreshold,
+				}
+			})
+		}, nil),
+	}
+
+	<-ctx.Done()
+
+	for _, sub := range subscriptions {
+		sub.Unsubscribe()
+	}
+	d.wg.Wait()
+
+	return nil
+}
+
+// dispatch sends an event to every enabled webhook that subscribed to it. If
+// dataFor is set, it computes the data per webhook and a nil result skips the
+// webhook.
+func (d *Dispatcher) dispatch(eventType types.WebhookEventType, taskID uuid.UUID, data any, dataFor func(*memory.Webhook) any) {
+	ctx := d.ctx
+	if ctx == nil || ctx.Err() != nil {
+		return
+	}
+
+	webhooks, err := d.enabledWebhooks(ctx)
+	if err != nil {
+		d.logger.ErrorContext(ctx, "failed to fetch webhooks", "error", err)
+		return
+	}
+
+	for _, w := range webhooks {
+		if !slices.Contains(w.Events, eventType) {
+			continue
+		}
+
+		webhookData := data
+		if dataFor != nil {
+			webhookData = dataFor(w)
+			if webhookData == nil {
+				continue
+			}
+		}
+
+		payload := Payload{
+			ID:        uuid.New().String(),
+			Event:     eventType,
+			Timestamp: time.Now().UTC(),
+			TaskID:    taskID.String(),
+			Data:      webhookData,
+		}
+
+		d.enqueue(ctx, delivery{webhook: w, taskID: taskID, payload: payload})
+	}
+}
+
+// enabledWebhooks returns the cached enabled webhooks and loads them if a
+// webhook changed since they were cached.
+func (d *Dispatcher) enabledWebhooks(ctx context.Context) ([]*memory.Webhook, error) {
+	d.mu.Lock()
+	if d.loaded {
+		webhooks := d.webhooks
+		d.mu.Unlock()
+		return webhooks, nil
+	}
+	generation := d.generation
+	d.mu.Unlock()
+
+	webhooks, err := d.memory.Webhook.Query().
+		Where(memory_webhook.Enabled(true)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	// a webhook changed while loading, the next event loads them again
+	if generation == d.generation {
+		d.webhooks = webhooks
+		d.loaded = true
+	}
+	return webhooks, nil
+}
+
+func (d *Dispatcher) invalidate() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.generation++
+	d.loaded = false
+	d.webhooks = nil
+}
+
+// enqueue adds a delivery to the queue of its webhook and starts a worker for
+// the queue if none is running.
+func (d *Dispatcher) enqueue(ctx context.Context, next delivery) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	id := next.webhook.ID
+	queue, running := d.queues[id]
+	if !running {
+		queue = &deliveryQueue{}
+		d.queues[id] = queue
+	}
+	queue.pending = append(queue.pending, next)
+	if running {
+		return
+	}
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		d.work(ctx, id, queue)
+	}()
+}
+
+// work sends the deliveries of a webhook in order until its queue is empty.
+func (d *Dispatcher) work(ctx context.Context, id uuid.UUID, queue *deliveryQueue) {
+	for {
+		d.mu.Lock()
+		if len(queue.pending) == 0 {
+			delete(d.queues, id)
+			d.mu.Unlock()
+			return
+		}
+		next := queue.pending[0]
+		queue.pending = queue.pending[1:]
+		d.mu.Unlock()
+
+		d.deliver(ctx, next.webhook, next.taskID, next.payload)
+	}
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, w *memory.Webhook, taskID uuid.UUID, payload Payload) {
+	logger := d.logger.With("webhook_id", w.ID, "delivery_id", payload.ID, "event", payload.Event)
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to marshal webhook payload", "error", err)
+		return
+	}
+
+	secretValue, err := d.encryption.Decrypt(w.Secret, secret.WebhookAssociated(w.ID))
+	if err != nil {
+		d.deadLetter(ctx, w, taskID, payload, body, 0, fmt.Errorf("failed to decrypt webhook secret: %w", err))
+		return
+	}
+
+	var lastErr error
+	attempts := 0
+	backoff := d.options.InitialBackoff
+	for attempts < d.options.MaxAttempts {
+		attempts++
+
+		retryable, err := d.send(ctx, w, payload, body, secretValue)
+		if err == nil {
+			logger.DebugContext(ctx, "webhook delivered", "attempts", attempts)
+			return
+		}
+		lastErr = err
+		logger.WarnContext(ctx, "webhook delivery failed", "error", err, "attempt", attempts)
+
+		if !retryable || attempts == d.options.MaxAttempts {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			d.deadLetter(ctx, w, taskID, payload, body, attempts, fmt.Errorf("delivery aborted during shutdown: %w", lastErr))
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, d.options.MaxBackoff)
+	}
+
+	d.deadLetter(ctx, w, taskID, payload, body, attempts, lastErr)
+}
+
+func (d *Dispatcher) send(ctx context.Context, w *memory.Webhook, payload Payload, body, secretValue []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "construct-webhook")
+	req.Header.Set(HeaderEvent, string(payload.Event))
+	req.Header.Set(HeaderDelivery, payload.ID)
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, Sign(secretValue, timestamp, body))
+
+	resp, err := d.options.HTTPClient.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+
+	retryable := resp.StatusCode >= 500 ||
+		resp.StatusCode == http.StatusTooManyRequests ||
+		resp.StatusCode == http.StatusRequestTimeout
+	return retryable, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+}
+
+func (d *Dispatcher) deadLetter(ctx context.Context, w *memory.Webhook, taskID uuid.UUID, payload Payload, body []byte, attempts int, deliveryErr error) {
+	_, err := d.memory.WebhookDeadLetter.Create().
+		SetWebhookID(w.ID).
+		SetTaskID(taskID).
+		SetEventType(payload.Event).
+		SetPayload(string(body)).
+		SetAttempts(attempts).
+		SetLastError(deliveryErr.Error()).
+		Save(context.WithoutCancel(ctx))
+	if err != nil {
+		d.logger.ErrorContext(ctx, "failed to record webhook dead letter",
+			"webhook_id", w.ID,
+			"delivery_id", payload.ID,
+			"error", err,
+		)
+	}
+}
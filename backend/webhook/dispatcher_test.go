@@ -0,0 +1,346 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/furisto/construct/backend/event"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/secret"
+	"github.com/google/uuid"
+	_ "modernc.org/sqlite"
+)
+
+type receivedDelivery struct {
+	header  http.Header
+	body    []byte
+	payload Payload
+}
+
+type receiver struct {
+	server *httptest.Server
+
+	mu         sync.Mutex
+	deliveries []receivedDelivery
+	failures   atomic.Int32
+	status     int
+}
+
+// newReceiver starts a webhook receiver that responds with status to the first
+// failures requests and with 204 afterwards.
+func newReceiver(t *testing.T, failures int32, status int) *receiver {
+	r := &receiver{status: status}
+	r.failures.Store(failures)
+	r.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		if r.failures.Add(-1) >= 0 {
+			w.WriteHeader(r.status)
+			return
+		}
+
+		var payload Payload
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Errorf("failed to unmarshal payload: %v", err)
+		}
+
+		r.mu.Lock()
+		r.deliveries = append(r.deliveries, receivedDelivery{header: req.Header.Clone(), body: body, payload: payload})
+		r.mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(r.server.Close)
+	return r
+}
+
+func (r *receiver) received() []receivedDelivery {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]receivedDelivery(nil), r.deliveries...)
+}
+
+type dispatcherTestSetup struct {
+	db         *memory.Client
+	encryption *secret.Encryption
+	bus        *event.Bus
+	dispatcher *Dispatcher
+}
+
+func newDispatcherTestSetup(t *testing.T) *dispatcherTestSetup {
+	t.Helper()
+
+	db, err := memory.Open(dialect.SQLite, "file:construct_test?mode=memory&cache=private&_pragma=foreign_keys(1)")
+	if err != nil {
+		t.Fatalf("failed opening connection to sqlite: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	if err := db.Schema.Create(t.Context()); err != nil {
+		t.Fatalf("failed to create schema: %v", err)
+	}
+
+	keyset, err := secret.GenerateKeyset()
+	if err != nil {
+		t.Fatalf("failed generating keyset: %v", err)
+	}
+
+	encryption, err := secret.NewClient(keyset)
+	if err != nil {
+		t.Fatalf("failed creating encryption client: %v", err)
+	}
+
+	bus := event.NewBus(nil)
+	t.Cleanup(bus.Close)
+
+	dispatcher := NewDispatcher(db, encryption, bus, WithRetry(3, time.Millisecond, 5*time.Millisecond))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		dispatcher.Run(ctx)
+		close(done)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	deadline := time.Now().Add(time.Second)
+	for event.SubscriberCount[event.TaskCostEvent](bus) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("dispatcher did not subscribe to the event bus")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	return &dispatcherTestSetup{
+		db:         db,
+		encryption: encryption,
+		bus:        bus,
+		dispatcher: dispatcher,
+	}
+}
+
+func (s *dispatcherTestSetup) createWebhook(t *testing.T, url, webhookSecret string, costThreshold float64, events ...types.WebhookEventType) *memory.Webhook {
+	t.Helper()
+
+	id := uuid.New()
+	encrypted, err := s.encryption.Encrypt([]byte(webhookSecret), secret.WebhookAssociated(id))
+	if err != nil {
+		t.Fatalf("failed to encrypt secret: %v", err)
+	}
+
+	create := s.db.Webhook.Create().
+		SetID(id).
+		SetName(id.String()).
+		SetURL(url).
+		SetSecret(encrypted).
+		SetEvents(events)
+	if costThreshold > 0 {
+		create = create.SetCostThreshold(costThreshold)
+	}
+
+	w, err := create.Save(t.Context())
+	if err != nil {
+		t.Fatalf("failed to create webhook: %v", err)
+	}
+	return w
+}
+
+func waitFor(t *testing.T, condition func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met before timeout")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestDispatcherDeliversSignedEvents(t *testing.T) {
+	setup := newDispatcherTestSetup(t)
+	recv := newReceiver(t, 0, 0)
+	setup.createWebhook(t, recv.server.URL, "s3cret", 0, types.WebhookEventTaskResponse)
+
+	taskID := uuid.New()
+	messageID := uuid.New()
+
+	// not subscribed, must not be delivered
+	event.Publish(setup.bus, event.TaskErrorEvent{TaskID: taskID, Error: "boom"})
+	event.Publish(setup.bus, event.TaskResponseEvent{TaskID: taskID, MessageID: messageID, Content: "All tests pass."})
+
+	waitFor(t, func() bool { return len(recv.received()) == 1 })
+
+	delivery := recv.received()[0]
+	if !Verify([]byte("s3cret"), delivery.body, delivery.header.Get(HeaderTimestamp), delivery.header.Get(HeaderSignature), DefaultTolerance) {
+		t.Errorf("invalid signature %q", delivery.header.Get(HeaderSignature))
+	}
+	if got := delivery.header.Get(HeaderEvent); got != string(types.WebhookEventTaskResponse) {
+		t.Errorf("unexpected event header %q", got)
+	}
+	if delivery.header.Get(HeaderDelivery) != delivery.payload.ID {
+		t.Errorf("delivery header %q does not match payload id %q", delivery.header.Get(HeaderDelivery), delivery.payload.ID)
+	}
+	if delivery.payload.TaskID != taskID.String() {
+		t.Errorf("unexpected task id %q", delivery.payload.TaskID)
+	}
+
+	data := delivery.payload.Data.(map[string]any)
+	if data["content"] != "All tests pass." || data["message_id"] != messageID.String() {
+		t.Errorf("unexpected data %v", data)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	if n := len(recv.received()); n != 1 {
+		t.Errorf("expected 1 delivery, got %d", n)
+	}
+}
+
+func TestDispatcherRetriesFailedDeliveries(t *testing.T) {
+	setup := newDispatcherTestSetup(t)
+	recv := newReceiver(t, 2, http.StatusServiceUnavailable)
+	setup.createWebhook(t, recv.server.URL, "s3cret", 0, types.WebhookEventTaskError)
+
+	event.Publish(setup.bus, event.TaskErrorEvent{TaskID: uuid.New(), Error: "model overloaded"})
+
+	waitFor(t, func() bool { return len(recv.received()) == 1 })
+
+	count, err := setup.db.WebhookDeadLetter.Query().Count(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 {
+		t.Errorf("expected no dead letters, got %d", count)
+	}
+}
+
+func TestDispatcherRecordsDeadLetters(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		attempts int
+	}{
+		{name: "retries exhausted", status: http.StatusInternalServerError, attempts: 3},
+		{name: "not retryable", status: http.StatusBadRequest, attempts: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup := newDispatcherTestSetup(t)
+			recv := newReceiver(t, 100, tt.status)
+			w := setup.createWebhook(t, recv.server.URL, "s3cret", 0, types.WebhookEventTaskPhaseChanged)
+
+			taskID := uuid.New()
+			event.Publish(setup.bus, event.TaskPhaseChangedEvent{
+				TaskID:        taskID,
+				Phase:         types.TaskPhaseAwaiting,
+				PreviousPhase: types.TaskPhaseRunning,
+			})
+
+			var deadLetters []*memory.WebhookDeadLetter
+			waitFor(t, func() bool {
+				deadLetters, _ = setup.db.WebhookDeadLetter.Query().All(t.Context())
+				return len(deadLetters) == 1
+			})
+
+			d := deadLetters[0]
+			if d.WebhookID != w.ID || d.TaskID != taskID || d.EventType != types.WebhookEventTaskPhaseChanged {
+				t.Errorf("unexpected dead letter %+v", d)
+			}
+			if d.Attempts != tt.attempts {
+				t.Errorf("expected %d attempts, got %d", tt.attempts, d.Attempts)
+			}
+
+			var payload Payload
+			if err := json.Unmarshal([]byte(d.Payload), &payload); err != nil {
+				t.Fatalf("failed to unmarshal dead letter payload: %v", err)
+			}
+			if payload.Event != types.WebhookEventTaskPhaseChanged {
+				t.Errorf("unexpected payload event %q", payload.Event)
+			}
+		})
+	}
+}
+
+func TestDispatcherCostThreshold(t *testing.T) {
+	setup := newDispatcherTestSetup(t)
+	recv := newReceiver(t, 0, 0)
+	setup.createWebhook(t, recv.server.URL, "s3cret", 1.0, types.WebhookEventTaskCostThreshold)
+
+	taskID := uuid.New()
+	event.Publish(setup.bus, event.TaskCostEvent{TaskID: taskID, PreviousCost: 0.2, Cost: 0.6})
+	event.Publish(setup.bus, event.TaskCostEvent{TaskID: taskID, PreviousCost: 0.6, Cost: 1.1})
+	event.Publish(setup.bus, event.TaskCostEvent{TaskID: taskID, PreviousCost: 1.1, Cost: 1.5})
+
+	waitFor(t, func() bool { return len(recv.received()) == 1 })
+	time.Sleep(20 * time.Millisecond)
+
+	deliveries := recv.received()
+	if len(deliveries) != 1 {
+		t.Fatalf("expected 1 delivery, got %d", len(deliveries))
+	}
+
+	data := deliveries[0].payload.Data.(map[string]any)
+	if data["cost"] != 1.1 || data["threshold"] != 1.0 {
+		t.Errorf("unexpected data %v", data)
+	}
+}
+
+func TestDispatcherDeliversInOrder(t *testing.T) {
+	setup := newDispatcherTestSetup(t)
+	// the first attempt fails, later deliveries must wait for its retry
+	recv := newReceiver(t, 1, http.StatusServiceUnavailable)
+	setup.createWebhook(t, recv.server.URL, "s3cret", 0, types.WebhookEventTaskError)
+
+	taskID := uuid.New()
+	for _, message := range []string{"first", "second", "third"} {
+		setup.dispatcher.dispatch(types.WebhookEventTaskError, taskID, ErrorData{Error: message}, nil)
+	}
+
+	waitFor(t, func() bool { return len(recv.received()) == 3 })
+
+	for i, expected := range []string{"first", "second", "third"} {
+		data := recv.received()[i].payload.Data.(map[string]any)
+		if data["error"] != expected {
+			t.Errorf("expected delivery %d to be %q, got %v", i+1, expected, data["error"])
+		}
+	}
+}
+
+func TestDispatcherReloadsChangedWebhooks(t *testing.T) {
+	setup := newDispatcherTestSetup(t)
+	first := newReceiver(t, 0, 0)
+	setup.createWebhook(t, first.server.URL, "s3cret", 0, types.WebhookEventTaskError)
+
+	event.Publish(setup.bus, event.TaskErrorEvent{TaskID: uuid.New(), Error: "boom"})
+	waitFor(t, func() bool { return len(first.received()) == 1 })
+
+	// webhooks are cached until a change is published
+	second := newReceiver(t, 0, 0)
+	w := setup.createWebhook(t, second.server.URL, "s3cret", 0, types.WebhookEventTaskError)
+	event.Publish(setup.bus, event.TaskErrorEvent{TaskID: uuid.New(), Error: "boom"})
+	waitFor(t, func() bool { return len(first.received()) == 2 })
+	if n := len(second.received()); n != 0 {
+		t.Fatalf("expected no delivery to the uncached webhook, got %d", n)
+	}
+
+	event.Publish(setup.bus, event.WebhookChangedEvent{WebhookID: w.ID})
+	waitFor(t, func() bool {
+		setup.dispatcher.mu.Lock()
+		defer setup.dispatcher.mu.Unlock()
+		return !setup.dispatcher.loaded
+	})
+
+	event.Publish(setup.bus, event.TaskErrorEvent{TaskID: uuid.New(), Error: "boom"})
+	waitFor(t, func() bool { return len(first.received()) == 3 && len(second.received()) == 1 })
+}
@@ -0,0 +1,80 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/furisto/construct/backend/memory/schema/types"
+)
+
+const (
+	HeaderEvent     = "X-Construct-Event"
+	HeaderDelivery  = "X-Construct-Delivery"
+	HeaderSignature = "X-Construct-Signature"
+	HeaderTimestamp = "X-Construct-Timestamp"
+
+	signaturePrefix = "sha256="
+
+	// DefaultTolerance is how far the timestamp of a delivery may be from the
+	// time of the receiver before Verify rejects it as a replay.
+	DefaultTolerance = 5 * time.Minute
+)
+
+// Payload is the JSON body of every webhook delivery.
+type Payload struct {
+	ID        string                 `json:"id"`
+	Event     types.WebhookEventType `json:"event"`
+	Timestamp time.Time              `json:"timestamp"`
+	TaskID    string                 `json:"task_id"`
+	Data      any                    `json:"data"`
+}
+
+type PhaseChangedData struct {
+	Phase         types.TaskPhase `json:"phase"`
+	PreviousPhase types.TaskPhase `json:"previous_phase"`
+}
+
+type ResponseData struct {
+	MessageID string `json:"message_id"`
+	Content   string `json:"content"`
+}
+
+type ErrorData struct {
+	Error string `json:"error"`
+}
+
+type CostThresholdData struct {
+	Cost      float64 `json:"cost"`
+	Threshold float64 `json:"threshold"`
+}
+
+// Sign returns the value of the signature header for a delivery body sent at
+// timestamp, the Unix time in seconds of the timestamp header. The signature
+// covers the timestamp followed by a dot and the body, so that a captured
+// delivery cannot be replayed with a new timestamp. Receivers recompute it with
+// their copy of the secret and compare it with Verify.
+func Sign(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature is a valid signature of body and
+// timestamp, and whether timestamp is within tolerance of the current time.
+func Verify(secret, body []byte, timestamp, signature string, tolerance time.Duration) bool {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+
+	age := time.Since(time.Unix(seconds, 0))
+	if age > tolerance || age < -tolerance {
+		return false
+	}
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
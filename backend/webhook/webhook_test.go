@@ -0,0 +1,75 @@
+package webhook
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestVerify(t *testing.T) {
+	secret := []byte("s3cret")
+	body := []byte(`{"event":"task.response"}`)
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	expired := strconv.FormatInt(time.Now().Add(-10*time.Minute).Unix(), 10)
+	future := strconv.FormatInt(time.Now().Add(10*time.Minute).Unix(), 10)
+
+	tests := []struct {
+		Name      string
+		Body      []byte
+		Timestamp string
+		Signature string
+		Valid     bool
+	}{
+		{
+			Name:      "valid signature",
+			Body:      body,
+			Timestamp: now,
+			Signature: Sign(secret, now, body),
+			Valid:     true,
+		},
+		{
+			Name:      "modified body",
+			Body:      []byte(`{"event":"task.error"}`),
+			Timestamp: now,
+			Signature: Sign(secret, now, body),
+		},
+		{
+			Name:      "replayed with a new timestamp",
+			Body:      body,
+			Timestamp: now,
+			Signature: Sign(secret, expired, body),
+		},
+		{
+			Name:      "timestamp outside of the tolerance",
+			Body:      body,
+			Timestamp: expired,
+			Signature: Sign(secret, expired, body),
+		},
+		{
+			Name:      "timestamp in the future",
+			Body:      body,
+			Timestamp: future,
+			Signature: Sign(secret, future, body),
+		},
+		{
+			Name:      "invalid timestamp",
+			Body:      body,
+			Timestamp: "yesterday",
+			Signature: Sign(secret, "yesterday", body),
+		},
+		{
+			Name:      "other secret",
+			Body:      body,
+			Timestamp: now,
+			Signature: Sign([]byte("other"), now, body),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			if valid := Verify(secret, tt.Body, tt.Timestamp, tt.Signature, DefaultTolerance); valid != tt.Valid {
+				t.Errorf("expected valid %t, got %t", tt.Valid, valid)
+			}
+		})
+	}
+}
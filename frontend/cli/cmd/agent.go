@@ -7,6 +7,7 @@ import (
 	"connectrpc.com/connect"
 	api "github.com/furisto/construct/api/go/client"
 	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/shared/config"
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
 )
@@ -86,6 +87,23 @@ func getAgentID(ctx context.Context, client *api.Client, idOrName string) (strin
 	return agentResp.Msg.Agents[0].Metadata.Id, nil
 }
 
+// defaultAgent returns the agent configured by defaults.agent, preferring the
+// project configuration of the workspace over the user configuration.
+func defaultAgent(ctx context.Context, workspace string) (string, error) {
+	configStore := getConfigStore(ctx)
+	if err := configStore.LoadProject(workspace); err != nil {
+		return "", err
+	}
+
+	if value, found := configStore.Get("defaults.agent"); found {
+		if agent, ok := value.String(); ok && agent != "" {
+			return agent, nil
+		}
+	}
+
+	return "", fmt.Errorf("no agent specified: use --agent or set defaults.agent in %s/%s or with 'construct config set defaults.agent <name>'", config.ProjectConfigDir, config.ProjectConfigFile)
+}
+
 func getModelID(ctx context.Context, client *api.Client, idOrName string) (string, error) {
 	_, err := uuid.Parse(idOrName)
 	if err == nil {
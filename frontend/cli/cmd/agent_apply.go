@@ -10,6 +10,7 @@ import (
 	v1 "github.com/furisto/construct/api/go/v1"
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
 	"gopkg.in/yaml.v3"
 )
 
@@ -24,6 +25,37 @@ type AgentSpec struct {
 	Description  string `yaml:"description,omitempty"`
 	Instructions string `yaml:"instructions"`
 	Model        string `yaml:"model"`
+
+	McpServers   []McpServerSpec   `yaml:"mcp_servers,omitempty"`
+	ToolSet      *ToolSetSpec      `yaml:"tool_set,omitempty"`
+	ScriptLimits *ScriptLimitsSpec `yaml:"script_limits,omitempty"`
+}
+
+// ScriptLimitsSpec restricts the resources of a single CodeAct script of the
+// agent. Omitted limits use the defaults of the daemon.
+type ScriptLimitsSpec struct {
+	TimeoutSeconds     int32 `yaml:"timeout_seconds,omitempty"`
+	MaxToolCalls       int32 `yaml:"max_tool_calls,omitempty"`
+	MaxOutputBytes     int32 `yaml:"max_output_bytes,omitempty"`
+	MaxToolResultBytes int32 `yaml:"max_tool_result_bytes,omitempty"`
+}
+
+// ToolSetSpec restricts the tools the agent may call. A '*' in an allowed
+// tool name matches any sequence of characters.
+type ToolSetSpec struct {
+	AllowedTools []string `yaml:"allowed_tools,omitempty"`
+	ReadOnly     bool     `yaml:"read_only,omitempty"`
+}
+
+// McpServerSpec configures an MCP server of the agent. Either command or url
+// must be set to select the stdio or the streamable HTTP transport.
+type McpServerSpec struct {
+	Name    string            `yaml:"name"`
+	Command string            `yaml:"command,omitempty"`
+	Args    []string          `yaml:"args,omitempty"`
+	Env     map[string]string `yaml:"env,omitempty"`
+	URL     string            `yaml:"url,omitempty"`
+	Headers map[string]string `yaml:"headers,omitempty"`
 }
 
 func NewAgentApplyCmd() *cobra.Command {
@@ -98,6 +130,14 @@ func parseAgentSpecFile(filename string) (*AgentSpec, error) {
 	if spec.Model == "" {
 		return nil, fmt.Errorf("model is required")
 	}
+	for _, server := range spec.McpServers {
+		if server.Name == "" {
+			return nil, fmt.Errorf("mcp server name is required")
+		}
+		if (server.Command == "") == (server.URL == "") {
+			return nil, fmt.Errorf("mcp server %s requires either a command or a url", server.Name)
+		}
+	}
 
 	return &spec, nil
 }
@@ -121,6 +161,9 @@ func createAgentFromSpec(ctx context.Context, client *api.Client, spec *AgentSpe
 			Description:  spec.Description,
 			Instructions: spec.Instructions,
 			ModelId:      modelID,
+			McpServers:   convertMcpServerSpecs(spec.McpServers),
+			ToolSet:      convertToolSetSpec(spec.ToolSet),
+			ScriptLimits: convertScriptLimitsSpec(spec.ScriptLimits),
 		},
 	})
 	if err != nil {
@@ -178,6 +221,26 @@ func updateAgentFromSpec(ctx context.Context, client *api.Client, spec *AgentSpe
 	if modelID != currentAgent.Spec.ModelId {
 		updateReq.ModelId = &modelID
 	}
+	mcpServers := &v1.McpServers{Servers: convertMcpServerSpecs(spec.McpServers)}
+	if !proto.Equal(mcpServers, &v1.McpServers{Servers: currentAgent.Spec.McpServers}) {
+		updateReq.McpServers = mcpServers
+	}
+	toolSet := convertToolSetSpec(spec.ToolSet)
+	if !proto.Equal(toolSet, currentAgent.Spec.ToolSet) {
+		if toolSet == nil {
+			// an empty tool set removes the restrictions
+			toolSet = &v1.ToolSet{}
+		}
+		updateReq.ToolSet = toolSet
+	}
+	scriptLimits := convertScriptLimitsSpec(spec.ScriptLimits)
+	if !proto.Equal(scriptLimits, currentAgent.Spec.ScriptLimits) {
+		if scriptLimits == nil {
+			// empty limits restore the defaults
+			scriptLimits = &v1.ScriptLimits{}
+		}
+		updateReq.ScriptLimits = scriptLimits
+	}
 
 	// Apply the update
 	_, err = client.Agent().UpdateAgent(ctx, &connect.Request[v1.UpdateAgentRequest]{
@@ -190,3 +253,53 @@ func updateAgentFromSpec(ctx context.Context, client *api.Client, spec *AgentSpe
 	fmt.Fprintf(cmd.OutOrStdout(), "agent.construct.ai/%s configured\n", spec.Name)
 	return nil
 }
+
+func convertMcpServerSpecs(specs []McpServerSpec) []*v1.McpServer {
+	var servers []*v1.McpServer
+	for _, spec := range specs {
+		server := &v1.McpServer{Name: spec.Name}
+		if spec.Command != "" {
+			server.Transport = &v1.McpServer_Stdio{
+				Stdio: &v1.McpServer_StdioTransport{
+					Command: spec.Command,
+					Args:    spec.Args,
+					Env:     spec.Env,
+				},
+			}
+		} else {
+			server.Transport = &v1.McpServer_Http{
+				Http: &v1.McpServer_HttpTransport{
+					Url:     spec.URL,
+					Headers: spec.Headers,
+				},
+			}
+		}
+		servers = append(servers, server)
+	}
+
+	return servers
+}
+
+func convertToolSetSpec(spec *ToolSetSpec) *v1.ToolSet {
+	if spec == nil || (len(spec.AllowedTools) == 0 && !spec.ReadOnly) {
+		return nil
+	}
+
+	return &v1.ToolSet{
+		AllowedTools: spec.AllowedTools,
+		ReadOnly:     spec.ReadOnly,
+	}
+}
+
+func convertScriptLimitsSpec(spec *ScriptLimitsSpec) *v1.ScriptLimits {
+	if spec == nil || *spec == (ScriptLimitsSpec{}) {
+		return nil
+	}
+
+	return &v1.ScriptLimits{
+		TimeoutSeconds:     spec.TimeoutSeconds,
+		MaxToolCalls:       spec.MaxToolCalls,
+		MaxOutputBytes:     spec.MaxOutputBytes,
+		MaxToolResultBytes: spec.MaxToolResultBytes,
+	}
+}
@@ -2,8 +2,12 @@ package cmd
 
 import (
 	"fmt"
+	"path/filepath"
+	"strings"
 
+	"github.com/furisto/construct/shared/config"
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 )
 
 type configDescription struct {
@@ -15,18 +19,64 @@ type configDescription struct {
 
 var configDescriptions = map[string]configDescription{
 	"defaults.agent": {
-		Description: "Specifies the default agent to use when running `construct new` without the\n  --agent flag. This allows you to set a preferred agent for new conversations.",
+		Description: "Specifies the default agent to use when running `construct new` or `construct exec`\n  without the --agent flag. This allows you to set a preferred agent for new conversations.",
 		Type:        "String (Agent Name or ID)",
 		Example:     "construct config set defaults.agent \"my-favorite-agent\"",
 	},
+	"permissions.policy": {
+		Description: "Controls which tools agents may use. `allow` permits every tool, `read-only`\n  denies tools that create or edit files and run commands.",
+		Type:        "String (allow, read-only)",
+		Default:     string(config.PermissionPolicyAllow),
+		Example:     "construct config set permissions.policy read-only",
+	},
+	"permissions.commands": {
+		Description: "Limits the commands agents may run to those matching one of the patterns.\n  A '*' matches any sequence of characters. Chained commands must match individually,\n  redirections and options that run other programs, such as -exec, are rejected.",
+		Type:        "List of Strings",
+		Default:     "(all commands)",
+		Example:     "permissions:\n    commands: [\"go test *\", \"make lint\"]",
+	},
+	"permissions.destructive_git": {
+		Description: "Permits git operations that discard work, such as `git reset --hard`, `git clean -f`,\n  force pushes, force deleting branches and dropping stash entries.",
+		Type:        "Boolean",
+		Default:     "false",
+		Example:     "construct config set permissions.destructive_git true",
+	},
+	"instructions": {
+		Description: "Additional instructions appended to the system prompt of every agent working\n  on a task.",
+		Type:        "String",
+		Example:     "instructions: |\n    Run `make lint` before you finish a task.",
+	},
+	"ignore": {
+		Description: "Paths hidden from the list_files, find_file and grep tools. Patterns are relative\n  to the project directory; patterns without a slash match names at any depth.",
+		Type:        "List of Strings (Glob Patterns)",
+		Example:     "ignore: [\"node_modules\", \"dist/**\", \"*.min.js\"]",
+	},
+	"models": {
+		Description: "Overrides the model used by an agent, keyed by agent name. Only settable by editing\n  the configuration file.",
+		Type:        "Map (Agent Name -> Model Name)",
+		Example:     "models:\n    coder: claude-sonnet-4",
+	},
+}
+
+type configExplainOptions struct {
+	Workspace string
 }
 
 func NewConfigExplainCmd() *cobra.Command {
+	options := configExplainOptions{}
 	cmd := &cobra.Command{
 		Use:   "explain <key>",
 		Short: "Explain a configuration value",
-		Long:  `The "explain" command allows you to explain a configuration value`,
-		Args:  cobra.ExactArgs(1),
+		Long: `The "explain" command describes a configuration key and shows its effective value.
+
+Values are resolved in the following order, the first match wins:
+  1. The project configuration (.construct/config.yaml in the workspace or one of its parents)
+  2. The user configuration (~/.config/construct/config.yaml)
+  3. The built-in default
+
+The permissions keys are combined instead, the project configuration can only
+restrict the permissions of the user configuration further.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			key := args[0]
 			description, ok := configDescriptions[key]
@@ -34,20 +84,86 @@ func NewConfigExplainCmd() *cobra.Command {
 				return fmt.Errorf("unknown configuration key: %s", key)
 			}
 
+			workspace := options.Workspace
+			if workspace == "" {
+				cwd, err := getUserInfo(cmd.Context()).Cwd()
+				if err != nil {
+					return err
+				}
+				workspace = cwd
+			}
+
+			configStore := getConfigStore(cmd.Context())
+			if err := configStore.LoadProject(workspace); err != nil {
+				return err
+			}
+
 			defaultValue := description.Default
 			if defaultValue == "" {
 				defaultValue = "(none)"
 			}
 
-			fmt.Printf("%s\n\n", key)
-			fmt.Printf("  %s\n\n", description.Description)
-			fmt.Printf("  Type                         Default\n")
-			fmt.Printf("  %-28s %s\n\n", description.Type, defaultValue)
-			fmt.Printf("  Example: %s\n", description.Example)
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "%s\n\n", key)
+			fmt.Fprintf(out, "  %s\n\n", description.Description)
+			fmt.Fprintf(out, "  Type                         Default\n")
+			fmt.Fprintf(out, "  %-28s %s\n\n", description.Type, defaultValue)
+
+			value, source, found := configStore.Lookup(key)
+			if found {
+				formatted, err := formatConfigValue(value.Raw())
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(out, "  Value: %s\n", formatted)
+				fmt.Fprintf(out, "  Source: %s\n\n", configSourceDescription(cmd, configStore, source))
+			} else {
+				fmt.Fprintf(out, "  Value: %s\n", defaultValue)
+				fmt.Fprintf(out, "  Source: %s\n\n", config.SourceDefault)
+			}
+
+			fmt.Fprintf(out, "  Example: %s\n", description.Example)
 
 			return nil
 		},
 	}
 
+	cmd.Flags().StringVarP(&options.Workspace, "workspace", "w", "", "Resolve the project configuration from this directory. Defaults to the current directory")
+
 	return cmd
 }
+
+func formatConfigValue(value any) (string, error) {
+	if str, ok := value.(string); ok {
+		return str, nil
+	}
+
+	output, err := yaml.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	lines := strings.Split(strings.TrimRight(string(output), "\n"), "\n")
+	if len(lines) == 1 {
+		return lines[0], nil
+	}
+	return "\n    " + strings.Join(lines, "\n    "), nil
+}
+
+func configSourceDescription(cmd *cobra.Command, configStore *config.Store, source config.Source) string {
+	switch source {
+	case config.SourceProject:
+		path, _ := configStore.ProjectFile()
+		return fmt.Sprintf("%s (%s)", source, path)
+	case config.SourceCombined:
+		path, _ := configStore.ProjectFile()
+		return fmt.Sprintf("user and project (%s), the stricter value applies", path)
+	case config.SourceUser:
+		configDir, err := getUserInfo(cmd.Context()).ConstructConfigDir()
+		if err != nil {
+			return string(source)
+		}
+		return fmt.Sprintf("%s (%s)", source, filepath.Join(configDir, "config.yaml"))
+	}
+	return string(source)
+}
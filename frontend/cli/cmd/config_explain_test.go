@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/furisto/construct/shared/conv"
+	"github.com/furisto/construct/shared/mocks"
+	"github.com/spf13/afero"
+)
+
+func TestConfigExplain(t *testing.T) {
+	setup := &TestSetup{}
+
+	header := "defaults.agent\n\n" +
+		"  Specifies the default agent to use when running `construct new` or `construct exec`\n" +
+		"  without the --agent flag. This allows you to set a preferred agent for new conversations.\n\n" +
+		"  Type                         Default\n" +
+		"  String (Agent Name or ID)    (none)\n\n"
+	footer := "  Example: construct config set defaults.agent \"my-favorite-agent\"\n"
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - project configuration takes precedence",
+			Command: []string{"config", "explain", "defaults.agent", "--workspace", "/repo/service"},
+			SetupFileSystem: func(fs *afero.Afero) {
+				fs.WriteFile("/home/user/.construct/config.yaml", []byte("defaults:\n  agent: coder\n"), 0600)
+				fs.WriteFile("/repo/.construct/config.yaml", []byte("defaults:\n  agent: reviewer\n"), 0644)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(header +
+					"  Value: reviewer\n" +
+					"  Source: project (/repo/.construct/config.yaml)\n\n" +
+					footer),
+			},
+		},
+		{
+			Name:    "success - user configuration",
+			Command: []string{"config", "explain", "defaults.agent"},
+			SetupUserInfo: func(userInfo *mocks.MockUserInfo) {
+				userInfo.EXPECT().Cwd().Return("/repo", nil)
+			},
+			SetupFileSystem: func(fs *afero.Afero) {
+				fs.WriteFile("/home/user/.construct/config.yaml", []byte("defaults:\n  agent: coder\n"), 0600)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(header +
+					"  Value: coder\n" +
+					"  Source: user (/home/user/.construct/config.yaml)\n\n" +
+					footer),
+			},
+		},
+		{
+			Name:    "success - default",
+			Command: []string{"config", "explain", "defaults.agent", "--workspace", "/repo"},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(header +
+					"  Value: (none)\n" +
+					"  Source: default\n\n" +
+					footer),
+			},
+		},
+		{
+			Name:    "success - project cannot loosen the user permissions",
+			Command: []string{"config", "explain", "permissions.policy", "--workspace", "/repo"},
+			SetupFileSystem: func(fs *afero.Afero) {
+				fs.WriteFile("/home/user/.construct/config.yaml", []byte("permissions:\n  policy: read-only\n"), 0600)
+				fs.WriteFile("/repo/.construct/config.yaml", []byte("permissions:\n  policy: allow\n"), 0644)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr("permissions.policy\n\n" +
+					"  Controls which tools agents may use. `allow` permits every tool, `read-only`\n" +
+					"  denies tools that create or edit files and run commands.\n\n" +
+					"  Type                         Default\n" +
+					"  String (allow, read-only)    allow\n\n" +
+					"  Value: read-only\n" +
+					"  Source: user and project (/repo/.construct/config.yaml), the stricter value applies\n\n" +
+					"  Example: construct config set permissions.policy read-only\n"),
+			},
+		},
+		{
+			Name:    "error - unknown key in project configuration",
+			Command: []string{"config", "explain", "defaults.agent", "--workspace", "/repo"},
+			SetupFileSystem: func(fs *afero.Afero) {
+				fs.WriteFile("/repo/.construct/config.yaml", []byte("defaults:\n  agnet: reviewer\n"), 0644)
+			},
+			Expected: TestExpectation{
+				Error: "failed to parse project config file /repo/.construct/config.yaml: yaml: unmarshal errors:\n  line 2: field agnet not found in type config.projectDefaults",
+			},
+		},
+		{
+			Name:    "error - invalid permission policy",
+			Command: []string{"config", "explain", "permissions.policy", "--workspace", "/repo"},
+			SetupFileSystem: func(fs *afero.Afero) {
+				fs.WriteFile("/repo/.construct/config.yaml", []byte("permissions:\n  policy: yolo\n"), 0644)
+			},
+			Expected: TestExpectation{
+				Error: "failed to parse project config file /repo/.construct/config.yaml: invalid permission policy \"yolo\": must be one of \"allow\", \"read-only\"",
+			},
+		},
+		{
+			Name:    "error - unknown key",
+			Command: []string{"config", "explain", "unknown"},
+			Expected: TestExpectation{
+				Error: "unknown configuration key: unknown",
+			},
+		},
+	})
+}
@@ -15,5 +15,8 @@ func NewDaemonCmd() *cobra.Command {
 	cmd.AddCommand(NewDaemonInstallCmd())
 	cmd.AddCommand(NewDaemonUninstallCmd())
 	cmd.AddCommand(NewDaemonStopCmd())
+	cmd.AddCommand(NewDaemonUserCmd())
+	cmd.AddCommand(NewDaemonTokenCmd())
+	cmd.AddCommand(NewDaemonLoginCmd())
 	return cmd
 }
@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"net/url"
+	"strings"
+
+	api "github.com/furisto/construct/api/go/client"
+	"github.com/furisto/construct/frontend/cli/pkg/terminal"
+	"github.com/furisto/construct/shared"
+	"github.com/spf13/cobra"
+)
+
+type daemonLoginOptions struct {
+	Context    string
+	Token      string
+	TokenStdin bool
+	CACert     string
+	ClientCert string
+	ClientKey  string
+	ServerName string
+}
+
+func NewDaemonLoginCmd() *cobra.Command {
+	options := new(daemonLoginOptions)
+	cmd := &cobra.Command{
+		Use:   "login <address> [flags]",
+		Short: "Connect to a remote daemon",
+		Long: `Connect to a remote daemon.
+
+Stores the address and credentials of a daemon started with --listen-http as a
+context and makes it the current one. Authenticate with a token issued by
+'construct daemon token create' on the remote machine, with a client
+certificate, or both.`,
+		Example: `  # Connect to a daemon on a shared dev box
+  construct daemon login https://devbox:8443 --token-stdin < token.txt
+
+  # Connect with a private CA and a client certificate
+  construct daemon login https://devbox:8443 --ca-cert ca.pem --client-cert laptop.pem --client-key laptop-key.pem`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address, err := url.Parse(args[0])
+			if err != nil || (address.Scheme != "http" && address.Scheme != "https") || address.Host == "" {
+				return fmt.Errorf("address must be an http or https URL: %s", args[0])
+			}
+
+			token := options.Token
+			if options.TokenStdin {
+				if token != "" {
+					return fmt.Errorf("--token and --token-stdin are mutually exclusive")
+				}
+
+				token, err = bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+				if err != nil && token == "" {
+					return fmt.Errorf("failed to read token from stdin: %w", err)
+				}
+				token = strings.TrimSpace(token)
+			}
+
+			endpointContext := api.EndpointContext{
+				Address: address.String(),
+				Kind:    "http",
+				Token:   token,
+			}
+			if options.CACert != "" || options.ClientCert != "" || options.ClientKey != "" || options.ServerName != "" {
+				endpointContext.TLS = &api.TLSConfig{
+					CACert:     options.CACert,
+					ClientCert: options.ClientCert,
+					ClientKey:  options.ClientKey,
+					ServerName: options.ServerName,
+				}
+			}
+
+			if endpointContext.Token == "" && (endpointContext.TLS == nil || endpointContext.TLS.ClientCert == "") {
+				return fmt.Errorf("either a token or a client certificate is required")
+			}
+
+			contextManager := shared.NewContextManager(getFileSystem(cmd.Context()), getUserInfo(cmd.Context()))
+			exists, err := contextManager.UpsertEndpointContext(options.Context, endpointContext, true)
+			if err != nil {
+				return fmt.Errorf("failed to save context: %w", err)
+			}
+
+			out := cmd.OutOrStdout()
+			if address.Scheme == "http" && token != "" {
+				fmt.Fprintf(out, "%s The token will be sent unencrypted. Serve the daemon with --tls-cert to protect it\n", terminal.WarningSymbol)
+			}
+
+			if exists {
+				fmt.Fprintf(out, "%s Context '%s' updated\n", terminal.SuccessSymbol, options.Context)
+			} else {
+				fmt.Fprintf(out, "%s Context '%s' created\n", terminal.SuccessSymbol, options.Context)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.Context, "context", "c", "remote", "Name of the context to create or update")
+	cmd.Flags().StringVarP(&options.Token, "token", "t", "", "Token issued by 'construct daemon token create'")
+	cmd.Flags().BoolVar(&options.TokenStdin, "token-stdin", false, "Read the token from stdin")
+	cmd.Flags().StringVar(&options.CACert, "ca-cert", "", "CA file to verify the daemon certificate")
+	cmd.Flags().StringVar(&options.ClientCert, "client-cert", "", "Client certificate file for mTLS")
+	cmd.Flags().StringVar(&options.ClientKey, "client-key", "", "Private key file for --client-cert")
+	cmd.Flags().StringVar(&options.ServerName, "server-name", "", "Override the server name used to verify the daemon certificate")
+
+	return cmd
+}
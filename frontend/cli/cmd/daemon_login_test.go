@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	api_client "github.com/furisto/construct/api/go/client"
+	"github.com/furisto/construct/shared"
+	"github.com/furisto/construct/shared/conv"
+	"github.com/furisto/construct/shared/mocks"
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/afero"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDaemonLogin(t *testing.T) {
+	setup := &TestSetup{}
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - token from stdin",
+			Command: []string{"daemon", "login", "https://devbox:8443", "--token-stdin"},
+			Stdin:   "ct_secret\n",
+			Expected: TestExpectation{
+				Stdout: conv.Ptr("✔ Context 'remote' created\n"),
+			},
+		},
+		{
+			Name:    "success - warns about plain http",
+			Command: []string{"daemon", "login", "http://devbox:8080", "--token", "ct_secret", "--context", "devbox"},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr("⚠️ The token will be sent unencrypted. Serve the daemon with --tls-cert to protect it\n✔ Context 'devbox' created\n"),
+			},
+		},
+		{
+			Name:    "success - client certificate updates existing context",
+			Command: []string{"daemon", "login", "https://devbox:8443", "--ca-cert", "/certs/ca.pem", "--client-cert", "/certs/laptop.pem", "--client-key", "/certs/laptop-key.pem"},
+			SetupFileSystem: func(fs *afero.Afero) {
+				fs.WriteFile("/home/user/.construct/context.yaml", []byte("current: remote\ncontexts:\n  remote:\n    address: https://devbox:8443\n    kind: http\n    token: ct_old\n"), 0600)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr("✔ Context 'remote' updated\n"),
+			},
+		},
+		{
+			Name:    "error - missing credentials",
+			Command: []string{"daemon", "login", "https://devbox:8443"},
+			Expected: TestExpectation{
+				Error: "either a token or a client certificate is required",
+			},
+		},
+		{
+			Name:    "error - address without scheme",
+			Command: []string{"daemon", "login", "devbox:8443", "--token", "ct_secret"},
+			Expected: TestExpectation{
+				Error: "address must be an http or https URL: devbox:8443",
+			},
+		},
+		{
+			Name:    "error - tls options with plain http",
+			Command: []string{"daemon", "login", "http://devbox:8080", "--token", "ct_secret", "--ca-cert", "/certs/ca.pem"},
+			Expected: TestExpectation{
+				Error: "failed to save context: tls requires an https address: http://devbox:8080",
+			},
+		},
+		{
+			Name:    "error - token and token-stdin",
+			Command: []string{"daemon", "login", "https://devbox:8443", "--token", "ct_secret", "--token-stdin"},
+			Stdin:   "ct_secret\n",
+			Expected: TestExpectation{
+				Error: "--token and --token-stdin are mutually exclusive",
+			},
+		},
+	})
+}
+
+func TestDaemonLoginStoresContext(t *testing.T) {
+	fs := &afero.Afero{Fs: afero.NewMemMapFs()}
+	ctrl := gomock.NewController(t)
+	userInfo := mocks.NewMockUserInfo(ctrl)
+	setupDefaultUserInfo(userInfo)
+
+	ctx := context.WithValue(context.Background(), ContextKeyFileSystem, fs)
+	ctx = context.WithValue(ctx, ContextKeyUserInfo, userInfo)
+
+	cmd := NewDaemonLoginCmd()
+	cmd.SetContext(ctx)
+	cmd.SetOut(io.Discard)
+	cmd.SetArgs([]string{"https://devbox:8443", "--token", "ct_secret", "--ca-cert", "/certs/ca.pem"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("failed to log in: %v", err)
+	}
+
+	endpointContexts, err := shared.NewContextManager(fs, userInfo).LoadContext()
+	if err != nil {
+		t.Fatalf("failed to load contexts: %v", err)
+	}
+
+	expected := &api_client.EndpointContexts{
+		CurrentContext: "remote",
+		Contexts: map[string]api_client.EndpointContext{
+			"remote": {
+				Address: "https://devbox:8443",
+				Kind:    "http",
+				Token:   "ct_secret",
+				TLS:     &api_client.TLSConfig{CACert: "/certs/ca.pem"},
+			},
+		},
+	}
+	if diff := cmp.Diff(expected, endpointContexts); diff != "" {
+		t.Errorf("stored context mismatch (-want +got):\n%s", diff)
+	}
+}
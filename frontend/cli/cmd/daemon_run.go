@@ -3,6 +3,7 @@ package cmd
 import (
 	"context"
 	"crypto/sha256"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -27,6 +28,9 @@ import (
 type daemonRunOptions struct {
 	HTTPAddress string
 	UnixSocket  string
+	TLSCert     string
+	TLSKey      string
+	TLSClientCA string
 }
 
 func NewDaemonRunCmd() *cobra.Command {
@@ -37,28 +41,22 @@ func NewDaemonRunCmd() *cobra.Command {
 		Long: `Run the daemon process in the foreground.
 
 Starts the daemon process directly in the current terminal. This is useful for 
-debugging and development. For normal use, 'construct daemon install' is recommended.`,
+debugging and development. For normal use, 'construct daemon install' is recommended.
+
+Requests over --listen-http must be authenticated with a token from
+'construct daemon token create' or, if --tls-client-ca is set, with a client
+certificate signed by that CA. Requests over a Unix socket are not authenticated.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			userInfo := getUserInfo(cmd.Context())
 			config := getConfigStore(cmd.Context())
 			fs := getFileSystem(cmd.Context())
 
-			dataDir, err := userInfo.ConstructDataDir()
-			if err != nil {
-				return fmt.Errorf("failed to get construct data directory: %w", err)
-			}
-
-			db, err := memory.Open(dialect.SQLite, "file:"+filepath.Join(dataDir, "construct.db")+"?_pragma=foreign_keys(1)&_pragma=journal_mode(WAL)&_pragma=busy_timeout(5000)")
+			db, err := openDatabase(cmd.Context(), userInfo)
 			if err != nil {
-				return fmt.Errorf("failed to open database: %w", err)
+				return err
 			}
 			defer db.Close()
 
-			err = setupMemory(cmd.Context(), db)
-			if err != nil {
-				return fmt.Errorf("failed to setup memory/database schema: %w", err)
-			}
-
 			secretProvider, err := getSecretProvider(config, userInfo, fs)
 			if err != nil {
 				return fmt.Errorf("failed to get secret provider: %w", err)
@@ -69,7 +67,12 @@ debugging and development. For normal use, 'construct daemon install' is recomme
 				return fmt.Errorf("failed to get encryption client: %w", err)
 			}
 
-			provider, err := listener.DetectProvider(options.HTTPAddress, options.UnixSocket)
+			tlsConfig, err := options.tlsConfig()
+			if err != nil {
+				return err
+			}
+
+			provider, err := listener.DetectProvider(options.HTTPAddress, options.UnixSocket, tlsConfig)
 			if err != nil {
 				return fmt.Errorf("failed to detect listener provider: %w", err)
 			}
@@ -82,7 +85,8 @@ debugging and development. For normal use, 'construct daemon install' is recomme
 			if explicitLaunch(provider.ActivationType()) {
 				contextManager := shared.NewContextManager(getFileSystem(cmd.Context()), getUserInfo(cmd.Context()))
 				contextName := generateContextName(provider.ActivationType(), listener)
-				_, err = contextManager.UpsertContext(contextName, provider.ActivationType(), listener.Addr().String(), true)
+				kind, address := contextEndpoint(provider.ActivationType(), listener, tlsConfig != nil)
+				_, err = contextManager.UpsertContext(contextName, kind, address, true)
 				if err != nil {
 					return fmt.Errorf("failed to upsert context: %w", err)
 				}
@@ -97,17 +101,23 @@ debugging and development. For normal use, 'construct daemon install' is recomme
 				db,
 				encryption,
 				listener,
-				agent.WithCodeActTools(
+				agent.WithCodeActTools(append([]codeact.Tool{
 					codeact.NewCreateFileTool(),
 					codeact.NewReadFileTool(),
 					codeact.NewEditFileTool(),
+					codeact.NewDeleteFileTool(),
+					codeact.NewMoveFileTool(),
+					codeact.NewCopyFileTool(),
+					codeact.NewApplyPatchTool(),
 					codeact.NewListFilesTool(),
 					codeact.NewGrepTool(),
 					codeact.NewFindFileTool(),
 					codeact.NewExecuteCommandTool(),
+					codeact.NewRunTestsTool(agent.AvailableDevTools().Available),
 					// codeact.NewSubmitReportTool(),
 					codeact.NewPrintTool(),
-				),
+					codeact.NewBeginTransactionTool(),
+				}, codeact.NewGitTools()...)...),
 				agent.WithAnalytics(analytics),
 			)
 
@@ -126,14 +136,42 @@ debugging and development. For normal use, 'construct daemon install' is recomme
 
 	cmd.Flags().StringVar(&options.HTTPAddress, "listen-http", "", "The address and port to listen on (e.g., 127.0.0.1:8080)")
 	cmd.Flags().StringVar(&options.UnixSocket, "listen-unix", "", "The path to listen on for Unix socket requests")
+	cmd.Flags().StringVar(&options.TLSCert, "tls-cert", "", "Certificate file to serve --listen-http over TLS")
+	cmd.Flags().StringVar(&options.TLSKey, "tls-key", "", "Private key file for --tls-cert")
+	cmd.Flags().StringVar(&options.TLSClientCA, "tls-client-ca", "", "CA file used to authenticate clients by certificate (mTLS)")
 
 	return cmd
 }
 
+func (o *daemonRunOptions) tlsConfig() (*tls.Config, error) {
+	if o.TLSCert == "" && o.TLSKey == "" && o.TLSClientCA == "" {
+		return nil, nil
+	}
+
+	if o.HTTPAddress == "" {
+		return nil, fmt.Errorf("tls flags require --listen-http")
+	}
+
+	return listener.ServerTLSConfig(o.TLSCert, o.TLSKey, o.TLSClientCA)
+}
+
 func explicitLaunch(kind string) bool {
 	return kind == "unix" || kind == "tcp"
 }
 
+// contextEndpoint returns the context kind and address under which clients reach
+// the listener.
+func contextEndpoint(activationType string, listener net.Listener, tls bool) (string, string) {
+	if activationType != "tcp" {
+		return activationType, listener.Addr().String()
+	}
+
+	if tls {
+		return "http", "https://" + listener.Addr().String()
+	}
+	return "http", "http://" + listener.Addr().String()
+}
+
 func generateContextName(kind string, listener net.Listener) string {
 	hash := sha256.Sum256([]byte(listener.Addr().String()))
 	return fmt.Sprintf("%s-%x", kind, hash[:3])
@@ -187,6 +225,26 @@ func getSecretProvider(cfg *config.Store, userInfo shared.UserInfo, fs afero.Fs)
 	return secret.NewKeyringProvider(), nil
 }
 
+func openDatabase(ctx context.Context, userInfo shared.UserInfo) (*memory.Client, error) {
+	dataDir, err := userInfo.ConstructDataDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get construct data directory: %w", err)
+	}
+
+	db, err := memory.Open(dialect.SQLite, "file:"+filepath.Join(dataDir, "construct.db")+"?_pragma=foreign_keys(1)&_pragma=journal_mode(WAL)&_pragma=busy_timeout(5000)")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	err = setupMemory(ctx, db)
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to setup memory/database schema: %w", err)
+	}
+
+	return db, nil
+}
+
 func setupMemory(ctx context.Context, db *memory.Client) error {
 	return db.Schema.Create(ctx,
 		migrate.WithDropColumn(true),
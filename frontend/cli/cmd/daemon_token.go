@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"time"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/spf13/cobra"
+)
+
+func NewDaemonTokenCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "token",
+		Short:   "Manage tokens for remote access to the daemon",
+		Aliases: []string{"tokens"},
+		Long: `Manage tokens for remote access to the daemon.
+
+Tokens authenticate clients that connect over --listen-http. They are stored in
+the local daemon database, so these commands have to run on the machine that
+hosts the daemon.`,
+	}
+
+	cmd.AddCommand(NewDaemonTokenCreateCmd())
+	cmd.AddCommand(NewDaemonTokenListCmd())
+	cmd.AddCommand(NewDaemonTokenRevokeCmd())
+
+	return cmd
+}
+
+type TokenDisplay struct {
+	ID         string `json:"id" yaml:"id" detail:"full"`
+	Name       string `json:"name" yaml:"name" detail:"default"`
+	User       string `json:"user" yaml:"user" detail:"default"`
+	CreatedAt  string `json:"created_at" yaml:"created_at" detail:"default"`
+	ExpiresAt  string `json:"expires_at,omitempty" yaml:"expires_at,omitempty" detail:"default"`
+	LastUsedAt string `json:"last_used_at,omitempty" yaml:"last_used_at,omitempty" detail:"default"`
+}
+
+func ConvertTokenToDisplay(token *memory.AuthToken) *TokenDisplay {
+	if token == nil {
+		return nil
+	}
+
+	var userName string
+	if token.Edges.User != nil {
+		userName = token.Edges.User.Name
+	}
+
+	return &TokenDisplay{
+		ID:         token.ID.String(),
+		Name:       token.Name,
+		User:       userName,
+		CreatedAt:  token.CreateTime.Local().Format("2006-01-02 15:04:05"),
+		ExpiresAt:  formatOptionalTime(token.ExpiresAt),
+		LastUsedAt: formatOptionalTime(token.LastUsedAt),
+	}
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Local().Format("2006-01-02 15:04:05")
+}
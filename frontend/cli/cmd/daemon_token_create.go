@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/furisto/construct/backend/auth"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/user"
+	"github.com/furisto/construct/frontend/cli/pkg/terminal"
+	"github.com/spf13/cobra"
+)
+
+type daemonTokenCreateOptions struct {
+	User      string
+	ExpiresIn time.Duration
+}
+
+func NewDaemonTokenCreateCmd() *cobra.Command {
+	options := new(daemonTokenCreateOptions)
+	cmd := &cobra.Command{
+		Use:   "create <name> [flags]",
+		Short: "Issue a new token for remote access",
+		Long: `Issue a new token for remote access.
+
+The token authenticates as the given user, see 'construct daemon user'. It is
+printed once and cannot be retrieved afterwards. Store it on the client with
+'construct daemon login'.`,
+		Example: `  # Issue a token for alice's laptop
+  construct daemon token create alice-laptop --user alice
+
+  # Issue a token that expires after 30 days
+  construct daemon token create ci --user ci-bot --expires-in 720h`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if options.ExpiresIn < 0 {
+				return fmt.Errorf("--expires-in must not be negative")
+			}
+
+			db, err := openDatabase(cmd.Context(), getUserInfo(cmd.Context()))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			owner, err := db.User.Query().Where(user.Name(options.User)).Only(cmd.Context())
+			if err != nil {
+				if memory.IsNotFound(err) {
+					return fmt.Errorf("user %s not found", options.User)
+				}
+				return fmt.Errorf("failed to get user: %w", err)
+			}
+
+			token, err := auth.GenerateToken()
+			if err != nil {
+				return err
+			}
+
+			create := db.AuthToken.Create().
+				SetName(args[0]).
+				SetTokenHash(auth.HashToken(token)).
+				SetUser(owner)
+			if options.ExpiresIn > 0 {
+				create = create.SetExpiresAt(time.Now().Add(options.ExpiresIn))
+			}
+
+			err = create.Exec(cmd.Context())
+			if err != nil {
+				if memory.IsConstraintError(err) {
+					return fmt.Errorf("token %s already exists", args[0])
+				}
+				return fmt.Errorf("failed to create token: %w", err)
+			}
+
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "%s Token '%s' created. It will not be shown again:\n", terminal.SuccessSymbol, args[0])
+			fmt.Fprintln(out, token)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.User, "user", "u", "", "User the token authenticates as (required)")
+	cmd.MarkFlagRequired("user")
+	cmd.Flags().DurationVar(&options.ExpiresIn, "expires-in", 0, "Duration after which the token expires (default never)")
+
+	return cmd
+}
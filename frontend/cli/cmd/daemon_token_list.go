@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/furisto/construct/backend/memory/authtoken"
+	"github.com/spf13/cobra"
+)
+
+type daemonTokenListOptions struct {
+	RenderOptions RenderOptions
+}
+
+func NewDaemonTokenListCmd() *cobra.Command {
+	var options daemonTokenListOptions
+
+	cmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   "List issued tokens",
+		Aliases: []string{"ls"},
+		Example: `  # List all tokens
+  construct daemon token list`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db, err := openDatabase(cmd.Context(), getUserInfo(cmd.Context()))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			tokens, err := db.AuthToken.Query().WithUser().Order(authtoken.ByName()).All(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to list tokens: %w", err)
+			}
+
+			displayTokens := make([]*TokenDisplay, len(tokens))
+			for i, token := range tokens {
+				displayTokens[i] = ConvertTokenToDisplay(token)
+			}
+
+			return getRenderer(cmd.Context()).Render(displayTokens, &options.RenderOptions)
+		},
+	}
+
+	addRenderOptions(cmd, &options.RenderOptions)
+
+	return cmd
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/furisto/construct/backend/memory/authtoken"
+	"github.com/spf13/cobra"
+)
+
+func NewDaemonTokenRevokeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "revoke <name>... [flags]",
+		Short:   "Revoke one or more tokens",
+		Aliases: []string{"rm"},
+		Long: `Revoke one or more tokens.
+
+Clients using a revoked token are rejected on their next request.`,
+		Example: `  # Revoke the token of a lost laptop
+  construct daemon token revoke laptop`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db, err := openDatabase(cmd.Context(), getUserInfo(cmd.Context()))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			for _, name := range args {
+				deleted, err := db.AuthToken.Delete().Where(authtoken.Name(name)).Exec(cmd.Context())
+				if err != nil {
+					return fmt.Errorf("failed to revoke token %s: %w", name, err)
+				}
+
+				if deleted == 0 {
+					return fmt.Errorf("token %s not found", name)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
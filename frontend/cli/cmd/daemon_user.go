@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/spf13/cobra"
+)
+
+func NewDaemonUserCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "user",
+		Short:   "Manage the users of a shared daemon",
+		Aliases: []string{"users"},
+		Long: `Manage the users of a shared daemon.
+
+Remote callers act as the user their token was issued for, or the user named by
+the common name of their client certificate. The role of a user decides what it
+may do:
+
+  admin   manages model providers, models, builtin agents, schedules and
+          webhooks, and every user's agents and tasks
+  member  creates agents and tasks and works on the ones it owns
+  viewer  reads all tasks and transcripts without changing anything
+
+Callers on the Unix socket are always admins. Users are stored in the local
+daemon database, so these commands have to run on the machine that hosts the
+daemon.`,
+	}
+
+	cmd.AddCommand(NewDaemonUserCreateCmd())
+	cmd.AddCommand(NewDaemonUserListCmd())
+	cmd.AddCommand(NewDaemonUserUpdateCmd())
+	cmd.AddCommand(NewDaemonUserDeleteCmd())
+
+	return cmd
+}
+
+type UserRole string
+
+const (
+	UserRoleAdmin  UserRole = "admin"
+	UserRoleMember UserRole = "member"
+	UserRoleViewer UserRole = "viewer"
+)
+
+func (r *UserRole) String() string {
+	return string(*r)
+}
+
+func (r *UserRole) Set(v string) error {
+	switch UserRole(strings.ToLower(strings.TrimSpace(v))) {
+	case UserRoleAdmin:
+		*r = UserRoleAdmin
+	case UserRoleMember:
+		*r = UserRoleMember
+	case UserRoleViewer:
+		*r = UserRoleViewer
+	default:
+		return errors.New(`must be one of "admin","member","viewer"`)
+	}
+	return nil
+}
+
+func (r *UserRole) Type() string {
+	return "role"
+}
+
+func (r UserRole) ToMemory() types.UserRole {
+	return types.UserRole(r)
+}
+
+type UserDisplay struct {
+	ID        string   `json:"id" yaml:"id" detail:"full"`
+	Name      string   `json:"name" yaml:"name" detail:"default"`
+	Role      UserRole `json:"role" yaml:"role" detail:"default"`
+	CreatedAt string   `json:"created_at" yaml:"created_at" detail:"default"`
+}
+
+func ConvertUserToDisplay(user *memory.User) *UserDisplay {
+	if user == nil {
+		return nil
+	}
+
+	return &UserDisplay{
+		ID:        user.ID.String(),
+		Name:      user.Name,
+		Role:      UserRole(user.Role),
+		CreatedAt: user.CreateTime.Local().Format("2006-01-02 15:04:05"),
+	}
+}
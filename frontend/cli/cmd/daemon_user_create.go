@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/frontend/cli/pkg/terminal"
+	"github.com/spf13/cobra"
+)
+
+type daemonUserCreateOptions struct {
+	Role UserRole
+}
+
+func NewDaemonUserCreateCmd() *cobra.Command {
+	options := &daemonUserCreateOptions{Role: UserRoleMember}
+	cmd := &cobra.Command{
+		Use:   "create <name> [flags]",
+		Short: "Create a user",
+		Example: `  # Add a team member
+  construct daemon user create alice
+
+  # Add a reviewer who can only read transcripts
+  construct daemon user create victor --role viewer`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db, err := openDatabase(cmd.Context(), getUserInfo(cmd.Context()))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			err = db.User.Create().
+				SetName(args[0]).
+				SetRole(options.Role.ToMemory()).
+				Exec(cmd.Context())
+			if err != nil {
+				if memory.IsConstraintError(err) {
+					return fmt.Errorf("user %s already exists", args[0])
+				}
+				return fmt.Errorf("failed to create user: %w", err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%s User '%s' created with role %s\n", terminal.SuccessSymbol, args[0], options.Role)
+			return nil
+		},
+	}
+
+	cmd.Flags().VarP(&options.Role, "role", "r", "Role of the user (admin, member, viewer)")
+
+	return cmd
+}
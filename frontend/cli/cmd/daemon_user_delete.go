@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/agent"
+	"github.com/furisto/construct/backend/memory/authtoken"
+	"github.com/furisto/construct/backend/memory/task"
+	"github.com/furisto/construct/backend/memory/user"
+	"github.com/spf13/cobra"
+)
+
+type daemonUserDeleteOptions struct {
+	Force bool
+}
+
+func NewDaemonUserDeleteCmd() *cobra.Command {
+	options := new(daemonUserDeleteOptions)
+	cmd := &cobra.Command{
+		Use:     "delete <name>... [flags]",
+		Short:   "Delete one or more users",
+		Aliases: []string{"rm"},
+		Long: `Delete one or more users.
+
+The tokens of the users are revoked. Their agents and tasks are kept and from
+then on can only be managed by admins.`,
+		Example: `  # Remove a user who left the team
+  construct daemon user delete alice`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db, err := openDatabase(cmd.Context(), getUserInfo(cmd.Context()))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			if !options.Force && !confirmDeletion(cmd.InOrStdin(), cmd.OutOrStdout(), "user", args) {
+				return nil
+			}
+
+			for _, name := range args {
+				if err := deleteUser(cmd.Context(), db, name); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&options.Force, "force", "f", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+func deleteUser(ctx context.Context, db *memory.Client, name string) error {
+	_, err := memory.Transaction(ctx, db, func(tx *memory.Client) (*memory.User, error) {
+		u, err := tx.User.Query().Where(user.Name(name)).Only(ctx)
+		if err != nil {
+			if memory.IsNotFound(err) {
+				return nil, fmt.Errorf("user %s not found", name)
+			}
+			return nil, err
+		}
+
+		if _, err := tx.AuthToken.Delete().Where(authtoken.UserID(u.ID)).Exec(ctx); err != nil {
+			return nil, err
+		}
+
+		if err := tx.Task.Update().Where(task.OwnerID(u.ID)).ClearOwnerID().Exec(ctx); err != nil {
+			return nil, err
+		}
+
+		if err := tx.Agent.Update().Where(agent.OwnerID(u.ID)).ClearOwnerID().Exec(ctx); err != nil {
+			return nil, err
+		}
+
+		return u, tx.User.DeleteOne(u).Exec(ctx)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete user %s: %w", name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/furisto/construct/backend/memory/user"
+	"github.com/spf13/cobra"
+)
+
+type daemonUserListOptions struct {
+	RenderOptions RenderOptions
+}
+
+func NewDaemonUserListCmd() *cobra.Command {
+	var options daemonUserListOptions
+
+	cmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   "List users",
+		Aliases: []string{"ls"},
+		Example: `  # List all users
+  construct daemon user list`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db, err := openDatabase(cmd.Context(), getUserInfo(cmd.Context()))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			users, err := db.User.Query().Order(user.ByName()).All(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to list users: %w", err)
+			}
+
+			displayUsers := make([]*UserDisplay, len(users))
+			for i, u := range users {
+				displayUsers[i] = ConvertUserToDisplay(u)
+			}
+
+			return getRenderer(cmd.Context()).Render(displayUsers, &options.RenderOptions)
+		},
+	}
+
+	addRenderOptions(cmd, &options.RenderOptions)
+
+	return cmd
+}
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/furisto/construct/shared/conv"
+	"github.com/furisto/construct/shared/mocks"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestDaemonUser(t *testing.T) {
+	setup := &TestSetup{
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreFields(UserDisplay{}, "ID", "CreatedAt"),
+		},
+	}
+	dataDir := t.TempDir()
+	withDataDir := func(userInfo *mocks.MockUserInfo) {
+		userInfo.EXPECT().ConstructDataDir().Return(dataDir, nil).AnyTimes()
+	}
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:          "success - create member",
+			Command:       []string{"daemon", "user", "create", "alice"},
+			SetupUserInfo: withDataDir,
+			Expected: TestExpectation{
+				Stdout: conv.Ptr("✔ User 'alice' created with role member\n"),
+			},
+		},
+		{
+			Name:          "success - create viewer",
+			Command:       []string{"daemon", "user", "create", "victor", "--role", "viewer"},
+			SetupUserInfo: withDataDir,
+			Expected: TestExpectation{
+				Stdout: conv.Ptr("✔ User 'victor' created with role viewer\n"),
+			},
+		},
+		{
+			Name:          "error - duplicate user",
+			Command:       []string{"daemon", "user", "create", "alice"},
+			SetupUserInfo: withDataDir,
+			Expected: TestExpectation{
+				Error: "user alice already exists",
+			},
+		},
+		{
+			Name:    "error - invalid role",
+			Command: []string{"daemon", "user", "create", "mallory", "--role", "owner"},
+			Expected: TestExpectation{
+				Error: `invalid argument "owner" for "-r, --role" flag: must be one of "admin","member","viewer"`,
+			},
+		},
+		{
+			Name:          "success - list users",
+			Command:       []string{"daemon", "user", "list"},
+			SetupUserInfo: withDataDir,
+			Expected: TestExpectation{
+				DisplayedObjects: []*UserDisplay{
+					{Name: "alice", Role: UserRoleMember},
+					{Name: "victor", Role: UserRoleViewer},
+				},
+			},
+		},
+		{
+			Name:          "success - promote user",
+			Command:       []string{"daemon", "user", "update", "alice", "--role", "admin"},
+			SetupUserInfo: withDataDir,
+			Expected:      TestExpectation{},
+		},
+		{
+			Name:          "error - update unknown user",
+			Command:       []string{"daemon", "user", "update", "mallory", "--role", "admin"},
+			SetupUserInfo: withDataDir,
+			Expected: TestExpectation{
+				Error: "user mallory not found",
+			},
+		},
+		{
+			Name:          "error - token for unknown user",
+			Command:       []string{"daemon", "token", "create", "laptop", "--user", "mallory"},
+			SetupUserInfo: withDataDir,
+			Expected: TestExpectation{
+				Error: "user mallory not found",
+			},
+		},
+		{
+			Name:          "success - delete user",
+			Command:       []string{"daemon", "user", "delete", "victor", "--force"},
+			SetupUserInfo: withDataDir,
+			Expected:      TestExpectation{},
+		},
+		{
+			Name:          "error - delete unknown user",
+			Command:       []string{"daemon", "user", "delete", "victor", "--force"},
+			SetupUserInfo: withDataDir,
+			Expected: TestExpectation{
+				Error: "failed to delete user victor: user victor not found",
+			},
+		},
+	})
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/furisto/construct/backend/memory/user"
+	"github.com/spf13/cobra"
+)
+
+type daemonUserUpdateOptions struct {
+	Role UserRole
+}
+
+func NewDaemonUserUpdateCmd() *cobra.Command {
+	options := new(daemonUserUpdateOptions)
+	cmd := &cobra.Command{
+		Use:   "update <name> [flags]",
+		Short: "Change the role of a user",
+		Example: `  # Promote alice to admin
+  construct daemon user update alice --role admin`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db, err := openDatabase(cmd.Context(), getUserInfo(cmd.Context()))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			updated, err := db.User.Update().
+				Where(user.Name(args[0])).
+				SetRole(options.Role.ToMemory()).
+				Save(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to update user %s: %w", args[0], err)
+			}
+
+			if updated == 0 {
+				return fmt.Errorf("user %s not found", args[0])
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().VarP(&options.Role, "role", "r", "New role of the user (admin, member, viewer)")
+	cmd.MarkFlagRequired("role")
+
+	return cmd
+}
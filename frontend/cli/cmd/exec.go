@@ -106,7 +106,7 @@ inspected or resumed later with construct resume.`,
 }
 
 func setupFlags(cmd *cobra.Command, options *execOptions) {
-	cmd.Flags().StringVarP(&options.Agent, "agent", "a", "", "Specify the agent to use by its name or ID")
+	cmd.Flags().StringVarP(&options.Agent, "agent", "a", "", "Specify the agent to use by its name or ID. Defaults to defaults.agent from the project or user configuration")
 	cmd.Flags().StringVarP(&options.Workspace, "workspace", "w", "", "Set the agent's working directory")
 	cmd.Flags().IntVar(&options.MaxTurns, "max-turns", 5, "Set a maximum number of conversational turns for the agent to complete the task")
 	cmd.Flags().StringSliceVarP(&options.Files, "file", "f", []string{}, "Add a file to the agent's context. Can be used multiple times")
@@ -208,7 +208,15 @@ func setupTask(ctx context.Context, cmd *cobra.Command, client *client.Client, o
 		}
 	}
 
-	agentID, err := getAgentID(ctx, client, options.Agent)
+	agent := options.Agent
+	if agent == "" {
+		agent, err = defaultAgent(ctx, workspace)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	agentID, err := getAgentID(ctx, client, agent)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,16 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func NewMcpCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "mcp",
+		Short:   "Make Construct available to other MCP clients",
+		GroupID: "system",
+	}
+
+	cmd.AddCommand(NewMcpServeCmd())
+	return cmd
+}
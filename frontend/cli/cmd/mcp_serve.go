@@ -0,0 +1,736 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	api "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/tool/mcp"
+	"github.com/furisto/construct/frontend/cli/pkg/fail"
+	"github.com/furisto/construct/shared/conv"
+	"github.com/spf13/cobra"
+)
+
+// mcpProtocolVersions are the revisions of the Model Context Protocol the
+// server understands, newest first.
+var mcpProtocolVersions = []string{"2025-06-18", "2025-03-26", "2024-11-05"}
+
+const (
+	// mcpMaxMessageSize is the largest JSON-RPC message accepted on stdin.
+	mcpMaxMessageSize = 16 * 1024 * 1024
+	// mcpDefaultWaitTimeout bounds how long a tool waits for the result of
+	// a task unless the caller asks for a different timeout.
+	mcpDefaultWaitTimeout = 30 * time.Minute
+)
+
+type mcpServeOptions struct {
+	Workspace string
+}
+
+func NewMcpServeCmd() *cobra.Command {
+	var options mcpServeOptions
+
+	cmd := &cobra.Command{
+		Use:   "serve [flags]",
+		Short: "Serve the agents of the daemon over MCP on stdin and stdout",
+		Args:  cobra.NoArgs,
+		Long: `Serve the agents of the daemon over MCP on stdin and stdout.
+
+Runs a Model Context Protocol server with the stdio transport so that editors and
+other MCP clients can delegate work to Construct. The server offers tools to list
+agents, create tasks, send messages and wait for results. Progress of running
+tasks is reported as MCP progress notifications. All requests are forwarded to
+the daemon of the current context.
+
+Logs are written to stderr because stdout carries the protocol.`,
+		Example: `  # Register Construct with an MCP client
+  {
+    "mcpServers": {
+      "construct": { "command": "construct", "args": ["mcp", "serve"] }
+    }
+  }
+
+  # Create tasks in a fixed workspace instead of the working directory
+  construct mcp serve --workspace /path/to/repo`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// stdout is reserved for the protocol
+			slog.SetDefault(slog.New(slog.NewJSONHandler(setupLogSink(cmd.Context(), getUserInfo(cmd.Context()), cmd.ErrOrStderr()), &slog.HandlerOptions{
+				Level: getGlobalOptions(cmd.Context()).LogLevel.SlogLevel(),
+			})))
+
+			workspace := options.Workspace
+			if workspace == "" {
+				var err error
+				workspace, err = os.Getwd()
+				if err != nil {
+					return fail.HandleError(cmd, err)
+				}
+			}
+
+			server := newMcpServer(cmd.Context(), getAPIClient(cmd.Context()), workspace, cmd.OutOrStdout())
+			return fail.HandleError(cmd, server.Serve(cmd.Context(), cmd.InOrStdin()))
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.Workspace, "workspace", "w", "", "Workspace of tasks that do not specify one. Defaults to the working directory")
+	return cmd
+}
+
+// mcpToolCall is a running call of a tool. Progress is only reported if the
+// client asked for it with a progress token.
+type mcpToolCall struct {
+	server        *mcpServer
+	arguments     json.RawMessage
+	progressToken any
+	progress      int
+}
+
+func (c *mcpToolCall) decode(v any) error {
+	if len(c.arguments) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(c.arguments, v); err != nil {
+		return fmt.Errorf("invalid arguments: %w", err)
+	}
+	return nil
+}
+
+func (c *mcpToolCall) reportProgress(message string) {
+	if c.progressToken == nil {
+		return
+	}
+
+	c.progress++
+	c.server.write(&mcp.Request{
+		JSONRPC: "2.0",
+		Method:  "notifications/progress",
+		Params: mcp.ProgressParams{
+			ProgressToken: c.progressToken,
+			Progress:      c.progress,
+			Message:       message,
+		},
+	})
+}
+
+type mcpServerTool struct {
+	Name        string
+	Description string
+	InputSchema map[string]any
+	ReadOnly    bool
+	Handler     func(ctx context.Context, call *mcpToolCall) (any, error)
+}
+
+// mcpServer translates MCP requests into calls of the API client. Tool calls
+// run concurrently because waiting for a task can take a long time, all other
+// requests are answered in order.
+type mcpServer struct {
+	// cmdCtx carries the configuration store to resolve the default agent.
+	cmdCtx    context.Context
+	client    *api.Client
+	workspace string
+	tools     []mcpServerTool
+
+	writeMu sync.Mutex
+	out     io.Writer
+
+	mu       sync.Mutex
+	inflight map[string]context.CancelFunc
+	wg       sync.WaitGroup
+}
+
+func newMcpServer(cmdCtx context.Context, client *api.Client, workspace string, out io.Writer) *mcpServer {
+	s := &mcpServer{
+		cmdCtx:    cmdCtx,
+		client:    client,
+		workspace: workspace,
+		out:       out,
+		inflight:  make(map[string]context.CancelFunc),
+	}
+	s.tools = s.serverTools()
+	return s
+}
+
+// Serve handles requests until in is closed and all running tool calls
+// have finished.
+func (s *mcpServer) Serve(ctx context.Context, in io.Reader) error {
+	defer s.wg.Wait()
+
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 64*1024), mcpMaxMessageSize)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		var req mcp.Message
+		if err := json.Unmarshal(line, &req); err != nil {
+			s.write(&mcp.Response{JSONRPC: "2.0", ID: json.RawMessage("null"), Error: &mcp.RPCError{Code: mcp.ErrorCodeParse, Message: err.Error()}})
+			continue
+		}
+		s.handle(ctx, &req)
+	}
+
+	return scanner.Err()
+}
+
+func (s *mcpServer) handle(ctx context.Context, req *mcp.Message) {
+	if len(req.ID) == 0 {
+		s.handleNotification(req)
+		return
+	}
+
+	switch req.Method {
+	case "initialize":
+		s.respond(req, s.initialize(req.Params), nil)
+	case "ping":
+		s.respond(req, struct{}{}, nil)
+	case "tools/list":
+		s.respond(req, s.listTools(), nil)
+	case "tools/call":
+		var params mcp.CallToolParams
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			s.respond(req, nil, &mcp.RPCError{Code: mcp.ErrorCodeInvalidParams, Message: err.Error()})
+			return
+		}
+
+		callCtx, cancel := context.WithCancel(ctx)
+		s.mu.Lock()
+		s.inflight[string(req.ID)] = cancel
+		s.mu.Unlock()
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer func() {
+				s.mu.Lock()
+				delete(s.inflight, string(req.ID))
+				s.mu.Unlock()
+				cancel()
+			}()
+
+			result, rpcErr := s.callTool(callCtx, &params)
+			if callCtx.Err() != nil && ctx.Err() == nil {
+				// the client cancelled the request and does not expect a response
+				return
+			}
+			s.respond(req, result, rpcErr)
+		}()
+	default:
+		s.respond(req, nil, &mcp.RPCError{Code: mcp.ErrorCodeMethodNotFound, Message: fmt.Sprintf("method %s not found", req.Method)})
+	}
+}
+
+func (s *mcpServer) handleNotification(req *mcp.Message) {
+	if req.Method != "notifications/cancelled" {
+		return
+	}
+
+	var params struct {
+		RequestID json.RawMessage `json:"requestId"`
+	}
+	if err := json.Unmarshal(req.Params, &params); err != nil {
+		return
+	}
+
+	s.mu.Lock()
+	cancel, ok := s.inflight[string(params.RequestID)]
+	s.mu.Unlock()
+	if ok {
+		cancel()
+	}
+}
+
+func (s *mcpServer) respond(req *mcp.Message, result any, err *mcp.RPCError) {
+	s.write(&mcp.Response{JSONRPC: "2.0", ID: req.ID, Result: result, Error: err})
+}
+
+func (s *mcpServer) write(v any) {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		slog.Error("failed to encode MCP message", "error", err)
+		return
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	if _, err := s.out.Write(append(encoded, '\n')); err != nil {
+		slog.Error("failed to write MCP message", "error", err)
+	}
+}
+
+func (s *mcpServer) initialize(params json.RawMessage) *mcp.InitializeResult {
+	var request mcp.InitializeParams
+	json.Unmarshal(params, &request)
+
+	version := mcpProtocolVersions[0]
+	if slices.Contains(mcpProtocolVersions, request.ProtocolVersion) {
+		version = request.ProtocolVersion
+	}
+
+	return &mcp.InitializeResult{
+		ProtocolVersion: version,
+		Capabilities:    mcp.ServerCapabilities{Tools: &struct{}{}},
+		ServerInfo:      mcp.Implementation{Name: "construct", Version: Version},
+		Instructions:    "Delegate work to Construct agents. Use run_task for a single request and create_task, send_message and wait_for_result for a conversation.",
+	}
+}
+
+func (s *mcpServer) listTools() *mcp.ListToolsResult {
+	tools := make([]mcp.Tool, 0, len(s.tools))
+	for _, tool := range s.tools {
+		tools = append(tools, mcp.Tool{
+			Name:        tool.Name,
+			Description: tool.Description,
+			InputSchema: tool.InputSchema,
+			Annotations: mcp.ToolAnnotations{ReadOnlyHint: tool.ReadOnly},
+		})
+	}
+	return &mcp.ListToolsResult{Tools: tools}
+}
+
+// callTool runs a tool. Failures of the tool are reported in the result so
+// that the model can see them, only unknown tools are protocol errors.
+func (s *mcpServer) callTool(ctx context.Context, params *mcp.CallToolParams) (any, *mcp.RPCError) {
+	index := slices.IndexFunc(s.tools, func(tool mcpServerTool) bool { return tool.Name == params.Name })
+	if index < 0 {
+		return nil, &mcp.RPCError{Code: mcp.ErrorCodeInvalidParams, Message: fmt.Sprintf("unknown tool %s", params.Name)}
+	}
+
+	call := &mcpToolCall{server: s, arguments: params.Arguments}
+	if params.Meta != nil {
+		call.progressToken = params.Meta.ProgressToken
+	}
+	output, err := s.tools[index].Handler(ctx, call)
+	if err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{{Type: "text", Text: err.Error()}},
+			IsError: true,
+		}, nil
+	}
+
+	encoded, err := json.Marshal(output)
+	if err != nil {
+		return nil, &mcp.RPCError{Code: mcp.ErrorCodeInvalidRequest, Message: err.Error()}
+	}
+	return &mcp.CallToolResult{
+		Content:           []mcp.Content{{Type: "text", Text: string(encoded)}},
+		StructuredContent: output,
+	}, nil
+}
+
+func (s *mcpServer) serverTools() []mcpServerTool {
+	taskArguments := map[string]any{
+		"agent": map[string]any{
+			"type":        "string",
+			"description": "Name or ID of the agent. Defaults to defaults.agent of the configuration.",
+		},
+		"workspace": map[string]any{
+			"type":        "string",
+			"description": "Absolute path of the directory the agent works in. Defaults to the workspace of the server.",
+		},
+	}
+	timeoutArgument := map[string]any{
+		"type":        "integer",
+		"description": "Seconds to wait for the result before giving up. Defaults to 1800.",
+		"minimum":     1,
+	}
+
+	return []mcpServerTool{
+		{
+			Name:        "list_agents",
+			Description: "Lists the agents that can work on tasks.",
+			InputSchema: map[string]any{"type": "object"},
+			ReadOnly:    true,
+			Handler:     s.listAgents,
+		},
+		{
+			Name:        "run_task",
+			Description: "Creates a task, sends the prompt to the agent and waits for its final response. Use it to delegate self-contained work.",
+			InputSchema: map[string]any{
+				"type": "object",
+				"properties": mergeSchemaProperties(taskArguments, map[string]any{
+					"prompt":          map[string]any{"type": "string", "description": "The request for the agent."},
+					"timeout_seconds": timeoutArgument,
+				}),
+				"required": []string{"prompt"},
+			},
+			Handler: s.runTask,
+		},
+		{
+			Name:        "create_task",
+			Description: "Creates a task and optionally sends it a first prompt without waiting for the response.",
+			InputSchema: map[string]any{
+				"type": "object",
+				"properties": mergeSchemaProperties(taskArguments, map[string]any{
+					"prompt": map[string]any{"type": "string", "description": "The first request for the agent."},
+				}),
+			},
+			Handler: s.createTask,
+		},
+		{
+			Name:        "send_message",
+			Description: "Sends a message to a task. The agent starts working on it immediately or after its current turn.",
+			InputSchema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"task_id": map[string]any{"type": "string", "description": "ID of the task."},
+					"message": map[string]any{"type": "string", "description": "The request for the agent."},
+				},
+				"required": []string{"task_id", "message"},
+			},
+			Handler: s.sendMessage,
+		},
+		{
+			Name:        "wait_for_result",
+			Description: "Waits until the agent of a task has finished working and returns its final response. Intermediate responses are reported as progress.",
+			InputSchema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"task_id":         map[string]any{"type": "string", "description": "ID of the task."},
+					"timeout_seconds": timeoutArgument,
+				},
+				"required": []string{"task_id"},
+			},
+			ReadOnly: true,
+			Handler:  s.waitForResult,
+		},
+		{
+			Name:        "get_task",
+			Description: "Returns the state and usage of a task.",
+			InputSchema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"task_id": map[string]any{"type": "string", "description": "ID of the task."},
+				},
+				"required": []string{"task_id"},
+			},
+			ReadOnly: true,
+			Handler:  s.getTask,
+		},
+	}
+}
+
+func mergeSchemaProperties(properties ...map[string]any) map[string]any {
+	merged := make(map[string]any)
+	for _, p := range properties {
+		for key, value := range p {
+			merged[key] = value
+		}
+	}
+	return merged
+}
+
+type mcpAgent struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+func (s *mcpServer) listAgents(ctx context.Context, call *mcpToolCall) (any, error) {
+	resp, err := s.client.Agent().ListAgents(ctx, &connect.Request[v1.ListAgentsRequest]{
+		Msg: &v1.ListAgentsRequest{},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list agents: %w", err)
+	}
+
+	agents := make([]mcpAgent, 0, len(resp.Msg.Agents))
+	for _, agent := range resp.Msg.Agents {
+		agents = append(agents, mcpAgent{
+			ID:          agent.Metadata.Id,
+			Name:        agent.Spec.Name,
+			Description: agent.Spec.Description,
+		})
+	}
+
+	return map[string]any{"agents": agents}, nil
+}
+
+type mcpTaskArguments struct {
+	Agent          string `json:"agent"`
+	Workspace      string `json:"workspace"`
+	Prompt         string `json:"prompt"`
+	TimeoutSeconds int    `json:"timeout_seconds"`
+}
+
+type mcpTaskResult struct {
+	TaskID   string `json:"task_id"`
+	Response string `json:"response,omitempty"`
+}
+
+func (s *mcpServer) createTask(ctx context.Context, call *mcpToolCall) (any, error) {
+	var args mcpTaskArguments
+	if err := call.decode(&args); err != nil {
+		return nil, err
+	}
+
+	task, err := s.newTask(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if args.Prompt != "" {
+		if err := sendMessage(ctx, s.client, task.Metadata.Id, args.Prompt); err != nil {
+			return nil, err
+		}
+	}
+
+	return &mcpTaskResult{TaskID: task.Metadata.Id}, nil
+}
+
+func (s *mcpServer) runTask(ctx context.Context, call *mcpToolCall) (any, error) {
+	var args mcpTaskArguments
+	if err := call.decode(&args); err != nil {
+		return nil, err
+	}
+	if args.Prompt == "" {
+		return nil, errors.New("prompt is required")
+	}
+
+	task, err := s.newTask(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := waitContext(ctx, args.TimeoutSeconds)
+	defer cancel()
+
+	// subscribe before sending the prompt so that no response is missed
+	stream, err := s.subscribe(ctx, task.Metadata.Id)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+
+	if err := sendMessage(ctx, s.client, task.Metadata.Id, args.Prompt); err != nil {
+		return nil, err
+	}
+
+	response, err := awaitFinalResponse(stream, call)
+	if err != nil {
+		return nil, taskWaitError(task.Metadata.Id, err)
+	}
+
+	return &mcpTaskResult{TaskID: task.Metadata.Id, Response: response}, nil
+}
+
+func (s *mcpServer) newTask(ctx context.Context, args mcpTaskArguments) (*v1.Task, error) {
+	workspace := args.Workspace
+	if workspace == "" {
+		workspace = s.workspace
+	}
+
+	agent := args.Agent
+	if agent == "" {
+		var err error
+		agent, err = defaultAgent(s.cmdCtx, workspace)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	agentID, err := getAgentID(ctx, s.client, agent)
+	if err != nil {
+		return nil, err
+	}
+
+	return createTask(ctx, s.client, agentID, workspace)
+}
+
+func (s *mcpServer) sendMessage(ctx context.Context, call *mcpToolCall) (any, error) {
+	var args struct {
+		TaskID  string `json:"task_id"`
+		Message string `json:"message"`
+	}
+	if err := call.decode(&args); err != nil {
+		return nil, err
+	}
+	if args.TaskID == "" || args.Message == "" {
+		return nil, errors.New("task_id and message are required")
+	}
+
+	if err := sendMessage(ctx, s.client, args.TaskID, args.Message); err != nil {
+		return nil, err
+	}
+
+	return &mcpTaskResult{TaskID: args.TaskID}, nil
+}
+
+func (s *mcpServer) waitForResult(ctx context.Context, call *mcpToolCall) (any, error) {
+	var args struct {
+		TaskID         string `json:"task_id"`
+		TimeoutSeconds int    `json:"timeout_seconds"`
+	}
+	if err := call.decode(&args); err != nil {
+		return nil, err
+	}
+	if args.TaskID == "" {
+		return nil, errors.New("task_id is required")
+	}
+
+	ctx, cancel := waitContext(ctx, args.TimeoutSeconds)
+	defer cancel()
+
+	stream, err := s.subscribe(ctx, args.TaskID)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+
+	// the agent may have finished before the stream was opened
+	task, err := s.client.Task().GetTask(ctx, &connect.Request[v1.GetTaskRequest]{
+		Msg: &v1.GetTaskRequest{Id: args.TaskID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+	if task.Msg.Task.Status.GetPhase() != v1.TaskPhase_TASK_PHASE_RUNNING && task.Msg.Task.Status.GetPendingMessages() == 0 {
+		response, err := s.lastResponse(ctx, args.TaskID)
+		if err != nil {
+			return nil, err
+		}
+		return &mcpTaskResult{TaskID: args.TaskID, Response: response}, nil
+	}
+
+	response, err := awaitFinalResponse(stream, call)
+	if err != nil {
+		return nil, taskWaitError(args.TaskID, err)
+	}
+
+	return &mcpTaskResult{TaskID: args.TaskID, Response: response}, nil
+}
+
+func (s *mcpServer) getTask(ctx context.Context, call *mcpToolCall) (any, error) {
+	var args struct {
+		TaskID string `json:"task_id"`
+	}
+	if err := call.decode(&args); err != nil {
+		return nil, err
+	}
+	if args.TaskID == "" {
+		return nil, errors.New("task_id is required")
+	}
+
+	resp, err := s.client.Task().GetTask(ctx, &connect.Request[v1.GetTaskRequest]{
+		Msg: &v1.GetTaskRequest{Id: args.TaskID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+
+	task := resp.Msg.Task
+	return map[string]any{
+		"task":  ConvertTaskToDisplay(task),
+		"phase": mcpTaskPhase(task.Status.GetPhase()),
+	}, nil
+}
+
+func mcpTaskPhase(phase v1.TaskPhase) string {
+	switch phase {
+	case v1.TaskPhase_TASK_PHASE_AWAITING:
+		return "awaiting"
+	case v1.TaskPhase_TASK_PHASE_RUNNING:
+		return "running"
+	case v1.TaskPhase_TASK_PHASE_SUSPENDED:
+		return "suspended"
+	default:
+		return "unknown"
+	}
+}
+
+func (s *mcpServer) subscribe(ctx context.Context, taskID string) (*connect.ServerStreamForClient[v1.SubscribeResponse], error) {
+	stream, err := s.client.Task().Subscribe(ctx, &connect.Request[v1.SubscribeRequest]{
+		Msg: &v1.SubscribeRequest{TaskId: taskID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe to task: %w", err)
+	}
+	return stream, nil
+}
+
+// lastResponse returns the text of the most recent message of the agent.
+func (s *mcpServer) lastResponse(ctx context.Context, taskID string) (string, error) {
+	resp, err := s.client.Message().ListMessages(ctx, &connect.Request[v1.ListMessagesRequest]{
+		Msg: &v1.ListMessagesRequest{
+			Filter: &v1.ListMessagesRequest_Filter{
+				TaskIds: &taskID,
+				Roles:   conv.Ptr(v1.MessageRole_MESSAGE_ROLE_ASSISTANT),
+			},
+			PageSize:  conv.Ptr(int32(1)),
+			SortField: conv.Ptr(v1.SortField_SORT_FIELD_CREATED_AT),
+			SortOrder: conv.Ptr(v1.SortOrder_SORT_ORDER_DESC),
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to list messages: %w", err)
+	}
+
+	if len(resp.Msg.Messages) == 0 {
+		return "", nil
+	}
+	return messageText(resp.Msg.Messages[0]), nil
+}
+
+// awaitFinalResponse reads the stream until the agent sends its final
+// response. Other messages of the agent are reported as progress.
+func awaitFinalResponse(stream *connect.ServerStreamForClient[v1.SubscribeResponse], call *mcpToolCall) (string, error) {
+	for stream.Receive() {
+		message := stream.Msg().GetMessage()
+		if message == nil || message.Metadata.GetRole() != v1.MessageRole_MESSAGE_ROLE_ASSISTANT {
+			continue
+		}
+
+		text := messageText(message)
+		if message.Status != nil && message.Status.IsFinalResponse {
+			return text, nil
+		}
+		if text != "" {
+			call.reportProgress(text)
+		}
+	}
+
+	if err := stream.Err(); err != nil {
+		return "", err
+	}
+	return "", errors.New("stream closed before the agent responded")
+}
+
+func messageText(message *v1.Message) string {
+	var text string
+	for _, part := range message.Spec.GetContent() {
+		if t, ok := part.Data.(*v1.MessagePart_Text_); ok {
+			if text != "" {
+				text += "\n"
+			}
+			text += t.Text.Content
+		}
+	}
+	return text
+}
+
+func waitContext(ctx context.Context, timeoutSeconds int) (context.Context, context.CancelFunc) {
+	timeout := mcpDefaultWaitTimeout
+	if timeoutSeconds > 0 {
+		timeout = time.Duration(timeoutSeconds) * time.Second
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+func taskWaitError(taskID string, err error) error {
+	if errors.Is(err, context.DeadlineExceeded) || connect.CodeOf(err) == connect.CodeDeadlineExceeded {
+		return fmt.Errorf("task %s is still running: call wait_for_result to keep waiting", taskID)
+	}
+	return fmt.Errorf("failed to wait for the result of task %s: %w", taskID, err)
+}
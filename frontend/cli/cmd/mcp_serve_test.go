@@ -0,0 +1,261 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/shared/conv"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMcpServe(t *testing.T) {
+	setup := &TestSetup{
+		CmpOptions: []cmp.Option{cmp.Transformer("decodeJSONLines", decodeJSONLines)},
+	}
+
+	taskID1 := uuid.NewString()
+	agentID1 := uuid.NewString()
+	agentID2 := uuid.NewString()
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - initialize negotiates the protocol version",
+			Command: []string{"mcp", "serve", "--workspace", "/path/to/repo"},
+			Stdin: mcpLines(
+				map[string]any{"jsonrpc": "2.0", "id": 1, "method": "initialize", "params": map[string]any{"protocolVersion": "2025-03-26"}},
+				map[string]any{"jsonrpc": "2.0", "method": "notifications/initialized"},
+				map[string]any{"jsonrpc": "2.0", "id": 2, "method": "initialize", "params": map[string]any{"protocolVersion": "1999-01-01"}},
+				map[string]any{"jsonrpc": "2.0", "id": "p", "method": "ping"},
+				map[string]any{"jsonrpc": "2.0", "id": 3, "method": "resources/list"},
+			),
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(mcpLines(
+					mcpInitializeResult(1, "2025-03-26"),
+					mcpInitializeResult(2, "2025-06-18"),
+					map[string]any{"jsonrpc": "2.0", "id": "p", "result": map[string]any{}},
+					map[string]any{"jsonrpc": "2.0", "id": 3, "error": map[string]any{"code": -32601, "message": "method resources/list not found"}},
+				)),
+			},
+		},
+		{
+			Name:    "success - list agents",
+			Command: []string{"mcp", "serve", "--workspace", "/path/to/repo"},
+			Stdin: mcpLines(
+				mcpToolCallRequest(1, "list_agents", nil),
+			),
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Agent.EXPECT().ListAgents(
+					gomock.Any(),
+					&connect.Request[v1.ListAgentsRequest]{Msg: &v1.ListAgentsRequest{}},
+				).Return(&connect.Response[v1.ListAgentsResponse]{
+					Msg: &v1.ListAgentsResponse{
+						Agents: []*v1.Agent{
+							createTestAgent(agentID1, "coder", "", "Writes code", uuid.NewString()),
+							createTestAgent(agentID2, "architect", "", "", uuid.NewString()),
+						},
+					},
+				}, nil)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(mcpLines(
+					mcpToolResult(1, map[string]any{"agents": []any{
+						map[string]any{"id": agentID1, "name": "coder", "description": "Writes code"},
+						map[string]any{"id": agentID2, "name": "architect"},
+					}}),
+				)),
+			},
+		},
+		{
+			Name:    "success - create task with prompt",
+			Command: []string{"mcp", "serve", "--workspace", "/path/to/repo"},
+			Stdin: mcpLines(
+				mcpToolCallRequest(1, "create_task", map[string]any{"agent": "coder", "prompt": "Fix the flaky test"}),
+			),
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupAgentLookupForTaskCreateMock(mockClient, "coder", agentID1)
+				setupTaskCreateMock(mockClient, agentID1, "/path/to/repo", taskID1)
+				setupMcpSendMessageMock(mockClient, taskID1, "Fix the flaky test", nil)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(mcpLines(
+					mcpToolResult(1, map[string]any{"task_id": taskID1}),
+				)),
+			},
+		},
+		{
+			Name:    "error - send message failure is reported as tool error",
+			Command: []string{"mcp", "serve"},
+			Stdin: mcpLines(
+				mcpToolCallRequest(1, "send_message", map[string]any{"task_id": taskID1, "message": "Also update the docs"}),
+			),
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupMcpSendMessageMock(mockClient, taskID1, "Also update the docs", connect.NewError(connect.CodeNotFound, errors.New("task not found")))
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(mcpLines(
+					mcpToolError(1, "failed to send message: not_found: task not found"),
+				)),
+			},
+		},
+		{
+			Name:    "error - missing arguments",
+			Command: []string{"mcp", "serve"},
+			Stdin: mcpLines(
+				mcpToolCallRequest(1, "run_task", map[string]any{"agent": "coder"}),
+			),
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(mcpLines(
+					mcpToolError(1, "prompt is required"),
+				)),
+			},
+		},
+		{
+			Name:    "error - unknown tool",
+			Command: []string{"mcp", "serve"},
+			Stdin: mcpLines(
+				mcpToolCallRequest(1, "delete_everything", nil),
+			),
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(mcpLines(
+					map[string]any{"jsonrpc": "2.0", "id": 1, "error": map[string]any{"code": -32602, "message": "unknown tool delete_everything"}},
+				)),
+			},
+		},
+	})
+}
+
+func TestMcpServeListTools(t *testing.T) {
+	server := newMcpServer(t.Context(), nil, "/path/to/repo", nil)
+
+	var names []string
+	for _, tool := range server.listTools().Tools {
+		names = append(names, tool.Name)
+	}
+
+	expected := "list_agents,run_task,create_task,send_message,wait_for_result,get_task"
+	if strings.Join(names, ",") != expected {
+		t.Errorf("expected tools %s, got %s", expected, strings.Join(names, ","))
+	}
+}
+
+func setupMcpSendMessageMock(mockClient *api_client.MockClient, taskID, message string, err error) {
+	call := mockClient.Message.EXPECT().CreateMessage(
+		gomock.Any(),
+		&connect.Request[v1.CreateMessageRequest]{
+			Msg: &v1.CreateMessageRequest{
+				TaskId: taskID,
+				Content: []*v1.MessagePart{
+					{
+						Data: &v1.MessagePart_Text_{
+							Text: &v1.MessagePart_Text{
+								Content: message,
+							},
+						},
+					},
+				},
+			},
+		},
+	)
+	if err != nil {
+		call.Return(nil, err)
+		return
+	}
+	call.Return(&connect.Response[v1.CreateMessageResponse]{
+		Msg: &v1.CreateMessageResponse{},
+	}, nil)
+}
+
+// decodeJSONLines decodes newline delimited JSON so that messages compare
+// independently of the order of their keys. Text content that is JSON itself
+// is decoded as well.
+func decodeJSONLines(s *string) []any {
+	if s == nil {
+		return nil
+	}
+
+	var values []any
+	for _, line := range strings.Split(strings.TrimSuffix(*s, "\n"), "\n") {
+		var value any
+		if err := json.Unmarshal([]byte(line), &value); err != nil {
+			return []any{*s}
+		}
+		values = append(values, decodeJSONText(value))
+	}
+	return values
+}
+
+func decodeJSONText(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			if text, ok := item.(string); ok && key == "text" {
+				var decoded any
+				if err := json.Unmarshal([]byte(text), &decoded); err == nil {
+					v[key] = decoded
+				}
+				continue
+			}
+			v[key] = decodeJSONText(item)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = decodeJSONText(item)
+		}
+	}
+	return value
+}
+
+func mcpLines(messages ...any) string {
+	var builder strings.Builder
+	for _, message := range messages {
+		encoded, err := json.Marshal(message)
+		if err != nil {
+			panic(err)
+		}
+		builder.Write(encoded)
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
+
+func mcpToolCallRequest(id int, name string, arguments map[string]any) map[string]any {
+	params := map[string]any{"name": name}
+	if arguments != nil {
+		params["arguments"] = arguments
+	}
+	return map[string]any{"jsonrpc": "2.0", "id": id, "method": "tools/call", "params": params}
+}
+
+func mcpToolResult(id int, output map[string]any) map[string]any {
+	encoded, err := json.Marshal(output)
+	if err != nil {
+		panic(err)
+	}
+	return map[string]any{"jsonrpc": "2.0", "id": id, "result": map[string]any{
+		"content":           []any{map[string]any{"type": "text", "text": string(encoded)}},
+		"structuredContent": output,
+	}}
+}
+
+func mcpToolError(id int, message string) map[string]any {
+	return map[string]any{"jsonrpc": "2.0", "id": id, "result": map[string]any{
+		"content": []any{map[string]any{"type": "text", "text": message}},
+		"isError": true,
+	}}
+}
+
+func mcpInitializeResult(id int, version string) map[string]any {
+	return map[string]any{"jsonrpc": "2.0", "id": id, "result": map[string]any{
+		"protocolVersion": version,
+		"capabilities":    map[string]any{"tools": map[string]any{}},
+		"serverInfo":      map[string]any{"name": "construct", "version": Version},
+		"instructions":    "Delegate work to Construct agents. Use run_task for a single request and create_task, send_message and wait_for_result for a conversation.",
+	}}
+}
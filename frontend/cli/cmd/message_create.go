@@ -9,6 +9,7 @@ import (
 )
 
 type messageCreateOptions struct {
+	Steer         bool
 	RenderOptions RenderOptions
 }
 
@@ -23,24 +24,32 @@ func NewMessageCreateCmd() *cobra.Command {
 Appends a new message to a task's history. This is an advanced command, typically 
 used for scripting or integrating external tools with Construct tasks.`,
 		Example: `  # Add a user message to an existing task
-  construct message create "01974c1d-0be8-70e1-88b4-ad9462fff25e" "Please check the file again."`,
+  construct message create "01974c1d-0be8-70e1-88b4-ad9462fff25e" "Please check the file again."
+
+  # Interrupt the running task and steer it in a new direction
+  construct message create "01974c1d-0be8-70e1-88b4-ad9462fff25e" "Use the v2 API instead." --steer`,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client := getAPIClient(cmd.Context())
 
-			resp, err := client.Message().CreateMessage(cmd.Context(), &connect.Request[v1.CreateMessageRequest]{
-				Msg: &v1.CreateMessageRequest{
-					TaskId: args[0],
-					Content: []*v1.MessagePart{
-						{
-							Data: &v1.MessagePart_Text_{
-								Text: &v1.MessagePart_Text{
-									Content: args[1],
-								},
+			req := &v1.CreateMessageRequest{
+				TaskId: args[0],
+				Content: []*v1.MessagePart{
+					{
+						Data: &v1.MessagePart_Text_{
+							Text: &v1.MessagePart_Text{
+								Content: args[1],
 							},
 						},
 					},
 				},
+			}
+			if options.Steer {
+				req.Delivery = v1.MessageDelivery_MESSAGE_DELIVERY_STEER
+			}
+
+			resp, err := client.Message().CreateMessage(cmd.Context(), &connect.Request[v1.CreateMessageRequest]{
+				Msg: req,
 			})
 
 			if err != nil {
@@ -52,6 +61,7 @@ used for scripting or integrating external tools with Construct tasks.`,
 		},
 	}
 
+	cmd.Flags().BoolVar(&options.Steer, "steer", false, "Interrupt the running task and process this message next instead of queueing it")
 	addRenderOptions(cmd, &options.RenderOptions)
 	return cmd
 }
@@ -32,6 +32,44 @@ func TestMessageCreate(t *testing.T) {
 				Stdout: conv.Ptr(messageID1 + "\n"),
 			},
 		},
+		{
+			Name:    "success - steer running task",
+			Command: []string{"message", "create", taskID1, "Use the v2 API instead", "--steer"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Message.EXPECT().CreateMessage(
+					gomock.Any(),
+					&connect.Request[v1.CreateMessageRequest]{
+						Msg: &v1.CreateMessageRequest{
+							TaskId: taskID1,
+							Content: []*v1.MessagePart{
+								{
+									Data: &v1.MessagePart_Text_{
+										Text: &v1.MessagePart_Text{
+											Content: "Use the v2 API instead",
+										},
+									},
+								},
+							},
+							Delivery: v1.MessageDelivery_MESSAGE_DELIVERY_STEER,
+						},
+					},
+				).Return(&connect.Response[v1.CreateMessageResponse]{
+					Msg: &v1.CreateMessageResponse{
+						Message: &v1.Message{
+							Metadata: &v1.MessageMetadata{
+								Id:        messageID1,
+								TaskId:    taskID1,
+								CreatedAt: timestamppb.New(createdAt),
+								Role:      v1.MessageRole_MESSAGE_ROLE_USER,
+							},
+						},
+					},
+				}, nil)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(messageID1 + "\n"),
+			},
+		},
 		{
 			Name:    "error - create message API failure",
 			Command: []string{"message", "create", taskID1, "Test message"},
@@ -17,8 +17,10 @@ import (
 )
 
 type newOptions struct {
-	agent     string
-	workspace string
+	agent           string
+	workspace       string
+	persistentState bool
+	preview         bool
 }
 
 func NewNewCmd() *cobra.Command {
@@ -51,6 +53,14 @@ code reviews.`,
 				}
 				options.workspace = workspace
 			}
+
+			if options.agent == "" {
+				agent, err := defaultAgent(cmd.Context(), options.workspace)
+				if err != nil {
+					return err
+				}
+				options.agent = agent
+			}
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -61,8 +71,10 @@ code reviews.`,
 		},
 	}
 
-	cmd.Flags().StringVar(&options.agent, "agent", "", "Start the session with a specific agent. Defaults to the last used agent")
+	cmd.Flags().StringVar(&options.agent, "agent", "", "Start the session with a specific agent. Defaults to defaults.agent from the project or user configuration")
 	cmd.Flags().StringVar(&options.workspace, "workspace", "", "Set the agent's working directory. Defaults to the current directory")
+	cmd.Flags().BoolVar(&options.persistentState, "persistent-state", false, "Keep the JavaScript state of the agent's scripts between tool calls")
+	cmd.Flags().BoolVar(&options.preview, "preview", false, "Stage the agent's file changes for review instead of writing them. Apply them with construct task apply")
 
 	return cmd
 }
@@ -90,6 +102,8 @@ func handleNewCommand(ctx context.Context, apiClient *api.Client, options *newOp
 		Msg: &v1.CreateTaskRequest{
 			AgentId:          agent.Metadata.Id,
 			ProjectDirectory: options.workspace,
+			PersistentState:  options.persistentState,
+			Preview:          options.preview,
 		},
 	})
 
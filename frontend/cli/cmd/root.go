@@ -103,10 +103,14 @@ func NewRootCmd() *cobra.Command {
 	cmd.AddCommand(NewAgentCmd())
 	cmd.AddCommand(NewTaskCmd())
 	cmd.AddCommand(NewMessageCmd())
+	cmd.AddCommand(NewScheduleCmd())
+	cmd.AddCommand(NewWebhookCmd())
+	cmd.AddCommand(NewToolCmd())
 	cmd.AddCommand(NewModelCmd())
 	cmd.AddCommand(NewModelProviderCmd())
 
 	cmd.AddCommand(NewConfigCmd())
+	cmd.AddCommand(NewMcpCmd())
 	cmd.AddCommand(NewDaemonCmd())
 	cmd.AddCommand(NewInfoCmd())
 	cmd.AddCommand(NewUpdateCmd())
@@ -174,13 +178,15 @@ func setAPIClient(ctx context.Context, cmd *cobra.Command) error {
 
 func requiresContext(cmd *cobra.Command) bool {
 	skipCommands := []string{"info", "help", "update", "daemon.", "config."}
-	for _, skipCmd := range skipCommands {
-		cmdName := cmd.Name()
-		parentCmd := cmd.Parent()
-		if parentCmd != nil {
-			cmdName = parentCmd.Name() + "." + cmdName
-		}
 
+	// qualify the name with all parents below the root command, so that
+	// nested commands such as "daemon.token.create" are matched as well
+	cmdName := cmd.Name()
+	for parent := cmd.Parent(); parent != nil && parent.HasParent(); parent = parent.Parent() {
+		cmdName = parent.Name() + "." + cmdName
+	}
+
+	for _, skipCmd := range skipCommands {
 		if strings.HasPrefix(cmdName, skipCmd) {
 			return false
 		}
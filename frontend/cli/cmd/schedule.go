@@ -0,0 +1,143 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	api "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+func NewScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "schedule",
+		Short:   "Run agents on a recurring schedule",
+		Aliases: []string{"schedules"},
+		GroupID: "resource",
+	}
+
+	cmd.AddCommand(NewScheduleCreateCmd())
+	cmd.AddCommand(NewScheduleGetCmd())
+	cmd.AddCommand(NewScheduleListCmd())
+	cmd.AddCommand(NewScheduleUpdateCmd())
+	cmd.AddCommand(NewScheduleEnableCmd())
+	cmd.AddCommand(NewScheduleDisableCmd())
+	cmd.AddCommand(NewScheduleDeleteCmd())
+	cmd.AddCommand(NewScheduleRunsCmd())
+
+	return cmd
+}
+
+type ScheduleDisplay struct {
+	ID         string  `json:"id" yaml:"id" detail:"default"`
+	Name       string  `json:"name" yaml:"name" detail:"default"`
+	Cron       string  `json:"cron" yaml:"cron" detail:"default"`
+	AgentID    string  `json:"agent_id" yaml:"agent_id" detail:"default"`
+	Enabled    bool    `json:"enabled" yaml:"enabled" detail:"default"`
+	NextRun    string  `json:"next_run,omitempty" yaml:"next_run,omitempty" detail:"default"`
+	LastRun    string  `json:"last_run,omitempty" yaml:"last_run,omitempty"`
+	LastTaskID string  `json:"last_task_id,omitempty" yaml:"last_task_id,omitempty"`
+	Runs       int64   `json:"runs" yaml:"runs"`
+	Skipped    int64   `json:"skipped" yaml:"skipped"`
+	MaxCost    float64 `json:"max_cost,omitempty" yaml:"max_cost,omitempty"`
+	Workspace  string  `json:"workspace" yaml:"workspace"`
+	Prompt     string  `json:"prompt" yaml:"prompt" detail:"full"`
+	CreatedAt  string  `json:"created_at" yaml:"created_at" detail:"full"`
+}
+
+func ConvertScheduleToDisplay(schedule *v1.Schedule) *ScheduleDisplay {
+	if schedule == nil || schedule.Metadata == nil || schedule.Spec == nil {
+		return nil
+	}
+
+	display := &ScheduleDisplay{
+		ID:        schedule.Metadata.Id,
+		Name:      schedule.Spec.Name,
+		Cron:      schedule.Spec.CronExpression,
+		AgentID:   schedule.Spec.AgentId,
+		Enabled:   schedule.Spec.Enabled,
+		MaxCost:   schedule.Spec.MaxCost,
+		Workspace: schedule.Spec.Workspace,
+		Prompt:    schedule.Spec.Prompt,
+		CreatedAt: schedule.Metadata.CreatedAt.AsTime().Format("2006-01-02 15:04:05"),
+	}
+
+	if status := schedule.Status; status != nil {
+		if status.NextRunAt != nil {
+			display.NextRun = status.NextRunAt.AsTime().Local().Format("2006-01-02 15:04:05")
+		}
+		if status.LastRunAt != nil {
+			display.LastRun = status.LastRunAt.AsTime().Local().Format("2006-01-02 15:04:05")
+		}
+		display.LastTaskID = status.GetLastTaskId()
+		display.Runs = status.RunCount
+		display.Skipped = status.SkippedCount
+	}
+
+	return display
+}
+
+type ScheduleRunDisplay struct {
+	ID          string `json:"id" yaml:"id" detail:"default"`
+	Status      string `json:"status" yaml:"status" detail:"default"`
+	RunAt       string `json:"run_at" yaml:"run_at" detail:"default"`
+	TaskID      string `json:"task_id,omitempty" yaml:"task_id,omitempty" detail:"default"`
+	Reason      string `json:"reason,omitempty" yaml:"reason,omitempty" detail:"default"`
+	ScheduledAt string `json:"scheduled_at" yaml:"scheduled_at" detail:"full"`
+}
+
+func ConvertScheduleRunToDisplay(run *v1.ScheduleRun) *ScheduleRunDisplay {
+	if run == nil {
+		return nil
+	}
+
+	return &ScheduleRunDisplay{
+		ID:          run.Id,
+		Status:      convertScheduleRunStatusFromAPI(run.Status),
+		RunAt:       run.RunAt.AsTime().Local().Format("2006-01-02 15:04:05"),
+		TaskID:      run.GetTaskId(),
+		Reason:      run.GetReason(),
+		ScheduledAt: run.ScheduledAt.AsTime().Local().Format("2006-01-02 15:04:05"),
+	}
+}
+
+func convertScheduleRunStatusFromAPI(status v1.ScheduleRunStatus) string {
+	switch status {
+	case v1.ScheduleRunStatus_SCHEDULE_RUN_STATUS_STARTED:
+		return "started"
+	case v1.ScheduleRunStatus_SCHEDULE_RUN_STATUS_SKIPPED:
+		return "skipped"
+	case v1.ScheduleRunStatus_SCHEDULE_RUN_STATUS_FAILED:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
+func getScheduleID(ctx context.Context, client *api.Client, idOrName string) (string, error) {
+	_, err := uuid.Parse(idOrName)
+	if err == nil {
+		return idOrName, nil
+	}
+
+	resp, err := client.Schedule().ListSchedules(ctx, &connect.Request[v1.ListSchedulesRequest]{
+		Msg: &v1.ListSchedulesRequest{
+			Filter: &v1.ListSchedulesRequest_Filter{
+				Name: &idOrName,
+			},
+		},
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("failed to list schedules: %w", err)
+	}
+
+	if len(resp.Msg.Schedules) == 0 {
+		return "", fmt.Errorf("schedule %s not found", idOrName)
+	}
+
+	return resp.Msg.Schedules[0].Metadata.Id, nil
+}
@@ -0,0 +1,143 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+type scheduleCreateOptions struct {
+	Cron       string
+	Agent      string
+	Workspace  string
+	Prompt     string
+	PromptFile string
+	MaxCost    float64
+	Disabled   bool
+}
+
+func NewScheduleCreateCmd() *cobra.Command {
+	var options scheduleCreateOptions
+
+	cmd := &cobra.Command{
+		Use:   "create <name> [flags]",
+		Short: "Run an agent on a recurring schedule",
+		Args:  cobra.ExactArgs(1),
+		Long: `Run an agent on a recurring schedule.
+
+Every time the cron expression fires, a new task is created in the workspace and
+the prompt is sent to the agent as the first message. If the task of the previous
+run is still working, the run is skipped.
+
+The cron expression uses the standard five fields (minute, hour, day of month,
+month, day of week) or a descriptor such as @hourly, @daily or @every 30m.
+
+The prompt is a Go template with access to {{.ScheduleName}}, {{.Workspace}}
+and {{.Time}}.`,
+		Example: `  # Review the changes of the last day every night at 2am
+  construct schedule create nightly-review \
+    --cron "0 2 * * *" \
+    --agent reviewer \
+    --workspace ~/src/project \
+    --prompt "Review the commits of the last 24 hours and summarize any issues"
+
+  # Triage issues every hour with a budget of $1 per run
+  construct schedule create triage --cron @hourly --agent coder \
+    --workspace . --prompt-file ./prompts/triage.md --max-cost 1`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+			fs := getFileSystem(cmd.Context())
+
+			prompt, err := getSchedulePrompt(options.Prompt, options.PromptFile, fs)
+			if err != nil {
+				return err
+			}
+
+			workspace, err := resolveWorkspace(fs, options.Workspace)
+			if err != nil {
+				return err
+			}
+
+			agentID, err := getAgentID(cmd.Context(), client, options.Agent)
+			if err != nil {
+				return fmt.Errorf("failed to resolve agent %s: %w", options.Agent, err)
+			}
+
+			resp, err := client.Schedule().CreateSchedule(cmd.Context(), &connect.Request[v1.CreateScheduleRequest]{
+				Msg: &v1.CreateScheduleRequest{
+					Name:           args[0],
+					CronExpression: options.Cron,
+					AgentId:        agentID,
+					Workspace:      workspace,
+					Prompt:         prompt,
+					MaxCost:        options.MaxCost,
+					Disabled:       options.Disabled,
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create schedule: %w", err)
+			}
+
+			cmd.Println(resp.Msg.Schedule.Metadata.Id)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.Cron, "cron", "c", "", "The cron expression that determines when the schedule runs (required)")
+	cmd.Flags().StringVarP(&options.Agent, "agent", "a", "", "The agent that performs the scheduled runs (required)")
+	cmd.Flags().StringVarP(&options.Workspace, "workspace", "w", "", "The workspace directory for the scheduled runs (required)")
+	cmd.Flags().StringVarP(&options.Prompt, "prompt", "p", "", "The prompt template sent to the agent on every run")
+	cmd.Flags().StringVar(&options.PromptFile, "prompt-file", "", "Read the prompt template from a file")
+	cmd.Flags().Float64Var(&options.MaxCost, "max-cost", 0, "The maximum cost of a single run in USD (0 means unlimited)")
+	cmd.Flags().BoolVar(&options.Disabled, "disabled", false, "Create the schedule without enabling it")
+
+	cmd.MarkFlagRequired("cron")
+	cmd.MarkFlagRequired("agent")
+	cmd.MarkFlagRequired("workspace")
+
+	return cmd
+}
+
+func getSchedulePrompt(prompt, promptFile string, fs *afero.Afero) (string, error) {
+	if prompt != "" && promptFile != "" {
+		return "", fmt.Errorf("only one prompt source can be specified (--prompt or --prompt-file)")
+	}
+
+	if promptFile != "" {
+		content, err := fs.ReadFile(promptFile)
+		if err != nil {
+			return "", fmt.Errorf("failed to read prompt file %s: %w", promptFile, err)
+		}
+		if len(content) == 0 {
+			return "", fmt.Errorf("prompt file %s is empty", promptFile)
+		}
+		return string(content), nil
+	}
+
+	if prompt == "" {
+		return "", fmt.Errorf("prompt is required (use --prompt or --prompt-file)")
+	}
+
+	return prompt, nil
+}
+
+func resolveWorkspace(fs *afero.Afero, workspace string) (string, error) {
+	exists, err := afero.Exists(fs, workspace)
+	if err != nil {
+		return "", fmt.Errorf("failed to check if workspace directory %s exists: %w", workspace, err)
+	}
+	if !exists {
+		return "", fmt.Errorf("workspace directory %s does not exist", workspace)
+	}
+
+	absPath, err := filepath.Abs(workspace)
+	if err != nil {
+		return "", fmt.Errorf("failed to get absolute path of workspace directory %s: %w", workspace, err)
+	}
+
+	return absPath, nil
+}
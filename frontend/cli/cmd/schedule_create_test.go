@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/shared/conv"
+	"github.com/google/uuid"
+	"github.com/spf13/afero"
+	"go.uber.org/mock/gomock"
+)
+
+func TestScheduleCreate(t *testing.T) {
+	setup := &TestSetup{}
+
+	scheduleID := uuid.NewString()
+	agentID := uuid.NewString()
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name: "success - create schedule with inline prompt",
+			Command: []string{"schedule", "create", "nightly-review",
+				"--cron", "0 2 * * *", "--agent", "reviewer", "--workspace", "/path/to/repo",
+				"--prompt", "Review the commits of the last day", "--max-cost", "1.5"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupAgentLookupForTaskCreateMock(mockClient, "reviewer", agentID)
+				setupScheduleCreateMock(mockClient, &v1.CreateScheduleRequest{
+					Name:           "nightly-review",
+					CronExpression: "0 2 * * *",
+					AgentId:        agentID,
+					Workspace:      "/path/to/repo",
+					Prompt:         "Review the commits of the last day",
+					MaxCost:        1.5,
+				}, scheduleID)
+			},
+			SetupFileSystem: func(fs *afero.Afero) {
+				fs.MkdirAll("/path/to/repo", 0755)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(fmt.Sprintln(scheduleID)),
+			},
+		},
+		{
+			Name: "success - create disabled schedule with prompt file",
+			Command: []string{"schedule", "create", "triage",
+				"-c", "@hourly", "-a", agentID, "-w", "/path/to/repo",
+				"--prompt-file", "/prompts/triage.md", "--disabled"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupScheduleCreateMock(mockClient, &v1.CreateScheduleRequest{
+					Name:           "triage",
+					CronExpression: "@hourly",
+					AgentId:        agentID,
+					Workspace:      "/path/to/repo",
+					Prompt:         "Triage the open issues in {{.Workspace}}",
+					Disabled:       true,
+				}, scheduleID)
+			},
+			SetupFileSystem: func(fs *afero.Afero) {
+				fs.MkdirAll("/path/to/repo", 0755)
+				fs.WriteFile("/prompts/triage.md", []byte("Triage the open issues in {{.Workspace}}"), 0644)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(fmt.Sprintln(scheduleID)),
+			},
+		},
+		{
+			Name: "error - missing prompt",
+			Command: []string{"schedule", "create", "nightly-review",
+				"--cron", "0 2 * * *", "--agent", agentID, "--workspace", "/path/to/repo"},
+			Expected: TestExpectation{
+				Error: "prompt is required (use --prompt or --prompt-file)",
+			},
+		},
+		{
+			Name: "error - workspace does not exist",
+			Command: []string{"schedule", "create", "nightly-review",
+				"--cron", "0 2 * * *", "--agent", agentID, "--workspace", "/missing",
+				"--prompt", "Review"},
+			Expected: TestExpectation{
+				Error: "workspace directory /missing does not exist",
+			},
+		},
+		{
+			Name:    "error - cron not provided",
+			Command: []string{"schedule", "create", "nightly-review", "--agent", agentID, "--workspace", "/path/to/repo", "--prompt", "Review"},
+			Expected: TestExpectation{
+				Error: "required flag(s) \"cron\" not set",
+			},
+		},
+		{
+			Name: "error - create schedule API failure",
+			Command: []string{"schedule", "create", "nightly-review",
+				"--cron", "every night", "--agent", agentID, "--workspace", "/path/to/repo",
+				"--prompt", "Review"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Schedule.EXPECT().CreateSchedule(gomock.Any(), gomock.Any()).
+					Return(nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid cron expression")))
+			},
+			SetupFileSystem: func(fs *afero.Afero) {
+				fs.MkdirAll("/path/to/repo", 0755)
+			},
+			Expected: TestExpectation{
+				Error: "failed to create schedule: invalid_argument: invalid cron expression",
+			},
+		},
+	})
+}
+
+func setupScheduleCreateMock(mockClient *api_client.MockClient, req *v1.CreateScheduleRequest, scheduleID string) {
+	mockClient.Schedule.EXPECT().CreateSchedule(
+		gomock.Any(),
+		&connect.Request[v1.CreateScheduleRequest]{Msg: req},
+	).Return(&connect.Response[v1.CreateScheduleResponse]{
+		Msg: &v1.CreateScheduleResponse{
+			Schedule: &v1.Schedule{
+				Metadata: &v1.ScheduleMetadata{
+					Id: scheduleID,
+				},
+			},
+		},
+	}, nil)
+}
@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type scheduleDeleteOptions struct {
+	Force bool
+}
+
+func NewScheduleDeleteCmd() *cobra.Command {
+	options := new(scheduleDeleteOptions)
+	cmd := &cobra.Command{
+		Use:     "delete <name|id>... [flags]",
+		Short:   "Permanently delete one or more schedules",
+		Aliases: []string{"rm"},
+		Long: `Permanently delete one or more schedules.
+
+Tasks that were created by the schedule are kept.`,
+		Example: `  # Delete a single schedule
+  construct schedule delete nightly-review
+
+  # Force delete without a confirmation prompt
+  construct schedule rm nightly-review triage --force`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			scheduleIDs := make(map[string]string)
+			for _, idOrName := range args {
+				scheduleID, err := getScheduleID(cmd.Context(), client, idOrName)
+				if err != nil {
+					return err
+				}
+
+				scheduleIDs[idOrName] = scheduleID
+			}
+
+			if !options.Force && !confirmDeletion(cmd.InOrStdin(), cmd.OutOrStdout(), "schedule", args) {
+				return nil
+			}
+
+			for idOrName, scheduleID := range scheduleIDs {
+				_, err := client.Schedule().DeleteSchedule(cmd.Context(), &connect.Request[v1.DeleteScheduleRequest]{
+					Msg: &v1.DeleteScheduleRequest{Id: scheduleID},
+				})
+				if err != nil {
+					return fmt.Errorf("failed to delete schedule %s: %w", idOrName, err)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&options.Force, "force", "f", false, "Skip the confirmation prompt")
+
+	return cmd
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/shared/conv"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func TestScheduleDelete(t *testing.T) {
+	setup := &TestSetup{}
+
+	scheduleID := uuid.NewString()
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - delete by name with force flag",
+			Command: []string{"schedule", "delete", "--force", "nightly-review"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupScheduleNameLookup(mockClient, "nightly-review", scheduleID)
+				setupScheduleDeletionMock(mockClient, scheduleID)
+			},
+		},
+		{
+			Name:    "success - delete by ID with user confirmation",
+			Command: []string{"schedule", "rm", scheduleID},
+			Stdin:   "y\n",
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupScheduleDeletionMock(mockClient, scheduleID)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr("Are you sure you want to delete schedule " + scheduleID + "? (y/n): "),
+			},
+		},
+		{
+			Name:    "success - deletion cancelled by user",
+			Command: []string{"schedule", "delete", scheduleID},
+			Stdin:   "n\n",
+			Expected: TestExpectation{
+				Stdout: conv.Ptr("Are you sure you want to delete schedule " + scheduleID + "? (y/n): "),
+			},
+		},
+		{
+			Name:    "error - delete schedule API failure",
+			Command: []string{"schedule", "delete", "--force", scheduleID},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Schedule.EXPECT().DeleteSchedule(gomock.Any(), gomock.Any()).
+					Return(nil, connect.NewError(connect.CodeNotFound, nil))
+			},
+			Expected: TestExpectation{
+				Error: "failed to delete schedule " + scheduleID + ": not_found",
+			},
+		},
+	})
+}
+
+func setupScheduleDeletionMock(mockClient *api_client.MockClient, scheduleID string) {
+	mockClient.Schedule.EXPECT().DeleteSchedule(
+		gomock.Any(),
+		&connect.Request[v1.DeleteScheduleRequest]{
+			Msg: &v1.DeleteScheduleRequest{Id: scheduleID},
+		},
+	).Return(&connect.Response[v1.DeleteScheduleResponse]{
+		Msg: &v1.DeleteScheduleResponse{},
+	}, nil)
+}
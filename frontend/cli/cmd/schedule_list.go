@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type scheduleListOptions struct {
+	Agent         string
+	RenderOptions RenderOptions
+}
+
+func NewScheduleListCmd() *cobra.Command {
+	var options scheduleListOptions
+
+	cmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   "List all schedules",
+		Aliases: []string{"ls"},
+		Example: `  # List all schedules
+  construct schedule list
+
+  # List the schedules of a specific agent
+  construct schedule ls --agent reviewer`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			filter := &v1.ListSchedulesRequest_Filter{}
+			if options.Agent != "" {
+				agentID, err := getAgentID(cmd.Context(), client, options.Agent)
+				if err != nil {
+					return fmt.Errorf("failed to resolve agent %s: %w", options.Agent, err)
+				}
+				filter.AgentId = &agentID
+			}
+
+			resp, err := client.Schedule().ListSchedules(cmd.Context(), &connect.Request[v1.ListSchedulesRequest]{
+				Msg: &v1.ListSchedulesRequest{
+					Filter: filter,
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to list schedules: %w", err)
+			}
+
+			displaySchedules := make([]*ScheduleDisplay, len(resp.Msg.Schedules))
+			for i, schedule := range resp.Msg.Schedules {
+				displaySchedules[i] = ConvertScheduleToDisplay(schedule)
+			}
+
+			return getRenderer(cmd.Context()).Render(displaySchedules, &options.RenderOptions)
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.Agent, "agent", "a", "", "Filter schedules by the agent that runs them")
+	addRenderOptions(cmd, &options.RenderOptions)
+
+	return cmd
+}
@@ -0,0 +1,165 @@
+package cmd
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestScheduleList(t *testing.T) {
+	setup := &TestSetup{
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreFields(ScheduleDisplay{}, "CreatedAt"),
+		},
+	}
+
+	scheduleID := uuid.NewString()
+	agentID := uuid.NewString()
+	taskID := uuid.NewString()
+
+	schedule := &v1.Schedule{
+		Metadata: &v1.ScheduleMetadata{
+			Id:        scheduleID,
+			CreatedAt: timestamppb.Now(),
+		},
+		Spec: &v1.ScheduleSpec{
+			Name:           "nightly-review",
+			CronExpression: "0 2 * * *",
+			AgentId:        agentID,
+			Workspace:      "/path/to/repo",
+			Prompt:         "Review the commits of the last day",
+			Enabled:        true,
+		},
+		Status: &v1.ScheduleStatus{
+			LastTaskId:   &taskID,
+			RunCount:     3,
+			SkippedCount: 1,
+		},
+	}
+
+	display := &ScheduleDisplay{
+		ID:         scheduleID,
+		Name:       "nightly-review",
+		Cron:       "0 2 * * *",
+		AgentID:    agentID,
+		Enabled:    true,
+		LastTaskID: taskID,
+		Runs:       3,
+		Skipped:    1,
+		Workspace:  "/path/to/repo",
+		Prompt:     "Review the commits of the last day",
+	}
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - list schedules",
+			Command: []string{"schedule", "list"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Schedule.EXPECT().ListSchedules(
+					gomock.Any(),
+					&connect.Request[v1.ListSchedulesRequest]{
+						Msg: &v1.ListSchedulesRequest{
+							Filter: &v1.ListSchedulesRequest_Filter{},
+						},
+					},
+				).Return(&connect.Response[v1.ListSchedulesResponse]{
+					Msg: &v1.ListSchedulesResponse{
+						Schedules: []*v1.Schedule{schedule},
+					},
+				}, nil)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: []*ScheduleDisplay{display},
+			},
+		},
+		{
+			Name:    "success - list schedules of an agent",
+			Command: []string{"schedule", "ls", "--agent", agentID},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Schedule.EXPECT().ListSchedules(
+					gomock.Any(),
+					&connect.Request[v1.ListSchedulesRequest]{
+						Msg: &v1.ListSchedulesRequest{
+							Filter: &v1.ListSchedulesRequest_Filter{
+								AgentId: &agentID,
+							},
+						},
+					},
+				).Return(&connect.Response[v1.ListSchedulesResponse]{
+					Msg: &v1.ListSchedulesResponse{},
+				}, nil)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: []*ScheduleDisplay{},
+			},
+		},
+		{
+			Name:    "success - get schedule by name",
+			Command: []string{"schedule", "get", "nightly-review"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupScheduleNameLookup(mockClient, "nightly-review", scheduleID)
+				mockClient.Schedule.EXPECT().GetSchedule(
+					gomock.Any(),
+					&connect.Request[v1.GetScheduleRequest]{
+						Msg: &v1.GetScheduleRequest{Id: scheduleID},
+					},
+				).Return(&connect.Response[v1.GetScheduleResponse]{
+					Msg: &v1.GetScheduleResponse{Schedule: schedule},
+				}, nil)
+			},
+			Expected: TestExpectation{
+				DisplayFormat: &RenderOptions{
+					Format: OutputFormatCard,
+				},
+				DisplayedObjects: display,
+			},
+		},
+		{
+			Name:    "error - schedule not found",
+			Command: []string{"schedule", "get", "weekly-review"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				name := "weekly-review"
+				mockClient.Schedule.EXPECT().ListSchedules(
+					gomock.Any(),
+					&connect.Request[v1.ListSchedulesRequest]{
+						Msg: &v1.ListSchedulesRequest{
+							Filter: &v1.ListSchedulesRequest_Filter{Name: &name},
+						},
+					},
+				).Return(&connect.Response[v1.ListSchedulesResponse]{
+					Msg: &v1.ListSchedulesResponse{},
+				}, nil)
+			},
+			Expected: TestExpectation{
+				Error: "failed to resolve schedule weekly-review: schedule weekly-review not found",
+			},
+		},
+	})
+}
+
+func setupScheduleNameLookup(mockClient *api_client.MockClient, name, scheduleID string) {
+	mockClient.Schedule.EXPECT().ListSchedules(
+		gomock.Any(),
+		&connect.Request[v1.ListSchedulesRequest]{
+			Msg: &v1.ListSchedulesRequest{
+				Filter: &v1.ListSchedulesRequest_Filter{Name: &name},
+			},
+		},
+	).Return(&connect.Response[v1.ListSchedulesResponse]{
+		Msg: &v1.ListSchedulesResponse{
+			Schedules: []*v1.Schedule{
+				{
+					Metadata: &v1.ScheduleMetadata{Id: scheduleID},
+					Spec:     &v1.ScheduleSpec{Name: name},
+				},
+			},
+		},
+	}, nil)
+}
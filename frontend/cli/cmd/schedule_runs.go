@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type scheduleRunsOptions struct {
+	Limit         int32
+	RenderOptions RenderOptions
+}
+
+func NewScheduleRunsCmd() *cobra.Command {
+	var options scheduleRunsOptions
+
+	cmd := &cobra.Command{
+		Use:   "runs <name|id> [flags]",
+		Short: "Show the run history of a schedule",
+		Long: `Show the run history of a schedule, newest first.
+
+Every time the schedule fires, a run is recorded: started runs reference the
+task they created, skipped and failed runs explain why no task was created.`,
+		Args: cobra.ExactArgs(1),
+		Example: `  # Show all runs of a schedule
+  construct schedule runs nightly-review
+
+  # Show the last 10 runs
+  construct schedule runs nightly-review --limit 10`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			scheduleID, err := getScheduleID(cmd.Context(), client, args[0])
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Schedule().ListScheduleRuns(cmd.Context(), &connect.Request[v1.ListScheduleRunsRequest]{
+				Msg: &v1.ListScheduleRunsRequest{
+					ScheduleId: scheduleID,
+					Limit:      options.Limit,
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to list runs of schedule %s: %w", args[0], err)
+			}
+
+			displayRuns := make([]*ScheduleRunDisplay, len(resp.Msg.Runs))
+			for i, run := range resp.Msg.Runs {
+				displayRuns[i] = ConvertScheduleRunToDisplay(run)
+			}
+
+			return getRenderer(cmd.Context()).Render(displayRuns, &options.RenderOptions)
+		},
+	}
+
+	cmd.Flags().Int32VarP(&options.Limit, "limit", "n", 0, "Maximum number of runs to show, 0 shows all")
+	addRenderOptions(cmd, &options.RenderOptions)
+
+	return cmd
+}
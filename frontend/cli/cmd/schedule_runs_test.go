@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/shared/conv"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestScheduleRuns(t *testing.T) {
+	setup := &TestSetup{
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreFields(ScheduleRunDisplay{}, "RunAt", "ScheduledAt"),
+		},
+	}
+
+	scheduleID := uuid.NewString()
+	startedID := uuid.NewString()
+	skippedID := uuid.NewString()
+	taskID := uuid.NewString()
+	runAt := timestamppb.New(time.Date(2026, 3, 14, 2, 0, 0, 0, time.UTC))
+
+	runs := []*v1.ScheduleRun{
+		{
+			Id:          skippedID,
+			ScheduleId:  scheduleID,
+			Status:      v1.ScheduleRunStatus_SCHEDULE_RUN_STATUS_SKIPPED,
+			Reason:      conv.Ptr("the task of the previous run is still active"),
+			ScheduledAt: runAt,
+			RunAt:       runAt,
+		},
+		{
+			Id:          startedID,
+			ScheduleId:  scheduleID,
+			Status:      v1.ScheduleRunStatus_SCHEDULE_RUN_STATUS_STARTED,
+			ScheduledAt: runAt,
+			RunAt:       runAt,
+			TaskId:      &taskID,
+		},
+	}
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - list runs by name",
+			Command: []string{"schedule", "runs", "nightly-review", "--limit", "2"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupScheduleNameLookup(mockClient, "nightly-review", scheduleID)
+				mockClient.Schedule.EXPECT().ListScheduleRuns(
+					gomock.Any(),
+					&connect.Request[v1.ListScheduleRunsRequest]{
+						Msg: &v1.ListScheduleRunsRequest{ScheduleId: scheduleID, Limit: 2},
+					},
+				).Return(&connect.Response[v1.ListScheduleRunsResponse]{
+					Msg: &v1.ListScheduleRunsResponse{Runs: runs},
+				}, nil)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: []*ScheduleRunDisplay{
+					{ID: skippedID, Status: "skipped", Reason: "the task of the previous run is still active"},
+					{ID: startedID, Status: "started", TaskID: taskID},
+				},
+			},
+		},
+		{
+			Name:    "error - list runs API failure",
+			Command: []string{"schedule", "runs", scheduleID},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Schedule.EXPECT().ListScheduleRuns(gomock.Any(), gomock.Any()).
+					Return(nil, connect.NewError(connect.CodeNotFound, nil))
+			},
+			Expected: TestExpectation{
+				Error: "failed to list runs of schedule " + scheduleID + ": not_found",
+			},
+		},
+	})
+}
@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type scheduleUpdateOptions struct {
+	Name       string
+	Cron       string
+	Agent      string
+	Workspace  string
+	Prompt     string
+	PromptFile string
+	MaxCost    float64
+}
+
+func NewScheduleUpdateCmd() *cobra.Command {
+	var options scheduleUpdateOptions
+
+	cmd := &cobra.Command{
+		Use:   "update <name|id> [flags]",
+		Short: "Change the configuration of a schedule",
+		Args:  cobra.ExactArgs(1),
+		Example: `  # Run the schedule every six hours
+  construct schedule update nightly-review --cron "0 */6 * * *"
+
+  # Change the prompt and remove the budget
+  construct schedule update triage --prompt-file ./prompts/triage.md --max-cost 0`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+			fs := getFileSystem(cmd.Context())
+			idOrName := args[0]
+
+			scheduleID, err := getScheduleID(cmd.Context(), client, idOrName)
+			if err != nil {
+				return fmt.Errorf("failed to resolve schedule %s: %w", idOrName, err)
+			}
+
+			req := &v1.UpdateScheduleRequest{Id: scheduleID}
+			flags := cmd.Flags()
+
+			if flags.Changed("name") {
+				req.Name = &options.Name
+			}
+
+			if flags.Changed("cron") {
+				req.CronExpression = &options.Cron
+			}
+
+			if flags.Changed("agent") {
+				agentID, err := getAgentID(cmd.Context(), client, options.Agent)
+				if err != nil {
+					return fmt.Errorf("failed to resolve agent %s: %w", options.Agent, err)
+				}
+				req.AgentId = &agentID
+			}
+
+			if flags.Changed("workspace") {
+				workspace, err := resolveWorkspace(fs, options.Workspace)
+				if err != nil {
+					return err
+				}
+				req.Workspace = &workspace
+			}
+
+			if flags.Changed("prompt") || flags.Changed("prompt-file") {
+				prompt, err := getSchedulePrompt(options.Prompt, options.PromptFile, fs)
+				if err != nil {
+					return err
+				}
+				req.Prompt = &prompt
+			}
+
+			if flags.Changed("max-cost") {
+				req.MaxCost = &options.MaxCost
+			}
+
+			_, err = client.Schedule().UpdateSchedule(cmd.Context(), &connect.Request[v1.UpdateScheduleRequest]{
+				Msg: req,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to update schedule %s: %w", idOrName, err)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&options.Name, "name", "", "The new name of the schedule")
+	cmd.Flags().StringVarP(&options.Cron, "cron", "c", "", "The new cron expression")
+	cmd.Flags().StringVarP(&options.Agent, "agent", "a", "", "The new agent that performs the scheduled runs")
+	cmd.Flags().StringVarP(&options.Workspace, "workspace", "w", "", "The new workspace directory")
+	cmd.Flags().StringVarP(&options.Prompt, "prompt", "p", "", "The new prompt template")
+	cmd.Flags().StringVar(&options.PromptFile, "prompt-file", "", "Read the new prompt template from a file")
+	cmd.Flags().Float64Var(&options.MaxCost, "max-cost", 0, "The new maximum cost of a single run in USD (0 means unlimited)")
+
+	return cmd
+}
+
+func NewScheduleEnableCmd() *cobra.Command {
+	return newScheduleToggleCmd("enable", "Resume creating runs for a schedule", true)
+}
+
+func NewScheduleDisableCmd() *cobra.Command {
+	return newScheduleToggleCmd("disable", "Stop creating runs for a schedule", false)
+}
+
+func newScheduleToggleCmd(use, short string, enabled bool) *cobra.Command {
+	return &cobra.Command{
+		Use:     use + " <name|id>...",
+		Short:   short,
+		Args:    cobra.MinimumNArgs(1),
+		Example: fmt.Sprintf("  construct schedule %s nightly-review", use),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			for _, idOrName := range args {
+				scheduleID, err := getScheduleID(cmd.Context(), client, idOrName)
+				if err != nil {
+					return fmt.Errorf("failed to resolve schedule %s: %w", idOrName, err)
+				}
+
+				_, err = client.Schedule().UpdateSchedule(cmd.Context(), &connect.Request[v1.UpdateScheduleRequest]{
+					Msg: &v1.UpdateScheduleRequest{
+						Id:      scheduleID,
+						Enabled: &enabled,
+					},
+				})
+				if err != nil {
+					return fmt.Errorf("failed to %s schedule %s: %w", use, idOrName, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
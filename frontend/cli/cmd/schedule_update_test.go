@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/shared/conv"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func TestScheduleUpdate(t *testing.T) {
+	setup := &TestSetup{}
+
+	scheduleID := uuid.NewString()
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - update cron and budget",
+			Command: []string{"schedule", "update", "nightly-review", "--cron", "0 */6 * * *", "--max-cost", "0"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupScheduleNameLookup(mockClient, "nightly-review", scheduleID)
+				setupScheduleUpdateMock(mockClient, &v1.UpdateScheduleRequest{
+					Id:             scheduleID,
+					CronExpression: conv.Ptr("0 */6 * * *"),
+					MaxCost:        conv.Ptr(0.0),
+				})
+			},
+		},
+		{
+			Name:    "success - disable schedule",
+			Command: []string{"schedule", "disable", scheduleID},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupScheduleUpdateMock(mockClient, &v1.UpdateScheduleRequest{
+					Id:      scheduleID,
+					Enabled: conv.Ptr(false),
+				})
+			},
+		},
+		{
+			Name:    "success - enable schedule",
+			Command: []string{"schedule", "enable", "nightly-review"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupScheduleNameLookup(mockClient, "nightly-review", scheduleID)
+				setupScheduleUpdateMock(mockClient, &v1.UpdateScheduleRequest{
+					Id:      scheduleID,
+					Enabled: conv.Ptr(true),
+				})
+			},
+		},
+		{
+			Name:    "error - update schedule API failure",
+			Command: []string{"schedule", "update", scheduleID, "--cron", "61 * * * *"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Schedule.EXPECT().UpdateSchedule(gomock.Any(), gomock.Any()).
+					Return(nil, connect.NewError(connect.CodeInvalidArgument, nil))
+			},
+			Expected: TestExpectation{
+				Error: "failed to update schedule " + scheduleID + ": invalid_argument",
+			},
+		},
+	})
+}
+
+func setupScheduleUpdateMock(mockClient *api_client.MockClient, req *v1.UpdateScheduleRequest) {
+	mockClient.Schedule.EXPECT().UpdateSchedule(
+		gomock.Any(),
+		&connect.Request[v1.UpdateScheduleRequest]{Msg: req},
+	).Return(&connect.Response[v1.UpdateScheduleResponse]{
+		Msg: &v1.UpdateScheduleResponse{},
+	}, nil)
+}
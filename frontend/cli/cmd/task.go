@@ -19,18 +19,26 @@ func NewTaskCmd() *cobra.Command {
 	cmd.AddCommand(NewTaskGetCmd())
 	cmd.AddCommand(NewTaskListCmd())
 	cmd.AddCommand(NewTaskDeleteCmd())
+	cmd.AddCommand(NewTaskRenderCmd())
+	cmd.AddCommand(NewTaskPreviewCmd())
+	cmd.AddCommand(NewTaskApplyCmd())
 
 	return cmd
 }
 
 type DisplayTask struct {
-	Id          string           `json:"id" yaml:"id" detail:"default"`
-	Description string           `json:"description,omitempty" yaml:"description,omitempty" detail:"default"`
-	AgentId     string           `json:"agent_id" yaml:"agent_id" detail:"default"`
-	Workspace   string           `json:"workspace" yaml:"workspace" detail:"default"`
-	CreatedAt   time.Time        `json:"created_at" yaml:"created_at"`
-	UpdatedAt   time.Time        `json:"updated_at" yaml:"updated_at"`
-	Usage       DisplayTaskUsage `json:"usage" yaml:"usage"`
+	Id               string           `json:"id" yaml:"id" detail:"default"`
+	Description      string           `json:"description,omitempty" yaml:"description,omitempty" detail:"default"`
+	AgentId          string           `json:"agent_id" yaml:"agent_id" detail:"default"`
+	ParentTaskId     string           `json:"parent_task_id,omitempty" yaml:"parent_task_id,omitempty" detail:"default"`
+	Workspace        string           `json:"workspace" yaml:"workspace" detail:"default"`
+	CreatedAt        time.Time        `json:"created_at" yaml:"created_at"`
+	UpdatedAt        time.Time        `json:"updated_at" yaml:"updated_at"`
+	Usage            DisplayTaskUsage `json:"usage" yaml:"usage"`
+	PendingMessages  int64            `json:"pending_messages" yaml:"pending_messages"`
+	InstructionFiles []string         `json:"instruction_files,omitempty" yaml:"instruction_files,omitempty" detail:"full"`
+	PersistentState  bool             `json:"persistent_state,omitempty" yaml:"persistent_state,omitempty" detail:"full"`
+	Preview          bool             `json:"preview,omitempty" yaml:"preview,omitempty" detail:"full"`
 }
 
 type DisplayTaskUsage struct {
@@ -44,18 +52,27 @@ type DisplayTaskUsage struct {
 
 func ConvertTaskToDisplay(task *v1.Task) *DisplayTask {
 	var usage DisplayTaskUsage
-	if task.Status != nil && task.Status.Usage != nil {
+	var pendingMessages int64
+	var instructionFiles []string
+	if task.Status != nil {
 		usage = ConvertTaskUsageToDisplay(task.Status.Usage)
+		pendingMessages = task.Status.PendingMessages
+		instructionFiles = task.Status.InstructionFiles
 	}
 
 	return &DisplayTask{
-		Id:          task.Metadata.Id,
-		Description: task.Spec.Description,
-		AgentId:     PtrToString(task.Spec.AgentId),
-		Workspace:   task.Spec.Workspace,
-		Usage:       usage,
-		CreatedAt:   task.Metadata.CreatedAt.AsTime(),
-		UpdatedAt:   task.Metadata.UpdatedAt.AsTime(),
+		Id:               task.Metadata.Id,
+		Description:      task.Spec.Description,
+		AgentId:          PtrToString(task.Spec.AgentId),
+		ParentTaskId:     PtrToString(task.Spec.ParentTaskId),
+		Workspace:        task.Spec.Workspace,
+		Usage:            usage,
+		PendingMessages:  pendingMessages,
+		InstructionFiles: instructionFiles,
+		PersistentState:  task.Spec.PersistentState,
+		Preview:          task.Spec.Preview,
+		CreatedAt:        task.Metadata.CreatedAt.AsTime(),
+		UpdatedAt:        task.Metadata.UpdatedAt.AsTime(),
 	}
 }
 
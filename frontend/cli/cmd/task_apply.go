@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+func NewTaskApplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply <task-id>",
+		Short: "Write the file changes a task staged in preview mode",
+		Args:  cobra.ExactArgs(1),
+		Long: `Write the file changes a task staged in preview mode.
+
+All staged files are written to the workspace together. The task stays in preview
+mode, so changes it makes afterwards are staged again. A running task has to be
+suspended or finish its turn before its changes can be applied.`,
+		Example: `  # Apply the staged changes of a task after reviewing them
+  construct task preview 01974c1d-0be8-70e1-88b4-ad9462fff25e
+  construct task apply 01974c1d-0be8-70e1-88b4-ad9462fff25e`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+			taskID := args[0]
+
+			resp, err := client.Task().ApplyPreview(cmd.Context(), &connect.Request[v1.ApplyPreviewRequest]{
+				Msg: &v1.ApplyPreviewRequest{Id: taskID},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to apply preview of task %s: %w", taskID, err)
+			}
+
+			if len(resp.Msg.Changes) == 0 {
+				cmd.Println("No changes staged")
+				return nil
+			}
+
+			for _, change := range resp.Msg.Changes {
+				if change.Created {
+					cmd.Printf("created %s\n", change.Path)
+				} else {
+					cmd.Printf("updated %s\n", change.Path)
+				}
+			}
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/shared/conv"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTaskApply(t *testing.T) {
+	setup := &TestSetup{}
+
+	taskID := uuid.New().String()
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - list written files",
+			Command: []string{"task", "apply", taskID},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupTaskApplyMock(mockClient, taskID, []*v1.PreviewChange{
+					{Path: "/workspace/README.md", Created: true},
+					{Path: "/workspace/main.go"},
+				})
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr("created /workspace/README.md\nupdated /workspace/main.go\n"),
+			},
+		},
+		{
+			Name:    "success - no staged changes",
+			Command: []string{"task", "apply", taskID},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupTaskApplyMock(mockClient, taskID, nil)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr("No changes staged\n"),
+			},
+		},
+		{
+			Name:    "error - task is running",
+			Command: []string{"task", "apply", taskID},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Task.EXPECT().ApplyPreview(
+					gomock.Any(),
+					&connect.Request[v1.ApplyPreviewRequest]{
+						Msg: &v1.ApplyPreviewRequest{Id: taskID},
+					},
+				).Return(nil, connect.NewError(connect.CodeFailedPrecondition, nil))
+			},
+			Expected: TestExpectation{
+				Error: "failed to apply preview of task " + taskID + ": failed_precondition",
+			},
+		},
+	})
+}
+
+func setupTaskApplyMock(mockClient *api_client.MockClient, taskID string, changes []*v1.PreviewChange) {
+	mockClient.Task.EXPECT().ApplyPreview(
+		gomock.Any(),
+		&connect.Request[v1.ApplyPreviewRequest]{
+			Msg: &v1.ApplyPreviewRequest{Id: taskID},
+		},
+	).Return(&connect.Response[v1.ApplyPreviewResponse]{
+		Msg: &v1.ApplyPreviewResponse{Changes: changes},
+	}, nil)
+}
@@ -12,8 +12,10 @@ import (
 )
 
 type taskCreateOptions struct {
-	Agent     string
-	Workspace string
+	Agent           string
+	Workspace       string
+	PersistentState bool
+	Preview         bool
 }
 
 func NewTaskCreateCmd() *cobra.Command {
@@ -31,7 +33,13 @@ use construct new.`,
   construct task create --agent coder
 
   # Create a task with a specific workspace
-  construct task create --agent sql-expert --workspace /path/to/db/repo`,
+  construct task create --agent sql-expert --workspace /path/to/db/repo
+
+  # Create a task that keeps JavaScript variables between tool calls
+  construct task create --agent coder --persistent-state
+
+  # Create a task that stages its file changes for review
+  construct task create --agent coder --preview`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client := getAPIClient(cmd.Context())
 			fs := getFileSystem(cmd.Context())
@@ -69,6 +77,8 @@ use construct new.`,
 				Msg: &v1.CreateTaskRequest{
 					AgentId:          agentID,
 					ProjectDirectory: options.Workspace,
+					PersistentState:  options.PersistentState,
+					Preview:          options.Preview,
 				},
 			}
 
@@ -84,6 +94,8 @@ use construct new.`,
 
 	cmd.Flags().StringVarP(&options.Agent, "agent", "a", "", "The agent to assign to the task (required)")
 	cmd.Flags().StringVarP(&options.Workspace, "workspace", "w", "", "The workspace directory for the task")
+	cmd.Flags().BoolVar(&options.PersistentState, "persistent-state", false, "Keep the JavaScript state of the agent's scripts between tool calls")
+	cmd.Flags().BoolVar(&options.Preview, "preview", false, "Stage the agent's file changes for review instead of writing them")
 
 	cmd.MarkFlagRequired("agent")
 
@@ -70,6 +70,29 @@ func TestTaskCreate(t *testing.T) {
 				Stdout: conv.Ptr(fmt.Sprintln(taskID1)),
 			},
 		},
+		{
+			Name:    "success - create task with persistent state",
+			Command: []string{"task", "create", "--agent", agentID1, "--persistent-state"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupTaskCreateMockWithPersistentState(mockClient, agentID1, "", taskID1, true)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(fmt.Sprintln(taskID1)),
+			},
+		},
+		{
+			Name:    "success - create task in preview mode",
+			Command: []string{"task", "create", "--agent", agentID1, "--preview"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupTaskCreateMockWithRequest(mockClient, &v1.CreateTaskRequest{
+					AgentId: agentID1,
+					Preview: true,
+				}, taskID1)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(fmt.Sprintln(taskID1)),
+			},
+		},
 		{
 			Name:    "error - agent not provided",
 			Command: []string{"task", "create", "-w", "/path/to/repo"},
@@ -152,13 +175,22 @@ func TestTaskCreate(t *testing.T) {
 }
 
 func setupTaskCreateMock(mockClient *api_client.MockClient, agentID, workspace, taskID string) {
+	setupTaskCreateMockWithPersistentState(mockClient, agentID, workspace, taskID, false)
+}
+
+func setupTaskCreateMockWithPersistentState(mockClient *api_client.MockClient, agentID, workspace, taskID string, persistentState bool) {
+	setupTaskCreateMockWithRequest(mockClient, &v1.CreateTaskRequest{
+		AgentId:          agentID,
+		ProjectDirectory: workspace,
+		PersistentState:  persistentState,
+	}, taskID)
+}
+
+func setupTaskCreateMockWithRequest(mockClient *api_client.MockClient, request *v1.CreateTaskRequest, taskID string) {
 	mockClient.Task.EXPECT().CreateTask(
 		gomock.Any(),
 		&connect.Request[v1.CreateTaskRequest]{
-			Msg: &v1.CreateTaskRequest{
-				AgentId:          agentID,
-				ProjectDirectory: workspace,
-			},
+			Msg: request,
 		},
 	).Return(&connect.Response[v1.CreateTaskResponse]{
 		Msg: &v1.CreateTaskResponse{
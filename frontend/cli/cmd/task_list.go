@@ -11,6 +11,7 @@ import (
 
 type taskListOptions struct {
 	Agent         string
+	Parent        string
 	Limit         int32
 	RenderOptions RenderOptions
 }
@@ -26,7 +27,10 @@ func NewTaskListCmd() *cobra.Command {
   construct task list
 
   # List tasks assigned to the 'coder' agent, in JSON format
-  construct task ls --agent "coder" --output json`,
+  construct task ls --agent "coder" --output json
+
+  # List the tasks a task delegated to other agents
+  construct task ls --parent 01974c1d`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client := getAPIClient(cmd.Context())
 
@@ -45,6 +49,19 @@ func NewTaskListCmd() *cobra.Command {
 				filter.AgentId = &agentID
 			}
 
+			if options.Parent != "" {
+				parentID := options.Parent
+				_, err := uuid.Parse(parentID)
+				if err != nil {
+					parent, err := resolveTaskID(cmd.Context(), client, parentID)
+					if err != nil {
+						return fmt.Errorf("failed to resolve parent task %s: %w", parentID, err)
+					}
+					parentID = parent.Metadata.Id
+				}
+				filter.ParentTaskId = &parentID
+			}
+
 			req := &connect.Request[v1.ListTasksRequest]{
 				Msg: &v1.ListTasksRequest{
 					Filter:   filter,
@@ -67,6 +84,7 @@ func NewTaskListCmd() *cobra.Command {
 	}
 
 	cmd.Flags().StringVarP(&options.Agent, "agent", "a", "", "Filter tasks by the agent assigned to them")
+	cmd.Flags().StringVarP(&options.Parent, "parent", "p", "", "Filter tasks by the task that delegated them")
 	cmd.Flags().Int32VarP(&options.Limit, "limit", "l", 0, "Limit the number of results returned")
 	addRenderOptions(cmd, &options.RenderOptions)
 	return cmd
@@ -119,6 +119,51 @@ func TestTaskList(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:    "success - list tasks filtered by parent task",
+			Command: []string{"task", "list", "--parent", taskID1},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				task := createTestTask(taskID2, agentID1, createdAt, updatedAt)
+				task.Spec.ParentTaskId = &taskID1
+
+				mockClient.Task.EXPECT().ListTasks(
+					gomock.Any(),
+					CmpEqual(&connect.Request[v1.ListTasksRequest]{
+						Msg: &v1.ListTasksRequest{
+							Filter: &v1.ListTasksRequest_Filter{
+								ParentTaskId: &taskID1,
+							},
+							PageSize: conv.Ptr(int32(0)),
+						},
+					}, protocmp.Transform(),
+						cmpopts.IgnoreUnexported(connect.Request[v1.ListTasksRequest]{}),
+						cmpopts.IgnoreFields(v1.ListTasksRequest{}, "state"),
+					),
+				).Return(&connect.Response[v1.ListTasksResponse]{
+					Msg: &v1.ListTasksResponse{
+						Tasks: []*v1.Task{task},
+					},
+				}, nil)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: []*DisplayTask{
+					{
+						Id:           taskID2,
+						AgentId:      agentID1,
+						ParentTaskId: taskID1,
+						CreatedAt:    createdAt,
+						UpdatedAt:    updatedAt,
+						Usage: DisplayTaskUsage{
+							InputTokens:      1000,
+							OutputTokens:     500,
+							CacheWriteTokens: 100,
+							CacheReadTokens:  50,
+							Cost:             0.05,
+						},
+					},
+				},
+			},
+		},
 		{
 			Name:    "success - list tasks with JSON output",
 			Command: []string{"task", "list", "--output", "json"},
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+func NewTaskPreviewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "preview <task-id>",
+		Short: "Show the file changes a task staged in preview mode",
+		Args:  cobra.ExactArgs(1),
+		Long: `Show the file changes a task staged in preview mode.
+
+Tasks created with --preview do not write to the workspace. Their file changes are
+staged instead and shown here as a unified diff against the current content of the
+workspace. Apply them with construct task apply.`,
+		Example: `  # Review the staged changes of a task
+  construct task preview 01974c1d-0be8-70e1-88b4-ad9462fff25e`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+			taskID := args[0]
+
+			resp, err := client.Task().GetPreview(cmd.Context(), &connect.Request[v1.GetPreviewRequest]{
+				Msg: &v1.GetPreviewRequest{Id: taskID},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to get preview of task %s: %w", taskID, err)
+			}
+
+			if len(resp.Msg.Changes) == 0 {
+				cmd.Println("No changes staged")
+				return nil
+			}
+
+			for _, change := range resp.Msg.Changes {
+				if _, err := fmt.Fprint(cmd.OutOrStdout(), change.Patch); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/shared/conv"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTaskPreview(t *testing.T) {
+	setup := &TestSetup{}
+
+	taskID := uuid.New().String()
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - print staged changes",
+			Command: []string{"task", "preview", taskID},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupTaskPreviewMock(mockClient, taskID, []*v1.PreviewChange{
+					{Path: "/workspace/main.go", Patch: "--- /workspace/main.go\n+++ /workspace/main.go\n@@ -1 +1 @@\n-func foo() {}\n+func bar() {}\n"},
+					{Path: "/workspace/README.md", Created: true, Patch: "--- /workspace/README.md\n+++ /workspace/README.md\n@@ -0,0 +1 @@\n+# Project\n"},
+				})
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr("--- /workspace/main.go\n+++ /workspace/main.go\n@@ -1 +1 @@\n-func foo() {}\n+func bar() {}\n" +
+					"--- /workspace/README.md\n+++ /workspace/README.md\n@@ -0,0 +1 @@\n+# Project\n"),
+			},
+		},
+		{
+			Name:    "success - no staged changes",
+			Command: []string{"task", "preview", taskID},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupTaskPreviewMock(mockClient, taskID, nil)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr("No changes staged\n"),
+			},
+		},
+		{
+			Name:    "error - task not in preview mode",
+			Command: []string{"task", "preview", taskID},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Task.EXPECT().GetPreview(
+					gomock.Any(),
+					&connect.Request[v1.GetPreviewRequest]{
+						Msg: &v1.GetPreviewRequest{Id: taskID},
+					},
+				).Return(nil, connect.NewError(connect.CodeFailedPrecondition, nil))
+			},
+			Expected: TestExpectation{
+				Error: "failed to get preview of task " + taskID + ": failed_precondition",
+			},
+		},
+	})
+}
+
+func setupTaskPreviewMock(mockClient *api_client.MockClient, taskID string, changes []*v1.PreviewChange) {
+	mockClient.Task.EXPECT().GetPreview(
+		gomock.Any(),
+		&connect.Request[v1.GetPreviewRequest]{
+			Msg: &v1.GetPreviewRequest{Id: taskID},
+		},
+	).Return(&connect.Response[v1.GetPreviewResponse]{
+		Msg: &v1.GetPreviewResponse{Changes: changes},
+	}, nil)
+}
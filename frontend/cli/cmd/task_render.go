@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type transcriptFormat string
+
+const (
+	transcriptFormatMarkdown transcriptFormat = "md"
+	transcriptFormatHTML     transcriptFormat = "html"
+)
+
+func (e *transcriptFormat) String() string {
+	if e == nil || *e == "" {
+		return string(transcriptFormatMarkdown)
+	}
+	return string(*e)
+}
+
+func (e *transcriptFormat) Set(v string) error {
+	switch v {
+	case "md", "html":
+		*e = transcriptFormat(v)
+		return nil
+	default:
+		return errors.New(`must be one of "md" or "html"`)
+	}
+}
+
+func (e *transcriptFormat) Type() string {
+	return "format"
+}
+
+func (e *transcriptFormat) ToAPI() v1.TranscriptFormat {
+	switch *e {
+	case transcriptFormatHTML:
+		return v1.TranscriptFormat_TRANSCRIPT_FORMAT_HTML
+	default:
+		return v1.TranscriptFormat_TRANSCRIPT_FORMAT_MARKDOWN
+	}
+}
+
+type taskRenderOptions struct {
+	Format transcriptFormat
+	File   string
+}
+
+func NewTaskRenderCmd() *cobra.Command {
+	options := taskRenderOptions{
+		Format: transcriptFormatMarkdown,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "render <task-id> [flags]",
+		Short: "Render the conversation of a task as a readable transcript",
+		Args:  cobra.ExactArgs(1),
+		Long: `Render the conversation of a task as a readable transcript.
+
+Produces a markdown or HTML document containing the messages of a task, the CodeAct
+scripts the agent ran, every tool call with its input and output, file edits as
+diffs, and the token usage and cost of each turn. Useful for attaching to pull
+requests so reviewers can follow how the agent arrived at a change.`,
+		Example: `  # Print a markdown transcript of a task
+  construct task render 01974c1d-0be8-70e1-88b4-ad9462fff25e
+
+  # Save an HTML transcript to a file
+  construct task render 01974c1d-0be8-70e1-88b4-ad9462fff25e --format html --file transcript.html`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+			taskID := args[0]
+
+			req := &connect.Request[v1.RenderTaskRequest]{
+				Msg: &v1.RenderTaskRequest{
+					Id:     taskID,
+					Format: options.Format.ToAPI(),
+				},
+			}
+
+			resp, err := client.Task().RenderTask(cmd.Context(), req)
+			if err != nil {
+				return fmt.Errorf("failed to render task %s: %w", taskID, err)
+			}
+
+			if options.File != "" {
+				err = getFileSystem(cmd.Context()).WriteFile(options.File, []byte(resp.Msg.Content), 0644)
+				if err != nil {
+					return fmt.Errorf("failed to write transcript to %s: %w", options.File, err)
+				}
+				return nil
+			}
+
+			_, err = fmt.Fprint(cmd.OutOrStdout(), resp.Msg.Content)
+			return err
+		},
+	}
+
+	cmd.Flags().VarP(&options.Format, "format", "F", "The format of the transcript (md, html)")
+	cmd.Flags().StringVarP(&options.File, "file", "f", "", "Write the transcript to a file instead of stdout")
+
+	return cmd
+}
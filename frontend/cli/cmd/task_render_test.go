@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/shared/conv"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTaskRender(t *testing.T) {
+	setup := &TestSetup{}
+
+	taskID := uuid.New().String()
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - render markdown by default",
+			Command: []string{"task", "render", taskID},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupTaskRenderMock(mockClient, taskID, v1.TranscriptFormat_TRANSCRIPT_FORMAT_MARKDOWN, "# Task\n")
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr("# Task\n"),
+			},
+		},
+		{
+			Name:    "success - render html",
+			Command: []string{"task", "render", taskID, "--format", "html"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupTaskRenderMock(mockClient, taskID, v1.TranscriptFormat_TRANSCRIPT_FORMAT_HTML, "<html></html>\n")
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr("<html></html>\n"),
+			},
+		},
+		{
+			Name:    "success - write transcript to file",
+			Command: []string{"task", "render", taskID, "--file", "/tmp/transcript.md"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupTaskRenderMock(mockClient, taskID, v1.TranscriptFormat_TRANSCRIPT_FORMAT_MARKDOWN, "# Task\n")
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(""),
+			},
+		},
+		{
+			Name:    "error - invalid format",
+			Command: []string{"task", "render", taskID, "--format", "pdf"},
+			Expected: TestExpectation{
+				Error: `invalid argument "pdf" for "-F, --format" flag: must be one of "md" or "html"`,
+			},
+		},
+		{
+			Name:    "error - render task API failure",
+			Command: []string{"task", "render", taskID},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Task.EXPECT().RenderTask(
+					gomock.Any(),
+					&connect.Request[v1.RenderTaskRequest]{
+						Msg: &v1.RenderTaskRequest{
+							Id:     taskID,
+							Format: v1.TranscriptFormat_TRANSCRIPT_FORMAT_MARKDOWN,
+						},
+					},
+				).Return(nil, connect.NewError(connect.CodeNotFound, nil))
+			},
+			Expected: TestExpectation{
+				Error: "failed to render task " + taskID + ": not_found",
+			},
+		},
+	})
+}
+
+func setupTaskRenderMock(mockClient *api_client.MockClient, taskID string, format v1.TranscriptFormat, content string) {
+	mockClient.Task.EXPECT().RenderTask(
+		gomock.Any(),
+		&connect.Request[v1.RenderTaskRequest]{
+			Msg: &v1.RenderTaskRequest{
+				Id:     taskID,
+				Format: format,
+			},
+		},
+	).Return(&connect.Response[v1.RenderTaskResponse]{
+		Msg: &v1.RenderTaskResponse{
+			Content:     content,
+			ContentType: "text/markdown; charset=utf-8",
+		},
+	}, nil)
+}
@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	api "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/google/uuid"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+func NewToolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "tool",
+		Short:   "Give agents custom tools backed by commands, HTTP endpoints or JavaScript",
+		Aliases: []string{"tools"},
+		GroupID: "resource",
+	}
+
+	cmd.AddCommand(NewToolCreateCmd())
+	cmd.AddCommand(NewToolGetCmd())
+	cmd.AddCommand(NewToolListCmd())
+	cmd.AddCommand(NewToolUpdateCmd())
+	cmd.AddCommand(NewToolDeleteCmd())
+
+	return cmd
+}
+
+type ToolKind string
+
+const (
+	ToolKindCommand    ToolKind = "command"
+	ToolKindHTTP       ToolKind = "http"
+	ToolKindJavaScript ToolKind = "javascript"
+)
+
+type ToolDisplay struct {
+	ID          string   `json:"id" yaml:"id" detail:"default"`
+	Name        string   `json:"name" yaml:"name" detail:"default"`
+	Kind        ToolKind `json:"kind" yaml:"kind" detail:"default"`
+	Target      string   `json:"target,omitempty" yaml:"target,omitempty" detail:"default"`
+	Enabled     bool     `json:"enabled" yaml:"enabled" detail:"default"`
+	Timeout     string   `json:"timeout" yaml:"timeout" detail:"full"`
+	Description string   `json:"description" yaml:"description" detail:"full"`
+	InputSchema string   `json:"input_schema,omitempty" yaml:"input_schema,omitempty" detail:"full"`
+	Source      string   `json:"source,omitempty" yaml:"source,omitempty" detail:"full"`
+	CreatedAt   string   `json:"created_at" yaml:"created_at" detail:"full"`
+}
+
+func ConvertToolToDisplay(tool *v1.Tool) *ToolDisplay {
+	if tool == nil || tool.Metadata == nil || tool.Spec == nil {
+		return nil
+	}
+
+	display := &ToolDisplay{
+		ID:          tool.Metadata.Id,
+		Name:        tool.Spec.Name,
+		Enabled:     tool.Spec.Enabled,
+		Timeout:     (time.Duration(tool.Spec.TimeoutSeconds) * time.Second).String(),
+		Description: tool.Spec.Description,
+		InputSchema: tool.Spec.InputSchema,
+		CreatedAt:   tool.Metadata.CreatedAt.AsTime().Format("2006-01-02 15:04:05"),
+	}
+
+	switch backend := tool.Spec.Backend.(type) {
+	case *v1.ToolSpec_Command:
+		display.Kind = ToolKindCommand
+		display.Target = backend.Command.Command
+	case *v1.ToolSpec_Http:
+		display.Kind = ToolKindHTTP
+		display.Target = backend.Http.Url
+	case *v1.ToolSpec_Javascript:
+		display.Kind = ToolKindJavaScript
+		display.Source = backend.Javascript.Source
+	}
+
+	return display
+}
+
+// toolBackendOptions are the flags shared by create and update that select
+// how a call of the tool is executed.
+type toolBackendOptions struct {
+	Command    string
+	URL        string
+	Headers    []string
+	ScriptFile string
+	Schema     string
+	SchemaFile string
+}
+
+func addToolBackendFlags(cmd *cobra.Command, options *toolBackendOptions) {
+	cmd.Flags().StringVarP(&options.Command, "command", "c", "", "Run a shell command that reads the arguments as JSON from stdin")
+	cmd.Flags().StringVarP(&options.URL, "url", "u", "", "Post the arguments as JSON to an HTTP endpoint")
+	cmd.Flags().StringArrayVarP(&options.Headers, "header", "H", nil, `Header sent to the HTTP endpoint as "Name: value". Can be used multiple times`)
+	cmd.Flags().StringVar(&options.ScriptFile, "script-file", "", "Evaluate a JavaScript module that assigns a function to module.exports")
+	cmd.Flags().StringVar(&options.Schema, "schema", "", "JSON schema of the argument object")
+	cmd.Flags().StringVar(&options.SchemaFile, "schema-file", "", "Read the JSON schema of the argument object from a file")
+
+	cmd.MarkFlagsMutuallyExclusive("command", "url", "script-file")
+	cmd.MarkFlagsMutuallyExclusive("schema", "schema-file")
+}
+
+type toolBackend struct {
+	Command    *v1.CommandBackend
+	HTTP       *v1.HttpBackend
+	JavaScript *v1.JavaScriptBackend
+}
+
+// backend returns the backend selected by the flags or nil if none was set.
+func (o *toolBackendOptions) backend(fs *afero.Afero) (*toolBackend, error) {
+	if len(o.Headers) > 0 && o.URL == "" {
+		return nil, fmt.Errorf("--header requires --url")
+	}
+
+	switch {
+	case o.Command != "":
+		return &toolBackend{Command: &v1.CommandBackend{Command: o.Command}}, nil
+	case o.URL != "":
+		headers := make(map[string]string, len(o.Headers))
+		for _, header := range o.Headers {
+			name, value, ok := strings.Cut(header, ":")
+			if !ok || strings.TrimSpace(name) == "" {
+				return nil, fmt.Errorf("invalid header %q: must be of the form \"Name: value\"", header)
+			}
+			headers[strings.TrimSpace(name)] = strings.TrimSpace(value)
+		}
+		return &toolBackend{HTTP: &v1.HttpBackend{Url: o.URL, Headers: headers}}, nil
+	case o.ScriptFile != "":
+		source, err := fs.ReadFile(o.ScriptFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read script file %s: %w", o.ScriptFile, err)
+		}
+		return &toolBackend{JavaScript: &v1.JavaScriptBackend{Source: string(source)}}, nil
+	}
+
+	return nil, nil
+}
+
+// schema returns the input schema selected by the flags and whether one was set.
+func (o *toolBackendOptions) schema(fs *afero.Afero) (string, bool, error) {
+	if o.SchemaFile != "" {
+		content, err := fs.ReadFile(o.SchemaFile)
+		if err != nil {
+			return "", false, fmt.Errorf("failed to read schema file %s: %w", o.SchemaFile, err)
+		}
+		return string(content), true, nil
+	}
+
+	return o.Schema, o.Schema != "", nil
+}
+
+func getToolID(ctx context.Context, client *api.Client, idOrName string) (string, error) {
+	_, err := uuid.Parse(idOrName)
+	if err == nil {
+		return idOrName, nil
+	}
+
+	resp, err := client.Tool().ListTools(ctx, &connect.Request[v1.ListToolsRequest]{
+		Msg: &v1.ListToolsRequest{
+			Filter: &v1.ListToolsRequest_Filter{
+				Name: &idOrName,
+			},
+		},
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("failed to list tools: %w", err)
+	}
+
+	if len(resp.Msg.Tools) == 0 {
+		return "", fmt.Errorf("tool %s not found", idOrName)
+	}
+
+	return resp.Msg.Tools[0].Metadata.Id, nil
+}
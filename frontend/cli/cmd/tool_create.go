@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type toolCreateOptions struct {
+	Description string
+	Timeout     time.Duration
+	Backend     toolBackendOptions
+}
+
+func NewToolCreateCmd() *cobra.Command {
+	var options toolCreateOptions
+
+	cmd := &cobra.Command{
+		Use:   "create <name> [flags]",
+		Short: "Make a command, HTTP endpoint or JavaScript module callable by agents",
+		Args:  cobra.ExactArgs(1),
+		Long: `Make a command, HTTP endpoint or JavaScript module callable by agents.
+
+Enabled tools are available to every agent as a JavaScript function with the
+name of the tool. The function takes a single object argument described by
+the JSON schema and returns the output of the tool. Output that is valid JSON
+is returned as an object, any other output as a string.
+
+Backends:
+  --command      Runs in the project directory of the task. The arguments are
+                 passed as JSON on stdin and stdout is the output. A non-zero
+                 exit code fails the call.
+  --url          The arguments are posted as JSON and the response body is the
+                 output. A status code other than 2xx fails the call.
+  --script-file  The module assigns a function to module.exports that is
+                 called with the arguments. It runs in an isolated interpreter
+                 without access to the builtin tools.`,
+		Example: `  # Expose an internal CLI
+  construct tool create deploy_preview \
+    --description "Deploys a preview environment for a branch and returns its URL" \
+    --command "deploy-preview --json" \
+    --schema '{"type":"object","properties":{"branch":{"type":"string"}},"required":["branch"]}'
+
+  # Look up tickets through an HTTP endpoint
+  construct tool create lookup_ticket --description "Returns the details of a ticket" \
+    --url https://tickets.example.com/api/lookup -H "Authorization: Bearer $TICKET_TOKEN" \
+    --schema-file ticket.schema.json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+			fs := getFileSystem(cmd.Context())
+
+			backend, err := options.Backend.backend(fs)
+			if err != nil {
+				return err
+			}
+			if backend == nil {
+				return fmt.Errorf("a backend is required (use --command, --url, or --script-file)")
+			}
+
+			schema, _, err := options.Backend.schema(fs)
+			if err != nil {
+				return err
+			}
+
+			req := &v1.CreateToolRequest{
+				Name:           args[0],
+				Description:    options.Description,
+				InputSchema:    schema,
+				TimeoutSeconds: int32(options.Timeout / time.Second),
+			}
+			switch {
+			case backend.Command != nil:
+				req.Backend = &v1.CreateToolRequest_Command{Command: backend.Command}
+			case backend.HTTP != nil:
+				req.Backend = &v1.CreateToolRequest_Http{Http: backend.HTTP}
+			case backend.JavaScript != nil:
+				req.Backend = &v1.CreateToolRequest_Javascript{Javascript: backend.JavaScript}
+			}
+
+			resp, err := client.Tool().CreateTool(cmd.Context(), &connect.Request[v1.CreateToolRequest]{
+				Msg: req,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create tool: %w", err)
+			}
+
+			cmd.Println(resp.Msg.Tool.Metadata.Id)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.Description, "description", "d", "", "Explains to the agent what the tool does and when to use it (required)")
+	cmd.Flags().DurationVar(&options.Timeout, "timeout", 0, "Maximum duration of a single call (default 30s)")
+	addToolBackendFlags(cmd, &options.Backend)
+
+	cmd.MarkFlagRequired("description")
+
+	return cmd
+}
@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/shared/conv"
+	"github.com/google/uuid"
+	"github.com/spf13/afero"
+	"go.uber.org/mock/gomock"
+)
+
+func TestToolCreate(t *testing.T) {
+	setup := &TestSetup{}
+
+	toolID := uuid.NewString()
+	schema := `{"type":"object","properties":{"branch":{"type":"string"}},"required":["branch"]}`
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name: "success - create command tool",
+			Command: []string{"tool", "create", "deploy_preview",
+				"--description", "Deploys a preview environment",
+				"--command", "deploy-preview --json", "--schema", schema, "--timeout", "2m"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupToolCreateMock(mockClient, &v1.CreateToolRequest{
+					Name:           "deploy_preview",
+					Description:    "Deploys a preview environment",
+					InputSchema:    schema,
+					Backend:        &v1.CreateToolRequest_Command{Command: &v1.CommandBackend{Command: "deploy-preview --json"}},
+					TimeoutSeconds: 120,
+				}, toolID)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(fmt.Sprintln(toolID)),
+			},
+		},
+		{
+			Name: "success - create http tool with headers and schema file",
+			Command: []string{"tool", "create", "lookup_ticket", "-d", "Returns the details of a ticket",
+				"--url", "https://tickets.example.com/api/lookup",
+				"-H", "Authorization: Bearer t0ken", "-H", "X-Team:core",
+				"--schema-file", "ticket.schema.json"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupToolCreateMock(mockClient, &v1.CreateToolRequest{
+					Name:        "lookup_ticket",
+					Description: "Returns the details of a ticket",
+					InputSchema: schema,
+					Backend: &v1.CreateToolRequest_Http{Http: &v1.HttpBackend{
+						Url: "https://tickets.example.com/api/lookup",
+						Headers: map[string]string{
+							"Authorization": "Bearer t0ken",
+							"X-Team":        "core",
+						},
+					}},
+				}, toolID)
+			},
+			SetupFileSystem: func(fs *afero.Afero) {
+				fs.WriteFile("ticket.schema.json", []byte(schema), 0644)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(fmt.Sprintln(toolID)),
+			},
+		},
+		{
+			Name:    "success - create javascript tool from script file",
+			Command: []string{"tool", "create", "slugify", "-d", "Turns a title into a slug", "--script-file", "slugify.js"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupToolCreateMock(mockClient, &v1.CreateToolRequest{
+					Name:        "slugify",
+					Description: "Turns a title into a slug",
+					Backend: &v1.CreateToolRequest_Javascript{Javascript: &v1.JavaScriptBackend{
+						Source: "module.exports = (args) => args.title.toLowerCase();",
+					}},
+				}, toolID)
+			},
+			SetupFileSystem: func(fs *afero.Afero) {
+				fs.WriteFile("slugify.js", []byte("module.exports = (args) => args.title.toLowerCase();"), 0644)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(fmt.Sprintln(toolID)),
+			},
+		},
+		{
+			Name:    "error - no backend",
+			Command: []string{"tool", "create", "deploy_preview", "-d", "Deploys a preview environment"},
+			Expected: TestExpectation{
+				Error: "a backend is required (use --command, --url, or --script-file)",
+			},
+		},
+		{
+			Name: "error - header without url",
+			Command: []string{"tool", "create", "deploy_preview", "-d", "Deploys a preview environment",
+				"--command", "deploy-preview", "-H", "Authorization: Bearer t0ken"},
+			Expected: TestExpectation{
+				Error: "--header requires --url",
+			},
+		},
+		{
+			Name: "error - malformed header",
+			Command: []string{"tool", "create", "lookup_ticket", "-d", "Returns the details of a ticket",
+				"--url", "https://tickets.example.com/api/lookup", "-H", "Authorization"},
+			Expected: TestExpectation{
+				Error: `invalid header "Authorization": must be of the form "Name: value"`,
+			},
+		},
+		{
+			Name: "error - create tool API failure",
+			Command: []string{"tool", "create", "deploy_preview", "-d", "Deploys a preview environment",
+				"--command", "deploy-preview"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Tool.EXPECT().CreateTool(gomock.Any(), gomock.Any()).
+					Return(nil, connect.NewError(connect.CodeAlreadyExists, nil))
+			},
+			Expected: TestExpectation{
+				Error: "failed to create tool: already_exists",
+			},
+		},
+	})
+}
+
+func setupToolCreateMock(mockClient *api_client.MockClient, req *v1.CreateToolRequest, toolID string) {
+	mockClient.Tool.EXPECT().CreateTool(
+		gomock.Any(),
+		&connect.Request[v1.CreateToolRequest]{Msg: req},
+	).Return(&connect.Response[v1.CreateToolResponse]{
+		Msg: &v1.CreateToolResponse{
+			Tool: &v1.Tool{
+				Metadata: &v1.ToolMetadata{Id: toolID},
+				Spec: &v1.ToolSpec{
+					Name:        req.Name,
+					Description: req.Description,
+					InputSchema: req.InputSchema,
+					Enabled:     true,
+				},
+			},
+		},
+	}, nil)
+}
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type toolDeleteOptions struct {
+	Force bool
+}
+
+func NewToolDeleteCmd() *cobra.Command {
+	options := new(toolDeleteOptions)
+	cmd := &cobra.Command{
+		Use:     "delete <name|id>... [flags]",
+		Short:   "Permanently delete one or more tools",
+		Aliases: []string{"rm"},
+		Example: `  # Delete a single tool
+  construct tool delete deploy_preview
+
+  # Force delete without a confirmation prompt
+  construct tool rm deploy_preview lookup_ticket --force`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			toolIDs := make(map[string]string)
+			for _, idOrName := range args {
+				toolID, err := getToolID(cmd.Context(), client, idOrName)
+				if err != nil {
+					return err
+				}
+
+				toolIDs[idOrName] = toolID
+			}
+
+			if !options.Force && !confirmDeletion(cmd.InOrStdin(), cmd.OutOrStdout(), "tool", args) {
+				return nil
+			}
+
+			for idOrName, toolID := range toolIDs {
+				_, err := client.Tool().DeleteTool(cmd.Context(), &connect.Request[v1.DeleteToolRequest]{
+					Msg: &v1.DeleteToolRequest{Id: toolID},
+				})
+				if err != nil {
+					return fmt.Errorf("failed to delete tool %s: %w", idOrName, err)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&options.Force, "force", "f", false, "Skip the confirmation prompt")
+
+	return cmd
+}
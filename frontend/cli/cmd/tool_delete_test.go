@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/shared/conv"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func TestToolDelete(t *testing.T) {
+	setup := &TestSetup{}
+
+	toolID := uuid.NewString()
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - delete by name with force flag",
+			Command: []string{"tool", "delete", "--force", "deploy_preview"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupToolNameLookup(mockClient, "deploy_preview", toolID)
+				setupToolDeletionMock(mockClient, toolID)
+			},
+		},
+		{
+			Name:    "success - delete by ID with user confirmation",
+			Command: []string{"tool", "rm", toolID},
+			Stdin:   "y\n",
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupToolDeletionMock(mockClient, toolID)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr("Are you sure you want to delete tool " + toolID + "? (y/n): "),
+			},
+		},
+		{
+			Name:    "error - delete tool API failure",
+			Command: []string{"tool", "delete", "--force", toolID},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Tool.EXPECT().DeleteTool(gomock.Any(), gomock.Any()).
+					Return(nil, connect.NewError(connect.CodeNotFound, nil))
+			},
+			Expected: TestExpectation{
+				Error: "failed to delete tool " + toolID + ": not_found",
+			},
+		},
+	})
+}
+
+func setupToolDeletionMock(mockClient *api_client.MockClient, toolID string) {
+	mockClient.Tool.EXPECT().DeleteTool(
+		gomock.Any(),
+		&connect.Request[v1.DeleteToolRequest]{
+			Msg: &v1.DeleteToolRequest{Id: toolID},
+		},
+	).Return(&connect.Response[v1.DeleteToolResponse]{
+		Msg: &v1.DeleteToolResponse{},
+	}, nil)
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type toolGetOptions struct {
+	RenderOptions RenderOptions
+}
+
+func NewToolGetCmd() *cobra.Command {
+	var options toolGetOptions
+
+	cmd := &cobra.Command{
+		Use:   "get <name|id> [flags]",
+		Short: "Inspect a tool and its input schema",
+		Args:  cobra.ExactArgs(1),
+		Example: `  # Get details for the 'deploy_preview' tool
+  construct tool get deploy_preview
+
+  # Get details and format as JSON
+  construct tool get deploy_preview --output json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+			idOrName := args[0]
+
+			toolID, err := getToolID(cmd.Context(), client, idOrName)
+			if err != nil {
+				return fmt.Errorf("failed to resolve tool %s: %w", idOrName, err)
+			}
+
+			resp, err := client.Tool().GetTool(cmd.Context(), &connect.Request[v1.GetToolRequest]{
+				Msg: &v1.GetToolRequest{Id: toolID},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to get tool %s: %w", idOrName, err)
+			}
+
+			return getRenderer(cmd.Context()).Render(ConvertToolToDisplay(resp.Msg.Tool), &options.RenderOptions)
+		},
+	}
+
+	addRenderOptions(cmd, WithCardFormat(&options.RenderOptions))
+	return cmd
+}
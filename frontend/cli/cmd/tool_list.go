@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type toolListOptions struct {
+	RenderOptions RenderOptions
+}
+
+func NewToolListCmd() *cobra.Command {
+	var options toolListOptions
+
+	cmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   "List all custom tools",
+		Aliases: []string{"ls"},
+		Example: `  # List all custom tools
+  construct tool list
+
+  # Show tools as JSON
+  construct tool ls --output json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			resp, err := client.Tool().ListTools(cmd.Context(), &connect.Request[v1.ListToolsRequest]{
+				Msg: &v1.ListToolsRequest{
+					Filter: &v1.ListToolsRequest_Filter{},
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to list tools: %w", err)
+			}
+
+			displayTools := make([]*ToolDisplay, len(resp.Msg.Tools))
+			for i, tool := range resp.Msg.Tools {
+				displayTools[i] = ConvertToolToDisplay(tool)
+			}
+
+			return getRenderer(cmd.Context()).Render(displayTools, &options.RenderOptions)
+		},
+	}
+
+	addRenderOptions(cmd, &options.RenderOptions)
+
+	return cmd
+}
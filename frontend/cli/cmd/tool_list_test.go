@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestToolList(t *testing.T) {
+	setup := &TestSetup{
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreFields(ToolDisplay{}, "CreatedAt"),
+		},
+	}
+
+	commandToolID := uuid.NewString()
+	scriptToolID := uuid.NewString()
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - list tools",
+			Command: []string{"tool", "list"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Tool.EXPECT().ListTools(
+					gomock.Any(),
+					&connect.Request[v1.ListToolsRequest]{
+						Msg: &v1.ListToolsRequest{
+							Filter: &v1.ListToolsRequest_Filter{},
+						},
+					},
+				).Return(&connect.Response[v1.ListToolsResponse]{
+					Msg: &v1.ListToolsResponse{
+						Tools: []*v1.Tool{
+							{
+								Metadata: &v1.ToolMetadata{Id: commandToolID, CreatedAt: timestamppb.Now()},
+								Spec: &v1.ToolSpec{
+									Name:           "deploy_preview",
+									Description:    "Deploys a preview environment",
+									Backend:        &v1.ToolSpec_Command{Command: &v1.CommandBackend{Command: "deploy-preview --json"}},
+									TimeoutSeconds: 120,
+									Enabled:        true,
+								},
+							},
+							{
+								Metadata: &v1.ToolMetadata{Id: scriptToolID, CreatedAt: timestamppb.Now()},
+								Spec: &v1.ToolSpec{
+									Name:           "slugify",
+									Description:    "Turns a title into a slug",
+									InputSchema:    `{"type":"object"}`,
+									Backend:        &v1.ToolSpec_Javascript{Javascript: &v1.JavaScriptBackend{Source: "module.exports = () => 1;"}},
+									TimeoutSeconds: 30,
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: []*ToolDisplay{
+					{
+						ID:          commandToolID,
+						Name:        "deploy_preview",
+						Kind:        ToolKindCommand,
+						Target:      "deploy-preview --json",
+						Enabled:     true,
+						Timeout:     "2m0s",
+						Description: "Deploys a preview environment",
+					},
+					{
+						ID:          scriptToolID,
+						Name:        "slugify",
+						Kind:        ToolKindJavaScript,
+						Timeout:     "30s",
+						Description: "Turns a title into a slug",
+						InputSchema: `{"type":"object"}`,
+						Source:      "module.exports = () => 1;",
+					},
+				},
+			},
+		},
+		{
+			Name:    "error - list tools API failure",
+			Command: []string{"tool", "list"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Tool.EXPECT().ListTools(gomock.Any(), gomock.Any()).
+					Return(nil, connect.NewError(connect.CodeInternal, nil))
+			},
+			Expected: TestExpectation{
+				Error: "failed to list tools: internal",
+			},
+		},
+	})
+}
+
+func setupToolNameLookup(mockClient *api_client.MockClient, name, toolID string) {
+	mockClient.Tool.EXPECT().ListTools(
+		gomock.Any(),
+		&connect.Request[v1.ListToolsRequest]{
+			Msg: &v1.ListToolsRequest{
+				Filter: &v1.ListToolsRequest_Filter{Name: &name},
+			},
+		},
+	).Return(&connect.Response[v1.ListToolsResponse]{
+		Msg: &v1.ListToolsResponse{
+			Tools: []*v1.Tool{
+				{
+					Metadata: &v1.ToolMetadata{Id: toolID},
+					Spec:     &v1.ToolSpec{Name: name},
+				},
+			},
+		},
+	}, nil)
+}
@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type toolUpdateOptions struct {
+	Description string
+	Timeout     time.Duration
+	Enabled     bool
+	Backend     toolBackendOptions
+}
+
+func NewToolUpdateCmd() *cobra.Command {
+	var options toolUpdateOptions
+
+	cmd := &cobra.Command{
+		Use:   "update <name|id> [flags]",
+		Short: "Update an existing tool",
+		Args:  cobra.ExactArgs(1),
+		Long: `Update an existing tool.
+
+Only the flags that are provided are changed. Passing --command, --url or
+--script-file replaces the backend of the tool, including the headers of an
+HTTP backend.`,
+		Example: `  # Rotate the token of an HTTP tool
+  construct tool update lookup_ticket \
+    --url https://tickets.example.com/api/lookup -H "Authorization: Bearer $NEW_TOKEN"
+
+  # Hide a tool from agents without deleting it
+  construct tool update deploy_preview --enabled=false`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+			fs := getFileSystem(cmd.Context())
+
+			toolID, err := getToolID(cmd.Context(), client, args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &v1.UpdateToolRequest{
+				Id: toolID,
+			}
+
+			if cmd.Flags().Changed("description") {
+				req.Description = &options.Description
+			}
+
+			schema, changed, err := options.Backend.schema(fs)
+			if err != nil {
+				return err
+			}
+			if changed || cmd.Flags().Changed("schema") {
+				req.InputSchema = &schema
+			}
+
+			backend, err := options.Backend.backend(fs)
+			if err != nil {
+				return err
+			}
+			if backend != nil {
+				switch {
+				case backend.Command != nil:
+					req.Backend = &v1.UpdateToolRequest_Command{Command: backend.Command}
+				case backend.HTTP != nil:
+					req.Backend = &v1.UpdateToolRequest_Http{Http: backend.HTTP}
+				case backend.JavaScript != nil:
+					req.Backend = &v1.UpdateToolRequest_Javascript{Javascript: backend.JavaScript}
+				}
+			}
+
+			if cmd.Flags().Changed("timeout") {
+				timeout := int32(options.Timeout / time.Second)
+				req.TimeoutSeconds = &timeout
+			}
+			if cmd.Flags().Changed("enabled") {
+				req.Enabled = &options.Enabled
+			}
+
+			_, err = client.Tool().UpdateTool(cmd.Context(), &connect.Request[v1.UpdateToolRequest]{
+				Msg: req,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to update tool %s: %w", args[0], err)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.Description, "description", "d", "", "The new description of the tool")
+	cmd.Flags().DurationVar(&options.Timeout, "timeout", 0, "The new maximum duration of a single call")
+	cmd.Flags().BoolVar(&options.Enabled, "enabled", true, "Expose the tool to agents or hide it")
+	addToolBackendFlags(cmd, &options.Backend)
+
+	return cmd
+}
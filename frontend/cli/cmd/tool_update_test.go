@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/shared/conv"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func TestToolUpdate(t *testing.T) {
+	setup := &TestSetup{}
+
+	toolID := uuid.NewString()
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name: "success - replace http backend",
+			Command: []string{"tool", "update", "lookup_ticket",
+				"--url", "https://tickets.example.com/api/lookup", "-H", "Authorization: Bearer n3w"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupToolNameLookup(mockClient, "lookup_ticket", toolID)
+				setupToolUpdateMock(mockClient, &v1.UpdateToolRequest{
+					Id: toolID,
+					Backend: &v1.UpdateToolRequest_Http{Http: &v1.HttpBackend{
+						Url:     "https://tickets.example.com/api/lookup",
+						Headers: map[string]string{"Authorization": "Bearer n3w"},
+					}},
+				})
+			},
+		},
+		{
+			Name:    "success - clear schema, change timeout and disable tool",
+			Command: []string{"tool", "update", toolID, "--schema", "", "--timeout", "45s", "--enabled=false"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupToolUpdateMock(mockClient, &v1.UpdateToolRequest{
+					Id:             toolID,
+					InputSchema:    conv.Ptr(""),
+					TimeoutSeconds: conv.Ptr(int32(45)),
+					Enabled:        conv.Ptr(false),
+				})
+			},
+		},
+		{
+			Name:    "error - update tool API failure",
+			Command: []string{"tool", "update", toolID, "--description", "Deploys a preview"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Tool.EXPECT().UpdateTool(gomock.Any(), gomock.Any()).
+					Return(nil, connect.NewError(connect.CodePermissionDenied, nil))
+			},
+			Expected: TestExpectation{
+				Error: "failed to update tool " + toolID + ": permission_denied",
+			},
+		},
+	})
+}
+
+func setupToolUpdateMock(mockClient *api_client.MockClient, req *v1.UpdateToolRequest) {
+	mockClient.Tool.EXPECT().UpdateTool(
+		gomock.Any(),
+		&connect.Request[v1.UpdateToolRequest]{Msg: req},
+	).Return(&connect.Response[v1.UpdateToolResponse]{
+		Msg: &v1.UpdateToolResponse{
+			Tool: &v1.Tool{
+				Metadata: &v1.ToolMetadata{Id: req.Id},
+				Spec:     &v1.ToolSpec{},
+			},
+		},
+	}, nil)
+}
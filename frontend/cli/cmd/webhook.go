@@ -0,0 +1,200 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	api "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+func NewWebhookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "webhook",
+		Short:   "Notify external services about task events",
+		Aliases: []string{"webhooks"},
+		GroupID: "resource",
+	}
+
+	cmd.AddCommand(NewWebhookCreateCmd())
+	cmd.AddCommand(NewWebhookListCmd())
+	cmd.AddCommand(NewWebhookUpdateCmd())
+	cmd.AddCommand(NewWebhookDeleteCmd())
+	cmd.AddCommand(NewWebhookDeadLettersCmd())
+
+	return cmd
+}
+
+type WebhookEvent string
+
+const (
+	WebhookEventPhase    WebhookEvent = "phase"
+	WebhookEventResponse WebhookEvent = "response"
+	WebhookEventError    WebhookEvent = "error"
+	WebhookEventCost     WebhookEvent = "cost"
+)
+
+type WebhookEvents []WebhookEvent
+
+func (e *WebhookEvents) String() string {
+	var s []string
+	for _, v := range *e {
+		s = append(s, string(v))
+	}
+	return strings.Join(s, ",")
+}
+
+func (e *WebhookEvents) Set(v string) error {
+	for _, v := range strings.Split(v, ",") {
+		event, err := ToWebhookEvent(v)
+		if err != nil {
+			return err
+		}
+		*e = append(*e, event)
+	}
+	return nil
+}
+
+func (e *WebhookEvents) Type() string {
+	return "events"
+}
+
+func (e *WebhookEvents) ToAPI() []v1.WebhookEvent {
+	events := make([]v1.WebhookEvent, 0, len(*e))
+	for _, event := range *e {
+		events = append(events, event.ToAPI())
+	}
+	return events
+}
+
+func ToWebhookEvent(v string) (WebhookEvent, error) {
+	switch WebhookEvent(strings.ToLower(strings.TrimSpace(v))) {
+	case WebhookEventPhase:
+		return WebhookEventPhase, nil
+	case WebhookEventResponse:
+		return WebhookEventResponse, nil
+	case WebhookEventError:
+		return WebhookEventError, nil
+	case WebhookEventCost:
+		return WebhookEventCost, nil
+	default:
+		return "", errors.New(`must be one of "phase","response","error","cost"`)
+	}
+}
+
+func (e WebhookEvent) ToAPI() v1.WebhookEvent {
+	switch e {
+	case WebhookEventPhase:
+		return v1.WebhookEvent_WEBHOOK_EVENT_TASK_PHASE_CHANGED
+	case WebhookEventResponse:
+		return v1.WebhookEvent_WEBHOOK_EVENT_TASK_RESPONSE
+	case WebhookEventError:
+		return v1.WebhookEvent_WEBHOOK_EVENT_TASK_ERROR
+	case WebhookEventCost:
+		return v1.WebhookEvent_WEBHOOK_EVENT_TASK_COST_THRESHOLD
+	default:
+		return v1.WebhookEvent_WEBHOOK_EVENT_UNSPECIFIED
+	}
+}
+
+func ConvertWebhookEventFromAPI(event v1.WebhookEvent) WebhookEvent {
+	switch event {
+	case v1.WebhookEvent_WEBHOOK_EVENT_TASK_PHASE_CHANGED:
+		return WebhookEventPhase
+	case v1.WebhookEvent_WEBHOOK_EVENT_TASK_RESPONSE:
+		return WebhookEventResponse
+	case v1.WebhookEvent_WEBHOOK_EVENT_TASK_ERROR:
+		return WebhookEventError
+	case v1.WebhookEvent_WEBHOOK_EVENT_TASK_COST_THRESHOLD:
+		return WebhookEventCost
+	default:
+		return "unknown"
+	}
+}
+
+type WebhookDisplay struct {
+	ID            string         `json:"id" yaml:"id" detail:"default"`
+	Name          string         `json:"name" yaml:"name" detail:"default"`
+	URL           string         `json:"url" yaml:"url" detail:"default"`
+	Events        []WebhookEvent `json:"events" yaml:"events" detail:"default"`
+	Enabled       bool           `json:"enabled" yaml:"enabled" detail:"default"`
+	CostThreshold float64        `json:"cost_threshold,omitempty" yaml:"cost_threshold,omitempty"`
+	CreatedAt     string         `json:"created_at" yaml:"created_at" detail:"full"`
+}
+
+func ConvertWebhookToDisplay(webhook *v1.Webhook) *WebhookDisplay {
+	if webhook == nil || webhook.Metadata == nil || webhook.Spec == nil {
+		return nil
+	}
+
+	events := make([]WebhookEvent, 0, len(webhook.Spec.Events))
+	for _, event := range webhook.Spec.Events {
+		events = append(events, ConvertWebhookEventFromAPI(event))
+	}
+
+	return &WebhookDisplay{
+		ID:            webhook.Metadata.Id,
+		Name:          webhook.Spec.Name,
+		URL:           webhook.Spec.Url,
+		Events:        events,
+		Enabled:       webhook.Spec.Enabled,
+		CostThreshold: webhook.Spec.CostThreshold,
+		CreatedAt:     webhook.Metadata.CreatedAt.AsTime().Format("2006-01-02 15:04:05"),
+	}
+}
+
+type WebhookDeadLetterDisplay struct {
+	ID        string       `json:"id" yaml:"id" detail:"default"`
+	TaskID    string       `json:"task_id" yaml:"task_id" detail:"default"`
+	Event     WebhookEvent `json:"event" yaml:"event" detail:"default"`
+	Attempts  int32        `json:"attempts" yaml:"attempts" detail:"default"`
+	LastError string       `json:"last_error" yaml:"last_error" detail:"default"`
+	CreatedAt string       `json:"created_at" yaml:"created_at" detail:"default"`
+	Payload   string       `json:"payload" yaml:"payload" detail:"full"`
+}
+
+func ConvertWebhookDeadLetterToDisplay(deadLetter *v1.WebhookDeadLetter) *WebhookDeadLetterDisplay {
+	if deadLetter == nil {
+		return nil
+	}
+
+	return &WebhookDeadLetterDisplay{
+		ID:        deadLetter.Id,
+		TaskID:    deadLetter.TaskId,
+		Event:     ConvertWebhookEventFromAPI(deadLetter.Event),
+		Attempts:  deadLetter.Attempts,
+		LastError: deadLetter.LastError,
+		CreatedAt: deadLetter.CreatedAt.AsTime().Local().Format("2006-01-02 15:04:05"),
+		Payload:   deadLetter.Payload,
+	}
+}
+
+func getWebhookID(ctx context.Context, client *api.Client, idOrName string) (string, error) {
+	_, err := uuid.Parse(idOrName)
+	if err == nil {
+		return idOrName, nil
+	}
+
+	resp, err := client.Webhook().ListWebhooks(ctx, &connect.Request[v1.ListWebhooksRequest]{
+		Msg: &v1.ListWebhooksRequest{
+			Filter: &v1.ListWebhooksRequest_Filter{
+				Name: &idOrName,
+			},
+		},
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("failed to list webhooks: %w", err)
+	}
+
+	if len(resp.Msg.Webhooks) == 0 {
+		return "", fmt.Errorf("webhook %s not found", idOrName)
+	}
+
+	return resp.Msg.Webhooks[0].Metadata.Id, nil
+}
@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type webhookCreateOptions struct {
+	URL           string
+	Secret        string
+	Events        WebhookEvents
+	CostThreshold float64
+}
+
+func NewWebhookCreateCmd() *cobra.Command {
+	var options webhookCreateOptions
+
+	cmd := &cobra.Command{
+		Use:   "create <name> [flags]",
+		Short: "Send task events to an HTTP endpoint",
+		Args:  cobra.ExactArgs(1),
+		Long: `Send task events to an HTTP endpoint.
+
+Every matching event is posted as JSON to the URL. The body is signed with
+HMAC-SHA256 using the secret and the signature is sent in the
+X-Construct-Signature header as "sha256=<hex>". Failed deliveries are retried
+with exponential backoff and recorded as dead letters once all attempts are
+exhausted.
+
+Events:
+  phase      The phase of a task changed, e.g. from running to awaiting input
+  response   The agent finished a turn with a final response
+  error      A task failed with an error
+  cost       The cost of a task crossed the --cost-threshold`,
+		Example: `  # Notify Slack when an agent finishes or fails
+  construct webhook create slack \
+    --url https://hooks.slack.com/services/T000/B000/XXXX \
+    --secret "$WEBHOOK_SECRET" \
+    --event response --event error
+
+  # Alert CI when a task costs more than $5
+  construct webhook create budget --url https://ci.example.com/hooks/construct \
+    --secret "$WEBHOOK_SECRET" --event cost --cost-threshold 5`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			if options.CostThreshold > 0 && !containsWebhookEvent(options.Events, WebhookEventCost) {
+				return fmt.Errorf("--cost-threshold requires the cost event")
+			}
+
+			resp, err := client.Webhook().CreateWebhook(cmd.Context(), &connect.Request[v1.CreateWebhookRequest]{
+				Msg: &v1.CreateWebhookRequest{
+					Name:          args[0],
+					Url:           options.URL,
+					Secret:        options.Secret,
+					Events:        options.Events.ToAPI(),
+					CostThreshold: options.CostThreshold,
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create webhook: %w", err)
+			}
+
+			cmd.Println(resp.Msg.Webhook.Metadata.Id)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.URL, "url", "u", "", "The endpoint the events are posted to (required)")
+	cmd.Flags().StringVarP(&options.Secret, "secret", "s", "", "The secret used to sign the deliveries (required)")
+	cmd.Flags().VarP(&options.Events, "event", "e", "The events to deliver (phase, response, error, cost). Can be used multiple times (required)")
+	cmd.Flags().Float64Var(&options.CostThreshold, "cost-threshold", 0, "The task cost in USD that triggers the cost event")
+
+	cmd.MarkFlagRequired("url")
+	cmd.MarkFlagRequired("secret")
+	cmd.MarkFlagRequired("event")
+
+	return cmd
+}
+
+func containsWebhookEvent(events WebhookEvents, event WebhookEvent) bool {
+	for _, e := range events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
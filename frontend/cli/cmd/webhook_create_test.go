@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/shared/conv"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func TestWebhookCreate(t *testing.T) {
+	setup := &TestSetup{}
+
+	webhookID := uuid.NewString()
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name: "success - create webhook with repeated event flags",
+			Command: []string{"webhook", "create", "slack",
+				"--url", "https://hooks.slack.com/services/T000", "--secret", "s3cret",
+				"--event", "response", "--event", "error"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupWebhookCreateMock(mockClient, &v1.CreateWebhookRequest{
+					Name:   "slack",
+					Url:    "https://hooks.slack.com/services/T000",
+					Secret: "s3cret",
+					Events: []v1.WebhookEvent{
+						v1.WebhookEvent_WEBHOOK_EVENT_TASK_RESPONSE,
+						v1.WebhookEvent_WEBHOOK_EVENT_TASK_ERROR,
+					},
+				}, webhookID)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(fmt.Sprintln(webhookID)),
+			},
+		},
+		{
+			Name: "success - create webhook with cost threshold",
+			Command: []string{"webhook", "create", "budget",
+				"-u", "https://ci.example.com/hooks", "-s", "s3cret",
+				"-e", "phase,cost", "--cost-threshold", "5"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupWebhookCreateMock(mockClient, &v1.CreateWebhookRequest{
+					Name:   "budget",
+					Url:    "https://ci.example.com/hooks",
+					Secret: "s3cret",
+					Events: []v1.WebhookEvent{
+						v1.WebhookEvent_WEBHOOK_EVENT_TASK_PHASE_CHANGED,
+						v1.WebhookEvent_WEBHOOK_EVENT_TASK_COST_THRESHOLD,
+					},
+					CostThreshold: 5,
+				}, webhookID)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(fmt.Sprintln(webhookID)),
+			},
+		},
+		{
+			Name: "error - invalid event",
+			Command: []string{"webhook", "create", "slack",
+				"--url", "https://hooks.slack.com/services/T000", "--secret", "s3cret",
+				"--event", "finished"},
+			Expected: TestExpectation{
+				Error: `invalid argument "finished" for "-e, --event" flag: must be one of "phase","response","error","cost"`,
+			},
+		},
+		{
+			Name: "error - cost threshold without cost event",
+			Command: []string{"webhook", "create", "slack",
+				"--url", "https://hooks.slack.com/services/T000", "--secret", "s3cret",
+				"--event", "response", "--cost-threshold", "5"},
+			Expected: TestExpectation{
+				Error: "--cost-threshold requires the cost event",
+			},
+		},
+		{
+			Name: "error - create webhook API failure",
+			Command: []string{"webhook", "create", "slack",
+				"--url", "https://hooks.slack.com/services/T000", "--secret", "s3cret",
+				"--event", "response"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Webhook.EXPECT().CreateWebhook(gomock.Any(), gomock.Any()).
+					Return(nil, connect.NewError(connect.CodeAlreadyExists, nil))
+			},
+			Expected: TestExpectation{
+				Error: "failed to create webhook: already_exists",
+			},
+		},
+	})
+}
+
+func setupWebhookCreateMock(mockClient *api_client.MockClient, req *v1.CreateWebhookRequest, webhookID string) {
+	mockClient.Webhook.EXPECT().CreateWebhook(
+		gomock.Any(),
+		&connect.Request[v1.CreateWebhookRequest]{Msg: req},
+	).Return(&connect.Response[v1.CreateWebhookResponse]{
+		Msg: &v1.CreateWebhookResponse{
+			Webhook: &v1.Webhook{
+				Metadata: &v1.WebhookMetadata{Id: webhookID},
+				Spec: &v1.WebhookSpec{
+					Name:          req.Name,
+					Url:           req.Url,
+					Events:        req.Events,
+					CostThreshold: req.CostThreshold,
+					Enabled:       true,
+				},
+			},
+		},
+	}, nil)
+}
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type webhookDeadLettersOptions struct {
+	RenderOptions RenderOptions
+}
+
+func NewWebhookDeadLettersCmd() *cobra.Command {
+	var options webhookDeadLettersOptions
+
+	cmd := &cobra.Command{
+		Use:   "dead-letters <name|id> [flags]",
+		Short: "List deliveries that could not be delivered",
+		Args:  cobra.ExactArgs(1),
+		Example: `  # Show the failed deliveries of a webhook
+  construct webhook dead-letters slack
+
+  # Include the payload of every delivery
+  construct webhook dead-letters slack --wide`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			webhookID, err := getWebhookID(cmd.Context(), client, args[0])
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Webhook().ListWebhookDeadLetters(cmd.Context(), &connect.Request[v1.ListWebhookDeadLettersRequest]{
+				Msg: &v1.ListWebhookDeadLettersRequest{
+					WebhookId: webhookID,
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to list dead letters of webhook %s: %w", args[0], err)
+			}
+
+			displayDeadLetters := make([]*WebhookDeadLetterDisplay, len(resp.Msg.DeadLetters))
+			for i, deadLetter := range resp.Msg.DeadLetters {
+				displayDeadLetters[i] = ConvertWebhookDeadLetterToDisplay(deadLetter)
+			}
+
+			return getRenderer(cmd.Context()).Render(displayDeadLetters, &options.RenderOptions)
+		},
+	}
+
+	addRenderOptions(cmd, &options.RenderOptions)
+
+	return cmd
+}
@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type webhookDeleteOptions struct {
+	Force bool
+}
+
+func NewWebhookDeleteCmd() *cobra.Command {
+	options := new(webhookDeleteOptions)
+	cmd := &cobra.Command{
+		Use:     "delete <name|id>... [flags]",
+		Short:   "Permanently delete one or more webhooks",
+		Aliases: []string{"rm"},
+		Long: `Permanently delete one or more webhooks.
+
+The dead letters of the webhook are deleted as well.`,
+		Example: `  # Delete a single webhook
+  construct webhook delete slack
+
+  # Force delete without a confirmation prompt
+  construct webhook rm slack budget --force`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			webhookIDs := make(map[string]string)
+			for _, idOrName := range args {
+				webhookID, err := getWebhookID(cmd.Context(), client, idOrName)
+				if err != nil {
+					return err
+				}
+
+				webhookIDs[idOrName] = webhookID
+			}
+
+			if !options.Force && !confirmDeletion(cmd.InOrStdin(), cmd.OutOrStdout(), "webhook", args) {
+				return nil
+			}
+
+			for idOrName, webhookID := range webhookIDs {
+				_, err := client.Webhook().DeleteWebhook(cmd.Context(), &connect.Request[v1.DeleteWebhookRequest]{
+					Msg: &v1.DeleteWebhookRequest{Id: webhookID},
+				})
+				if err != nil {
+					return fmt.Errorf("failed to delete webhook %s: %w", idOrName, err)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&options.Force, "force", "f", false, "Skip the confirmation prompt")
+
+	return cmd
+}
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/shared/conv"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func TestWebhookDelete(t *testing.T) {
+	setup := &TestSetup{}
+
+	webhookID := uuid.NewString()
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - delete by name with force flag",
+			Command: []string{"webhook", "delete", "--force", "slack"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupWebhookNameLookup(mockClient, "slack", webhookID)
+				setupWebhookDeletionMock(mockClient, webhookID)
+			},
+		},
+		{
+			Name:    "success - delete by ID with user confirmation",
+			Command: []string{"webhook", "rm", webhookID},
+			Stdin:   "y\n",
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupWebhookDeletionMock(mockClient, webhookID)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr("Are you sure you want to delete webhook " + webhookID + "? (y/n): "),
+			},
+		},
+		{
+			Name:    "error - delete webhook API failure",
+			Command: []string{"webhook", "delete", "--force", webhookID},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Webhook.EXPECT().DeleteWebhook(gomock.Any(), gomock.Any()).
+					Return(nil, connect.NewError(connect.CodeNotFound, nil))
+			},
+			Expected: TestExpectation{
+				Error: "failed to delete webhook " + webhookID + ": not_found",
+			},
+		},
+	})
+}
+
+func setupWebhookDeletionMock(mockClient *api_client.MockClient, webhookID string) {
+	mockClient.Webhook.EXPECT().DeleteWebhook(
+		gomock.Any(),
+		&connect.Request[v1.DeleteWebhookRequest]{
+			Msg: &v1.DeleteWebhookRequest{Id: webhookID},
+		},
+	).Return(&connect.Response[v1.DeleteWebhookResponse]{
+		Msg: &v1.DeleteWebhookResponse{},
+	}, nil)
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type webhookListOptions struct {
+	RenderOptions RenderOptions
+}
+
+func NewWebhookListCmd() *cobra.Command {
+	var options webhookListOptions
+
+	cmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   "List all webhooks",
+		Aliases: []string{"ls"},
+		Example: `  # List all webhooks
+  construct webhook list
+
+  # Show webhooks as JSON
+  construct webhook ls --output json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			resp, err := client.Webhook().ListWebhooks(cmd.Context(), &connect.Request[v1.ListWebhooksRequest]{
+				Msg: &v1.ListWebhooksRequest{
+					Filter: &v1.ListWebhooksRequest_Filter{},
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to list webhooks: %w", err)
+			}
+
+			displayWebhooks := make([]*WebhookDisplay, len(resp.Msg.Webhooks))
+			for i, webhook := range resp.Msg.Webhooks {
+				displayWebhooks[i] = ConvertWebhookToDisplay(webhook)
+			}
+
+			return getRenderer(cmd.Context()).Render(displayWebhooks, &options.RenderOptions)
+		},
+	}
+
+	addRenderOptions(cmd, &options.RenderOptions)
+
+	return cmd
+}
@@ -0,0 +1,171 @@
+package cmd
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestWebhookList(t *testing.T) {
+	setup := &TestSetup{
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreFields(WebhookDisplay{}, "CreatedAt"),
+		},
+	}
+
+	webhookID := uuid.NewString()
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - list webhooks",
+			Command: []string{"webhook", "list"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Webhook.EXPECT().ListWebhooks(
+					gomock.Any(),
+					&connect.Request[v1.ListWebhooksRequest]{
+						Msg: &v1.ListWebhooksRequest{
+							Filter: &v1.ListWebhooksRequest_Filter{},
+						},
+					},
+				).Return(&connect.Response[v1.ListWebhooksResponse]{
+					Msg: &v1.ListWebhooksResponse{
+						Webhooks: []*v1.Webhook{
+							{
+								Metadata: &v1.WebhookMetadata{
+									Id:        webhookID,
+									CreatedAt: timestamppb.Now(),
+								},
+								Spec: &v1.WebhookSpec{
+									Name: "slack",
+									Url:  "https://hooks.slack.com/services/T000",
+									Events: []v1.WebhookEvent{
+										v1.WebhookEvent_WEBHOOK_EVENT_TASK_RESPONSE,
+										v1.WebhookEvent_WEBHOOK_EVENT_TASK_COST_THRESHOLD,
+									},
+									CostThreshold: 5,
+									Enabled:       true,
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: []*WebhookDisplay{
+					{
+						ID:            webhookID,
+						Name:          "slack",
+						URL:           "https://hooks.slack.com/services/T000",
+						Events:        []WebhookEvent{WebhookEventResponse, WebhookEventCost},
+						Enabled:       true,
+						CostThreshold: 5,
+					},
+				},
+			},
+		},
+		{
+			Name:    "error - list webhooks API failure",
+			Command: []string{"webhook", "list"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Webhook.EXPECT().ListWebhooks(gomock.Any(), gomock.Any()).
+					Return(nil, connect.NewError(connect.CodeInternal, nil))
+			},
+			Expected: TestExpectation{
+				Error: "failed to list webhooks: internal",
+			},
+		},
+	})
+}
+
+func TestWebhookDeadLetters(t *testing.T) {
+	setup := &TestSetup{}
+
+	webhookID := uuid.NewString()
+	deadLetterID := uuid.NewString()
+	taskID := uuid.NewString()
+	createdAt := timestamppb.Now()
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - list dead letters by webhook name",
+			Command: []string{"webhook", "dead-letters", "slack"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupWebhookNameLookup(mockClient, "slack", webhookID)
+				mockClient.Webhook.EXPECT().ListWebhookDeadLetters(
+					gomock.Any(),
+					&connect.Request[v1.ListWebhookDeadLettersRequest]{
+						Msg: &v1.ListWebhookDeadLettersRequest{WebhookId: webhookID},
+					},
+				).Return(&connect.Response[v1.ListWebhookDeadLettersResponse]{
+					Msg: &v1.ListWebhookDeadLettersResponse{
+						DeadLetters: []*v1.WebhookDeadLetter{
+							{
+								Id:        deadLetterID,
+								WebhookId: webhookID,
+								TaskId:    taskID,
+								Event:     v1.WebhookEvent_WEBHOOK_EVENT_TASK_ERROR,
+								Payload:   `{"event":"task.error"}`,
+								Attempts:  5,
+								LastError: "unexpected status code 502",
+								CreatedAt: createdAt,
+							},
+						},
+					},
+				}, nil)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: []*WebhookDeadLetterDisplay{
+					{
+						ID:        deadLetterID,
+						TaskID:    taskID,
+						Event:     WebhookEventError,
+						Attempts:  5,
+						LastError: "unexpected status code 502",
+						CreatedAt: createdAt.AsTime().Local().Format("2006-01-02 15:04:05"),
+						Payload:   `{"event":"task.error"}`,
+					},
+				},
+			},
+		},
+		{
+			Name:    "error - webhook not found",
+			Command: []string{"webhook", "dead-letters", "missing"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Webhook.EXPECT().ListWebhooks(gomock.Any(), gomock.Any()).
+					Return(&connect.Response[v1.ListWebhooksResponse]{
+						Msg: &v1.ListWebhooksResponse{},
+					}, nil)
+			},
+			Expected: TestExpectation{
+				Error: "webhook missing not found",
+			},
+		},
+	})
+}
+
+func setupWebhookNameLookup(mockClient *api_client.MockClient, name, webhookID string) {
+	mockClient.Webhook.EXPECT().ListWebhooks(
+		gomock.Any(),
+		&connect.Request[v1.ListWebhooksRequest]{
+			Msg: &v1.ListWebhooksRequest{
+				Filter: &v1.ListWebhooksRequest_Filter{Name: &name},
+			},
+		},
+	).Return(&connect.Response[v1.ListWebhooksResponse]{
+		Msg: &v1.ListWebhooksResponse{
+			Webhooks: []*v1.Webhook{
+				{
+					Metadata: &v1.WebhookMetadata{Id: webhookID},
+					Spec:     &v1.WebhookSpec{Name: name},
+				},
+			},
+		},
+	}, nil)
+}
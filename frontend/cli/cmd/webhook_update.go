@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type webhookUpdateOptions struct {
+	Name          string
+	URL           string
+	Secret        string
+	Events        WebhookEvents
+	CostThreshold float64
+	Enabled       bool
+}
+
+func NewWebhookUpdateCmd() *cobra.Command {
+	var options webhookUpdateOptions
+
+	cmd := &cobra.Command{
+		Use:   "update <name|id> [flags]",
+		Short: "Update an existing webhook",
+		Args:  cobra.ExactArgs(1),
+		Long: `Update an existing webhook.
+
+Only the flags that are provided are changed. Passing --event replaces the
+current list of events.`,
+		Example: `  # Rotate the signing secret
+  construct webhook update slack --secret "$NEW_WEBHOOK_SECRET"
+
+  # Pause deliveries without deleting the webhook
+  construct webhook update slack --enabled=false`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			webhookID, err := getWebhookID(cmd.Context(), client, args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &v1.UpdateWebhookRequest{
+				Id: webhookID,
+			}
+
+			if cmd.Flags().Changed("name") {
+				req.Name = &options.Name
+			}
+			if cmd.Flags().Changed("url") {
+				req.Url = &options.URL
+			}
+			if cmd.Flags().Changed("secret") {
+				req.Secret = &options.Secret
+			}
+			if cmd.Flags().Changed("event") {
+				req.Events = options.Events.ToAPI()
+			}
+			if cmd.Flags().Changed("cost-threshold") {
+				req.CostThreshold = &options.CostThreshold
+			}
+			if cmd.Flags().Changed("enabled") {
+				req.Enabled = &options.Enabled
+			}
+
+			_, err = client.Webhook().UpdateWebhook(cmd.Context(), &connect.Request[v1.UpdateWebhookRequest]{
+				Msg: req,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to update webhook %s: %w", args[0], err)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.Name, "name", "n", "", "The new name of the webhook")
+	cmd.Flags().StringVarP(&options.URL, "url", "u", "", "The new endpoint of the webhook")
+	cmd.Flags().StringVarP(&options.Secret, "secret", "s", "", "The new signing secret of the webhook")
+	cmd.Flags().VarP(&options.Events, "event", "e", "Replace the events to deliver (phase, response, error, cost)")
+	cmd.Flags().Float64Var(&options.CostThreshold, "cost-threshold", 0, "The new cost threshold in USD")
+	cmd.Flags().BoolVar(&options.Enabled, "enabled", true, "Enable or disable deliveries")
+
+	return cmd
+}
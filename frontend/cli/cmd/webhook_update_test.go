@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/shared/conv"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func TestWebhookUpdate(t *testing.T) {
+	setup := &TestSetup{}
+
+	webhookID := uuid.NewString()
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - rotate secret and replace events",
+			Command: []string{"webhook", "update", "slack", "--secret", "n3w", "--event", "phase"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupWebhookNameLookup(mockClient, "slack", webhookID)
+				setupWebhookUpdateMock(mockClient, &v1.UpdateWebhookRequest{
+					Id:     webhookID,
+					Secret: conv.Ptr("n3w"),
+					Events: []v1.WebhookEvent{v1.WebhookEvent_WEBHOOK_EVENT_TASK_PHASE_CHANGED},
+				})
+			},
+		},
+		{
+			Name:    "success - disable webhook",
+			Command: []string{"webhook", "update", webhookID, "--enabled=false"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupWebhookUpdateMock(mockClient, &v1.UpdateWebhookRequest{
+					Id:      webhookID,
+					Enabled: conv.Ptr(false),
+				})
+			},
+		},
+		{
+			Name:    "error - update webhook API failure",
+			Command: []string{"webhook", "update", webhookID, "--url", "ftp://example.com"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Webhook.EXPECT().UpdateWebhook(gomock.Any(), gomock.Any()).
+					Return(nil, connect.NewError(connect.CodeInvalidArgument, nil))
+			},
+			Expected: TestExpectation{
+				Error: "failed to update webhook " + webhookID + ": invalid_argument",
+			},
+		},
+	})
+}
+
+func setupWebhookUpdateMock(mockClient *api_client.MockClient, req *v1.UpdateWebhookRequest) {
+	mockClient.Webhook.EXPECT().UpdateWebhook(
+		gomock.Any(),
+		&connect.Request[v1.UpdateWebhookRequest]{Msg: req},
+	).Return(&connect.Response[v1.UpdateWebhookResponse]{
+		Msg: &v1.UpdateWebhookResponse{
+			Webhook: &v1.Webhook{
+				Metadata: &v1.WebhookMetadata{Id: req.Id},
+				Spec:     &v1.WebhookSpec{},
+			},
+		},
+	}, nil)
+}
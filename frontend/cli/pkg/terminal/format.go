@@ -1,11 +1,13 @@
 package terminal
 
 import (
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"strings"
 
 	"github.com/charmbracelet/glamour"
+	v1 "github.com/furisto/construct/api/go/v1"
 )
 
 func renderUserMessage(msg *userTextMessage, width int, margin bool) string {
@@ -114,3 +116,200 @@ func formatCodeInterpreterContent(code string) string {
 func addBottomMargin(idx int, messages []message) bool {
 	return idx == 0 || idx != len(messages)-1
 }
+
+// patchTargets returns the paths of the files changed by a unified diff.
+func patchTargets(patch string) []string {
+	var targets []string
+	lines := strings.Split(patch, "\n")
+	for i, line := range lines {
+		if !strings.HasPrefix(line, "+++ ") || i == 0 || !strings.HasPrefix(lines[i-1], "--- ") {
+			continue
+		}
+
+		target, _, _ := strings.Cut(strings.TrimSpace(line[4:]), "\t")
+		if target == "/dev/null" {
+			target, _, _ = strings.Cut(strings.TrimSpace(lines[i-1][4:]), "\t")
+		}
+		target = strings.TrimPrefix(strings.TrimPrefix(target, "a/"), "b/")
+		targets = append(targets, target)
+	}
+	return targets
+}
+
+// gitCallSummary describes a call of the git tool family in the style of the
+// git command line, e.g. "commit Fix typo" or "blame main.go L10-20".
+func gitCallSummary(input *v1.ToolCall_GitInput) string {
+	var args struct {
+		Staged    bool     `json:"staged"`
+		From      string   `json:"from"`
+		To        string   `json:"to"`
+		Paths     []string `json:"paths"`
+		StatOnly  bool     `json:"stat_only"`
+		Ref       string   `json:"ref"`
+		Rev       string   `json:"rev"`
+		Path      string   `json:"path"`
+		StartLine int      `json:"start_line"`
+		EndLine   int      `json:"end_line"`
+		Create    string   `json:"create"`
+		Checkout  string   `json:"checkout"`
+		Delete    string   `json:"delete"`
+		Force     bool     `json:"force"`
+		Action    string   `json:"action"`
+		Message   string   `json:"message"`
+		Amend     bool     `json:"amend"`
+	}
+	json.Unmarshal([]byte(input.Arguments), &args)
+
+	parts := []string{input.Operation}
+	switch input.Operation {
+	case "diff":
+		if args.Staged {
+			parts = append(parts, "--staged")
+		}
+		if args.From != "" && args.To != "" {
+			parts = append(parts, args.From+".."+args.To)
+		} else if args.From != "" {
+			parts = append(parts, args.From)
+		}
+		if args.StatOnly {
+			parts = append(parts, "--stat")
+		}
+		parts = append(parts, args.Paths...)
+	case "log":
+		if args.Ref != "" {
+			parts = append(parts, args.Ref)
+		}
+		parts = append(parts, args.Paths...)
+	case "show":
+		if args.Rev != "" {
+			parts = append(parts, args.Rev)
+		}
+		if args.StatOnly {
+			parts = append(parts, "--stat")
+		}
+	case "blame":
+		parts = append(parts, args.Path)
+		if args.EndLine > 0 {
+			parts = append(parts, fmt.Sprintf("L%d-%d", Max(args.StartLine, 1), args.EndLine))
+		} else if args.StartLine > 0 {
+			parts = append(parts, fmt.Sprintf("L%d-", args.StartLine))
+		}
+	case "branch":
+		switch {
+		case args.Create != "":
+			parts = append(parts, "create", args.Create)
+		case args.Checkout != "":
+			parts = []string{"switch", args.Checkout}
+		case args.Delete != "":
+			parts = append(parts, "delete", args.Delete)
+			if args.Force {
+				parts = append(parts, "--force")
+			}
+		}
+	case "stash":
+		if args.Action != "" {
+			parts = append(parts, args.Action)
+		}
+	case "commit":
+		if args.Amend {
+			parts = append(parts, "--amend")
+		}
+		subject, _, _ := strings.Cut(args.Message, "\n")
+		if subject != "" {
+			parts = append(parts, truncate(subject, 72))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// gitResultSummary summarizes the results of git operations that change the
+// repository or give an overview of it. It returns an empty tool name for
+// results that are not shown.
+func gitResultSummary(result *v1.ToolResult_GitResult) (string, string) {
+	var output struct {
+		Commit json.RawMessage `json:"commit"`
+		Branch string          `json:"branch"`
+		Clean  bool            `json:"clean"`
+		Files  []struct {
+			Path string `json:"path"`
+		} `json:"files"`
+		Insertions int `json:"insertions"`
+		Deletions  int `json:"deletions"`
+	}
+	if err := json.Unmarshal([]byte(result.Output), &output); err != nil {
+		return "", ""
+	}
+
+	switch result.Operation {
+	case "commit":
+		var commit struct {
+			ShortHash string `json:"short_hash"`
+			Subject   string `json:"subject"`
+		}
+		json.Unmarshal(output.Commit, &commit)
+		return "Committed", fmt.Sprintf("%s %s (%s)", commit.ShortHash, truncate(commit.Subject, 72), changeStat(len(output.Files), output.Insertions, output.Deletions))
+	case "status":
+		if output.Clean {
+			return "Status", output.Branch + ", clean"
+		}
+		return "Status", fmt.Sprintf("%s, %d changed files", output.Branch, len(output.Files))
+	case "diff", "show":
+		return "Changes", changeStat(len(output.Files), output.Insertions, output.Deletions)
+	}
+	return "", ""
+}
+
+func changeStat(files, insertions, deletions int) string {
+	noun := "files"
+	if files == 1 {
+		noun = "file"
+	}
+	return fmt.Sprintf("%d %s, +%d -%d", files, noun, insertions, deletions)
+}
+
+func runTestsCallSummary(input *v1.ToolCall_RunTestsInput) string {
+	if input.Command != "" {
+		return input.Command
+	}
+
+	parts := []string{input.Framework}
+	if input.Framework == "" {
+		parts = []string{"auto"}
+	}
+	if input.Path != "" {
+		parts = append(parts, input.Path)
+	}
+	parts = append(parts, input.Tests...)
+	if input.Filter != "" {
+		parts = append(parts, "filter: "+input.Filter)
+	}
+	return strings.Join(parts, " ")
+}
+
+func runTestsResultSummary(result *v1.ToolResult_RunTestsResult) string {
+	summary := fmt.Sprintf("%d passed, %d failed, %d skipped", result.Passed, result.Failed, result.Skipped)
+	if result.TimedOut {
+		summary += ", timed out"
+	} else if !result.Success && result.Failed == 0 {
+		summary += ", run failed"
+	}
+
+	var failed []string
+	for _, test := range result.Tests {
+		if test.Status != "failed" {
+			continue
+		}
+		name := test.Name
+		if name == "" {
+			name = test.Suite
+		}
+		failed = append(failed, name)
+	}
+	if len(failed) > 3 {
+		failed = append(failed[:3], fmt.Sprintf("+%d more", len(failed)-3))
+	}
+	if len(failed) > 0 {
+		summary += " (" + strings.Join(failed, ", ") + ")"
+	}
+	return summary
+}
@@ -22,7 +22,8 @@ func (m *Session) renderHelp() string {
 		helpItemStyle.Render("  Tab           - Switch agent"),
 		"",
 		helpItemStyle.Render("Input Mode (F1):"),
-		helpItemStyle.Render("  Enter         - Send message"),
+		helpItemStyle.Render("  Enter         - Send message (queued while the agent is busy)"),
+		helpItemStyle.Render("  Alt+Enter     - Interrupt the agent and steer it with the message"),
 		helpItemStyle.Render("  Ctrl+Enter    - New line"),
 		helpItemStyle.Render("  F2            - Switch to scroll mode"),
 		"",
@@ -253,6 +253,42 @@ func (m *MessageFeed) createToolCallMessage(toolCall *v1.ToolCall, timestamp tim
 			Input:     toolInput.CreateFile,
 			timestamp: timestamp,
 		}
+	case *v1.ToolCall_DeleteFile:
+		return &deleteFileToolCall{
+			ID:        toolCall.Id,
+			Input:     toolInput.DeleteFile,
+			timestamp: timestamp,
+		}
+	case *v1.ToolCall_MoveFile:
+		return &moveFileToolCall{
+			ID:        toolCall.Id,
+			Input:     toolInput.MoveFile,
+			timestamp: timestamp,
+		}
+	case *v1.ToolCall_CopyFile:
+		return &copyFileToolCall{
+			ID:        toolCall.Id,
+			Input:     toolInput.CopyFile,
+			timestamp: timestamp,
+		}
+	case *v1.ToolCall_ApplyPatch:
+		return &applyPatchToolCall{
+			ID:        toolCall.Id,
+			Input:     toolInput.ApplyPatch,
+			timestamp: timestamp,
+		}
+	case *v1.ToolCall_Git:
+		return &gitToolCall{
+			ID:        toolCall.Id,
+			Input:     toolInput.Git,
+			timestamp: timestamp,
+		}
+	case *v1.ToolCall_RunTests:
+		return &runTestsToolCall{
+			ID:        toolCall.Id,
+			Input:     toolInput.RunTests,
+			timestamp: timestamp,
+		}
 	case *v1.ToolCall_ExecuteCommand:
 		return &executeCommandToolCall{
 			ID:        toolCall.Id,
@@ -301,6 +337,25 @@ func (m *MessageFeed) createToolCallMessage(toolCall *v1.ToolCall, timestamp tim
 			Input:     toolInput.SubmitReport,
 			timestamp: timestamp,
 		}
+	case *v1.ToolCall_Delegate:
+		return &delegateToolCall{
+			ID:        toolCall.Id,
+			Input:     toolInput.Delegate,
+			timestamp: timestamp,
+		}
+	case *v1.ToolCall_RunParallel:
+		return &runParallelToolCall{
+			ID:        toolCall.Id,
+			Input:     toolInput.RunParallel,
+			timestamp: timestamp,
+		}
+	case *v1.ToolCall_CustomTool:
+		return &customToolCall{
+			ID:        toolCall.Id,
+			ToolName:  toolCall.ToolName,
+			Input:     toolInput.CustomTool,
+			timestamp: timestamp,
+		}
 		// case *v1.ToolCall_CodeInterpreter:
 		// 	if m.Verbose {
 		// 		return &codeInterpreterToolCall{
@@ -364,6 +419,30 @@ func (m *MessageFeed) createToolResultMessage(toolResult *v1.ToolResult, timesta
 			Result:    toolOutput.SubmitReport,
 			timestamp: timestamp,
 		}
+	case *v1.ToolResult_Delegate:
+		return &delegateResult{
+			ID:        toolResult.Id,
+			Result:    toolOutput.Delegate,
+			timestamp: timestamp,
+		}
+	case *v1.ToolResult_RunParallel:
+		return &runParallelResult{
+			ID:        toolResult.Id,
+			Result:    toolOutput.RunParallel,
+			timestamp: timestamp,
+		}
+	case *v1.ToolResult_Git:
+		return &gitResult{
+			ID:        toolResult.Id,
+			Result:    toolOutput.Git,
+			timestamp: timestamp,
+		}
+	case *v1.ToolResult_RunTests:
+		return &runTestsResult{
+			ID:        toolResult.Id,
+			Result:    toolOutput.RunTests,
+			timestamp: timestamp,
+		}
 		// case *v1.ToolResult_CodeInterpreter:
 		// 	if m.Verbose {
 		// 		return &codeInterpreterResult{
@@ -402,6 +481,36 @@ func formatMessages(messages []message, partialMessage string, width int) string
 		case *editFileToolCall:
 			renderedMessages = append(renderedMessages, renderToolCallMessage("Edit", msg.Input.Path, width, addBottomMargin(i, messages)))
 
+		case *deleteFileToolCall:
+			renderedMessages = append(renderedMessages, renderToolCallMessage("Delete", msg.Input.Path, width, addBottomMargin(i, messages)))
+
+		case *moveFileToolCall:
+			renderedMessages = append(renderedMessages, renderToolCallMessage("Move", fmt.Sprintf("%s → %s", msg.Input.Source, msg.Input.Destination), width, addBottomMargin(i, messages)))
+
+		case *copyFileToolCall:
+			renderedMessages = append(renderedMessages, renderToolCallMessage("Copy", fmt.Sprintf("%s → %s", msg.Input.Source, msg.Input.Destination), width, addBottomMargin(i, messages)))
+
+		case *applyPatchToolCall:
+			patchInfo := strings.Join(patchTargets(msg.Input.Patch), ", ")
+			if msg.Input.DryRun {
+				patchInfo += " (dry run)"
+			}
+			renderedMessages = append(renderedMessages, renderToolCallMessage("Patch", patchInfo, width, addBottomMargin(i, messages)))
+
+		case *gitToolCall:
+			renderedMessages = append(renderedMessages, renderToolCallMessage("Git", gitCallSummary(msg.Input), width, addBottomMargin(i, messages)))
+
+		case *gitResult:
+			if tool, summary := gitResultSummary(msg.Result); tool != "" {
+				renderedMessages = append(renderedMessages, renderToolCallMessage(tool, summary, width, addBottomMargin(i, messages)))
+			}
+
+		case *runTestsToolCall:
+			renderedMessages = append(renderedMessages, renderToolCallMessage("Test", runTestsCallSummary(msg.Input), width, addBottomMargin(i, messages)))
+
+		case *runTestsResult:
+			renderedMessages = append(renderedMessages, renderToolCallMessage("Tests", runTestsResultSummary(msg.Result), width, addBottomMargin(i, messages)))
+
 		case *executeCommandToolCall:
 			renderedMessages = append(renderedMessages, renderToolCallMessage("Execute", msg.Input.Command, width, addBottomMargin(i, messages)))
 
@@ -437,6 +546,29 @@ func formatMessages(messages []message, partialMessage string, width int) string
 		case *handoffToolCall:
 			renderedMessages = append(renderedMessages, renderToolCallMessage("Handoff", msg.Input.RequestedAgent, width, addBottomMargin(i, messages)))
 
+		case *delegateToolCall:
+			renderedMessages = append(renderedMessages,
+				renderToolCallMessage("Delegate", fmt.Sprintf("%s: %s", msg.Input.Agent, truncate(msg.Input.Instructions, 80)), width, addBottomMargin(i, messages)))
+
+		case *delegateResult:
+			status := "completed"
+			if !msg.Result.Completed {
+				status = "incomplete"
+			}
+			renderedMessages = append(renderedMessages,
+				renderToolCallMessage("Delegated", fmt.Sprintf("%s, task %s %s: %s", msg.Result.Agent, msg.Result.TaskId, status, truncate(msg.Result.Summary, 80)), width, addBottomMargin(i, messages)))
+
+		case *runParallelToolCall:
+			renderedMessages = append(renderedMessages,
+				renderToolCallMessage("Run parallel", fmt.Sprintf("%d tasks", len(msg.Input.Tasks)), width, addBottomMargin(i, messages)))
+
+		case *runParallelResult:
+			renderedMessages = append(renderedMessages,
+				renderToolCallMessage("Finished", fmt.Sprintf("%d finished, %d failed, $%.2f", msg.Result.Finished, msg.Result.Failed, msg.Result.Cost), width, addBottomMargin(i, messages)))
+
+		case *customToolCall:
+			renderedMessages = append(renderedMessages, renderToolCallMessage(msg.ToolName, msg.Input.Arguments, width, addBottomMargin(i, messages)))
+
 		case *listFilesToolCall:
 			pathInfo := msg.Input.Path
 			if pathInfo == "" {
@@ -91,6 +91,90 @@ func (m *editFileToolCall) Timestamp() time.Time {
 	return m.timestamp
 }
 
+type deleteFileToolCall struct {
+	ID        string
+	Input     *v1.ToolCall_DeleteFileInput
+	timestamp time.Time
+}
+
+func (m *deleteFileToolCall) Type() messageType {
+	return MessageTypeAssistantTool
+}
+
+func (m *deleteFileToolCall) Timestamp() time.Time {
+	return m.timestamp
+}
+
+type moveFileToolCall struct {
+	ID        string
+	Input     *v1.ToolCall_MoveFileInput
+	timestamp time.Time
+}
+
+func (m *moveFileToolCall) Type() messageType {
+	return MessageTypeAssistantTool
+}
+
+func (m *moveFileToolCall) Timestamp() time.Time {
+	return m.timestamp
+}
+
+type copyFileToolCall struct {
+	ID        string
+	Input     *v1.ToolCall_CopyFileInput
+	timestamp time.Time
+}
+
+func (m *copyFileToolCall) Type() messageType {
+	return MessageTypeAssistantTool
+}
+
+func (m *copyFileToolCall) Timestamp() time.Time {
+	return m.timestamp
+}
+
+type applyPatchToolCall struct {
+	ID        string
+	Input     *v1.ToolCall_ApplyPatchInput
+	timestamp time.Time
+}
+
+func (m *applyPatchToolCall) Type() messageType {
+	return MessageTypeAssistantTool
+}
+
+func (m *applyPatchToolCall) Timestamp() time.Time {
+	return m.timestamp
+}
+
+type gitToolCall struct {
+	ID        string
+	Input     *v1.ToolCall_GitInput
+	timestamp time.Time
+}
+
+func (m *gitToolCall) Type() messageType {
+	return MessageTypeAssistantTool
+}
+
+func (m *gitToolCall) Timestamp() time.Time {
+	return m.timestamp
+}
+
+type runTestsToolCall struct {
+	ID        string
+	Input     *v1.ToolCall_RunTestsInput
+	timestamp time.Time
+}
+
+func (m *runTestsToolCall) Type() messageType {
+	return MessageTypeAssistantTool
+}
+
+func (m *runTestsToolCall) Timestamp() time.Time {
+	return m.timestamp
+}
+
 type executeCommandToolCall struct {
 	ID        string
 	Input     *v1.ToolCall_ExecuteCommandInput
@@ -147,6 +231,49 @@ func (m *handoffToolCall) Timestamp() time.Time {
 	return m.timestamp
 }
 
+type customToolCall struct {
+	ID        string
+	ToolName  string
+	Input     *v1.ToolCall_CustomToolInput
+	timestamp time.Time
+}
+
+func (m *customToolCall) Type() messageType {
+	return MessageTypeAssistantTool
+}
+
+func (m *customToolCall) Timestamp() time.Time {
+	return m.timestamp
+}
+
+type delegateToolCall struct {
+	ID        string
+	Input     *v1.ToolCall_DelegateInput
+	timestamp time.Time
+}
+
+func (m *delegateToolCall) Type() messageType {
+	return MessageTypeAssistantTool
+}
+
+func (m *delegateToolCall) Timestamp() time.Time {
+	return m.timestamp
+}
+
+type runParallelToolCall struct {
+	ID        string
+	Input     *v1.ToolCall_RunParallelInput
+	timestamp time.Time
+}
+
+func (m *runParallelToolCall) Type() messageType {
+	return MessageTypeAssistantTool
+}
+
+func (m *runParallelToolCall) Timestamp() time.Time {
+	return m.timestamp
+}
+
 type askUserToolCall struct {
 	ID        string
 	Input     *v1.ToolCall_AskUserInput
@@ -330,6 +457,62 @@ func (m *submitReportResult) Timestamp() time.Time {
 	return m.timestamp
 }
 
+type delegateResult struct {
+	ID        string
+	Result    *v1.ToolResult_DelegateResult
+	timestamp time.Time
+}
+
+func (m *delegateResult) Type() messageType {
+	return MessageTypeAssistantTool
+}
+
+func (m *delegateResult) Timestamp() time.Time {
+	return m.timestamp
+}
+
+type runParallelResult struct {
+	ID        string
+	Result    *v1.ToolResult_RunParallelResult
+	timestamp time.Time
+}
+
+func (m *runParallelResult) Type() messageType {
+	return MessageTypeAssistantTool
+}
+
+func (m *runParallelResult) Timestamp() time.Time {
+	return m.timestamp
+}
+
+type gitResult struct {
+	ID        string
+	Result    *v1.ToolResult_GitResult
+	timestamp time.Time
+}
+
+func (m *gitResult) Type() messageType {
+	return MessageTypeAssistantTool
+}
+
+func (m *gitResult) Timestamp() time.Time {
+	return m.timestamp
+}
+
+type runTestsResult struct {
+	ID        string
+	Result    *v1.ToolResult_RunTestsResult
+	timestamp time.Time
+}
+
+func (m *runTestsResult) Type() messageType {
+	return MessageTypeAssistantTool
+}
+
+func (m *runTestsResult) Timestamp() time.Time {
+	return m.timestamp
+}
+
 type codeInterpreterResult struct {
 	ID        string
 	Result    *v1.ToolResult_CodeInterpreterResult
@@ -25,12 +25,13 @@ type modelInfo struct {
 }
 
 type SessionKeyBindings struct {
-	Help        key.Binding
-	SendMessage key.Binding
-	NewLine     key.Binding
-	SwitchAgent key.Binding
-	ClearOrQuit key.Binding
-	SuspendTask key.Binding
+	Help         key.Binding
+	SendMessage  key.Binding
+	SteerMessage key.Binding
+	NewLine      key.Binding
+	SwitchAgent  key.Binding
+	ClearOrQuit  key.Binding
+	SuspendTask  key.Binding
 }
 
 func NewSessionKeyBindings() SessionKeyBindings {
@@ -43,6 +44,10 @@ func NewSessionKeyBindings() SessionKeyBindings {
 			key.WithKeys("enter"),
 			key.WithHelp("enter", "send message"),
 		),
+		SteerMessage: key.NewBinding(
+			key.WithKeys("alt+enter"),
+			key.WithHelp("alt+enter", "interrupt the agent and steer it with the message"),
+		),
 		SwitchAgent: key.NewBinding(
 			key.WithKeys("tab"),
 			key.WithHelp("tab", "switch agent"),
@@ -186,7 +191,7 @@ func (m *Session) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case suspendTaskCmd:
 		cmds = append(cmds, m.executeSuspendTask())
 	case sendMessageCmd:
-		cmds = append(cmds, m.executeSendMessage(msg.content))
+		cmds = append(cmds, m.executeSendMessage(msg.content, msg.delivery))
 	case getTaskCmd:
 		cmds = append(cmds, m.executeGetTask(msg.taskId))
 	case getModelCmd:
@@ -240,7 +245,9 @@ func (m *Session) onKeyEvent(msg tea.KeyMsg) []tea.Cmd {
 		m.showHelp = !m.showHelp
 		return nil
 	case key.Matches(msg, m.keyBindings.SendMessage):
-		return []tea.Cmd{m.handleMessageSend()}
+		return []tea.Cmd{m.handleMessageSend(v1.MessageDelivery_MESSAGE_DELIVERY_QUEUE)}
+	case key.Matches(msg, m.keyBindings.SteerMessage):
+		return []tea.Cmd{m.handleMessageSend(v1.MessageDelivery_MESSAGE_DELIVERY_STEER)}
 	case key.Matches(msg, m.keyBindings.SwitchAgent):
 		return m.handleSwitchAgent()
 	case key.Matches(msg, m.keyBindings.SuspendTask):
@@ -252,14 +259,14 @@ func (m *Session) onKeyEvent(msg tea.KeyMsg) []tea.Cmd {
 	return nil
 }
 
-func (m *Session) handleMessageSend() tea.Cmd {
+func (m *Session) handleMessageSend(delivery v1.MessageDelivery) tea.Cmd {
 	if m.input.Value() != "" {
 		userInput := strings.TrimSpace(m.input.Value())
 		m.input.Reset()
 
 		m.waitingForAgent = true
 		return func() tea.Msg {
-			return sendMessageCmd{content: userInput}
+			return sendMessageCmd{content: userInput, delivery: delivery}
 		}
 	}
 
@@ -337,7 +344,7 @@ func (m *Session) executeSuspendTask() tea.Cmd {
 	}
 }
 
-func (m *Session) executeSendMessage(userInput string) tea.Cmd {
+func (m *Session) executeSendMessage(userInput string, delivery v1.MessageDelivery) tea.Cmd {
 	return func() tea.Msg {
 		_, err := m.apiClient.Message().CreateMessage(context.Background(), &connect.Request[v1.CreateMessageRequest]{
 			Msg: &v1.CreateMessageRequest{
@@ -351,6 +358,7 @@ func (m *Session) executeSendMessage(userInput string) tea.Cmd {
 						},
 					},
 				},
+				Delivery: delivery,
 			},
 		})
 
@@ -475,6 +483,10 @@ func (m *Session) headerView() string {
 		case v1.TaskPhase_TASK_PHASE_SUSPENDED:
 			statusText = taskStatusStyle.Render("Suspended")
 		}
+
+		if pending := m.task.Status.PendingMessages; pending > 0 {
+			statusText += taskStatusStyle.Render(fmt.Sprintf(" (%d queued)", pending))
+		}
 	}
 
 	agentSection := lipgloss.JoinHorizontal(lipgloss.Left,
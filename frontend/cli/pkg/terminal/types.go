@@ -2,6 +2,8 @@ package terminal
 
 import (
 	"time"
+
+	v1 "github.com/furisto/construct/api/go/v1"
 )
 
 type appState int
@@ -22,7 +24,8 @@ const (
 
 type suspendTaskCmd struct{}
 type sendMessageCmd struct {
-	content string
+	content  string
+	delivery v1.MessageDelivery
 }
 type getTaskCmd struct {
 	taskId string
@@ -24,6 +24,18 @@ func SupportedKeys() []string {
 		"cmd.resume",
 		"cmd.resume.recent_task_limit",
 
+		// Defaults
+		"defaults",
+		"defaults.agent",
+
+		// Task behaviour, also settable from a project's .construct/config.yaml
+		"permissions",
+		"permissions.policy",
+		"permissions.commands",
+		"permissions.destructive_git",
+		"instructions",
+		"ignore",
+
 		// Logging
 		"log",
 		"log.level",
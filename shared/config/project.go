@@ -0,0 +1,284 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/furisto/construct/shared"
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	ProjectConfigDir  = ".construct"
+	ProjectConfigFile = "config.yaml"
+)
+
+// Source identifies the configuration layer a value was read from. Project
+// configuration takes precedence over user configuration, which takes
+// precedence over built-in defaults. The permission keys are the exception:
+// a repository is untrusted content, so the project configuration can only
+// restrict the permissions of the user configuration further.
+type Source string
+
+const (
+	SourceDefault Source = "default"
+	SourceUser    Source = "user"
+	SourceProject Source = "project"
+	// SourceCombined marks a permission combined from the user and the
+	// project configuration.
+	SourceCombined Source = "combined"
+)
+
+// permissionKeys are combined from the user and the project configuration
+// instead of being overridden by the project.
+var permissionKeys = []string{"permissions.policy", "permissions.commands", "permissions.destructive_git"}
+
+type PermissionPolicy string
+
+const (
+	// PermissionPolicyAllow lets the agent use every tool. Command execution is
+	// still limited to permissions.commands if that list is not empty.
+	PermissionPolicyAllow PermissionPolicy = "allow"
+	// PermissionPolicyReadOnly denies tools that modify files or run commands.
+	PermissionPolicyReadOnly PermissionPolicy = "read-only"
+)
+
+func (p PermissionPolicy) Validate() error {
+	switch p {
+	case PermissionPolicyAllow, PermissionPolicyReadOnly:
+		return nil
+	}
+	return fmt.Errorf("invalid permission policy %q: must be one of %q, %q", p, PermissionPolicyAllow, PermissionPolicyReadOnly)
+}
+
+// projectFile mirrors the layout of .construct/config.yaml. It is decoded
+// strictly so that a misspelled key fails loudly instead of being ignored.
+type projectFile struct {
+	Defaults     projectDefaults    `yaml:"defaults"`
+	Permissions  projectPermissions `yaml:"permissions"`
+	Instructions string             `yaml:"instructions"`
+	Ignore       []string           `yaml:"ignore"`
+	Models       map[string]string  `yaml:"models"`
+}
+
+type projectDefaults struct {
+	Agent string `yaml:"agent"`
+}
+
+type projectPermissions struct {
+	Policy         PermissionPolicy `yaml:"policy"`
+	Commands       []string         `yaml:"commands"`
+	DestructiveGit bool             `yaml:"destructive_git"`
+}
+
+// FindProjectConfig walks up from dir and returns the path of the closest
+// .construct/config.yaml.
+func FindProjectConfig(fs *afero.Afero, dir string) (string, bool, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to resolve project directory: %w", err)
+	}
+
+	for {
+		candidate := filepath.Join(dir, ProjectConfigDir, ProjectConfigFile)
+		exists, err := fs.Exists(candidate)
+		if err != nil {
+			return "", false, fmt.Errorf("failed to check project config file: %w", err)
+		}
+		if exists {
+			return candidate, true, nil
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false, nil
+		}
+		dir = parent
+	}
+}
+
+func parseProjectConfig(content []byte) (map[string]any, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+
+	var file projectFile
+	if err := decoder.Decode(&file); err != nil {
+		if errors.Is(err, io.EOF) {
+			return map[string]any{}, nil
+		}
+		return nil, err
+	}
+
+	if file.Permissions.Policy != "" {
+		if err := file.Permissions.Policy.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	settings := make(map[string]any)
+	if err := yaml.Unmarshal(content, &settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// TaskSettings is the effective configuration the daemon applies to a task
+// running in a project directory.
+type TaskSettings struct {
+	Agent            string
+	PermissionPolicy PermissionPolicy
+	// AllowedCommands limits the commands the agent may run. Nil allows all
+	// commands, an empty list none.
+	AllowedCommands []string
+	// AllowDestructiveGit permits git operations that discard work, such as
+	// reset --hard or force pushes.
+	AllowDestructiveGit bool
+	Instructions        string
+	Ignore              []string
+	Models              map[string]string
+}
+
+// ModelFor returns the model override for the agent with the given name.
+func (s *TaskSettings) ModelFor(agentName string) (string, bool) {
+	model, ok := s.Models[agentName]
+	return model, ok && model != ""
+}
+
+func (c *Store) TaskSettings() (*TaskSettings, error) {
+	settings := &TaskSettings{
+		PermissionPolicy: PermissionPolicyAllow,
+	}
+
+	if value, found := c.Get("defaults.agent"); found {
+		settings.Agent, _ = value.String()
+	}
+
+	// The permissions of the user and the project configuration are combined
+	// so that the stricter one applies.
+	for _, value := range c.layerValues("permissions.policy") {
+		policy, _ := value.String()
+		if err := PermissionPolicy(policy).Validate(); err != nil {
+			return nil, err
+		}
+		if PermissionPolicy(policy) == PermissionPolicyReadOnly {
+			settings.PermissionPolicy = PermissionPolicyReadOnly
+		}
+	}
+
+	for _, value := range c.layerValues("permissions.commands") {
+		commands, ok := value.StringSlice()
+		if !ok {
+			return nil, fmt.Errorf("permissions.commands must be a list of strings")
+		}
+		if len(commands) > 0 {
+			settings.AllowedCommands = intersectCommands(settings.AllowedCommands, commands)
+		}
+	}
+
+	// Only the user can permit destructive git operations.
+	for i, layer := range []map[string]any{c.settings, c.project} {
+		raw, found := getNestedValue(layer, "permissions.destructive_git")
+		if !found {
+			continue
+		}
+		allow, ok := Value{raw: raw}.Bool()
+		if !ok {
+			return nil, fmt.Errorf("permissions.destructive_git must be a boolean")
+		}
+		settings.AllowDestructiveGit = (i == 0 || settings.AllowDestructiveGit) && allow
+	}
+
+	if value, found := c.Get("instructions"); found {
+		settings.Instructions, _ = value.String()
+	}
+
+	if value, found := c.Get("ignore"); found {
+		ignore, ok := value.StringSlice()
+		if !ok {
+			return nil, fmt.Errorf("ignore must be a list of strings")
+		}
+		settings.Ignore = ignore
+	}
+
+	if value, found := c.Get("models"); found {
+		models, ok := value.StringMap()
+		if !ok {
+			return nil, fmt.Errorf("models must map agent names to model names")
+		}
+		settings.Models = models
+	}
+
+	return settings, nil
+}
+
+// layerValues returns the values of key in the user and the project
+// configuration, in that order.
+func (c *Store) layerValues(key string) []Value {
+	var values []Value
+	for _, layer := range []map[string]any{c.settings, c.project} {
+		if raw, found := getNestedValue(layer, key); found {
+			values = append(values, Value{raw: raw})
+		}
+	}
+	return values
+}
+
+// intersectCommands returns the command patterns allowed by both lists. A
+// pattern is kept if it is covered by a pattern of the other list, so the
+// result never allows a command one of the lists denies. A nil list allows
+// every command.
+func intersectCommands(a, b []string) []string {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+
+	result := []string{}
+	for _, pair := range [][2][]string{{a, b}, {b, a}} {
+		for _, pattern := range pair[0] {
+			if slices.Contains(result, pattern) {
+				continue
+			}
+			if slices.ContainsFunc(pair[1], func(other string) bool { return patternCovers(other, pattern) }) {
+				result = append(result, pattern)
+			}
+		}
+	}
+	return result
+}
+
+// patternCovers reports whether every command matching pattern also matches
+// other, in which a '*' matches any sequence of characters. Matching the
+// pattern literally against other suffices because a '*' of pattern can only
+// be matched by a '*' of other.
+func patternCovers(other, pattern string) bool {
+	expr := "^" + strings.ReplaceAll(regexp.QuoteMeta(strings.TrimSpace(other)), `\*`, ".*") + "$"
+	matched, _ := regexp.MatchString(expr, strings.TrimSpace(pattern))
+	return matched
+}
+
+// LoadTaskSettings merges the user configuration with the project
+// configuration closest to projectDirectory.
+func LoadTaskSettings(fs *afero.Afero, userInfo shared.UserInfo, projectDirectory string) (*TaskSettings, error) {
+	store, err := NewStore(fs, userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	if projectDirectory != "" {
+		if err := store.LoadProject(projectDirectory); err != nil {
+			return nil, err
+		}
+	}
+
+	return store.TaskSettings()
+}
@@ -0,0 +1,111 @@
+package config
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/furisto/construct/shared"
+	"github.com/spf13/afero"
+)
+
+type testUserInfo struct {
+	shared.UserInfo
+}
+
+func (testUserInfo) ConstructConfigDir() (string, error) {
+	return "/home/user/.construct", nil
+}
+
+func TestLoadTaskSettingsPermissions(t *testing.T) {
+	tests := []struct {
+		Name                string
+		User                string
+		Project             string
+		Policy              PermissionPolicy
+		AllowedCommands     []string
+		AllowDestructiveGit bool
+	}{
+		{
+			Name:    "project cannot loosen a read-only user policy",
+			User:    "permissions:\n  policy: read-only\n",
+			Project: "permissions:\n  policy: allow\n",
+			Policy:  PermissionPolicyReadOnly,
+		},
+		{
+			Name:    "project can tighten the user policy",
+			User:    "permissions:\n  policy: allow\n",
+			Project: "permissions:\n  policy: read-only\n",
+			Policy:  PermissionPolicyReadOnly,
+		},
+		{
+			Name:    "project cannot permit destructive git operations",
+			Project: "permissions:\n  destructive_git: true\n",
+			Policy:  PermissionPolicyAllow,
+		},
+		{
+			Name:    "project can deny destructive git operations",
+			User:    "permissions:\n  destructive_git: true\n",
+			Project: "permissions:\n  destructive_git: false\n",
+			Policy:  PermissionPolicyAllow,
+		},
+		{
+			Name:                "user permits destructive git operations",
+			User:                "permissions:\n  destructive_git: true\n",
+			Project:             "instructions: Run make lint\n",
+			Policy:              PermissionPolicyAllow,
+			AllowDestructiveGit: true,
+		},
+		{
+			Name:            "project commands limit unrestricted users",
+			Project:         "permissions:\n  commands: [\"go test *\"]\n",
+			Policy:          PermissionPolicyAllow,
+			AllowedCommands: []string{"go test *"},
+		},
+		{
+			Name:            "project commands are intersected with the user commands",
+			User:            "permissions:\n  commands: [\"go *\", \"make lint\"]\n",
+			Project:         "permissions:\n  commands: [\"go test *\", \"rm *\", \"make *\"]\n",
+			Policy:          PermissionPolicyAllow,
+			AllowedCommands: []string{"make lint", "go test *"},
+		},
+		{
+			Name:            "project wildcard keeps the user commands",
+			User:            "permissions:\n  commands: [\"npm test\"]\n",
+			Project:         "permissions:\n  commands: [\"*\"]\n",
+			Policy:          PermissionPolicyAllow,
+			AllowedCommands: []string{"npm test"},
+		},
+		{
+			Name:            "disjoint commands allow none",
+			User:            "permissions:\n  commands: [\"npm test\"]\n",
+			Project:         "permissions:\n  commands: [\"go test *\"]\n",
+			Policy:          PermissionPolicyAllow,
+			AllowedCommands: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			fs := &afero.Afero{Fs: afero.NewMemMapFs()}
+			if tt.User != "" {
+				fs.WriteFile("/home/user/.construct/config.yaml", []byte(tt.User), 0600)
+			}
+			fs.WriteFile("/repo/.construct/config.yaml", []byte(tt.Project), 0644)
+
+			settings, err := LoadTaskSettings(fs, testUserInfo{}, "/repo")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if settings.PermissionPolicy != tt.Policy {
+				t.Errorf("expected policy %s, got %s", tt.Policy, settings.PermissionPolicy)
+			}
+			if (settings.AllowedCommands == nil) != (tt.AllowedCommands == nil) || !slices.Equal(settings.AllowedCommands, tt.AllowedCommands) {
+				t.Errorf("expected allowed commands %#v, got %#v", tt.AllowedCommands, settings.AllowedCommands)
+			}
+			if settings.AllowDestructiveGit != tt.AllowDestructiveGit {
+				t.Errorf("expected destructive git %t, got %t", tt.AllowDestructiveGit, settings.AllowDestructiveGit)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/furisto/construct/shared"
@@ -11,14 +12,17 @@ import (
 )
 
 type Store struct {
-	settings map[string]any
-	fs       *afero.Afero
-	userInfo shared.UserInfo
+	settings    map[string]any
+	project     map[string]any
+	projectFile string
+	fs          *afero.Afero
+	userInfo    shared.UserInfo
 }
 
 func NewStore(fs *afero.Afero, userInfo shared.UserInfo) (*Store, error) {
 	store := &Store{
 		settings: make(map[string]any),
+		project:  make(map[string]any),
 		fs:       fs,
 		userInfo: userInfo,
 	}
@@ -60,21 +64,95 @@ func (c *Store) load() error {
 	return nil
 }
 
-func (c *Store) Get(key string) (Value, bool) {
-	raw, found := getNestedValue(c.settings, key)
+// LoadProject layers the project configuration closest to dir on top of the
+// user configuration. Values from the project take precedence on reads, while
+// Set, Delete and Flush only ever touch the user configuration.
+func (c *Store) LoadProject(dir string) error {
+	path, found, err := FindProjectConfig(c.fs, dir)
+	if err != nil {
+		return err
+	}
+
 	if !found {
+		return nil
+	}
+
+	content, err := c.fs.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read project config file: %w", err)
+	}
+
+	settings, err := parseProjectConfig(content)
+	if err != nil {
+		return fmt.Errorf("failed to parse project config file %s: %w", path, err)
+	}
+
+	c.project = settings
+	c.projectFile = path
+	return nil
+}
+
+// ProjectFile returns the path of the loaded project configuration file.
+func (c *Store) ProjectFile() (string, bool) {
+	return c.projectFile, c.projectFile != ""
+}
+
+func (c *Store) Get(key string) (Value, bool) {
+	value, _, found := c.Lookup(key)
+	return value, found
+}
+
+// Lookup returns the effective value of key together with the layer it was
+// read from. Permission keys set by the project are combined with the user
+// configuration, see TaskSettings.
+func (c *Store) Lookup(key string) (Value, Source, bool) {
+	if value, found := c.lookupPermission(key); found {
+		return value, SourceCombined, true
+	}
+
+	if raw, found := getNestedValue(c.project, key); found {
+		return Value{raw: raw}, SourceProject, true
+	}
+
+	if raw, found := getNestedValue(c.settings, key); found {
+		return Value{raw: raw}, SourceUser, true
+	}
+
+	return Value{}, SourceDefault, false
+}
+
+func (c *Store) lookupPermission(key string) (Value, bool) {
+	if !slices.Contains(permissionKeys, key) {
+		return Value{}, false
+	}
+	if _, found := getNestedValue(c.project, key); !found {
+		return Value{}, false
+	}
+
+	settings, err := c.TaskSettings()
+	if err != nil {
 		return Value{}, false
 	}
 
-	return Value{raw: raw}, true
+	switch key {
+	case "permissions.policy":
+		return Value{raw: string(settings.PermissionPolicy)}, true
+	case "permissions.commands":
+		if settings.AllowedCommands != nil {
+			return Value{raw: settings.AllowedCommands}, true
+		}
+	case "permissions.destructive_git":
+		return Value{raw: settings.AllowDestructiveGit}, true
+	}
+	return Value{}, false
 }
 
 func (c *Store) GetOrDefault(key string, defaultValue Value) (Value, bool) {
-	raw, found := getNestedValue(c.settings, key)
+	value, found := c.Get(key)
 	if !found {
 		return defaultValue, false
 	}
-	return Value{raw: raw}, true
+	return value, true
 }
 
 func (c *Store) Set(key string, value any) error {
@@ -161,6 +239,43 @@ func (v Value) Bool() (bool, bool) {
 	return false, false
 }
 
+func (v Value) StringSlice() ([]string, bool) {
+	switch raw := v.raw.(type) {
+	case string:
+		return []string{raw}, true
+	case []string:
+		return raw, true
+	case []any:
+		result := make([]string, 0, len(raw))
+		for _, item := range raw {
+			str, ok := item.(string)
+			if !ok {
+				return nil, false
+			}
+			result = append(result, str)
+		}
+		return result, true
+	}
+	return nil, false
+}
+
+func (v Value) StringMap() (map[string]string, bool) {
+	raw, ok := v.raw.(map[string]any)
+	if !ok {
+		return nil, false
+	}
+
+	result := make(map[string]string, len(raw))
+	for key, item := range raw {
+		str, ok := item.(string)
+		if !ok {
+			return nil, false
+		}
+		result[key] = str
+	}
+	return result, true
+}
+
 func (v Value) Raw() any {
 	return v.raw
 }
@@ -3,6 +3,7 @@
 package listener
 
 import (
+	"crypto/tls"
 	"fmt"
 	"net"
 	"os"
@@ -38,13 +39,13 @@ func IsLaunchdSocketActivation() bool {
 	return strings.HasPrefix(os.Getenv("XPC_SERVICE_NAME"), "sh.construct.daemon.")
 }
 
-func DetectProvider(httpAddress, unixSocket string) (Provider, error) {
+func DetectProvider(httpAddress, unixSocket string, tlsConfig *tls.Config) (Provider, error) {
 	if unixSocket != "" {
 		return NewUnixSocketProvider(unixSocket), nil
 	}
 
 	if httpAddress != "" {
-		return NewTCPListenerProvider(httpAddress), nil
+		return NewTCPListenerProvider(httpAddress, tlsConfig), nil
 	}
 
 	if IsLaunchdSocketActivation() {
@@ -1,6 +1,9 @@
 package listener
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	"net"
 	"os"
@@ -48,13 +51,17 @@ func (p *UnixSocketProvider) ActivationType() string {
 
 type TCPProvider struct {
 	httpAddress string
+	tlsConfig   *tls.Config
 }
 
 var _ Provider = (*TCPProvider)(nil)
 
-func NewTCPListenerProvider(httpAddress string) *TCPProvider {
+// NewTCPListenerProvider creates a provider for a plain TCP listener. When
+// tlsConfig is not nil, accepted connections are served over TLS.
+func NewTCPListenerProvider(httpAddress string, tlsConfig *tls.Config) *TCPProvider {
 	return &TCPProvider{
 		httpAddress: httpAddress,
+		tlsConfig:   tlsConfig,
 	}
 }
 
@@ -64,6 +71,10 @@ func (p *TCPProvider) Create() (net.Listener, error) {
 		return nil, fmt.Errorf("failed to listen on tcp: %w", err)
 	}
 
+	if p.tlsConfig != nil {
+		return tls.NewListener(listener, p.tlsConfig), nil
+	}
+
 	return listener, nil
 }
 
@@ -74,3 +85,38 @@ func (p *TCPProvider) Close() error {
 func (p *TCPProvider) ActivationType() string {
 	return "tcp"
 }
+
+// ServerTLSConfig loads the daemon certificate and, if clientCAFile is set, the
+// CA used to verify client certificates. Clients without a certificate are
+// still accepted so that they can authenticate with a token instead.
+func ServerTLSConfig(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	if certFile == "" || keyFile == "" {
+		return nil, errors.New("both a certificate and a key are required for tls")
+	}
+
+	certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tls certificate: %w", err)
+	}
+
+	config := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if clientCAFile != "" {
+		clientCA, err := os.ReadFile(clientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client ca: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(clientCA) {
+			return nil, errors.New("no certificates found in client ca file")
+		}
+		config.ClientCAs = pool
+		config.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+
+	return config, nil
+}
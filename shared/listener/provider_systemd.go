@@ -3,6 +3,7 @@
 package listener
 
 import (
+	"crypto/tls"
 	"fmt"
 	"net"
 	"os"
@@ -81,13 +82,13 @@ func isSocket(fd uintptr) bool {
 	return stat.Mode&syscall.S_IFMT == syscall.S_IFSOCK
 }
 
-func DetectProvider(httpAddress, unixSocket string) (Provider, error) {
+func DetectProvider(httpAddress, unixSocket string, tlsConfig *tls.Config) (Provider, error) {
 	if unixSocket != "" {
 		return NewUnixSocketProvider(unixSocket), nil
 	}
 
 	if httpAddress != "" {
-		return NewTCPListenerProvider(httpAddress), nil
+		return NewTCPListenerProvider(httpAddress, tlsConfig), nil
 	}
 
 	if IsSystemdSocketActivation() {
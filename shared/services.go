@@ -56,16 +56,18 @@ func (m *ContextManager) LoadContext() (*api.EndpointContexts, error) {
 }
 
 func (m *ContextManager) UpsertContext(contextName string, kind string, address string, setCurrent bool) (bool, error) {
+	return m.UpsertEndpointContext(contextName, api.EndpointContext{
+		Address: address,
+		Kind:    kind,
+	}, setCurrent)
+}
+
+func (m *ContextManager) UpsertEndpointContext(contextName string, context api.EndpointContext, setCurrent bool) (bool, error) {
 	endpointContexts, err := m.LoadContext()
 	if err != nil {
 		return false, err
 	}
 
-	context := api.EndpointContext{
-		Address: address,
-		Kind:    kind,
-	}
-
 	if err := context.Validate(); err != nil {
 		return false, err
 	}